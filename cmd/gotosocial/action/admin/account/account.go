@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -104,12 +105,18 @@ var Create action.GTSAction = func(ctx context.Context) error {
 		return err
 	}
 
+	domain := config.GetAdminAccountDomain()
+	if err := validate.AccountDomain(domain); err != nil {
+		return err
+	}
+
 	_, err = state.DB.NewSignup(ctx, gtsmodel.NewSignup{
 		Username:      username,
 		Email:         email,
 		Password:      password,
 		EmailVerified: true, // Assume cli user wants email marked as verified already.
 		PreApproved:   true, // Assume cli user wants account marked as approved already.
+		AccountDomain: domain,
 	})
 	return err
 }
@@ -385,3 +392,30 @@ var Password action.GTSAction = func(ctx context.Context) error {
 		"encrypted_password",
 	)
 }
+
+// Recount recounts stored stats of all local
+// accounts, correcting any that have drifted.
+var Recount action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	//nolint:contextcheck
+	cleaner := cleaner.New(state)
+
+	corrected, err := cleaner.Account().RecountStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Infof(ctx, "corrected stats of %d local accounts", corrected)
+	return nil
+}
@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// Caches initializes all caches from the current
+// configuration, reporting any configuration errors,
+// then prints each cache's indices, capacity and the
+// estimated size of a single entry.
+var Caches action.GTSAction = func(ctx context.Context) error {
+	var state state.State
+
+	if err := state.Caches.TryInit(); err != nil {
+		return fmt.Errorf("invalid cache configuration:\n%w", err)
+	}
+
+	fmt.Printf("memory target: %s\n\n", config.GetCacheMemoryTarget())
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "cache\tcapacity\tentry size\tindices")
+	for _, info := range state.Caches.Info() {
+		size := "-"
+		if info.EntrySize > 0 {
+			size = fmt.Sprintf("%dB", info.EntrySize)
+		}
+
+		indices := "-"
+		if len(info.Indices) > 0 {
+			indices = strings.Join(info.Indices, " | ")
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", info.Name, info.Cap, size, indices)
+	}
+
+	return w.Flush()
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -41,11 +42,16 @@ var All action.GTSAction = func(ctx context.Context) error {
 		}
 	}()
 
-	if config.GetAdminMediaPruneDryRun() {
+	dryRun := config.GetAdminMediaPruneDryRun()
+	if dryRun {
 		log.Info(ctx, "prune DRY RUN")
 		ctx = gtscontext.SetDryRun(ctx)
 	}
 
+	// Collect pruned media for report.
+	report := newReport(prune.state)
+	ctx = cleaner.SetMediaCollector(ctx, report.collect)
+
 	days := config.GetMediaRemoteCacheDays()
 
 	// Perform the actual pruning with logging.
@@ -57,5 +63,5 @@ var All action.GTSAction = func(ctx context.Context) error {
 		log.Error(ctx, "error cleaning storage: %v", err)
 	}
 
-	return nil
+	return report.finish(config.GetAdminMediaPruneReport(), dryRun)
 }
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -42,11 +43,16 @@ var Remote action.GTSAction = func(ctx context.Context) error {
 		}
 	}()
 
-	if config.GetAdminMediaPruneDryRun() {
+	dryRun := config.GetAdminMediaPruneDryRun()
+	if dryRun {
 		log.Info(ctx, "prune DRY RUN")
 		ctx = gtscontext.SetDryRun(ctx)
 	}
 
+	// Collect pruned media for report.
+	report := newReport(prune.state)
+	ctx = cleaner.SetMediaCollector(ctx, report.collect)
+
 	t := time.Now().Add(-24 * time.Hour * time.Duration(config.GetMediaRemoteCacheDays()))
 
 	// Perform the actual pruning with logging.
@@ -58,5 +64,5 @@ var Remote action.GTSAction = func(ctx context.Context) error {
 		log.Error(ctx, "error cleaning storage: %v", err)
 	}
 
-	return nil
+	return report.finish(config.GetAdminMediaPruneReport(), dryRun)
 }
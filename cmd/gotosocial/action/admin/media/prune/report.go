@@ -0,0 +1,212 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package prune
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// report collects the media attachments passed
+// to it by cleaner.Media utilities as they're
+// pruned (or in a dry run, would be pruned),
+// grouping them by their owning account's domain.
+type report struct {
+	state *state.State
+	mu    sync.Mutex
+
+	// Media attachment IDs already
+	// collected, as in a dry run the
+	// same media may be seen twice.
+	seen map[string]struct{}
+
+	// Account ID -> domain
+	// lookups already made.
+	accounts map[string]string
+
+	// Collected media per domain.
+	domains map[string]*reportDomain
+}
+
+// reportFile is the JSON
+// report file structure.
+type reportFile struct {
+	DryRun  bool            `json:"dry_run"`
+	Count   int             `json:"count"`
+	Bytes   int             `json:"bytes"`
+	Domains []*reportDomain `json:"domains"`
+}
+
+// reportDomain is all collected
+// media owned by accounts on
+// one domain, and their size.
+type reportDomain struct {
+	Domain string        `json:"domain"`
+	Count  int           `json:"count"`
+	Bytes  int           `json:"bytes"`
+	Media  []reportMedia `json:"media"`
+}
+
+// reportMedia is one
+// collected attachment.
+type reportMedia struct {
+	ID        string `json:"id"`
+	AccountID string `json:"account_id"`
+
+	// Size is the summed size
+	// of file + thumbnail.
+	Size int `json:"size"`
+
+	// LastAccessedAt is the last time the attachment
+	// was updated, eg., when it was last (re)fetched.
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+}
+
+func newReport(state *state.State) *report {
+	return &report{
+		state:    state,
+		seen:     make(map[string]struct{}),
+		accounts: make(map[string]string),
+		domains:  make(map[string]*reportDomain),
+	}
+}
+
+// collect implements cleaner.MediaCollector.
+func (r *report) collect(ctx context.Context, media *gtsmodel.MediaAttachment) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.seen[media.ID]; ok {
+		return
+	}
+	r.seen[media.ID] = struct{}{}
+
+	domain := r.domainOf(ctx, media.AccountID)
+
+	d, ok := r.domains[domain]
+	if !ok {
+		d = &reportDomain{Domain: domain}
+		r.domains[domain] = d
+	}
+
+	size := media.File.FileSize + media.Thumbnail.FileSize
+	d.Count++
+	d.Bytes += size
+	d.Media = append(d.Media, reportMedia{
+		ID:             media.ID,
+		AccountID:      media.AccountID,
+		Size:           size,
+		LastAccessedAt: media.UpdatedAt,
+	})
+}
+
+// domainOf returns the domain of the account with
+// given ID, the instance host for local accounts,
+// or "unknown" if the account couldn't be found.
+func (r *report) domainOf(ctx context.Context, accountID string) string {
+	if domain, ok := r.accounts[accountID]; ok {
+		return domain
+	}
+
+	domain := "unknown"
+
+	account, err := r.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	switch {
+	case err != nil && !errors.Is(err, db.ErrNoEntries):
+		log.Errorf(ctx, "error getting account %s: %v", accountID, err)
+	case account == nil:
+		// Missing account.
+	case account.IsLocal():
+		domain = config.GetHost()
+	default:
+		domain = account.Domain
+	}
+
+	r.accounts[accountID] = domain
+	return domain
+}
+
+// finish writes the collected media to a JSON report file
+// at path (if set), then prints a summary of the number
+// of attachments and bytes reclaimable per domain.
+func (r *report) finish(path string, dryRun bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file := reportFile{DryRun: dryRun}
+	for _, d := range r.domains {
+		slices.SortFunc(d.Media, func(a, b reportMedia) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+		file.Count += d.Count
+		file.Bytes += d.Bytes
+		file.Domains = append(file.Domains, d)
+	}
+
+	// Largest domains first.
+	slices.SortFunc(file.Domains, func(a, b *reportDomain) int {
+		if c := cmp.Compare(b.Bytes, a.Bytes); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Domain, b.Domain)
+	})
+
+	if path != "" {
+		b, err := json.MarshalIndent(file, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding report: %w", err)
+		}
+
+		if err := os.WriteFile(path, b, 0o644); err != nil {
+			return fmt.Errorf("error writing report: %w", err)
+		}
+	}
+
+	verb := "reclaimed"
+	if dryRun {
+		verb = "reclaimable"
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "domain\tattachments\tbytes %s\n", verb)
+	for _, d := range file.Domains {
+		fmt.Fprintf(w, "%s\t%d\t%s\n", d.Domain, d.Count, bytesize.Size(d.Bytes))
+	}
+	fmt.Fprintf(w, "total\t%d\t%s\n", file.Count, bytesize.Size(file.Bytes))
+
+	return w.Flush()
+}
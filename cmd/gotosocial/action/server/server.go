@@ -60,6 +60,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
 )
@@ -137,8 +138,12 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error initializing tracing: %w", err)
 	}
 
-	// Initialize caches
-	state.Caches.Init()
+	// Initialize caches, reporting all
+	// cache configuration errors together
+	// before anything else is started.
+	if err := state.Caches.TryInit(); err != nil {
+		return fmt.Errorf("error initializing caches:\n%w", err)
+	}
 	state.Caches.Start()
 
 	// Open connection to the database now caches started.
@@ -161,6 +166,22 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error creating instance application: %s", err)
 	}
 
+	// Ensure every account domain in use by local
+	// accounts is still configured, otherwise their
+	// names would silently change or stop resolving.
+	accountDomains, err := dbService.GetLocalAccountDomains(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting local account domains: %s", err)
+	}
+	for _, d := range accountDomains {
+		if !config.IsAccountDomain(d) {
+			return fmt.Errorf(
+				"account domain %s is used by local accounts but is not set in %s or %s; add it back to your config",
+				d, config.AccountDomainFlag(), config.AccountDomainsFlag(),
+			)
+		}
+	}
+
 	// Get the instance account (we'll need this later).
 	instanceAccount, err := dbService.GetInstanceAccount(ctx, "")
 	if err != nil {
@@ -269,18 +290,61 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	// Initialize the specialized workers pools.
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
-	state.Workers.Delivery.Init(client)
+	if err := state.Workers.Delivery.Init(client); err != nil {
+		return fmt.Errorf("error initializing delivery workers: %w", err)
+	}
 	state.Workers.Client.Process = processor.Workers().ProcessFromClientAPI
 	state.Workers.Federator.Process = processor.Workers().ProcessFromFediAPI
 
+	// Track the health of remote domains
+	// delivered to, backing off from those
+	// failing, persisted to the database.
+	state.Workers.Delivery.Health = &delivery.DomainHealth{DB: state.DB}
+
+	if config.GetFederationAuditLog() {
+		// Record delivery attempts to the
+		// federation audit log, with buffered
+		// entries written out every 10s.
+		auditLog := &delivery.AuditLog{DB: state.DB}
+		state.Workers.Delivery.Audit = auditLog
+		if !state.Workers.Scheduler.AddRecurring(
+			"@deliveryaudit", // id
+			time.Time{},      // start
+			10*time.Second,   // freq
+			func(ctx context.Context, _ time.Time) {
+				auditLog.Flush(ctx)
+			},
+		) {
+			return errors.New("error scheduling delivery audit log flush")
+		}
+	}
+
 	// Now start workers!
 	state.Workers.Start()
 
+	// Resize workers according to any
+	// overrides set via the admin API.
+	if err := processor.Admin().WorkersResume(ctx); err != nil {
+		return fmt.Errorf("error resizing workers: %w", err)
+	}
+
 	// Schedule notif tasks for all existing poll expiries.
 	if err := processor.Polls().ScheduleAll(ctx); err != nil {
 		return fmt.Errorf("error scheduling poll expiries: %w", err)
 	}
 
+	// Resume processing side effects of
+	// any incomplete domain blocks.
+	if err := processor.Admin().DomainBlocksResume(ctx); err != nil {
+		return fmt.Errorf("error resuming domain blocks: %w", err)
+	}
+
+	// Schedule fetching of any
+	// configured block lists.
+	if err := processor.Admin().BlockListsSchedule(ctx); err != nil {
+		return fmt.Errorf("error scheduling block lists: %w", err)
+	}
+
 	// Initialize metrics.
 	if err := metrics.Initialize(state.DB); err != nil {
 		return fmt.Errorf("error initializing metrics: %w", err)
@@ -380,7 +444,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		wellKnownModule   = api.NewWellKnown(processor)                                        // .well-known endpoints
 		nodeInfoModule    = api.NewNodeInfo(processor)                                         // nodeinfo endpoint
 		activityPubModule = api.NewActivityPub(dbService, processor)                           // ActivityPub endpoints
-		webModule         = web.New(dbService, processor)                                      // web pages + user profiles + settings panels etc
+		webModule         = web.New(dbService, processor, cspExtraURIs)                        // web pages + user profiles + settings panels etc
 	)
 
 	// create required middleware
@@ -395,10 +459,10 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	// throttling
 	cpuMultiplier := config.GetAdvancedThrottlingMultiplier()
 	retryAfter := config.GetAdvancedThrottlingRetryAfter()
-	clThrottle := middleware.Throttle(cpuMultiplier, retryAfter)  // client api
-	s2sThrottle := middleware.Throttle(cpuMultiplier, retryAfter) // server-to-server (AP)
-	fsThrottle := middleware.Throttle(cpuMultiplier, retryAfter)  // fileserver / web templates / emojis
-	pkThrottle := middleware.Throttle(cpuMultiplier, retryAfter)  // throttle public key endpoint separately
+	clThrottle := middleware.Throttle("client", cpuMultiplier, retryAfter)     // client api
+	s2sThrottle := middleware.Throttle("s2s", cpuMultiplier, retryAfter)       // server-to-server (AP)
+	fsThrottle := middleware.Throttle("fileserver", cpuMultiplier, retryAfter) // fileserver / web templates / emojis
+	pkThrottle := middleware.Throttle("publickey", cpuMultiplier, retryAfter)  // throttle public key endpoint separately
 
 	gzip := middleware.Gzip() // applied to all except fileserver
 
@@ -20,6 +20,7 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/diagnose"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media/prune"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
@@ -146,6 +147,18 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountRecountCmd := &cobra.Command{
+		Use:   "recount",
+		Short: "recount stored follower, following and status counts of all local accounts, correcting any that have drifted",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Recount)
+		},
+	}
+	adminAccountCmd.AddCommand(adminAccountRecountCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -249,6 +262,7 @@ func adminCommands() *cobra.Command {
 		},
 	}
 	config.AddAdminMediaPrune(adminMediaPruneRemoteCmd)
+	config.AddAdminMediaPruneReport(adminMediaPruneRemoteCmd)
 	adminMediaPruneCmd.AddCommand(adminMediaPruneRemoteCmd)
 
 	adminMediaPruneAllCmd := &cobra.Command{
@@ -262,11 +276,35 @@ func adminCommands() *cobra.Command {
 		},
 	}
 	config.AddAdminMediaPrune(adminMediaPruneAllCmd)
+	config.AddAdminMediaPruneReport(adminMediaPruneAllCmd)
 	adminMediaPruneCmd.AddCommand(adminMediaPruneAllCmd)
 
 	adminMediaCmd.AddCommand(adminMediaPruneCmd)
 
 	adminCmd.AddCommand(adminMediaCmd)
 
+	/*
+		ADMIN DIAGNOSE COMMANDS
+	*/
+
+	adminDiagnoseCmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "admin commands for diagnosing instance configuration",
+	}
+
+	adminDiagnoseCachesCmd := &cobra.Command{
+		Use:   "caches",
+		Short: "validate cache configuration, and print each cache's indices, capacity and estimated entry size",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), diagnose.Caches)
+		},
+	}
+	adminDiagnoseCmd.AddCommand(adminDiagnoseCachesCmd)
+
+	adminCmd.AddCommand(adminDiagnoseCmd)
+
 	return adminCmd
 }
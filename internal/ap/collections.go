@@ -320,6 +320,10 @@ type CollectionParams struct {
 	First *paging.Page
 	Query url.Values
 
+	// Last page details.
+	// Omitted if nil.
+	Last *paging.Page
+
 	// Total no. items.
 	// Omitted if nil.
 	Total *int
@@ -347,6 +351,7 @@ type CollectionPageParams struct {
 type CollectionBuilder interface {
 	SetJSONLDId(vocab.JSONLDIdProperty)
 	SetActivityStreamsFirst(vocab.ActivityStreamsFirstProperty)
+	SetActivityStreamsLast(vocab.ActivityStreamsLastProperty)
 	SetActivityStreamsTotalItems(i vocab.ActivityStreamsTotalItemsProperty)
 }
 
@@ -441,6 +446,24 @@ func buildCollection[C CollectionBuilder](collection C, params CollectionParams)
 	first := streams.NewActivityStreamsFirstProperty()
 	first.SetIRI(firstIRI)
 	collection.SetActivityStreamsFirst(first)
+
+	// No Last page means we're done.
+	if params.Last == nil {
+		return
+	}
+
+	// Build the last page link IRI.
+	lastIRI := params.Last.ToLinkURL(
+		params.ID.Scheme,
+		params.ID.Host,
+		params.ID.Path,
+		pageQueryParams,
+	)
+
+	// Add the collection last IRI property.
+	last := streams.NewActivityStreamsLastProperty()
+	last.SetIRI(lastIRI)
+	collection.SetActivityStreamsLast(last)
 }
 
 func buildCollectionPage[C CollectionPageBuilder, I ItemsPropertyBuilder](collectionPage C, itemsProp I, setItems func(I), params CollectionPageParams) {
@@ -672,8 +672,9 @@ func ExtractAttachments(i WithAttachment) ([]*gtsmodel.MediaAttachment, error) {
 }
 
 // ExtractAttachment extracts a minimal gtsmodel.Attachment
-// (just remote URL, description, and blurhash) from the given
-// Attachmentable interface, or an error if no remote URL is set.
+// (just remote URL, description, blurhash, and sensitivity)
+// from the given Attachmentable interface, or an error if
+// no remote URL is set.
 func ExtractAttachment(i Attachmentable) (*gtsmodel.MediaAttachment, error) {
 	// Get the URL for the attachment file.
 	// If no URL is set, we can't do anything.
@@ -682,11 +683,20 @@ func ExtractAttachment(i Attachmentable) (*gtsmodel.MediaAttachment, error) {
 		return nil, gtserror.Newf("error extracting attachment URL: %w", err)
 	}
 
+	// Some implementations (e.g. Pixelfed)
+	// mark sensitivity per-attachment, as
+	// well as (or instead of) per-status.
+	var sensitive bool
+	if withSensitive, ok := i.(WithSensitive); ok {
+		sensitive = ExtractSensitive(withSensitive)
+	}
+
 	return &gtsmodel.MediaAttachment{
 		RemoteURL:   remoteURL.String(),
 		Description: ExtractDescription(i),
 		Blurhash:    ExtractBlurhash(i),
 		Processing:  gtsmodel.ProcessingStatusReceived,
+		Sensitive:   &sensitive,
 	}, nil
 }
 
@@ -762,9 +772,11 @@ func ExtractHashtags(i WithTag) ([]*gtsmodel.Tag, error) {
 			continue
 		}
 
-		// We store tag names lowercased, might
-		// as well change case here already.
-		tag.Name = strings.ToLower(normalized)
+		// We store tag names folded to their canonical
+		// form, might as well fold here already, keeping
+		// the normalized spelling around for display.
+		tag.Name = text.FoldHashtag(normalized)
+		tag.DisplayName = normalized
 
 		// Only append this tag if we haven't
 		// seen it already, to avoid duplicates
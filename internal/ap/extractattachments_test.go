@@ -77,6 +77,61 @@ func (suite *ExtractAttachmentsTestSuite) TestExtractDescription() {
 	suite.Equal("A very large panel that is entirely twist switches", attachment.Description)
 }
 
+func (suite *ExtractAttachmentsTestSuite) TestExtractSensitive() {
+	for _, test := range []struct {
+		attachmentableJSON string
+		expectSensitive    bool
+	}{
+		{
+			// Pixelfed style attachment, marked
+			// as sensitive on the Document itself.
+			attachmentableJSON: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "mediaType": "image/jpeg",
+  "name": "a bowl of soup",
+  "sensitive": true,
+  "type": "Image",
+  "url": "https://example.org/storage/m/soup.jpg"
+}`,
+			expectSensitive: true,
+		},
+		{
+			// Without the property,
+			// should default to false.
+			attachmentableJSON: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "mediaType": "image/jpeg",
+  "name": "a bowl of soup",
+  "type": "Image",
+  "url": "https://example.org/storage/m/soup.jpg"
+}`,
+			expectSensitive: false,
+		},
+	} {
+		raw := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(test.attachmentableJSON), &raw); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		t, err := streams.ToType(context.Background(), raw)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		attachmentable, ok := t.(ap.Attachmentable)
+		if !ok {
+			suite.FailNow("type was not Attachmentable")
+		}
+
+		attachment, err := ap.ExtractAttachment(attachmentable)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.expectSensitive, *attachment.Sensitive)
+	}
+}
+
 func TestExtractAttachmentsTestSuite(t *testing.T) {
 	suite.Run(t, &ExtractAttachmentsTestSuite{})
 }
@@ -51,7 +51,8 @@ func (suite *ExtractHashtagsTestSuite) TestExtractHashtags1() {
 	suite.Equal(true, *hashtagGoToSocial.Listable)
 
 	hashtagGrüvy := hashtags[2]
-	suite.Equal("grüvy", hashtagGrüvy.Name)
+	suite.Equal("gruvy", hashtagGrüvy.Name)
+	suite.Equal("Grüvy", hashtagGrüvy.DisplayName)
 	suite.Equal(true, *hashtagGrüvy.Useable)
 	suite.Equal(true, *hashtagGrüvy.Listable)
 
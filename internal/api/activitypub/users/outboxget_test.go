@@ -32,6 +32,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/api/activitypub/users"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -82,6 +83,7 @@ func (suite *OutboxGetTestSuite) TestGetOutbox() {
   "@context": "https://www.w3.org/ns/activitystreams",
   "first": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40",
   "id": "http://localhost:8080/users/the_mighty_zork/outbox",
+  "last": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40&min_id=00000000000000000000000000",
   "totalItems": 7,
   "type": "OrderedCollection"
 }`, dst.String())
@@ -140,7 +142,6 @@ func (suite *OutboxGetTestSuite) TestGetOutboxFirstPage() {
 	suite.Equal(`{
   "@context": "https://www.w3.org/ns/activitystreams",
   "id": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40",
-  "next": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40\u0026max_id=01F8MHAMCHF6Y650WCRSCP4WMY",
   "orderedItems": [
     {
       "actor": "http://localhost:8080/users/the_mighty_zork",
@@ -239,6 +240,60 @@ func (suite *OutboxGetTestSuite) TestGetOutboxNextPage() {
 	suite.True(ok)
 }
 
+func (suite *OutboxGetTestSuite) TestGetOutboxHidden() {
+	// the dereference we're gonna use
+	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
+	signedRequest := derefRequests["foss_satan_dereference_zork_outbox_first"]
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// set zork to hide their outbox contents
+	settings, err := suite.db.GetAccountSettings(context.Background(), targetAccount.ID)
+	suite.NoError(err)
+	settings.HideOutbox = util.Ptr(true)
+	err = suite.db.UpdateAccountSettings(context.Background(), settings, "hide_outbox")
+	suite.NoError(err)
+
+	// setup request
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetAccount.OutboxURI+"?limit=40", nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", signedRequest.SignatureHeader)
+	ctx.Request.Header.Set("Date", signedRequest.DateHeader)
+
+	// we need to pass the context through signature check first to set appropriate values on it
+	suite.signatureCheck(ctx)
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	// trigger the function being tested
+	suite.userModule.OutboxGETHandler(ctx)
+
+	// check response: only a count, no pages, even though a page was requested
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/the_mighty_zork/outbox",
+  "totalItems": 7,
+  "type": "OrderedCollection"
+}`, dst.String())
+}
+
 func TestOutboxGetTestSuite(t *testing.T) {
 	suite.Run(t, new(OutboxGetTestSuite))
 }
@@ -89,6 +89,14 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 		return
 	}
 
+	if !app.RevokedAt.IsZero() {
+		m.clearSession(s)
+		const safe = "this application has been revoked by the instance admin"
+		err := fmt.Errorf("application %s is revoked", app.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, safe), m.processor.InstanceGetV1)
+		return
+	}
+
 	user, err := m.db.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		m.clearSession(s)
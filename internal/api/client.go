@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/bookmarks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/customemojis"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/directory"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/favourites"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
 	filtersV1 "github.com/superseriousbusiness/gotosocial/internal/api/client/filters/v1"
@@ -45,6 +46,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/streaming"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/suggestions"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/timelines"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -65,6 +67,7 @@ type Client struct {
 	bookmarks      *bookmarks.Module      // api/v1/bookmarks
 	conversations  *conversations.Module  // api/v1/conversations
 	customEmojis   *customemojis.Module   // api/v1/custom_emojis
+	directory      *directory.Module      // api/v1/directory
 	favourites     *favourites.Module     // api/v1/favourites
 	featuredTags   *featuredtags.Module   // api/v1/featured_tags
 	filtersV1      *filtersV1.Module      // api/v1/filters
@@ -82,6 +85,7 @@ type Client struct {
 	search         *search.Module         // api/v1/search, api/v2/search
 	statuses       *statuses.Module       // api/v1/statuses
 	streaming      *streaming.Module      // api/v1/streaming
+	suggestions    *suggestions.Module    // api/v1/suggestions, api/v2/suggestions
 	timelines      *timelines.Module      // api/v1/timelines
 	user           *user.Module           // api/v1/user
 }
@@ -94,6 +98,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	apiGroup.Use(m...)
 	apiGroup.Use(
 		middleware.TokenCheck(c.db, c.processor.OAuthValidateBearerToken),
+		middleware.ObfuscateIDs(),
 		middleware.CacheControl(middleware.CacheControlConfig{
 			// Never cache client api responses.
 			Directives: []string{"no-store"},
@@ -110,6 +115,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.bookmarks.Route(h)
 	c.conversations.Route(h)
 	c.customEmojis.Route(h)
+	c.directory.Route(h)
 	c.favourites.Route(h)
 	c.featuredTags.Route(h)
 	c.filtersV1.Route(h)
@@ -127,6 +133,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.search.Route(h)
 	c.statuses.Route(h)
 	c.streaming.Route(h)
+	c.suggestions.Route(h)
 	c.timelines.Route(h)
 	c.user.Route(h)
 }
@@ -143,6 +150,7 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 		bookmarks:      bookmarks.New(p),
 		conversations:  conversations.New(p),
 		customEmojis:   customemojis.New(p),
+		directory:      directory.New(p),
 		favourites:     favourites.New(p),
 		featuredTags:   featuredtags.New(p),
 		filtersV1:      filtersV1.New(p),
@@ -160,6 +168,7 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 		search:         search.New(p),
 		statuses:       statuses.New(p),
 		streaming:      streaming.New(p, time.Second*30, 4096),
+		suggestions:    suggestions.New(p),
 		timelines:      timelines.New(p),
 		user:           user.New(p),
 	}
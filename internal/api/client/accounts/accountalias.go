@@ -51,6 +51,9 @@ import (
 //			ActivityPub URI/IDs of target accounts to which this account
 //			is being aliased. Eg., `["https://example.org/users/some_account"]`.
 //
+//			Account namestrings are also accepted, and will be resolved
+//			to the account's URI. Eg., `["@some_account@example.org"]`.
+//
 //			Use an empty array to unset alsoKnownAs, clearing the aliases.
 //		type: string
 //		required: true
@@ -49,6 +49,7 @@ const (
 	NotePath          = BasePathWithID + "/note"
 	RelationshipsPath = BasePath + "/relationships"
 	SearchPath        = BasePath + "/search"
+	StatisticsPath    = BasePath + "/me/statistics"
 	StatusesPath      = BasePathWithID + "/statuses"
 	UnblockPath       = BasePathWithID + "/unblock"
 	UnfollowPath      = BasePathWithID + "/unfollow"
@@ -58,6 +59,7 @@ const (
 	MovePath          = BasePath + "/move"
 	AliasPath         = BasePath + "/alias"
 	ThemesPath        = BasePath + "/themes"
+	WarningsPath      = BasePath + "/warnings"
 
 	// ProfileBasePath for the profile API, an extension of the account update API with a different path.
 	ProfileBasePath = "/v1/profile"
@@ -133,4 +135,10 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 
 	// account themes
 	attachHandler(http.MethodGet, ThemesPath, m.AccountThemesGETHandler)
+
+	// moderation warnings
+	attachHandler(http.MethodGet, WarningsPath, m.AccountWarningsGETHandler)
+
+	// personal statistics
+	attachHandler(http.MethodGet, StatisticsPath, m.AccountStatisticsGETHandler)
 }
@@ -133,6 +133,20 @@ import (
 //		description: Hide the account's following/followers collections.
 //		type: boolean
 //	-
+//		name: hide_interaction_counts
+//		in: formData
+//		description: >-
+//			Hide favourite and boost counts of the account's statuses from other accounts.
+//			Other accounts will see counts of 0, though they can still see who favourited or boosted.
+//		type: boolean
+//	-
+//		name: hide_outbox
+//		in: formData
+//		description: >-
+//			Hide the contents of the account's ActivityPub outbox from remote instances.
+//			The outbox will still show how many statuses the account has posted, and pinned statuses will not be served.
+//		type: boolean
+//	-
 //		name: fields_attributes[0][name]
 //		in: formData
 //		description: Name of 1st profile field to be added to this account's profile.
@@ -325,7 +339,9 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.Theme == nil &&
 			form.CustomCSS == nil &&
 			form.EnableRSS == nil &&
-			form.HideCollections == nil) {
+			form.HideCollections == nil &&
+			form.HideInteractionCounts == nil &&
+			form.HideOutbox == nil) {
 		return nil, errors.New("empty form submitted")
 	}
 
@@ -51,7 +51,9 @@ import (
 //	-
 //		name: comment
 //		type: string
-//		description: The text of the note. Omit this parameter or send an empty string to clear the note.
+//		description: >-
+//			The text of the note, at most 2000 characters after trimming surrounding whitespace.
+//			Omit this parameter or send an empty string to clear the note.
 //		in: formData
 //		default: ""
 //
@@ -72,6 +74,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable content, eg., note too long
 //		'500':
 //			description: internal server error
 func (m *Module) AccountNotePOSTHandler(c *gin.Context) {
@@ -160,7 +160,8 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
         "name": "admin"
       }
     },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+    "media_used_bytes": 69401
   },
   {
     "id": "01AY6P665V14JJR0AFVRT7311Y",
@@ -247,7 +248,8 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
         "name": "user"
       }
     },
-    "created_by_application_id": "01F8MGY43H3N2C8EWPR2FPYEXG"
+    "created_by_application_id": "01F8MGY43H3N2C8EWPR2FPYEXG",
+    "media_used_bytes": 4463269
   },
   {
     "id": "01F8MH0BBE4FHXPH513MBVFHB0",
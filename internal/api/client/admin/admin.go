@@ -28,37 +28,69 @@ import (
 )
 
 const (
-	BasePath                = "/v1/admin"
-	EmojiPath               = BasePath + "/custom_emojis"
-	EmojiPathWithID         = EmojiPath + "/:" + apiutil.IDKey
-	EmojiCategoriesPath     = EmojiPath + "/categories"
-	DomainBlocksPath        = BasePath + "/domain_blocks"
-	DomainBlocksPathWithID  = DomainBlocksPath + "/:" + apiutil.IDKey
-	DomainAllowsPath        = BasePath + "/domain_allows"
-	DomainAllowsPathWithID  = DomainAllowsPath + "/:" + apiutil.IDKey
-	DomainKeysExpirePath    = BasePath + "/domain_keys_expire"
-	HeaderAllowsPath        = BasePath + "/header_allows"
-	HeaderAllowsPathWithID  = HeaderAllowsPath + "/:" + apiutil.IDKey
-	HeaderBlocksPath        = BasePath + "/header_blocks"
-	HeaderBlocksPathWithID  = HeaderBlocksPath + "/:" + apiutil.IDKey
-	AccountsV1Path          = BasePath + "/accounts"
-	AccountsV2Path          = "/v2/admin/accounts"
-	AccountsPathWithID      = AccountsV1Path + "/:" + apiutil.IDKey
-	AccountsActionPath      = AccountsPathWithID + "/action"
-	AccountsApprovePath     = AccountsPathWithID + "/approve"
-	AccountsRejectPath      = AccountsPathWithID + "/reject"
-	MediaCleanupPath        = BasePath + "/media_cleanup"
-	MediaRefetchPath        = BasePath + "/media_refetch"
-	ReportsPath             = BasePath + "/reports"
-	ReportsPathWithID       = ReportsPath + "/:" + apiutil.IDKey
-	ReportsResolvePath      = ReportsPathWithID + "/resolve"
-	EmailPath               = BasePath + "/email"
-	EmailTestPath           = EmailPath + "/test"
-	InstanceRulesPath       = BasePath + "/instance/rules"
-	InstanceRulesPathWithID = InstanceRulesPath + "/:" + apiutil.IDKey
-	DebugPath               = BasePath + "/debug"
-	DebugAPUrlPath          = DebugPath + "/apurl"
-	DebugClearCachesPath    = DebugPath + "/caches/clear"
+	BasePath                      = "/v1/admin"
+	EmojiPath                     = BasePath + "/custom_emojis"
+	EmojiPathWithID               = EmojiPath + "/:" + apiutil.IDKey
+	EmojiCategoriesPath           = EmojiPath + "/categories"
+	EmojiCopyPath                 = EmojiPathWithID + "/copy"
+	EmojiImportFromPath           = EmojiPath + "/import_from"
+	EmojiCategoriesListPath       = BasePath + "/custom_emoji_categories"
+	EmojiCategoryPathWithName     = EmojiCategoriesListPath + "/:" + apiutil.EmojiCategoryNameKey
+	DomainBlocksPath              = BasePath + "/domain_blocks"
+	DomainBlocksPathWithID        = DomainBlocksPath + "/:" + apiutil.IDKey
+	DomainBlockAccountsPath       = DomainBlocksPathWithID + "/accounts"
+	DomainBlocksImportPath        = DomainBlocksPath + "/import"
+	DomainBlockPinPath            = DomainBlocksPathWithID + "/pin"
+	DomainBlockUnpinPath          = DomainBlocksPathWithID + "/unpin"
+	DomainAllowsPath              = BasePath + "/domain_allows"
+	DomainAllowsPathWithID        = DomainAllowsPath + "/:" + apiutil.IDKey
+	DomainKeysExpirePath          = BasePath + "/domain_keys_expire"
+	HeaderAllowsPath              = BasePath + "/header_allows"
+	HeaderAllowsPathWithID        = HeaderAllowsPath + "/:" + apiutil.IDKey
+	HeaderBlocksPath              = BasePath + "/header_blocks"
+	HeaderBlocksPathWithID        = HeaderBlocksPath + "/:" + apiutil.IDKey
+	AccountsV1Path                = BasePath + "/accounts"
+	AccountsV2Path                = "/v2/admin/accounts"
+	AccountsPathWithID            = AccountsV1Path + "/:" + apiutil.IDKey
+	AccountsActionPath            = AccountsPathWithID + "/action"
+	AccountsApprovePath           = AccountsPathWithID + "/approve"
+	AccountsRejectPath            = AccountsPathWithID + "/reject"
+	AccountsWarningsPath          = AccountsPathWithID + "/warnings"
+	AccountsWarningPath           = AccountsWarningsPath + "/:" + apiutil.AdminWarningIDKey
+	AccountsMediaQuotaPath        = AccountsPathWithID + "/media_quota"
+	AccountsCharacterLimitPath    = AccountsPathWithID + "/character_limit"
+	MediaCleanupPath              = BasePath + "/media_cleanup"
+	MediaRefetchPath              = BasePath + "/media_refetch"
+	ReportsPath                   = BasePath + "/reports"
+	ReportsPathWithID             = ReportsPath + "/:" + apiutil.IDKey
+	ReportsResolvePath            = ReportsPathWithID + "/resolve"
+	FeaturedSuggestionsPath       = BasePath + "/suggestions"
+	FeaturedSuggestionsPathWithID = FeaturedSuggestionsPath + "/:" + apiutil.IDKey
+	EmailPath                     = BasePath + "/email"
+	EmailTestPath                 = EmailPath + "/test"
+	InstanceRulesPath             = BasePath + "/instance/rules"
+	InstanceRulesPathWithID       = InstanceRulesPath + "/:" + apiutil.IDKey
+	InstanceCustomCSSPath         = BasePath + "/instance/custom_css"
+	RetentionPath                 = BasePath + "/retention"
+	ActionLogPath                 = BasePath + "/action_log"
+	ActionsPathWithID             = BasePath + "/actions/:" + apiutil.IDKey
+	FederationAuditPath           = BasePath + "/federation_audit"
+	FederationDomainPath          = BasePath + "/federation/domains/:" + apiutil.AdminDomainKey
+	FederationDomainStatusPath    = FederationDomainPath + "/status"
+	FederationDomainRetryPath     = FederationDomainPath + "/retry"
+	ApplicationsPath              = BasePath + "/applications"
+	ApplicationsPathWithID        = ApplicationsPath + "/:" + apiutil.IDKey
+	ApplicationsRevokePath        = ApplicationsPathWithID + "/revoke"
+	ApplicationBlocksPath         = BasePath + "/application_blocks"
+	ApplicationBlockPath          = ApplicationBlocksPath + "/:" + apiutil.IDKey
+	WordFiltersPath               = BasePath + "/word_filters"
+	WordFiltersPathWithID         = WordFiltersPath + "/:" + apiutil.IDKey
+	WorkersPath                   = BasePath + "/workers"
+	DebugPath                     = BasePath + "/debug"
+	DebugAPUrlPath                = DebugPath + "/apurl"
+	DebugClearCachesPath          = DebugPath + "/caches/clear"
+	DebugCacheStatsPath           = DebugPath + "/caches/stats"
+	DebugVisibilityPath           = DebugPath + "/visibility"
 
 	FilterQueryKey        = "filter"
 	MaxShortcodeDomainKey = "max_shortcode_domain"
@@ -85,13 +117,23 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodDelete, EmojiPathWithID, m.EmojiDELETEHandler)
 	attachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
 	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
+	attachHandler(http.MethodPut, EmojiPathWithID, m.EmojiPUTHandler)
+	attachHandler(http.MethodPost, EmojiCopyPath, m.EmojiCopyPOSTHandler)
+	attachHandler(http.MethodPost, EmojiImportFromPath, m.EmojiImportFromPOSTHandler)
 	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	attachHandler(http.MethodGet, EmojiCategoriesListPath, m.EmojiCategoriesListGETHandler)
+	attachHandler(http.MethodPut, EmojiCategoryPathWithName, m.EmojiCategoryPUTHandler)
+	attachHandler(http.MethodDelete, EmojiCategoryPathWithName, m.EmojiCategoryDELETEHandler)
 
 	// domain block stuff
 	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
+	attachHandler(http.MethodPost, DomainBlocksImportPath, m.DomainBlocksImportPOSTHandler)
 	attachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
 	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
 	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
+	attachHandler(http.MethodGet, DomainBlockAccountsPath, m.DomainBlockAccountsGETHandler)
+	attachHandler(http.MethodPost, DomainBlockPinPath, m.DomainBlockPinPOSTHandler)
+	attachHandler(http.MethodPost, DomainBlockUnpinPath, m.DomainBlockUnpinPOSTHandler)
 
 	// domain allow stuff
 	attachHandler(http.MethodPost, DomainAllowsPath, m.DomainAllowsPOSTHandler)
@@ -119,6 +161,11 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
 	attachHandler(http.MethodPost, AccountsApprovePath, m.AccountApprovePOSTHandler)
 	attachHandler(http.MethodPost, AccountsRejectPath, m.AccountRejectPOSTHandler)
+	attachHandler(http.MethodPost, AccountsWarningsPath, m.AccountWarningPOSTHandler)
+	attachHandler(http.MethodGet, AccountsWarningsPath, m.AccountWarningsGETHandler)
+	attachHandler(http.MethodGet, AccountsWarningPath, m.AccountWarningGETHandler)
+	attachHandler(http.MethodPut, AccountsMediaQuotaPath, m.AccountMediaQuotaPUTHandler)
+	attachHandler(http.MethodPut, AccountsCharacterLimitPath, m.AccountCharacterLimitPUTHandler)
 
 	// media stuff
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
@@ -132,6 +179,11 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// email stuff
 	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
 
+	// follow suggestion stuff
+	attachHandler(http.MethodGet, FeaturedSuggestionsPath, m.FeaturedSuggestionsGETHandler)
+	attachHandler(http.MethodPost, FeaturedSuggestionsPathWithID, m.FeaturedSuggestionPOSTHandler)
+	attachHandler(http.MethodDelete, FeaturedSuggestionsPathWithID, m.FeaturedSuggestionDELETEHandler)
+
 	// instance rules stuff
 	attachHandler(http.MethodGet, InstanceRulesPath, m.RulesGETHandler)
 	attachHandler(http.MethodGet, InstanceRulesPathWithID, m.RuleGETHandler)
@@ -139,9 +191,47 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPatch, InstanceRulesPathWithID, m.RulePATCHHandler)
 	attachHandler(http.MethodDelete, InstanceRulesPathWithID, m.RuleDELETEHandler)
 
-	// debug stuff
+	// instance custom css stuff
+	attachHandler(http.MethodPut, InstanceCustomCSSPath, m.InstanceCustomCSSPUTHandler)
+
+	// retention policy stuff
+	attachHandler(http.MethodGet, RetentionPath, m.RetentionPolicyGETHandler)
+	attachHandler(http.MethodPost, RetentionPath, m.RetentionPolicyPOSTHandler)
+
+	// action log stuff
+	attachHandler(http.MethodGet, ActionLogPath, m.ActionLogGETHandler)
+	attachHandler(http.MethodGet, ActionsPathWithID, m.ActionGETHandler)
+
+	// federation audit log stuff
+	attachHandler(http.MethodGet, FederationAuditPath, m.FederationAuditGETHandler)
+
+	// federation domain delivery health stuff
+	attachHandler(http.MethodGet, FederationDomainStatusPath, m.FederationDomainStatusGETHandler)
+	attachHandler(http.MethodPost, FederationDomainRetryPath, m.FederationDomainRetryPOSTHandler)
+
+	// application stuff
+	attachHandler(http.MethodGet, ApplicationsPath, m.ApplicationsGETHandler)
+	attachHandler(http.MethodPost, ApplicationsRevokePath, m.ApplicationRevokePOSTHandler)
+	attachHandler(http.MethodGet, ApplicationBlocksPath, m.ApplicationBlocksGETHandler)
+	attachHandler(http.MethodPost, ApplicationBlocksPath, m.ApplicationBlocksPOSTHandler)
+	attachHandler(http.MethodDelete, ApplicationBlockPath, m.ApplicationBlockDELETEHandler)
+
+	// word filter stuff
+	attachHandler(http.MethodGet, WordFiltersPath, m.WordFiltersGETHandler)
+	attachHandler(http.MethodGet, WordFiltersPathWithID, m.WordFilterGETHandler)
+	attachHandler(http.MethodPost, WordFiltersPath, m.WordFiltersPOSTHandler)
+	attachHandler(http.MethodDelete, WordFiltersPathWithID, m.WordFilterDELETEHandler)
+
+	// worker pool stuff
+	attachHandler(http.MethodGet, WorkersPath, m.WorkersGETHandler)
+	attachHandler(http.MethodPatch, WorkersPath, m.WorkersPATCHHandler)
+
+	// debug stuff; visibility debugging is
+	// read-only, so it's always available
+	attachHandler(http.MethodGet, DebugVisibilityPath, m.DebugVisibilityGETHandler)
 	if debug.DEBUG {
 		attachHandler(http.MethodGet, DebugAPUrlPath, m.DebugAPUrlHandler)
 		attachHandler(http.MethodPost, DebugClearCachesPath, m.DebugClearCachesHandler)
+		attachHandler(http.MethodGet, DebugCacheStatsPath, m.DebugCacheStatsHandler)
 	}
 }
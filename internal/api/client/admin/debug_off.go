@@ -105,3 +105,44 @@ func (m *Module) DebugAPUrlHandler(c *gin.Context) {}
 //		'500':
 //			description: internal server error
 func (m *Module) DebugClearCachesHandler(c *gin.Context) {}
+
+// DebugCacheStatsHandler swagger:operation GET /api/v1/admin/debug/caches/stats debugCacheStats
+//
+// Get statistics about the indices of all in-memory struct caches.
+//
+// The response is an object keyed by cache name, each value being an
+// object keyed by index name, eg., `{"Status": {"AccountID": {...}}}`.
+//
+// Only enabled / exposed if GoToSocial was built and is running with flag DEBUG=1.
+//
+//	---
+//	tags:
+//	- debug
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: Index statistics, keyed by cache name then index name.
+//			schema:
+//				type: object
+//				additionalProperties:
+//					type: object
+//					additionalProperties:
+//						"$ref": "#/definitions/debugIndexStat"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DebugCacheStatsHandler(c *gin.Context) {}
@@ -25,6 +25,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -80,3 +81,40 @@ func (m *Module) DebugClearCachesHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"status": "OK"})
 }
+
+func (m *Module) DebugCacheStatsHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Gather index stats of all struct caches,
+	// keyed by cache name then index name.
+	stats := m.state.Caches.IndexStats()
+	resp := make(map[string]map[string]apimodel.DebugIndexStat, len(stats))
+	for name, indices := range stats {
+		resp[name] = make(map[string]apimodel.DebugIndexStat, len(indices))
+		for index, stat := range indices {
+			resp[name][index] = apimodel.DebugIndexStat{
+				Keys:      stat.Keys,
+				Entries:   stat.Entries,
+				MaxBucket: stat.MaxBucket,
+				FanOut:    stat.FanOut(),
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -90,6 +90,20 @@ import (
 //			is a useful way of internally keeping track of why a certain domain ended up blocked.
 //			Used only if `import` is not `true`.
 //		type: string
+//	-
+//		name: severity
+//		in: formData
+//		description: >-
+//			Severity of the domain block. `suspend` cuts off federation with the domain entirely.
+//			`limit` keeps federating, but keeps statuses from the domain off public timelines for
+//			anyone not following their author. `silence` is accepted as an alias of `limit`.
+//			Used only if `import` is not `true`.
+//		type: string
+//		enum:
+//			- suspend
+//			- limit
+//			- silence
+//		default: suspend
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+)
+
+type DomainBlocksExportTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// exportCSV gets domain blocks with the given format.
+func (suite *AdminStandardTestSuite) exportCSV(format string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainBlocksPath+"?format="+format, "")
+	ctx.Request.Header.Set("accept", "text/csv")
+
+	suite.adminModule.DomainBlocksGETHandler(ctx)
+
+	return recorder
+}
+
+func (suite *DomainBlocksExportTestSuite) TestExport() {
+	recorder := suite.exportCSV("csv")
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+	suite.Equal(`attachment; filename="domain_blocks.csv"`, recorder.Header().Get("Content-Disposition"))
+	suite.Equal(
+		"#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate\n"+
+			"replyguys.com,suspend,true,true,reply-guying to tech posts,false\n",
+		recorder.Body.String(),
+	)
+}
+
+func (suite *DomainBlocksExportTestSuite) TestExportInvalidFormat() {
+	recorder := suite.exportCSV("xlsx")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Equal(`{"error":"Bad Request: invalid format \"xlsx\", valid formats are [json, csv]"}`, recorder.Body.String())
+}
+
+func TestDomainBlocksExportTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainBlocksExportTestSuite))
+}
@@ -18,20 +18,34 @@
 package admin
 
 import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
 // DomainBlocksGETHandler swagger:operation GET /api/v1/admin/domain_blocks domainBlocksGet
 //
 // View all domain blocks currently in place.
 //
+// If format is `csv`, the blocks will instead be returned as a CSV file, with the same columns as
+// a Mastodon domain blocks export (`#domain`, `#severity`, `#reject_media`, `#reject_reports`,
+// `#public_comment`, `#obfuscate`). This file can be imported again via /api/v1/admin/domain_blocks/import,
+// or into a Mastodon instance. Private comments are not included.
+//
 //	---
 //	tags:
 //	- admin
 //
 //	produces:
 //	- application/json
+//	- text/csv
 //
 //	parameters:
 //	-
@@ -44,6 +58,16 @@ import (
 //			but you don't want them to see the database IDs of your blocks, or private comments etc.
 //		in: query
 //		required: false
+//	-
+//		name: format
+//		type: string
+//		enum:
+//			- json
+//			- csv
+//		default: json
+//		description: Format in which to return the domain blocks.
+//		in: query
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -51,7 +75,7 @@ import (
 //
 //	responses:
 //		'200':
-//			description: All domain blocks currently in place.
+//			description: All domain blocks currently in place. When format is `csv`, a CSV file instead.
 //			schema:
 //				type: array
 //				items:
@@ -69,5 +93,71 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) DomainBlocksGETHandler(c *gin.Context) {
+	format, errWithCode := apiutil.ParseDomainPermissionFormat(c.Query(apiutil.DomainPermissionFormatKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if format == "csv" {
+		m.exportDomainBlocksCSV(c)
+		return
+	}
+
 	m.getDomainPermissions(c, gtsmodel.DomainPermissionBlock)
 }
+
+// exportDomainBlocksCSV streams all domain blocks as a CSV file.
+func (m *Module) exportDomainBlocksCSV(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextCSV); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	var (
+		ctx     = c.Request.Context()
+		started bool
+		w       = csv.NewWriter(c.Writer)
+	)
+
+	errWithCode := m.processor.Admin().DomainBlocksExportCSV(
+		ctx,
+		func(record []string) error {
+			if !started {
+				// Start the stream on first record.
+				c.Header("Content-Type", apiutil.TextCSV+"; charset=utf-8")
+				c.Header("Content-Disposition", `attachment; filename="domain_blocks.csv"`)
+				c.Status(http.StatusOK)
+				started = true
+			}
+
+			if err := w.Write(record); err != nil {
+				return err
+			}
+
+			w.Flush()
+			return w.Error()
+		},
+	)
+	if errWithCode != nil {
+		if started {
+			// Too late to send an error
+			// response, so just log it.
+			log.Errorf(ctx, "error exporting domain blocks: %v", errWithCode)
+			return
+		}
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+	}
+}
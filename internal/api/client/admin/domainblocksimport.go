@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DomainBlocksImportPOSTHandler swagger:operation POST /api/v1/admin/domain_blocks/import domainBlocksImport
+//
+// Import domain blocks from a CSV file.
+//
+// The first row of the file must be a header naming the columns, of which only `domain` is required:
+// `domain,severity,reject_media,reject_reports,private_comment,public_comment,obfuscate`.
+// Column names may start with `#`, as in files exported from Mastodon.
+//
+// Each row creates a new domain block, or updates the comments of an existing block for the same domain.
+// Only severity `suspend` (the default) is supported; rows with other severities are rejected.
+// Domain blocks always reject media and reports, so `reject_media` and `reject_reports` are ignored.
+//
+// The result of importing each row is streamed back as it's processed, as a line of newline-delimited JSON,
+// so that clients can show progress. A row that fails to import doesn't stop the rest of the import.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//
+//	produces:
+//	- application/x-ndjson
+//
+//	parameters:
+//	-
+//		name: domains
+//		in: formData
+//		description: CSV file of domain blocks to import.
+//		type: file
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: >-
+//				Stream of results, one line of JSON per row of the file.
+//			schema:
+//				"$ref": "#/definitions/domainBlockImportProgress"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DomainBlocksImportPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.AppNDJSON, apiutil.AppJSON); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := new(apimodel.DomainBlocksImportRequest)
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.Domains == nil || form.Domains.Size == 0 {
+		err := errors.New("no domains file provided")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Carry on importing even if the client
+	// goes away, rather than stopping part way.
+	ctx := context.WithoutCancel(c.Request.Context())
+
+	var (
+		started bool
+		enc     = json.NewEncoder(c.Writer)
+	)
+
+	errWithCode := m.processor.Admin().DomainBlocksImportCSV(
+		ctx,
+		authed.Account,
+		form.Domains,
+		func(progress *apimodel.DomainBlockImportProgress) {
+			if !started {
+				// Start the stream on first result.
+				c.Header("Content-Type", apiutil.AppNDJSON)
+				c.Status(http.StatusOK)
+				started = true
+			}
+
+			if err := enc.Encode(progress); err != nil {
+				log.Debugf(ctx, "error writing import progress: %v", err)
+				return
+			}
+
+			c.Writer.Flush()
+		},
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+}
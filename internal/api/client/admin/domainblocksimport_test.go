@@ -0,0 +1,161 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type DomainBlocksImportTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainBlocksImportTestSuite) importCSV(csv string) (*httptest.ResponseRecorder, []*apimodel.DomainBlockImportProgress) {
+	// Build the multipart form with the csv as file.
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	fw, err := w.CreateFormFile("domains", "domain_blocks.csv")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := fw.Write([]byte(csv)); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := w.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, body.Bytes(), admin.DomainBlocksImportPath, w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/x-ndjson")
+
+	suite.adminModule.DomainBlocksImportPOSTHandler(ctx)
+
+	// Read back one progress
+	// result from each line.
+	progress := []*apimodel.DomainBlockImportProgress{}
+	scanner := bufio.NewScanner(bytes.NewReader(recorder.Body.Bytes()))
+	for scanner.Scan() {
+		p := new(apimodel.DomainBlockImportProgress)
+		if err := json.Unmarshal(scanner.Bytes(), p); err != nil {
+			// Not a stream, eg. an error response.
+			break
+		}
+		progress = append(progress, p)
+	}
+
+	return recorder, progress
+}
+
+func (suite *DomainBlocksImportTestSuite) TestImport() {
+	recorder, progress := suite.importCSV(
+		"#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate\n" +
+			"new-domain.example.org,suspend,true,true,they're bad,false\n" +
+			"quiet.example.org,silence,false,false,,false\n" +
+			"replyguys.com,,,,updated comment,true\n",
+	)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("application/x-ndjson", recorder.Header().Get("Content-Type"))
+	if !suite.Len(progress, 3) {
+		suite.FailNow("")
+	}
+
+	// New domain should be blocked.
+	suite.Equal(2, progress[0].Line)
+	suite.Equal("new-domain.example.org", progress[0].Domain)
+	suite.Equal(http.StatusOK, progress[0].Status)
+	suite.Equal(1, progress[0].Processed)
+	suite.Equal(3, progress[0].Total)
+	if suite.NotNil(progress[0].DomainBlock) {
+		suite.Equal("they're bad", progress[0].DomainBlock.PublicComment)
+	}
+
+	// Silence should create a limit.
+	suite.Equal(3, progress[1].Line)
+	suite.Equal("quiet.example.org", progress[1].Domain)
+	suite.Equal(http.StatusOK, progress[1].Status)
+	if suite.NotNil(progress[1].DomainBlock) {
+		suite.Equal("limit", progress[1].DomainBlock.Severity)
+	}
+	suite.Equal(2, progress[1].Processed)
+
+	// Existing block should be updated.
+	suite.Equal(4, progress[2].Line)
+	suite.Equal("replyguys.com", progress[2].Domain)
+	suite.Equal(http.StatusOK, progress[2].Status)
+	suite.Equal(3, progress[2].Processed)
+
+	block, err := suite.db.GetDomainBlock(context.Background(), "replyguys.com")
+	suite.NoError(err)
+	suite.Equal("updated comment", block.PublicComment)
+	suite.True(*block.Obfuscate)
+
+	block, err = suite.db.GetDomainBlock(context.Background(), "new-domain.example.org")
+	suite.NoError(err)
+	suite.Equal("they're bad", block.PublicComment)
+
+	// Silenced domain should be limited, not blocked.
+	blocked, err := suite.db.IsDomainBlocked(context.Background(), "quiet.example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	limited, err := suite.db.IsDomainLimited(context.Background(), "quiet.example.org")
+	suite.NoError(err)
+	suite.True(limited)
+}
+
+func (suite *DomainBlocksImportTestSuite) TestImportNoDomainColumn() {
+	recorder, _ := suite.importCSV(
+		"#severity,#public_comment\n" +
+			"suspend,they're bad\n",
+	)
+
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Contains(recorder.Body.String(), "no domain column")
+}
+
+func (suite *DomainBlocksImportTestSuite) TestImportExported() {
+	// Exported blocks should import again unchanged.
+	exported := suite.exportCSV("csv").Body.String()
+
+	recorder, progress := suite.importCSV(exported)
+	suite.Equal(http.StatusOK, recorder.Code)
+	if !suite.Len(progress, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal("replyguys.com", progress[0].Domain)
+	suite.Equal(http.StatusOK, progress[0].Status)
+
+	suite.Equal(exported, suite.exportCSV("csv").Body.String())
+}
+
+func TestDomainBlocksImportTestSuite(t *testing.T) {
+	suite.Run(t, &DomainBlocksImportTestSuite{})
+}
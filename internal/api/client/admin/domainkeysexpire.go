@@ -149,7 +149,7 @@ func validateDomainKeysExpire(form *apimodel.DomainKeysExpireRequest) error {
 		return errors.New("no domain given")
 	}
 
-	if form.Domain == config.GetHost() || form.Domain == config.GetAccountDomain() {
+	if config.IsLocalDomain(form.Domain) {
 		return errors.New("provided domain was this domain, but must be a remote domain")
 	}
 
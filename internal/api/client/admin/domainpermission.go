@@ -41,6 +41,7 @@ type singleDomainPermCreate func(
 	string, // publicComment
 	string, // privateComment
 	string, // subscriptionID
+	gtsmodel.DomainBlockSeverity, // severity (blocks only)
 ) (*apimodel.DomainPermission, string, gtserror.WithCode)
 
 type multiDomainPermCreate func(
@@ -109,6 +110,13 @@ func (m *Module) createDomainPermissions(
 		return
 	}
 
+	severity, ok := gtsmodel.ParseDomainBlockSeverity(form.Severity)
+	if !ok {
+		err := fmt.Errorf("invalid severity %q, must be suspend or limit", form.Severity)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
 	if !importing {
 		// Single domain permission creation.
 		domainBlock, _, errWithCode := single(
@@ -120,6 +128,7 @@ func (m *Module) createDomainPermissions(
 			form.PublicComment,
 			form.PrivateComment,
 			"", // No sub ID for single perm creation.
+			severity,
 		)
 
 		if errWithCode != nil {
@@ -83,3 +83,42 @@ func (m *Module) EmojiCategoriesGETHandler(c *gin.Context) {
 
 	apiutil.JSON(c, http.StatusOK, categories)
 }
+
+// EmojiCategoriesListGETHandler swagger:operation GET /api/v1/admin/custom_emoji_categories emojiCategoriesList
+//
+// Get a list of existing emoji categories.
+//
+// This is equivalent to GET /api/v1/admin/custom_emojis/categories,
+// and is provided alongside the other emoji category endpoints.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: Array of existing emoji categories, sorted by name.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/emojiCategory"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) EmojiCategoriesListGETHandler(c *gin.Context) {
+	m.EmojiCategoriesGETHandler(c)
+}
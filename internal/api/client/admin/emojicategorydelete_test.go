@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type EmojiCategoryDeleteTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryDeleteTestSuite) deleteCategory(name string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, admin.EmojiCategoriesListPath+"/"+url.PathEscape(name), "application/json")
+	ctx.AddParam(apiutil.EmojiCategoryNameKey, name)
+
+	suite.adminModule.EmojiCategoryDELETEHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiCategoryDeleteTestSuite) TestEmojiCategoryDelete() {
+	recorder := suite.deleteCategory("reactions")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "id": "01GGQ8V4993XK67B2JB396YFB7",
+  "name": "reactions"
+}`, dst.String())
+
+	// Category should be gone.
+	_, err = suite.db.GetEmojiCategoryByName(context.Background(), "reactions")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Emoji that was in the category
+	// should still exist, uncategorized.
+	emoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "rainbow", "")
+	suite.NoError(err)
+	suite.Empty(emoji.CategoryID)
+	suite.Nil(emoji.Category)
+}
+
+func (suite *EmojiCategoryDeleteTestSuite) TestEmojiCategoryDeleteNotFound() {
+	recorder := suite.deleteCategory("does not exist")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestEmojiCategoryDeleteTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryDeleteTestSuite{})
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiCategoryUpdateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) updateCategory(name string, newName string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", map[string][]string{
+		"name": {newName},
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	bodyBytes := requestBody.Bytes()
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, bodyBytes, admin.EmojiCategoriesListPath+"/"+url.PathEscape(name), w.FormDataContentType())
+	ctx.AddParam(apiutil.EmojiCategoryNameKey, name)
+
+	suite.adminModule.EmojiCategoryPUTHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) TestEmojiCategoryUpdate() {
+	recorder := suite.updateCategory("reactions", "emotions")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "id": "01GGQ8V4993XK67B2JB396YFB7",
+  "name": "emotions"
+}`, dst.String())
+
+	// Emoji in the category should now
+	// be shown with the new category name.
+	emoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "rainbow", "")
+	suite.NoError(err)
+	suite.NotNil(emoji.Category)
+	suite.Equal("emotions", emoji.Category.Name)
+
+	// Old name should be gone.
+	_, err = suite.db.GetEmojiCategoryByName(context.Background(), "reactions")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) TestEmojiCategoryUpdateAlreadyExists() {
+	recorder := suite.updateCategory("reactions", "cute stuff")
+	suite.Equal(http.StatusConflict, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Conflict: emoji category cute stuff already exists"}`, string(b))
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) TestEmojiCategoryUpdateNotFound() {
+	recorder := suite.updateCategory("does not exist", "emotions")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) TestEmojiCategoryUpdateEmptyName() {
+	recorder := suite.updateCategory("reactions", "  ")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no new category name provided"}`, string(b))
+}
+
+func TestEmojiCategoryUpdateTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryUpdateTestSuite{})
+}
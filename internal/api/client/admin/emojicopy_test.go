@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiCopyTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCopyTestSuite) copy(emojiID string, extraFields map[string][]string) (int, []byte) {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", extraFields)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), admin.EmojiCopyPath, w.FormDataContentType())
+	ctx.AddParam(apiutil.IDKey, emojiID)
+
+	suite.adminModule.EmojiCopyPOSTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyLocal() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	code, b := suite.copy(testEmoji.ID, map[string][]string{
+		"shortcode": {"rainbow_dark"},
+		"category":  {"variants"},
+	})
+	suite.Equal(http.StatusOK, code, string(b))
+
+	adminEmoji := &apimodel.AdminEmoji{}
+	if err := json.Unmarshal(b, adminEmoji); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("rainbow_dark", adminEmoji.Shortcode)
+	suite.Equal("variants", adminEmoji.Category)
+
+	// Both original and copy should now exist.
+	original, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "rainbow", "")
+	suite.NoError(err)
+	dbEmoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "rainbow_dark", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEqual(original.ID, dbEmoji.ID)
+	suite.NotEqual(original.ImagePath, dbEmoji.ImagePath)
+	suite.Equal(original.ImageFileSize, dbEmoji.ImageFileSize)
+
+	emojiBytes, err := suite.storage.Get(context.Background(), dbEmoji.ImagePath)
+	suite.NoError(err)
+	suite.Len(emojiBytes, dbEmoji.ImageFileSize)
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyShortcodeAlreadyInUse() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	code, b := suite.copy(testEmoji.ID, map[string][]string{
+		"shortcode": {"rainbow"},
+	})
+	suite.Equal(http.StatusConflict, code)
+	suite.Equal(`{"error":"Conflict: emoji with shortcode rainbow already exists on this instance"}`, string(b))
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyNotFound() {
+	code, _ := suite.copy("01GF8VRXX1R00X7XH8973Z29R1", map[string][]string{
+		"shortcode": {"nothing_here"},
+	})
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func TestEmojiCopyTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCopyTestSuite{})
+}
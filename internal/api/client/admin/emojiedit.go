@@ -0,0 +1,220 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register gif decoder
+	_ "image/png" // register png decoder
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	_ "golang.org/x/image/webp" // register webp decoder
+)
+
+// EmojiMaxDimension is the maximum width
+// and height in pixels of an emoji image
+// uploaded via the emoji edit endpoint.
+const EmojiMaxDimension = 128
+
+// EmojiPUTHandler swagger:operation PUT /api/v1/admin/custom_emojis/{id} emojiEdit
+//
+// Edit a LOCAL emoji on this instance.
+//
+// You can provide a new shortcode, a new image, and/or a new category for the emoji.
+// At least one of these must be provided. To edit a remote emoji, copy it to this
+// instance first.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the emoji.
+//		in: path
+//		required: true
+//	-
+//		name: shortcode
+//		in: formData
+//		description: >-
+//			New code to use for the emoji, which will be used by instance denizens to select it.
+//			This must be unique on the instance.
+//		type: string
+//		pattern: \w{2,30}
+//	-
+//		name: image
+//		in: formData
+//		description: >-
+//			A new png, gif or webp image to use for the emoji. Animated pngs work too!
+//			To ensure compatibility with other fedi implementations, emoji size limit is 50kb by default,
+//			and the image may be at most 128x128 pixels.
+//		type: file
+//	-
+//		name: category
+//		in: formData
+//		description: >-
+//			Category in which to place the emoji.
+//			If a category with the given name doesn't exist yet, it will be created.
+//			If an empty string is provided, the emoji will be uncategorized.
+//		type: string
+//		maximumLength: 64
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: The edited emoji.
+//			schema:
+//				"$ref": "#/definitions/adminEmoji"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: conflict -- shortcode for this emoji is already in use
+//		'500':
+//			description: internal server error
+func (m *Module) EmojiPUTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	emojiID, errWithCode := apiutil.ParseID(c.Param(apiutil.IDKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.EmojiEditRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := validateEditEmoji(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	emoji, errWithCode := m.processor.Admin().EmojiEdit(c.Request.Context(), emojiID, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, emoji)
+}
+
+func validateEditEmoji(form *apimodel.EmojiEditRequest) error {
+	hasImage := form.Image != nil && form.Image.Size != 0
+	if form.Shortcode == nil && !hasImage && form.CategoryName == nil {
+		return errors.New("no shortcode, image or category name was provided")
+	}
+
+	if form.Shortcode != nil {
+		if err := validate.EmojiShortcode(*form.Shortcode); err != nil {
+			return err
+		}
+	}
+
+	if hasImage {
+		maxSize := config.GetMediaEmojiLocalMaxSize()
+		if form.Image.Size > int64(maxSize) {
+			return fmt.Errorf("emoji image too large: image is %dKB but size limit for custom emojis is %dKB", form.Image.Size/1024, maxSize/1024)
+		}
+
+		if err := validateEmojiDimensions(form.Image); err != nil {
+			return err
+		}
+	}
+
+	if form.CategoryName != nil {
+		if err := validate.EmojiCategory(*form.CategoryName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateEmojiDimensions decodes just the header of the
+// given emoji image, and checks that its dimensions do
+// not exceed EmojiMaxDimension in either direction.
+func validateEmojiDimensions(fh *multipart.FileHeader) error {
+	f, err := fh.Open()
+	if err != nil {
+		return fmt.Errorf("error opening emoji image: %w", err)
+	}
+	defer f.Close()
+
+	cfg, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return fmt.Errorf("error decoding emoji image: %w", err)
+	}
+
+	if cfg.Width > EmojiMaxDimension || cfg.Height > EmojiMaxDimension {
+		return fmt.Errorf("emoji image too large: image is %dx%dpx but dimension limit for custom emojis is %dx%dpx",
+			cfg.Width, cfg.Height, EmojiMaxDimension, EmojiMaxDimension)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiEditTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiEditTestSuite) edit(emojiID string, fieldName string, fileName string, extraFields map[string][]string) (int, []byte) {
+	requestBody, w, err := testrig.CreateMultipartFormData(fieldName, fileName, extraFields)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, requestBody.Bytes(), admin.EmojiPathWithID, w.FormDataContentType())
+	ctx.AddParam(apiutil.IDKey, emojiID)
+
+	suite.adminModule.EmojiPUTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+func (suite *EmojiEditTestSuite) TestEmojiEditShortcode() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	code, b := suite.edit(testEmoji.ID, "", "", map[string][]string{
+		"shortcode": {"rainbow_new"},
+	})
+	suite.Equal(http.StatusOK, code)
+
+	adminEmoji := &apimodel.AdminEmoji{}
+	if err := json.Unmarshal(b, adminEmoji); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("rainbow_new", adminEmoji.Shortcode)
+	suite.Equal(testEmoji.ImageURL, adminEmoji.URL)
+
+	// Emoji should be findable by new shortcode only.
+	dbEmoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "rainbow_new", "")
+	suite.NoError(err)
+	suite.Equal(testEmoji.ID, dbEmoji.ID)
+
+	_, err = suite.db.GetEmojiByShortcodeDomain(context.Background(), "rainbow", "")
+	suite.Error(err)
+}
+
+func (suite *EmojiEditTestSuite) TestEmojiEditImage() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	code, b := suite.edit(testEmoji.ID, "image", "../../../../testrig/media/kip-original.gif", map[string][]string{
+		"category": {"cute stuff"},
+	})
+	suite.Equal(http.StatusOK, code, string(b))
+
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("rainbow", dbEmoji.Shortcode)
+	suite.Equal("image/gif", dbEmoji.ImageContentType)
+	suite.NotEqual(testEmoji.ImagePath, dbEmoji.ImagePath)
+	suite.NotEmpty(dbEmoji.CategoryID)
+
+	// New image should be in storage.
+	emojiBytes, err := suite.storage.Get(context.Background(), dbEmoji.ImagePath)
+	suite.NoError(err)
+	suite.Len(emojiBytes, dbEmoji.ImageFileSize)
+}
+
+func (suite *EmojiEditTestSuite) TestEmojiEditImageTooLarge() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	// Write out a png just over the max dimensions.
+	path := filepath.Join(suite.T().TempDir(), "big.png")
+	f, err := os.Create(path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, admin.EmojiMaxDimension+1, admin.EmojiMaxDimension))
+	if err := png.Encode(f, img); err != nil {
+		suite.FailNow(err.Error())
+	}
+	f.Close()
+
+	code, b := suite.edit(testEmoji.ID, "image", path, nil)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: emoji image too large: image is 129x128px but dimension limit for custom emojis is 128x128px"}`, string(b))
+}
+
+func (suite *EmojiEditTestSuite) TestEmojiEditRemoteEmoji() {
+	testEmoji := suite.testEmojis["yell"]
+
+	code, b := suite.edit(testEmoji.ID, "", "", map[string][]string{
+		"shortcode": {"yell_local"},
+	})
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: emoji `+testEmoji.ID+` is not a local emoji, cannot edit it via this endpoint"}`, string(b))
+}
+
+func (suite *EmojiEditTestSuite) TestEmojiEditNoParams() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	code, b := suite.edit(testEmoji.ID, "", "", nil)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: no shortcode, image or category name was provided"}`, string(b))
+}
+
+func TestEmojiEditTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiEditTestSuite{})
+}
@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// EmojiImportFromPOSTHandler swagger:operation POST /api/v1/admin/custom_emojis/import_from emojiImportFrom
+//
+// Import custom emojis from the given remote instance as new LOCAL emojis.
+//
+// The remote instance's emoji list is fetched from its /api/v1/custom_emojis endpoint.
+//
+// If neither `all` nor any `shortcodes[]` are given, no emojis are imported; instead,
+// the list of emojis available on the remote instance is returned, so that the caller
+// can select which emojis to import with a subsequent call.
+//
+// Otherwise, an admin action is started in the background, which downloads and stores each
+// selected emoji as a local emoji with the same shortcode, in the same category as on the remote
+// instance. The ID of the action is returned, and its progress can be checked by viewing it at
+// /api/v1/admin/actions/{id}. Once completed, the errors of the action list any selected emojis
+// that could not be imported.
+//
+// Only one import (as opposed to listing) may be started per minute, to avoid hammering
+// remote instances.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: domain
+//		type: string
+//		description: Domain of the remote instance to import emojis from.
+//		in: query
+//		required: true
+//	-
+//		name: all
+//		type: boolean
+//		description: Import all emojis available on the remote instance.
+//		in: query
+//		default: false
+//	-
+//		name: shortcodes[]
+//		type: array
+//		items:
+//			type: string
+//		description: Shortcodes of the remote emojis to import.
+//		in: query
+//		collectionFormat: multi
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: The emojis available for import on the remote instance, when none were selected.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/emoji"
+//		'202':
+//			description: The import was accepted, and will be processed in the background.
+//			schema:
+//				"$ref": "#/definitions/adminActionResponse"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: >-
+//				Conflict: There is already an admin action running that conflicts with this action.
+//				Check the error message in the response body for more information. This is a temporary
+//				error; it should be possible to process this action if you try again in a bit.
+//		'422':
+//			description: unprocessable -- the remote emoji list could not be fetched for listing
+//		'429':
+//			description: too many requests -- an import was already started in the last minute
+//		'500':
+//			description: internal server error
+func (m *Module) EmojiImportFromPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	domain, errWithCode := apiutil.ParseEmojiImportDomain(c.Query(apiutil.EmojiImportDomainKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	all, errWithCode := apiutil.ParseEmojiImportAll(c.Query(apiutil.EmojiImportAllKey), false)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	shortcodes := c.QueryArray(apiutil.EmojiImportShortcodesKey)
+
+	if !all && len(shortcodes) == 0 {
+		// Nothing selected, just
+		// list what's available.
+		emojis, errWithCode := m.processor.Admin().EmojisImportList(c.Request.Context(), domain)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		apiutil.JSON(c, http.StatusOK, emojis)
+		return
+	}
+
+	actionID, errWithCode := m.processor.Admin().EmojisImport(
+		c.Request.Context(),
+		authed.Account,
+		domain,
+		shortcodes,
+		all,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusAccepted, &apimodel.AdminActionResponse{
+		ActionID: actionID,
+	})
+}
@@ -177,7 +177,7 @@ func (m *Module) EmojisGETHandler(c *gin.Context) {
 	if domain == "" {
 		// default is to show all domains
 		domain = db.EmojiAllDomains
-	} else if domain == "local" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+	} else if domain == "local" || config.IsLocalDomain(domain) {
 		// pass empty string for local domain
 		domain = ""
 	}
@@ -288,7 +288,8 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
           "name": "admin"
         }
       },
-      "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+      "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+      "media_used_bytes": 69401
     },
     "action_taken_by_account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -334,7 +335,8 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
           "name": "admin"
         }
       },
-      "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+      "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+      "media_used_bytes": 69401
     },
     "statuses": [],
     "rules": [],
@@ -470,6 +472,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "bookmarked": false,
         "pinned": false,
         "content": "dark souls status bot: \"thoughts of dog\"",
+        "word_count": 0,
         "reblog": null,
         "account": {
           "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -692,6 +695,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "bookmarked": false,
         "pinned": false,
         "content": "dark souls status bot: \"thoughts of dog\"",
+        "word_count": 0,
         "reblog": null,
         "account": {
           "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -914,6 +918,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "bookmarked": false,
         "pinned": false,
         "content": "dark souls status bot: \"thoughts of dog\"",
+        "word_count": 0,
         "reblog": null,
         "account": {
           "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -30,6 +30,8 @@ import (
 //
 // Get an array of custom emojis available on the instance.
 //
+// Emojis are sorted by category name, then alphabetically by shortcode within each category.
+//
 //	---
 //	tags:
 //	- custom_emojis
@@ -37,6 +39,20 @@ import (
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: category
+//		type: string
+//		description: >-
+//			Only return emojis in the category with this name (case-insensitive).
+//		in: query
+//	-
+//		name: search
+//		type: string
+//		description: >-
+//			Only return emojis with a shortcode containing this keyword (case-insensitive).
+//		in: query
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- read:custom_emojis
@@ -65,7 +81,11 @@ func (m *Module) CustomEmojisGETHandler(c *gin.Context) {
 		return
 	}
 
-	emojis, errWithCode := m.processor.Media().GetCustomEmojis(c)
+	emojis, errWithCode := m.processor.Media().GetCustomEmojis(
+		c.Request.Context(),
+		c.Query(apiutil.EmojiCategoryKey),
+		c.Query(apiutil.EmojiSearchKey),
+	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
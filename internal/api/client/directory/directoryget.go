@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package directory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DirectoryGETHandler swagger:operation GET /api/v1/directory directoryGet
+//
+// List accounts visible in the profile directory.
+//
+// Only accounts that have opted in to being discoverable are shown;
+// suspended and silenced accounts, and accounts on limited domains,
+// are never shown.
+//
+// Results are paged using offset + limit, rather than a Link header.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: offset
+//		type: integer
+//		description: Skip the first n results.
+//		default: 0
+//		minimum: 0
+//		in: query
+//		required: false
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of accounts to return.
+//		default: 40
+//		minimum: 1
+//		maximum: 80
+//		in: query
+//		required: false
+//	-
+//		name: order
+//		type: string
+//		description: >-
+//			Use `active` to sort by most recently posted statuses (default),
+//			or `new` to sort by most recently created accounts.
+//		default: active
+//		enum:
+//			- active
+//			- new
+//		in: query
+//		required: false
+//	-
+//		name: local
+//		type: boolean
+//		description: Show only local accounts.
+//		default: false
+//		in: query
+//		required: false
+//
+//	responses:
+//		'200':
+//			name: accounts
+//			description: Array of accounts.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/account"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DirectoryGETHandler(c *gin.Context) {
+	// The directory only shows accounts that opted in
+	// to it, so don't require auth, but still check it
+	// so that blocked accounts can be filtered out.
+	authed, err := oauth.Authed(c, false, false, false, false)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	offset, errWithCode := apiutil.ParseDirectoryOffset(c.Query(apiutil.DirectoryOffsetKey), 0, 1000000, 0)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 40, 80, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	order, errWithCode := apiutil.ParseDirectoryOrder(c.Query(apiutil.DirectoryOrderKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	local, errWithCode := apiutil.ParseLocal(c.Query(apiutil.LocalKey), false)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	accounts, errWithCode := m.processor.Account().Directory(
+		c.Request.Context(),
+		authed.Account,
+		local,
+		order,
+		offset,
+		limit,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, accounts)
+}
@@ -95,7 +95,8 @@ func (suite *AuthorizeTestSuite) TestAuthorize() {
   "requested_by": false,
   "domain_blocking": false,
   "endorsed": false,
-  "note": ""
+  "note": "",
+  "languages": null
 }`, dst.String())
 }
 
@@ -95,7 +95,8 @@ func (suite *RejectTestSuite) TestReject() {
   "requested_by": false,
   "domain_blocking": false,
   "endorsed": false,
-  "note": ""
+  "note": "",
+  "languages": null
 }`, dst.String())
 }
 
@@ -113,7 +113,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -234,7 +237,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -355,7 +361,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -527,7 +536,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -670,7 +682,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -828,7 +843,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 // MediaCreatePOSTHandler swagger:operation POST /api/{api_version}/media mediaCreate
@@ -69,6 +70,15 @@ import (
 //		type: string
 //		default: "0,0"
 //	-
+//		name: sensitive
+//		in: formData
+//		description: >-
+//			Mark the media as sensitive, hiding it behind a sensitive
+//			media warning even if the status it's attached to isn't.
+//			This is a GoToSocial extension.
+//		type: boolean
+//		default: false
+//	-
 //		name: file
 //		in: formData
 //		description: The media attachment to upload.
@@ -88,6 +98,8 @@ import (
 //			description: bad request
 //		'401':
 //			description: unauthorized
+//		'413':
+//			description: upload would take account over its media quota
 //		'422':
 //			description: unprocessable
 //		'500':
@@ -155,8 +167,6 @@ func validateCreateMedia(form *apimodel.AttachmentRequest) error {
 
 	maxVideoSize := config.GetMediaVideoMaxSize()
 	maxImageSize := config.GetMediaImageMaxSize()
-	minDescriptionChars := config.GetMediaDescriptionMinChars()
-	maxDescriptionChars := config.GetMediaDescriptionMaxChars()
 
 	// a very superficial check to see if no size limits are exceeded
 	// we still don't actually know which media types we're dealing with but the other handlers will go into more detail there
@@ -169,9 +179,8 @@ func validateCreateMedia(form *apimodel.AttachmentRequest) error {
 		return fmt.Errorf("file size limit exceeded: limit is %d bytes but attachment was %d bytes", maxSize, form.File.Size)
 	}
 
-	if length := len([]rune(form.Description)); length > maxDescriptionChars {
-		return fmt.Errorf("image description length must be between %d and %d characters (inclusive), but provided image description was %d chars", minDescriptionChars, maxDescriptionChars, length)
-	}
-
-	return nil
+	// Min length is checked on *UPDATE*
+	// or when the media is attached to a
+	// status, not on initial upload.
+	return validate.MediaDescription(form.Description, false)
 }
@@ -19,15 +19,14 @@ package media
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
-	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 // MediaPUTHandler swagger:operation PUT /api/v1/media/{id} mediaUpdate
@@ -77,6 +76,14 @@ import (
 //		type: string
 //		allowEmptyValue: true
 //		default: "0,0"
+//	-
+//		name: sensitive
+//		in: formData
+//		description: >-
+//			Mark the media as sensitive, hiding it behind a sensitive
+//			media warning even if the status it's attached to isn't.
+//			This is a GoToSocial extension.
+//		type: boolean
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -150,17 +157,14 @@ func (m *Module) MediaPUTHandler(c *gin.Context) {
 }
 
 func validateUpdateMedia(form *apimodel.AttachmentUpdateRequest) error {
-	minDescriptionChars := config.GetMediaDescriptionMinChars()
-	maxDescriptionChars := config.GetMediaDescriptionMaxChars()
-
 	if form.Description != nil {
-		if length := len([]rune(*form.Description)); length < minDescriptionChars || length > maxDescriptionChars {
-			return fmt.Errorf("image description length must be between %d and %d characters (inclusive), but provided image description was %d chars", minDescriptionChars, maxDescriptionChars, length)
+		if err := validate.MediaDescription(*form.Description, true); err != nil {
+			return err
 		}
 	}
 
-	if form.Focus == nil && form.Description == nil {
-		return errors.New("focus and description were both nil, there's nothing to update")
+	if form.Focus == nil && form.Description == nil && form.Sensitive == nil {
+		return errors.New("focus, description and sensitive were all nil, there's nothing to update")
 	}
 
 	return nil
@@ -19,6 +19,7 @@ package media_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -184,6 +185,57 @@ func (suite *MediaUpdateTestSuite) TestUpdateImage() {
 	suite.NotEmpty(toUpdate.Thumbnail.URL, attachmentReply.PreviewURL)
 }
 
+func (suite *MediaUpdateTestSuite) TestUpdateImageSensitive() {
+	toUpdate := suite.testAttachments["local_account_1_unattached_1"]
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	// create the request, only
+	// marking media as sensitive
+	buf, w, err := testrig.CreateMultipartFormData("", "", map[string][]string{
+		"sensitive": {"true"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080/api/v1/media/%s", toUpdate.ID), bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+	ctx.AddParam(mediamodule.IDKey, toUpdate.ID)
+
+	// do the actual request
+	suite.mediaModule.MediaPUTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	// reply should be a sensitive attachment
+	attachmentReply := &apimodel.Attachment{}
+	err = json.Unmarshal(b, attachmentReply)
+	suite.NoError(err)
+	suite.True(attachmentReply.Sensitive)
+	suite.Equal(toUpdate.Description, *attachmentReply.Description)
+
+	// should be stored as sensitive
+	dbAttachment, err := suite.db.GetAttachmentByID(context.Background(), toUpdate.ID)
+	suite.NoError(err)
+	suite.True(*dbAttachment.Sensitive)
+}
+
 func (suite *MediaUpdateTestSuite) TestUpdateImageShortDescription() {
 	// set the min description length
 	config.SetMediaDescriptionMinChars(50)
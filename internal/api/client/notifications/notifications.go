@@ -33,6 +33,12 @@ const (
 	// Use this anywhere you need to know the ID of the notification being queried.
 	BasePathWithID    = BasePath + "/:" + IDKey
 	BasePathWithClear = BasePath + "/clear"
+	// DismissPath is for dismissing one notification.
+	DismissPath = BasePathWithID + "/dismiss"
+	// DismissAllBeforePath is for dismissing one notification and all older ones.
+	DismissAllBeforePath = BasePathWithID + "/dismiss-all-before"
+	// UnreadCountPath is for counting unread notifications.
+	UnreadCountPath = BasePath + "/unread_count"
 
 	// TypesKey names an array param specifying notification types to include.
 	TypesKey = "types[]"
@@ -57,5 +63,8 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
 	attachHandler(http.MethodGet, BasePathWithID, m.NotificationGETHandler)
+	attachHandler(http.MethodGet, UnreadCountPath, m.NotificationsUnreadCountGETHandler)
 	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodPost, DismissPath, m.NotificationDismissPOSTHandler)
+	attachHandler(http.MethodPost, DismissAllBeforePath, m.NotificationsDismissAllBeforePOSTHandler)
 }
@@ -28,7 +28,12 @@ import (
 
 // NotificationsClearPOSTHandler swagger:operation POST /api/v1/notifications/clear clearNotifications
 //
-// Clear/delete all notifications for currently authorized user.
+// Clear/dismiss all notifications for currently authorized user.
+//
+// Dismissed notifications are no longer returned by default, but can
+// still be viewed by requesting notifications with `include_dismissed`.
+// A `notifications.cleared` event with an empty payload will be streamed
+// to the user's other open streams.
 //
 // Will return an empty object `{}` to indicate success.
 //
@@ -0,0 +1,195 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/notifications"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// notificationsRequest calls the given handler as local_account_1,
+// with the given notification ID path param and query, returning
+// the response code and body.
+func (suite *NotificationsTestSuite) notificationsRequest(
+	method string,
+	path string,
+	notifID string,
+	query url.Values,
+	handler gin.HandlerFunc,
+) (int, []byte) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.URL.RawQuery = query.Encode()
+	if notifID != "" {
+		ctx.AddParam(notifications.IDKey, notifID)
+	}
+
+	handler(ctx)
+
+	b, err := io.ReadAll(recorder.Result().Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+func (suite *NotificationsTestSuite) listNotifications(includeDismissed bool) []*apimodel.Notification {
+	query := url.Values{}
+	if includeDismissed {
+		query.Set("include_dismissed", "true")
+	}
+
+	code, b := suite.notificationsRequest(http.MethodGet, notifications.BasePath, "", query, suite.notificationsModule.NotificationsGETHandler)
+	suite.Equal(http.StatusOK, code)
+
+	notifs := []*apimodel.Notification{}
+	if err := json.Unmarshal(b, &notifs); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return notifs
+}
+
+func (suite *NotificationsTestSuite) unreadCount() int {
+	code, b := suite.notificationsRequest(http.MethodGet, notifications.UnreadCountPath, "", url.Values{}, suite.notificationsModule.NotificationsUnreadCountGETHandler)
+	suite.Equal(http.StatusOK, code)
+
+	count := new(apimodel.NotificationsUnreadCount)
+	if err := json.Unmarshal(b, count); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return count.Count
+}
+
+func (suite *NotificationsTestSuite) TestDismissNotification() {
+	suite.addMoreNotifications(suite.testAccounts["local_account_1"])
+
+	notifs := suite.listNotifications(false)
+	suite.Len(notifs, 3)
+
+	// Only the notifs newer than the marker are unread.
+	suite.Equal(2, suite.unreadCount())
+
+	// Dismiss the newest notif.
+	code, b := suite.notificationsRequest(http.MethodPost, notifications.DismissPath, notifs[0].ID, url.Values{}, suite.notificationsModule.NotificationDismissPOSTHandler)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, string(b))
+
+	// It shouldn't be listed or counted anymore.
+	remaining := suite.listNotifications(false)
+	suite.Len(remaining, 2)
+	suite.Equal(notifs[1].ID, remaining[0].ID)
+	suite.Equal(1, suite.unreadCount())
+
+	// Unless dismissed notifs are requested.
+	all := suite.listNotifications(true)
+	suite.Len(all, 3)
+	suite.Equal(notifs[0].ID, all[0].ID)
+	suite.True(all[0].Dismissed)
+	suite.False(all[1].Dismissed)
+}
+
+func (suite *NotificationsTestSuite) TestDismissNotificationNotOwn() {
+	// Try to dismiss a notif targeting admin.
+	notif := suite.testNotifications["local_account_2_like"]
+
+	code, _ := suite.notificationsRequest(http.MethodPost, notifications.DismissPath, notif.ID, url.Values{}, suite.notificationsModule.NotificationDismissPOSTHandler)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *NotificationsTestSuite) TestDismissNotificationsAllBefore() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	suite.addMoreNotifications(account)
+	notifs := suite.listNotifications(false)
+	suite.Len(notifs, 3)
+
+	// Open a stream for the account, to
+	// check the account's clients are told.
+	openStream, errWithCode := suite.processor.Stream().Open(ctx, account, stream.TimelineNotifications)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Dismiss all but the newest notif.
+	code, b := suite.notificationsRequest(http.MethodPost, notifications.DismissAllBeforePath, notifs[1].ID, url.Values{}, suite.notificationsModule.NotificationsDismissAllBeforePOSTHandler)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, string(b))
+
+	remaining := suite.listNotifications(false)
+	suite.Len(remaining, 1)
+	suite.Equal(notifs[0].ID, remaining[0].ID)
+	suite.Equal(1, suite.unreadCount())
+
+	msg, ok := openStream.Recv(ctx)
+	suite.True(ok)
+	suite.Equal(stream.EventTypeNotificationsCleared, msg.Event)
+	suite.Equal(notifs[1].ID, msg.Payload)
+}
+
+func (suite *NotificationsTestSuite) TestClearNotifications() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	suite.addMoreNotifications(account)
+
+	openStream, errWithCode := suite.processor.Stream().Open(ctx, account, stream.TimelineNotifications)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	code, b := suite.notificationsRequest(http.MethodPost, notifications.BasePathWithClear, "", url.Values{}, suite.notificationsModule.NotificationsClearPOSTHandler)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, string(b))
+
+	// Everything should be dismissed, not deleted.
+	suite.Empty(suite.listNotifications(false))
+	suite.Len(suite.listNotifications(true), 3)
+	suite.Zero(suite.unreadCount())
+
+	msg, ok := openStream.Recv(ctx)
+	suite.True(ok)
+	suite.Equal(stream.EventTypeNotificationsCleared, msg.Event)
+	suite.Empty(msg.Payload)
+}
@@ -113,6 +113,15 @@ import (
 //		description: Types of notifications to exclude.
 //		in: query
 //		required: false
+//	-
+//		name: include_dismissed
+//		type: boolean
+//		description: >-
+//			Include notifications that have been dismissed.
+//			Dismissed notifications will have `dismissed` set to true.
+//		default: false
+//		in: query
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -164,6 +173,12 @@ func (m *Module) NotificationsGETHandler(c *gin.Context) {
 		limit = int(i)
 	}
 
+	includeDismissed, errWithCode := apiutil.ParseNotificationsIncludeDismissed(c.Query(apiutil.NotificationsIncludeDismissedKey), false)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	resp, errWithCode := m.processor.Timeline().NotificationsGet(
 		c.Request.Context(),
 		authed,
@@ -173,6 +188,7 @@ func (m *Module) NotificationsGETHandler(c *gin.Context) {
 		limit,
 		c.QueryArray(TypesKey),
 		c.QueryArray(ExcludeTypesKey),
+		includeDismissed,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
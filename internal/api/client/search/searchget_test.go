@@ -791,6 +791,83 @@ func (suite *SearchGetTestSuite) TestSearchStatusByURL() {
 	suite.NotNil(gotStatus)
 }
 
+func (suite *SearchGetTestSuite) TestSearchRemoteStatusByWebURL() {
+	const (
+		// Web URL of the status, which the remote
+		// only serves an HTML page at, linking to
+		// the ActivityPub URI of the status.
+		webURL = "https://unknown-instance.com/@brand_new_person/01FE4NTHKWW7THT67EF10EB839"
+		apURI  = "https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839"
+	)
+
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = func() *bool { i := true; return &i }()
+		query                      = webURL
+		queryType          *string = func() *string { i := "statuses"; return &i }()
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	// Serve the HTML page at the web URL,
+	// deferring to usual mock responses for
+	// all other requests (including apURI).
+	mock := testrig.NewMockHTTPClient(nil, "../../../../testrig/media")
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != webURL {
+			return mock.Do(req)
+		}
+
+		body := `<html><head><link rel="alternate" type="application/activity+json" href="` + apURI + `"></head></html>`
+		return &http.Response{
+			Request:       req,
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": {"text/html; charset=utf-8"}},
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+		}, nil
+	}, "")
+
+	suite.federator = testrig.NewTestFederator(&suite.state, testrig.NewTestTransportController(&suite.state, httpClient), suite.mediaManager)
+	suite.processor = testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, suite.mediaManager)
+	suite.searchModule = search.New(suite.processor)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Statuses, 1) {
+		suite.FailNow("expected 1 status in search results but got 0")
+	}
+
+	// Status should have been resolved by its canonical URI.
+	suite.Equal(apURI, searchResult.Statuses[0].URI)
+}
+
 func (suite *SearchGetTestSuite) TestSearchBlockedDomainURL() {
 	var (
 		requestingAccount          = suite.testAccounts["local_account_1"]
@@ -70,6 +70,9 @@ const (
 
 	// SourcePath is used for fetching source of a post.
 	SourcePath = BasePathWithID + "/source"
+
+	// PreviewPath is used for previewing how a new status would render, without creating it.
+	PreviewPath = "/v1/gotosocial/statuses/preview"
 )
 
 type Module struct {
@@ -88,6 +91,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePathWithID, m.StatusGETHandler)
 	attachHandler(http.MethodDelete, BasePathWithID, m.StatusDELETEHandler)
 
+	// preview status
+	attachHandler(http.MethodPost, PreviewPath, m.StatusPreviewPOSTHandler)
+
 	// fave stuff
 	attachHandler(http.MethodPost, FavouritePath, m.StatusFavePOSTHandler)
 	attachHandler(http.MethodPost, UnfavouritePath, m.StatusUnfavePOSTHandler)
@@ -25,12 +25,22 @@ import (
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // StatusBoostedByGETHandler swagger:operation GET /api/v1/statuses/{id}/reblogged_by statusBoostedBy
 //
 // View accounts that have reblogged/boosted the target status.
 //
+// The next and previous queries can be parsed from the returned Link header.
+// Example:
+//
+// ```
+// <https://example.org/api/v1/statuses/01FC0SKA48HNSVR6YKZCQGS2V8/reblogged_by?limit=40&max_id=01FC0SKW5JK2Q4EVAV2B462YY0>; rel="next", <https://example.org/api/v1/statuses/01FC0SKA48HNSVR6YKZCQGS2V8/reblogged_by?limit=40&min_id=01FC0SKA48HNSVR6YKZCQGS2V9>; rel="prev"
+// ````
+//
+// Accounts that block, or are blocked by, the requesting account are not included.
+//
 //	---
 //	tags:
 //	- statuses
@@ -45,6 +55,42 @@ import (
 //		description: Target status ID.
 //		in: path
 //		required: true
+//	-
+//		name: max_id
+//		type: string
+//		description: >-
+//			Return only boosting accounts *OLDER* than the given max ID.
+//			The boosting account with the specified ID will not be included in the response.
+//			NOTE: the ID is of the internal boost, NOT any of the returned accounts.
+//		in: query
+//		required: false
+//	-
+//		name: since_id
+//		type: string
+//		description: >-
+//			Return only boosting accounts *NEWER* than the given since ID.
+//			The boosting account with the specified ID will not be included in the response.
+//			NOTE: the ID is of the internal boost, NOT any of the returned accounts.
+//		in: query
+//		required: false
+//	-
+//		name: min_id
+//		type: string
+//		description: >-
+//			Return only boosting accounts *IMMEDIATELY NEWER* than the given min ID.
+//			The boosting account with the specified ID will not be included in the response.
+//			NOTE: the ID is of the internal boost, NOT any of the returned accounts.
+//		in: query
+//		required: false
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of boosting accounts to return.
+//		default: 40
+//		minimum: 1
+//		maximum: 80
+//		in: query
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -52,10 +98,22 @@ import (
 //
 //	responses:
 //		'200':
+//			name: accounts
+//			description: Array of accounts that have boosted this status.
 //			schema:
 //				type: array
 //				items:
 //					"$ref": "#/definitions/account"
+//			headers:
+//				Link:
+//					type: string
+//					description: Links to the next and previous queries.
+//				X-Note:
+//					type: string
+//					description: >-
+//						Set if the status author has hidden interaction counts of their statuses
+//						from the requester, in which case the requester sees counts of 0 on the
+//						status, though the accounts which interacted with it are still listed.
 //		'400':
 //			description: bad request
 //		'401':
@@ -78,11 +136,29 @@ func (m *Module) StatusBoostedByGETHandler(c *gin.Context) {
 		return
 	}
 
-	apiAccounts, errWithCode := m.processor.Status().StatusBoostedBy(c.Request.Context(), authed.Account, targetStatusID)
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		80, // max limit
+		40, // default limit
+	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	c.JSON(http.StatusOK, apiAccounts)
+	resp, errWithCode := m.processor.Status().StatusBoostedBy(c.Request.Context(), authed.Account, targetStatusID, page)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if resp.LinkHeader != "" {
+		c.Header("Link", resp.LinkHeader)
+	}
+
+	if resp.Note != "" {
+		c.Header("X-Note", resp.Note)
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp.Items)
 }
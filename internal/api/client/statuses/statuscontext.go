@@ -23,6 +23,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
@@ -47,6 +48,22 @@ import (
 //		description: Target status ID.
 //		in: path
 //		required: true
+//	-
+//		name: max_depth_ancestors
+//		type: integer
+//		description: >-
+//			Return at most this many ancestors, closest to the target status first.
+//			Defaults to, and is capped at, the instance's configured maximum (20 by default).
+//		minimum: 1
+//		in: query
+//	-
+//		name: max_depth_descendants
+//		type: integer
+//		description: >-
+//			Return descendants at most this many replies deep below the target status.
+//			Defaults to, and is capped at, the instance's configured maximum (40 by default).
+//		minimum: 1
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -89,7 +106,35 @@ func (m *Module) StatusContextGETHandler(c *gin.Context) {
 		return
 	}
 
-	statusContext, errWithCode := m.processor.Status().ContextGet(c.Request.Context(), authed.Account, targetStatusID)
+	maxAncestors, errWithCode := apiutil.ParseStatusContextMaxDepthAncestors(
+		c.Query(apiutil.StatusContextMaxDepthAncestorsKey),
+		config.GetStatusContextMaxDepthAncestors(), // default
+		config.GetStatusContextMaxDepthAncestors(), // max
+		1, // min
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	maxDescendants, errWithCode := apiutil.ParseStatusContextMaxDepthDescendants(
+		c.Query(apiutil.StatusContextMaxDepthDescendantsKey),
+		config.GetStatusContextMaxDepthDescendants(), // default
+		config.GetStatusContextMaxDepthDescendants(), // max
+		1, // min
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	statusContext, errWithCode := m.processor.Status().ContextGet(
+		c.Request.Context(),
+		authed.Account,
+		targetStatusID,
+		maxAncestors,
+		maxDescendants,
+	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -22,12 +22,13 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
-	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
@@ -113,7 +114,9 @@ import (
 //	-
 //		name: sensitive
 //		x-go-name: Sensitive
-//		description: Status and attached media should be marked as sensitive.
+//		description: |-
+//			Status and attached media should be marked as sensitive.
+//			If not provided, the account's default sensitive setting will be used.
 //		type: boolean
 //		in: formData
 //	-
@@ -122,6 +125,7 @@ import (
 //		description: |-
 //			Text to be shown as a warning or subject before the actual content.
 //			Statuses are generally collapsed behind this field.
+//			Must not be longer than the instance's configured max content warning length.
 //		type: string
 //		in: formData
 //	-
@@ -209,6 +213,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable content, eg., content warning too long, or post too short
 //		'500':
 //			description: internal server error
 func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
@@ -248,6 +254,11 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if err := validateCreateStatusLength(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnprocessableEntity(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
 	apiStatus, errWithCode := m.processor.Status().Create(
 		c.Request.Context(),
 		authed.Account,
@@ -280,12 +291,7 @@ func validateNormalizeCreateStatus(form *apimodel.AdvancedStatusCreateForm) erro
 		return errors.New("can't post media + poll in same status")
 	}
 
-	maxChars := config.GetStatusesMaxChars()
-	if length := len([]rune(form.Status)) + len([]rune(form.SpoilerText)); length > maxChars {
-		return fmt.Errorf("status too long, %d characters provided (including spoiler/content warning) but limit is %d", length, maxChars)
-	}
-
-	maxMediaFiles := config.GetStatusesMediaMaxFiles()
+	maxMediaFiles := gtsmodel.NewInstanceConfiguration().StatusesMediaMaxFiles
 	if len(form.MediaIDs) > maxMediaFiles {
 		return fmt.Errorf("too many media files attached to status, %d attached but limit is %d", len(form.MediaIDs), maxMediaFiles)
 	}
@@ -307,9 +313,33 @@ func validateNormalizeCreateStatus(form *apimodel.AdvancedStatusCreateForm) erro
 	return nil
 }
 
+// validateCreateStatusLength checks the form's content
+// warning isn't longer than permitted, and that the status
+// text isn't shorter than required, unless it has media or
+// a poll, in which case the text may be as short as it likes.
+func validateCreateStatusLength(form *apimodel.AdvancedStatusCreateForm) error {
+	limits := gtsmodel.NewInstanceConfiguration()
+
+	maxSpoilerChars := limits.StatusesSpoilerMaxChars
+	if length := len([]rune(form.SpoilerText)); length > maxSpoilerChars {
+		return fmt.Errorf("content warning too long, %d characters provided but limit is %d", length, maxSpoilerChars)
+	}
+
+	minChars := limits.StatusesMinChars
+	if minChars > 0 && len(form.MediaIDs) == 0 && form.Poll == nil {
+		text := strings.TrimSpace(form.Status)
+		if length := len([]rune(text)); length < minChars {
+			return fmt.Errorf("post too short, %d characters provided but minimum is %d", length, minChars)
+		}
+	}
+
+	return nil
+}
+
 func validateNormalizeCreatePoll(form *apimodel.AdvancedStatusCreateForm) error {
-	maxPollOptions := config.GetStatusesPollMaxOptions()
-	maxPollChars := config.GetStatusesPollOptionMaxChars()
+	limits := gtsmodel.NewInstanceConfiguration()
+	maxPollOptions := limits.StatusesPollMaxOptions
+	maxPollChars := limits.StatusesPollOptionMaxChars
 
 	// Normalize poll expiry if necessary.
 	// If we parsed this as JSON, expires_in
@@ -18,6 +18,7 @@
 package statuses_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -31,7 +32,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -82,6 +86,117 @@ func (suite *StatusFavedByTestSuite) TestGetFavedBy() {
 	assert.Equal(suite.T(), "the_mighty_zork", accts[0].Username)
 }
 
+func (suite *StatusFavedByTestSuite) TestGetFavedByPaged() {
+	t := suite.testTokens["local_account_2"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	targetStatus := suite.testStatuses["admin_account_status_1"] // this status is faved by local_account_1
+	remoteAcct := suite.testAccounts["remote_account_2"]
+
+	// Add a newer fave from a remote account.
+	fave := &gtsmodel.StatusFave{
+		ID:              id.NewULID(),
+		AccountID:       remoteAcct.ID,
+		TargetAccountID: targetStatus.AccountID,
+		StatusID:        targetStatus.ID,
+		URI:             remoteAcct.URI + "/likes/" + targetStatus.ID,
+	}
+	if err := suite.db.PutStatusFave(context.Background(), fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	path := strings.Replace(statuses.FavouritedPath, ":id", targetStatus.ID, 1)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_2"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_2"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_2"])
+	ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:8080%s?limit=1", path), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	suite.statusModule.StatusFavedByGETHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	accts := []apimodel.Account{}
+	err = json.Unmarshal(b, &accts)
+	suite.NoError(err)
+
+	// Newest (remote) fave should be on the first page,
+	// with a link to the next page paged by fave ID.
+	suite.Len(accts, 1)
+	suite.Equal(remoteAcct.ID, accts[0].ID)
+	suite.Equal(
+		`<http://localhost:8080/api`+path+`?limit=1&max_id=`+fave.ID+`>; rel="next", `+
+			`<http://localhost:8080/api`+path+`?limit=1&min_id=`+fave.ID+`>; rel="prev"`,
+		result.Header.Get("Link"),
+	)
+}
+
+func (suite *StatusFavedByTestSuite) TestGetFavedByHiddenInteractions() {
+	targetStatus := suite.testStatuses["admin_account_status_1"] // this status is faved by local_account_1
+
+	// Admin opts to hide interaction counts.
+	settings := new(gtsmodel.AccountSettings)
+	*settings = *suite.testAccounts["admin_account"].Settings
+	settings.HideInteractionCounts = util.Ptr(true)
+	if err := suite.db.UpdateAccountSettings(context.Background(), settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, test := range []struct {
+		requester    string
+		expectedNote string
+	}{
+		{"local_account_2", "status author has hidden interaction counts of this status"},
+		{"admin_account", ""},
+	} {
+		recorder := httptest.NewRecorder()
+		ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+		ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+		ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[test.requester]))
+		ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[test.requester])
+		ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[test.requester])
+		ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:8080%s", strings.Replace(statuses.FavouritedPath, ":id", targetStatus.ID, 1)), nil)
+		ctx.Request.Header.Set("accept", "application/json")
+		ctx.Params = gin.Params{
+			gin.Param{
+				Key:   statuses.IDKey,
+				Value: targetStatus.ID,
+			},
+		}
+
+		suite.statusModule.StatusFavedByGETHandler(ctx)
+
+		// Faves should still be listed, but
+		// non-authors should get a note on it.
+		suite.Equal(http.StatusOK, recorder.Code, test.requester)
+		suite.Equal(test.expectedNote, recorder.Header().Get("X-Note"), test.requester)
+
+		accts := []apimodel.Account{}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &accts); err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Len(accts, 1, test.requester)
+		suite.Equal(suite.testAccounts["local_account_1"].ID, accts[0].ID, test.requester)
+	}
+}
+
 func TestStatusFavedByTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusFavedByTestSuite))
 }
@@ -123,7 +123,8 @@ func (suite *StatusHistoryTestSuite) TestGetHistory() {
     },
     "poll": null,
     "media_attachments": [],
-    "emojis": []
+    "emojis": [],
+    "media_edited": false
   }
 ]`, dst.String())
 }
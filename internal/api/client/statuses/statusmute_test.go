@@ -108,6 +108,7 @@ func (suite *StatusMuteTestSuite) TestMuteUnmuteStatus() {
   "bookmarked": false,
   "pinned": false,
   "content": "hello everyone!",
+  "word_count": 0,
   "reblog": null,
   "application": {
     "name": "really cool gts application",
@@ -171,6 +172,7 @@ func (suite *StatusMuteTestSuite) TestMuteUnmuteStatus() {
   "bookmarked": false,
   "pinned": false,
   "content": "hello everyone!",
+  "word_count": 0,
   "reblog": null,
   "application": {
     "name": "really cool gts application",
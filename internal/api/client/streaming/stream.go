@@ -20,9 +20,11 @@ package streaming
 import (
 	"context"
 	"slices"
+	"strings"
 	"time"
 
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -193,6 +195,10 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		return
 	}
 
+	// Whether IDs in the stream should be obfuscated,
+	// as set by the middleware for this HTTP request.
+	obfuscateIDs := gtscontext.ObfuscateIDs(c.Request.Context())
+
 	// Get the initial requested stream type, if there is one.
 	streamType := c.Query(StreamQueryKey)
 
@@ -201,6 +207,9 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 	// The streamType in this case will end up looking like
 	// `hashtag:example` or `list:01H3YF48G8B7KTPQFS8D2QBVG8`.
 	if list := c.Query(StreamListKey); list != "" {
+		if obfuscateIDs {
+			list = apiutil.DeobfuscateID(list)
+		}
 		streamType += ":" + list
 	} else if tag := c.Query(StreamTagKey); tag != "" {
 		streamType += ":" + tag
@@ -242,7 +251,7 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 	// This prevents the upgrade handler from holding open any
 	// throttle / rate-limit request tokens which could become
 	// problematic on instances with multiple users.
-	go m.handleWSConn(&l, wsConn, stream)
+	go m.handleWSConn(&l, wsConn, stream, obfuscateIDs)
 }
 
 // handleWSConn handles a two-way websocket streaming connection.
@@ -250,12 +259,18 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 // into the connection. If any errors are encountered while reading
 // or writing (including expected errors like clients leaving), the
 // connection will be closed.
-func (m *Module) handleWSConn(l *log.Entry, wsConn *websocket.Conn, stream *streampkg.Stream) {
+func (m *Module) handleWSConn(l *log.Entry, wsConn *websocket.Conn, stream *streampkg.Stream, obfuscateIDs bool) {
 	l.Info("opened websocket connection")
 
 	// Create new async context with cancel.
 	ctx, cncl := context.WithCancel(context.Background())
 
+	if obfuscateIDs {
+		// Carry over ID obfuscation
+		// flag from the HTTP request.
+		ctx = gtscontext.SetObfuscateIDs(ctx)
+	}
+
 	go func() {
 		defer cncl()
 
@@ -335,6 +350,10 @@ func (m *Module) readFromWSConn(
 		}
 
 		if msg.List != "" {
+			if gtscontext.ObfuscateIDs(ctx) {
+				msg.List = apiutil.DeobfuscateID(msg.List)
+			}
+
 			// If a list is given, add this to
 			// the stream name as this is how we
 			// we track stream types internally.
@@ -398,6 +417,10 @@ func (m *Module) writeToWSConn(
 
 		l.Trace("writing websocket message: %+v", msg)
 
+		if gtscontext.ObfuscateIDs(ctx) {
+			msg = obfuscateMessageIDs(msg)
+		}
+
 		// Received a new message from the processor.
 		if err := wsConn.WriteJSON(msg); err != nil {
 			l.Debugf("error writing websocket message: %v", err)
@@ -407,3 +430,32 @@ func (m *Module) writeToWSConn(
 
 	l.Debug("finished websocket write")
 }
+
+// obfuscateMessageIDs obfuscates IDs in the payload
+// and list stream types of the given stream message.
+func obfuscateMessageIDs(msg streampkg.Message) streampkg.Message {
+	stream := make([]string, len(msg.Stream))
+	for i, streamType := range msg.Stream {
+		if list, id, ok := strings.Cut(streamType, ":"); ok &&
+			list == streampkg.TimelineList {
+			streamType = list + ":" + apiutil.ObfuscateID(id)
+		}
+		stream[i] = streamType
+	}
+	msg.Stream = stream
+
+	if id := apiutil.ObfuscateID(msg.Payload); id != msg.Payload {
+		// Payload is a bare
+		// ID, e.g. delete.
+		msg.Payload = id
+	} else if msg.Payload != "" {
+		// Payload is JSON,
+		// e.g. update.
+		b, err := apiutil.ObfuscateIDsJSON([]byte(msg.Payload))
+		if err == nil {
+			msg.Payload = string(b)
+		}
+	}
+
+	return msg
+}
@@ -55,6 +55,39 @@ import (
 //		in: path
 //		required: true
 //	-
+//		name: any[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Return statuses that use any of these tags, in addition to the tag given in the path.
+//			At most 4 tags may be given across any[], all[], and none[].
+//		collectionFormat: multi
+//		in: query
+//		required: false
+//	-
+//		name: all[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Return only statuses that also use all of these tags.
+//			At most 4 tags may be given across any[], all[], and none[].
+//		collectionFormat: multi
+//		in: query
+//		required: false
+//	-
+//		name: none[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Return only statuses that use none of these tags.
+//			At most 4 tags may be given across any[], all[], and none[].
+//		collectionFormat: multi
+//		in: query
+//		required: false
+//	-
 //		name: max_id
 //		type: string
 //		description: >-
@@ -142,6 +175,9 @@ func (m *Module) TagTimelineGETHandler(c *gin.Context) {
 		c.Request.Context(),
 		authed.Account,
 		tagName,
+		c.QueryArray(apiutil.TagTimelineAnyKey),
+		c.QueryArray(apiutil.TagTimelineAllKey),
+		c.QueryArray(apiutil.TagTimelineNoneKey),
 		c.Query(apiutil.MaxIDKey),
 		c.Query(apiutil.SinceIDKey),
 		c.Query(apiutil.MinIDKey),
@@ -31,6 +31,8 @@ const (
 	PasswordChangePath = BasePath + "/password_change"
 	// EmailChangePath is the path for POSTing an email address change request.
 	EmailChangePath = BasePath + "/email_change"
+	// StatusRetentionPath is the path for getting / updating status retention settings.
+	StatusRetentionPath = BasePath + "/status_retention"
 )
 
 type Module struct {
@@ -47,4 +49,6 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.UserGETHandler)
 	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
 	attachHandler(http.MethodPost, EmailChangePath, m.EmailChangePOSTHandler)
+	attachHandler(http.MethodGet, StatusRetentionPath, m.StatusRetentionGETHandler)
+	attachHandler(http.MethodPost, StatusRetentionPath, m.StatusRetentionPOSTHandler)
 }
@@ -98,6 +98,14 @@ type Account struct {
 	// Account has opted to hide their followers/following collections.
 	// Key/value omitted if false.
 	HideCollections bool `json:"hide_collections,omitempty"`
+	// Account has opted to hide the favourite + boost
+	// counts of its statuses from other accounts.
+	// Key/value omitted if false.
+	HideInteractionCounts bool `json:"hide_interaction_counts,omitempty"`
+	// Account has opted to hide the contents of its
+	// ActivityPub outbox, serving only a statuses count.
+	// Key/value omitted if false.
+	HideOutbox bool `json:"hide_outbox,omitempty"`
 	// Role of the account on this instance.
 	// Key/value omitted for remote accounts.
 	Role *AccountRole `json:"role,omitempty"`
@@ -148,6 +156,12 @@ type AccountCreateRequest struct {
 	// example: en
 	// Required: true
 	Locale string `form:"locale" json:"locale" xml:"locale" binding:"required"`
+	// The account domain to use in the new account's name.
+	// Must be one of the account domains configured on this
+	// instance. If not set, the default account domain is used.
+	// swagger:parameters
+	// example: example.org
+	Domain string `form:"domain" json:"domain" xml:"domain"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -188,6 +202,10 @@ type UpdateCredentialsRequest struct {
 	EnableRSS *bool `form:"enable_rss" json:"enable_rss"`
 	// Hide this account's following/followers collections.
 	HideCollections *bool `form:"hide_collections" json:"hide_collections"`
+	// Hide favourite + boost counts of this account's statuses from other accounts.
+	HideInteractionCounts *bool `form:"hide_interaction_counts" json:"hide_interaction_counts"`
+	// Hide the contents of this account's ActivityPub outbox, serving only a statuses count.
+	HideOutbox *bool `form:"hide_outbox" json:"hide_outbox"`
 }
 
 // UpdateSource is to be used specifically in an UpdateCredentialsRequest.
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AccountStatistics represents personal usage
+// statistics of the requesting account.
+//
+// swagger:model accountStatistics
+type AccountStatistics struct {
+	// The period covered by these statistics.
+	// example: 30d
+	Period string `json:"period"`
+	// Number of statuses (not including boosts) posted during the period.
+	// example: 42
+	StatusesCount int `json:"statuses_count"`
+	// Number of favourites of the account's statuses received during the period.
+	// example: 108
+	FavouritesReceived int `json:"favourites_received"`
+	// Number of boosts of the account's statuses received during the period.
+	// example: 17
+	BoostsReceived int `json:"boosts_received"`
+	// Number of new followers gained in the last 30 days.
+	// example: 5
+	FollowersGained30d int `json:"followers_gained_30d"`
+	// Hashtags most used in the account's statuses during the period, most used first.
+	TopTags []AccountStatisticsTag `json:"top_tags"`
+}
+
+// AccountStatisticsTag represents one of the
+// hashtags most used by the requesting account.
+//
+// swagger:model accountStatisticsTag
+type AccountStatisticsTag struct {
+	// The name of the hashtag.
+	// example: gotosocial
+	Name string `json:"name"`
+	// A link to the hashtag on this instance.
+	// example: https://example.org/tags/gotosocial
+	URL string `json:"url"`
+	// Number of the account's statuses using this hashtag during the period.
+	// example: 12
+	StatusesCount int `json:"statuses_count"`
+}
+
+// AccountStatisticsRequest models a request
+// for personal usage statistics.
+//
+// swagger:ignore
+type AccountStatisticsRequest struct {
+	// Period to cover, one of 30d, 90d, 1y.
+	Period string `form:"period" json:"period"`
+}
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AccountWarning models a formal moderation
+// warning issued to an account by an admin.
+//
+// swagger:model accountWarning
+type AccountWarning struct {
+	// The ID of the warning.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// Action taken against the account along with the warning.
+	// Currently always "none", as warnings are issued on their own.
+	// example: none
+	Action string `json:"action"`
+	// Text of the warning, written by the admin.
+	// example: Please stop posting spam.
+	Text string `json:"text"`
+	// The account that was warned.
+	TargetAccount *Account `json:"target_account"`
+	// State of any appeal against this warning.
+	// One of: none, pending, approved, rejected.
+	// example: none
+	AppealState string `json:"appeal_state"`
+	// Time when the warning was issued (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// AdminAccountWarningRequest models a request
+// to issue a formal warning to an account.
+//
+// swagger:ignore
+type AdminAccountWarningRequest struct {
+	// Text of the warning, shown to the warned account.
+	Text string `form:"text" json:"text"`
+}
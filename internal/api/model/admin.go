@@ -72,6 +72,19 @@ type AdminAccountInfo struct {
 	CreatedByApplicationID string `json:"created_by_application_id,omitempty"`
 	// The ID of the account that invited this user
 	InvitedByAccountID string `json:"invited_by_account_id,omitempty"`
+	// Max total size in bytes of media this account may upload.
+	// 0 means the instance default quota applies, and a negative
+	// value means no limit. Only set for local accounts.
+	// example: 1073741824
+	MediaQuotaBytes int64 `json:"media_quota_bytes,omitempty"`
+	// Total size in bytes of media uploaded by this account,
+	// which counts towards its media quota. Only set for local accounts.
+	// example: 1048576
+	MediaUsedBytes int64 `json:"media_used_bytes,omitempty"`
+	// Max characters permitted in statuses created by this account.
+	// 0 means the instance default applies. Only set for local accounts.
+	// example: 5000
+	CharacterLimitOverride int `json:"character_limit_override,omitempty"`
 }
 
 // AdminReport models the admin view of a report.
@@ -189,6 +202,36 @@ type AdminActionResponse struct {
 	ActionID string `json:"action_id"`
 }
 
+// AdminAction models an admin action that
+// is processed asynchronously, such that its
+// progress and outcome may be checked later.
+//
+// swagger:model adminAction
+type AdminAction struct {
+	// Internal ID of the action.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	ID string `json:"id"`
+	// Time when the action was started (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time when the action was completed (ISO 8601 Datetime).
+	// Null if the action is still running.
+	// example: 2021-07-30T09:20:25+00:00
+	CompletedAt *string `json:"completed_at"`
+	// Type of the action.
+	// example: import-emojis
+	Type string `json:"type"`
+	// Category of the entity targeted by the action.
+	// example: domain
+	TargetCategory string `json:"target_category"`
+	// Identifier of the entity targeted by the action,
+	// eg., an account ID or a domain name.
+	// example: example.org
+	TargetID string `json:"target_id"`
+	// Errors encountered while processing the action, if any.
+	Errors []string `json:"errors"`
+}
+
 // MediaCleanupRequest models admin media cleanup parameters
 //
 // swagger:parameters mediaCleanup
@@ -206,6 +249,55 @@ type AdminSendTestEmailRequest struct {
 	Message string `form:"message" json:"message"`
 }
 
+// AdminRetentionPolicy models the instance data retention policy,
+// ie., automatic deletion of statuses and media after a given age.
+//
+// swagger:model adminRetentionPolicy
+type AdminRetentionPolicy struct {
+	// Delete statuses older than this many days.
+	// 0 means statuses are never deleted by the policy.
+	// example: 365
+	MaxStatusAgeDays int `json:"max_status_age_days"`
+	// Delete media attachments older than this many days.
+	// 0 means media is never deleted by the policy.
+	// example: 90
+	MaxMediaAgeDays int `json:"max_media_age_days"`
+	// Apply the policy to statuses and media of local accounts.
+	// example: false
+	AppliesToLocal bool `json:"applies_to_local"`
+	// Apply the policy to statuses and media of remote accounts.
+	// example: true
+	AppliesToRemote bool `json:"applies_to_remote"`
+	// Time when the policy was last updated (ISO 8601 Datetime).
+	// Empty if the policy has never been set.
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// AdminRetentionPolicyRequest models retention policy update parameters.
+//
+// swagger:parameters retentionPolicyUpdate
+type AdminRetentionPolicyRequest struct {
+	// Delete statuses older than this many days.
+	// Set to 0 to never delete statuses.
+	//
+	// in: formData
+	MaxStatusAgeDays *int `form:"max_status_age_days" json:"max_status_age_days" xml:"max_status_age_days"`
+	// Delete media attachments older than this many days.
+	// Set to 0 to never delete media.
+	//
+	// in: formData
+	MaxMediaAgeDays *int `form:"max_media_age_days" json:"max_media_age_days" xml:"max_media_age_days"`
+	// Apply the policy to statuses and media of local accounts.
+	//
+	// in: formData
+	AppliesToLocal *bool `form:"applies_to_local" json:"applies_to_local" xml:"applies_to_local"`
+	// Apply the policy to statuses and media of remote accounts.
+	//
+	// in: formData
+	AppliesToRemote *bool `form:"applies_to_remote" json:"applies_to_remote" xml:"applies_to_remote"`
+}
+
 type AdminInstanceRule struct {
 	ID        string `json:"id"`         // id of this item in the database
 	CreatedAt string `json:"created_at"` // when was item created
@@ -232,6 +324,55 @@ type DebugAPUrlResponse struct {
 	ResponseBody string `json:"response_body"`
 }
 
+// DebugVisibilityResponse explains, check by check, whether
+// a status is visible to an account, and if not, why not.
+//
+// swagger:model debugVisibilityResponse
+type DebugVisibilityResponse struct {
+	// ID of the account the checks were run for.
+	AccountID string `json:"account_id"`
+	// ID of the status the checks were run for.
+	StatusID string `json:"status_id"`
+	// Whether the status is visible to the account at all,
+	// i.e. the outcome of the status_visible check.
+	Visible bool `json:"visible"`
+	// Outcome of each check, in the order they were run.
+	Checks []DebugVisibilityCheck `json:"checks"`
+}
+
+// DebugVisibilityCheck is the outcome
+// of one check of status visibility.
+//
+// swagger:model debugVisibilityCheck
+type DebugVisibilityCheck struct {
+	// Name of the check, one of:
+	// status_visible, home_timelineable, list, filters, mutes.
+	Name string `json:"name"`
+	// ID of the list checked, for list checks only.
+	ListID string `json:"list_id,omitempty"`
+	// Whether the check passed, i.e. didn't hide the status.
+	Passed bool `json:"passed"`
+	// Reasons given by the check, in the order the
+	// rules were evaluated. A rule that denied
+	// visibility will be the last reason given.
+	Reasons []string `json:"reasons"`
+}
+
+// DebugIndexStat provides statistics
+// about one index of an in-memory cache.
+//
+// swagger:model debugIndexStat
+type DebugIndexStat struct {
+	// Number of unique keys in the index.
+	Keys int `json:"keys"`
+	// Number of entries across all keys in the index.
+	Entries int `json:"entries"`
+	// Number of entries under the largest key.
+	MaxBucket int `json:"max_bucket"`
+	// Average number of entries per key.
+	FanOut float64 `json:"fan_out"`
+}
+
 // AdminGetAccountsRequest models a request
 // to get an admin view of one or more
 // accounts using given parameters.
@@ -280,3 +421,200 @@ type AdminAccountRejectRequest struct {
 	// them that their sign-up has been rejected.
 	SendEmail bool `form:"send_email" json:"send_email"`
 }
+
+// AdminAccountMediaQuotaRequest models a request
+// to set the media quota of a local account.
+//
+// swagger:ignore
+type AdminAccountMediaQuotaRequest struct {
+	// Max total size in bytes of media the account may upload.
+	// 0 to use the instance default, or negative for no limit.
+	MediaQuotaBytes *int64 `form:"media_quota_bytes" json:"media_quota_bytes"`
+}
+
+// AdminAccountCharacterLimitRequest models a request
+// to set the status character limit of a local account.
+//
+// swagger:ignore
+type AdminAccountCharacterLimitRequest struct {
+	// Max characters permitted in statuses
+	// created by the account, or 0 to use
+	// the instance default.
+	CharacterLimit *int `form:"character_limit" json:"character_limit"`
+}
+
+// AdminActionLog models an entry in the log
+// of actions taken by instance administrators.
+//
+// swagger:model adminActionLog
+type AdminActionLog struct {
+	// The ID of the log entry.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// Time when the action was taken (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Type of action that was taken.
+	// example: suspend
+	Action string `json:"action"`
+	// Reason given for taking the action, if any.
+	// example: spam
+	Reason string `json:"reason"`
+	// The admin account that took the action.
+	// Null if the account no longer exists.
+	Account *AdminAccountInfo `json:"account"`
+	// The account targeted by the action.
+	// Null if the account no longer exists.
+	TargetAccount *AdminAccountInfo `json:"target_account"`
+}
+
+// AdminDeliveryAudit models an entry in the federation
+// audit log, recording a single attempt at delivering
+// an outgoing activity to a remote inbox.
+//
+// swagger:model adminDeliveryAudit
+type AdminDeliveryAudit struct {
+	// The ID of the log entry.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// Time when the delivery was attempted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Inbox URI the activity was delivered to.
+	// example: https://example.org/users/someone/inbox
+	Inbox string `json:"inbox"`
+	// Domain of the target inbox.
+	// example: example.org
+	Domain string `json:"domain"`
+	// ActivityStreams type of the delivered activity.
+	// example: Create
+	ActivityType string `json:"activity_type"`
+	// URI of the object of the delivered activity, if any.
+	// example: https://gts.example.org/users/admin/statuses/01FBW9XGEP7G6K88VY4S9MPE1R
+	ObjectURI string `json:"object_uri"`
+	// HTTP status code of the response from the
+	// remote inbox. 0 if no response was received.
+	// example: 202
+	StatusCode int `json:"status_code"`
+	// Attempt number of this delivery, starting at 1.
+	// example: 1
+	Attempt int `json:"attempt"`
+}
+
+// AdminDomainDeliveryStatus models the health of
+// outgoing deliveries to a remote domain, as tracked
+// to back off from delivering to failing domains.
+//
+// swagger:model adminDomainDeliveryStatus
+type AdminDomainDeliveryStatus struct {
+	// The (punycode) domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of delivery attempts to the domain that have failed in a row.
+	// example: 7
+	ConsecutiveFailures int `json:"consecutive_failures"`
+	// Time of the most recent failed delivery attempt (ISO 8601 Datetime), if any.
+	// example: 2021-07-30T09:20:25+00:00
+	LastFailureAt *string `json:"last_failure_at"`
+	// Time of the most recent successful delivery attempt (ISO 8601 Datetime), if known.
+	// example: 2021-07-30T09:20:25+00:00
+	LastSuccessAt *string `json:"last_success_at"`
+	// Time before which deliveries to the domain
+	// won't be attempted (ISO 8601 Datetime), if any.
+	// example: 2021-07-30T09:20:25+00:00
+	NextAttemptAt *string `json:"next_attempt_at"`
+	// Whether deliveries to the domain are currently being backed off from.
+	BackingOff bool `json:"backing_off"`
+	// The most recent delivery errors, newest first.
+	RecentErrors []AdminDeliveryError `json:"recent_errors"`
+}
+
+// AdminDeliveryError models a single failed
+// attempt at delivering to a remote domain.
+//
+// swagger:model adminDeliveryError
+type AdminDeliveryError struct {
+	// Time of the failed attempt (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Inbox URI the activity was being delivered to.
+	// example: https://example.org/users/someone/inbox
+	Inbox string `json:"inbox"`
+	// HTTP status code of the response from the
+	// remote inbox. 0 if no response was received.
+	// example: 503
+	StatusCode int `json:"status_code"`
+	// Error returned by the attempt.
+	Error string `json:"error"`
+}
+
+// AdminInstanceCustomCSS models custom CSS
+// injected into every page of the web UI.
+//
+// swagger:model adminInstanceCustomCSS
+type AdminInstanceCustomCSS struct {
+	// Custom CSS for the web UI, if set.
+	// example: body { background: hotpink; }
+	CustomCSS string `json:"custom_css"`
+}
+
+// AdminInstanceCustomCSSRequest models a request
+// to set custom CSS for the web UI of this instance.
+//
+// swagger:ignore
+type AdminInstanceCustomCSSRequest struct {
+	// Custom CSS to inject into the web UI.
+	// Empty string clears any existing CSS.
+	CustomCSS *string `form:"custom_css" json:"custom_css"`
+}
+
+// AdminWorkerPool models the current
+// state of one of this instance's pools
+// of background workers.
+//
+// swagger:model adminWorkerPool
+type AdminWorkerPool struct {
+	// Name of the worker pool.
+	// example: delivery
+	Name string `json:"name"`
+	// Current number of workers in the pool.
+	// example: 8
+	Size int `json:"size"`
+	// Number of jobs queued awaiting a worker.
+	// example: 12
+	Queued int `json:"queued"`
+	// Number of workers currently busy with a job.
+	// example: 3
+	Busy int `json:"busy"`
+	// Size of the pool as derived from
+	// the instance configuration file.
+	// example: 8
+	Default int `json:"default"`
+	// Size override for the pool set via the
+	// admin API, which persists across restarts.
+	// 0 if the pool is sized from configuration.
+	// example: 0
+	Override int `json:"override"`
+}
+
+// AdminWorkersUpdateRequest models a request
+// to resize worker pools of this instance.
+//
+// swagger:ignore
+type AdminWorkersUpdateRequest struct {
+	// New size of the delivery worker pool.
+	// 0 resets pool to configured size.
+	Delivery *int `form:"delivery" json:"delivery"`
+	// New size of the client API worker pool.
+	// 0 resets pool to configured size.
+	Client *int `form:"client" json:"client"`
+	// New size of the fedi API worker pool.
+	// 0 resets pool to configured size.
+	Federator *int `form:"federator" json:"federator"`
+	// New size of the dereference worker pool.
+	// 0 resets pool to configured size.
+	Dereference *int `form:"dereference" json:"dereference"`
+	// New size of the media worker pool.
+	// 0 resets pool to configured size.
+	Media *int `form:"media" json:"media"`
+}
@@ -68,3 +68,74 @@ type ApplicationCreateRequest struct {
 	// in: formData
 	Website string `form:"website" json:"website" xml:"website"`
 }
+
+// AdminApplication models an api application
+// as seen by an admin, with usage statistics.
+//
+// swagger:model adminApplication
+type AdminApplication struct {
+	// The ID of the application.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The name of the application.
+	// example: Tusky
+	Name string `json:"name"`
+	// The website associated with the application (url)
+	// example: https://tusky.app
+	Website string `json:"website,omitempty"`
+	// Post-authorization redirect URI for the application (OAuth2).
+	// example: https://example.org/callback?some=query
+	RedirectURI string `json:"redirect_uri"`
+	// Client ID associated with this application.
+	ClientID string `json:"client_id"`
+	// Space separated list of scopes requested by this application.
+	// example: read write
+	Scopes string `json:"scopes"`
+	// Time when the application was registered (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time when the application was revoked by an admin (ISO 8601 Datetime).
+	// Null if the application has not been revoked.
+	// example: 2021-07-30T09:20:25+00:00
+	RevokedAt *string `json:"revoked_at"`
+	// Number of access tokens currently issued to this application.
+	// example: 5
+	TokensCount int `json:"tokens_count"`
+	// Time when any token of this application was last used (ISO 8601 Datetime).
+	// Null if no token has been used yet. Accurate to about an hour.
+	// example: 2021-07-30T09:20:25+00:00
+	LastUsedAt *string `json:"last_used_at"`
+}
+
+// AdminApplicationBlock models a pattern that
+// blocks matching applications from registering.
+//
+// swagger:model adminApplicationBlock
+type AdminApplicationBlock struct {
+	// The ID of the application block.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Regular expression matched against the name of registering applications.
+	// example: (?i)scraper
+	NamePattern string `json:"name_pattern,omitempty"`
+	// Regular expression matched against the website of registering applications.
+	// example: ^https://scraper\.example\.org
+	WebsitePattern string `json:"website_pattern,omitempty"`
+	// ID of the admin account that created the block.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	CreatedBy string `json:"created_by"`
+	// Time when the block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// AdminApplicationBlockRequest models a
+// request to create an application block.
+//
+// swagger:ignore
+type AdminApplicationBlockRequest struct {
+	// Regular expression matched against the name of registering applications.
+	NamePattern string `form:"name_pattern" json:"name_pattern" xml:"name_pattern"`
+	// Regular expression matched against the website of registering applications.
+	WebsitePattern string `form:"website_pattern" json:"website_pattern" xml:"website_pattern"`
+}
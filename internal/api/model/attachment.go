@@ -33,6 +33,9 @@ type AttachmentRequest struct {
 	// If present, it should be in the form of two comma-separated floats between -1 and 1.
 	// example: -0.5,0.565
 	Focus string `form:"focus"`
+	// Mark the media file as sensitive. Optional.
+	// This is a GoToSocial extension.
+	Sensitive bool `form:"sensitive"`
 }
 
 // AttachmentUpdateRequest models an update request for an attachment.
@@ -47,6 +50,9 @@ type AttachmentUpdateRequest struct {
 	// If present, it should be in the form of two comma-separated floats between -1 and 1.
 	// allowEmptyValue: true
 	Focus *string `form:"focus" json:"focus" xml:"focus"`
+	// Mark the media file as sensitive, or not.
+	// This is a GoToSocial extension.
+	Sensitive *bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
 }
 
 // Attachment models a media attachment.
@@ -90,12 +96,11 @@ type Attachment struct {
 	// A hash computed by the BlurHash algorithm, for generating colorful preview thumbnails when media has not been downloaded yet.
 	// See https://github.com/woltapp/blurhash
 	Blurhash *string `json:"blurhash"`
-
-	// Additional fields not exposed via JSON
-	// (used only internally for templating etc).
-
-	// Parent status of this media is sensitive.
-	Sensitive bool `json:"-"`
+	// This attachment should be hidden behind a sensitive media warning,
+	// regardless of whether the status it's attached to is sensitive.
+	// Only set if true. This is a GoToSocial extension.
+	// example: true
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // MediaMeta models media metadata.
@@ -25,4 +25,8 @@ type Context struct {
 	Ancestors []Status `json:"ancestors"`
 	// Children in the thread.
 	Descendants []Status `json:"descendants"`
+	// Ancestors and/or descendants were cut
+	// off because the thread was deeper than
+	// the requested (or allowed) max depth.
+	Truncated bool `json:"truncated"`
 }
@@ -55,12 +55,52 @@ type DomainPermission struct {
 	// If applicable, the ID of the subscription that caused this domain permission entry to be created.
 	// example: 01FBW25TF5J67JW3HFHZCSD23K
 	SubscriptionID string `json:"subscription_id,omitempty"`
+	// Severity of this domain block: "suspend" or "limit". Only set for domain blocks.
+	// example: suspend
+	Severity string `json:"severity,omitempty"`
+	// If this domain block was applied from a shared block list, the URL of the list.
+	// example: https://example.org/blocklist.json
+	BlockListURL string `json:"block_list_url,omitempty"`
+	// Whether this domain block is pinned, so that updates to its block list can't change it.
+	// Only set for domain blocks.
+	// example: false
+	Pinned *bool `json:"pinned,omitempty"`
 	// ID of the account that created this domain permission entry.
 	// example: 01FBW2758ZB6PBR200YPDDJK4C
 	CreatedBy string `json:"created_by,omitempty"`
 	// Time at which the permission entry was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at,omitempty"`
+	// Number of accounts from the domain processed so far by side effects of this domain block.
+	// Only set for domain blocks.
+	// example: 120
+	// readonly: true
+	Processed *int `json:"processed,omitempty"`
+	// Total number of accounts from the domain to be processed by side effects of this domain block.
+	// Processed will equal total once side effects are complete. Only set for domain blocks.
+	// example: 480
+	// readonly: true
+	Total *int `json:"total,omitempty"`
+	// Number of known accounts from the domain at the time this domain block was created.
+	// Only set in the response to domain block creation.
+	// example: 480
+	// readonly: true
+	AffectedAccountsCount *int `json:"affected_accounts_count,omitempty"`
+}
+
+// DomainBlockAccount represents one account affected by a domain block,
+// along with how many local accounts it has follow relationships with.
+//
+// swagger:model domainBlockAccount
+type DomainBlockAccount struct {
+	// Admin view of the affected account.
+	Account *AdminAccountInfo `json:"account"`
+	// Number of local accounts following this account.
+	// example: 3
+	LocalFollowersCount int `json:"local_followers_count"`
+	// Number of local accounts this account follows.
+	// example: 1
+	LocalFollowingCount int `json:"local_following_count"`
 }
 
 // DomainPermissionRequest is the form submitted as a POST to create a new domain permission entry (allow/block).
@@ -85,6 +125,46 @@ type DomainPermissionRequest struct {
 	// Will be visible to requesters at /api/v1/instance/peers if this endpoint is exposed.
 	// example: foss dorks 😫
 	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+	// Severity of a domain block: "suspend" (default) or "limit" ("silence" is accepted as an alias).
+	// Not used for domain allows.
+	// example: limit
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+}
+
+// DomainBlocksImportRequest is the form submitted as a POST to /api/v1/admin/domain_blocks/import.
+//
+// swagger:ignore
+type DomainBlocksImportRequest struct {
+	// CSV file of domain blocks to import.
+	Domains *multipart.FileHeader `form:"domains" json:"domains" xml:"domains"`
+}
+
+// DomainBlockImportProgress reports the result of importing one
+// row of a domain blocks CSV file. One of these is streamed back
+// as a line of NDJSON for each row, as the import progresses.
+//
+// swagger:model domainBlockImportProgress
+type DomainBlockImportProgress struct {
+	// Line of the CSV file this row was read from.
+	// example: 2
+	Line int `json:"line"`
+	// Domain given in this row.
+	// example: example.org
+	Domain string `json:"domain"`
+	// HTTP status code of the result of importing this row.
+	// example: 200
+	Status int `json:"status"`
+	// Message/error message for this row.
+	// example: OK
+	Message string `json:"message"`
+	// The created or updated domain block, if successful.
+	DomainBlock *DomainPermission `json:"domain_block,omitempty"`
+	// Number of rows processed so far, including this one.
+	// example: 1
+	Processed int `json:"processed"`
+	// Total number of rows to process.
+	// example: 120
+	Total int `json:"total"`
 }
 
 // DomainKeysExpireRequest is the form submitted as a POST to /api/v1/admin/domain_keys_expire to expire a domain's public keys.
@@ -70,6 +70,31 @@ type EmojiUpdateRequest struct {
 	CategoryName *string `form:"category"`
 }
 
+// EmojiEditRequest represents a request to edit a local custom emoji, made through the admin API.
+//
+// swagger:ignore
+type EmojiEditRequest struct {
+	// New shortcode for the emoji, without surrounding colons. This must be unique for the domain.
+	// example: blobcat_uwu
+	Shortcode *string `form:"shortcode"`
+	// New image file to use for the emoji.
+	// Must be png, gif or webp, no larger than 50kb, and no larger than 128x128px.
+	Image *multipart.FileHeader `form:"image"`
+	// Category in which to place the emoji.
+	CategoryName *string `form:"category"`
+}
+
+// EmojiCopyRequest represents a request to copy a custom emoji to a new local shortcode, made through the admin API.
+//
+// swagger:ignore
+type EmojiCopyRequest struct {
+	// Shortcode for the new emoji, without surrounding colons. This must be unique for the domain.
+	// example: blobcat_uwu_dark
+	Shortcode string `form:"shortcode" validation:"required"`
+	// Category in which to place the new emoji. Will be uncategorized by default.
+	CategoryName string `form:"category"`
+}
+
 // EmojiUpdateType models an admin update action to take on a custom emoji.
 type EmojiUpdateType string
 
@@ -25,4 +25,16 @@ type EmojiCategory struct {
 	ID string `json:"id"`
 	// The name of the custom emoji category.
 	Name string `json:"name"`
+	// Custom emojis in this category, sorted by shortcode.
+	// Only set when emojis are returned grouped by category.
+	Emojis []Emoji `json:"emojis,omitempty"`
+}
+
+// EmojiCategoryUpdateRequest models a request
+// to rename a custom emoji category.
+//
+// swagger:ignore
+type EmojiCategoryUpdateRequest struct {
+	// New name for the category.
+	Name string `form:"name" json:"name"`
 }
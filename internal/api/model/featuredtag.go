@@ -28,5 +28,6 @@ type FeaturedTag struct {
 	// The number of authored statuses containing this hashtag.
 	StatusesCount int `json:"statuses_count"`
 	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Datetime)
-	LastStatusAt string `json:"last_status_at"`
+	// Will be null if no authored status contains this hashtag.
+	LastStatusAt *string `json:"last_status_at"`
 }
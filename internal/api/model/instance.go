@@ -43,6 +43,17 @@ type InstanceSettingsUpdateRequest struct {
 	Header *multipart.FileHeader `form:"header" json:"header" xml:"header"`
 }
 
+// InstanceConfiguration models the configured limits
+// of an instance that are common to both the v1 and v2
+// instance models.
+type InstanceConfiguration struct {
+	Statuses         InstanceConfigurationStatuses
+	MediaAttachments InstanceConfigurationMediaAttachments
+	Polls            InstanceConfigurationPolls
+	Accounts         InstanceConfigurationAccounts
+	Emojis           InstanceConfigurationEmojis
+}
+
 // InstanceConfigurationAccounts models instance account config parameters.
 //
 // swagger:model instanceConfigurationAccounts
@@ -115,6 +126,21 @@ type InstanceConfigurationMediaAttachments struct {
 	//
 	// example: 16777216
 	VideoMatrixLimit int `json:"video_matrix_limit"`
+	// Max allowed length of a media description (alt text), in characters.
+	//
+	// example: 1500
+	DescriptionLimit int `json:"description_limit"`
+	// Min required length of a media description (alt text), in characters.
+	//
+	// Only checked when updating media, or attaching it to a status.
+	//
+	// example: 0
+	DescriptionMinLimit int `json:"description_min_limit"`
+	// Whether new statuses created on this instance
+	// must have a description for every attachment.
+	//
+	// example: false
+	DescriptionRequired bool `json:"description_required"`
 }
 
 // InstanceConfigurationPolls models instance poll config parameters.
@@ -146,3 +172,17 @@ type InstanceConfigurationEmojis struct {
 	// example: 51200
 	EmojiSizeLimit int `json:"emoji_size_limit"`
 }
+
+// ExtendedDescription models an instance's extended
+// description, as shown on its about page.
+//
+// swagger:model extendedDescription
+type ExtendedDescription struct {
+	// Time when the extended description was last updated (ISO 8601 Datetime).
+	//
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// HTML content of the extended description.
+	// Empty if the instance doesn't have one.
+	Content string `json:"content"`
+}
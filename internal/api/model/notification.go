@@ -44,6 +44,21 @@ type Notification struct {
 
 	// Status that was the object of the notification, e.g. in mentions, reblogs, favourites, or polls.
 	Status *Status `json:"status,omitempty"`
+	// Notification has been dismissed. Dismissed notifications
+	// are only shown when requested with `include_dismissed`.
+	// Key will not be present on undismissed notifications.
+	Dismissed bool `json:"dismissed,omitempty"`
+}
+
+// NotificationsUnreadCount is the number of unread
+// notifications for the requesting account.
+//
+// swagger:model notificationsUnreadCount
+type NotificationsUnreadCount struct {
+	// Number of notifications newer than the
+	// notifications marker, up to the limit.
+	// example: 5
+	Count int `json:"count"`
 }
 
 /*
@@ -24,4 +24,8 @@ type PageableResponse struct {
 	LinkHeader string
 	NextLink   string
 	PrevLink   string
+
+	// Note is an optional notice about the items
+	// for the client, returned in the X-Note header.
+	Note string
 }
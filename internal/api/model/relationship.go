@@ -50,4 +50,7 @@ type Relationship struct {
 	Endorsed bool `json:"endorsed"`
 	// Your note on this account.
 	Note string `json:"note"`
+	// Which languages you are following from this account.
+	// Null if you are following posts in all languages.
+	Languages []string `json:"languages"`
 }
@@ -58,6 +58,10 @@ type Report struct {
 	RuleIDs []string `json:"rule_ids"`
 	// Account that was reported.
 	TargetAccount *Account `json:"target_account"`
+	// Array of statuses that were submitted along with this report.
+	// Will be empty if no status IDs were submitted, or if the
+	// submitted statuses have since been deleted.
+	Statuses []*Status `json:"statuses"`
 }
 
 // ReportCreateRequest models user report creation parameters.
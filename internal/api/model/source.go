@@ -38,6 +38,14 @@ type Source struct {
 	Fields []Field `json:"fields"`
 	// The number of pending follow requests.
 	FollowRequestsCount int `json:"follow_requests_count"`
+	// Total size in bytes of media uploaded by this
+	// account, which counts towards its media quota.
+	// example: 1048576
+	MediaUsedBytes int64 `json:"media_used_bytes"`
+	// Max total size in bytes of media this account
+	// may upload. 0 means there is no limit.
+	// example: 1073741824
+	MediaQuotaBytes int64 `json:"media_quota_bytes"`
 	// This account is aliased to / also known as accounts at the
 	// given ActivityPub URIs. To set this, use `/api/v1/accounts/alias`.
 	//
@@ -75,6 +75,10 @@ type Status struct {
 	// The content of this status. Should be HTML, but might also be plaintext in some cases.
 	// example: <p>Hey this is a status!</p>
 	Content string `json:"content"`
+	// Number of words in the content of this status, with all formatting stripped.
+	// Useful for estimating reading time of long-form content.
+	// example: 4
+	WordCount int `json:"word_count"`
 	// The status that this status reblogs/boosts.
 	// nullable: true
 	Reblog *StatusReblogged `json:"reblog"`
@@ -102,6 +106,8 @@ type Status struct {
 	Text string `json:"text,omitempty"`
 	// A list of filters that matched this status and why they matched, if there are any such filters.
 	Filtered []FilterResult `json:"filtered,omitempty"`
+	// The status quoted by this status, if this is a quote post.
+	Quote *Quote `json:"quote,omitempty"`
 
 	// Additional fields not exposed via JSON
 	// (used only internally for templating etc).
@@ -156,6 +162,24 @@ func (s *Status) GetBoostOfAccountID() string {
 	return ""
 }
 
+// Quote represents the quoting of one status
+// by another status, ie., a quote post.
+//
+// swagger:model quote
+type Quote struct {
+	// Whether the author of the quoted status has approved the quote.
+	// enum:
+	// - pending
+	// - accepted
+	// - rejected
+	State string `json:"state"`
+	// The quoted status. Only set if the quote has been accepted, and
+	// the quoted status still exists and is visible to the requester;
+	// otherwise clients should show a placeholder instead.
+	// nullable: true
+	QuotedStatus *Status `json:"quoted_status"`
+}
+
 // StatusReblogged represents a reblogged status.
 //
 // swagger:model statusReblogged
@@ -179,7 +203,8 @@ type StatusCreateRequest struct {
 	// ID of the status being replied to, if status is a reply.
 	InReplyToID string `form:"in_reply_to_id" json:"in_reply_to_id" xml:"in_reply_to_id"`
 	// Status and attached media should be marked as sensitive.
-	Sensitive bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
+	// If not set, the account's default sensitive setting will be used.
+	Sensitive *bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
 	// Text to be shown as a warning or subject before the actual content.
 	// Statuses are generally collapsed behind this field.
 	SpoilerText string `form:"spoiler_text" json:"spoiler_text" xml:"spoiler_text"`
@@ -294,4 +319,9 @@ type StatusEdit struct {
 	MediaAttachments []*Attachment `json:"media_attachments"`
 	// Custom emoji to be used when rendering status content.
 	Emojis []Emoji `json:"emojis"`
+	// Media attachments of the status were changed by an edit,
+	// ie., attachments were added, removed, reordered, or had
+	// their description changed.
+	// example: false
+	MediaEdited bool `json:"media_edited"`
 }
@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusPreview represents how a status would render
+// if it were created from the given form, without it
+// actually being created.
+//
+// swagger:model statusPreview
+type StatusPreview struct {
+	// The rendered HTML content of the status.
+	// example: <p>Hello <span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span>!</p>
+	Content string `json:"content"`
+	// The rendered content warning of the status.
+	SpoilerText string `json:"spoiler_text"`
+	// Accounts that would be mentioned in the status.
+	Mentions []Mention `json:"mentions"`
+	// Hashtags used in the status.
+	Tags []Tag `json:"tags"`
+	// Custom emoji used in the status.
+	Emojis []Emoji `json:"emojis"`
+	// Mentions in the status text which could not be
+	// resolved to an account, and so would be left as
+	// plain text. Given as they were written, eg., `@someone@example.org`.
+	UnresolvedMentions []string `json:"unresolved_mentions"`
+	// Number of characters in the status text and content warning
+	// combined, as counted against the status character limit.
+	// example: 42
+	CharacterCount int `json:"character_count"`
+	// Max number of characters permitted in a status by the requesting account.
+	// example: 5000
+	CharacterLimit int `json:"character_limit"`
+}
@@ -24,7 +24,7 @@ type Token struct {
 	// Access token used for authorization.
 	AccessToken string `json:"access_token"`
 	// OAuth token type. Will always be 'Bearer'.
-	// example: bearer
+	// example: Bearer
 	TokenType string `json:"token_type"`
 	// OAuth scopes granted by this token, space-separated.
 	// example: read write admin
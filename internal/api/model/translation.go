@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents the translation of a status.
+//
+// swagger:model translation
+type Translation struct {
+	// The translated text of the status (HTML), equivalent to status.content.
+	// example: <p>Hola mundo</p>
+	Content string `json:"content"`
+	// The translated spoiler text of the status, equivalent to status.spoiler_text.
+	// example: Esto es una prueba
+	SpoilerText string `json:"spoiler_text"`
+	// The translated poll of the status, if it has one.
+	Poll *TranslationPoll `json:"poll,omitempty"`
+	// The translated media descriptions of the status.
+	MediaAttachments []TranslationAttachment `json:"media_attachments"`
+	// The language of the source text, as auto-detected by the translation provider. (ISO 639 language code)
+	// example: en
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	// The service that provided the translation.
+	// example: LibreTranslate
+	Provider string `json:"provider"`
+}
+
+// TranslationPoll represents the translation of a poll.
+//
+// swagger:model translationPoll
+type TranslationPoll struct {
+	// The ID of the poll.
+	// example: 01FBYKMD1KBMJ0W6JF1YZ3VY5D
+	ID string `json:"id"`
+	// The translated options of the poll.
+	Options []TranslationPollOption `json:"options"`
+}
+
+// TranslationPollOption represents the translation of a poll option.
+//
+// swagger:model translationPollOption
+type TranslationPollOption struct {
+	// The translated title of the poll option.
+	// example: Sí
+	Title string `json:"title"`
+}
+
+// TranslationAttachment represents the translation of a media attachment's description.
+//
+// swagger:model translationAttachment
+type TranslationAttachment struct {
+	// The ID of the media attachment.
+	// example: 01FBYKMD1KBMJ0W6JF1YZ3VY5D
+	ID string `json:"id"`
+	// The translated description of the media attachment.
+	// example: Un gato durmiendo
+	Description string `json:"description"`
+}
@@ -95,3 +95,48 @@ type EmailChangeRequest struct {
 	// required: true
 	NewEmail string `form:"new_email" json:"new_email" xml:"new_email" validation:"required"`
 }
+
+// StatusRetention models the status retention settings of a user,
+// ie., automatic deletion of their own statuses after a given age.
+//
+// swagger:model statusRetention
+type StatusRetention struct {
+	// Delete own statuses older than this many days.
+	// 0 means status retention is disabled for this user.
+	// example: 180
+	Days int `json:"days"`
+	// Never delete own statuses that are pinned.
+	// example: true
+	KeepPinned bool `json:"keep_pinned"`
+	// Never delete own statuses that the user has bookmarked.
+	// example: true
+	KeepBookmarked bool `json:"keep_bookmarked"`
+	// Never delete own statuses favourited more than this many times.
+	// 0 means statuses are deleted regardless of favourites.
+	// example: 10
+	KeepFavesAbove int `json:"keep_faves_above"`
+}
+
+// StatusRetentionUpdateRequest models status retention update parameters.
+//
+// swagger:parameters userStatusRetentionUpdate
+type StatusRetentionUpdateRequest struct {
+	// Delete own statuses older than this many days.
+	// Set to 0 to disable status retention.
+	//
+	// in: formData
+	Days *int `form:"days" json:"days" xml:"days"`
+	// Never delete own statuses that are pinned.
+	//
+	// in: formData
+	KeepPinned *bool `form:"keep_pinned" json:"keep_pinned" xml:"keep_pinned"`
+	// Never delete own statuses that the user has bookmarked.
+	//
+	// in: formData
+	KeepBookmarked *bool `form:"keep_bookmarked" json:"keep_bookmarked" xml:"keep_bookmarked"`
+	// Never delete own statuses favourited more than this many times.
+	// Set to 0 to delete statuses regardless of favourites.
+	//
+	// in: formData
+	KeepFavesAbove *int `form:"keep_faves_above" json:"keep_faves_above" xml:"keep_faves_above"`
+}
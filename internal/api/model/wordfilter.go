@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// WordFilter represents an instance-level rule matching statuses
+// coming in over federation by keyword or regular expression.
+//
+// swagger:model wordFilter
+type WordFilter struct {
+	// The ID of the word filter.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// The keyword or regular expression to match.
+	// example: casino
+	Pattern string `json:"pattern"`
+
+	// Whether pattern is a regular expression. If not,
+	// pattern is matched as a case-insensitive keyword.
+	// example: false
+	Regex bool `json:"regex"`
+
+	// The part of incoming statuses to match: content, links, or display_name.
+	// example: content
+	Target string `json:"target"`
+
+	// What to do with matching statuses: drop, reject, or sensitive.
+	// example: drop
+	Action string `json:"action"`
+
+	// Comment on this word filter, visible to admins.
+	// example: casino spam wave
+	Comment string `json:"comment"`
+
+	// Number of statuses this word filter has matched.
+	// example: 12
+	// readonly: true
+	MatchesCount int `json:"matches_count"`
+
+	// Time at which this word filter last matched a status (ISO 8601 Datetime).
+	// Not set if the filter never matched.
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	LastMatchedAt string `json:"last_matched_at,omitempty"`
+
+	// The ID of the admin account that created this word filter.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the word filter was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+}
+
+// WordFilterRequest is the form submitted as a POST to create a new word filter.
+//
+// swagger:parameters wordFilterCreate
+type WordFilterRequest struct {
+	// The keyword or regular expression to match.
+	// required: true
+	// in: formData
+	Pattern string `form:"pattern" json:"pattern" xml:"pattern"`
+
+	// Whether pattern is a regular expression. If not,
+	// pattern is matched as a case-insensitive keyword.
+	// in: formData
+	Regex bool `form:"regex" json:"regex" xml:"regex"`
+
+	// The part of incoming statuses to match: content, links, or display_name.
+	// required: true
+	// in: formData
+	Target string `form:"target" json:"target" xml:"target"`
+
+	// What to do with matching statuses: drop, reject, or sensitive.
+	// required: true
+	// in: formData
+	Action string `form:"action" json:"action" xml:"action"`
+
+	// Comment on this word filter, visible to admins.
+	// in: formData
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+}
@@ -80,9 +80,17 @@ func genericErrorHandler(c *gin.Context, instanceGet func(ctx context.Context) (
 			gtscontext.RequestID(ctx),
 		)
 	default:
-		JSON(c, errWithCode.Code(), map[string]string{
+		obj := map[string]string{
 			"error": errWithCode.Safe(),
-		})
+		}
+
+		// Include a machine-readable
+		// error code if one was set.
+		if errType := gtserror.Type(errWithCode); errType != "" {
+			obj["error_code"] = string(errType)
+		}
+
+		JSON(c, errWithCode.Code(), obj)
 	}
 }
 
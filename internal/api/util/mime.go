@@ -30,11 +30,13 @@ const (
 	appActivityLDJSON = `application/ld+json` // without profile
 	AppActivityLDJSON = appActivityLDJSON + `; profile="https://www.w3.org/ns/activitystreams"`
 	AppJRDJSON        = `application/jrd+json` // https://www.rfc-editor.org/rfc/rfc7033#section-10.2
+	AppNDJSON         = `application/x-ndjson` // newline-delimited JSON, for streamed responses
 	AppForm           = `application/x-www-form-urlencoded`
 	MultipartForm     = `multipart/form-data`
 	TextXML           = `text/xml`
 	TextHTML          = `text/html`
 	TextCSS           = `text/css`
+	TextCSV           = `text/csv`
 )
 
 // JSONContentType returns whether is application/json(;charset=utf-8)? content-type.
@@ -73,6 +75,14 @@ func XMLXRDContentType(ct string) bool {
 		p[0] == AppXMLXRD
 }
 
+// HTMLContentType returns whether is text/html(;charset=utf-8)? content-type.
+func HTMLContentType(ct string) bool {
+	p := splitContentType(ct)
+	p, ok := isUTF8ContentType(p)
+	return ok && len(p) == 1 &&
+		p[0] == TextHTML
+}
+
 // ASContentType returns whether is valid ActivityStreams content-types:
 // - application/activity+json
 // - application/ld+json;profile=https://w3.org/ns/activitystreams
@@ -73,3 +73,35 @@ func TestIsASContentType(t *testing.T) {
 		}
 	}
 }
+
+func TestIsHTMLContentType(t *testing.T) {
+	for _, test := range []struct {
+		Input  string
+		Expect bool
+	}{
+		{
+			Input:  "text/html",
+			Expect: true,
+		},
+		{
+			Input:  "text/html; charset=utf-8",
+			Expect: true,
+		},
+		{
+			Input:  "TEXT/HTML;charset=UTF-8",
+			Expect: true,
+		},
+		{
+			Input:  "text/html; charset=iso-8859-1",
+			Expect: false,
+		},
+		{
+			Input:  "application/activity+json",
+			Expect: false,
+		},
+	} {
+		if util.HTMLContentType(test.Input) != test.Expect {
+			t.Errorf("did not get expected result %v for input: %s", test.Expect, test.Input)
+		}
+	}
+}
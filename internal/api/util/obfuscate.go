@@ -0,0 +1,295 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/oklog/ulid"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+// idCipher caches the block cipher used
+// to obfuscate IDs, along with the secret
+// it was derived from (in case of change).
+var idCipher struct {
+	secret string
+	block  cipher.Block
+	mu     sync.Mutex
+}
+
+// idBlock returns the block cipher for
+// obfuscating IDs, derived from the
+// configured instance-obfuscate-ids-secret.
+func idBlock() cipher.Block {
+	secret := config.GetInstanceObfuscateIDsSecret()
+
+	idCipher.mu.Lock()
+	defer idCipher.mu.Unlock()
+
+	if idCipher.block == nil || idCipher.secret != secret {
+		// (Re)derive 256-bit AES key from secret.
+		key := sha256.Sum256([]byte(secret))
+
+		block, err := aes.NewCipher(key[:])
+		if err != nil {
+			// Only possible with
+			// bad key size, i.e. never.
+			panic(err)
+		}
+
+		idCipher.secret = secret
+		idCipher.block = block
+	}
+
+	return idCipher.block
+}
+
+// ObfuscateID maps an internal ULID to its external, obfuscated form.
+//
+// A ULID is exactly one (128-bit) AES block, so encrypting it with a
+// key derived from instance-obfuscate-ids-secret gives a stable,
+// reversible mapping to another valid ULID, without the original's
+// timestamp ordering. Values that aren't ULIDs are returned as-is.
+func ObfuscateID(id string) string {
+	in, err := ulid.ParseStrict(id)
+	if err != nil {
+		return id
+	}
+
+	var out ulid.ULID
+	idBlock().Encrypt(out[:], in[:])
+	return out.String()
+}
+
+// DeobfuscateID maps an external, obfuscated ID
+// back to its internal ULID, i.e. the reverse of
+// ObfuscateID(). Values that aren't ULIDs are
+// returned as-is.
+func DeobfuscateID(id string) string {
+	in, err := ulid.ParseStrict(id)
+	if err != nil {
+		return id
+	}
+
+	var out ulid.ULID
+	idBlock().Decrypt(out[:], in[:])
+	return out.String()
+}
+
+// IsIDKey returns whether the given JSON field,
+// form field or query parameter name is one that
+// contains ID(s), i.e. "id", "*_id" or "*_ids",
+// optionally with a trailing "[]" for arrays, or
+// nested in a form field like "home[last_read_id]".
+func IsIDKey(key string) bool {
+	key = strings.TrimSuffix(key, "[]")
+	if i := strings.LastIndexByte(key, '['); i >= 0 &&
+		strings.HasSuffix(key, "]") {
+		key = key[i+1 : len(key)-1]
+	}
+
+	switch {
+	case key == "client_id":
+		// OAuth client IDs are passed back to
+		// the (un-obfuscated) OAuth endpoints.
+		return false
+	case key == IDKey:
+		return true
+	default:
+		return strings.HasSuffix(key, "_id") ||
+			strings.HasSuffix(key, "_ids")
+	}
+}
+
+// ObfuscateIDsJSON obfuscates IDs in the given JSON
+// document, using ObfuscateID() on the values of all
+// object fields for which IsIDKey() returns true.
+//
+// IDs are rewritten in-place in b, which is returned,
+// leaving the rest of the document untouched.
+func ObfuscateIDsJSON(b []byte) ([]byte, error) {
+	return transformIDsJSON(b, ObfuscateID)
+}
+
+// DeobfuscateIDsJSON is the reverse of ObfuscateIDsJSON().
+func DeobfuscateIDsJSON(b []byte) ([]byte, error) {
+	return transformIDsJSON(b, DeobfuscateID)
+}
+
+// DeobfuscateIDsValues deobfuscates ID values in the given
+// URL query or form values, in-place, for keys with IsIDKey().
+func DeobfuscateIDsValues(values url.Values) {
+	for key, vals := range values {
+		if !IsIDKey(key) {
+			continue
+		}
+		for i := range vals {
+			vals[i] = DeobfuscateID(vals[i])
+		}
+	}
+}
+
+// linkURLRegex matches URLs in a Link header.
+var linkURLRegex = regexp.MustCompile(`<[^>]*>`)
+
+// ObfuscateIDsLink obfuscates IDs in URLs of the given Link header
+// value, in both path segments and in query parameters with IsIDKey().
+func ObfuscateIDsLink(link string) string {
+	return linkURLRegex.ReplaceAllStringFunc(link, func(s string) string {
+		u, err := url.Parse(s[1 : len(s)-1])
+		if err != nil {
+			return s
+		}
+
+		// Obfuscate any ID path segments.
+		segments := strings.Split(u.Path, "/")
+		for i := range segments {
+			segments[i] = ObfuscateID(segments[i])
+		}
+		u.Path = strings.Join(segments, "/")
+
+		// Obfuscate any ID query params.
+		query := u.Query()
+		for key, vals := range query {
+			if !IsIDKey(key) {
+				continue
+			}
+			for i := range vals {
+				vals[i] = ObfuscateID(vals[i])
+			}
+		}
+		u.RawQuery = query.Encode()
+
+		return "<" + u.String() + ">"
+	})
+}
+
+// jsonFrame is the state of an object
+// or array being walked by transformIDsJSON.
+type jsonFrame struct {
+	object bool // object, else array
+
+	// for objects, whether the next token is a
+	// key, and whether the last key was an ID key.
+	expectKey bool
+	idKey     bool
+
+	// for arrays, whether it is
+	// the value of an ID key.
+	ids bool
+}
+
+// transformIDsJSON walks the tokens of JSON document b, replacing
+// ID values (and ID array elements) of fields with IsIDKey() with
+// the result of transform. Obfuscated IDs are always the same length
+// as the original, so these are replaced in-place in b, without
+// touching the rest of the document (e.g. the order of fields).
+func transformIDsJSON(b []byte, transform func(string) string) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	// Don't mangle numbers into floats.
+	dec.UseNumber()
+
+	var stack []jsonFrame
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		var top *jsonFrame
+		if len(stack) > 0 {
+			top = &stack[len(stack)-1]
+		}
+
+		switch tok := tok.(type) {
+		case json.Delim:
+			switch tok {
+			case '{', '[':
+				var ids bool
+				if top != nil && top.object {
+					// Container is the field value.
+					ids = (tok == '[' && top.idKey)
+					top.expectKey = true
+				}
+				stack = append(stack, jsonFrame{
+					object:    (tok == '{'),
+					expectKey: (tok == '{'),
+					ids:       ids,
+				})
+
+			case '}', ']':
+				stack = stack[:len(stack)-1]
+			}
+
+		case string:
+			switch {
+			case top == nil:
+				// Top-level value.
+
+			case top.object && top.expectKey:
+				top.idKey = IsIDKey(tok)
+				top.expectKey = false
+
+			case top.object:
+				if top.idKey {
+					replaceID(b, dec.InputOffset(), tok, transform)
+				}
+				top.expectKey = true
+
+			case top.ids:
+				replaceID(b, dec.InputOffset(), tok, transform)
+			}
+
+		default:
+			if top != nil && top.object {
+				// Non-string field value.
+				top.expectKey = true
+			}
+		}
+	}
+
+	return b, nil
+}
+
+// replaceID replaces the ID string token ending at offset end
+// in b with transform(id), if it is the same length, and the
+// token is not written with any escape sequences.
+func replaceID(b []byte, end int64, id string, transform func(string) string) {
+	start := end - int64(len(id)) - 2
+	if start < 0 || string(b[start:end]) != `"`+id+`"` {
+		return
+	}
+
+	if out := transform(id); out != id && len(out) == len(id) {
+		copy(b[start+1:end-1], out)
+	}
+}
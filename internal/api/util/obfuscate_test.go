@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+func TestObfuscateID(t *testing.T) {
+	config.SetInstanceObfuscateIDsSecret("some secret")
+
+	const id = "01F8MH75CBF9JFX4ZAD54N0W0R"
+
+	obfuscated := util.ObfuscateID(id)
+	if obfuscated == id || len(obfuscated) != len(id) {
+		t.Fatalf("unexpected obfuscated id %s", obfuscated)
+	}
+
+	if again := util.ObfuscateID(id); again != obfuscated {
+		t.Fatalf("obfuscated id not stable: %s != %s", again, obfuscated)
+	}
+
+	if deobfuscated := util.DeobfuscateID(obfuscated); deobfuscated != id {
+		t.Fatalf("expected %s, got %s", id, deobfuscated)
+	}
+
+	// Non-ULIDs should be untouched.
+	for _, in := range []string{"", "zork", "ZZZZZZZZZZZZZZZZZZZZZZZZZZ"} {
+		if out := util.ObfuscateID(in); out != in {
+			t.Errorf("expected %q to be untouched, got %q", in, out)
+		}
+	}
+
+	// Different secret, different ID.
+	config.SetInstanceObfuscateIDsSecret("some other secret")
+	if other := util.ObfuscateID(id); other == obfuscated {
+		t.Fatalf("expected different obfuscated id for different secret")
+	}
+}
+
+func TestIsIDKey(t *testing.T) {
+	for key, expect := range map[string]bool{
+		"id":                         true,
+		"id[]":                       true,
+		"in_reply_to_id":             true,
+		"media_ids[]":                true,
+		"home[last_read_id]":         true,
+		"keywords_attributes[][id]":  true,
+		"client_id":                  false,
+		"username":                   false,
+		"keywords_attributes[][key]": false,
+	} {
+		if isIDKey := util.IsIDKey(key); isIDKey != expect {
+			t.Errorf("%s: expected %t, got %t", key, expect, isIDKey)
+		}
+	}
+}
+
+func TestObfuscateIDsJSON(t *testing.T) {
+	config.SetInstanceObfuscateIDsSecret("some secret")
+
+	var (
+		statusID  = "01F8MH75CBF9JFX4ZAD54N0W0R"
+		accountID = "01F8MH1H7YV1Z7D2C8K2730QBF"
+		obfStatus = util.ObfuscateID(statusID)
+		obfAcct   = util.ObfuscateID(accountID)
+	)
+
+	in := `{"id":"` + statusID + `","account":{"id":"` + accountID + `","username":"the_mighty_zork"},"in_reply_to_account_id":"` + accountID + `","media_ids":["` + statusID + `"],"replies_count":1}`
+	expect := `{"id":"` + obfStatus + `","account":{"id":"` + obfAcct + `","username":"the_mighty_zork"},"in_reply_to_account_id":"` + obfAcct + `","media_ids":["` + obfStatus + `"],"replies_count":1}`
+
+	// Field order and formatting should be kept as-is.
+	out, err := util.ObfuscateIDsJSON([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expect {
+		t.Fatalf("expected %s, got %s", expect, out)
+	}
+
+	back, err := util.DeobfuscateIDsJSON(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(back) != in {
+		t.Fatalf("expected %s, got %s", in, back)
+	}
+
+	// Nested arrays of objects, with whitespace.
+	in = "[\n  {\"id\": \"" + statusID + "\", \"tags\": [\"" + accountID + "\"]}\n]"
+	expect = "[\n  {\"id\": \"" + obfStatus + "\", \"tags\": [\"" + accountID + "\"]}\n]"
+
+	out, err = util.ObfuscateIDsJSON([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expect {
+		t.Fatalf("expected %s, got %s", expect, out)
+	}
+}
+
+func TestObfuscateIDsLink(t *testing.T) {
+	config.SetInstanceObfuscateIDsSecret("some secret")
+
+	var (
+		accountID = "01H11KA68PM4NNYJEG0FJQ90R3"
+		maxID     = "01H11KA1DM2VH3747YDE7FV5HN"
+		minID     = "01H11KBBVRRDYYC5KEPME1NP5R"
+	)
+
+	in := `<https://example.org/api/v1/accounts/` + accountID + `/statuses?limit=10&max_id=` + maxID + `>; rel="next", ` +
+		`<https://example.org/api/v1/accounts/` + accountID + `/statuses?limit=10&min_id=` + minID + `>; rel="prev"`
+	expect := `<https://example.org/api/v1/accounts/` + util.ObfuscateID(accountID) + `/statuses?limit=10&max_id=` + util.ObfuscateID(maxID) + `>; rel="next", ` +
+		`<https://example.org/api/v1/accounts/` + util.ObfuscateID(accountID) + `/statuses?limit=10&min_id=` + util.ObfuscateID(minID) + `>; rel="prev"`
+
+	if out := util.ObfuscateIDsLink(in); out != expect {
+		t.Fatalf("expected %s, got %s", expect, out)
+	}
+}
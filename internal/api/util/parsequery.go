@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
@@ -43,6 +44,7 @@ const (
 	UsernameKey        = "username"
 	AccountIDKey       = "account_id"
 	TargetAccountIDKey = "target_account_id"
+	StatusIDKey        = "status_id"
 	ResolvedKey        = "resolved"
 
 	/* AP endpoint keys */
@@ -59,38 +61,70 @@ const (
 	SearchResolveKey           = "resolve"
 	SearchTypeKey              = "type"
 
+	/* Directory keys */
+
+	DirectoryOffsetKey = "offset"
+	DirectoryOrderKey  = "order"
+
 	/* Tag keys */
 
-	TagNameKey = "tag_name"
+	TagNameKey         = "tag_name"
+	TagTimelineAnyKey  = "any[]"
+	TagTimelineAllKey  = "all[]"
+	TagTimelineNoneKey = "none[]"
+
+	/* Emoji keys */
+
+	EmojiCategoryNameKey     = "category_name"
+	EmojiImportDomainKey     = "domain"
+	EmojiImportAllKey        = "all"
+	EmojiImportShortcodesKey = "shortcodes[]"
+	EmojiCategoryKey         = "category"
+	EmojiSearchKey           = "search"
 
 	/* Web endpoint keys */
 
 	WebStatusIDKey = "status"
 
+	/* Status context keys */
+
+	StatusContextMaxDepthAncestorsKey   = "max_depth_ancestors"
+	StatusContextMaxDepthDescendantsKey = "max_depth_descendants"
+
+	/* Notification keys */
+
+	NotificationsIncludeDismissedKey = "include_dismissed"
+
 	/* Domain permission keys */
 
 	DomainPermissionExportKey = "export"
 	DomainPermissionImportKey = "import"
+	DomainPermissionFormatKey = "format"
 
 	/* Admin query keys */
 
-	AdminRemoteKey      = "remote"
-	AdminActiveKey      = "active"
-	AdminPendingKey     = "pending"
-	AdminDisabledKey    = "disabled"
-	AdminSilencedKey    = "silenced"
-	AdminSuspendedKey   = "suspended"
-	AdminSensitizedKey  = "sensitized"
-	AdminDisplayNameKey = "display_name"
-	AdminByDomainKey    = "by_domain"
-	AdminEmailKey       = "email"
-	AdminIPKey          = "ip"
-	AdminStaffKey       = "staff"
-	AdminOriginKey      = "origin"
-	AdminStatusKey      = "status"
-	AdminPermissionsKey = "permissions"
-	AdminRoleIDsKey     = "role_ids[]"
-	AdminInvitedByKey   = "invited_by"
+	AdminRemoteKey       = "remote"
+	AdminActiveKey       = "active"
+	AdminPendingKey      = "pending"
+	AdminDisabledKey     = "disabled"
+	AdminSilencedKey     = "silenced"
+	AdminSuspendedKey    = "suspended"
+	AdminSensitizedKey   = "sensitized"
+	AdminDisplayNameKey  = "display_name"
+	AdminByDomainKey     = "by_domain"
+	AdminEmailKey        = "email"
+	AdminIPKey           = "ip"
+	AdminStaffKey        = "staff"
+	AdminOriginKey       = "origin"
+	AdminStatusKey       = "status"
+	AdminPermissionsKey  = "permissions"
+	AdminRoleIDsKey      = "role_ids[]"
+	AdminInvitedByKey    = "invited_by"
+	AdminStartAtKey      = "start_at"
+	AdminEndAtKey        = "end_at"
+	AdminWarningIDKey    = "warning_id"
+	AdminDomainKey       = "domain"
+	AdminActivityTypeKey = "activity_type"
 )
 
 /*
@@ -154,6 +188,37 @@ func ParseSearchResolve(value string, defaultValue bool) (bool, gtserror.WithCod
 	return parseBool(value, defaultValue, SearchResolveKey)
 }
 
+func ParseDirectoryOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, DirectoryOffsetKey)
+}
+
+// ParseDirectoryOrder parses the order in which profile
+// directory accounts should be returned, either "active"
+// (the default, if value is empty) or "new".
+func ParseDirectoryOrder(value string) (string, gtserror.WithCode) {
+	switch value {
+	case "", "active":
+		return "active", nil
+	case "new":
+		return value, nil
+	default:
+		err := fmt.Errorf("invalid %s %q, valid orders are [active, new]", DirectoryOrderKey, value)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+}
+
+func ParseStatusContextMaxDepthAncestors(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, StatusContextMaxDepthAncestorsKey)
+}
+
+func ParseStatusContextMaxDepthDescendants(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, StatusContextMaxDepthDescendantsKey)
+}
+
+func ParseNotificationsIncludeDismissed(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, NotificationsIncludeDismissedKey)
+}
+
 func ParseDomainPermissionExport(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, DomainPermissionExportKey)
 }
@@ -162,6 +227,25 @@ func ParseDomainPermissionImport(value string, defaultValue bool) (bool, gtserro
 	return parseBool(value, defaultValue, DomainPermissionImportKey)
 }
 
+// ParseDomainPermissionFormat parses the format in which
+// domain permissions should be returned, either "json"
+// (the default, if value is empty) or "csv".
+func ParseDomainPermissionFormat(value string) (string, gtserror.WithCode) {
+	switch value {
+	case "", "json":
+		return "json", nil
+	case "csv":
+		return value, nil
+	default:
+		err := fmt.Errorf("invalid %s %q, valid formats are [json, csv]", DomainPermissionFormatKey, value)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+}
+
+func ParseEmojiImportAll(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, EmojiImportAllKey)
+}
+
 func ParseOnlyOtherAccounts(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, OnlyOtherAccountsKey)
 }
@@ -194,6 +278,27 @@ func ParseAdminStaff(value string, defaultValue bool) (bool, gtserror.WithCode)
 	return parseBool(value, defaultValue, AdminStaffKey)
 }
 
+// ParseAdminStartAt parses the given value as an RFC3339
+// timestamp or a YYYY-MM-DD date, in which case the start
+// of that day (UTC) is returned. Empty value returns zero time.
+func ParseAdminStartAt(value string) (time.Time, gtserror.WithCode) {
+	t, _, err := parseTime(value, AdminStartAtKey)
+	return t, err
+}
+
+// ParseAdminEndAt parses the given value as an RFC3339
+// timestamp or a YYYY-MM-DD date, in which case the start
+// of the *following* day (UTC) is returned, so that the
+// whole given day is included in an exclusive range.
+// Empty value returns zero time.
+func ParseAdminEndAt(value string) (time.Time, gtserror.WithCode) {
+	t, dateOnly, err := parseTime(value, AdminEndAtKey)
+	if dateOnly {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, err
+}
+
 /*
 	Parse functions for *REQUIRED* parameters.
 */
@@ -228,6 +333,16 @@ func ParseID(value string) (string, gtserror.WithCode) {
 	return value, nil
 }
 
+func ParseEmojiImportDomain(value string) (string, gtserror.WithCode) {
+	key := EmojiImportDomainKey
+
+	if value == "" {
+		return "", requiredError(key)
+	}
+
+	return value, nil
+}
+
 func ParseSearchLookup(value string) (string, gtserror.WithCode) {
 	key := SearchLookupKey
 
@@ -327,6 +442,23 @@ func parseInt(value string, defaultValue int, max int, min int, key string) (int
 	return i, nil
 }
 
+func parseTime(value string, key string) (time.Time, bool, gtserror.WithCode) {
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, parseError(key, value, time.Time{}, err)
+	}
+
+	return t, false, nil
+}
+
 // parseError returns gtserror.WithCode set to 400 Bad Request, to indicate
 // to the caller that a key was set to a value that could not be parsed.
 func parseError(key string, value, defaultValue any, err error) gtserror.WithCode {
@@ -28,6 +28,7 @@ import (
 	"codeberg.org/gruf/go-byteutil"
 	"codeberg.org/gruf/go-fastcopy"
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
@@ -135,19 +136,32 @@ func EncodeJSONResponse(
 	enc.SetEscapeHTML(false)
 
 	// Encode JSON data into byte buffer.
-	if err := enc.Encode(data); err == nil {
+	err := enc.Encode(data)
+	body := buf.B
 
+	if err == nil {
 		// Drop new-line added by encoder.
-		if buf.B[len(buf.B)-1] == '\n' {
-			buf.B = buf.B[:len(buf.B)-1]
+		if body[len(body)-1] == '\n' {
+			body = body[:len(body)-1]
 		}
 
+		if gtscontext.ObfuscateIDs(r.Context()) {
+			// Obfuscate IDs in the response body,
+			// and in paging links to further IDs.
+			body, err = ObfuscateIDsJSON(body)
+			if link := rw.Header().Get("Link"); link != "" {
+				rw.Header().Set("Link", ObfuscateIDsLink(link))
+			}
+		}
+	}
+
+	if err == nil {
 		// Respond with the now-known
 		// size byte slice within buf.
 		WriteResponseBytes(rw, r,
 			statusCode,
 			contentType,
-			buf.B,
+			body,
 		)
 	} else {
 		// This will always be a JSON error, we
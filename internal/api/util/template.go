@@ -18,12 +18,19 @@
 package util
 
 import (
+	htmltemplate "html/template"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 )
 
+// InstanceCustomCSSKey is the gin context key under
+// which the web UI stores admin-set instance custom CSS,
+// to be rendered inline in the standard "page" template.
+// The Content-Security-Policy must allow the CSS's hash.
+const InstanceCustomCSSKey = "instanceCustomCSS"
+
 // WebPage encapsulates variables for
 // rendering an HTML template within
 // a standard GtS "page" template.
@@ -50,6 +57,14 @@ type WebPage struct {
 	// Can be nil.
 	Javascript []string
 
+	// Subresource Integrity hashes of
+	// any Stylesheets or Javascript not
+	// served from the assets dir, keyed
+	// by path, eg., user custom CSS.
+	// Hashes for assets are calculated
+	// automatically. Can be nil.
+	Integrity map[string]string
+
 	// Extra parameters to pass to
 	// the template for rendering,
 	// eg., "account": *Account etc.
@@ -60,9 +75,9 @@ type WebPage struct {
 // TemplateWebPage renders the given HTML template and
 // page params within the standard GtS "page" template.
 //
-// ogMeta, stylesheets, javascript, and any extra
-// properties will be provided to the template if
-// set, but can all be nil.
+// ogMeta, stylesheets, javascript, integrity, and
+// any extra properties will be provided to the
+// template if set, but can all be nil.
 func TemplateWebPage(
 	c *gin.Context,
 	page WebPage,
@@ -72,6 +87,7 @@ func TemplateWebPage(
 		"ogMeta":      page.OGMeta,
 		"stylesheets": page.Stylesheets,
 		"javascript":  page.Javascript,
+		"integrity":   page.Integrity,
 	}
 
 	for k, v := range page.Extra {
@@ -131,5 +147,11 @@ func templatePage(
 ) {
 	const pageTmpl = "page.tmpl"
 	obj["pageContent"] = template
+
+	// Include instance custom CSS if set. This is
+	// validated on input, so mark it as trusted.
+	if css := c.GetString(InstanceCustomCSSKey); css != "" {
+		obj["instanceCustomCSS"] = htmltemplate.CSS(css) // #nosec G203
+	}
 	c.HTML(code, pageTmpl, obj)
 }
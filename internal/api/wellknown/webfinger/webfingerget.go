@@ -74,13 +74,13 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 		return
 	}
 
-	if requestedHost != config.GetHost() && requestedHost != config.GetAccountDomain() {
+	if !config.IsLocalDomain(requestedHost) {
 		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
 		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
 		return
 	}
 
-	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUsername)
+	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUsername, requestedHost)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -18,9 +18,13 @@
 package cache
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
+	"codeberg.org/gruf/go-structr"
 	"github.com/superseriousbusiness/gotosocial/internal/cache/headerfilter"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/wordfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
@@ -38,66 +42,440 @@ type Caches struct {
 	// the block []headerfilter.Filter cache.
 	BlockHeaderFilters headerfilter.Cache
 
+	// WordFilters provides access to
+	// the []wordfilter.Filter cache.
+	WordFilters wordfilter.Cache
+
 	// Visibility provides access to the item visibility
 	// cache. (used by the visibility filter).
 	Visibility VisibilityCache
 
+	// FollowSuggestions provides access to the computed
+	// follow suggestions cache. (used by the suggestions
+	// processor).
+	FollowSuggestions FollowSuggestionsCache // TTL=6hr, sweep=5min
+
+	// dist handles distributed cache invalidation
+	// between nodes, if an invalidation backend
+	// is configured (see distributed.go).
+	dist *distributor
+
 	// prevent pass-by-value.
 	_ nocopy
 }
 
 // Init will (re)initialize both the GTS and AP cache collections.
 // NOTE: the cache MUST NOT be in use anywhere, this is not thread-safe.
+//
+// Init panics on any cache misconfiguration, see TryInit().
 func (c *Caches) Init() {
+	if err := c.TryInit(); err != nil {
+		panic(err)
+	}
+}
+
+// TryInit will (re)initialize all caches as Init() does, but
+// rather than panicking on the first misconfigured cache (e.g.
+// an index on an unknown struct field) it initializes every
+// cache, returning all errors found together, by cache name.
+// NOTE: the cache MUST NOT be in use anywhere, this is not thread-safe.
+func (c *Caches) TryInit() error {
 	log.Infof(nil, "init: %p", c)
 
-	c.initAccount()
-	c.initAccountNote()
-	c.initAccountSettings()
-	c.initAccountStats()
-	c.initApplication()
-	c.initBlock()
-	c.initBlockIDs()
-	c.initBoostOfIDs()
-	c.initClient()
-	c.initDomainAllow()
-	c.initDomainBlock()
-	c.initEmoji()
-	c.initEmojiCategory()
-	c.initFilter()
-	c.initFilterKeyword()
-	c.initFilterStatus()
-	c.initFollow()
-	c.initFollowIDs()
-	c.initFollowRequest()
-	c.initFollowRequestIDs()
-	c.initInReplyToIDs()
-	c.initInstance()
-	c.initList()
-	c.initListEntry()
-	c.initMarker()
-	c.initMedia()
-	c.initMention()
-	c.initMove()
-	c.initNotification()
-	c.initPoll()
-	c.initPollVote()
-	c.initPollVoteIDs()
-	c.initReport()
-	c.initStatus()
-	c.initStatusBookmark()
-	c.initStatusBookmarkIDs()
-	c.initStatusFave()
-	c.initStatusFaveIDs()
-	c.initTag()
-	c.initThreadMute()
-	c.initToken()
-	c.initTombstone()
-	c.initUser()
-	c.initUserMute()
-	c.initUserMuteIDs()
-	c.initWebfinger()
-	c.initVisibility()
+	var errs []error
+	for _, cache := range c.caches() {
+		if err := tryInit(cache.init); err != nil {
+			errs = append(errs, fmt.Errorf("cache %s: %w", cache.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CacheInfo provides diagnostic
+// information about a single cache.
+type CacheInfo struct {
+	// Name of the cache.
+	Name string
+
+	// Indices configured for the
+	// cache, nil if not a struct cache.
+	Indices []string
+
+	// Cap is the maximum number of entries,
+	// as calculated from the memory target.
+	Cap int
+
+	// EntrySize is the estimated in-memory
+	// size of a single cached entry, 0 if
+	// not known for this type of cache.
+	EntrySize uintptr
+}
+
+// Info returns diagnostic information about
+// each cache. Caches must be initialized first.
+func (c *Caches) Info() []CacheInfo {
+	caches := c.caches()
+	info := make([]CacheInfo, len(caches))
+	for i, cache := range caches {
+		info[i].Name = cache.name
+		if cache.size != nil {
+			info[i].EntrySize = cache.size()
+		}
+		v := cache.cache()
+		if v, ok := v.(interface{ Indices() []string }); ok {
+			info[i].Indices = v.Indices()
+		}
+		if v, ok := v.(interface{ Cap() int }); ok {
+			info[i].Cap = v.Cap()
+		}
+	}
+	return info
+}
+
+// IndexStats returns index statistics for each struct
+// cache, keyed by cache name, then by index name. Caches
+// must be initialized first.
+func (c *Caches) IndexStats() map[string]map[string]structr.IndexStat {
+	stats := make(map[string]map[string]structr.IndexStat)
+	for _, cache := range c.caches() {
+		v := cache.cache()
+		if v, ok := v.(interface {
+			IndexStats() map[string]structr.IndexStat
+		}); ok {
+			stats[cache.name] = v.IndexStats()
+		}
+	}
+	return stats
+}
+
+// cacheEntry describes one of
+// the caches held by Caches{}.
+type cacheEntry struct {
+	name  string
+	init  func()
+	cache func() any
+	size  func() uintptr
+}
+
+// caches returns an entry for each cache
+// held by Caches{}, in initialization order.
+func (c *Caches) caches() []cacheEntry {
+	return []cacheEntry{
+		{
+			name:  "Account",
+			init:  c.initAccount,
+			cache: func() any { return &c.GTS.Account },
+			size:  sizeofAccount,
+		},
+		{
+			name:  "AccountNote",
+			init:  c.initAccountNote,
+			cache: func() any { return &c.GTS.AccountNote },
+			size:  sizeofAccountNote,
+		},
+		{
+			name:  "AccountSettings",
+			init:  c.initAccountSettings,
+			cache: func() any { return &c.GTS.AccountSettings },
+			size:  sizeofAccountSettings,
+		},
+		{
+			name:  "AccountStats",
+			init:  c.initAccountStats,
+			cache: func() any { return &c.GTS.AccountStats },
+			size:  sizeofAccountStats,
+		},
+		{
+			name:  "Application",
+			init:  c.initApplication,
+			cache: func() any { return &c.GTS.Application },
+			size:  sizeofApplication,
+		},
+		{
+			name:  "Block",
+			init:  c.initBlock,
+			cache: func() any { return &c.GTS.Block },
+			size:  sizeofBlock,
+		},
+		{
+			name:  "BlockIDs",
+			init:  c.initBlockIDs,
+			cache: func() any { return &c.GTS.BlockIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "BoostOfIDs",
+			init:  c.initBoostOfIDs,
+			cache: func() any { return &c.GTS.BoostOfIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "Client",
+			init:  c.initClient,
+			cache: func() any { return &c.GTS.Client },
+			size:  sizeofClient,
+		},
+		{
+			name:  "DomainAllow",
+			init:  c.initDomainAllow,
+			cache: func() any { return c.GTS.DomainAllow },
+		},
+		{
+			name:  "DomainBlock",
+			init:  c.initDomainBlock,
+			cache: func() any { return c.GTS.DomainBlock },
+		},
+		{
+			name:  "DomainLimit",
+			init:  c.initDomainLimit,
+			cache: func() any { return c.GTS.DomainLimit },
+		},
+		{
+			name:  "Emoji",
+			init:  c.initEmoji,
+			cache: func() any { return &c.GTS.Emoji },
+			size:  sizeofEmoji,
+		},
+		{
+			name:  "EmojiCategory",
+			init:  c.initEmojiCategory,
+			cache: func() any { return &c.GTS.EmojiCategory },
+			size:  sizeofEmojiCategory,
+		},
+		{
+			name:  "Filter",
+			init:  c.initFilter,
+			cache: func() any { return &c.GTS.Filter },
+			size:  sizeofFilter,
+		},
+		{
+			name:  "FilterKeyword",
+			init:  c.initFilterKeyword,
+			cache: func() any { return &c.GTS.FilterKeyword },
+			size:  sizeofFilterKeyword,
+		},
+		{
+			name:  "FilterStatus",
+			init:  c.initFilterStatus,
+			cache: func() any { return &c.GTS.FilterStatus },
+			size:  sizeofFilterStatus,
+		},
+		{
+			name:  "Follow",
+			init:  c.initFollow,
+			cache: func() any { return &c.GTS.Follow },
+			size:  sizeofFollow,
+		},
+		{
+			name:  "FollowIDs",
+			init:  c.initFollowIDs,
+			cache: func() any { return &c.GTS.FollowIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "FollowRequest",
+			init:  c.initFollowRequest,
+			cache: func() any { return &c.GTS.FollowRequest },
+			size:  sizeofFollowRequest,
+		},
+		{
+			name:  "FollowRequestIDs",
+			init:  c.initFollowRequestIDs,
+			cache: func() any { return &c.GTS.FollowRequestIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "FollowSuggestions",
+			init:  c.initFollowSuggestions,
+			cache: func() any { return c.FollowSuggestions.Cache },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "InReplyToIDs",
+			init:  c.initInReplyToIDs,
+			cache: func() any { return &c.GTS.InReplyToIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "Instance",
+			init:  c.initInstance,
+			cache: func() any { return &c.GTS.Instance },
+			size:  sizeofInstance,
+		},
+		{
+			name:  "InstanceSettings",
+			init:  c.initInstanceSettings,
+			cache: func() any { return &c.GTS.InstanceSettings },
+			size:  sizeofInstanceSettings,
+		},
+		{
+			name:  "List",
+			init:  c.initList,
+			cache: func() any { return &c.GTS.List },
+			size:  sizeofList,
+		},
+		{
+			name:  "ListEntry",
+			init:  c.initListEntry,
+			cache: func() any { return &c.GTS.ListEntry },
+			size:  sizeofListEntry,
+		},
+		{
+			name:  "Marker",
+			init:  c.initMarker,
+			cache: func() any { return &c.GTS.Marker },
+			size:  sizeofMarker,
+		},
+		{
+			name:  "Media",
+			init:  c.initMedia,
+			cache: func() any { return &c.GTS.Media },
+			size:  sizeofMedia,
+		},
+		{
+			name:  "Mention",
+			init:  c.initMention,
+			cache: func() any { return &c.GTS.Mention },
+			size:  sizeofMention,
+		},
+		{
+			name:  "Move",
+			init:  c.initMove,
+			cache: func() any { return &c.GTS.Move },
+			size:  sizeofMove,
+		},
+		{
+			name:  "Notification",
+			init:  c.initNotification,
+			cache: func() any { return &c.GTS.Notification },
+			size:  sizeofNotification,
+		},
+		{
+			name:  "Poll",
+			init:  c.initPoll,
+			cache: func() any { return &c.GTS.Poll },
+			size:  sizeofPoll,
+		},
+		{
+			name:  "PollVote",
+			init:  c.initPollVote,
+			cache: func() any { return &c.GTS.PollVote },
+			size:  sizeofPollVote,
+		},
+		{
+			name:  "PollVoteIDs",
+			init:  c.initPollVoteIDs,
+			cache: func() any { return &c.GTS.PollVoteIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "Report",
+			init:  c.initReport,
+			cache: func() any { return &c.GTS.Report },
+			size:  sizeofReport,
+		},
+		{
+			name:  "Status",
+			init:  c.initStatus,
+			cache: func() any { return &c.GTS.Status },
+			size:  sizeofStatus,
+		},
+		{
+			name:  "StatusBookmark",
+			init:  c.initStatusBookmark,
+			cache: func() any { return &c.GTS.StatusBookmark },
+			size:  sizeofStatusBookmark,
+		},
+		{
+			name:  "StatusBookmarkIDs",
+			init:  c.initStatusBookmarkIDs,
+			cache: func() any { return &c.GTS.StatusBookmarkIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "StatusFave",
+			init:  c.initStatusFave,
+			cache: func() any { return &c.GTS.StatusFave },
+			size:  sizeofStatusFave,
+		},
+		{
+			name:  "StatusFaveIDs",
+			init:  c.initStatusFaveIDs,
+			cache: func() any { return &c.GTS.StatusFaveIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "Tag",
+			init:  c.initTag,
+			cache: func() any { return &c.GTS.Tag },
+			size:  sizeofTag,
+		},
+		{
+			name:  "ThreadMute",
+			init:  c.initThreadMute,
+			cache: func() any { return &c.GTS.ThreadMute },
+			size:  sizeofThreadMute,
+		},
+		{
+			name:  "Token",
+			init:  c.initToken,
+			cache: func() any { return &c.GTS.Token },
+			size:  sizeofToken,
+		},
+		{
+			name:  "Tombstone",
+			init:  c.initTombstone,
+			cache: func() any { return &c.GTS.Tombstone },
+			size:  sizeofTombstone,
+		},
+		{
+			name:  "User",
+			init:  c.initUser,
+			cache: func() any { return &c.GTS.User },
+			size:  sizeofUser,
+		},
+		{
+			name:  "UserMute",
+			init:  c.initUserMute,
+			cache: func() any { return &c.GTS.UserMute },
+			size:  sizeofUserMute,
+		},
+		{
+			name:  "UserMuteIDs",
+			init:  c.initUserMuteIDs,
+			cache: func() any { return &c.GTS.UserMuteIDs },
+			size:  func() uintptr { return sizeofIDSlice },
+		},
+		{
+			name:  "Webfinger",
+			init:  c.initWebfinger,
+			cache: func() any { return c.GTS.Webfinger },
+			size:  func() uintptr { return 2 * sizeofURIStr },
+		},
+		{
+			name:  "Visibility",
+			init:  c.initVisibility,
+			cache: func() any { return &c.Visibility },
+			size:  sizeofVisibility,
+		},
+	}
+}
+
+// tryInit calls the given cache init function,
+// returning any panic it raises as an error.
+func tryInit(init func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+	init()
+	return nil
 }
 
 // Start will start any caches that require a background
@@ -108,6 +486,12 @@ func (c *Caches) Start() {
 	tryUntil("starting webfinger cache", 5, func() bool {
 		return c.GTS.Webfinger.Start(5 * time.Minute)
 	})
+
+	tryUntil("starting follow suggestions cache", 5, func() bool {
+		return c.FollowSuggestions.Start(5 * time.Minute)
+	})
+
+	c.startDistributor()
 }
 
 // Stop will stop any caches that require a background
@@ -116,6 +500,9 @@ func (c *Caches) Stop() {
 	log.Infof(nil, "stop: %p", c)
 
 	tryUntil("stopping webfinger cache", 5, c.GTS.Webfinger.Stop)
+	tryUntil("stopping follow suggestions cache", 5, c.FollowSuggestions.Stop)
+
+	c.stopDistributor()
 }
 
 // Sweep will sweep all the available caches to ensure none
@@ -145,6 +532,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.FollowRequestIDs.Trim(threshold)
 	c.GTS.InReplyToIDs.Trim(threshold)
 	c.GTS.Instance.Trim(threshold)
+	c.GTS.InstanceSettings.Trim(threshold)
 	c.GTS.List.Trim(threshold)
 	c.GTS.ListEntry.Trim(threshold)
 	c.GTS.Marker.Trim(threshold)
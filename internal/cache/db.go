@@ -62,6 +62,9 @@ type GTSCaches struct {
 	// DomainBlock provides access to the domain block database cache.
 	DomainBlock *domain.Cache
 
+	// DomainLimit provides access to the domain limit database cache.
+	DomainLimit *domain.Cache
+
 	// Emoji provides access to the gtsmodel Emoji database cache.
 	Emoji StructCache[*gtsmodel.Emoji]
 
@@ -100,6 +103,9 @@ type GTSCaches struct {
 	// Instance provides access to the gtsmodel Instance database cache.
 	Instance StructCache[*gtsmodel.Instance]
 
+	// InstanceSettings provides access to the gtsmodel InstanceSettings database cache.
+	InstanceSettings StructCache[*gtsmodel.InstanceSettings]
+
 	// InReplyToIDs provides access to the status in reply to IDs list database cache.
 	InReplyToIDs SliceCache[string]
 
@@ -213,7 +219,8 @@ func (c *Caches) initAccount() {
 		return a2
 	}
 
-	c.GTS.Account.Init(structr.CacheConfig[*gtsmodel.Account]{
+	c.GTS.Account.InitSharded(structr.CacheConfig[*gtsmodel.Account]{
+		Name: "account_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -229,7 +236,13 @@ func (c *Caches) initAccount() {
 		IgnoreErr:  ignoreErrors,
 		Copy:       copyF,
 		Invalidate: c.OnInvalidateAccount,
-	})
+
+		// Dereferencing and bulk crawls
+		// can flood this cache with many
+		// one-off entries, so protect the
+		// frequently accessed ones.
+		ScanResistant: true,
+	}, config.GetCacheShards())
 }
 
 func (c *Caches) initAccountNote() {
@@ -255,6 +268,7 @@ func (c *Caches) initAccountNote() {
 	}
 
 	c.GTS.AccountNote.Init(structr.CacheConfig[*gtsmodel.AccountNote]{
+		Name: "account_note_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID,TargetAccountID"},
@@ -275,6 +289,7 @@ func (c *Caches) initAccountSettings() {
 	log.Infof(nil, "cache size = %d", cap)
 
 	c.GTS.AccountSettings.Init(structr.CacheConfig[*gtsmodel.AccountSettings]{
+		Name: "account_settings_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "AccountID"},
 		},
@@ -298,6 +313,7 @@ func (c *Caches) initAccountStats() {
 	log.Infof(nil, "cache size = %d", cap)
 
 	c.GTS.AccountStats.Init(structr.CacheConfig[*gtsmodel.AccountStats]{
+		Name: "account_stats_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "AccountID"},
 		},
@@ -327,6 +343,7 @@ func (c *Caches) initApplication() {
 	}
 
 	c.GTS.Application.Init(structr.CacheConfig[*gtsmodel.Application]{
+		Name: "application_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "ClientID"},
@@ -361,6 +378,7 @@ func (c *Caches) initBlock() {
 	}
 
 	c.GTS.Block.Init(structr.CacheConfig[*gtsmodel.Block]{
+		Name: "block_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -413,6 +431,7 @@ func (c *Caches) initClient() {
 	}
 
 	c.GTS.Client.Init(structr.CacheConfig[*gtsmodel.Client]{
+		Name: "client_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 		},
@@ -431,6 +450,10 @@ func (c *Caches) initDomainBlock() {
 	c.GTS.DomainBlock = new(domain.Cache)
 }
 
+func (c *Caches) initDomainLimit() {
+	c.GTS.DomainLimit = new(domain.Cache)
+}
+
 func (c *Caches) initEmoji() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -453,6 +476,7 @@ func (c *Caches) initEmoji() {
 	}
 
 	c.GTS.Emoji.Init(structr.CacheConfig[*gtsmodel.Emoji]{
+		Name: "emoji_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -482,6 +506,7 @@ func (c *Caches) initEmojiCategory() {
 	}
 
 	c.GTS.EmojiCategory.Init(structr.CacheConfig[*gtsmodel.EmojiCategory]{
+		Name: "emoji_category_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "Name"},
@@ -516,6 +541,7 @@ func (c *Caches) initFilter() {
 	}
 
 	c.GTS.Filter.Init(structr.CacheConfig[*gtsmodel.Filter]{
+		Name: "filter_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID", Multiple: true},
@@ -553,6 +579,7 @@ func (c *Caches) initFilterKeyword() {
 	}
 
 	c.GTS.FilterKeyword.Init(structr.CacheConfig[*gtsmodel.FilterKeyword]{
+		Name: "filter_keyword_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID", Multiple: true},
@@ -586,6 +613,7 @@ func (c *Caches) initFilterStatus() {
 	}
 
 	c.GTS.FilterStatus.Init(structr.CacheConfig[*gtsmodel.FilterStatus]{
+		Name: "filter_status_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID", Multiple: true},
@@ -620,6 +648,7 @@ func (c *Caches) initFollow() {
 	}
 
 	c.GTS.Follow.Init(structr.CacheConfig[*gtsmodel.Follow]{
+		Name: "follow_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -668,6 +697,7 @@ func (c *Caches) initFollowRequest() {
 	}
 
 	c.GTS.FollowRequest.Init(structr.CacheConfig[*gtsmodel.FollowRequest]{
+		Name: "follow_request_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -727,6 +757,7 @@ func (c *Caches) initInstance() {
 	}
 
 	c.GTS.Instance.Init(structr.CacheConfig[*gtsmodel.Instance]{
+		Name: "instance_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "Domain"},
@@ -737,6 +768,30 @@ func (c *Caches) initInstance() {
 	})
 }
 
+func (c *Caches) initInstanceSettings() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofInstanceSettings(), // model in-mem size.
+		config.GetCacheInstanceSettingsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.GTS.InstanceSettings.Init(structr.CacheConfig[*gtsmodel.InstanceSettings]{
+		Name: "instance_settings_cache",
+		Indices: []structr.IndexConfig{
+			{Fields: "InstanceID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy: func(s1 *gtsmodel.InstanceSettings) *gtsmodel.InstanceSettings {
+			s2 := new(gtsmodel.InstanceSettings)
+			*s2 = *s1
+			return s2
+		},
+	})
+}
+
 func (c *Caches) initList() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -760,6 +815,7 @@ func (c *Caches) initList() {
 	}
 
 	c.GTS.List.Init(structr.CacheConfig[*gtsmodel.List]{
+		Name: "list_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 		},
@@ -792,6 +848,7 @@ func (c *Caches) initListEntry() {
 	}
 
 	c.GTS.ListEntry.Init(structr.CacheConfig[*gtsmodel.ListEntry]{
+		Name: "list_entry_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "ListID", Multiple: true},
@@ -819,6 +876,7 @@ func (c *Caches) initMarker() {
 	}
 
 	c.GTS.Marker.Init(structr.CacheConfig[*gtsmodel.Marker]{
+		Name: "marker_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "AccountID,Name"},
 		},
@@ -844,6 +902,7 @@ func (c *Caches) initMedia() {
 	}
 
 	c.GTS.Media.Init(structr.CacheConfig[*gtsmodel.MediaAttachment]{
+		Name: "media_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 		},
@@ -878,6 +937,7 @@ func (c *Caches) initMention() {
 	}
 
 	c.GTS.Mention.Init(structr.CacheConfig[*gtsmodel.Mention]{
+		Name: "mention_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 		},
@@ -897,6 +957,7 @@ func (c *Caches) initMove() {
 	log.Infof(nil, "cache size = %d", cap)
 
 	c.GTS.Move.Init(structr.CacheConfig[*gtsmodel.Move]{
+		Name: "move_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -938,6 +999,7 @@ func (c *Caches) initNotification() {
 	}
 
 	c.GTS.Notification.Init(structr.CacheConfig[*gtsmodel.Notification]{
+		Name: "notification_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "NotificationType,TargetAccountID,OriginAccountID,StatusID", AllowZero: true},
@@ -975,6 +1037,7 @@ func (c *Caches) initPoll() {
 	}
 
 	c.GTS.Poll.Init(structr.CacheConfig[*gtsmodel.Poll]{
+		Name: "poll_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "StatusID"},
@@ -1009,6 +1072,7 @@ func (c *Caches) initPollVote() {
 	}
 
 	c.GTS.PollVote.Init(structr.CacheConfig[*gtsmodel.PollVote]{
+		Name: "poll_vote_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "PollID", Multiple: true},
@@ -1058,6 +1122,7 @@ func (c *Caches) initReport() {
 	}
 
 	c.GTS.Report.Init(structr.CacheConfig[*gtsmodel.Report]{
+		Name: "report_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 		},
@@ -1098,20 +1163,26 @@ func (c *Caches) initStatus() {
 		return s2
 	}
 
-	c.GTS.Status.Init(structr.CacheConfig[*gtsmodel.Status]{
+	c.GTS.Status.InitSharded(structr.CacheConfig[*gtsmodel.Status]{
+		Name: "status_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
 			{Fields: "URL"},
 			{Fields: "PollID"},
 			{Fields: "BoostOfID,AccountID"},
+			{Fields: "AccountID", Multiple: true},
+			{Fields: "InReplyToID", Multiple: true},
 			{Fields: "ThreadID", Multiple: true},
 		},
 		MaxSize:    cap,
 		IgnoreErr:  ignoreErrors,
 		Copy:       copyF,
 		Invalidate: c.OnInvalidateStatus,
-	})
+
+		// See note in initAccount().
+		ScanResistant: true,
+	}, config.GetCacheShards())
 }
 
 func (c *Caches) initStatusBookmark() {
@@ -1137,6 +1208,7 @@ func (c *Caches) initStatusBookmark() {
 	}
 
 	c.GTS.StatusBookmark.Init(structr.CacheConfig[*gtsmodel.StatusBookmark]{
+		Name: "status_bookmark_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID,StatusID"},
@@ -1186,6 +1258,7 @@ func (c *Caches) initStatusFave() {
 	}
 
 	c.GTS.StatusFave.Init(structr.CacheConfig[*gtsmodel.StatusFave]{
+		Name: "status_fave_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID,StatusID"},
@@ -1225,6 +1298,7 @@ func (c *Caches) initTag() {
 	}
 
 	c.GTS.Tag.Init(structr.CacheConfig[*gtsmodel.Tag]{
+		Name: "tag_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "Name"},
@@ -1250,6 +1324,7 @@ func (c *Caches) initThreadMute() {
 	}
 
 	c.GTS.ThreadMute.Init(structr.CacheConfig[*gtsmodel.ThreadMute]{
+		Name: "thread_mute_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "ThreadID", Multiple: true},
@@ -1278,6 +1353,7 @@ func (c *Caches) initToken() {
 	}
 
 	c.GTS.Token.Init(structr.CacheConfig[*gtsmodel.Token]{
+		Name: "token_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "Code"},
@@ -1307,6 +1383,7 @@ func (c *Caches) initTombstone() {
 	}
 
 	c.GTS.Tombstone.Init(structr.CacheConfig[*gtsmodel.Tombstone]{
+		Name: "tombstone_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "URI"},
@@ -1339,6 +1416,7 @@ func (c *Caches) initUser() {
 	}
 
 	c.GTS.User.Init(structr.CacheConfig[*gtsmodel.User]{
+		Name: "user_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID"},
@@ -1375,6 +1453,7 @@ func (c *Caches) initUserMute() {
 	}
 
 	c.GTS.UserMute.Init(structr.CacheConfig[*gtsmodel.UserMute]{
+		Name: "user_mute_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
 			{Fields: "AccountID,TargetAccountID"},
@@ -0,0 +1,381 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/redis"
+)
+
+var (
+	errInvalidIndex = errors.New("invalid cache index")
+	errInvalidKey   = errors.New("invalid cache key")
+)
+
+// invalidation is a cache invalidation
+// event as published between nodes.
+type invalidation struct {
+	// Node is the ID of the publishing
+	// node, used to ignore our own events.
+	Node string `json:"node"`
+
+	// Cache is the registered name of the cache.
+	Cache string `json:"cache"`
+
+	// Index is the name of the cache index
+	// that keys are for, empty for slice caches.
+	Index string `json:"index,omitempty"`
+
+	// Keys contains the invalidated
+	// keys, each split into its parts.
+	Keys [][]json.RawMessage `json:"keys,omitempty"`
+
+	// Clear indicates the
+	// cache should be cleared.
+	Clear bool `json:"clear,omitempty"`
+}
+
+// distributedCache is a cache that
+// can be invalidated from other nodes.
+type distributedCache interface {
+	setDistributor(name string, dist *distributor)
+	invalidateRemote(index string, keys [][]json.RawMessage) error
+	Clear()
+}
+
+// clearCache wraps a cache that only supports clearing
+// as a distributedCache, e.g. the domain and header
+// filter caches which have no keys to invalidate.
+type clearCache struct{ clear func() }
+
+func (c clearCache) setDistributor(string, *distributor) {}
+
+func (c clearCache) invalidateRemote(string, [][]json.RawMessage) error {
+	return errInvalidIndex
+}
+
+func (c clearCache) Clear() { c.clear() }
+
+// distributor publishes local cache invalidations
+// to, and applies invalidations received from, all
+// other nodes subscribed to the invalidation backend.
+type distributor struct {
+	node   string
+	caches map[string]distributedCache
+	client *redis.PubSub
+}
+
+// publishInvalidate publishes invalidation of
+// given keys under index, in named cache.
+func (d *distributor) publishInvalidate(cache, index string, keys [][]any) {
+	if len(keys) == 0 {
+		return
+	}
+
+	raw := make([][]json.RawMessage, len(keys))
+	for i, key := range keys {
+		raw[i] = make([]json.RawMessage, len(key))
+		for x, part := range key {
+			b, err := json.Marshal(part)
+			if err != nil {
+				log.Errorf(nil, "error encoding %s cache key: %v", cache, err)
+				return
+			}
+			raw[i][x] = b
+		}
+	}
+
+	d.publish(&invalidation{
+		Cache: cache,
+		Index: index,
+		Keys:  raw,
+	})
+}
+
+// publishClear publishes clearing of the named cache.
+func (d *distributor) publishClear(cache string) {
+	d.publish(&invalidation{
+		Cache: cache,
+		Clear: true,
+	})
+}
+
+// publish encodes and queues the event for publishing.
+func (d *distributor) publish(ev *invalidation) {
+	ev.Node = d.node
+
+	b, err := json.Marshal(ev)
+	if err != nil {
+		log.Errorf(nil, "error encoding invalidation: %v", err)
+		return
+	}
+
+	if !d.client.Publish(b) {
+		log.Warnf(nil, "invalidation queue full, dropped %s cache event", ev.Cache)
+	}
+}
+
+// handle decodes and applies an invalidation event received from
+// the backend, ignoring any events that this node published itself.
+func (d *distributor) handle(b []byte) {
+	var ev invalidation
+
+	if err := json.Unmarshal(b, &ev); err != nil {
+		log.Errorf(nil, "error decoding invalidation: %v", err)
+		return
+	}
+
+	if ev.Node == d.node {
+		// Our own event.
+		return
+	}
+
+	cache, ok := d.caches[ev.Cache]
+	if !ok {
+		log.Warnf(nil, "invalidation for unknown cache: %s", ev.Cache)
+		return
+	}
+
+	if ev.Clear {
+		cache.Clear()
+		return
+	}
+
+	if err := cache.invalidateRemote(ev.Index, ev.Keys); err != nil {
+		log.Errorf(nil, "error invalidating %s cache: %v", ev.Cache, err)
+	}
+}
+
+// clearAll clears all registered caches, used when
+// invalidation events may have been missed entirely.
+func (d *distributor) clearAll() {
+	log.Info(nil, "clearing all caches after invalidation backend reconnect")
+	for _, cache := range d.caches {
+		cache.Clear()
+	}
+}
+
+// startDistributor starts distributed cache
+// invalidation with the configured backend, if any.
+func (c *Caches) startDistributor() {
+	if config.GetCacheInvalidationBackend() != config.CacheInvalidationBackendRedis {
+		return
+	}
+
+	// Generate random ID for this node.
+	node := make([]byte, 16)
+	if _, err := rand.Read(node); err != nil {
+		panic(err)
+	}
+
+	dist := &distributor{
+		node:   hex.EncodeToString(node),
+		caches: c.distributedCaches(),
+	}
+
+	dist.client = redis.NewPubSub(
+		config.GetCacheInvalidationRedisAddress(),
+		config.GetCacheInvalidationRedisPassword(),
+		config.GetCacheInvalidationRedisChannel(),
+		dist.handle,
+		dist.clearAll,
+	)
+
+	for name, cache := range dist.caches {
+		cache.setDistributor(name, dist)
+	}
+
+	log.Infof(nil, "starting redis cache invalidation at %s", config.GetCacheInvalidationRedisAddress())
+	dist.client.Start()
+	c.dist = dist
+}
+
+// stopDistributor stops distributed cache
+// invalidation, if it was started.
+func (c *Caches) stopDistributor() {
+	if c.dist == nil {
+		return
+	}
+
+	for _, cache := range c.dist.caches {
+		cache.setDistributor("", nil)
+	}
+
+	c.dist.client.Stop()
+	c.dist = nil
+}
+
+// distributedCaches returns all caches
+// that support distributed invalidation,
+// keyed by name used in invalidation events.
+func (c *Caches) distributedCaches() map[string]distributedCache {
+	return map[string]distributedCache{
+		"Account":            &c.GTS.Account,
+		"AccountNote":        &c.GTS.AccountNote,
+		"AccountSettings":    &c.GTS.AccountSettings,
+		"AccountStats":       &c.GTS.AccountStats,
+		"Application":        &c.GTS.Application,
+		"Block":              &c.GTS.Block,
+		"BlockIDs":           &c.GTS.BlockIDs,
+		"BoostOfIDs":         &c.GTS.BoostOfIDs,
+		"Client":             &c.GTS.Client,
+		"DomainAllow":        clearCache{c.GTS.DomainAllow.Clear},
+		"DomainBlock":        clearCache{c.GTS.DomainBlock.Clear},
+		"DomainLimit":        clearCache{c.GTS.DomainLimit.Clear},
+		"Emoji":              &c.GTS.Emoji,
+		"EmojiCategory":      &c.GTS.EmojiCategory,
+		"Filter":             &c.GTS.Filter,
+		"FilterKeyword":      &c.GTS.FilterKeyword,
+		"FilterStatus":       &c.GTS.FilterStatus,
+		"Follow":             &c.GTS.Follow,
+		"FollowIDs":          &c.GTS.FollowIDs,
+		"FollowRequest":      &c.GTS.FollowRequest,
+		"FollowRequestIDs":   &c.GTS.FollowRequestIDs,
+		"InReplyToIDs":       &c.GTS.InReplyToIDs,
+		"Instance":           &c.GTS.Instance,
+		"InstanceSettings":   &c.GTS.InstanceSettings,
+		"List":               &c.GTS.List,
+		"ListEntry":          &c.GTS.ListEntry,
+		"Marker":             &c.GTS.Marker,
+		"Media":              &c.GTS.Media,
+		"Mention":            &c.GTS.Mention,
+		"Move":               &c.GTS.Move,
+		"Notification":       &c.GTS.Notification,
+		"Poll":               &c.GTS.Poll,
+		"PollVote":           &c.GTS.PollVote,
+		"PollVoteIDs":        &c.GTS.PollVoteIDs,
+		"Report":             &c.GTS.Report,
+		"Status":             &c.GTS.Status,
+		"StatusBookmark":     &c.GTS.StatusBookmark,
+		"StatusBookmarkIDs":  &c.GTS.StatusBookmarkIDs,
+		"StatusFave":         &c.GTS.StatusFave,
+		"StatusFaveIDs":      &c.GTS.StatusFaveIDs,
+		"Tag":                &c.GTS.Tag,
+		"ThreadMute":         &c.GTS.ThreadMute,
+		"Token":              &c.GTS.Token,
+		"Tombstone":          &c.GTS.Tombstone,
+		"User":               &c.GTS.User,
+		"UserMute":           &c.GTS.UserMute,
+		"UserMuteIDs":        &c.GTS.UserMuteIDs,
+		"Visibility":         &c.Visibility.StructCache,
+		"AllowHeaderFilters": clearCache{c.AllowHeaderFilters.Clear},
+		"BlockHeaderFilters": clearCache{c.BlockHeaderFilters.Clear},
+		"WordFilters":        clearCache{c.WordFilters.Clear},
+	}
+}
+
+// ClearDomainAllow clears the domain allow cache,
+// publishing this to other nodes if configured.
+func (c *Caches) ClearDomainAllow() {
+	c.GTS.DomainAllow.Clear()
+	c.publishClear("DomainAllow")
+}
+
+// ClearDomainBlock clears the domain block cache,
+// publishing this to other nodes if configured.
+func (c *Caches) ClearDomainBlock() {
+	c.GTS.DomainBlock.Clear()
+	c.publishClear("DomainBlock")
+}
+
+// ClearDomainLimit clears the domain limit cache, along with
+// the visibility cache whose public timeline entries depend
+// on it, publishing this to other nodes if configured.
+func (c *Caches) ClearDomainLimit() {
+	c.GTS.DomainLimit.Clear()
+	c.Visibility.Clear()
+	c.publishClear("DomainLimit")
+	c.publishClear("Visibility")
+}
+
+// ClearAllowHeaderFilters clears the allow header filter
+// cache, publishing this to other nodes if configured.
+func (c *Caches) ClearAllowHeaderFilters() {
+	c.AllowHeaderFilters.Clear()
+	c.publishClear("AllowHeaderFilters")
+}
+
+// ClearBlockHeaderFilters clears the block header filter
+// cache, publishing this to other nodes if configured.
+func (c *Caches) ClearBlockHeaderFilters() {
+	c.BlockHeaderFilters.Clear()
+	c.publishClear("BlockHeaderFilters")
+}
+
+// ClearWordFilters clears the word filter
+// cache, publishing this to other nodes if configured.
+func (c *Caches) ClearWordFilters() {
+	c.WordFilters.Clear()
+	c.publishClear("WordFilters")
+}
+
+func (c *Caches) publishClear(cache string) {
+	if c.dist != nil {
+		c.dist.publishClear(cache)
+	}
+}
+
+// fieldTypes returns the types of the comma separated (and possibly
+// dot nested) struct fields of t, as given in a structr index config.
+func fieldTypes(t reflect.Type, fields string) []reflect.Type {
+	var types []reflect.Type
+	for _, field := range strings.Split(fields, ",") {
+		ft := t
+		for _, name := range strings.Split(field, ".") {
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			sf, ok := ft.FieldByName(name)
+			if !ok {
+				panic("missing index field: " + field)
+			}
+			ft = sf.Type
+		}
+		types = append(types, ft)
+	}
+	return types
+}
+
+// fieldValues returns the values of the comma separated (and possibly dot nested)
+// struct fields of v, as given in a structr index config. Returns false if a nil
+// pointer is encountered along the way, i.e. the field values can't be reached.
+func fieldValues(v reflect.Value, fields string) ([]any, bool) {
+	var values []any
+	for _, field := range strings.Split(fields, ",") {
+		fv := v
+		for _, name := range strings.Split(field, ".") {
+			for fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					return nil, false
+				}
+				fv = fv.Elem()
+			}
+			fv = fv.FieldByName(name)
+		}
+		values = append(values, fv.Interface())
+	}
+	return values, true
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func TestDistributedInvalidation(t *testing.T) {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	srv := miniredis.RunT(t)
+
+	config.SetCacheInvalidationBackend(config.CacheInvalidationBackendRedis)
+	config.SetCacheInvalidationRedisAddress(srv.Addr())
+	defer config.SetCacheInvalidationBackend(config.CacheInvalidationBackendNone)
+
+	var nodeA, nodeB cache.Caches
+	for _, c := range []*cache.Caches{&nodeA, &nodeB} {
+		c.Init()
+		c.Start()
+		defer c.Stop()
+	}
+
+	// Wait for both nodes to subscribe.
+	channel := config.GetCacheInvalidationRedisChannel()
+	waitFor(t, "subscribers", func() bool {
+		return srv.PubSubNumSub(channel)[channel] == 2
+	})
+
+	account := &gtsmodel.Account{
+		ID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
+		URI:      "http://localhost:8080/users/the_mighty_zork",
+		Username: "the_mighty_zork",
+	}
+
+	// Load account into cache on node B (loads don't publish).
+	if _, err := nodeB.GTS.Account.LoadOne("ID", func() (*gtsmodel.Account, error) {
+		return account, nil
+	}, account.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Invalidate by a different index on node A,
+	// B should drop its copy via the event.
+	nodeA.GTS.Account.Invalidate("URI", account.URI)
+	waitFor(t, "account invalidation", func() bool {
+		_, ok := nodeB.GTS.Account.GetOne("ID", account.ID)
+		return !ok
+	})
+
+	// Load domain blocks on node B.
+	var loads int
+	load := func() ([]string, error) {
+		loads++
+		return []string{"example.org"}, nil
+	}
+	if _, err := nodeB.GTS.DomainBlock.Matches("example.org", load); err != nil {
+		t.Fatal(err)
+	}
+
+	// Clear domain blocks on node A, B
+	// should have to reload domain list.
+	nodeA.ClearDomainBlock()
+	waitFor(t, "domain block clear", func() bool {
+		_, _ = nodeB.GTS.DomainBlock.Matches("example.org", load)
+		return loads > 1
+	})
+}
+
+// waitFor waits for up to
+// 5s for cond to be true.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	for i := 0; i < 500; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
@@ -188,7 +188,9 @@ func totalOfRatios() float64 {
 		config.GetCacheFollowIDsMemRatio() +
 		config.GetCacheFollowRequestMemRatio() +
 		config.GetCacheFollowRequestIDsMemRatio() +
+		config.GetCacheFollowSuggestionsMemRatio() +
 		config.GetCacheInstanceMemRatio() +
+		config.GetCacheInstanceSettingsMemRatio() +
 		config.GetCacheInReplyToIDsMemRatio() +
 		config.GetCacheListMemRatio() +
 		config.GetCacheListEntryMemRatio() +
@@ -269,6 +271,14 @@ func sizeofAccountSettings() uintptr {
 		CustomCSS:         exampleText,
 		EnableRSS:         util.Ptr(true),
 		HideCollections:   util.Ptr(false),
+
+		HideInteractionCounts: util.Ptr(true),
+		HideOutbox:            util.Ptr(true),
+
+		StatusRetentionDays:           180,
+		StatusRetentionKeepPinned:     util.Ptr(true),
+		StatusRetentionKeepBookmarked: util.Ptr(true),
+		StatusRetentionKeepFavesAbove: 10,
 	}))
 }
 
@@ -280,6 +290,7 @@ func sizeofAccountStats() uintptr {
 		StatusesCount:       util.Ptr(100),
 		StatusesPinnedCount: util.Ptr(100),
 		LastStatusAt:        exampleTime,
+		MediaUsedBytes:      util.Ptr(int64(100)),
 	}))
 }
 
@@ -426,6 +437,20 @@ func sizeofInstance() uintptr {
 	}))
 }
 
+func sizeofInstanceSettings() uintptr {
+	return uintptr(size.Of(&gtsmodel.InstanceSettings{
+		InstanceID:         exampleID,
+		CreatedAt:          exampleTime,
+		UpdatedAt:          exampleTime,
+		CustomCSS:          exampleText,
+		DeliveryWorkers:    8,
+		ClientWorkers:      16,
+		FederatorWorkers:   16,
+		DereferenceWorkers: 16,
+		MediaWorkers:       32,
+	}))
+}
+
 func sizeofList() uintptr {
 	return uintptr(size.Of(&gtsmodel.List{
 		ID:            exampleID,
@@ -482,9 +507,10 @@ func sizeofMedia() uintptr {
 			URL:         exampleURI,
 			RemoteURL:   exampleURI,
 		},
-		Avatar: func() *bool { ok := false; return &ok }(),
-		Header: func() *bool { ok := false; return &ok }(),
-		Cached: func() *bool { ok := true; return &ok }(),
+		Avatar:    func() *bool { ok := false; return &ok }(),
+		Header:    func() *bool { ok := false; return &ok }(),
+		Cached:    func() *bool { ok := true; return &ok }(),
+		Sensitive: func() *bool { ok := false; return &ok }(),
 	}))
 }
 
@@ -525,6 +551,7 @@ func sizeofNotification() uintptr {
 		OriginAccountID:  exampleID,
 		StatusID:         exampleID,
 		Read:             func() *bool { ok := false; return &ok }(),
+		Dismissed:        func() *bool { ok := false; return &ok }(),
 	}))
 }
 
@@ -628,12 +655,13 @@ func sizeofStatusFave() uintptr {
 
 func sizeofTag() uintptr {
 	return uintptr(size.Of(&gtsmodel.Tag{
-		ID:        exampleID,
-		Name:      exampleUsername,
-		CreatedAt: exampleTime,
-		UpdatedAt: exampleTime,
-		Useable:   func() *bool { ok := true; return &ok }(),
-		Listable:  func() *bool { ok := true; return &ok }(),
+		ID:          exampleID,
+		Name:        exampleUsername,
+		DisplayName: exampleUsername,
+		CreatedAt:   exampleTime,
+		UpdatedAt:   exampleTime,
+		Useable:     func() *bool { ok := true; return &ok }(),
+		Listable:    func() *bool { ok := true; return &ok }(),
 	}))
 }
 
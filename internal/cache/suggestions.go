@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"time"
+
+	"codeberg.org/gruf/go-cache/v3/ttl"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// FollowSuggestionsCache wraps a ttl.Cache of computed follow
+// suggestions, keyed by the ID of the account they're for.
+//
+// Computing suggestions requires walking the social graph, which
+// isn't cheap, so results are kept for a few hours. Suggestions are
+// still expected to be checked against blocks, follows etc before
+// being served, so no invalidation is done when these change.
+type FollowSuggestionsCache struct {
+	*ttl.Cache[string, []gtsmodel.FollowSuggestion]
+}
+
+func (c *Caches) initFollowSuggestions() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheFollowSuggestionsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.FollowSuggestions.Cache = new(ttl.Cache[string, []gtsmodel.FollowSuggestion])
+	c.FollowSuggestions.Init(
+		0,
+		cap,
+		6*time.Hour,
+	)
+}
@@ -43,6 +43,7 @@ func (c *Caches) initVisibility() {
 	}
 
 	c.Visibility.Init(structr.CacheConfig[*CachedVisibility]{
+		Name: "visibility_cache",
 		Indices: []structr.IndexConfig{
 			{Fields: "ItemID", Multiple: true},
 			{Fields: "RequesterID", Multiple: true},
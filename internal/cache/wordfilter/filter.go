@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package wordfilter
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+)
+
+// Cache provides a means of caching wordfilter.Filters in
+// memory to reduce load on an underlying storage mechanism.
+type Cache struct {
+	// current cached word filters slice.
+	ptr atomic.Pointer[wordfilter.Filters]
+}
+
+// Match performs .Match() on cached wordfilter.Filters, loading using callback if necessary.
+func (c *Cache) Match(in *wordfilter.Input, load func() ([]*gtsmodel.WordFilter, error)) ([]*wordfilter.Filter, error) {
+	// Load ptr value.
+	ptr := c.ptr.Load()
+
+	if ptr == nil {
+		// Cache is not hydrated.
+		// Load filters from callback.
+		filters, err := loadFilters(load)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store the new
+		// word filters.
+		ptr = &filters
+		c.ptr.Store(ptr)
+	}
+
+	// Deref and perform match.
+	return ptr.Match(in)
+}
+
+// Clear will drop the currently loaded filters,
+// triggering a reload on next call to .Match().
+func (c *Cache) Clear() { c.ptr.Store(nil) }
+
+// loadFilters will load filters from given load callback, compiling each filter.
+func loadFilters(load func() ([]*gtsmodel.WordFilter, error)) (wordfilter.Filters, error) {
+	// Load filters from callback.
+	wordFilters, err := load()
+	if err != nil {
+		return nil, fmt.Errorf("error reloading cache: %w", err)
+	}
+
+	// Allocate new word filter slice to store compiled filters.
+	filters := make(wordfilter.Filters, 0, len(wordFilters))
+
+	// Add all filters to compiled filter slice.
+	for _, filter := range wordFilters {
+		if err := filters.Append(filter); err != nil {
+			return nil, fmt.Errorf("error appending filter %s: %w", filter.ID, err)
+		}
+	}
+
+	return filters, nil
+}
@@ -18,6 +18,10 @@
 package cache
 
 import (
+	"cmp"
+	"encoding/json"
+	"hash/maphash"
+	"reflect"
 	"slices"
 
 	"codeberg.org/gruf/go-cache/v3/simple"
@@ -28,6 +32,11 @@ import (
 // functions for fetching + caching slices of objects (e.g. IDs).
 type SliceCache[T any] struct {
 	cache simple.Cache[string, []T]
+
+	// distributed cache
+	// invalidation, if set.
+	dist *distributor
+	name string
 }
 
 // Init initializes the cache with given length + capacity.
@@ -58,9 +67,51 @@ func (c *SliceCache[T]) Load(key string, load func() ([]T, error)) ([]T, error)
 	return slices.Clone(data), nil
 }
 
+// Get will attempt to get an existing slice from cache for key, without loading on miss.
+func (c *SliceCache[T]) Get(key string) ([]T, bool) {
+	data, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	// Return data clone for safety.
+	return slices.Clone(data), true
+}
+
 // Invalidate: see simple.Cache{}.InvalidateAll().
 func (c *SliceCache[T]) Invalidate(keys ...string) {
 	_ = c.cache.InvalidateAll(keys...)
+
+	if c.dist != nil {
+		// Publish invalidated keys to other nodes.
+		parts := make([][]any, len(keys))
+		for i, key := range keys {
+			parts[i] = []any{key}
+		}
+		c.dist.publishInvalidate(c.name, "", parts)
+	}
+}
+
+// setDistributor sets the distributor used to publish invalidations of cache, under name.
+func (c *SliceCache[T]) setDistributor(name string, dist *distributor) {
+	c.name, c.dist = name, dist
+}
+
+// invalidateRemote handles an invalidation event received from another node.
+func (c *SliceCache[T]) invalidateRemote(_ string, parts [][]json.RawMessage) error {
+	keys := make([]string, 0, len(parts))
+	for _, part := range parts {
+		var key string
+		if len(part) != 1 {
+			return errInvalidKey
+		}
+		if err := json.Unmarshal(part[0], &key); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	_ = c.cache.InvalidateAll(keys...)
+	return nil
 }
 
 // Trim: see simple.Cache{}.Trim().
@@ -88,45 +139,217 @@ func (c *SliceCache[T]) Cap() int {
 // this). (in the future it may be worth embedding these indexes by
 // name under the main database caches struct which would reduce
 // time required to access cached values).
+//
+// The cache may optionally be split into a number of shards, each
+// an independent structr.Cache{} with its own lock, to reduce lock
+// contention on busy caches. Values are placed in a shard by hash
+// of their primary (i.e. first) index key. Lookups by the primary
+// index go straight to the one relevant shard, lookups by any other
+// index have to check each shard in turn. Note that LRU eviction
+// is per-shard, so is only an approximation of a global LRU.
+//
+// Values returned by the cache (from Get, GetOne, Load and LoadOne)
+// are always copies, made with the configured structr.CacheConfig{}
+// Copy function, so callers may freely modify a returned value without
+// affecting the cached one. This costs one allocation per returned
+// value. Copies are shallow: slice and map fields still share memory
+// with the cached value, so must be replaced rather than modified in
+// place.
 type StructCache[StructType any] struct {
+	shards []structShard[StructType]
+	seed   maphash.Seed
+
+	// field types of each index, and name
+	// of the primary (i.e. first) index, for
+	// encoding / decoding invalidation events.
+	types   map[string][]reflect.Type
+	primary string
+
+	// names of all configured
+	// indices, in config order.
+	indices []string
+
+	// distributed cache
+	// invalidation, if set.
+	dist *distributor
+	name string
+}
+
+// structShard is a single shard of a StructCache{}.
+type structShard[StructType any] struct {
 	cache structr.Cache[StructType]
 	index map[string]*structr.Index
 }
 
 // Init initializes the cache with given structr.CacheConfig{}.
 func (c *StructCache[T]) Init(config structr.CacheConfig[T]) {
-	c.index = make(map[string]*structr.Index, len(config.Indices))
-	c.cache = structr.Cache[T]{}
-	c.cache.Init(config)
-	for _, cfg := range config.Indices {
-		c.index[cfg.Fields] = c.cache.Index(cfg.Fields)
+	c.InitSharded(config, 1)
+}
+
+// InitSharded initializes the cache with given structr.CacheConfig{}, split into
+// given number of shards. The configured MaxSize is divided evenly between shards.
+func (c *StructCache[T]) InitSharded(config structr.CacheConfig[T], shards int) {
+	if shards < 1 {
+		shards = 1
+	}
+
+	// Divide max size between shards,
+	// keeping minimum for LRU to work.
+	config.MaxSize = max(config.MaxSize/shards, 2)
+
+	// Check config up-front, so any
+	// errors are reported for every
+	// index rather than just the first.
+	if err := config.Validate(); err != nil {
+		panic(err)
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	c.types = make(map[string][]reflect.Type, len(config.Indices))
+	c.indices = make([]string, len(config.Indices))
+	for i, cfg := range config.Indices {
+		c.types[cfg.Fields] = fieldTypes(t, cfg.Fields)
+		c.indices[i] = cfg.Fields
+	}
+	c.primary = config.Indices[0].Fields
+	c.seed = maphash.MakeSeed()
+
+	c.shards = make([]structShard[T], shards)
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.index = make(map[string]*structr.Index, len(config.Indices))
+		shard.cache.Init(config)
+		for _, cfg := range config.Indices {
+			shard.index[cfg.Fields] = shard.cache.Index(cfg.Fields)
+		}
 	}
 }
 
 // GetOne calls structr.Cache{}.GetOne(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) GetOne(index string, key ...any) (T, bool) {
-	i := c.index[index]
-	return c.cache.GetOne(i, i.Key(key...))
+	k := c.key(index, key)
+
+	if !c.fanout(index) {
+		shard := c.shardOf(k)
+		return shard.cache.GetOne(shard.index[index], k)
+	}
+
+	// Check each shard in turn.
+	for i := range c.shards {
+		shard := &c.shards[i]
+		if value, ok := shard.cache.GetOne(shard.index[index], k); ok {
+			return value, true
+		}
+	}
+
+	var zero T
+	return zero, false
 }
 
 // Get calls structr.Cache{}.Get(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) Get(index string, keys ...[]any) []T {
-	i := c.index[index]
-	return c.cache.Get(i, i.Keys(keys...)...)
+	if len(c.shards) == 1 {
+		shard := &c.shards[0]
+		i := shard.index[index]
+		return shard.cache.Get(i, i.Keys(keys...)...)
+	}
+
+	values := make([]T, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, c.get(index, c.key(index, key))...)
+	}
+	return values
+}
+
+// GetAll calls structr.Cache{}.GetAll(), using a cached structr.Index{} by 'index'
+// name, returning all values stored under key on a non-unique index (e.g. statuses
+// by account ID) in the order they were stored. Unlike Get(), the returned values
+// are not marked as recently used, so a lookup of many values does not distort
+// cache eviction.
+func (c *StructCache[T]) GetAll(index string, key ...any) []T {
+	k := c.key(index, key)
+
+	if !c.fanout(index) {
+		shard := c.shardOf(k)
+		return shard.cache.GetAll(shard.index[index], k, false)
+	}
+
+	// Gather from each shard, then
+	// merge by insertion sequence
+	// to keep a stable order.
+	type seqValue struct {
+		seq   uint64
+		value T
+	}
+	var merged []seqValue
+	for i := range c.shards {
+		shard := &c.shards[i]
+		values, seqs := shard.cache.GetAllSeq(shard.index[index], k, false)
+		for j := range values {
+			merged = append(merged, seqValue{seqs[j], values[j]})
+		}
+	}
+	slices.SortFunc(merged, func(a, b seqValue) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	values := make([]T, len(merged))
+	for i := range merged {
+		values[i] = merged[i].value
+	}
+	return values
 }
 
 // Put: see structr.Cache{}.Put().
 func (c *StructCache[T]) Put(values ...T) {
-	c.cache.Put(values...)
+	if len(c.shards) == 1 {
+		c.shards[0].cache.Put(values...)
+	} else {
+		for _, value := range values {
+			c.shardFor(value).cache.Put(value)
+		}
+	}
+
+	if c.dist != nil {
+		// Publish invalidation of any
+		// older copies on other nodes.
+		c.publishValues(values...)
+	}
 }
 
 // LoadOne calls structr.Cache{}.LoadOne(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
+//
+// When sharded, loads by an index other than the primary don't cache error results, as there
+// is no way to tell which shard a later stored value under the same key would be placed in.
 func (c *StructCache[T]) LoadOne(index string, load func() (T, error), key ...any) (T, error) {
-	i := c.index[index]
-	return c.cache.LoadOne(i, i.Key(key...), load)
+	k := c.key(index, key)
+
+	if !c.fanout(index) {
+		shard := c.shardOf(k)
+		return shard.cache.LoadOne(shard.index[index], k, load)
+	}
+
+	// Check each shard in turn.
+	for i := range c.shards {
+		shard := &c.shards[i]
+		if value, ok := shard.cache.GetOne(shard.index[index], k); ok {
+			return value, nil
+		}
+	}
+
+	// Load new result.
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	// Cache in shard for value.
+	c.store(value)
+
+	return value, nil
 }
 
 // LoadIDs calls structr.Cache{}.Load(), using a cached structr.Index{} by 'index' name. Note: this also handles
@@ -134,7 +357,7 @@ func (c *StructCache[T]) LoadOne(index string, load func() (T, error), key ...an
 //
 // If you need to load multiple cache keys other than by ID strings, please create another convenience wrapper.
 func (c *StructCache[T]) LoadIDs(index string, ids []string, load func([]string) ([]T, error)) ([]T, error) {
-	i := c.index[index]
+	i := c.shards[0].index[index]
 	if i == nil {
 		// we only perform this check here as
 		// we're going to use the index before
@@ -148,26 +371,75 @@ func (c *StructCache[T]) LoadIDs(index string, ids []string, load func([]string)
 		keys[x] = i.Key(id)
 	}
 
-	// Pass loader callback with wrapper onto main cache load function.
-	return c.cache.Load(i, keys, func(uncached []structr.Key) ([]T, error) {
-		uncachedIDs := make([]string, len(uncached))
-		for i := range uncached {
-			uncachedIDs[i] = uncached[i].Values()[0].(string)
+	if len(c.shards) == 1 {
+		// Pass loader callback with wrapper onto main cache load function.
+		return c.shards[0].cache.Load(i, keys, func(uncached []structr.Key) ([]T, error) {
+			uncachedIDs := make([]string, len(uncached))
+			for i := range uncached {
+				uncachedIDs[i] = uncached[i].Values()[0].(string)
+			}
+			return load(uncachedIDs)
+		})
+	}
+
+	// Gather cached values from
+	// shards, and uncached IDs.
+	values := make([]T, 0, len(ids))
+	uncachedIDs := make([]string, 0, len(ids))
+	for x, key := range keys {
+		cached := c.get(index, key)
+		if len(cached) == 0 {
+			uncachedIDs = append(uncachedIDs, ids[x])
+			continue
 		}
-		return load(uncachedIDs)
-	})
+		values = append(values, cached...)
+	}
+
+	if len(uncachedIDs) == 0 {
+		// All cached.
+		return values, nil
+	}
+
+	// Load all uncached in one go, so
+	// as not to call loader per-shard.
+	loaded, err := load(uncachedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache each loaded
+	// in shard for value.
+	for _, value := range loaded {
+		c.store(value)
+	}
+
+	return append(values, loaded...), nil
 }
 
 // Store: see structr.Cache{}.Store().
 func (c *StructCache[T]) Store(value T, store func() error) error {
-	return c.cache.Store(value, store)
+	if err := c.shardFor(value).cache.Store(value, store); err != nil {
+		return err
+	}
+
+	if c.dist != nil {
+		// Publish invalidation of any
+		// older copies on other nodes.
+		c.publishValues(value)
+	}
+
+	return nil
 }
 
 // Invalidate calls structr.Cache{}.Invalidate(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) Invalidate(index string, key ...any) {
-	i := c.index[index]
-	c.cache.Invalidate(i, i.Key(key...))
+	c.invalidate(index, [][]any{key})
+
+	if c.dist != nil {
+		// Publish invalidated key to other nodes.
+		c.dist.publishInvalidate(c.name, index, [][]any{key})
+	}
 }
 
 // InvalidateIDs calls structr.Cache{}.Invalidate(), using a cached structr.Index{} by 'index' name. Note: this also
@@ -175,40 +447,231 @@ func (c *StructCache[T]) Invalidate(index string, key ...any) {
 //
 // If you need to invalidate multiple cache keys other than by ID strings, please create another convenience wrapper.
 func (c *StructCache[T]) InvalidateIDs(index string, ids []string) {
-	i := c.index[index]
-	if i == nil {
+	if c.shards[0].index[index] == nil {
 		// we only perform this check here as
 		// we're going to use the index before
 		// passing it to cache in main .Load().
 		panic("missing index for cache type")
 	}
 
-	// Generate cache keys for ID types.
-	keys := make([]structr.Key, len(ids))
+	// Convert IDs to key parts.
+	parts := make([][]any, len(ids))
 	for x, id := range ids {
-		keys[x] = i.Key(id)
+		parts[x] = []any{id}
 	}
 
 	// Pass to main invalidate func.
-	c.cache.Invalidate(i, keys...)
+	c.invalidate(index, parts)
+
+	if c.dist != nil {
+		// Publish invalidated IDs to other nodes.
+		c.dist.publishInvalidate(c.name, index, parts)
+	}
+}
+
+// setDistributor sets the distributor used to publish invalidations of cache, under name.
+func (c *StructCache[T]) setDistributor(name string, dist *distributor) {
+	c.name, c.dist = name, dist
+}
+
+// publishValues publishes invalidation of the given
+// values by their primary index key to other nodes.
+func (c *StructCache[T]) publishValues(values ...T) {
+	parts := make([][]any, 0, len(values))
+	for _, value := range values {
+		if key, ok := fieldValues(reflect.ValueOf(value), c.primary); ok {
+			parts = append(parts, key)
+		}
+	}
+	c.dist.publishInvalidate(c.name, c.primary, parts)
+}
+
+// invalidateRemote handles an invalidation event received from another node.
+//
+// Note this does not publish the invalidation, though any invalidate hooks
+// triggered will; this always terminates as hooks are only called for values
+// actually contained within the cache, which will have just been removed.
+func (c *StructCache[T]) invalidateRemote(index string, parts [][]json.RawMessage) error {
+	types := c.types[index]
+	if c.shards[0].index[index] == nil {
+		return errInvalidIndex
+	}
+
+	keys := make([][]any, 0, len(parts))
+	for _, part := range parts {
+		if len(part) != len(types) {
+			return errInvalidKey
+		}
+
+		// Decode each key part
+		// into index field type.
+		key := make([]any, len(part))
+		for x, raw := range part {
+			ptr := reflect.New(types[x])
+			if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
+				return err
+			}
+			key[x] = ptr.Elem().Interface()
+		}
+
+		keys = append(keys, key)
+	}
+
+	c.invalidate(index, keys)
+	return nil
 }
 
 // Trim: see structr.Cache{}.Trim().
 func (c *StructCache[T]) Trim(perc float64) {
-	c.cache.Trim(perc)
+	for i := range c.shards {
+		c.shards[i].cache.Trim(perc)
+	}
 }
 
 // Clear: see structr.Cache{}.Clear().
 func (c *StructCache[T]) Clear() {
-	c.cache.Clear()
+	for i := range c.shards {
+		c.shards[i].cache.Clear()
+	}
 }
 
 // Len: see structr.Cache{}.Len().
 func (c *StructCache[T]) Len() int {
-	return c.cache.Len()
+	var n int
+	for i := range c.shards {
+		n += c.shards[i].cache.Len()
+	}
+	return n
 }
 
 // Cap: see structr.Cache{}.Cap().
 func (c *StructCache[T]) Cap() int {
-	return c.cache.Cap()
+	var n int
+	for i := range c.shards {
+		n += c.shards[i].cache.Cap()
+	}
+	return n
+}
+
+// Indices returns the names of all indices
+// configured for the cache, in config order.
+func (c *StructCache[T]) Indices() []string {
+	return slices.Clone(c.indices)
+}
+
+// IndexStats returns statistics about each of the cache indices,
+// keyed by index name, see structr.Cache{}.IndexStats(). For sharded
+// caches, stats are summed across shards, except MaxBucket which is
+// the largest in any single shard. Note that keys of non-primary
+// indices may be spread across (and so counted once per) shard.
+func (c *StructCache[T]) IndexStats() map[string]structr.IndexStat {
+	stats := c.shards[0].cache.IndexStats()
+	for i := 1; i < len(c.shards); i++ {
+		for name, shard := range c.shards[i].cache.IndexStats() {
+			stat := stats[name]
+			stat.Keys += shard.Keys
+			stat.Entries += shard.Entries
+			stat.MaxBucket = max(stat.MaxBucket, shard.MaxBucket)
+			stats[name] = stat
+		}
+	}
+	return stats
+}
+
+// Stats returns statistics about the cache and its indices, see
+// structr.Cache{}.Stats(). For sharded caches, stats are summed
+// across shards in the same manner as StructCache{}.IndexStats().
+func (c *StructCache[T]) Stats() structr.CacheStat {
+	return structr.CacheStat{
+		Name:    c.Name(),
+		Len:     c.Len(),
+		Cap:     c.Cap(),
+		Indices: c.IndexStats(),
+	}
+}
+
+// Name returns the configured name of the cache, if any,
+// as passed via structr.CacheConfig{}.Name on initialization.
+func (c *StructCache[T]) Name() string {
+	return c.shards[0].cache.Name()
+}
+
+// key generates a structr.Key{} for index from key parts. Keys don't
+// depend on the shard they were generated by, so we always use the first.
+func (c *StructCache[T]) key(index string, parts []any) structr.Key {
+	return c.shards[0].index[index].Key(parts...)
+}
+
+// fanout returns whether lookups by index need to check every shard.
+func (c *StructCache[T]) fanout(index string) bool {
+	return len(c.shards) > 1 && index != c.primary
+}
+
+// shardOf returns the shard responsible for given primary index key.
+func (c *StructCache[T]) shardOf(key structr.Key) *structShard[T] {
+	if len(c.shards) == 1 {
+		return &c.shards[0]
+	}
+	h := maphash.String(c.seed, key.Key())
+	return &c.shards[h%uint64(len(c.shards))]
+}
+
+// shardFor returns the shard responsible for given value.
+func (c *StructCache[T]) shardFor(value T) *structShard[T] {
+	if len(c.shards) == 1 {
+		return &c.shards[0]
+	}
+	parts, _ := fieldValues(reflect.ValueOf(value), c.primary)
+	return c.shardOf(c.key(c.primary, parts))
+}
+
+// get returns values stored under key in index, from the relevant shard(s).
+func (c *StructCache[T]) get(index string, key structr.Key) []T {
+	if !c.fanout(index) {
+		shard := c.shardOf(key)
+		return shard.cache.Get(shard.index[index], key)
+	}
+
+	var values []T
+	for i := range c.shards {
+		shard := &c.shards[i]
+		values = append(values, shard.cache.Get(shard.index[index], key)...)
+	}
+	return values
+}
+
+// store caches a freshly loaded value in its shard. This goes via LoadOne()
+// by primary key rather than Put(), so as to not trigger the invalidate hook.
+func (c *StructCache[T]) store(value T) {
+	parts, _ := fieldValues(reflect.ValueOf(value), c.primary)
+	key := c.key(c.primary, parts)
+	shard := c.shardOf(key)
+	_, _ = shard.cache.LoadOne(shard.index[c.primary], key, func() (T, error) {
+		return value, nil
+	})
+}
+
+// invalidate invalidates values stored under keys in index, in the relevant shard(s).
+func (c *StructCache[T]) invalidate(index string, keys [][]any) {
+	if len(c.shards) == 1 {
+		shard := &c.shards[0]
+		i := shard.index[index]
+		shard.cache.Invalidate(i, i.Keys(keys...)...)
+		return
+	}
+
+	for _, parts := range keys {
+		key := c.key(index, parts)
+
+		if !c.fanout(index) {
+			shard := c.shardOf(key)
+			shard.cache.Invalidate(shard.index[index], key)
+			continue
+		}
+
+		for i := range c.shards {
+			shard := &c.shards[i]
+			shard.cache.Invalidate(shard.index[index], key)
+		}
+	}
 }
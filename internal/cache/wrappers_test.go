@@ -0,0 +1,756 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache_test
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"codeberg.org/gruf/go-structr"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func TestStructCacheZeroKeys(t *testing.T) {
+	testrig.InitTestConfig()
+
+	var c cache.Caches
+	c.Init()
+
+	// Two statuses without URL or poll,
+	// i.e. zero values for those indices.
+	status1 := &gtsmodel.Status{
+		ID:  "01F8MH75CBF9JFX4ZAD54N0W0R",
+		URI: "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+	}
+	status2 := &gtsmodel.Status{
+		ID:  "01F8MHAAY43M6RJ473VQFCVH37",
+		URI: "http://localhost:8080/users/admin/statuses/01F8MHAAY43M6RJ473VQFCVH37",
+	}
+	c.GTS.Status.Put(status1, status2)
+
+	// Both should be stored under non-zero indices,
+	// the second not having evicted the first from
+	// a zero key on the (unique) zero-valued indices.
+	for _, status := range []*gtsmodel.Status{status1, status2} {
+		if _, ok := c.GTS.Status.GetOne("ID", status.ID); !ok {
+			t.Errorf("status %s not cached by ID", status.ID)
+		}
+		if _, ok := c.GTS.Status.GetOne("URI", status.URI); !ok {
+			t.Errorf("status %s not cached by URI", status.ID)
+		}
+	}
+
+	// Neither should be indexed under zero keys.
+	if _, ok := c.GTS.Status.GetOne("URL", ""); ok {
+		t.Error("status cached under zero URL")
+	}
+	if _, ok := c.GTS.Status.GetOne("PollID", ""); ok {
+		t.Error("status cached under zero PollID")
+	}
+
+	// Local accounts have a zero domain, but
+	// Username,Domain explicitly allows zero.
+	account := &gtsmodel.Account{
+		ID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
+		URI:      "http://localhost:8080/users/the_mighty_zork",
+		Username: "the_mighty_zork",
+	}
+	c.GTS.Account.Put(account)
+	if _, ok := c.GTS.Account.GetOne("Username,Domain", account.Username, ""); !ok {
+		t.Error("local account not cached by Username,Domain")
+	}
+}
+
+func TestStructCacheSharded(t *testing.T) {
+	testrig.InitTestConfig()
+	config.SetCacheShards(8)
+
+	var c cache.Caches
+	c.Init()
+
+	statuses := make([]*gtsmodel.Status, 100)
+	for i := range statuses {
+		statusID := id.NewULID()
+		statuses[i] = &gtsmodel.Status{
+			ID:  statusID,
+			URI: "http://localhost:8080/users/admin/statuses/" + statusID,
+		}
+	}
+	c.GTS.Status.Put(statuses...)
+
+	if l := c.GTS.Status.Len(); l != len(statuses) {
+		t.Fatalf("expected %d cached statuses, got %d", len(statuses), l)
+	}
+
+	// Each should be found whether looked up
+	// by primary index, or by another index.
+	for _, status := range statuses {
+		if _, ok := c.GTS.Status.GetOne("ID", status.ID); !ok {
+			t.Errorf("status %s not cached by ID", status.ID)
+		}
+		if _, ok := c.GTS.Status.GetOne("URI", status.URI); !ok {
+			t.Errorf("status %s not cached by URI", status.ID)
+		}
+	}
+
+	// Invalidating by non-primary
+	// index must reach the right shard.
+	c.GTS.Status.Invalidate("URI", statuses[0].URI)
+	if _, ok := c.GTS.Status.GetOne("ID", statuses[0].ID); ok {
+		t.Error("status still cached after invalidation by URI")
+	}
+
+	// Load by non-primary index should call
+	// loader, then serve the result from cache.
+	var loads int
+	load := func() (*gtsmodel.Status, error) {
+		loads++
+		return statuses[0], nil
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.GTS.Status.LoadOne("URI", load, statuses[0].URI); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("expected 1 load, got %d", loads)
+	}
+	if _, ok := c.GTS.Status.GetOne("ID", statuses[0].ID); !ok {
+		t.Error("loaded status not cached by ID")
+	}
+
+	// Loading IDs should only pass uncached IDs
+	// to the loader, in one call across shards.
+	c.GTS.Status.InvalidateIDs("ID", []string{statuses[1].ID, statuses[2].ID})
+	var loaded []string
+	values, err := c.GTS.Status.LoadIDs("ID",
+		[]string{statuses[1].ID, statuses[2].ID, statuses[3].ID},
+		func(ids []string) ([]*gtsmodel.Status, error) {
+			loaded = append(loaded, ids...)
+			return []*gtsmodel.Status{statuses[1], statuses[2]}, nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 3 {
+		t.Errorf("expected 3 values, got %d", len(values))
+	}
+	if len(loaded) != 2 {
+		t.Errorf("expected 2 loaded IDs, got %v", loaded)
+	}
+
+	c.GTS.Status.Clear()
+	if l := c.GTS.Status.Len(); l != 0 {
+		t.Errorf("expected empty cache after clear, got %d", l)
+	}
+}
+
+func BenchmarkStructCacheShards(b *testing.B) {
+	for _, shards := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			var c cache.StructCache[*gtsmodel.Status]
+			c.InitSharded(structr.CacheConfig[*gtsmodel.Status]{
+				Indices: []structr.IndexConfig{
+					{Fields: "ID"},
+					{Fields: "URI"},
+				},
+				MaxSize: 10000,
+				Copy: func(s1 *gtsmodel.Status) *gtsmodel.Status {
+					s2 := new(gtsmodel.Status)
+					*s2 = *s1
+					return s2
+				},
+			}, shards)
+
+			statuses := make([]*gtsmodel.Status, 1000)
+			for i := range statuses {
+				statusID := id.NewULID()
+				statuses[i] = &gtsmodel.Status{
+					ID:  statusID,
+					URI: "http://localhost:8080/users/admin/statuses/" + statusID,
+				}
+			}
+			c.Put(statuses...)
+
+			// Many more goroutines than
+			// CPUs, to simulate high load.
+			b.SetParallelism(64)
+			b.ResetTimer()
+
+			var n atomic.Uint64
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := n.Add(1)
+					status := statuses[i%uint64(len(statuses))]
+					if i%10 == 0 {
+						c.Put(status)
+					} else {
+						c.GetOne("ID", status.ID)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestStructCacheGetCopy(t *testing.T) {
+	testrig.InitTestConfig()
+
+	var c cache.Caches
+	c.Init()
+
+	status := &gtsmodel.Status{
+		ID:      "01F8MH75CBF9JFX4ZAD54N0W0R",
+		URI:     "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+		Content: "hello world",
+	}
+	c.GTS.Status.Put(status)
+
+	// Mutate a returned value, as a caller
+	// populating it for their own use would.
+	got, ok := c.GTS.Status.GetOne("ID", status.ID)
+	if !ok {
+		t.Fatal("status not cached")
+	}
+	got.Content = "goodbye world"
+	got.Account = &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"}
+
+	// The cached value should be unaffected,
+	// by whichever index it's fetched.
+	for _, index := range []string{"ID", "URI"} {
+		key := status.ID
+		if index == "URI" {
+			key = status.URI
+		}
+
+		again, ok := c.GTS.Status.GetOne(index, key)
+		if !ok {
+			t.Fatalf("status not cached by %s", index)
+		}
+		if again == got {
+			t.Errorf("get by %s returned same pointer as previous get", index)
+		}
+		if again.Content != "hello world" {
+			t.Errorf("get by %s returned mutated content %q", index, again.Content)
+		}
+		if again.Account != nil {
+			t.Errorf("get by %s returned mutated account", index)
+		}
+	}
+}
+
+func TestStructrComputedIndex(t *testing.T) {
+	var c structr.Cache[*gtsmodel.Account]
+	c.Init(structr.CacheConfig[*gtsmodel.Account]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize: 100,
+		Copy: func(a *gtsmodel.Account) *gtsmodel.Account {
+			a2 := new(gtsmodel.Account)
+			*a2 = *a
+			return a2
+		},
+	})
+
+	// Index accounts by a normalized acct
+	// string, which isn't expressible as
+	// a simple set of struct fields.
+	c.AddComputedIndex("acct", func(a *gtsmodel.Account) string {
+		if a.Username == "" {
+			return ""
+		}
+		acct := a.Username
+		if a.Domain != "" {
+			acct += "@" + a.Domain
+		}
+		return strings.ToLower(acct)
+	})
+
+	accounts := []*gtsmodel.Account{
+		{
+			ID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Username: "the_mighty_zork",
+		},
+		{
+			ID:       "01F8MH5ZK5VRH73AKHQM6Y9VNX",
+			Username: "Foss_Satan",
+			Domain:   "fossbros-anonymous.io",
+		},
+		{
+			// No username, not indexed by acct.
+			ID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+	}
+	c.Put(accounts...)
+
+	idx := c.Index("acct")
+	for acct, accountID := range map[string]string{
+		"the_mighty_zork":                  accounts[0].ID,
+		"foss_satan@fossbros-anonymous.io": accounts[1].ID,
+	} {
+		account, ok := c.GetOne(idx, idx.Key(acct))
+		if !ok {
+			t.Errorf("account %s not cached by acct %s", accountID, acct)
+			continue
+		}
+		if account.ID != accountID {
+			t.Errorf("expected account %s for acct %s, got %s", accountID, acct, account.ID)
+		}
+	}
+
+	// Nothing should be indexed under zero key.
+	if _, ok := c.GetOne(idx, idx.Key("")); ok {
+		t.Error("account cached under zero acct")
+	}
+
+	// Invalidating by another index should
+	// also drop the computed index entry.
+	c.Invalidate(c.Index("ID"), c.Index("ID").Key(accounts[0].ID))
+	if _, ok := c.GetOne(idx, idx.Key("the_mighty_zork")); ok {
+		t.Error("account still cached by acct after invalidation by ID")
+	}
+
+	// Load by computed index should call
+	// loader, then serve result from cache.
+	var loads int
+	load := func() (*gtsmodel.Account, error) {
+		loads++
+		return accounts[0], nil
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.LoadOne(idx, idx.Key("the_mighty_zork"), load); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("expected 1 load, got %d", loads)
+	}
+	if _, ok := c.GetOne(c.Index("ID"), c.Index("ID").Key(accounts[0].ID)); !ok {
+		t.Error("loaded account not cached by ID")
+	}
+}
+
+// scanWorkload runs a workload against a status cache of given
+// size, where a small set of hot entries is first established,
+// before hot set accesses are interleaved with a scan of one-off
+// entries many times the cache size, e.g. as might be seen during
+// a bulk crawl. Returns the hit rate of the hot set during the scan.
+func scanWorkload(size int, scanResistant bool) float64 {
+	var c structr.Cache[*gtsmodel.Status]
+	c.Init(structr.CacheConfig[*gtsmodel.Status]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize:       size,
+		ScanResistant: scanResistant,
+		Copy: func(s *gtsmodel.Status) *gtsmodel.Status {
+			s2 := new(gtsmodel.Status)
+			*s2 = *s
+			return s2
+		},
+	})
+
+	idx := c.Index("ID")
+	load := func(statusID string) {
+		_, _ = c.LoadOne(idx, idx.Key(statusID), func() (*gtsmodel.Status, error) {
+			return &gtsmodel.Status{ID: statusID}, nil
+		})
+	}
+
+	// Hot set is a quarter of the cache size,
+	// each entry accessed more than once.
+	hot := make([]string, size/4)
+	for i := range hot {
+		hot[i] = fmt.Sprintf("hot-%d", i)
+		load(hot[i])
+		load(hot[i])
+	}
+
+	var hits, total int
+	for round := 0; round < 20; round++ {
+		for i, statusID := range hot {
+			if _, ok := c.GetOne(idx, idx.Key(statusID)); ok {
+				hits++
+			}
+			total++
+			load(statusID)
+
+			// Scan in one-off entries
+			// between each hot access.
+			for j := 0; j < 4; j++ {
+				load(fmt.Sprintf("scan-%d-%d-%d", round, i, j))
+			}
+		}
+	}
+
+	return float64(hits) / float64(total)
+}
+
+func TestStructrScanResistant(t *testing.T) {
+	const size = 1000
+
+	lru := scanWorkload(size, false)
+	slru := scanWorkload(size, true)
+
+	if slru <= lru {
+		t.Fatalf("expected scan resistant hot hit rate %f > plain lru hit rate %f", slru, lru)
+	}
+
+	if slru < 0.9 {
+		t.Fatalf("expected scan resistant hot hit rate >= 0.9, got %f", slru)
+	}
+}
+
+func TestStructrScanResistantEviction(t *testing.T) {
+	var c structr.Cache[*gtsmodel.Status]
+	c.Init(structr.CacheConfig[*gtsmodel.Status]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize:       10,
+		ScanResistant: true,
+		Copy: func(s *gtsmodel.Status) *gtsmodel.Status {
+			s2 := new(gtsmodel.Status)
+			*s2 = *s
+			return s2
+		},
+	})
+	idx := c.Index("ID")
+
+	// Fill the cache, then access every
+	// entry to promote them all, which
+	// demotes the oldest back down.
+	for i := 0; i < 10; i++ {
+		c.Put(&gtsmodel.Status{ID: fmt.Sprint(i)})
+	}
+	for i := 0; i < 10; i++ {
+		if _, ok := c.GetOne(idx, idx.Key(fmt.Sprint(i))); !ok {
+			t.Fatalf("status %d not cached", i)
+		}
+	}
+
+	if l := c.Len(); l != 10 {
+		t.Fatalf("expected cache length 10, got %d", l)
+	}
+
+	// Push more in than the cache size, the
+	// protected (most recently used) entries
+	// should all survive the scan.
+	for i := 10; i < 100; i++ {
+		c.Put(&gtsmodel.Status{ID: fmt.Sprint(i)})
+	}
+
+	if l := c.Len(); l != 10 {
+		t.Fatalf("expected cache length 10, got %d", l)
+	}
+	for i := 2; i < 10; i++ {
+		if _, ok := c.GetOne(idx, idx.Key(fmt.Sprint(i))); !ok {
+			t.Errorf("protected status %d evicted by scan", i)
+		}
+	}
+
+	// Trim should still empty
+	// both of the segments.
+	c.Trim(0)
+	if l := c.Len(); l != 0 {
+		t.Fatalf("expected empty cache after trim, got %d", l)
+	}
+}
+
+func BenchmarkStructrScanResistant(b *testing.B) {
+	for _, scanResistant := range []bool{false, true} {
+		b.Run(fmt.Sprintf("ScanResistant=%t", scanResistant), func(b *testing.B) {
+			var rate float64
+			for i := 0; i < b.N; i++ {
+				rate = scanWorkload(1000, scanResistant)
+			}
+			b.ReportMetric(rate*100, "hot-hit-%")
+		})
+	}
+}
+
+func TestStructrConfigValidate(t *testing.T) {
+	config := structr.CacheConfig[*gtsmodel.Status]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "URI"},
+			{Fields: "AcountID"},
+			{Fields: "InReplyToID,BoostOf.Nope"},
+		},
+		MaxSize: 10,
+		Copy:    func(s *gtsmodel.Status) *gtsmodel.Status { return s },
+	}
+
+	// All bad indices should be
+	// reported, not just the first.
+	err := config.Validate()
+	if err == nil {
+		t.Fatal("expected error validating cache config")
+	}
+	for _, expect := range []string{
+		`index "AcountID": unknown field: AcountID`,
+		`index "InReplyToID,BoostOf.Nope": unknown field: Nope`,
+	} {
+		if !strings.Contains(err.Error(), expect) {
+			t.Errorf("expected error to contain %q, got %q", expect, err.Error())
+		}
+	}
+
+	config.Indices = config.Indices[:2]
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected error validating cache config: %v", err)
+	}
+}
+
+func TestCachesTryInit(t *testing.T) {
+	testrig.InitTestConfig()
+
+	var c cache.Caches
+	if err := c.TryInit(); err != nil {
+		t.Fatalf("unexpected error initializing caches: %v", err)
+	}
+
+	// Every cache should be described,
+	// with struct caches listing their
+	// indices, primary index first.
+	var found bool
+	for _, info := range c.Info() {
+		if info.Cap <= 0 && !strings.HasPrefix(info.Name, "Domain") {
+			t.Errorf("expected cache %s to have capacity", info.Name)
+		}
+		if info.Name == "Status" {
+			found = true
+			if len(info.Indices) == 0 || info.Indices[0] != "ID" {
+				t.Errorf("unexpected status cache indices: %v", info.Indices)
+			}
+			if info.EntrySize == 0 {
+				t.Error("expected status cache entry size")
+			}
+		}
+	}
+	if !found {
+		t.Fatal("status cache not found in cache info")
+	}
+}
+
+func TestStructCacheGetAll(t *testing.T) {
+	for _, shards := range []int{1, 4} {
+		t.Run(fmt.Sprintf("shards=%d", shards), func(t *testing.T) {
+			testrig.InitTestConfig()
+			config.SetCacheShards(shards)
+
+			var c cache.Caches
+			c.Init()
+
+			const authorID = "01F8MH1H7YV1Z7D2C8K2730QBF"
+
+			// Store several statuses by one author,
+			// interleaved with statuses by another.
+			var expect []string
+			for i := 0; i < 10; i++ {
+				statusID := id.NewULID()
+				accountID := authorID
+				if i%3 == 0 {
+					accountID = "01F8MH17FWEB39HZJ76B6VXSKF"
+				} else {
+					expect = append(expect, statusID)
+				}
+				c.GTS.Status.Put(&gtsmodel.Status{
+					ID:        statusID,
+					URI:       "http://localhost:8080/users/admin/statuses/" + statusID,
+					AccountID: accountID,
+				})
+			}
+
+			// All should be returned,
+			// in insertion order.
+			statuses := c.GTS.Status.GetAll("AccountID", authorID)
+			got := make([]string, len(statuses))
+			for i, status := range statuses {
+				got[i] = status.ID
+			}
+			if strings.Join(got, ",") != strings.Join(expect, ",") {
+				t.Fatalf("expected statuses %v, got %v", expect, got)
+			}
+
+			if statuses := c.GTS.Status.GetAll("AccountID", "01F8MH0BBE4FHXPH513MBVFHB0"); len(statuses) != 0 {
+				t.Fatalf("expected no statuses, got %d", len(statuses))
+			}
+		})
+	}
+}
+
+func TestStructrGetAllNoTouch(t *testing.T) {
+	var c structr.Cache[*gtsmodel.Status]
+	c.Init(structr.CacheConfig[*gtsmodel.Status]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize: 4,
+		Copy: func(s *gtsmodel.Status) *gtsmodel.Status {
+			s2 := new(gtsmodel.Status)
+			*s2 = *s
+			return s2
+		},
+	})
+	idx := c.Index("AccountID")
+
+	for i := 0; i < 4; i++ {
+		c.Put(&gtsmodel.Status{ID: fmt.Sprint(i), AccountID: "a"})
+	}
+
+	// Fetching all without touch
+	// must not promote the oldest.
+	if l := len(c.GetAll(idx, idx.Key("a"), false)); l != 4 {
+		t.Fatalf("expected 4 statuses, got %d", l)
+	}
+	c.Put(&gtsmodel.Status{ID: "4", AccountID: "b"})
+
+	values := c.GetAll(idx, idx.Key("a"), false)
+	if len(values) != 3 || values[0].ID != "1" {
+		t.Fatalf("expected oldest status to be evicted, got %v", values)
+	}
+
+	// Whereas with touch, they're
+	// all marked as recently used.
+	_ = c.GetAll(idx, idx.Key("a"), true)
+	c.Put(&gtsmodel.Status{ID: "5", AccountID: "b"})
+
+	if l := len(c.GetAll(idx, idx.Key("a"), false)); l != 3 {
+		t.Fatalf("expected 3 statuses after touch, got %d", l)
+	}
+}
+
+func TestStructCacheIndexStats(t *testing.T) {
+	testrig.InitTestConfig()
+	config.SetCacheShards(1)
+
+	var c cache.Caches
+	c.Init()
+
+	// Store a skewed spread of statuses,
+	// with one author holding most of them.
+	authors := map[string]int{
+		"01F8MH1H7YV1Z7D2C8K2730QBF": 50,
+		"01F8MH17FWEB39HZJ76B6VXSKF": 5,
+		"01F8MH0BBE4FHXPH513MBVFHB0": 1,
+	}
+	var total int
+	for accountID, count := range authors {
+		for i := 0; i < count; i++ {
+			statusID := id.NewULID()
+			c.GTS.Status.Put(&gtsmodel.Status{
+				ID:        statusID,
+				URI:       "http://localhost:8080/users/admin/statuses/" + statusID,
+				AccountID: accountID,
+			})
+		}
+		total += count
+	}
+
+	stats := c.GTS.Status.IndexStats()
+
+	stat := stats["AccountID"]
+	if stat.Keys != len(authors) {
+		t.Errorf("expected %d AccountID keys, got %d", len(authors), stat.Keys)
+	}
+	if stat.Entries != total {
+		t.Errorf("expected %d AccountID entries, got %d", total, stat.Entries)
+	}
+	if stat.MaxBucket != 50 {
+		t.Errorf("expected AccountID max bucket 50, got %d", stat.MaxBucket)
+	}
+
+	// Unique indices should have
+	// exactly one entry per key.
+	stat = stats["ID"]
+	if stat.Keys != total || stat.Entries != total || stat.MaxBucket != 1 {
+		t.Errorf("unexpected ID index stats: %+v", stat)
+	}
+
+	// And stats should be reachable
+	// from the top-level caches too.
+	if s := c.IndexStats()["Status"]["AccountID"]; s.MaxBucket != 50 {
+		t.Errorf("expected Status AccountID max bucket 50, got %d", s.MaxBucket)
+	}
+}
+
+func TestStructCacheStatsName(t *testing.T) {
+	for _, shards := range []int{1, 4} {
+		t.Run(fmt.Sprintf("shards=%d", shards), func(t *testing.T) {
+			var c cache.StructCache[*gtsmodel.Status]
+			c.InitSharded(structr.CacheConfig[*gtsmodel.Status]{
+				Name: "status_cache",
+				Indices: []structr.IndexConfig{
+					{Fields: "ID"},
+					{Fields: "AccountID", Multiple: true},
+				},
+				MaxSize: 100,
+				Copy: func(s1 *gtsmodel.Status) *gtsmodel.Status {
+					s2 := new(gtsmodel.Status)
+					*s2 = *s1
+					return s2
+				},
+			}, shards)
+
+			c.Put(&gtsmodel.Status{ID: "1", AccountID: "a"})
+			c.Put(&gtsmodel.Status{ID: "2", AccountID: "a"})
+
+			if name := c.Name(); name != "status_cache" {
+				t.Fatalf("expected cache name status_cache, got %q", name)
+			}
+
+			// Name should propagate
+			// into all stats output.
+			stats := c.Stats()
+			if stats.Name != "status_cache" {
+				t.Errorf("expected stats name status_cache, got %q", stats.Name)
+			}
+			if stats.Len != 2 {
+				t.Errorf("expected stats len 2, got %d", stats.Len)
+			}
+			for index, stat := range stats.Indices {
+				if stat.Cache != "status_cache" {
+					t.Errorf("expected %s index stats cache name status_cache, got %q", index, stat.Cache)
+				}
+			}
+		})
+	}
+
+	// Caches initialized by
+	// Caches{} are all named.
+	testrig.InitTestConfig()
+	var c cache.Caches
+	c.Init()
+
+	if name := c.GTS.Account.Name(); name != "account_cache" {
+		t.Errorf("expected account cache name account_cache, got %q", name)
+	}
+	if s := c.IndexStats()["Status"]["ID"]; s.Cache != "status_cache" {
+		t.Errorf("expected Status ID index stats cache name status_cache, got %q", s.Cache)
+	}
+}
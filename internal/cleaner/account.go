@@ -0,0 +1,161 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Account encompasses a set of
+// account cleanup / admin utils.
+type Account struct{ *Cleaner }
+
+// LogRecountStats performs Account.RecountStats(...), logging the start and outcome.
+func (a *Account) LogRecountStats(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := a.RecountStats(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "corrected: %d", n)
+	}
+}
+
+// RecountStats recounts the stored stats counters of all local accounts
+// from the source tables, in batches, updating any that have drifted.
+// Returns the number of accounts whose stats were corrected.
+//
+// Stats of remote accounts are left alone, since they're set from
+// values asserted by the remote actor and its collections when the
+// account is dereferenced, which are preferable to a partial local count.
+//
+// Each account is counted and updated separately, so no long-running
+// transactions or table locks are held, and it's safe to run this
+// alongside normal operation.
+func (a *Account) RecountStats(ctx context.Context) (int, error) {
+	var (
+		maxID   string
+		checked int
+		total   int
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		// Fetch the next batch of local account IDs.
+		accountIDs, err := a.state.DB.GetLocalAccountIDs(ctx, maxID, selectLimit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting local accounts: %w", err)
+		}
+
+		// If no accounts are returned, we reached the end.
+		if len(accountIDs) == 0 {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = accountIDs[len(accountIDs)-1]
+
+		for _, accountID := range accountIDs {
+			corrected, err := a.recountStats(ctx, accountID)
+			if err != nil {
+				return total, err
+			}
+
+			checked++
+			if corrected {
+				total++
+			}
+		}
+	}
+
+	log.Infof(ctx, "checked stats of %d local accounts", checked)
+	return total, nil
+}
+
+func (a *Account) recountStats(ctx context.Context, accountID string) (bool, error) {
+	account, err := a.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), accountID)
+	if err != nil {
+		return false, gtserror.Newf("error getting account %s: %w", accountID, err)
+	}
+
+	stats, err := a.state.DB.GetAccountStats(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting stats for account %s: %w", accountID, err)
+	}
+
+	if stats == nil {
+		// No stats stored yet, these
+		// will be freshly generated
+		// when they're first needed.
+		return false, nil
+	}
+
+	fresh, err := a.state.DB.CountAccountStats(ctx, account)
+	if err != nil {
+		return false, gtserror.Newf("error counting stats for account %s: %w", accountID, err)
+	}
+
+	// Check each counter against its
+	// fresh value, noting which differ.
+	var columns []string
+	for _, counter := range []struct {
+		column string
+		stored **int
+		fresh  *int
+	}{
+		{"followers_count", &stats.FollowersCount, fresh.FollowersCount},
+		{"following_count", &stats.FollowingCount, fresh.FollowingCount},
+		{"follow_requests_count", &stats.FollowRequestsCount, fresh.FollowRequestsCount},
+		{"statuses_count", &stats.StatusesCount, fresh.StatusesCount},
+		{"statuses_pinned_count", &stats.StatusesPinnedCount, fresh.StatusesPinnedCount},
+	} {
+		stored := util.PtrValueOr(*counter.stored, 0)
+		if stored == *counter.fresh {
+			continue
+		}
+
+		log.Infof(ctx, "account %s %s drifted: %d -> %d",
+			account.Username, counter.column, stored, *counter.fresh)
+		*counter.stored = counter.fresh
+		columns = append(columns, counter.column)
+	}
+
+	if len(columns) == 0 {
+		// Nothing to correct.
+		return false, nil
+	}
+
+	// Update the drifted counters, which
+	// also updates the cached stats entry.
+	stats.RegeneratedAt = fresh.RegeneratedAt
+	columns = append(columns, "regenerated_at")
+	if err := a.state.DB.UpdateAccountStats(ctx, stats, columns...); err != nil {
+		return false, gtserror.Newf("error updating stats for account %s: %w", accountID, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (suite *CleanerTestSuite) TestAccountRecountStats() {
+	ctx := context.Background()
+
+	// Start from a clean slate, in case
+	// test model stats have drifted.
+	_, err := suite.cleaner.Account().RecountStats(ctx)
+	suite.NoError(err)
+
+	local := suite.testAccounts["local_account_1"]
+	remote := suite.testAccounts["remote_account_1"]
+
+	// Drift the stats of a local
+	// and a remote account.
+	for _, account := range []string{local.ID, remote.ID} {
+		account, err := suite.state.DB.GetAccountByID(ctx, account)
+		suite.NoError(err)
+
+		suite.NoError(suite.state.DB.PopulateAccountStats(ctx, account))
+		account.Stats.StatusesCount = util.Ptr(9999)
+		account.Stats.FollowersCount = util.Ptr(9999)
+		suite.NoError(suite.state.DB.UpdateAccountStats(ctx,
+			account.Stats,
+			"statuses_count",
+			"followers_count",
+		))
+	}
+
+	// Only the local account should be corrected.
+	n, err := suite.cleaner.Account().RecountStats(ctx)
+	suite.NoError(err)
+	suite.Equal(1, n)
+
+	localStats, err := suite.state.DB.GetAccountStats(ctx, local.ID)
+	suite.NoError(err)
+	fresh, err := suite.state.DB.CountAccountStats(ctx, local)
+	suite.NoError(err)
+	suite.Equal(*fresh.StatusesCount, *localStats.StatusesCount)
+	suite.Equal(*fresh.FollowersCount, *localStats.FollowersCount)
+
+	remoteStats, err := suite.state.DB.GetAccountStats(ctx, remote.ID)
+	suite.NoError(err)
+	suite.Equal(9999, *remoteStats.StatusesCount)
+	suite.Equal(9999, *remoteStats.FollowersCount)
+
+	// Nothing left to correct.
+	n, err = suite.cleaner.Account().RecountStats(ctx)
+	suite.NoError(err)
+	suite.Zero(n)
+}
@@ -25,28 +25,42 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/scheduler"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 )
 
 const (
 	selectLimit = 50
+
+	// walkConcurrency is the number of storage
+	// keys checked at once during storage walks.
+	walkConcurrency = 8
 )
 
 type Cleaner struct {
-	state *state.State
-	emoji Emoji
-	media Media
+	state   *state.State
+	account Account
+	emoji   Emoji
+	media   Media
+	status  Status
 }
 
 func New(state *state.State) *Cleaner {
 	c := new(Cleaner)
 	c.state = state
+	c.account.Cleaner = c
 	c.emoji.Cleaner = c
 	c.media.Cleaner = c
+	c.status.Cleaner = c
 	return c
 }
 
+// Account returns the account set of cleaner utilities.
+func (c *Cleaner) Account() *Account {
+	return &c.account
+}
+
 // Emoji returns the emoji set of cleaner utilities.
 func (c *Cleaner) Emoji() *Emoji {
 	return &c.emoji
@@ -57,6 +71,11 @@ func (c *Cleaner) Media() *Media {
 	return &c.media
 }
 
+// Status returns the status set of cleaner utilities.
+func (c *Cleaner) Status() *Status {
+	return &c.status
+}
+
 // haveFiles returns whether all of the provided files exist within current storage.
 func (c *Cleaner) haveFiles(ctx context.Context, files ...string) (bool, error) {
 	for _, file := range files {
@@ -109,7 +128,8 @@ func (c *Cleaner) removeFiles(ctx context.Context, files ...string) (int, error)
 // jobs using configured parameters.
 //
 // Returns an error if `MediaCleanupFrom`
-// is not a valid format (hh:mm:ss).
+// is not a valid format (hh:mm:ss), or if
+// `DbMaintenanceSchedule` is not valid cron.
 func (c *Cleaner) ScheduleJobs() error {
 	const hourMinute = "15:04"
 
@@ -169,5 +189,189 @@ func (c *Cleaner) ScheduleJobs() error {
 		panic("failed to schedule @mediacleanup")
 	}
 
+	c.scheduleStatusRetention()
+	c.scheduleRetentionPolicy()
+	c.scheduleAccountStatsRecount()
+	c.scheduleWordCountBackfill()
+
+	return c.scheduleDBMaintenance()
+}
+
+// scheduleStatusRetention schedules the status
+// retention job to run hourly, deleting old
+// statuses of accounts that have opted in.
+func (c *Cleaner) scheduleStatusRetention() {
+	// Start at the top of the next hour.
+	firstRetentionAt := time.Now().Truncate(time.Hour).Add(time.Hour)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting status retention")
+		c.Status().LogRetention(ctx)
+		log.Infof(ctx, "finished status retention after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling status retention to run every hour; next run will be at %s",
+		firstRetentionAt,
+	)
+
+	// Schedule status retention to execute hourly,
+	// in which each account is limited to a max no.
+	// of deletes, effectively rate limiting deletes.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@statusretention",
+		firstRetentionAt,
+		time.Hour,
+		fn,
+	) {
+		panic("failed to schedule @statusretention")
+	}
+}
+
+// scheduleRetentionPolicy schedules the instance
+// data retention policy job to run nightly, deleting
+// statuses and media older than the policy allows.
+func (c *Cleaner) scheduleRetentionPolicy() {
+	// Start at the next midnight (local time).
+	now := time.Now()
+	firstPolicyAt := time.Date(
+		now.Year(),
+		now.Month(),
+		now.Day()+1,
+		0, 0, 0, 0,
+		now.Location(),
+	)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting retention policy")
+		c.Status().LogRetentionPolicy(ctx)
+		c.Media().LogRetentionPolicy(ctx)
+		log.Infof(ctx, "finished retention policy after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling retention policy to run every 24h; next run will be at %s",
+		firstPolicyAt,
+	)
+
+	// Schedule retention policy to execute nightly. This
+	// is a no-op unless the admin has set a policy.
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@retentionpolicy",
+		firstPolicyAt,
+		24*time.Hour,
+		fn,
+	) {
+		panic("failed to schedule @retentionpolicy")
+	}
+}
+
+// scheduleAccountStatsRecount schedules the local
+// account stats recount job to run weekly, if enabled.
+func (c *Cleaner) scheduleAccountStatsRecount() {
+	if !config.GetAccountsStatsRecountWeekly() {
+		log.Info(nil, "weekly account stats recount disabled")
+		return
+	}
+
+	// Start at the next midnight (local time).
+	now := time.Now()
+	firstRecountAt := time.Date(
+		now.Year(),
+		now.Month(),
+		now.Day()+1,
+		0, 0, 0, 0,
+		now.Location(),
+	)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting account stats recount")
+		c.Account().LogRecountStats(ctx)
+		log.Infof(ctx, "finished account stats recount after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling account stats recount to run every 7 days; next run will be at %s",
+		firstRecountAt,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@accountstatsrecount",
+		firstRecountAt,
+		7*24*time.Hour,
+		fn,
+	) {
+		panic("failed to schedule @accountstatsrecount")
+	}
+}
+
+// scheduleWordCountBackfill schedules a one-off job
+// shortly after startup to count the words of statuses
+// stored before word counts were. This is a no-op once
+// all existing statuses have been backfilled.
+func (c *Cleaner) scheduleWordCountBackfill() {
+	backfillAt := time.Now().Add(time.Minute)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting status word count backfill")
+		c.Status().LogBackfillWordCounts(ctx)
+		log.Infof(ctx, "finished status word count backfill after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling status word count backfill to run at %s",
+		backfillAt,
+	)
+
+	if !c.state.Workers.Scheduler.AddOnce(
+		"@wordcountbackfill",
+		backfillAt,
+		fn,
+	) {
+		panic("failed to schedule @wordcountbackfill")
+	}
+}
+
+// scheduleDBMaintenance schedules database maintenance
+// according to configured cron schedule, if any is set.
+func (c *Cleaner) scheduleDBMaintenance() error {
+	schedule := config.GetDbMaintenanceSchedule()
+	if schedule == "" {
+		log.Info(nil, "database maintenance disabled")
+		return nil
+	}
+
+	cron, err := scheduler.ParseCron(schedule)
+	if err != nil {
+		return gtserror.Newf("error parsing db maintenance schedule: %w", err)
+	}
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting database maintenance")
+		ran, err := c.state.DB.Vacuum(ctx)
+		switch {
+		case err != nil:
+			log.Errorf(ctx, "error performing database maintenance: %v", err)
+		case !ran:
+			log.Info(ctx, "database maintenance already running elsewhere, skipping")
+		default:
+			log.Infof(ctx, "finished database maintenance after %s", time.Since(start))
+		}
+	}
+
+	log.Infof(nil,
+		"scheduling database maintenance to run at '%s'; next maintenance will run at %s",
+		schedule, cron.Next(time.Now()),
+	)
+
+	// Schedule maintenance to execute according to schedule.
+	if !c.state.Workers.Scheduler.AddCron(
+		"@dbmaintenance",
+		cron,
+		fn,
+	) {
+		panic("failed to schedule @dbmaintenance")
+	}
+
 	return nil
 }
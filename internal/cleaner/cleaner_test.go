@@ -28,9 +28,10 @@ import (
 )
 
 type CleanerTestSuite struct {
-	state   state.State
-	cleaner *cleaner.Cleaner
-	emojis  map[string]*gtsmodel.Emoji
+	state        state.State
+	cleaner      *cleaner.Cleaner
+	emojis       map[string]*gtsmodel.Emoji
+	testAccounts map[string]*gtsmodel.Account
 	suite.Suite
 }
 
@@ -61,8 +62,9 @@ func (suite *CleanerTestSuite) SetupTest() {
 	testrig.StartNoopWorkers(&suite.state)
 	suite.cleaner = cleaner.New(&suite.state)
 
-	// Allocate new test model emojis.
+	// Allocate new test model emojis + accounts.
 	suite.emojis = testrig.NewTestEmojis()
+	suite.testAccounts = testrig.NewTestAccounts()
 }
 
 func (suite *CleanerTestSuite) TearDownTest() {
@@ -20,12 +20,15 @@ package cleaner
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
@@ -37,6 +40,30 @@ import (
 // media cleanup / admin utils.
 type Media struct{ *Cleaner }
 
+// MediaCollector is a callback passed each media attachment that
+// cleaner.Media utilities are about to uncache or delete, before
+// doing so. Combined with `gtscontext.SetDryRun()`, this allows
+// callers to collect the candidates without anything being removed.
+type MediaCollector func(ctx context.Context, media *gtsmodel.MediaAttachment)
+
+// mediaCollectorKey is the context
+// key for a set MediaCollector.
+type mediaCollectorKey struct{}
+
+// SetMediaCollector returns a context which will cause cleaner.Media
+// utilities to pass each attachment they uncache or delete to fn.
+func SetMediaCollector(ctx context.Context, fn MediaCollector) context.Context {
+	return context.WithValue(ctx, mediaCollectorKey{}, fn)
+}
+
+// collect passes media to the MediaCollector
+// set on context, if any, else does nothing.
+func collect(ctx context.Context, media *gtsmodel.MediaAttachment) {
+	if fn, _ := ctx.Value(mediaCollectorKey{}).(MediaCollector); fn != nil {
+		fn(ctx, media)
+	}
+}
+
 // All will execute all cleaner.Media utilities synchronously, including output logging.
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
 func (m *Media) All(ctx context.Context, maxRemoteDays int) {
@@ -88,13 +115,26 @@ func (m *Media) LogFixCacheStates(ctx context.Context) {
 	}
 }
 
+// LogRetentionPolicy performs Media.RetentionPolicy(...), logging the start and outcome.
+func (m *Media) LogRetentionPolicy(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := m.RetentionPolicy(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "unreferenced: %d", n)
+	}
+}
+
 // PruneOrphaned will delete orphaned files from storage (i.e. media missing a database entry).
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
 func (m *Media) PruneOrphaned(ctx context.Context) (int, error) {
-	var files []string
+	var (
+		files   []string
+		filesMu sync.Mutex
+	)
 
 	// All media files in storage will have path fitting: {$account}/{$type}/{$size}/{$id}.{$ext}
-	if err := m.state.Storage.WalkKeys(ctx, func(path string) error {
+	if err := m.state.Storage.WalkKeysConcurrent(ctx, walkConcurrency, func(path string) error {
 		// Check for our expected fileserver path format.
 		if !regexes.FilePath.MatchString(path) {
 			log.Warn(ctx, "unexpected storage item: %s", path)
@@ -109,7 +149,9 @@ func (m *Media) PruneOrphaned(ctx context.Context) (int, error) {
 
 		if orphaned {
 			// Add this orphaned entry.
+			filesMu.Lock()
 			files = append(files, path)
+			filesMu.Unlock()
 		}
 
 		return nil
@@ -264,6 +306,75 @@ func (m *Media) FixCacheStates(ctx context.Context) (int, error) {
 	return total, nil
 }
 
+// RetentionPolicy will unreference and delete all status media attachments older than the
+// instance retention policy's max media age, for local and / or remote accounts as set in
+// the policy. Media attached to pinned statuses, and account avatars / headers, are kept.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (m *Media) RetentionPolicy(ctx context.Context) (int, error) {
+	var total int
+
+	policy, err := m.state.DB.GetRetentionPolicy(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return total, gtserror.Newf("error getting retention policy: %w", err)
+	}
+
+	if policy == nil || !policy.Enabled() || policy.MaxMediaAgeDays <= 0 {
+		// Nothing to do.
+		return total, nil
+	}
+
+	// Calculate the cutoff time, and from it the highest
+	// media ID we may delete (media IDs are time-based).
+	cutoff := time.Now().AddDate(0, 0, -policy.MaxMediaAgeDays)
+	maxID, err := id.NewULIDFromTime(cutoff)
+	if err != nil {
+		return total, gtserror.Newf("error generating max id: %w", err)
+	}
+
+	// Set page select limit
+	// and starting max ID.
+	page := paging.Page{
+		Max:   paging.MaxID(maxID),
+		Limit: selectLimit,
+	}
+
+	for {
+		// Fetch the next batch of media attachments up to next max ID.
+		attachments, err := m.state.DB.GetAttachments(ctx, &page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting attachments: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no attachments or the same group is returned, we reached the end.
+		if len(attachments) == 0 || maxID == attachments[len(attachments)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID' value.
+		maxID = attachments[len(attachments)-1].ID
+		page.Max = paging.MaxID(maxID)
+
+		for _, media := range attachments {
+			// Check / unreference each media attachment.
+			deleted, err := m.retentionPolicy(ctx, policy, media)
+			if err != nil {
+				return total, err
+			}
+
+			if deleted {
+				// Update
+				// count.
+				total++
+			}
+		}
+	}
+
+	return total, nil
+}
+
 func (m *Media) isOrphaned(ctx context.Context, path string) (bool, error) {
 	pathParts := regexes.FilePath.FindStringSubmatch(path)
 	if len(pathParts) != 6 {
@@ -496,6 +607,71 @@ func (m *Media) uncacheRemote(ctx context.Context, after time.Time, media *gtsmo
 	return true, m.uncache(ctx, media)
 }
 
+func (m *Media) retentionPolicy(ctx context.Context, policy *gtsmodel.RetentionPolicy, media *gtsmodel.MediaAttachment) (bool, error) {
+	if *media.Avatar || *media.Header {
+		// Account media isn't
+		// covered by the policy.
+		return false, nil
+	}
+
+	// Start a log entry for media.
+	l := log.WithContext(ctx).
+		WithField("media", media.ID)
+
+	// Check whether we have the account that owns the media.
+	account, missing, err := m.getOwningAccount(ctx, media)
+	if err != nil {
+		return false, err
+	} else if missing || account == nil {
+		// PruneUnused will take care of this case.
+		l.Debug("skipping due to missing account")
+		return false, nil
+	}
+
+	if !policy.AppliesTo(account) {
+		// Policy doesn't apply
+		// to this account's media.
+		return false, nil
+	}
+
+	// Check whether we have the status that media is attached to.
+	status, missing, err := m.getRelatedStatus(ctx, media)
+	if err != nil {
+		return false, err
+	} else if missing {
+		// PruneUnused will take care of this case.
+		l.Debug("skipping due to missing status")
+		return false, nil
+	}
+
+	if status != nil && !status.PinnedAt.IsZero() {
+		l.Debug("skipping due to pinned status")
+		return false, nil
+	}
+
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return true, nil
+	}
+
+	if status != nil {
+		// Unreference media from the status it's attached
+		// to, so the status isn't left pointing at nothing.
+		status.AttachmentIDs = slices.DeleteFunc(status.AttachmentIDs,
+			func(id string) bool { return id == media.ID },
+		)
+		status.Attachments = nil
+
+		if err := m.state.DB.UpdateStatus(ctx, status, "attachments"); err != nil {
+			return false, gtserror.Newf("error updating status: %w", err)
+		}
+	}
+
+	// This media is too old, delete it.
+	l.Debug("deleting media past retention policy")
+	return true, m.delete(ctx, media)
+}
+
 func (m *Media) getOwningAccount(ctx context.Context, media *gtsmodel.MediaAttachment) (*gtsmodel.Account, bool, error) {
 	if media.AccountID == "" {
 		// no related account.
@@ -543,6 +719,9 @@ func (m *Media) getRelatedStatus(ctx context.Context, media *gtsmodel.MediaAttac
 }
 
 func (m *Media) uncache(ctx context.Context, media *gtsmodel.MediaAttachment) error {
+	// Pass to any collector.
+	collect(ctx, media)
+
 	if gtscontext.DryRun(ctx) {
 		// Dry run, do nothing.
 		return nil
@@ -568,6 +747,9 @@ func (m *Media) uncache(ctx context.Context, media *gtsmodel.MediaAttachment) er
 }
 
 func (m *Media) delete(ctx context.Context, media *gtsmodel.MediaAttachment) error {
+	// Pass to any collector.
+	collect(ctx, media)
+
 	if gtscontext.DryRun(ctx) {
 		// Dry run, do nothing.
 		return nil
@@ -36,6 +36,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -338,6 +339,34 @@ func (suite *MediaTestSuite) TestUncacheRemoteDry() {
 	suite.True(*uncachedAttachment.Cached)
 }
 
+func (suite *MediaTestSuite) TestUncacheRemoteDryCollect() {
+	ctx := context.Background()
+
+	testStatusAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(*testStatusAttachment.Cached)
+
+	// Collect the media that would be uncached.
+	var collected []string
+	collectCtx := cleaner.SetMediaCollector(
+		gtscontext.SetDryRun(ctx),
+		func(_ context.Context, media *gtsmodel.MediaAttachment) {
+			collected = append(collected, media.ID)
+		},
+	)
+
+	after := time.Now().Add(-24 * time.Hour)
+	totalUncached, err := suite.cleaner.Media().UncacheRemote(collectCtx, after)
+	suite.NoError(err)
+	suite.Equal(3, totalUncached)
+	suite.Len(collected, totalUncached)
+	suite.Contains(collected, testStatusAttachment.ID)
+
+	// Nothing was actually uncached.
+	uncachedAttachment, err := suite.db.GetAttachmentByID(ctx, testStatusAttachment.ID)
+	suite.NoError(err)
+	suite.True(*uncachedAttachment.Cached)
+}
+
 func (suite *MediaTestSuite) TestUncacheRemoteTwice() {
 	ctx := context.Background()
 	after := time.Now().Add(-24 * time.Hour)
@@ -426,3 +455,37 @@ func (suite *MediaTestSuite) TestUncacheOneNonExistent() {
 	suite.NoError(err)
 	suite.Equal(3, totalUncached)
 }
+
+func (suite *MediaTestSuite) TestRetentionPolicy() {
+	ctx := context.Background()
+
+	testRemoteAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	testLocalAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+
+	// Delete old remote media only.
+	if err := suite.db.PutRetentionPolicy(ctx, &gtsmodel.RetentionPolicy{
+		ID:              "01J0A4Q2G7V8ZK3NWJ5T1XH6RB",
+		MaxMediaAgeDays: 1,
+		AppliesToLocal:  util.Ptr(false),
+		AppliesToRemote: util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	totalDeleted, err := suite.cleaner.Media().RetentionPolicy(ctx)
+	suite.NoError(err)
+	suite.NotZero(totalDeleted)
+
+	// Remote attachment should be gone, and
+	// unreferenced from the status it was on.
+	_, err = suite.db.GetAttachmentByID(ctx, testRemoteAttachment.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	status, err := suite.db.GetStatusByID(ctx, testRemoteAttachment.StatusID)
+	suite.NoError(err)
+	suite.NotContains(status.AttachmentIDs, testRemoteAttachment.ID)
+
+	// Local attachment should be untouched.
+	_, err = suite.db.GetAttachmentByID(ctx, testLocalAttachment.ID)
+	suite.NoError(err)
+}
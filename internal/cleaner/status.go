@@ -0,0 +1,548 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// inflightTimeout is the time after which a queued
+// retention delete that has still not removed its
+// status is dropped from the in-flight set, so that
+// it may be queued again on a later run.
+const inflightTimeout = 24 * time.Hour
+
+// Status encompasses a set of
+// status cleanup / admin utils.
+type Status struct {
+	*Cleaner
+
+	// inflight holds IDs of statuses with a retention
+	// delete queued to the client worker, mapped to
+	// the time it was queued, protected by mutex.
+	inflight map[string]time.Time
+	mutex    sync.Mutex
+}
+
+// LogRetention performs Status.Retention(...), logging the start and outcome.
+func (s *Status) LogRetention(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := s.Retention(ctx, config.GetStatusesRetentionDeletesPerHour()); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "deleted: %d", n)
+	}
+}
+
+// Retention will delete old statuses for all accounts that have explicitly opted
+// in to status retention, according to each account's retention settings. At most
+// maxPerAccount statuses will be deleted for each account, to rate limit the rate
+// at which Deletes are federated. Context will be checked for `gtscontext.DryRun()`
+// in order to actually perform the action.
+func (s *Status) Retention(ctx context.Context, maxPerAccount int) (int, error) {
+	var total int
+
+	// Fetch IDs of all accounts that have opted in.
+	accountIDs, err := s.state.DB.GetStatusRetentionAccountIDs(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return total, gtserror.Newf("error getting status retention accounts: %w", err)
+	}
+
+	// Drop finished deletes
+	// from the in-flight set.
+	s.pruneInflight(ctx)
+
+	for _, accountID := range accountIDs {
+		// Delete old statuses for each account.
+		n, err := s.retention(ctx,
+			accountID,
+			maxPerAccount,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error applying retention for account %s: %v", accountID, err)
+			continue
+		}
+
+		// Update
+		// count.
+		total += n
+	}
+
+	return total, nil
+}
+
+func (s *Status) retention(ctx context.Context, accountID string, max int) (int, error) {
+	var total int
+
+	account, err := s.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return total, gtserror.Newf("error getting account %s: %w", accountID, err)
+	}
+
+	if !account.IsLocal() || account.IsSuspended() {
+		// Only ever delete statuses of
+		// local, active accounts.
+		return total, nil
+	}
+
+	settings, err := s.state.DB.GetAccountSettings(ctx, accountID)
+	if err != nil {
+		return total, gtserror.Newf("error getting settings for account %s: %w", accountID, err)
+	}
+
+	if !settings.StatusRetentionEnabled() {
+		// Double check this account
+		// has explicitly opted in.
+		return total, nil
+	}
+
+	// Calculate the cutoff time, and from it the highest
+	// status ID we may delete (status IDs are time-based).
+	cutoff := time.Now().AddDate(0, 0, -settings.StatusRetentionDays)
+	maxID, err := id.NewULIDFromTime(cutoff)
+	if err != nil {
+		return total, gtserror.Newf("error generating max id: %w", err)
+	}
+
+	for total < max {
+		// Fetch the next batch of account statuses older than max ID.
+		statuses, err := s.state.DB.GetAccountStatuses(ctx,
+			accountID,
+			selectLimit,
+			false, // excludeReplies
+			false, // excludeReblogs
+			maxID,
+			"",    // minID
+			false, // mediaOnly
+			false, // publicOnly
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting statuses for account %s: %w", accountID, err)
+		}
+
+		// If no statuses are returned, we reached the end.
+		if len(statuses) == 0 {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			if total >= max {
+				log.Infof(ctx, "reached limit of %d deletes for account %s, continuing next run", max, accountID)
+				break
+			}
+
+			// Check / delete each status.
+			deleted, err := s.retainStatus(ctx,
+				account,
+				settings,
+				status,
+			)
+			if err != nil {
+				return total, err
+			}
+
+			if deleted {
+				// Update
+				// count.
+				total++
+			}
+		}
+	}
+
+	if total > 0 {
+		log.Infof(ctx, "deleted %d statuses older than %s for account %s", total, cutoff.Format(time.Stamp), accountID)
+	}
+
+	return total, nil
+}
+
+func (s *Status) retainStatus(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	settings *gtsmodel.AccountSettings,
+	status *gtsmodel.Status,
+) (bool, error) {
+	if s.isInflight(status.ID) {
+		// Delete already queued
+		// on an earlier run.
+		return false, nil
+	}
+
+	if util.PtrValueOr(settings.StatusRetentionKeepPinned, true) &&
+		!status.PinnedAt.IsZero() {
+		// Keep pinned.
+		return false, nil
+	}
+
+	if util.PtrValueOr(settings.StatusRetentionKeepBookmarked, true) {
+		bookmarked, err := s.state.DB.IsStatusBookmarkedBy(ctx, account.ID, status.ID)
+		if err != nil {
+			return false, gtserror.Newf("error checking status bookmark: %w", err)
+		}
+
+		if bookmarked {
+			// Keep self-bookmarked.
+			return false, nil
+		}
+	}
+
+	if above := settings.StatusRetentionKeepFavesAbove; above > 0 && status.BoostOfID == "" {
+		faves, err := s.state.DB.CountStatusFaves(ctx, status.ID)
+		if err != nil {
+			return false, gtserror.Newf("error counting status faves: %w", err)
+		}
+
+		if faves > above {
+			// Keep popular.
+			return false, nil
+		}
+	}
+
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return true, nil
+	}
+
+	s.deleteLocal(account, status)
+	return true, nil
+}
+
+// deleteLocal queues deletion of the given local status (or
+// undo of the given local boost), federating it as necessary.
+func (s *Status) deleteLocal(account *gtsmodel.Account, status *gtsmodel.Status) {
+	s.setInflight(status.ID)
+
+	if status.BoostOfID != "" {
+		// Process unboost side effects.
+		s.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityAnnounce,
+			APActivityType: ap.ActivityUndo,
+			GTSModel:       status,
+			Origin:         account,
+			Target:         status.BoostOfAccount,
+		})
+		return
+	}
+
+	// Process delete side effects.
+	s.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityDelete,
+		GTSModel:       status,
+		Origin:         account,
+		Target:         account,
+	})
+}
+
+// setInflight marks a retention delete
+// as queued for status with given ID.
+func (s *Status) setInflight(statusID string) {
+	s.mutex.Lock()
+	if s.inflight == nil {
+		s.inflight = make(map[string]time.Time)
+	}
+	s.inflight[statusID] = time.Now()
+	s.mutex.Unlock()
+}
+
+// isInflight returns whether a retention delete
+// is currently queued for status with given ID.
+func (s *Status) isInflight(statusID string) bool {
+	s.mutex.Lock()
+	_, ok := s.inflight[statusID]
+	s.mutex.Unlock()
+	return ok
+}
+
+// pruneInflight drops statuses from the in-flight set once
+// they no longer exist in the database, i.e. their queued
+// delete has been processed, or once they have been queued
+// for longer than inflightTimeout (e.g. the delete failed).
+func (s *Status) pruneInflight(ctx context.Context) {
+	s.mutex.Lock()
+	statusIDs := make([]string, 0, len(s.inflight))
+	for statusID, queued := range s.inflight {
+		if time.Since(queued) > inflightTimeout {
+			delete(s.inflight, statusID)
+			continue
+		}
+		statusIDs = append(statusIDs, statusID)
+	}
+	s.mutex.Unlock()
+
+	for _, statusID := range statusIDs {
+		_, err := s.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			statusID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "error getting status %s: %v", statusID, err)
+			continue
+		}
+
+		if err == nil {
+			// Still queued.
+			continue
+		}
+
+		s.mutex.Lock()
+		delete(s.inflight, statusID)
+		s.mutex.Unlock()
+	}
+}
+
+// LogRetentionPolicy performs Status.RetentionPolicy(...), logging the start and outcome.
+func (s *Status) LogRetentionPolicy(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := s.RetentionPolicy(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "deleted: %d", n)
+	}
+}
+
+// RetentionPolicy will delete all statuses older than the instance retention policy's
+// max status age, for local and / or remote accounts as set in the policy, except those
+// that are pinned. Deletes of local statuses are federated as usual, while remote statuses
+// are only ever wiped from this instance, and tombstoned so they aren't automatically
+// re-fetched, (unless explicitly looked up by a local user). Context will be checked
+// for `gtscontext.DryRun()` in order to actually perform the action.
+func (s *Status) RetentionPolicy(ctx context.Context) (int, error) {
+	var total int
+
+	policy, err := s.state.DB.GetRetentionPolicy(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return total, gtserror.Newf("error getting retention policy: %w", err)
+	}
+
+	if policy == nil || !policy.Enabled() || policy.MaxStatusAgeDays <= 0 {
+		// Nothing to do.
+		return total, nil
+	}
+
+	// Calculate the cutoff time, and from it the highest
+	// status ID we may delete (status IDs are time-based).
+	cutoff := time.Now().AddDate(0, 0, -policy.MaxStatusAgeDays)
+	maxID, err := id.NewULIDFromTime(cutoff)
+	if err != nil {
+		return total, gtserror.Newf("error generating max id: %w", err)
+	}
+
+	// Drop finished deletes
+	// from the in-flight set.
+	s.pruneInflight(ctx)
+
+	if *policy.AppliesToLocal {
+		// Delete old local statuses.
+		n, err := s.retentionPolicy(ctx, true, maxID)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	if *policy.AppliesToRemote {
+		// Delete old remote statuses.
+		n, err := s.retentionPolicy(ctx, false, maxID)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+func (s *Status) retentionPolicy(ctx context.Context, local bool, maxID string) (int, error) {
+	var total int
+
+	// Instance account is used as the
+	// receiving account of remote deletes.
+	instanceAcc, err := s.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return total, gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	for {
+		// Fetch the next batch of statuses older than max ID.
+		statuses, err := s.state.DB.GetStatusesOlderThan(ctx,
+			local,
+			maxID,
+			selectLimit,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting statuses: %w", err)
+		}
+
+		// If no statuses are returned, we reached the end.
+		if len(statuses) == 0 {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			if s.isInflight(status.ID) {
+				// Delete already queued
+				// on an earlier run.
+				continue
+			}
+
+			if !status.PinnedAt.IsZero() {
+				// Keep pinned.
+				continue
+			}
+
+			if status.Account == nil {
+				// Can't process side effects
+				// without the status author.
+				log.Warnf(ctx, "skipping status %s with missing account", status.ID)
+				continue
+			}
+
+			// Update
+			// count.
+			total++
+
+			if gtscontext.DryRun(ctx) {
+				// Dry run, do nothing.
+				continue
+			}
+
+			if local {
+				s.deleteLocal(status.Account, status)
+				continue
+			}
+
+			// Wipe remote status from this instance
+			// only; as far as the rest of the fedi is
+			// concerned, the status still exists.
+			// Tombstone its URI first, so it isn't
+			// simply dereferenced again later on.
+			if err := s.state.DB.PutTombstone(ctx, &gtsmodel.Tombstone{
+				ID:     id.NewULID(),
+				Domain: status.Account.Domain,
+				URI:    status.URI,
+			}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+				log.Errorf(ctx, "error putting tombstone for status %s: %v", status.URI, err)
+				continue
+			}
+
+			s.setInflight(status.ID)
+			s.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityDelete,
+				GTSModel:       status,
+				Requesting:     status.Account,
+				Receiving:      instanceAcc,
+			})
+		}
+	}
+
+	return total, nil
+}
+
+// LogBackfillWordCounts performs Status.BackfillWordCounts(...), logging the start and outcome.
+func (s *Status) LogBackfillWordCounts(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := s.BackfillWordCounts(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "backfilled: %d", n)
+	}
+}
+
+// BackfillWordCounts counts and stores the words in the content of all
+// statuses that were stored before word counts were, in batches. Returns
+// the number of statuses whose word count was updated. Statuses that
+// really do contain no words are simply left at zero.
+func (s *Status) BackfillWordCounts(ctx context.Context) (int, error) {
+	var (
+		maxID string
+		total int
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		// Fetch the next batch of statuses missing a word count.
+		statuses, err := s.state.DB.GetStatusesWithoutWordCount(
+			gtscontext.SetBarebones(ctx),
+			maxID,
+			selectLimit,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting statuses: %w", err)
+		}
+
+		// If no statuses are returned, we reached the end.
+		if len(statuses) == 0 {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			status.WordCount = text.WordCount(status.Content)
+			if status.WordCount == 0 {
+				// Nothing to
+				// store here.
+				continue
+			}
+
+			if gtscontext.DryRun(ctx) {
+				// Dry run, do nothing.
+				total++
+				continue
+			}
+
+			if err := s.state.DB.UpdateStatus(ctx,
+				status,
+				"word_count",
+			); err != nil {
+				return total, gtserror.Newf("error updating status %s: %w", status.ID, err)
+			}
+
+			total++
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,248 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestStatusRetentionNotOptedIn() {
+	queued := suite.state.Workers.Client.Queue.Len()
+
+	// No test accounts have opted in to
+	// status retention, nothing to delete.
+	n, err := suite.cleaner.Status().Retention(context.Background(), 100)
+	suite.NoError(err)
+	suite.Zero(n)
+	suite.Equal(queued, suite.state.Workers.Client.Queue.Len())
+}
+
+func (suite *CleanerTestSuite) TestStatusRetention() {
+	ctx := context.Background()
+	suite.optInStatusRetention(ctx, "01F8MH1H7YV1Z7D2C8K2730QBF")
+	queued := suite.state.Workers.Client.Queue.Len()
+
+	// Test statuses are old enough that all should
+	// be deleted, barring the configured limit.
+	n, err := suite.cleaner.Status().Retention(ctx, 2)
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Side effects for each deleted
+	// status should have been queued.
+	suite.Equal(queued+2, suite.state.Workers.Client.Queue.Len())
+}
+
+func (suite *CleanerTestSuite) TestStatusRetentionDryRun() {
+	ctx := context.Background()
+	suite.optInStatusRetention(ctx, "01F8MH1H7YV1Z7D2C8K2730QBF")
+	queued := suite.state.Workers.Client.Queue.Len()
+
+	n, err := suite.cleaner.Status().Retention(gtscontext.SetDryRun(ctx), 2)
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Nothing should have been queued.
+	suite.Equal(queued, suite.state.Workers.Client.Queue.Len())
+}
+
+func (suite *CleanerTestSuite) TestStatusRetentionKeepPinned() {
+	ctx := context.Background()
+	accountID := "01F8MH17FWEB39HZJ76B6VXSKF" // admin account
+	suite.optInStatusRetention(ctx, accountID)
+
+	pinned, err := suite.state.DB.GetAccountPinnedStatuses(ctx, accountID)
+	suite.NoError(err)
+	suite.NotEmpty(pinned)
+
+	// Delete everything possible, in dry run mode.
+	ctx = gtscontext.SetDryRun(ctx)
+	all, err := suite.cleaner.Status().Retention(ctx, 1000)
+	suite.NoError(err)
+
+	// Now disable keeping pinned, there
+	// should be exactly pinned more deletes.
+	settings, err := suite.state.DB.GetAccountSettings(ctx, accountID)
+	suite.NoError(err)
+	settings.StatusRetentionKeepPinned = util.Ptr(false)
+	suite.NoError(suite.state.DB.UpdateAccountSettings(ctx, settings, "status_retention_keep_pinned"))
+
+	n, err := suite.cleaner.Status().Retention(ctx, 1000)
+	suite.NoError(err)
+	suite.Equal(all+len(pinned), n)
+}
+
+func (suite *CleanerTestSuite) TestRetentionPolicyNotSet() {
+	queued := suite.state.Workers.Client.Queue.Len()
+
+	// No retention policy
+	// set, nothing to delete.
+	n, err := suite.cleaner.Status().RetentionPolicy(context.Background())
+	suite.NoError(err)
+	suite.Zero(n)
+	suite.Equal(queued, suite.state.Workers.Client.Queue.Len())
+}
+
+func (suite *CleanerTestSuite) TestRetentionPolicyLocal() {
+	ctx := context.Background()
+	suite.setRetentionPolicy(ctx, true, false)
+	clientQueued := suite.state.Workers.Client.Queue.Len()
+	fediQueued := suite.state.Workers.Federator.Queue.Len()
+
+	// Test statuses are old enough that all
+	// local statuses bar pinned should be deleted.
+	n, err := suite.cleaner.Status().RetentionPolicy(ctx)
+	suite.NoError(err)
+	suite.NotZero(n)
+
+	// Only local deletes should have been queued.
+	suite.Equal(clientQueued+n, suite.state.Workers.Client.Queue.Len())
+	suite.Equal(fediQueued, suite.state.Workers.Federator.Queue.Len())
+}
+
+func (suite *CleanerTestSuite) TestRetentionPolicyRemote() {
+	ctx := context.Background()
+	suite.setRetentionPolicy(ctx, false, true)
+	clientQueued := suite.state.Workers.Client.Queue.Len()
+	fediQueued := suite.state.Workers.Federator.Queue.Len()
+
+	n, err := suite.cleaner.Status().RetentionPolicy(ctx)
+	suite.NoError(err)
+	suite.NotZero(n)
+
+	// Only remote wipes should have been queued.
+	suite.Equal(clientQueued, suite.state.Workers.Client.Queue.Len())
+	suite.Equal(fediQueued+n, suite.state.Workers.Federator.Queue.Len())
+}
+
+func (suite *CleanerTestSuite) TestRetentionPolicyRemoteTombstones() {
+	ctx := context.Background()
+	suite.setRetentionPolicy(ctx, false, true)
+
+	_, err := suite.cleaner.Status().RetentionPolicy(ctx)
+	suite.NoError(err)
+
+	// Wiped remote statuses should be tombstoned, local ones not.
+	for _, status := range testrig.NewTestStatuses() {
+		if !status.PinnedAt.IsZero() {
+			continue
+		}
+
+		tombstoned, err := suite.state.DB.TombstoneExistsWithURI(ctx, status.URI)
+		suite.NoError(err)
+		suite.Equal(!*status.Local, tombstoned, status.URI)
+	}
+
+	// Running again should not fail on existing tombstones.
+	_, err = suite.cleaner.Status().RetentionPolicy(ctx)
+	suite.NoError(err)
+}
+
+func (suite *CleanerTestSuite) TestRetentionPolicyKeepPinned() {
+	ctx := gtscontext.SetDryRun(context.Background())
+	accountID := "01F8MH17FWEB39HZJ76B6VXSKF" // admin account
+	suite.setRetentionPolicy(ctx, true, false)
+
+	pinned, err := suite.state.DB.GetAccountPinnedStatuses(ctx, accountID)
+	suite.NoError(err)
+	suite.NotEmpty(pinned)
+
+	all, err := suite.cleaner.Status().RetentionPolicy(ctx)
+	suite.NoError(err)
+
+	// Unpin statuses, there should
+	// be exactly pinned more deletes.
+	for _, status := range pinned {
+		status.PinnedAt = time.Time{}
+		suite.NoError(suite.state.DB.UpdateStatus(ctx, status, "pinned_at"))
+	}
+
+	n, err := suite.cleaner.Status().RetentionPolicy(ctx)
+	suite.NoError(err)
+	suite.Equal(all+len(pinned), n)
+}
+
+// setRetentionPolicy sets the instance retention policy to delete
+// statuses and media older than one day, of local and / or remote accounts.
+func (suite *CleanerTestSuite) setRetentionPolicy(ctx context.Context, local bool, remote bool) {
+	if err := suite.state.DB.PutRetentionPolicy(ctx, &gtsmodel.RetentionPolicy{
+		ID:               "01J0A4Q2G7V8ZK3NWJ5T1XH6RB",
+		MaxStatusAgeDays: 1,
+		MaxMediaAgeDays:  1,
+		AppliesToLocal:   util.Ptr(local),
+		AppliesToRemote:  util.Ptr(remote),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+// optInStatusRetention opts the account with given ID in
+// to status retention of statuses older than one day.
+func (suite *CleanerTestSuite) optInStatusRetention(ctx context.Context, accountID string) {
+	settings, err := suite.state.DB.GetAccountSettings(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings.StatusRetentionDays = 1
+	if err := suite.state.DB.UpdateAccountSettings(ctx, settings,
+		"status_retention_days",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *CleanerTestSuite) TestStatusRetentionSkipsInflight() {
+	ctx := context.Background()
+	suite.optInStatusRetention(ctx, "01F8MH1H7YV1Z7D2C8K2730QBF")
+	queued := suite.state.Workers.Client.Queue.Len()
+
+	n, err := suite.cleaner.Status().Retention(ctx, 2)
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Deletes from the first run have not been
+	// processed yet, so the next run should queue
+	// deletes for two other statuses, not the same.
+	n, err = suite.cleaner.Status().Retention(ctx, 2)
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	suite.Equal(queued+4, suite.state.Workers.Client.Queue.Len())
+
+	// Skip any messages queued beforehand.
+	for i := 0; i < queued; i++ {
+		suite.state.Workers.Client.Queue.Pop()
+	}
+
+	var statusIDs []string
+	for i := 0; i < 4; i++ {
+		msg, ok := suite.state.Workers.Client.Queue.Pop()
+		suite.True(ok)
+		statusIDs = append(statusIDs, msg.GTSModel.(*gtsmodel.Status).ID)
+	}
+
+	// All queued statuses should be unique.
+	suite.Len(util.Deduplicate(statusIDs), 4)
+}
@@ -54,6 +54,7 @@ type Configuration struct {
 	ConfigPath         string   `name:"config-path" usage:"Path to a file containing gotosocial configuration. Values set in this file will be overwritten by values set as env vars or arguments"`
 	Host               string   `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
 	AccountDomain      string   `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
+	AccountDomains     []string `name:"account-domains" usage:"Additional domains which local accounts may use in their names, alongside account-domain (eg., example.com, example.net). Each must redirect its /.well-known endpoints to host."`
 	Protocol           string   `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging or behind a reverse proxy!)"`
 	BindAddress        string   `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
 	Port               int      `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
@@ -73,9 +74,15 @@ type Configuration struct {
 	DbSqliteSynchronous      string        `name:"db-sqlite-synchronous" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_synchronous"`
 	DbSqliteCacheSize        bytesize.Size `name:"db-sqlite-cache-size" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_cache_size"`
 	DbSqliteBusyTimeout      time.Duration `name:"db-sqlite-busy-timeout" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_busy_timeout"`
+	DbReplicaAddresses       []string      `name:"db-replica-addresses" usage:"Postgres only: connection strings (DSNs) of read-only replica databases to send heavy read-only listings to. Account and home / list timeline reads always go to the primary, as replication lag could poison the caches."`
+	DbMaintenanceSchedule    string        `name:"db-maintenance-schedule" usage:"Cron expression (eg., '0 4 * * 0') at which to run database VACUUM maintenance. Empty string disables maintenance."`
 
-	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
-	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
+	WebTemplateBaseDir string   `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
+	WebAssetBaseDir    string   `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
+	WebCSPExtraSrc     []string `name:"web-csp-extra-src" usage:"Additional sources to allow for scripts, styles, fonts, images and connections in the content-security-policy of web UI pages, eg., font CDNs."`
+	WebDefaultTheme    string   `name:"web-default-theme" usage:"Default color theme of web pages for visitors who haven't chosen one: 'system' (follow browser preference), 'light', or 'dark'."`
+
+	APICORSAllowedOrigins []string `name:"api-cors-allowed-origins" usage:"Origins permitted to make cross-origin requests to the API, eg., 'https://frontend.example.org'. Patterns may contain one '*' wildcard. '*' allows all origins."`
 
 	InstanceFederationMode         string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
 	InstanceFederationSpamFilter   bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
@@ -86,35 +93,60 @@ type Configuration struct {
 	InstanceDeliverToSharedInboxes bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
 	InstanceInjectMastodonVersion  bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
 	InstanceLanguages              language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
+	InstanceObfuscateIDs           bool               `name:"instance-obfuscate-ids" usage:"Obfuscate IDs of statuses, accounts, notifications etc. in the client API, so that they don't reveal when something was created."`
+	InstanceObfuscateIDsSecret     string             `name:"instance-obfuscate-ids-secret" usage:"Secret key used to obfuscate client API IDs when instance-obfuscate-ids is enabled. Changing this changes all obfuscated IDs."`
 
-	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
-	AccountsReasonRequired   bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
-	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
-	AccountsCustomCSSLength  int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsRegistrationOpen   bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
+	AccountsReasonRequired     bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
+	AccountsAllowCustomCSS     bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
+	AccountsCustomCSSLength    int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsStatsRecountWeekly bool `name:"accounts-stats-recount-weekly" usage:"Recount stored follower, following and status counts of local accounts once a week, correcting any that have drifted."`
 
 	MediaImageMaxSize        bytesize.Size `name:"media-image-max-size" usage:"Max size of accepted images in bytes"`
 	MediaVideoMaxSize        bytesize.Size `name:"media-video-max-size" usage:"Max size of accepted videos in bytes"`
 	MediaDescriptionMinChars int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
 	MediaDescriptionMaxChars int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
+	MediaDescriptionRequired bool          `name:"media-description-required" usage:"Reject new statuses from local accounts if any attached media has no description"`
 	MediaRemoteCacheDays     int           `name:"media-remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
 	MediaEmojiLocalMaxSize   bytesize.Size `name:"media-emoji-local-max-size" usage:"Max size in bytes of emojis uploaded to this instance via the admin API."`
 	MediaEmojiRemoteMaxSize  bytesize.Size `name:"media-emoji-remote-max-size" usage:"Max size in bytes of emojis to download from other instances."`
+	MediaAccountQuota        bytesize.Size `name:"media-account-quota" usage:"Default max total size in bytes of media uploaded by each local account. If set to 0, there is no limit."`
 	MediaCleanupFrom         string        `name:"media-cleanup-from" usage:"Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
 	MediaCleanupEvery        time.Duration `name:"media-cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
 
-	StorageBackend       string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
-	StorageLocalBasePath string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
-	StorageS3Endpoint    string `name:"storage-s3-endpoint" usage:"S3 Endpoint URL (e.g 'minio.example.org:9000')"`
-	StorageS3AccessKey   string `name:"storage-s3-access-key" usage:"S3 Access Key"`
-	StorageS3SecretKey   string `name:"storage-s3-secret-key" usage:"S3 Secret Key"`
-	StorageS3UseSSL      bool   `name:"storage-s3-use-ssl" usage:"Use SSL for S3 connections. Only set this to 'false' when testing locally"`
-	StorageS3BucketName  string `name:"storage-s3-bucket" usage:"Place blobs in this bucket"`
-	StorageS3Proxy       bool   `name:"storage-s3-proxy" usage:"Proxy S3 contents through GoToSocial instead of redirecting to a presigned URL"`
+	StorageBackend         string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
+	StorageLocalBasePath   string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
+	StorageS3Endpoint      string `name:"storage-s3-endpoint" usage:"S3 Endpoint URL (e.g 'minio.example.org:9000')"`
+	StorageS3AccessKey     string `name:"storage-s3-access-key" usage:"S3 Access Key"`
+	StorageS3SecretKey     string `name:"storage-s3-secret-key" usage:"S3 Secret Key"`
+	StorageS3UseSSL        bool   `name:"storage-s3-use-ssl" usage:"Use SSL for S3 connections. Only set this to 'false' when testing locally"`
+	StorageS3BucketName    string `name:"storage-s3-bucket" usage:"Place blobs in this bucket"`
+	StorageS3Proxy         bool   `name:"storage-s3-proxy" usage:"Proxy S3 contents through GoToSocial instead of redirecting to a presigned URL"`
+	StorageS3RequesterPays bool   `name:"storage-s3-requester-pays" usage:"Acknowledge request charges when reading from a requester-pays S3 bucket"`
+
+	StorageS3RateLimitInitial float64 `name:"storage-s3-rate-limit-initial" usage:"Initial permitted rate of requests per second to S3, adapted down when S3 responds '503 SlowDown'. If set to 0, there is no limit."`
+	StorageS3RateLimitMin     float64 `name:"storage-s3-rate-limit-min" usage:"Minimum rate of requests per second to S3 to back off to on '503 SlowDown'."`
+	StorageS3RateLimitMax     float64 `name:"storage-s3-rate-limit-max" usage:"Maximum rate of requests per second to S3 to recover to after backing off."`
+
+	StorageAzureConnectionString string        `name:"storage-azure-connection-string" usage:"Azure storage account connection string. If set, account name, key and endpoint are taken from this."`
+	StorageAzureAccountName      string        `name:"storage-azure-account-name" usage:"Azure storage account name"`
+	StorageAzureAccountKey       string        `name:"storage-azure-account-key" usage:"Azure storage account key"`
+	StorageAzureEndpoint         string        `name:"storage-azure-endpoint" usage:"Azure blob service endpoint (e.g 'https://example.blob.core.windows.net')"`
+	StorageAzureContainer        string        `name:"storage-azure-container" usage:"Place blobs in this container"`
+	StorageAzureBlockSize        bytesize.Size `name:"storage-azure-block-size" usage:"Size of blocks to upload when writing large blobs, or blobs of unknown size"`
+
+	StatusesMaxChars                int      `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
+	MaxSpoilerTextChars             int      `name:"max-spoiler-text-chars" usage:"Max permitted characters for the content warning (spoiler text) of posted statuses"`
+	MinStatusChars                  int      `name:"min-status-chars" usage:"Min required characters for posted statuses, not including content warning. Statuses with media or a poll are exempt. 0 to disable"`
+	StatusesPollMaxOptions          int      `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
+	StatusesPollOptionMaxChars      int      `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
+	StatusesMediaMaxFiles           int      `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesRetentionDeletesPerHour int      `name:"statuses-retention-deletes-per-hour" usage:"Maximum number of old statuses deleted per hour for each account that has opted in to status retention"`
+	DetectStatusLanguage            bool     `name:"detect-status-language" usage:"Detect the language of statuses posted without a language set, instead of falling back to the account's default posting language"`
+	DetectStatusLanguages           []string `name:"detect-status-languages" usage:"ISO 639-1 codes of the languages (at least two) that status language detection chooses from"`
 
-	StatusesMaxChars           int `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
-	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
-	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
-	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusContextMaxDepthAncestors   int `name:"status-context-max-depth-ancestors" usage:"Default and maximum number of ancestors returned when fetching the context of a status"`
+	StatusContextMaxDepthDescendants int `name:"status-context-max-depth-descendants" usage:"Default and maximum depth of the reply tree returned as descendants when fetching the context of a status"`
 
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
@@ -156,14 +188,26 @@ type Configuration struct {
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
-	AdvancedCookiesSamesite      string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
-	AdvancedRateLimitRequests    int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
-	AdvancedRateLimitExceptions  []string      `name:"advanced-rate-limit-exceptions" usage:"Slice of CIDRs to exclude from rate limit restrictions."`
-	AdvancedThrottlingMultiplier int           `name:"advanced-throttling-multiplier" usage:"Multiplier to use per cpu for http request throttling. 0 or less turns throttling off."`
-	AdvancedThrottlingRetryAfter time.Duration `name:"advanced-throttling-retry-after" usage:"Retry-After duration response to send for throttled requests."`
-	AdvancedSenderMultiplier     int           `name:"advanced-sender-multiplier" usage:"Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended)."`
-	AdvancedCSPExtraURIs         []string      `name:"advanced-csp-extra-uris" usage:"Additional URIs to allow when building content-security-policy for media + images."`
-	AdvancedHeaderFilterMode     string        `name:"advanced-header-filter-mode" usage:"Set incoming request header filtering mode."`
+	FederationDeliveryBackend       string   `name:"federation-delivery-backend" usage:"Backend for the outgoing federation delivery queue. Empty for in-memory, or 'redis' to share the queue between instances."`
+	FederationDeliveryRedisAddress  string   `name:"federation-delivery-redis-address" usage:"Address:port of the redis server used for the delivery queue, if federation-delivery-backend is 'redis'."`
+	FederationDeliveryRedisPassword string   `name:"federation-delivery-redis-password" usage:"Password to authenticate with the redis server used for the delivery queue. Leave empty for no authentication."`
+	FederationDeliveryRedisKey      string   `name:"federation-delivery-redis-key" usage:"Key prefix used for the delivery queue (and related locks) in redis. Must be the same for all instances."`
+	FederationBlockListURLs         []string `name:"federation-block-list-urls" usage:"URLs of shared domain block lists (JSON) to fetch every 6 hours, applying any new blocks they contain."`
+	FederationAuditLog              bool     `name:"federation-audit-log" usage:"Record outgoing federation delivery attempts in the database, so that they can be queried by admins."`
+	FederationAuditLogMaxRows       int      `name:"federation-audit-log-max-rows" usage:"Maximum number of entries to keep in the federation audit log. Older entries are pruned."`
+
+	AdvancedCookiesSamesite             string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
+	AdvancedRateLimitRequests           int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
+	AdvancedRateLimitExceptions         []string      `name:"advanced-rate-limit-exceptions" usage:"Slice of CIDRs to exclude from rate limit restrictions."`
+	AdvancedThrottlingMultiplier        int           `name:"advanced-throttling-multiplier" usage:"Multiplier to use per cpu for http request throttling. 0 or less turns throttling off."`
+	AdvancedThrottlingRetryAfter        time.Duration `name:"advanced-throttling-retry-after" usage:"Maximum Retry-After duration response to send for throttled requests."`
+	AdvancedSenderMultiplier            int           `name:"advanced-sender-multiplier" usage:"Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended)."`
+	AdvancedClientWorkerMultiplier      int           `name:"advanced-client-worker-multiplier" usage:"Multiplier to use per cpu for client API processing workers. 0 or less means 1 worker only."`
+	AdvancedFederatorWorkerMultiplier   int           `name:"advanced-federator-worker-multiplier" usage:"Multiplier to use per cpu for fedi API processing workers. 0 or less means 1 worker only."`
+	AdvancedDereferenceWorkerMultiplier int           `name:"advanced-dereference-worker-multiplier" usage:"Multiplier to use per cpu for asynchronous dereferencing workers. 0 or less means 1 worker only."`
+	AdvancedMediaWorkerMultiplier       int           `name:"advanced-media-worker-multiplier" usage:"Multiplier to use per cpu for asynchronous media processing workers. 0 or less means 1 worker only."`
+	AdvancedCSPExtraURIs                []string      `name:"advanced-csp-extra-uris" usage:"Additional URIs to allow when building content-security-policy for media + images."`
+	AdvancedHeaderFilterMode            string        `name:"advanced-header-filter-mode" usage:"Set incoming request header filtering mode."`
 
 	// HTTPClient configuration vars.
 	HTTPClient HTTPClientConfiguration `name:"http-client"`
@@ -175,8 +219,10 @@ type Configuration struct {
 	AdminAccountUsername     string `name:"username" usage:"the username to create/delete/etc"`
 	AdminAccountEmail        string `name:"email" usage:"the email address of this account"`
 	AdminAccountPassword     string `name:"password" usage:"the password to set for this account"`
+	AdminAccountDomain       string `name:"domain" usage:"the account domain to use in this account's name; must be one of account-domain or account-domains (default account-domain)"`
 	AdminTransPath           string `name:"path" usage:"the path of the file to import from/export to"`
 	AdminMediaPruneDryRun    bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
+	AdminMediaPruneReport    string `name:"report" usage:"path of a JSON file to write a report of pruned (or in a dry run, prunable) media attachments to"`
 	AdminMediaListLocalOnly  bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true"`
 	AdminMediaListRemoteOnly bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true"`
 
@@ -210,8 +256,10 @@ type CacheConfiguration struct {
 	FollowIDsMemRatio         float64       `name:"follow-ids-mem-ratio"`
 	FollowRequestMemRatio     float64       `name:"follow-request-mem-ratio"`
 	FollowRequestIDsMemRatio  float64       `name:"follow-request-ids-mem-ratio"`
+	FollowSuggestionsMemRatio float64       `name:"follow-suggestions-mem-ratio"`
 	InReplyToIDsMemRatio      float64       `name:"in-reply-to-ids-mem-ratio"`
 	InstanceMemRatio          float64       `name:"instance-mem-ratio"`
+	InstanceSettingsMemRatio  float64       `name:"instance-settings-mem-ratio"`
 	ListMemRatio              float64       `name:"list-mem-ratio"`
 	ListEntryMemRatio         float64       `name:"list-entry-mem-ratio"`
 	MarkerMemRatio            float64       `name:"marker-mem-ratio"`
@@ -237,6 +285,13 @@ type CacheConfiguration struct {
 	UserMuteIDsMemRatio       float64       `name:"user-mute-ids-mem-ratio"`
 	WebfingerMemRatio         float64       `name:"webfinger-mem-ratio"`
 	VisibilityMemRatio        float64       `name:"visibility-mem-ratio"`
+
+	Shards int `name:"shards"`
+
+	InvalidationBackend       string `name:"invalidation-backend"`
+	InvalidationRedisAddress  string `name:"invalidation-redis-address"`
+	InvalidationRedisPassword string `name:"invalidation-redis-password"`
+	InvalidationRedisChannel  string `name:"invalidation-redis-channel"`
 }
 
 // MarshalMap will marshal current Configuration into a map structure (useful for JSON/TOML/YAML).
@@ -29,4 +29,22 @@ const (
 	RequestHeaderFilterModeAllow    = "allow"
 	RequestHeaderFilterModeBlock    = "block"
 	RequestHeaderFilterModeDisabled = ""
+
+	// Cache invalidation backend determines how cache
+	// invalidations are distributed to other instances
+	// sharing the same database (if at all).
+	CacheInvalidationBackendNone  = ""
+	CacheInvalidationBackendRedis = "redis"
+
+	// Federation delivery backend determines
+	// where the outgoing delivery queue is kept.
+	FederationDeliveryBackendMemory = ""
+	FederationDeliveryBackendRedis  = "redis"
+
+	// Web default theme determines the color
+	// theme used for web pages when a visitor
+	// hasn't chosen one themselves.
+	WebDefaultThemeSystem = "system"
+	WebDefaultThemeLight  = "light"
+	WebDefaultThemeDark   = "dark"
 )
@@ -36,6 +36,7 @@ var Defaults = Configuration{
 	ConfigPath:         "",
 	Host:               "",
 	AccountDomain:      "",
+	AccountDomains:     []string{},
 	Protocol:           "https",
 	BindAddress:        "0.0.0.0",
 	Port:               8080,
@@ -54,9 +55,14 @@ var Defaults = Configuration{
 	DbSqliteSynchronous:      "NORMAL",
 	DbSqliteCacheSize:        8 * bytesize.MiB,
 	DbSqliteBusyTimeout:      time.Minute * 30,
+	DbReplicaAddresses:       []string{},
+	DbMaintenanceSchedule:    "",
 
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
+	WebDefaultTheme:    WebDefaultThemeSystem,
+
+	APICORSAllowedOrigins: []string{"*"},
 
 	InstanceFederationMode:         InstanceFederationModeDefault,
 	InstanceFederationSpamFilter:   false,
@@ -65,31 +71,53 @@ var Defaults = Configuration{
 	InstanceExposeSuspendedWeb:     false,
 	InstanceDeliverToSharedInboxes: true,
 	InstanceLanguages:              make(language.Languages, 0),
+	InstanceObfuscateIDs:           false,
 
-	AccountsRegistrationOpen: false,
-	AccountsReasonRequired:   true,
-	AccountsAllowCustomCSS:   false,
-	AccountsCustomCSSLength:  10000,
+	AccountsRegistrationOpen:   false,
+	AccountsReasonRequired:     true,
+	AccountsAllowCustomCSS:     false,
+	AccountsCustomCSSLength:    10000,
+	AccountsStatsRecountWeekly: false,
 
 	MediaImageMaxSize:        10 * bytesize.MiB,
 	MediaVideoMaxSize:        40 * bytesize.MiB,
 	MediaDescriptionMinChars: 0,
 	MediaDescriptionMaxChars: 1500,
+	MediaDescriptionRequired: false,
 	MediaRemoteCacheDays:     7,
 	MediaEmojiLocalMaxSize:   50 * bytesize.KiB,
 	MediaEmojiRemoteMaxSize:  100 * bytesize.KiB,
+	MediaAccountQuota:        0,              // No limit.
 	MediaCleanupFrom:         "00:00",        // Midnight.
 	MediaCleanupEvery:        24 * time.Hour, // 1/day.
 
-	StorageBackend:       "local",
-	StorageLocalBasePath: "/gotosocial/storage",
-	StorageS3UseSSL:      true,
-	StorageS3Proxy:       false,
+	StorageBackend:         "local",
+	StorageLocalBasePath:   "/gotosocial/storage",
+	StorageS3UseSSL:        true,
+	StorageS3Proxy:         false,
+	StorageS3RequesterPays: false,
+
+	StorageS3RateLimitInitial: 10000,
+	StorageS3RateLimitMin:     1,
+	StorageS3RateLimitMax:     10000,
+
+	StorageAzureBlockSize: 4 * bytesize.MiB,
+
+	StatusesMaxChars:                5000,
+	MaxSpoilerTextChars:             500,
+	MinStatusChars:                  0,
+	StatusesPollMaxOptions:          6,
+	StatusesPollOptionMaxChars:      50,
+	StatusesMediaMaxFiles:           6,
+	StatusesRetentionDeletesPerHour: 50,
+	DetectStatusLanguage:            false,
+	DetectStatusLanguages: []string{
+		"en", "de", "es", "fr", "it", "pt", "nl", "pl", "cs", "ru",
+		"uk", "sv", "da", "nb", "fi", "tr", "ca", "ja", "ko", "zh",
+	},
 
-	StatusesMaxChars:           5000,
-	StatusesPollMaxOptions:     6,
-	StatusesPollOptionMaxChars: 50,
-	StatusesMediaMaxFiles:      6,
+	StatusContextMaxDepthAncestors:   20,
+	StatusContextMaxDepthDescendants: 40,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
@@ -127,14 +155,26 @@ var Defaults = Configuration{
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
-	AdvancedCookiesSamesite:      "lax",
-	AdvancedRateLimitRequests:    300, // 1 per second per 5 minutes
-	AdvancedRateLimitExceptions:  []string{},
-	AdvancedThrottlingMultiplier: 8, // 8 open requests per CPU
-	AdvancedThrottlingRetryAfter: time.Second * 30,
-	AdvancedSenderMultiplier:     2, // 2 senders per CPU
-	AdvancedCSPExtraURIs:         []string{},
-	AdvancedHeaderFilterMode:     RequestHeaderFilterModeDisabled,
+	FederationDeliveryBackend:       "",
+	FederationDeliveryRedisAddress:  "localhost:6379",
+	FederationDeliveryRedisPassword: "",
+	FederationDeliveryRedisKey:      "gotosocial:delivery",
+	FederationBlockListURLs:         []string{},
+	FederationAuditLog:              false,
+	FederationAuditLogMaxRows:       100000,
+
+	AdvancedCookiesSamesite:             "lax",
+	AdvancedRateLimitRequests:           300, // 1 per second per 5 minutes
+	AdvancedRateLimitExceptions:         []string{},
+	AdvancedThrottlingMultiplier:        8, // 8 open requests per CPU
+	AdvancedThrottlingRetryAfter:        time.Second * 30,
+	AdvancedSenderMultiplier:            2, // 2 senders per CPU
+	AdvancedClientWorkerMultiplier:      4, // 4 client workers per CPU
+	AdvancedFederatorWorkerMultiplier:   4, // 4 federator workers per CPU
+	AdvancedDereferenceWorkerMultiplier: 4, // 4 dereference workers per CPU
+	AdvancedMediaWorkerMultiplier:       8, // 8 media workers per CPU
+	AdvancedCSPExtraURIs:                []string{},
+	AdvancedHeaderFilterMode:            RequestHeaderFilterModeDisabled,
 
 	Cache: CacheConfiguration{
 		// Rough memory target that the total
@@ -174,8 +214,10 @@ var Defaults = Configuration{
 		FollowIDsMemRatio:         4,
 		FollowRequestMemRatio:     2,
 		FollowRequestIDsMemRatio:  2,
+		FollowSuggestionsMemRatio: 0.5,
 		InReplyToIDsMemRatio:      3,
 		InstanceMemRatio:          1,
+		InstanceSettingsMemRatio:  0.1,
 		ListMemRatio:              1,
 		ListEntryMemRatio:         2,
 		MarkerMemRatio:            0.5,
@@ -201,6 +243,20 @@ var Defaults = Configuration{
 		UserMuteIDsMemRatio:       3,
 		WebfingerMemRatio:         0.1,
 		VisibilityMemRatio:        2,
+
+		// Number of independently locked
+		// shards the busiest caches are
+		// split into; 1 means unsharded.
+		Shards: 1,
+
+		// Distributed cache invalidation
+		// between instances is disabled
+		// by default, as most deployments
+		// only run a single instance.
+		InvalidationBackend:       "",
+		InvalidationRedisAddress:  "localhost:6379",
+		InvalidationRedisPassword: "",
+		InvalidationRedisChannel:  "gotosocial:cache-invalidation",
 	},
 
 	HTTPClient: HTTPClientConfiguration{
@@ -0,0 +1,43 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import "slices"
+
+// AllAccountDomains returns every domain which
+// local accounts may use in their names, starting
+// with the default `account-domain`, followed by
+// any additional `account-domains`.
+func AllAccountDomains() []string {
+	domains := []string{GetAccountDomain()}
+	return append(domains, GetAccountDomains()...)
+}
+
+// IsAccountDomain returns whether the given domain
+// is one of the configured account domains.
+func IsAccountDomain(domain string) bool {
+	return domain == GetAccountDomain() ||
+		slices.Contains(GetAccountDomains(), domain)
+}
+
+// IsLocalDomain returns whether the given domain refers
+// to this instance, ie., is either `host`, or one of the
+// configured account domains.
+func IsLocalDomain(domain string) bool {
+	return domain == GetHost() || IsAccountDomain(domain)
+}
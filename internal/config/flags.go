@@ -36,6 +36,7 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(LandingPageUserFlag(), cfg.LandingPageUser, fieldtag("LandingPageUser", "usage"))
 		cmd.PersistentFlags().String(HostFlag(), cfg.Host, fieldtag("Host", "usage"))
 		cmd.PersistentFlags().String(AccountDomainFlag(), cfg.AccountDomain, fieldtag("AccountDomain", "usage"))
+		cmd.PersistentFlags().StringSlice(AccountDomainsFlag(), cfg.AccountDomains, fieldtag("AccountDomains", "usage"))
 		cmd.PersistentFlags().String(ProtocolFlag(), cfg.Protocol, fieldtag("Protocol", "usage"))
 		cmd.PersistentFlags().String(LogLevelFlag(), cfg.LogLevel, fieldtag("LogLevel", "usage"))
 		cmd.PersistentFlags().String(LogTimestampFormatFlag(), cfg.LogTimestampFormat, fieldtag("LogTimestampFormat", "usage"))
@@ -56,6 +57,8 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(DbSqliteSynchronousFlag(), cfg.DbSqliteSynchronous, fieldtag("DbSqliteSynchronous", "usage"))
 		cmd.PersistentFlags().Uint64(DbSqliteCacheSizeFlag(), uint64(cfg.DbSqliteCacheSize), fieldtag("DbSqliteCacheSize", "usage"))
 		cmd.PersistentFlags().Duration(DbSqliteBusyTimeoutFlag(), cfg.DbSqliteBusyTimeout, fieldtag("DbSqliteBusyTimeout", "usage"))
+		cmd.PersistentFlags().StringSlice(DbReplicaAddressesFlag(), cfg.DbReplicaAddresses, fieldtag("DbReplicaAddresses", "usage"))
+		cmd.PersistentFlags().String(DbMaintenanceScheduleFlag(), cfg.DbMaintenanceSchedule, fieldtag("DbMaintenanceSchedule", "usage"))
 
 		// HTTPClient
 		cmd.PersistentFlags().StringSlice(HTTPClientAllowIPsFlag(), cfg.HTTPClient.AllowIPs, "no usage string")
@@ -81,6 +84,11 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		// Template
 		cmd.Flags().String(WebTemplateBaseDirFlag(), cfg.WebTemplateBaseDir, fieldtag("WebTemplateBaseDir", "usage"))
 		cmd.Flags().String(WebAssetBaseDirFlag(), cfg.WebAssetBaseDir, fieldtag("WebAssetBaseDir", "usage"))
+		cmd.Flags().StringSlice(WebCSPExtraSrcFlag(), cfg.WebCSPExtraSrc, fieldtag("WebCSPExtraSrc", "usage"))
+		cmd.Flags().String(WebDefaultThemeFlag(), cfg.WebDefaultTheme, fieldtag("WebDefaultTheme", "usage"))
+
+		// API
+		cmd.Flags().StringSlice(APICORSAllowedOriginsFlag(), cfg.APICORSAllowedOrigins, fieldtag("APICORSAllowedOrigins", "usage"))
 
 		// Instance
 		cmd.Flags().String(InstanceFederationModeFlag(), cfg.InstanceFederationMode, fieldtag("InstanceFederationMode", "usage"))
@@ -90,20 +98,25 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
 		cmd.Flags().StringSlice(InstanceLanguagesFlag(), cfg.InstanceLanguages.TagStrs(), fieldtag("InstanceLanguages", "usage"))
+		cmd.Flags().Bool(InstanceObfuscateIDsFlag(), cfg.InstanceObfuscateIDs, fieldtag("InstanceObfuscateIDs", "usage"))
+		cmd.Flags().String(InstanceObfuscateIDsSecretFlag(), cfg.InstanceObfuscateIDsSecret, fieldtag("InstanceObfuscateIDsSecret", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Bool(AccountsStatsRecountWeeklyFlag(), cfg.AccountsStatsRecountWeekly, fieldtag("AccountsStatsRecountWeekly", "usage"))
 
 		// Media
 		cmd.Flags().Uint64(MediaImageMaxSizeFlag(), uint64(cfg.MediaImageMaxSize), fieldtag("MediaImageMaxSize", "usage"))
 		cmd.Flags().Uint64(MediaVideoMaxSizeFlag(), uint64(cfg.MediaVideoMaxSize), fieldtag("MediaVideoMaxSize", "usage"))
 		cmd.Flags().Int(MediaDescriptionMinCharsFlag(), cfg.MediaDescriptionMinChars, fieldtag("MediaDescriptionMinChars", "usage"))
 		cmd.Flags().Int(MediaDescriptionMaxCharsFlag(), cfg.MediaDescriptionMaxChars, fieldtag("MediaDescriptionMaxChars", "usage"))
+		cmd.Flags().Bool(MediaDescriptionRequiredFlag(), cfg.MediaDescriptionRequired, fieldtag("MediaDescriptionRequired", "usage"))
 		cmd.Flags().Int(MediaRemoteCacheDaysFlag(), cfg.MediaRemoteCacheDays, fieldtag("MediaRemoteCacheDays", "usage"))
 		cmd.Flags().Uint64(MediaEmojiLocalMaxSizeFlag(), uint64(cfg.MediaEmojiLocalMaxSize), fieldtag("MediaEmojiLocalMaxSize", "usage"))
 		cmd.Flags().Uint64(MediaEmojiRemoteMaxSizeFlag(), uint64(cfg.MediaEmojiRemoteMaxSize), fieldtag("MediaEmojiRemoteMaxSize", "usage"))
+		cmd.Flags().Uint64(MediaAccountQuotaFlag(), uint64(cfg.MediaAccountQuota), fieldtag("MediaAccountQuota", "usage"))
 		cmd.Flags().String(MediaCleanupFromFlag(), cfg.MediaCleanupFrom, fieldtag("MediaCleanupFrom", "usage"))
 		cmd.Flags().Duration(MediaCleanupEveryFlag(), cfg.MediaCleanupEvery, fieldtag("MediaCleanupEvery", "usage"))
 
@@ -113,9 +126,16 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 
 		// Statuses
 		cmd.Flags().Int(StatusesMaxCharsFlag(), cfg.StatusesMaxChars, fieldtag("StatusesMaxChars", "usage"))
+		cmd.Flags().Int(MaxSpoilerTextCharsFlag(), cfg.MaxSpoilerTextChars, fieldtag("MaxSpoilerTextChars", "usage"))
+		cmd.Flags().Int(MinStatusCharsFlag(), cfg.MinStatusChars, fieldtag("MinStatusChars", "usage"))
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
+		cmd.Flags().Int(StatusesRetentionDeletesPerHourFlag(), cfg.StatusesRetentionDeletesPerHour, fieldtag("StatusesRetentionDeletesPerHour", "usage"))
+		cmd.Flags().Bool(DetectStatusLanguageFlag(), cfg.DetectStatusLanguage, fieldtag("DetectStatusLanguage", "usage"))
+		cmd.Flags().StringSlice(DetectStatusLanguagesFlag(), cfg.DetectStatusLanguages, fieldtag("DetectStatusLanguages", "usage"))
+		cmd.Flags().Int(StatusContextMaxDepthAncestorsFlag(), cfg.StatusContextMaxDepthAncestors, fieldtag("StatusContextMaxDepthAncestors", "usage"))
+		cmd.Flags().Int(StatusContextMaxDepthDescendantsFlag(), cfg.StatusContextMaxDepthDescendants, fieldtag("StatusContextMaxDepthDescendants", "usage"))
 
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
@@ -149,6 +169,15 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
 		cmd.Flags().String(SyslogAddressFlag(), cfg.SyslogAddress, fieldtag("SyslogAddress", "usage"))
 
+		// Federation delivery
+		cmd.Flags().String(FederationDeliveryBackendFlag(), cfg.FederationDeliveryBackend, fieldtag("FederationDeliveryBackend", "usage"))
+		cmd.Flags().String(FederationDeliveryRedisAddressFlag(), cfg.FederationDeliveryRedisAddress, fieldtag("FederationDeliveryRedisAddress", "usage"))
+		cmd.Flags().String(FederationDeliveryRedisPasswordFlag(), cfg.FederationDeliveryRedisPassword, fieldtag("FederationDeliveryRedisPassword", "usage"))
+		cmd.Flags().String(FederationDeliveryRedisKeyFlag(), cfg.FederationDeliveryRedisKey, fieldtag("FederationDeliveryRedisKey", "usage"))
+		cmd.Flags().StringSlice(FederationBlockListURLsFlag(), cfg.FederationBlockListURLs, fieldtag("FederationBlockListURLs", "usage"))
+		cmd.Flags().Bool(FederationAuditLogFlag(), cfg.FederationAuditLog, fieldtag("FederationAuditLog", "usage"))
+		cmd.Flags().Int(FederationAuditLogMaxRowsFlag(), cfg.FederationAuditLogMaxRows, fieldtag("FederationAuditLogMaxRows", "usage"))
+
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
@@ -156,6 +185,10 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(AdvancedThrottlingMultiplierFlag(), cfg.AdvancedThrottlingMultiplier, fieldtag("AdvancedThrottlingMultiplier", "usage"))
 		cmd.Flags().Duration(AdvancedThrottlingRetryAfterFlag(), cfg.AdvancedThrottlingRetryAfter, fieldtag("AdvancedThrottlingRetryAfter", "usage"))
 		cmd.Flags().Int(AdvancedSenderMultiplierFlag(), cfg.AdvancedSenderMultiplier, fieldtag("AdvancedSenderMultiplier", "usage"))
+		cmd.Flags().Int(AdvancedClientWorkerMultiplierFlag(), cfg.AdvancedClientWorkerMultiplier, fieldtag("AdvancedClientWorkerMultiplier", "usage"))
+		cmd.Flags().Int(AdvancedFederatorWorkerMultiplierFlag(), cfg.AdvancedFederatorWorkerMultiplier, fieldtag("AdvancedFederatorWorkerMultiplier", "usage"))
+		cmd.Flags().Int(AdvancedDereferenceWorkerMultiplierFlag(), cfg.AdvancedDereferenceWorkerMultiplier, fieldtag("AdvancedDereferenceWorkerMultiplier", "usage"))
+		cmd.Flags().Int(AdvancedMediaWorkerMultiplierFlag(), cfg.AdvancedMediaWorkerMultiplier, fieldtag("AdvancedMediaWorkerMultiplier", "usage"))
 		cmd.Flags().StringSlice(AdvancedCSPExtraURIsFlag(), cfg.AdvancedCSPExtraURIs, fieldtag("AdvancedCSPExtraURIs", "usage"))
 		cmd.Flags().String(AdvancedHeaderFilterModeFlag(), cfg.AdvancedHeaderFilterMode, fieldtag("AdvancedHeaderFilterMode", "usage"))
 
@@ -195,6 +228,10 @@ func AddAdminAccountCreate(cmd *cobra.Command) {
 	if err := cmd.MarkFlagRequired(name); err != nil {
 		panic(err)
 	}
+
+	name = AdminAccountDomainFlag()
+	usage = fieldtag("AdminAccountDomain", "usage")
+	cmd.Flags().String(name, "", usage)
 }
 
 // AddAdminTrans attaches flags pertaining to import/export commands.
@@ -224,3 +261,10 @@ func AddAdminMediaPrune(cmd *cobra.Command) {
 	usage := fieldtag("AdminMediaPruneDryRun", "usage")
 	cmd.Flags().Bool(name, true, usage)
 }
+
+// AddAdminMediaPruneReport attaches flags pertaining to media prune reports.
+func AddAdminMediaPruneReport(cmd *cobra.Command) {
+	name := AdminMediaPruneReportFlag()
+	usage := fieldtag("AdminMediaPruneReport", "usage")
+	cmd.Flags().String(name, "", usage)
+}
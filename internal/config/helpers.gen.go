@@ -250,6 +250,31 @@ func GetAccountDomain() string { return global.GetAccountDomain() }
 // SetAccountDomain safely sets the value for global configuration 'AccountDomain' field
 func SetAccountDomain(v string) { global.SetAccountDomain(v) }
 
+// GetAccountDomains safely fetches the Configuration value for state's 'AccountDomains' field
+func (st *ConfigState) GetAccountDomains() (v []string) {
+	st.mutex.RLock()
+	v = st.config.AccountDomains
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountDomains safely sets the Configuration value for state's 'AccountDomains' field
+func (st *ConfigState) SetAccountDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountDomains = v
+	st.reloadToViper()
+}
+
+// AccountDomainsFlag returns the flag name for the 'AccountDomains' field
+func AccountDomainsFlag() string { return "account-domains" }
+
+// GetAccountDomains safely fetches the value for global configuration 'AccountDomains' field
+func GetAccountDomains() []string { return global.GetAccountDomains() }
+
+// SetAccountDomains safely sets the value for global configuration 'AccountDomains' field
+func SetAccountDomains(v []string) { global.SetAccountDomains(v) }
+
 // GetProtocol safely fetches the Configuration value for state's 'Protocol' field
 func (st *ConfigState) GetProtocol() (v string) {
 	st.mutex.RLock()
@@ -700,6 +725,56 @@ func GetDbSqliteBusyTimeout() time.Duration { return global.GetDbSqliteBusyTimeo
 // SetDbSqliteBusyTimeout safely sets the value for global configuration 'DbSqliteBusyTimeout' field
 func SetDbSqliteBusyTimeout(v time.Duration) { global.SetDbSqliteBusyTimeout(v) }
 
+// GetDbReplicaAddresses safely fetches the Configuration value for state's 'DbReplicaAddresses' field
+func (st *ConfigState) GetDbReplicaAddresses() (v []string) {
+	st.mutex.RLock()
+	v = st.config.DbReplicaAddresses
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbReplicaAddresses safely sets the Configuration value for state's 'DbReplicaAddresses' field
+func (st *ConfigState) SetDbReplicaAddresses(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbReplicaAddresses = v
+	st.reloadToViper()
+}
+
+// DbReplicaAddressesFlag returns the flag name for the 'DbReplicaAddresses' field
+func DbReplicaAddressesFlag() string { return "db-replica-addresses" }
+
+// GetDbReplicaAddresses safely fetches the value for global configuration 'DbReplicaAddresses' field
+func GetDbReplicaAddresses() []string { return global.GetDbReplicaAddresses() }
+
+// SetDbReplicaAddresses safely sets the value for global configuration 'DbReplicaAddresses' field
+func SetDbReplicaAddresses(v []string) { global.SetDbReplicaAddresses(v) }
+
+// GetDbMaintenanceSchedule safely fetches the Configuration value for state's 'DbMaintenanceSchedule' field
+func (st *ConfigState) GetDbMaintenanceSchedule() (v string) {
+	st.mutex.RLock()
+	v = st.config.DbMaintenanceSchedule
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbMaintenanceSchedule safely sets the Configuration value for state's 'DbMaintenanceSchedule' field
+func (st *ConfigState) SetDbMaintenanceSchedule(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbMaintenanceSchedule = v
+	st.reloadToViper()
+}
+
+// DbMaintenanceScheduleFlag returns the flag name for the 'DbMaintenanceSchedule' field
+func DbMaintenanceScheduleFlag() string { return "db-maintenance-schedule" }
+
+// GetDbMaintenanceSchedule safely fetches the value for global configuration 'DbMaintenanceSchedule' field
+func GetDbMaintenanceSchedule() string { return global.GetDbMaintenanceSchedule() }
+
+// SetDbMaintenanceSchedule safely sets the value for global configuration 'DbMaintenanceSchedule' field
+func SetDbMaintenanceSchedule(v string) { global.SetDbMaintenanceSchedule(v) }
+
 // GetWebTemplateBaseDir safely fetches the Configuration value for state's 'WebTemplateBaseDir' field
 func (st *ConfigState) GetWebTemplateBaseDir() (v string) {
 	st.mutex.RLock()
@@ -750,6 +825,81 @@ func GetWebAssetBaseDir() string { return global.GetWebAssetBaseDir() }
 // SetWebAssetBaseDir safely sets the value for global configuration 'WebAssetBaseDir' field
 func SetWebAssetBaseDir(v string) { global.SetWebAssetBaseDir(v) }
 
+// GetWebCSPExtraSrc safely fetches the Configuration value for state's 'WebCSPExtraSrc' field
+func (st *ConfigState) GetWebCSPExtraSrc() (v []string) {
+	st.mutex.RLock()
+	v = st.config.WebCSPExtraSrc
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebCSPExtraSrc safely sets the Configuration value for state's 'WebCSPExtraSrc' field
+func (st *ConfigState) SetWebCSPExtraSrc(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebCSPExtraSrc = v
+	st.reloadToViper()
+}
+
+// WebCSPExtraSrcFlag returns the flag name for the 'WebCSPExtraSrc' field
+func WebCSPExtraSrcFlag() string { return "web-csp-extra-src" }
+
+// GetWebCSPExtraSrc safely fetches the value for global configuration 'WebCSPExtraSrc' field
+func GetWebCSPExtraSrc() []string { return global.GetWebCSPExtraSrc() }
+
+// SetWebCSPExtraSrc safely sets the value for global configuration 'WebCSPExtraSrc' field
+func SetWebCSPExtraSrc(v []string) { global.SetWebCSPExtraSrc(v) }
+
+// GetWebDefaultTheme safely fetches the Configuration value for state's 'WebDefaultTheme' field
+func (st *ConfigState) GetWebDefaultTheme() (v string) {
+	st.mutex.RLock()
+	v = st.config.WebDefaultTheme
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebDefaultTheme safely sets the Configuration value for state's 'WebDefaultTheme' field
+func (st *ConfigState) SetWebDefaultTheme(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebDefaultTheme = v
+	st.reloadToViper()
+}
+
+// WebDefaultThemeFlag returns the flag name for the 'WebDefaultTheme' field
+func WebDefaultThemeFlag() string { return "web-default-theme" }
+
+// GetWebDefaultTheme safely fetches the value for global configuration 'WebDefaultTheme' field
+func GetWebDefaultTheme() string { return global.GetWebDefaultTheme() }
+
+// SetWebDefaultTheme safely sets the value for global configuration 'WebDefaultTheme' field
+func SetWebDefaultTheme(v string) { global.SetWebDefaultTheme(v) }
+
+// GetAPICORSAllowedOrigins safely fetches the Configuration value for state's 'APICORSAllowedOrigins' field
+func (st *ConfigState) GetAPICORSAllowedOrigins() (v []string) {
+	st.mutex.RLock()
+	v = st.config.APICORSAllowedOrigins
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAPICORSAllowedOrigins safely sets the Configuration value for state's 'APICORSAllowedOrigins' field
+func (st *ConfigState) SetAPICORSAllowedOrigins(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.APICORSAllowedOrigins = v
+	st.reloadToViper()
+}
+
+// APICORSAllowedOriginsFlag returns the flag name for the 'APICORSAllowedOrigins' field
+func APICORSAllowedOriginsFlag() string { return "api-cors-allowed-origins" }
+
+// GetAPICORSAllowedOrigins safely fetches the value for global configuration 'APICORSAllowedOrigins' field
+func GetAPICORSAllowedOrigins() []string { return global.GetAPICORSAllowedOrigins() }
+
+// SetAPICORSAllowedOrigins safely sets the value for global configuration 'APICORSAllowedOrigins' field
+func SetAPICORSAllowedOrigins(v []string) { global.SetAPICORSAllowedOrigins(v) }
+
 // GetInstanceFederationMode safely fetches the Configuration value for state's 'InstanceFederationMode' field
 func (st *ConfigState) GetInstanceFederationMode() (v string) {
 	st.mutex.RLock()
@@ -975,6 +1125,56 @@ func GetInstanceLanguages() language.Languages { return global.GetInstanceLangua
 // SetInstanceLanguages safely sets the value for global configuration 'InstanceLanguages' field
 func SetInstanceLanguages(v language.Languages) { global.SetInstanceLanguages(v) }
 
+// GetInstanceObfuscateIDs safely fetches the Configuration value for state's 'InstanceObfuscateIDs' field
+func (st *ConfigState) GetInstanceObfuscateIDs() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceObfuscateIDs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceObfuscateIDs safely sets the Configuration value for state's 'InstanceObfuscateIDs' field
+func (st *ConfigState) SetInstanceObfuscateIDs(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceObfuscateIDs = v
+	st.reloadToViper()
+}
+
+// InstanceObfuscateIDsFlag returns the flag name for the 'InstanceObfuscateIDs' field
+func InstanceObfuscateIDsFlag() string { return "instance-obfuscate-ids" }
+
+// GetInstanceObfuscateIDs safely fetches the value for global configuration 'InstanceObfuscateIDs' field
+func GetInstanceObfuscateIDs() bool { return global.GetInstanceObfuscateIDs() }
+
+// SetInstanceObfuscateIDs safely sets the value for global configuration 'InstanceObfuscateIDs' field
+func SetInstanceObfuscateIDs(v bool) { global.SetInstanceObfuscateIDs(v) }
+
+// GetInstanceObfuscateIDsSecret safely fetches the Configuration value for state's 'InstanceObfuscateIDsSecret' field
+func (st *ConfigState) GetInstanceObfuscateIDsSecret() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstanceObfuscateIDsSecret
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceObfuscateIDsSecret safely sets the Configuration value for state's 'InstanceObfuscateIDsSecret' field
+func (st *ConfigState) SetInstanceObfuscateIDsSecret(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceObfuscateIDsSecret = v
+	st.reloadToViper()
+}
+
+// InstanceObfuscateIDsSecretFlag returns the flag name for the 'InstanceObfuscateIDsSecret' field
+func InstanceObfuscateIDsSecretFlag() string { return "instance-obfuscate-ids-secret" }
+
+// GetInstanceObfuscateIDsSecret safely fetches the value for global configuration 'InstanceObfuscateIDsSecret' field
+func GetInstanceObfuscateIDsSecret() string { return global.GetInstanceObfuscateIDsSecret() }
+
+// SetInstanceObfuscateIDsSecret safely sets the value for global configuration 'InstanceObfuscateIDsSecret' field
+func SetInstanceObfuscateIDsSecret(v string) { global.SetInstanceObfuscateIDsSecret(v) }
+
 // GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
 func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.RLock()
@@ -1075,6 +1275,31 @@ func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength
 // SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
 func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
+// GetAccountsStatsRecountWeekly safely fetches the Configuration value for state's 'AccountsStatsRecountWeekly' field
+func (st *ConfigState) GetAccountsStatsRecountWeekly() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsStatsRecountWeekly
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsStatsRecountWeekly safely sets the Configuration value for state's 'AccountsStatsRecountWeekly' field
+func (st *ConfigState) SetAccountsStatsRecountWeekly(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsStatsRecountWeekly = v
+	st.reloadToViper()
+}
+
+// AccountsStatsRecountWeeklyFlag returns the flag name for the 'AccountsStatsRecountWeekly' field
+func AccountsStatsRecountWeeklyFlag() string { return "accounts-stats-recount-weekly" }
+
+// GetAccountsStatsRecountWeekly safely fetches the value for global configuration 'AccountsStatsRecountWeekly' field
+func GetAccountsStatsRecountWeekly() bool { return global.GetAccountsStatsRecountWeekly() }
+
+// SetAccountsStatsRecountWeekly safely sets the value for global configuration 'AccountsStatsRecountWeekly' field
+func SetAccountsStatsRecountWeekly(v bool) { global.SetAccountsStatsRecountWeekly(v) }
+
 // GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
 func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
@@ -1175,6 +1400,31 @@ func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxCha
 // SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
 func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
 
+// GetMediaDescriptionRequired safely fetches the Configuration value for state's 'MediaDescriptionRequired' field
+func (st *ConfigState) GetMediaDescriptionRequired() (v bool) {
+	st.mutex.RLock()
+	v = st.config.MediaDescriptionRequired
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescriptionRequired safely sets the Configuration value for state's 'MediaDescriptionRequired' field
+func (st *ConfigState) SetMediaDescriptionRequired(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaDescriptionRequired = v
+	st.reloadToViper()
+}
+
+// MediaDescriptionRequiredFlag returns the flag name for the 'MediaDescriptionRequired' field
+func MediaDescriptionRequiredFlag() string { return "media-description-required" }
+
+// GetMediaDescriptionRequired safely fetches the value for global configuration 'MediaDescriptionRequired' field
+func GetMediaDescriptionRequired() bool { return global.GetMediaDescriptionRequired() }
+
+// SetMediaDescriptionRequired safely sets the value for global configuration 'MediaDescriptionRequired' field
+func SetMediaDescriptionRequired(v bool) { global.SetMediaDescriptionRequired(v) }
+
 // GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
 func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
 	st.mutex.RLock()
@@ -1250,6 +1500,31 @@ func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRem
 // SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
 func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
 
+// GetMediaAccountQuota safely fetches the Configuration value for state's 'MediaAccountQuota' field
+func (st *ConfigState) GetMediaAccountQuota() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaAccountQuota
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaAccountQuota safely sets the Configuration value for state's 'MediaAccountQuota' field
+func (st *ConfigState) SetMediaAccountQuota(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaAccountQuota = v
+	st.reloadToViper()
+}
+
+// MediaAccountQuotaFlag returns the flag name for the 'MediaAccountQuota' field
+func MediaAccountQuotaFlag() string { return "media-account-quota" }
+
+// GetMediaAccountQuota safely fetches the value for global configuration 'MediaAccountQuota' field
+func GetMediaAccountQuota() bytesize.Size { return global.GetMediaAccountQuota() }
+
+// SetMediaAccountQuota safely sets the value for global configuration 'MediaAccountQuota' field
+func SetMediaAccountQuota(v bytesize.Size) { global.SetMediaAccountQuota(v) }
+
 // GetMediaCleanupFrom safely fetches the Configuration value for state's 'MediaCleanupFrom' field
 func (st *ConfigState) GetMediaCleanupFrom() (v string) {
 	st.mutex.RLock()
@@ -1500,241 +1775,666 @@ func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
 // SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
 func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
 
-// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) GetStatusesMaxChars() (v int) {
+// GetStorageS3RequesterPays safely fetches the Configuration value for state's 'StorageS3RequesterPays' field
+func (st *ConfigState) GetStorageS3RequesterPays() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StatusesMaxChars
+	v = st.config.StorageS3RequesterPays
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) SetStatusesMaxChars(v int) {
+// SetStorageS3RequesterPays safely sets the Configuration value for state's 'StorageS3RequesterPays' field
+func (st *ConfigState) SetStorageS3RequesterPays(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMaxChars = v
+	st.config.StorageS3RequesterPays = v
 	st.reloadToViper()
 }
 
-// StatusesMaxCharsFlag returns the flag name for the 'StatusesMaxChars' field
-func StatusesMaxCharsFlag() string { return "statuses-max-chars" }
+// StorageS3RequesterPaysFlag returns the flag name for the 'StorageS3RequesterPays' field
+func StorageS3RequesterPaysFlag() string { return "storage-s3-requester-pays" }
 
-// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
-func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+// GetStorageS3RequesterPays safely fetches the value for global configuration 'StorageS3RequesterPays' field
+func GetStorageS3RequesterPays() bool { return global.GetStorageS3RequesterPays() }
 
-// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
-func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+// SetStorageS3RequesterPays safely sets the value for global configuration 'StorageS3RequesterPays' field
+func SetStorageS3RequesterPays(v bool) { global.SetStorageS3RequesterPays(v) }
 
-// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+// GetStorageS3RateLimitInitial safely fetches the Configuration value for state's 'StorageS3RateLimitInitial' field
+func (st *ConfigState) GetStorageS3RateLimitInitial() (v float64) {
 	st.mutex.RLock()
-	v = st.config.StatusesPollMaxOptions
+	v = st.config.StorageS3RateLimitInitial
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+// SetStorageS3RateLimitInitial safely sets the Configuration value for state's 'StorageS3RateLimitInitial' field
+func (st *ConfigState) SetStorageS3RateLimitInitial(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollMaxOptions = v
+	st.config.StorageS3RateLimitInitial = v
 	st.reloadToViper()
 }
 
-// StatusesPollMaxOptionsFlag returns the flag name for the 'StatusesPollMaxOptions' field
-func StatusesPollMaxOptionsFlag() string { return "statuses-poll-max-options" }
+// StorageS3RateLimitInitialFlag returns the flag name for the 'StorageS3RateLimitInitial' field
+func StorageS3RateLimitInitialFlag() string { return "storage-s3-rate-limit-initial" }
 
-// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
-func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+// GetStorageS3RateLimitInitial safely fetches the value for global configuration 'StorageS3RateLimitInitial' field
+func GetStorageS3RateLimitInitial() float64 { return global.GetStorageS3RateLimitInitial() }
 
-// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
-func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+// SetStorageS3RateLimitInitial safely sets the value for global configuration 'StorageS3RateLimitInitial' field
+func SetStorageS3RateLimitInitial(v float64) { global.SetStorageS3RateLimitInitial(v) }
 
-// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+// GetStorageS3RateLimitMin safely fetches the Configuration value for state's 'StorageS3RateLimitMin' field
+func (st *ConfigState) GetStorageS3RateLimitMin() (v float64) {
 	st.mutex.RLock()
-	v = st.config.StatusesPollOptionMaxChars
+	v = st.config.StorageS3RateLimitMin
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+// SetStorageS3RateLimitMin safely sets the Configuration value for state's 'StorageS3RateLimitMin' field
+func (st *ConfigState) SetStorageS3RateLimitMin(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollOptionMaxChars = v
+	st.config.StorageS3RateLimitMin = v
 	st.reloadToViper()
 }
 
-// StatusesPollOptionMaxCharsFlag returns the flag name for the 'StatusesPollOptionMaxChars' field
-func StatusesPollOptionMaxCharsFlag() string { return "statuses-poll-option-max-chars" }
+// StorageS3RateLimitMinFlag returns the flag name for the 'StorageS3RateLimitMin' field
+func StorageS3RateLimitMinFlag() string { return "storage-s3-rate-limit-min" }
 
-// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
-func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+// GetStorageS3RateLimitMin safely fetches the value for global configuration 'StorageS3RateLimitMin' field
+func GetStorageS3RateLimitMin() float64 { return global.GetStorageS3RateLimitMin() }
 
-// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
-func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+// SetStorageS3RateLimitMin safely sets the value for global configuration 'StorageS3RateLimitMin' field
+func SetStorageS3RateLimitMin(v float64) { global.SetStorageS3RateLimitMin(v) }
 
-// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+// GetStorageS3RateLimitMax safely fetches the Configuration value for state's 'StorageS3RateLimitMax' field
+func (st *ConfigState) GetStorageS3RateLimitMax() (v float64) {
 	st.mutex.RLock()
-	v = st.config.StatusesMediaMaxFiles
+	v = st.config.StorageS3RateLimitMax
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+// SetStorageS3RateLimitMax safely sets the Configuration value for state's 'StorageS3RateLimitMax' field
+func (st *ConfigState) SetStorageS3RateLimitMax(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMediaMaxFiles = v
+	st.config.StorageS3RateLimitMax = v
 	st.reloadToViper()
 }
 
-// StatusesMediaMaxFilesFlag returns the flag name for the 'StatusesMediaMaxFiles' field
-func StatusesMediaMaxFilesFlag() string { return "statuses-media-max-files" }
+// StorageS3RateLimitMaxFlag returns the flag name for the 'StorageS3RateLimitMax' field
+func StorageS3RateLimitMaxFlag() string { return "storage-s3-rate-limit-max" }
 
-// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
-func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+// GetStorageS3RateLimitMax safely fetches the value for global configuration 'StorageS3RateLimitMax' field
+func GetStorageS3RateLimitMax() float64 { return global.GetStorageS3RateLimitMax() }
 
-// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
-func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+// SetStorageS3RateLimitMax safely sets the value for global configuration 'StorageS3RateLimitMax' field
+func SetStorageS3RateLimitMax(v float64) { global.SetStorageS3RateLimitMax(v) }
 
-// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+// GetStorageAzureConnectionString safely fetches the Configuration value for state's 'StorageAzureConnectionString' field
+func (st *ConfigState) GetStorageAzureConnectionString() (v string) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEnabled
+	v = st.config.StorageAzureConnectionString
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+// SetStorageAzureConnectionString safely sets the Configuration value for state's 'StorageAzureConnectionString' field
+func (st *ConfigState) SetStorageAzureConnectionString(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEnabled = v
+	st.config.StorageAzureConnectionString = v
 	st.reloadToViper()
 }
 
-// LetsEncryptEnabledFlag returns the flag name for the 'LetsEncryptEnabled' field
-func LetsEncryptEnabledFlag() string { return "letsencrypt-enabled" }
+// StorageAzureConnectionStringFlag returns the flag name for the 'StorageAzureConnectionString' field
+func StorageAzureConnectionStringFlag() string { return "storage-azure-connection-string" }
 
-// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
-func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+// GetStorageAzureConnectionString safely fetches the value for global configuration 'StorageAzureConnectionString' field
+func GetStorageAzureConnectionString() string { return global.GetStorageAzureConnectionString() }
 
-// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
-func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+// SetStorageAzureConnectionString safely sets the value for global configuration 'StorageAzureConnectionString' field
+func SetStorageAzureConnectionString(v string) { global.SetStorageAzureConnectionString(v) }
 
-// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) GetLetsEncryptPort() (v int) {
+// GetStorageAzureAccountName safely fetches the Configuration value for state's 'StorageAzureAccountName' field
+func (st *ConfigState) GetStorageAzureAccountName() (v string) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptPort
+	v = st.config.StorageAzureAccountName
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) SetLetsEncryptPort(v int) {
+// SetStorageAzureAccountName safely sets the Configuration value for state's 'StorageAzureAccountName' field
+func (st *ConfigState) SetStorageAzureAccountName(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptPort = v
+	st.config.StorageAzureAccountName = v
 	st.reloadToViper()
 }
 
-// LetsEncryptPortFlag returns the flag name for the 'LetsEncryptPort' field
-func LetsEncryptPortFlag() string { return "letsencrypt-port" }
+// StorageAzureAccountNameFlag returns the flag name for the 'StorageAzureAccountName' field
+func StorageAzureAccountNameFlag() string { return "storage-azure-account-name" }
 
-// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
-func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+// GetStorageAzureAccountName safely fetches the value for global configuration 'StorageAzureAccountName' field
+func GetStorageAzureAccountName() string { return global.GetStorageAzureAccountName() }
 
-// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
-func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+// SetStorageAzureAccountName safely sets the value for global configuration 'StorageAzureAccountName' field
+func SetStorageAzureAccountName(v string) { global.SetStorageAzureAccountName(v) }
 
-// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+// GetStorageAzureAccountKey safely fetches the Configuration value for state's 'StorageAzureAccountKey' field
+func (st *ConfigState) GetStorageAzureAccountKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptCertDir
+	v = st.config.StorageAzureAccountKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+// SetStorageAzureAccountKey safely sets the Configuration value for state's 'StorageAzureAccountKey' field
+func (st *ConfigState) SetStorageAzureAccountKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptCertDir = v
+	st.config.StorageAzureAccountKey = v
 	st.reloadToViper()
 }
 
-// LetsEncryptCertDirFlag returns the flag name for the 'LetsEncryptCertDir' field
-func LetsEncryptCertDirFlag() string { return "letsencrypt-cert-dir" }
+// StorageAzureAccountKeyFlag returns the flag name for the 'StorageAzureAccountKey' field
+func StorageAzureAccountKeyFlag() string { return "storage-azure-account-key" }
 
-// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
-func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+// GetStorageAzureAccountKey safely fetches the value for global configuration 'StorageAzureAccountKey' field
+func GetStorageAzureAccountKey() string { return global.GetStorageAzureAccountKey() }
 
-// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
-func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+// SetStorageAzureAccountKey safely sets the value for global configuration 'StorageAzureAccountKey' field
+func SetStorageAzureAccountKey(v string) { global.SetStorageAzureAccountKey(v) }
 
-// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+// GetStorageAzureEndpoint safely fetches the Configuration value for state's 'StorageAzureEndpoint' field
+func (st *ConfigState) GetStorageAzureEndpoint() (v string) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEmailAddress
+	v = st.config.StorageAzureEndpoint
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+// SetStorageAzureEndpoint safely sets the Configuration value for state's 'StorageAzureEndpoint' field
+func (st *ConfigState) SetStorageAzureEndpoint(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEmailAddress = v
+	st.config.StorageAzureEndpoint = v
 	st.reloadToViper()
 }
 
-// LetsEncryptEmailAddressFlag returns the flag name for the 'LetsEncryptEmailAddress' field
-func LetsEncryptEmailAddressFlag() string { return "letsencrypt-email-address" }
+// StorageAzureEndpointFlag returns the flag name for the 'StorageAzureEndpoint' field
+func StorageAzureEndpointFlag() string { return "storage-azure-endpoint" }
 
-// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
-func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+// GetStorageAzureEndpoint safely fetches the value for global configuration 'StorageAzureEndpoint' field
+func GetStorageAzureEndpoint() string { return global.GetStorageAzureEndpoint() }
 
-// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
-func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+// SetStorageAzureEndpoint safely sets the value for global configuration 'StorageAzureEndpoint' field
+func SetStorageAzureEndpoint(v string) { global.SetStorageAzureEndpoint(v) }
 
-// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) GetTLSCertificateChain() (v string) {
+// GetStorageAzureContainer safely fetches the Configuration value for state's 'StorageAzureContainer' field
+func (st *ConfigState) GetStorageAzureContainer() (v string) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateChain
+	v = st.config.StorageAzureContainer
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) SetTLSCertificateChain(v string) {
+// SetStorageAzureContainer safely sets the Configuration value for state's 'StorageAzureContainer' field
+func (st *ConfigState) SetStorageAzureContainer(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateChain = v
+	st.config.StorageAzureContainer = v
 	st.reloadToViper()
 }
 
-// TLSCertificateChainFlag returns the flag name for the 'TLSCertificateChain' field
-func TLSCertificateChainFlag() string { return "tls-certificate-chain" }
+// StorageAzureContainerFlag returns the flag name for the 'StorageAzureContainer' field
+func StorageAzureContainerFlag() string { return "storage-azure-container" }
 
-// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
-func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+// GetStorageAzureContainer safely fetches the value for global configuration 'StorageAzureContainer' field
+func GetStorageAzureContainer() string { return global.GetStorageAzureContainer() }
 
-// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
-func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+// SetStorageAzureContainer safely sets the value for global configuration 'StorageAzureContainer' field
+func SetStorageAzureContainer(v string) { global.SetStorageAzureContainer(v) }
 
-// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) GetTLSCertificateKey() (v string) {
+// GetStorageAzureBlockSize safely fetches the Configuration value for state's 'StorageAzureBlockSize' field
+func (st *ConfigState) GetStorageAzureBlockSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateKey
+	v = st.config.StorageAzureBlockSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) SetTLSCertificateKey(v string) {
+// SetStorageAzureBlockSize safely sets the Configuration value for state's 'StorageAzureBlockSize' field
+func (st *ConfigState) SetStorageAzureBlockSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageAzureBlockSize = v
+	st.reloadToViper()
+}
+
+// StorageAzureBlockSizeFlag returns the flag name for the 'StorageAzureBlockSize' field
+func StorageAzureBlockSizeFlag() string { return "storage-azure-block-size" }
+
+// GetStorageAzureBlockSize safely fetches the value for global configuration 'StorageAzureBlockSize' field
+func GetStorageAzureBlockSize() bytesize.Size { return global.GetStorageAzureBlockSize() }
+
+// SetStorageAzureBlockSize safely sets the value for global configuration 'StorageAzureBlockSize' field
+func SetStorageAzureBlockSize(v bytesize.Size) { global.SetStorageAzureBlockSize(v) }
+
+// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) GetStatusesMaxChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesMaxChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) SetStatusesMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesMaxCharsFlag returns the flag name for the 'StatusesMaxChars' field
+func StatusesMaxCharsFlag() string { return "statuses-max-chars" }
+
+// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
+func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+
+// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
+func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+
+// GetMaxSpoilerTextChars safely fetches the Configuration value for state's 'MaxSpoilerTextChars' field
+func (st *ConfigState) GetMaxSpoilerTextChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.MaxSpoilerTextChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMaxSpoilerTextChars safely sets the Configuration value for state's 'MaxSpoilerTextChars' field
+func (st *ConfigState) SetMaxSpoilerTextChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MaxSpoilerTextChars = v
+	st.reloadToViper()
+}
+
+// MaxSpoilerTextCharsFlag returns the flag name for the 'MaxSpoilerTextChars' field
+func MaxSpoilerTextCharsFlag() string { return "max-spoiler-text-chars" }
+
+// GetMaxSpoilerTextChars safely fetches the value for global configuration 'MaxSpoilerTextChars' field
+func GetMaxSpoilerTextChars() int { return global.GetMaxSpoilerTextChars() }
+
+// SetMaxSpoilerTextChars safely sets the value for global configuration 'MaxSpoilerTextChars' field
+func SetMaxSpoilerTextChars(v int) { global.SetMaxSpoilerTextChars(v) }
+
+// GetMinStatusChars safely fetches the Configuration value for state's 'MinStatusChars' field
+func (st *ConfigState) GetMinStatusChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.MinStatusChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMinStatusChars safely sets the Configuration value for state's 'MinStatusChars' field
+func (st *ConfigState) SetMinStatusChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MinStatusChars = v
+	st.reloadToViper()
+}
+
+// MinStatusCharsFlag returns the flag name for the 'MinStatusChars' field
+func MinStatusCharsFlag() string { return "min-status-chars" }
+
+// GetMinStatusChars safely fetches the value for global configuration 'MinStatusChars' field
+func GetMinStatusChars() int { return global.GetMinStatusChars() }
+
+// SetMinStatusChars safely sets the value for global configuration 'MinStatusChars' field
+func SetMinStatusChars(v int) { global.SetMinStatusChars(v) }
+
+// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesPollMaxOptions
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollMaxOptions = v
+	st.reloadToViper()
+}
+
+// StatusesPollMaxOptionsFlag returns the flag name for the 'StatusesPollMaxOptions' field
+func StatusesPollMaxOptionsFlag() string { return "statuses-poll-max-options" }
+
+// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
+func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+
+// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
+func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+
+// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesPollOptionMaxChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollOptionMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesPollOptionMaxCharsFlag returns the flag name for the 'StatusesPollOptionMaxChars' field
+func StatusesPollOptionMaxCharsFlag() string { return "statuses-poll-option-max-chars" }
+
+// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
+func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+
+// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
+func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+
+// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesMediaMaxFiles
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMediaMaxFiles = v
+	st.reloadToViper()
+}
+
+// StatusesMediaMaxFilesFlag returns the flag name for the 'StatusesMediaMaxFiles' field
+func StatusesMediaMaxFilesFlag() string { return "statuses-media-max-files" }
+
+// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
+func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+
+// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
+func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+
+// GetStatusesRetentionDeletesPerHour safely fetches the Configuration value for state's 'StatusesRetentionDeletesPerHour' field
+func (st *ConfigState) GetStatusesRetentionDeletesPerHour() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesRetentionDeletesPerHour
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesRetentionDeletesPerHour safely sets the Configuration value for state's 'StatusesRetentionDeletesPerHour' field
+func (st *ConfigState) SetStatusesRetentionDeletesPerHour(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesRetentionDeletesPerHour = v
+	st.reloadToViper()
+}
+
+// StatusesRetentionDeletesPerHourFlag returns the flag name for the 'StatusesRetentionDeletesPerHour' field
+func StatusesRetentionDeletesPerHourFlag() string { return "statuses-retention-deletes-per-hour" }
+
+// GetStatusesRetentionDeletesPerHour safely fetches the value for global configuration 'StatusesRetentionDeletesPerHour' field
+func GetStatusesRetentionDeletesPerHour() int { return global.GetStatusesRetentionDeletesPerHour() }
+
+// SetStatusesRetentionDeletesPerHour safely sets the value for global configuration 'StatusesRetentionDeletesPerHour' field
+func SetStatusesRetentionDeletesPerHour(v int) { global.SetStatusesRetentionDeletesPerHour(v) }
+
+// GetDetectStatusLanguage safely fetches the Configuration value for state's 'DetectStatusLanguage' field
+func (st *ConfigState) GetDetectStatusLanguage() (v bool) {
+	st.mutex.RLock()
+	v = st.config.DetectStatusLanguage
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDetectStatusLanguage safely sets the Configuration value for state's 'DetectStatusLanguage' field
+func (st *ConfigState) SetDetectStatusLanguage(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DetectStatusLanguage = v
+	st.reloadToViper()
+}
+
+// DetectStatusLanguageFlag returns the flag name for the 'DetectStatusLanguage' field
+func DetectStatusLanguageFlag() string { return "detect-status-language" }
+
+// GetDetectStatusLanguage safely fetches the value for global configuration 'DetectStatusLanguage' field
+func GetDetectStatusLanguage() bool { return global.GetDetectStatusLanguage() }
+
+// SetDetectStatusLanguage safely sets the value for global configuration 'DetectStatusLanguage' field
+func SetDetectStatusLanguage(v bool) { global.SetDetectStatusLanguage(v) }
+
+// GetDetectStatusLanguages safely fetches the Configuration value for state's 'DetectStatusLanguages' field
+func (st *ConfigState) GetDetectStatusLanguages() (v []string) {
+	st.mutex.RLock()
+	v = st.config.DetectStatusLanguages
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDetectStatusLanguages safely sets the Configuration value for state's 'DetectStatusLanguages' field
+func (st *ConfigState) SetDetectStatusLanguages(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DetectStatusLanguages = v
+	st.reloadToViper()
+}
+
+// DetectStatusLanguagesFlag returns the flag name for the 'DetectStatusLanguages' field
+func DetectStatusLanguagesFlag() string { return "detect-status-languages" }
+
+// GetDetectStatusLanguages safely fetches the value for global configuration 'DetectStatusLanguages' field
+func GetDetectStatusLanguages() []string { return global.GetDetectStatusLanguages() }
+
+// SetDetectStatusLanguages safely sets the value for global configuration 'DetectStatusLanguages' field
+func SetDetectStatusLanguages(v []string) { global.SetDetectStatusLanguages(v) }
+
+// GetStatusContextMaxDepthAncestors safely fetches the Configuration value for state's 'StatusContextMaxDepthAncestors' field
+func (st *ConfigState) GetStatusContextMaxDepthAncestors() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusContextMaxDepthAncestors
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusContextMaxDepthAncestors safely sets the Configuration value for state's 'StatusContextMaxDepthAncestors' field
+func (st *ConfigState) SetStatusContextMaxDepthAncestors(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusContextMaxDepthAncestors = v
+	st.reloadToViper()
+}
+
+// StatusContextMaxDepthAncestorsFlag returns the flag name for the 'StatusContextMaxDepthAncestors' field
+func StatusContextMaxDepthAncestorsFlag() string { return "status-context-max-depth-ancestors" }
+
+// GetStatusContextMaxDepthAncestors safely fetches the value for global configuration 'StatusContextMaxDepthAncestors' field
+func GetStatusContextMaxDepthAncestors() int { return global.GetStatusContextMaxDepthAncestors() }
+
+// SetStatusContextMaxDepthAncestors safely sets the value for global configuration 'StatusContextMaxDepthAncestors' field
+func SetStatusContextMaxDepthAncestors(v int) { global.SetStatusContextMaxDepthAncestors(v) }
+
+// GetStatusContextMaxDepthDescendants safely fetches the Configuration value for state's 'StatusContextMaxDepthDescendants' field
+func (st *ConfigState) GetStatusContextMaxDepthDescendants() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusContextMaxDepthDescendants
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusContextMaxDepthDescendants safely sets the Configuration value for state's 'StatusContextMaxDepthDescendants' field
+func (st *ConfigState) SetStatusContextMaxDepthDescendants(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusContextMaxDepthDescendants = v
+	st.reloadToViper()
+}
+
+// StatusContextMaxDepthDescendantsFlag returns the flag name for the 'StatusContextMaxDepthDescendants' field
+func StatusContextMaxDepthDescendantsFlag() string { return "status-context-max-depth-descendants" }
+
+// GetStatusContextMaxDepthDescendants safely fetches the value for global configuration 'StatusContextMaxDepthDescendants' field
+func GetStatusContextMaxDepthDescendants() int { return global.GetStatusContextMaxDepthDescendants() }
+
+// SetStatusContextMaxDepthDescendants safely sets the value for global configuration 'StatusContextMaxDepthDescendants' field
+func SetStatusContextMaxDepthDescendants(v int) { global.SetStatusContextMaxDepthDescendants(v) }
+
+// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEnabled = v
+	st.reloadToViper()
+}
+
+// LetsEncryptEnabledFlag returns the flag name for the 'LetsEncryptEnabled' field
+func LetsEncryptEnabledFlag() string { return "letsencrypt-enabled" }
+
+// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
+func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+
+// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
+func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+
+// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) GetLetsEncryptPort() (v int) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptPort
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) SetLetsEncryptPort(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptPort = v
+	st.reloadToViper()
+}
+
+// LetsEncryptPortFlag returns the flag name for the 'LetsEncryptPort' field
+func LetsEncryptPortFlag() string { return "letsencrypt-port" }
+
+// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
+func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+
+// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
+func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+
+// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptCertDir
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptCertDir = v
+	st.reloadToViper()
+}
+
+// LetsEncryptCertDirFlag returns the flag name for the 'LetsEncryptCertDir' field
+func LetsEncryptCertDirFlag() string { return "letsencrypt-cert-dir" }
+
+// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
+func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+
+// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
+func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+
+// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptEmailAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEmailAddress = v
+	st.reloadToViper()
+}
+
+// LetsEncryptEmailAddressFlag returns the flag name for the 'LetsEncryptEmailAddress' field
+func LetsEncryptEmailAddressFlag() string { return "letsencrypt-email-address" }
+
+// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
+func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+
+// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
+func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+
+// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) GetTLSCertificateChain() (v string) {
+	st.mutex.RLock()
+	v = st.config.TLSCertificateChain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) SetTLSCertificateChain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TLSCertificateChain = v
+	st.reloadToViper()
+}
+
+// TLSCertificateChainFlag returns the flag name for the 'TLSCertificateChain' field
+func TLSCertificateChainFlag() string { return "tls-certificate-chain" }
+
+// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
+func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+
+// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
+func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+
+// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) GetTLSCertificateKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.TLSCertificateKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) SetTLSCertificateKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
 	st.config.TLSCertificateKey = v
@@ -2250,180 +2950,355 @@ func GetSMTPPort() int { return global.GetSMTPPort() }
 // SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
 func SetSMTPPort(v int) { global.SetSMTPPort(v) }
 
-// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) GetSMTPUsername() (v string) {
+// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) GetSMTPUsername() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPUsername
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) SetSMTPUsername(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPUsername = v
+	st.reloadToViper()
+}
+
+// SMTPUsernameFlag returns the flag name for the 'SMTPUsername' field
+func SMTPUsernameFlag() string { return "smtp-username" }
+
+// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
+func GetSMTPUsername() string { return global.GetSMTPUsername() }
+
+// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
+func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
+
+// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) GetSMTPPassword() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPPassword
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) SetSMTPPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPPassword = v
+	st.reloadToViper()
+}
+
+// SMTPPasswordFlag returns the flag name for the 'SMTPPassword' field
+func SMTPPasswordFlag() string { return "smtp-password" }
+
+// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
+func GetSMTPPassword() string { return global.GetSMTPPassword() }
+
+// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
+func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
+
+// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) GetSMTPFrom() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPFrom
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) SetSMTPFrom(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPFrom = v
+	st.reloadToViper()
+}
+
+// SMTPFromFlag returns the flag name for the 'SMTPFrom' field
+func SMTPFromFlag() string { return "smtp-from" }
+
+// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
+func GetSMTPFrom() string { return global.GetSMTPFrom() }
+
+// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
+func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
+
+// GetSMTPDiscloseRecipients safely fetches the Configuration value for state's 'SMTPDiscloseRecipients' field
+func (st *ConfigState) GetSMTPDiscloseRecipients() (v bool) {
+	st.mutex.RLock()
+	v = st.config.SMTPDiscloseRecipients
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPDiscloseRecipients safely sets the Configuration value for state's 'SMTPDiscloseRecipients' field
+func (st *ConfigState) SetSMTPDiscloseRecipients(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPDiscloseRecipients = v
+	st.reloadToViper()
+}
+
+// SMTPDiscloseRecipientsFlag returns the flag name for the 'SMTPDiscloseRecipients' field
+func SMTPDiscloseRecipientsFlag() string { return "smtp-disclose-recipients" }
+
+// GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
+func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
+
+// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
+func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
+
+// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) GetSyslogEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.SyslogEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) SetSyslogEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SyslogEnabled = v
+	st.reloadToViper()
+}
+
+// SyslogEnabledFlag returns the flag name for the 'SyslogEnabled' field
+func SyslogEnabledFlag() string { return "syslog-enabled" }
+
+// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
+func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
+
+// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
+func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
+
+// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) GetSyslogProtocol() (v string) {
+	st.mutex.RLock()
+	v = st.config.SyslogProtocol
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) SetSyslogProtocol(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SyslogProtocol = v
+	st.reloadToViper()
+}
+
+// SyslogProtocolFlag returns the flag name for the 'SyslogProtocol' field
+func SyslogProtocolFlag() string { return "syslog-protocol" }
+
+// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
+func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
+
+// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
+func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
+
+// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) GetSyslogAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.SyslogAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) SetSyslogAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SyslogAddress = v
+	st.reloadToViper()
+}
+
+// SyslogAddressFlag returns the flag name for the 'SyslogAddress' field
+func SyslogAddressFlag() string { return "syslog-address" }
+
+// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
+func GetSyslogAddress() string { return global.GetSyslogAddress() }
+
+// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
+func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
+
+// GetFederationDeliveryBackend safely fetches the Configuration value for state's 'FederationDeliveryBackend' field
+func (st *ConfigState) GetFederationDeliveryBackend() (v string) {
 	st.mutex.RLock()
-	v = st.config.SMTPUsername
+	v = st.config.FederationDeliveryBackend
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) SetSMTPUsername(v string) {
+// SetFederationDeliveryBackend safely sets the Configuration value for state's 'FederationDeliveryBackend' field
+func (st *ConfigState) SetFederationDeliveryBackend(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPUsername = v
+	st.config.FederationDeliveryBackend = v
 	st.reloadToViper()
 }
 
-// SMTPUsernameFlag returns the flag name for the 'SMTPUsername' field
-func SMTPUsernameFlag() string { return "smtp-username" }
+// FederationDeliveryBackendFlag returns the flag name for the 'FederationDeliveryBackend' field
+func FederationDeliveryBackendFlag() string { return "federation-delivery-backend" }
 
-// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
-func GetSMTPUsername() string { return global.GetSMTPUsername() }
+// GetFederationDeliveryBackend safely fetches the value for global configuration 'FederationDeliveryBackend' field
+func GetFederationDeliveryBackend() string { return global.GetFederationDeliveryBackend() }
 
-// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
-func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
+// SetFederationDeliveryBackend safely sets the value for global configuration 'FederationDeliveryBackend' field
+func SetFederationDeliveryBackend(v string) { global.SetFederationDeliveryBackend(v) }
 
-// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) GetSMTPPassword() (v string) {
+// GetFederationDeliveryRedisAddress safely fetches the Configuration value for state's 'FederationDeliveryRedisAddress' field
+func (st *ConfigState) GetFederationDeliveryRedisAddress() (v string) {
 	st.mutex.RLock()
-	v = st.config.SMTPPassword
+	v = st.config.FederationDeliveryRedisAddress
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) SetSMTPPassword(v string) {
+// SetFederationDeliveryRedisAddress safely sets the Configuration value for state's 'FederationDeliveryRedisAddress' field
+func (st *ConfigState) SetFederationDeliveryRedisAddress(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPassword = v
+	st.config.FederationDeliveryRedisAddress = v
 	st.reloadToViper()
 }
 
-// SMTPPasswordFlag returns the flag name for the 'SMTPPassword' field
-func SMTPPasswordFlag() string { return "smtp-password" }
+// FederationDeliveryRedisAddressFlag returns the flag name for the 'FederationDeliveryRedisAddress' field
+func FederationDeliveryRedisAddressFlag() string { return "federation-delivery-redis-address" }
 
-// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
-func GetSMTPPassword() string { return global.GetSMTPPassword() }
+// GetFederationDeliveryRedisAddress safely fetches the value for global configuration 'FederationDeliveryRedisAddress' field
+func GetFederationDeliveryRedisAddress() string { return global.GetFederationDeliveryRedisAddress() }
 
-// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
-func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
+// SetFederationDeliveryRedisAddress safely sets the value for global configuration 'FederationDeliveryRedisAddress' field
+func SetFederationDeliveryRedisAddress(v string) { global.SetFederationDeliveryRedisAddress(v) }
 
-// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) GetSMTPFrom() (v string) {
+// GetFederationDeliveryRedisPassword safely fetches the Configuration value for state's 'FederationDeliveryRedisPassword' field
+func (st *ConfigState) GetFederationDeliveryRedisPassword() (v string) {
 	st.mutex.RLock()
-	v = st.config.SMTPFrom
+	v = st.config.FederationDeliveryRedisPassword
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) SetSMTPFrom(v string) {
+// SetFederationDeliveryRedisPassword safely sets the Configuration value for state's 'FederationDeliveryRedisPassword' field
+func (st *ConfigState) SetFederationDeliveryRedisPassword(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPFrom = v
+	st.config.FederationDeliveryRedisPassword = v
 	st.reloadToViper()
 }
 
-// SMTPFromFlag returns the flag name for the 'SMTPFrom' field
-func SMTPFromFlag() string { return "smtp-from" }
+// FederationDeliveryRedisPasswordFlag returns the flag name for the 'FederationDeliveryRedisPassword' field
+func FederationDeliveryRedisPasswordFlag() string { return "federation-delivery-redis-password" }
 
-// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
-func GetSMTPFrom() string { return global.GetSMTPFrom() }
+// GetFederationDeliveryRedisPassword safely fetches the value for global configuration 'FederationDeliveryRedisPassword' field
+func GetFederationDeliveryRedisPassword() string { return global.GetFederationDeliveryRedisPassword() }
 
-// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
-func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
+// SetFederationDeliveryRedisPassword safely sets the value for global configuration 'FederationDeliveryRedisPassword' field
+func SetFederationDeliveryRedisPassword(v string) { global.SetFederationDeliveryRedisPassword(v) }
 
-// GetSMTPDiscloseRecipients safely fetches the Configuration value for state's 'SMTPDiscloseRecipients' field
-func (st *ConfigState) GetSMTPDiscloseRecipients() (v bool) {
+// GetFederationDeliveryRedisKey safely fetches the Configuration value for state's 'FederationDeliveryRedisKey' field
+func (st *ConfigState) GetFederationDeliveryRedisKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.SMTPDiscloseRecipients
+	v = st.config.FederationDeliveryRedisKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPDiscloseRecipients safely sets the Configuration value for state's 'SMTPDiscloseRecipients' field
-func (st *ConfigState) SetSMTPDiscloseRecipients(v bool) {
+// SetFederationDeliveryRedisKey safely sets the Configuration value for state's 'FederationDeliveryRedisKey' field
+func (st *ConfigState) SetFederationDeliveryRedisKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPDiscloseRecipients = v
+	st.config.FederationDeliveryRedisKey = v
 	st.reloadToViper()
 }
 
-// SMTPDiscloseRecipientsFlag returns the flag name for the 'SMTPDiscloseRecipients' field
-func SMTPDiscloseRecipientsFlag() string { return "smtp-disclose-recipients" }
+// FederationDeliveryRedisKeyFlag returns the flag name for the 'FederationDeliveryRedisKey' field
+func FederationDeliveryRedisKeyFlag() string { return "federation-delivery-redis-key" }
 
-// GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
-func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
+// GetFederationDeliveryRedisKey safely fetches the value for global configuration 'FederationDeliveryRedisKey' field
+func GetFederationDeliveryRedisKey() string { return global.GetFederationDeliveryRedisKey() }
 
-// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
-func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
+// SetFederationDeliveryRedisKey safely sets the value for global configuration 'FederationDeliveryRedisKey' field
+func SetFederationDeliveryRedisKey(v string) { global.SetFederationDeliveryRedisKey(v) }
 
-// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) GetSyslogEnabled() (v bool) {
+// GetFederationBlockListURLs safely fetches the Configuration value for state's 'FederationBlockListURLs' field
+func (st *ConfigState) GetFederationBlockListURLs() (v []string) {
 	st.mutex.RLock()
-	v = st.config.SyslogEnabled
+	v = st.config.FederationBlockListURLs
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) SetSyslogEnabled(v bool) {
+// SetFederationBlockListURLs safely sets the Configuration value for state's 'FederationBlockListURLs' field
+func (st *ConfigState) SetFederationBlockListURLs(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogEnabled = v
+	st.config.FederationBlockListURLs = v
 	st.reloadToViper()
 }
 
-// SyslogEnabledFlag returns the flag name for the 'SyslogEnabled' field
-func SyslogEnabledFlag() string { return "syslog-enabled" }
+// FederationBlockListURLsFlag returns the flag name for the 'FederationBlockListURLs' field
+func FederationBlockListURLsFlag() string { return "federation-block-list-urls" }
 
-// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
-func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
+// GetFederationBlockListURLs safely fetches the value for global configuration 'FederationBlockListURLs' field
+func GetFederationBlockListURLs() []string { return global.GetFederationBlockListURLs() }
 
-// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
-func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
+// SetFederationBlockListURLs safely sets the value for global configuration 'FederationBlockListURLs' field
+func SetFederationBlockListURLs(v []string) { global.SetFederationBlockListURLs(v) }
 
-// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) GetSyslogProtocol() (v string) {
+// GetFederationAuditLog safely fetches the Configuration value for state's 'FederationAuditLog' field
+func (st *ConfigState) GetFederationAuditLog() (v bool) {
 	st.mutex.RLock()
-	v = st.config.SyslogProtocol
+	v = st.config.FederationAuditLog
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) SetSyslogProtocol(v string) {
+// SetFederationAuditLog safely sets the Configuration value for state's 'FederationAuditLog' field
+func (st *ConfigState) SetFederationAuditLog(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogProtocol = v
+	st.config.FederationAuditLog = v
 	st.reloadToViper()
 }
 
-// SyslogProtocolFlag returns the flag name for the 'SyslogProtocol' field
-func SyslogProtocolFlag() string { return "syslog-protocol" }
+// FederationAuditLogFlag returns the flag name for the 'FederationAuditLog' field
+func FederationAuditLogFlag() string { return "federation-audit-log" }
 
-// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
-func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
+// GetFederationAuditLog safely fetches the value for global configuration 'FederationAuditLog' field
+func GetFederationAuditLog() bool { return global.GetFederationAuditLog() }
 
-// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
-func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
+// SetFederationAuditLog safely sets the value for global configuration 'FederationAuditLog' field
+func SetFederationAuditLog(v bool) { global.SetFederationAuditLog(v) }
 
-// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) GetSyslogAddress() (v string) {
+// GetFederationAuditLogMaxRows safely fetches the Configuration value for state's 'FederationAuditLogMaxRows' field
+func (st *ConfigState) GetFederationAuditLogMaxRows() (v int) {
 	st.mutex.RLock()
-	v = st.config.SyslogAddress
+	v = st.config.FederationAuditLogMaxRows
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) SetSyslogAddress(v string) {
+// SetFederationAuditLogMaxRows safely sets the Configuration value for state's 'FederationAuditLogMaxRows' field
+func (st *ConfigState) SetFederationAuditLogMaxRows(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogAddress = v
+	st.config.FederationAuditLogMaxRows = v
 	st.reloadToViper()
 }
 
-// SyslogAddressFlag returns the flag name for the 'SyslogAddress' field
-func SyslogAddressFlag() string { return "syslog-address" }
+// FederationAuditLogMaxRowsFlag returns the flag name for the 'FederationAuditLogMaxRows' field
+func FederationAuditLogMaxRowsFlag() string { return "federation-audit-log-max-rows" }
 
-// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
-func GetSyslogAddress() string { return global.GetSyslogAddress() }
+// GetFederationAuditLogMaxRows safely fetches the value for global configuration 'FederationAuditLogMaxRows' field
+func GetFederationAuditLogMaxRows() int { return global.GetFederationAuditLogMaxRows() }
 
-// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
-func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
+// SetFederationAuditLogMaxRows safely sets the value for global configuration 'FederationAuditLogMaxRows' field
+func SetFederationAuditLogMaxRows(v int) { global.SetFederationAuditLogMaxRows(v) }
 
 // GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
 func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
@@ -2575,6 +3450,110 @@ func GetAdvancedSenderMultiplier() int { return global.GetAdvancedSenderMultipli
 // SetAdvancedSenderMultiplier safely sets the value for global configuration 'AdvancedSenderMultiplier' field
 func SetAdvancedSenderMultiplier(v int) { global.SetAdvancedSenderMultiplier(v) }
 
+// GetAdvancedClientWorkerMultiplier safely fetches the Configuration value for state's 'AdvancedClientWorkerMultiplier' field
+func (st *ConfigState) GetAdvancedClientWorkerMultiplier() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdvancedClientWorkerMultiplier
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedClientWorkerMultiplier safely sets the Configuration value for state's 'AdvancedClientWorkerMultiplier' field
+func (st *ConfigState) SetAdvancedClientWorkerMultiplier(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedClientWorkerMultiplier = v
+	st.reloadToViper()
+}
+
+// AdvancedClientWorkerMultiplierFlag returns the flag name for the 'AdvancedClientWorkerMultiplier' field
+func AdvancedClientWorkerMultiplierFlag() string { return "advanced-client-worker-multiplier" }
+
+// GetAdvancedClientWorkerMultiplier safely fetches the value for global configuration 'AdvancedClientWorkerMultiplier' field
+func GetAdvancedClientWorkerMultiplier() int { return global.GetAdvancedClientWorkerMultiplier() }
+
+// SetAdvancedClientWorkerMultiplier safely sets the value for global configuration 'AdvancedClientWorkerMultiplier' field
+func SetAdvancedClientWorkerMultiplier(v int) { global.SetAdvancedClientWorkerMultiplier(v) }
+
+// GetAdvancedFederatorWorkerMultiplier safely fetches the Configuration value for state's 'AdvancedFederatorWorkerMultiplier' field
+func (st *ConfigState) GetAdvancedFederatorWorkerMultiplier() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdvancedFederatorWorkerMultiplier
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedFederatorWorkerMultiplier safely sets the Configuration value for state's 'AdvancedFederatorWorkerMultiplier' field
+func (st *ConfigState) SetAdvancedFederatorWorkerMultiplier(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedFederatorWorkerMultiplier = v
+	st.reloadToViper()
+}
+
+// AdvancedFederatorWorkerMultiplierFlag returns the flag name for the 'AdvancedFederatorWorkerMultiplier' field
+func AdvancedFederatorWorkerMultiplierFlag() string { return "advanced-federator-worker-multiplier" }
+
+// GetAdvancedFederatorWorkerMultiplier safely fetches the value for global configuration 'AdvancedFederatorWorkerMultiplier' field
+func GetAdvancedFederatorWorkerMultiplier() int { return global.GetAdvancedFederatorWorkerMultiplier() }
+
+// SetAdvancedFederatorWorkerMultiplier safely sets the value for global configuration 'AdvancedFederatorWorkerMultiplier' field
+func SetAdvancedFederatorWorkerMultiplier(v int) { global.SetAdvancedFederatorWorkerMultiplier(v) }
+
+// GetAdvancedDereferenceWorkerMultiplier safely fetches the Configuration value for state's 'AdvancedDereferenceWorkerMultiplier' field
+func (st *ConfigState) GetAdvancedDereferenceWorkerMultiplier() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdvancedDereferenceWorkerMultiplier
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedDereferenceWorkerMultiplier safely sets the Configuration value for state's 'AdvancedDereferenceWorkerMultiplier' field
+func (st *ConfigState) SetAdvancedDereferenceWorkerMultiplier(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedDereferenceWorkerMultiplier = v
+	st.reloadToViper()
+}
+
+// AdvancedDereferenceWorkerMultiplierFlag returns the flag name for the 'AdvancedDereferenceWorkerMultiplier' field
+func AdvancedDereferenceWorkerMultiplierFlag() string {
+	return "advanced-dereference-worker-multiplier"
+}
+
+// GetAdvancedDereferenceWorkerMultiplier safely fetches the value for global configuration 'AdvancedDereferenceWorkerMultiplier' field
+func GetAdvancedDereferenceWorkerMultiplier() int {
+	return global.GetAdvancedDereferenceWorkerMultiplier()
+}
+
+// SetAdvancedDereferenceWorkerMultiplier safely sets the value for global configuration 'AdvancedDereferenceWorkerMultiplier' field
+func SetAdvancedDereferenceWorkerMultiplier(v int) { global.SetAdvancedDereferenceWorkerMultiplier(v) }
+
+// GetAdvancedMediaWorkerMultiplier safely fetches the Configuration value for state's 'AdvancedMediaWorkerMultiplier' field
+func (st *ConfigState) GetAdvancedMediaWorkerMultiplier() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdvancedMediaWorkerMultiplier
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedMediaWorkerMultiplier safely sets the Configuration value for state's 'AdvancedMediaWorkerMultiplier' field
+func (st *ConfigState) SetAdvancedMediaWorkerMultiplier(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedMediaWorkerMultiplier = v
+	st.reloadToViper()
+}
+
+// AdvancedMediaWorkerMultiplierFlag returns the flag name for the 'AdvancedMediaWorkerMultiplier' field
+func AdvancedMediaWorkerMultiplierFlag() string { return "advanced-media-worker-multiplier" }
+
+// GetAdvancedMediaWorkerMultiplier safely fetches the value for global configuration 'AdvancedMediaWorkerMultiplier' field
+func GetAdvancedMediaWorkerMultiplier() int { return global.GetAdvancedMediaWorkerMultiplier() }
+
+// SetAdvancedMediaWorkerMultiplier safely sets the value for global configuration 'AdvancedMediaWorkerMultiplier' field
+func SetAdvancedMediaWorkerMultiplier(v int) { global.SetAdvancedMediaWorkerMultiplier(v) }
+
 // GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'AdvancedCSPExtraURIs' field
 func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
 	st.mutex.RLock()
@@ -3200,6 +4179,31 @@ func GetCacheFollowRequestIDsMemRatio() float64 { return global.GetCacheFollowRe
 // SetCacheFollowRequestIDsMemRatio safely sets the value for global configuration 'Cache.FollowRequestIDsMemRatio' field
 func SetCacheFollowRequestIDsMemRatio(v float64) { global.SetCacheFollowRequestIDsMemRatio(v) }
 
+// GetCacheFollowSuggestionsMemRatio safely fetches the Configuration value for state's 'Cache.FollowSuggestionsMemRatio' field
+func (st *ConfigState) GetCacheFollowSuggestionsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FollowSuggestionsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFollowSuggestionsMemRatio safely sets the Configuration value for state's 'Cache.FollowSuggestionsMemRatio' field
+func (st *ConfigState) SetCacheFollowSuggestionsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FollowSuggestionsMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheFollowSuggestionsMemRatioFlag returns the flag name for the 'Cache.FollowSuggestionsMemRatio' field
+func CacheFollowSuggestionsMemRatioFlag() string { return "cache-follow-suggestions-mem-ratio" }
+
+// GetCacheFollowSuggestionsMemRatio safely fetches the value for global configuration 'Cache.FollowSuggestionsMemRatio' field
+func GetCacheFollowSuggestionsMemRatio() float64 { return global.GetCacheFollowSuggestionsMemRatio() }
+
+// SetCacheFollowSuggestionsMemRatio safely sets the value for global configuration 'Cache.FollowSuggestionsMemRatio' field
+func SetCacheFollowSuggestionsMemRatio(v float64) { global.SetCacheFollowSuggestionsMemRatio(v) }
+
 // GetCacheInReplyToIDsMemRatio safely fetches the Configuration value for state's 'Cache.InReplyToIDsMemRatio' field
 func (st *ConfigState) GetCacheInReplyToIDsMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3250,6 +4254,31 @@ func GetCacheInstanceMemRatio() float64 { return global.GetCacheInstanceMemRatio
 // SetCacheInstanceMemRatio safely sets the value for global configuration 'Cache.InstanceMemRatio' field
 func SetCacheInstanceMemRatio(v float64) { global.SetCacheInstanceMemRatio(v) }
 
+// GetCacheInstanceSettingsMemRatio safely fetches the Configuration value for state's 'Cache.InstanceSettingsMemRatio' field
+func (st *ConfigState) GetCacheInstanceSettingsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.InstanceSettingsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInstanceSettingsMemRatio safely sets the Configuration value for state's 'Cache.InstanceSettingsMemRatio' field
+func (st *ConfigState) SetCacheInstanceSettingsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InstanceSettingsMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheInstanceSettingsMemRatioFlag returns the flag name for the 'Cache.InstanceSettingsMemRatio' field
+func CacheInstanceSettingsMemRatioFlag() string { return "cache-instance-settings-mem-ratio" }
+
+// GetCacheInstanceSettingsMemRatio safely fetches the value for global configuration 'Cache.InstanceSettingsMemRatio' field
+func GetCacheInstanceSettingsMemRatio() float64 { return global.GetCacheInstanceSettingsMemRatio() }
+
+// SetCacheInstanceSettingsMemRatio safely sets the value for global configuration 'Cache.InstanceSettingsMemRatio' field
+func SetCacheInstanceSettingsMemRatio(v float64) { global.SetCacheInstanceSettingsMemRatio(v) }
+
 // GetCacheListMemRatio safely fetches the Configuration value for state's 'Cache.ListMemRatio' field
 func (st *ConfigState) GetCacheListMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3875,6 +4904,131 @@ func GetCacheVisibilityMemRatio() float64 { return global.GetCacheVisibilityMemR
 // SetCacheVisibilityMemRatio safely sets the value for global configuration 'Cache.VisibilityMemRatio' field
 func SetCacheVisibilityMemRatio(v float64) { global.SetCacheVisibilityMemRatio(v) }
 
+// GetCacheShards safely fetches the Configuration value for state's 'Cache.Shards' field
+func (st *ConfigState) GetCacheShards() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.Shards
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheShards safely sets the Configuration value for state's 'Cache.Shards' field
+func (st *ConfigState) SetCacheShards(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.Shards = v
+	st.reloadToViper()
+}
+
+// CacheShardsFlag returns the flag name for the 'Cache.Shards' field
+func CacheShardsFlag() string { return "cache-shards" }
+
+// GetCacheShards safely fetches the value for global configuration 'Cache.Shards' field
+func GetCacheShards() int { return global.GetCacheShards() }
+
+// SetCacheShards safely sets the value for global configuration 'Cache.Shards' field
+func SetCacheShards(v int) { global.SetCacheShards(v) }
+
+// GetCacheInvalidationBackend safely fetches the Configuration value for state's 'Cache.InvalidationBackend' field
+func (st *ConfigState) GetCacheInvalidationBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.InvalidationBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInvalidationBackend safely sets the Configuration value for state's 'Cache.InvalidationBackend' field
+func (st *ConfigState) SetCacheInvalidationBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InvalidationBackend = v
+	st.reloadToViper()
+}
+
+// CacheInvalidationBackendFlag returns the flag name for the 'Cache.InvalidationBackend' field
+func CacheInvalidationBackendFlag() string { return "cache-invalidation-backend" }
+
+// GetCacheInvalidationBackend safely fetches the value for global configuration 'Cache.InvalidationBackend' field
+func GetCacheInvalidationBackend() string { return global.GetCacheInvalidationBackend() }
+
+// SetCacheInvalidationBackend safely sets the value for global configuration 'Cache.InvalidationBackend' field
+func SetCacheInvalidationBackend(v string) { global.SetCacheInvalidationBackend(v) }
+
+// GetCacheInvalidationRedisAddress safely fetches the Configuration value for state's 'Cache.InvalidationRedisAddress' field
+func (st *ConfigState) GetCacheInvalidationRedisAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.InvalidationRedisAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInvalidationRedisAddress safely sets the Configuration value for state's 'Cache.InvalidationRedisAddress' field
+func (st *ConfigState) SetCacheInvalidationRedisAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InvalidationRedisAddress = v
+	st.reloadToViper()
+}
+
+// CacheInvalidationRedisAddressFlag returns the flag name for the 'Cache.InvalidationRedisAddress' field
+func CacheInvalidationRedisAddressFlag() string { return "cache-invalidation-redis-address" }
+
+// GetCacheInvalidationRedisAddress safely fetches the value for global configuration 'Cache.InvalidationRedisAddress' field
+func GetCacheInvalidationRedisAddress() string { return global.GetCacheInvalidationRedisAddress() }
+
+// SetCacheInvalidationRedisAddress safely sets the value for global configuration 'Cache.InvalidationRedisAddress' field
+func SetCacheInvalidationRedisAddress(v string) { global.SetCacheInvalidationRedisAddress(v) }
+
+// GetCacheInvalidationRedisPassword safely fetches the Configuration value for state's 'Cache.InvalidationRedisPassword' field
+func (st *ConfigState) GetCacheInvalidationRedisPassword() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.InvalidationRedisPassword
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInvalidationRedisPassword safely sets the Configuration value for state's 'Cache.InvalidationRedisPassword' field
+func (st *ConfigState) SetCacheInvalidationRedisPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InvalidationRedisPassword = v
+	st.reloadToViper()
+}
+
+// CacheInvalidationRedisPasswordFlag returns the flag name for the 'Cache.InvalidationRedisPassword' field
+func CacheInvalidationRedisPasswordFlag() string { return "cache-invalidation-redis-password" }
+
+// GetCacheInvalidationRedisPassword safely fetches the value for global configuration 'Cache.InvalidationRedisPassword' field
+func GetCacheInvalidationRedisPassword() string { return global.GetCacheInvalidationRedisPassword() }
+
+// SetCacheInvalidationRedisPassword safely sets the value for global configuration 'Cache.InvalidationRedisPassword' field
+func SetCacheInvalidationRedisPassword(v string) { global.SetCacheInvalidationRedisPassword(v) }
+
+// GetCacheInvalidationRedisChannel safely fetches the Configuration value for state's 'Cache.InvalidationRedisChannel' field
+func (st *ConfigState) GetCacheInvalidationRedisChannel() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.InvalidationRedisChannel
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInvalidationRedisChannel safely sets the Configuration value for state's 'Cache.InvalidationRedisChannel' field
+func (st *ConfigState) SetCacheInvalidationRedisChannel(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InvalidationRedisChannel = v
+	st.reloadToViper()
+}
+
+// CacheInvalidationRedisChannelFlag returns the flag name for the 'Cache.InvalidationRedisChannel' field
+func CacheInvalidationRedisChannelFlag() string { return "cache-invalidation-redis-channel" }
+
+// GetCacheInvalidationRedisChannel safely fetches the value for global configuration 'Cache.InvalidationRedisChannel' field
+func GetCacheInvalidationRedisChannel() string { return global.GetCacheInvalidationRedisChannel() }
+
+// SetCacheInvalidationRedisChannel safely sets the value for global configuration 'Cache.InvalidationRedisChannel' field
+func SetCacheInvalidationRedisChannel(v string) { global.SetCacheInvalidationRedisChannel(v) }
+
 // GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
 func (st *ConfigState) GetAdminAccountUsername() (v string) {
 	st.mutex.RLock()
@@ -3950,6 +5104,31 @@ func GetAdminAccountPassword() string { return global.GetAdminAccountPassword()
 // SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
 func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
 
+// GetAdminAccountDomain safely fetches the Configuration value for state's 'AdminAccountDomain' field
+func (st *ConfigState) GetAdminAccountDomain() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountDomain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountDomain safely sets the Configuration value for state's 'AdminAccountDomain' field
+func (st *ConfigState) SetAdminAccountDomain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountDomain = v
+	st.reloadToViper()
+}
+
+// AdminAccountDomainFlag returns the flag name for the 'AdminAccountDomain' field
+func AdminAccountDomainFlag() string { return "domain" }
+
+// GetAdminAccountDomain safely fetches the value for global configuration 'AdminAccountDomain' field
+func GetAdminAccountDomain() string { return global.GetAdminAccountDomain() }
+
+// SetAdminAccountDomain safely sets the value for global configuration 'AdminAccountDomain' field
+func SetAdminAccountDomain(v string) { global.SetAdminAccountDomain(v) }
+
 // GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
 func (st *ConfigState) GetAdminTransPath() (v string) {
 	st.mutex.RLock()
@@ -4000,6 +5179,31 @@ func GetAdminMediaPruneDryRun() bool { return global.GetAdminMediaPruneDryRun()
 // SetAdminMediaPruneDryRun safely sets the value for global configuration 'AdminMediaPruneDryRun' field
 func SetAdminMediaPruneDryRun(v bool) { global.SetAdminMediaPruneDryRun(v) }
 
+// GetAdminMediaPruneReport safely fetches the Configuration value for state's 'AdminMediaPruneReport' field
+func (st *ConfigState) GetAdminMediaPruneReport() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaPruneReport
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaPruneReport safely sets the Configuration value for state's 'AdminMediaPruneReport' field
+func (st *ConfigState) SetAdminMediaPruneReport(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaPruneReport = v
+	st.reloadToViper()
+}
+
+// AdminMediaPruneReportFlag returns the flag name for the 'AdminMediaPruneReport' field
+func AdminMediaPruneReportFlag() string { return "report" }
+
+// GetAdminMediaPruneReport safely fetches the value for global configuration 'AdminMediaPruneReport' field
+func GetAdminMediaPruneReport() string { return global.GetAdminMediaPruneReport() }
+
+// SetAdminMediaPruneReport safely sets the value for global configuration 'AdminMediaPruneReport' field
+func SetAdminMediaPruneReport(v string) { global.SetAdminMediaPruneReport(v) }
+
 // GetAdminMediaListLocalOnly safely fetches the Configuration value for state's 'AdminMediaListLocalOnly' field
 func (st *ConfigState) GetAdminMediaListLocalOnly() (v bool) {
 	st.mutex.RLock()
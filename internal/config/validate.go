@@ -19,6 +19,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -58,6 +59,28 @@ func Validate() error {
 				AccountDomainFlag(), ad, HostFlag(), host,
 			)
 		}
+
+		// Any additional `account-domains` must be
+		// valid domain names, and must not collide
+		// with each other or with `account-domain`.
+		seen := map[string]struct{}{GetAccountDomain(): {}}
+		for _, d := range GetAccountDomains() {
+			if _, ok := seen[d]; ok {
+				errf(
+					"%s contains %s more than once, or it is already set as %s",
+					AccountDomainsFlag(), d, AccountDomainFlag(),
+				)
+				continue
+			}
+			seen[d] = struct{}{}
+
+			if _, ok := dns.IsDomainName(d); !ok || d == "" {
+				errf(
+					"%s entry %s is not a valid domain name",
+					AccountDomainsFlag(), d,
+				)
+			}
+		}
 	}
 
 	// Ensure `protocol` sensibly set.
@@ -98,6 +121,61 @@ func Validate() error {
 		)
 	}
 
+	// `web-default-theme` should be
+	// "system", "light", or "dark".
+	switch theme := GetWebDefaultTheme(); theme {
+	case WebDefaultThemeSystem, WebDefaultThemeLight, WebDefaultThemeDark:
+		// No problem.
+
+	default:
+		errf(
+			"%s must be set to either system, light, or dark, provided value was %s",
+			WebDefaultThemeFlag(), theme,
+		)
+	}
+
+	// `cache-invalidation-backend` should
+	// be "redis", or unset (disabled).
+	switch backend := GetCacheInvalidationBackend(); backend {
+	case CacheInvalidationBackendNone:
+		// No problem.
+
+	case CacheInvalidationBackendRedis:
+		if GetCacheInvalidationRedisAddress() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				CacheInvalidationRedisAddressFlag(), CacheInvalidationBackendFlag(), backend,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be either unset or redis, provided value was %s",
+			CacheInvalidationBackendFlag(), backend,
+		)
+	}
+
+	// `federation-delivery-backend` should
+	// be "redis", or unset (in-memory).
+	switch backend := GetFederationDeliveryBackend(); backend {
+	case FederationDeliveryBackendMemory:
+		// No problem.
+
+	case FederationDeliveryBackendRedis:
+		if GetFederationDeliveryRedisAddress() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				FederationDeliveryRedisAddressFlag(), FederationDeliveryBackendFlag(), backend,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be either unset or redis, provided value was %s",
+			FederationDeliveryBackendFlag(), backend,
+		)
+	}
+
 	// Parse `instance-languages`, and
 	// set enriched version into config.
 	parsedLangs, err := language.InitLangs(GetInstanceLanguages().TagStrs())
@@ -112,12 +190,53 @@ func Validate() error {
 		SetInstanceLanguages(parsedLangs)
 	}
 
+	// `detect-status-languages` must be languages
+	// that detection can choose between.
+	if _, err := language.NewDetector(GetDetectStatusLanguages()); err != nil {
+		errf(
+			"%s could not be used for status language detection: %v",
+			DetectStatusLanguagesFlag(), err,
+		)
+	}
+
+	// `instance-obfuscate-ids` requires a
+	// secret key to obfuscate IDs with.
+	if GetInstanceObfuscateIDs() && GetInstanceObfuscateIDsSecret() == "" {
+		errf(
+			"%s must be set when %s is true",
+			InstanceObfuscateIDsSecretFlag(), InstanceObfuscateIDsFlag(),
+		)
+	}
+
 	// `web-assets-base-dir`.
 	webAssetsBaseDir := GetWebAssetBaseDir()
 	if webAssetsBaseDir == "" {
 		errf("%s must be set", WebAssetBaseDirFlag())
 	}
 
+	// `api-cors-allowed-origins` entries should
+	// be "*" or an http(s) origin, which may
+	// contain at most one wildcard.
+	for _, origin := range GetAPICORSAllowedOrigins() {
+		switch {
+		case origin == "*":
+			// No problem.
+
+		case !strings.HasPrefix(origin, "http://") &&
+			!strings.HasPrefix(origin, "https://"):
+			errf(
+				"%s entry %s must start with http:// or https://",
+				APICORSAllowedOriginsFlag(), origin,
+			)
+
+		case strings.Count(origin, "*") > 1:
+			errf(
+				"%s entry %s must not contain more than one wildcard",
+				APICORSAllowedOriginsFlag(), origin,
+			)
+		}
+	}
+
 	// Custom / LE TLS settings.
 	//
 	// Only one of custom certs or LE can be set,
@@ -73,6 +73,32 @@ func (suite *ConfigValidateTestSuite) TestValidateAccountDomainOK3() {
 	suite.NoError(err)
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateAccountDomainsOK() {
+	testrig.InitTestConfig()
+
+	config.SetHost("gts.example.org")
+	config.SetAccountDomain("example.org")
+	config.SetAccountDomains([]string{"example.net", "example.com"})
+
+	err := config.Validate()
+	suite.NoError(err)
+
+	suite.True(config.IsAccountDomain("example.net"))
+	suite.True(config.IsLocalDomain("gts.example.org"))
+	suite.False(config.IsLocalDomain("example.social"))
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateAccountDomainsCollision() {
+	testrig.InitTestConfig()
+
+	config.SetHost("gts.example.org")
+	config.SetAccountDomain("example.org")
+	config.SetAccountDomains([]string{"example.net", "example.org"})
+
+	err := config.Validate()
+	suite.EqualError(err, "account-domains contains example.org more than once, or it is already set as account-domain")
+}
+
 func (suite *ConfigValidateTestSuite) TestValidateAccountDomainNotSubdomain1() {
 	testrig.InitTestConfig()
 
@@ -140,6 +166,39 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set\nprotocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigAPICORSAllowedOrigins() {
+	testrig.InitTestConfig()
+
+	config.SetAPICORSAllowedOrigins([]string{
+		"https://frontend.example.org",
+		"https://*.example.org",
+	})
+
+	err := config.Validate()
+	suite.NoError(err)
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadAPICORSAllowedOrigins() {
+	testrig.InitTestConfig()
+
+	config.SetAPICORSAllowedOrigins([]string{
+		"frontend.example.org",
+		"https://*.*.example.org",
+	})
+
+	err := config.Validate()
+	suite.EqualError(err, "api-cors-allowed-origins entry frontend.example.org must start with http:// or https://\napi-cors-allowed-origins entry https://*.*.example.org must not contain more than one wildcard")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadWebDefaultTheme() {
+	testrig.InitTestConfig()
+
+	config.SetWebDefaultTheme("sepia")
+
+	err := config.Validate()
+	suite.EqualError(err, "web-default-theme must be set to either system, light, or dark, provided value was sepia")
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
@@ -20,6 +20,7 @@ package db
 import (
 	"context"
 	"net/netip"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
@@ -124,6 +125,13 @@ type Account interface {
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountWebStatuses(ctx context.Context, accountID string, limit int, maxID string) ([]*gtsmodel.Status, error)
 
+	// GetAccountOutboxStatuses returns statuses that should be served in the ActivityPub outbox
+	// of an account. So, only public and unlisted, federated statuses that aren't boosts or replies
+	// to other accounts. Statuses are paged by ID, and always returned sorted by ID descending.
+	//
+	// In the case of no statuses, this function will return db.ErrNoEntries.
+	GetAccountOutboxStatuses(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Status, error)
+
 	// SetAccountHeaderOrAvatar sets the header or avatar for the given accountID to the given media attachment.
 	SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachment *gtsmodel.MediaAttachment, accountID string) error
 
@@ -140,6 +148,24 @@ type Account interface {
 	// Update local account settings.
 	UpdateAccountSettings(ctx context.Context, settings *gtsmodel.AccountSettings, columns ...string) error
 
+	// GetStatusRetentionAccountIDs returns the IDs of all local
+	// accounts that have opted in to status retention.
+	GetStatusRetentionAccountIDs(ctx context.Context) ([]string, error)
+
+	// GetLocalAccountIDs returns IDs of local accounts, arranged by ID
+	// descending, with ID lower than maxID (if set), up to given limit.
+	GetLocalAccountIDs(ctx context.Context, maxID string, limit int) ([]string, error)
+
+	// GetDirectoryAccountIDs returns IDs of discoverable accounts for the
+	// profile directory, excluding suspended, silenced and moved accounts.
+	// If local is true, only local accounts are returned. If newest is true,
+	// accounts are ordered by creation date, else by most recent status.
+	GetDirectoryAccountIDs(ctx context.Context, local bool, newest bool, offset int, limit int) ([]string, error)
+
+	// GetAccountStats returns the stored stats for the given accountID,
+	// without creating or regenerating them if they're missing or stale.
+	GetAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error)
+
 	// PopulateAccountStats either creates account stats for the given
 	// account by performing COUNT(*) database queries, or retrieves
 	// existing stats from the database, and attaches stats to account.
@@ -167,6 +193,31 @@ type Account interface {
 	// specifically), callers should prefer GetAccountStats in 99% of cases.
 	RegenerateAccountStats(ctx context.Context, account *gtsmodel.Account) error
 
+	// CountAccountStats counts fresh stats for the given account
+	// using COUNT(*) queries, like RegenerateAccountStats, but
+	// returns them without storing or attaching them to account.
+	CountAccountStats(ctx context.Context, account *gtsmodel.Account) (*gtsmodel.AccountStats, error)
+
+	// CountAccountStatusesSince counts statuses (not boosts)
+	// created by the given account since the given time.
+	CountAccountStatusesSince(ctx context.Context, accountID string, since time.Time) (int, error)
+
+	// CountAccountFavesReceivedSince counts faves of statuses owned by
+	// the given account, made by other accounts since the given time.
+	CountAccountFavesReceivedSince(ctx context.Context, accountID string, since time.Time) (int, error)
+
+	// CountAccountBoostsReceivedSince counts boosts of statuses owned by
+	// the given account, made by other accounts since the given time.
+	CountAccountBoostsReceivedSince(ctx context.Context, accountID string, since time.Time) (int, error)
+
+	// CountAccountFollowersSince counts follows targeting
+	// the given account, created since the given time.
+	CountAccountFollowersSince(ctx context.Context, accountID string, since time.Time) (int, error)
+
+	// GetAccountTopTags returns usage counts of the tags most used in statuses
+	// created by the given account since the given time, most used first.
+	GetAccountTopTags(ctx context.Context, accountID string, since time.Time, limit int) ([]gtsmodel.TagUsage, error)
+
 	// Update account stats.
 	UpdateAccountStats(ctx context.Context, stats *gtsmodel.AccountStats, columns ...string) error
 
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Admin contains functions related to instance administration (new signups etc).
@@ -37,6 +38,10 @@ type Admin interface {
 	// C) something went wrong in the db
 	IsEmailAvailable(ctx context.Context, email string) (bool, error)
 
+	// GetLocalAccountDomains returns the distinct, non-default account
+	// domains currently used in the names of local accounts.
+	GetLocalAccountDomains(ctx context.Context) ([]string, error)
+
 	// NewSignup creates a new user + account in the database with the given parameters.
 	// By the time this function is called, it should be assumed that all the parameters have passed validation!
 	NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (*gtsmodel.User, error)
@@ -86,4 +91,71 @@ type Admin interface {
 
 	// DeleteAdminAction deletes admin action with the given ID.
 	DeleteAdminAction(ctx context.Context, id string) error
+
+	/*
+		RETENTION POLICY FUNCS
+	*/
+
+	// GetRetentionPolicy returns the instance data retention policy,
+	// or db.ErrNoEntries if the admin has never set one.
+	GetRetentionPolicy(ctx context.Context) (*gtsmodel.RetentionPolicy, error)
+
+	// PutRetentionPolicy inserts or updates the instance data retention policy.
+	PutRetentionPolicy(ctx context.Context, policy *gtsmodel.RetentionPolicy) error
+
+	/*
+		ADMIN ACTION LOG FUNCS
+	*/
+
+	// GetAdminActionLogs pages through admin action log entries,
+	// newest first, optionally filtered by the admin who performed
+	// the action, the account targeted by it, and / or a date range.
+	// Zero startAt / endAt values mean no bound on that side.
+	GetAdminActionLogs(ctx context.Context, accountID string, targetAccountID string, startAt time.Time, endAt time.Time, page *paging.Page) ([]*gtsmodel.AdminActionLog, error)
+
+	// PutAdminActionLog inserts the given admin action log entry.
+	PutAdminActionLog(ctx context.Context, entry *gtsmodel.AdminActionLog) error
+
+	/*
+		DELIVERY AUDIT FUNCS
+	*/
+
+	// GetDeliveryAudits pages through federation audit log entries,
+	// newest first, optionally filtered by target domain, activity
+	// type, and / or a date range. Zero startAt / endAt values
+	// mean no bound on that side.
+	GetDeliveryAudits(ctx context.Context, domain string, activityType string, startAt time.Time, endAt time.Time, page *paging.Page) ([]*gtsmodel.DeliveryAudit, error)
+
+	// PutDeliveryAudits inserts the given batch of federation audit log entries.
+	PutDeliveryAudits(ctx context.Context, entries []*gtsmodel.DeliveryAudit) error
+
+	// PruneDeliveryAudits deletes the oldest federation audit log entries
+	// such that at most max entries remain, returning the no. deleted.
+	PruneDeliveryAudits(ctx context.Context, max int) (int, error)
+
+	/*
+		DOMAIN DELIVERY HEALTH FUNCS
+	*/
+
+	// GetDomainDeliveryHealth gets the delivery health entry for the given punycode domain.
+	GetDomainDeliveryHealth(ctx context.Context, domain string) (*gtsmodel.DomainDeliveryHealth, error)
+
+	// PutDomainDeliveryHealth inserts the given delivery health entry, or
+	// replaces the existing entry for its domain if there already is one.
+	PutDomainDeliveryHealth(ctx context.Context, health *gtsmodel.DomainDeliveryHealth) error
+
+	/*
+		ACCOUNT WARNING FUNCS
+	*/
+
+	// GetAccountWarningByID returns the account warning with the given ID.
+	GetAccountWarningByID(ctx context.Context, id string) (*gtsmodel.AccountWarning, error)
+
+	// GetAccountWarnings pages through warnings issued to the given
+	// target account, newest first. If activeOnly is true, warnings
+	// overturned on appeal are excluded.
+	GetAccountWarnings(ctx context.Context, targetAccountID string, activeOnly bool, page *paging.Page) ([]*gtsmodel.AccountWarning, error)
+
+	// PutAccountWarning inserts the given account warning.
+	PutAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error
 }
@@ -19,8 +19,10 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 type Application interface {
@@ -33,9 +35,31 @@ type Application interface {
 	// PutApplication places the new application in the database, erroring on non-unique ID or client_id.
 	PutApplication(ctx context.Context, app *gtsmodel.Application) error
 
+	// GetApplications fetches a page of all applications registered on the instance.
+	GetApplications(ctx context.Context, page *paging.Page) ([]*gtsmodel.Application, error)
+
+	// UpdateApplication updates the given application in the database. If columns is empty, all columns will be updated.
+	UpdateApplication(ctx context.Context, app *gtsmodel.Application, columns ...string) error
+
 	// DeleteApplicationByClientID deletes the application with corresponding client_id value from the database.
 	DeleteApplicationByClientID(ctx context.Context, clientID string) error
 
+	// GetApplicationTokenStats returns the number of access tokens belonging to the
+	// given client ID, and the latest time any of them was used (zero if never).
+	GetApplicationTokenStats(ctx context.Context, clientID string) (int, time.Time, error)
+
+	// GetApplicationBlocks fetches all application blocks.
+	GetApplicationBlocks(ctx context.Context) ([]*gtsmodel.ApplicationBlock, error)
+
+	// GetApplicationBlockByID fetches the application block with the given ID.
+	GetApplicationBlockByID(ctx context.Context, id string) (*gtsmodel.ApplicationBlock, error)
+
+	// PutApplicationBlock inserts the given application block into the database.
+	PutApplicationBlock(ctx context.Context, block *gtsmodel.ApplicationBlock) error
+
+	// DeleteApplicationBlockByID deletes the application block with the given ID.
+	DeleteApplicationBlockByID(ctx context.Context, id string) error
+
 	// GetClientByID ...
 	GetClientByID(ctx context.Context, id string) (*gtsmodel.Client, error)
 
@@ -60,6 +84,9 @@ type Application interface {
 	// PutToken ...
 	PutToken(ctx context.Context, token *gtsmodel.Token) error
 
+	// UpdateToken updates the given token in the database. If columns is empty, all columns will be updated.
+	UpdateToken(ctx context.Context, token *gtsmodel.Token, columns ...string) error
+
 	// DeleteTokenByID ...
 	DeleteTokenByID(ctx context.Context, id string) error
 
@@ -71,4 +98,7 @@ type Application interface {
 
 	// DeleteTokenByRefresh ...
 	DeleteTokenByRefresh(ctx context.Context, refresh string) error
+
+	// DeleteTokensByClientID deletes all tokens belonging to the given client ID.
+	DeleteTokensByClientID(ctx context.Context, clientID string) error
 }
@@ -36,6 +36,11 @@ type Basic interface {
 	// Ready returns nil if the database connection is ready, or an error if not.
 	Ready(ctx context.Context) error
 
+	// Vacuum performs database maintenance, reclaiming space used by dead rows.
+	// Returns false if maintenance is already being performed elsewhere, e.g. by
+	// another instance sharing the same database.
+	Vacuum(ctx context.Context) (bool, error)
+
 	// GetByID gets one entry by its id. In a database like postgres, this might be the 'id' field of the entry,
 	// for other implementations (for example, in-memory) it might just be the key of a map.
 	// The given interface i will be set to the result of the query, whatever it is. Use a pointer or a slice.
@@ -1094,6 +1094,92 @@ func (a *accountDB) GetAccountWebStatuses(ctx context.Context, accountID string,
 	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
 }
 
+func (a *accountDB) GetAccountOutboxStatuses(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.Status, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		statusIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		// Select only IDs from table
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		// Do include self replies (threads), but
+		// don't include replies to other people.
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("status.in_reply_to_account_id"), accountID).
+				WhereOr("? IS NULL", bun.Ident("status.in_reply_to_uri"))
+		}).
+		// Don't show boosts.
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		// Only Public or Unlisted statuses.
+		Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+			gtsmodel.VisibilityPublic,
+			gtsmodel.VisibilityUnlocked,
+		})).
+		// Don't serve local-only statuses.
+		Where("? = ?", bun.Ident("status.federated"), true)
+
+	// Don't include replies that mention other people:
+	// for example, an account's reply to its own reply to someone else.
+	q = whereArrayIsNullOrEmpty(q, bun.Ident("status.mentions"))
+
+	// Return only statuses with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	// Return only statuses with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// statuses returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("status.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("status.id"))
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want statuses
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(statusIDs)
+	}
+
+	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (a *accountDB) GetAccountSettings(
 	ctx context.Context,
 	accountID string,
@@ -1159,9 +1245,112 @@ func (a *accountDB) UpdateAccountSettings(
 	})
 }
 
-func (a *accountDB) PopulateAccountStats(ctx context.Context, account *gtsmodel.Account) error {
+func (a *accountDB) GetStatusRetentionAccountIDs(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	if err := a.db.
+		NewSelect().
+		Table("account_settings").
+		Column("account_id").
+		Where("? > 0", bun.Ident("status_retention_days")).
+		Order("account_id ASC").
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (a *accountDB) GetLocalAccountIDs(ctx context.Context, maxID string, limit int) ([]string, error) {
+	// Make educated guess for slice size
+	accountIDs := make([]string, 0, limit)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		// Select just the account ID.
+		Column("account.id").
+		// Local accounts have no domain.
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Order("account.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account.id"), maxID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return accountIDs, nil
+}
+
+func (a *accountDB) GetDirectoryAccountIDs(ctx context.Context, local bool, newest bool, offset int, limit int) ([]string, error) {
+	// Make educated guess for slice size
+	accountIDs := make([]string, 0, limit)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		// Select just the account ID.
+		Column("account.id").
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? IS NULL", bun.Ident("account.silenced_at")).
+		Where("? IS NULL", bun.Ident("account.moved_to_uri")).
+		// Exclude our own instance account.
+		Where("? != ?", bun.Ident("account.username"), config.GetHost())
+
+	if local {
+		// Local accounts have no domain.
+		q = q.Where("? IS NULL", bun.Ident("account.domain"))
+	}
+
+	if newest {
+		q = q.Order("account.created_at DESC", "account.id DESC")
+	} else {
+		// Order by most recent status, with
+		// accounts that have never posted last.
+		q = q.
+			Join("LEFT JOIN ? AS ? ON ? = ?",
+				bun.Ident("account_stats"), bun.Ident("stats"),
+				bun.Ident("stats.account_id"), bun.Ident("account.id"),
+			).
+			OrderExpr("? IS NULL", bun.Ident("stats.last_status_at")).
+			OrderExpr("? DESC", bun.Ident("stats.last_status_at")).
+			Order("account.id DESC")
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return accountIDs, nil
+}
+
+func (a *accountDB) GetAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error) {
 	// Fetch stats from db cache with loader callback.
-	stats, err := a.state.Caches.GTS.AccountStats.LoadOne(
+	return a.state.Caches.GTS.AccountStats.LoadOne(
 		"AccountID",
 		func() (*gtsmodel.AccountStats, error) {
 			// Not cached! Perform database query.
@@ -1169,15 +1358,18 @@ func (a *accountDB) PopulateAccountStats(ctx context.Context, account *gtsmodel.
 			if err := a.db.
 				NewSelect().
 				Model(&stats).
-				Where("? = ?", bun.Ident("account_stats.account_id"), account.ID).
+				Where("? = ?", bun.Ident("account_stats.account_id"), accountID).
 				Scan(ctx); err != nil {
 				return nil, err
 			}
 			return &stats, nil
 		},
-		account.ID,
+		accountID,
 	)
+}
 
+func (a *accountDB) PopulateAccountStats(ctx context.Context, account *gtsmodel.Account) error {
+	stats, err := a.GetAccountStats(ctx, account.ID)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		// Real error.
 		return err
@@ -1226,6 +1418,7 @@ func (a *accountDB) StubAccountStats(ctx context.Context, account *gtsmodel.Acco
 		FollowRequestsCount: util.Ptr(0),
 		StatusesCount:       util.Ptr(0),
 		StatusesPinnedCount: util.Ptr(0),
+		MediaUsedBytes:      util.Ptr(int64(0)),
 	}
 
 	// Upsert this stats in case a race
@@ -1247,6 +1440,30 @@ func (a *accountDB) StubAccountStats(ctx context.Context, account *gtsmodel.Acco
 }
 
 func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmodel.Account) error {
+	stats, err := a.CountAccountStats(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	// Upsert this stats in case a race
+	// meant someone else inserted it first.
+	if err := a.state.Caches.GTS.AccountStats.Store(stats, func() error {
+		if _, err := NewUpsert(a.db).
+			Model(stats).
+			Constraint("account_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	account.Stats = stats
+	return nil
+}
+
+func (a *accountDB) CountAccountStats(ctx context.Context, account *gtsmodel.Account) (*gtsmodel.AccountStats, error) {
 	// Initialize a new stats struct.
 	stats := &gtsmodel.AccountStats{
 		AccountID:     account.ID,
@@ -1257,7 +1474,7 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	// it uses a cache + requires its own db calls.
 	followerIDs, err := a.state.DB.GetAccountFollowerIDs(ctx, account.ID, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	stats.FollowersCount = util.Ptr(len(followerIDs))
 
@@ -1265,7 +1482,7 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	// it uses a cache + requires its own db calls.
 	followIDs, err := a.state.DB.GetAccountFollowIDs(ctx, account.ID, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	stats.FollowingCount = util.Ptr(len(followIDs))
 
@@ -1273,10 +1490,22 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	// it uses a cache + requires its own db calls.
 	followRequestIDs, err := a.state.DB.GetAccountFollowRequestIDs(ctx, account.ID, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	stats.FollowRequestsCount = util.Ptr(len(followRequestIDs))
 
+	// Sum local media uploaded by the account. Remote
+	// accounts have no media quota, so their cached
+	// media is never counted against them.
+	var mediaUsedBytes int64
+	if account.IsLocal() {
+		mediaUsedBytes, err = a.state.DB.GetAccountMediaSize(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	stats.MediaUsedBytes = &mediaUsedBytes
+
 	// Populate remaining stats struct fields.
 	// This can be done inside a transaction.
 	if err := a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
@@ -1320,25 +1549,10 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 
 		return nil
 	}); err != nil {
-		return err
-	}
-
-	// Upsert this stats in case a race
-	// meant someone else inserted it first.
-	if err := a.state.Caches.GTS.AccountStats.Store(stats, func() error {
-		if _, err := NewUpsert(a.db).
-			Model(stats).
-			Constraint("account_id").
-			Exec(ctx); err != nil {
-			return err
-		}
-		return nil
-	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	account.Stats = stats
-	return nil
+	return stats, nil
 }
 
 func (a *accountDB) UpdateAccountStats(ctx context.Context, stats *gtsmodel.AccountStats, columns ...string) error {
@@ -687,6 +687,59 @@ func (suite *AccountTestSuite) TestGetAccountsWithIP() {
 	suite.Len(accounts, 1)
 }
 
+func (suite *AccountTestSuite) TestGetAccountsRemoteSuspended() {
+	var (
+		ctx         = context.Background()
+		origin      = "remote"
+		status      = "suspended"
+		mods        = false
+		invitedBy   = ""
+		username    = ""
+		displayName = ""
+		domain      = ""
+		email       = ""
+		ip          netip.Addr
+		page        = &paging.Page{
+			Limit: 100,
+		}
+	)
+
+	// Suspend one remote account and one local
+	// account, using copies so the suspension
+	// doesn't leak into the other tests.
+	for _, account := range []*gtsmodel.Account{
+		suite.testAccounts["remote_account_1"],
+		suite.testAccounts["local_account_2"],
+	} {
+		account := util.Ptr(*account)
+		account.SuspendedAt = time.Now()
+		if err := suite.db.UpdateAccount(ctx, account, "suspended_at"); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	accounts, err := suite.db.GetAccounts(
+		ctx,
+		origin,
+		status,
+		mods,
+		invitedBy,
+		username,
+		displayName,
+		domain,
+		email,
+		ip,
+		page,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["remote_account_1"].ID, accounts[0].ID)
+	}
+}
+
 func (suite *AccountTestSuite) TestGetPendingAccounts() {
 	var (
 		ctx         = context.Background()
@@ -773,6 +826,48 @@ func (suite *AccountTestSuite) TestAccountStatsAll() {
 	}
 }
 
+func (suite *AccountTestSuite) TestGetDirectoryAccountIDs() {
+	ctx := context.Background()
+
+	// Local discoverable accounts only,
+	// the instance account is excluded.
+	localIDs, err := suite.db.GetDirectoryAccountIDs(ctx, true, true, 0, 40)
+	suite.NoError(err)
+	suite.ElementsMatch([]string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_1"].ID,
+	}, localIDs)
+
+	// All discoverable accounts.
+	allIDs, err := suite.db.GetDirectoryAccountIDs(ctx, false, false, 0, 40)
+	suite.NoError(err)
+	suite.ElementsMatch([]string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_1"].ID,
+		suite.testAccounts["remote_account_1"].ID,
+		suite.testAccounts["remote_account_2"].ID,
+		suite.testAccounts["remote_account_3"].ID,
+	}, allIDs)
+
+	// Offset + limit should page through
+	// the same list in the same order.
+	pageIDs, err := suite.db.GetDirectoryAccountIDs(ctx, false, false, 1, 2)
+	suite.NoError(err)
+	suite.Equal(allIDs[1:3], pageIDs)
+
+	// Suspended accounts are excluded.
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["local_account_1"]
+	account.SuspendedAt = time.Now()
+	if err := suite.db.UpdateAccount(ctx, account, "suspended_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	localIDs, err = suite.db.GetDirectoryAccountIDs(ctx, true, false, 0, 40)
+	suite.NoError(err)
+	suite.Equal([]string{suite.testAccounts["admin_account"].ID}, localIDs)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/uptrace/bun"
+)
+
+// The below statistics queries select rows by ID rather than
+// created_at, as IDs are ULIDs which sort by creation time,
+// so the queries can make use of existing primary key indices.
+
+func (a *accountDB) CountAccountStatusesSince(ctx context.Context, accountID string, since time.Time) (int, error) {
+	minID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? >= ?", bun.Ident("status.id"), minID).
+		Count(ctx)
+}
+
+func (a *accountDB) CountAccountFavesReceivedSince(ctx context.Context, accountID string, since time.Time) (int, error) {
+	minID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		Where("? = ?", bun.Ident("status_fave.target_account_id"), accountID).
+		Where("? != ?", bun.Ident("status_fave.account_id"), accountID).
+		Where("? >= ?", bun.Ident("status_fave.id"), minID).
+		Count(ctx)
+}
+
+func (a *accountDB) CountAccountBoostsReceivedSince(ctx context.Context, accountID string, since time.Time) (int, error) {
+	minID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? = ?", bun.Ident("status.boost_of_account_id"), accountID).
+		Where("? != ?", bun.Ident("status.account_id"), accountID).
+		Where("? >= ?", bun.Ident("status.id"), minID).
+		Count(ctx)
+}
+
+func (a *accountDB) CountAccountFollowersSince(ctx context.Context, accountID string, since time.Time) (int, error) {
+	minID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Where("? = ?", bun.Ident("follow.target_account_id"), accountID).
+		Where("? >= ?", bun.Ident("follow.id"), minID).
+		Count(ctx)
+}
+
+func (a *accountDB) GetAccountTopTags(ctx context.Context, accountID string, since time.Time, limit int) ([]gtsmodel.TagUsage, error) {
+	minID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []gtsmodel.TagUsage
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		ColumnExpr("? AS ?", bun.Ident("status_to_tag.tag_id"), bun.Ident("tag_id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? >= ?", bun.Ident("status.id"), minID).
+		Group("status_to_tag.tag_id").
+		OrderExpr("? DESC", bun.Ident("count")).
+		OrderExpr("? ASC", bun.Ident("status_to_tag.tag_id")).
+		Limit(limit).
+		Scan(ctx, &usages); err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"slices"
 	"strings"
 	"time"
 
@@ -31,10 +32,12 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -60,6 +63,20 @@ func (a *adminDB) IsUsernameAvailable(ctx context.Context, username string) (boo
 	return notExists(ctx, q)
 }
 
+func (a *adminDB) GetLocalAccountDomains(ctx context.Context) ([]string, error) {
+	var domains []string
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		ColumnExpr("DISTINCT ?", bun.Ident("account.account_domain")).
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? IS NOT NULL", bun.Ident("account.account_domain")).
+		Scan(ctx, &domains); err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
 func (a *adminDB) IsEmailAvailable(ctx context.Context, email string) (bool, error) {
 	// parse the domain from the email
 	m, err := mail.ParseAddress(email)
@@ -137,6 +154,13 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 			PublicKeyURI:          uris.PublicKeyURI,
 		}
 
+		// Only store account domain if it
+		// differs from the default, so that
+		// single-domain instances are unaffected.
+		if newSignup.AccountDomain != config.GetAccountDomain() {
+			account.AccountDomain = newSignup.AccountDomain
+		}
+
 		// Insert the new account!
 		if err := a.state.DB.PutAccount(ctx, account); err != nil {
 			return nil, err
@@ -452,6 +476,7 @@ func (a *adminDB) GetAdminAction(ctx context.Context, id string) (*gtsmodel.Admi
 	if err := a.db.
 		NewSelect().
 		Model(action).
+		Where("? = ?", bun.Ident("admin_action.id"), id).
 		Scan(ctx); err != nil {
 		return nil, err
 	}
@@ -507,3 +532,462 @@ func (a *adminDB) DeleteAdminAction(ctx context.Context, id string) error {
 
 	return err
 }
+
+/*
+	RETENTION POLICY FUNCS
+*/
+
+func (a *adminDB) GetRetentionPolicy(ctx context.Context) (*gtsmodel.RetentionPolicy, error) {
+	policy := new(gtsmodel.RetentionPolicy)
+
+	// There should only ever be one
+	// policy, but get latest to be sure.
+	if err := a.db.
+		NewSelect().
+		Model(policy).
+		Order("retention_policy.id DESC").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (a *adminDB) PutRetentionPolicy(ctx context.Context, policy *gtsmodel.RetentionPolicy) error {
+	// Update the policy's last-updated
+	policy.UpdatedAt = time.Now()
+
+	_, err := NewUpsert(a.db).
+		Model(policy).
+		Constraint("id").
+		Exec(ctx)
+
+	return err
+}
+
+func (a *adminDB) GetAdminActionLogs(
+	ctx context.Context,
+	accountID string,
+	targetAccountID string,
+	startAt time.Time,
+	endAt time.Time,
+	page *paging.Page,
+) ([]*gtsmodel.AdminActionLog, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		entries = make([]*gtsmodel.AdminActionLog, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		Model(&entries)
+
+	if accountID != "" {
+		q = q.Where("? = ?", bun.Ident("admin_action_log.account_id"), accountID)
+	}
+
+	if targetAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("admin_action_log.target_account_id"), targetAccountID)
+	}
+
+	if !startAt.IsZero() {
+		q = q.Where("? >= ?", bun.Ident("admin_action_log.created_at"), startAt)
+	}
+
+	if !endAt.IsZero() {
+		q = q.Where("? < ?", bun.Ident("admin_action_log.created_at"), endAt)
+	}
+
+	// Return only entries with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("admin_action_log.id"), maxID)
+	}
+
+	// Return only entries with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("admin_action_log.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// entries returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("admin_action_log.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("admin_action_log.id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no entries early
+	if len(entries) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want entries
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(entries)
+	}
+
+	for _, entry := range entries {
+		if err := a.populateAdminActionLog(ctx, entry); err != nil {
+			log.Errorf(ctx, "error populating admin action log %s: %v", entry.ID, err)
+		}
+	}
+
+	return entries, nil
+}
+
+func (a *adminDB) populateAdminActionLog(ctx context.Context, entry *gtsmodel.AdminActionLog) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if entry.Account == nil {
+		// Entry account is not set, fetch from database.
+		entry.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			entry.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating account: %w", err)
+		}
+	}
+
+	if entry.TargetAccount == nil {
+		// Entry target account is not set, fetch from database.
+		entry.TargetAccount, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			entry.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating target account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *adminDB) PutAdminActionLog(ctx context.Context, entry *gtsmodel.AdminActionLog) error {
+	_, err := a.db.
+		NewInsert().
+		Model(entry).
+		Exec(ctx)
+	return err
+}
+
+func (a *adminDB) GetDeliveryAudits(
+	ctx context.Context,
+	domain string,
+	activityType string,
+	startAt time.Time,
+	endAt time.Time,
+	page *paging.Page,
+) ([]*gtsmodel.DeliveryAudit, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		entries = make([]*gtsmodel.DeliveryAudit, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		Model(&entries)
+
+	if domain != "" {
+		q = q.Where("? = ?", bun.Ident("delivery_audit.domain"), domain)
+	}
+
+	if activityType != "" {
+		q = q.Where("? = ?", bun.Ident("delivery_audit.activity_type"), activityType)
+	}
+
+	if !startAt.IsZero() {
+		q = q.Where("? >= ?", bun.Ident("delivery_audit.created_at"), startAt)
+	}
+
+	if !endAt.IsZero() {
+		q = q.Where("? < ?", bun.Ident("delivery_audit.created_at"), endAt)
+	}
+
+	// Return only entries with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("delivery_audit.id"), maxID)
+	}
+
+	// Return only entries with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("delivery_audit.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// entries returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("delivery_audit.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("delivery_audit.id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no entries early
+	if len(entries) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want entries
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(entries)
+	}
+
+	return entries, nil
+}
+
+func (a *adminDB) PutDeliveryAudits(ctx context.Context, entries []*gtsmodel.DeliveryAudit) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, err := a.db.
+		NewInsert().
+		Model(&entries).
+		Exec(ctx)
+	return err
+}
+
+func (a *adminDB) PruneDeliveryAudits(ctx context.Context, max int) (int, error) {
+	if max < 0 {
+		max = 0
+	}
+
+	// Find the newest entry beyond the
+	// max no. entries, i.e. the first
+	// entry that should be deleted.
+	var ids []string
+	if err := a.db.
+		NewSelect().
+		Table("delivery_audits").
+		Column("id").
+		OrderExpr("? DESC", bun.Ident("id")).
+		Offset(max).
+		Limit(1).
+		Scan(ctx, &ids); err != nil {
+		return 0, err
+	}
+
+	if len(ids) == 0 {
+		// Within max,
+		// nothing to do.
+		return 0, nil
+	}
+
+	// Delete this entry and all those older,
+	// taking advantage of IDs being ULIDs.
+	res, err := a.db.
+		NewDelete().
+		Table("delivery_audits").
+		Where("? <= ?", bun.Ident("id"), ids[0]).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	return int(deleted), err
+}
+
+func (a *adminDB) GetDomainDeliveryHealth(ctx context.Context, domain string) (*gtsmodel.DomainDeliveryHealth, error) {
+	health := new(gtsmodel.DomainDeliveryHealth)
+
+	if err := a.db.
+		NewSelect().
+		Model(health).
+		Where("? = ?", bun.Ident("domain_delivery_health.domain"), domain).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
+
+func (a *adminDB) PutDomainDeliveryHealth(ctx context.Context, health *gtsmodel.DomainDeliveryHealth) error {
+	// Update the entry's last-updated
+	health.UpdatedAt = time.Now()
+
+	_, err := NewUpsert(a.db).
+		Model(health).
+		Constraint("domain").
+		Exec(ctx)
+
+	return err
+}
+
+func (a *adminDB) GetAccountWarningByID(ctx context.Context, id string) (*gtsmodel.AccountWarning, error) {
+	warning := new(gtsmodel.AccountWarning)
+
+	if err := a.db.
+		NewSelect().
+		Model(warning).
+		Where("? = ?", bun.Ident("account_warning.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.populateAccountWarning(ctx, warning); err != nil {
+		log.Errorf(ctx, "error populating account warning %s: %v", warning.ID, err)
+	}
+
+	return warning, nil
+}
+
+func (a *adminDB) GetAccountWarnings(
+	ctx context.Context,
+	targetAccountID string,
+	activeOnly bool,
+	page *paging.Page,
+) ([]*gtsmodel.AccountWarning, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		warnings = make([]*gtsmodel.AccountWarning, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		Model(&warnings).
+		Where("? = ?", bun.Ident("account_warning.target_account_id"), targetAccountID)
+
+	if activeOnly {
+		q = q.Where("? != ?",
+			bun.Ident("account_warning.appeal_state"),
+			gtsmodel.AccountWarningAppealApproved,
+		)
+	}
+
+	// Return only warnings with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account_warning.id"), maxID)
+	}
+
+	// Return only warnings with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("account_warning.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// warnings returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("account_warning.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("account_warning.id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no warnings early
+	if len(warnings) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want warnings
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(warnings)
+	}
+
+	for _, warning := range warnings {
+		if err := a.populateAccountWarning(ctx, warning); err != nil {
+			log.Errorf(ctx, "error populating account warning %s: %v", warning.ID, err)
+		}
+	}
+
+	return warnings, nil
+}
+
+func (a *adminDB) populateAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if warning.Account == nil {
+		// Warning account is not set, fetch from database.
+		warning.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			warning.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating account: %w", err)
+		}
+	}
+
+	if warning.TargetAccount == nil {
+		// Warning target account is not set, fetch from database.
+		warning.TargetAccount, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			warning.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating target account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *adminDB) PutAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error {
+	_, err := a.db.
+		NewInsert().
+		Model(warning).
+		Exec(ctx)
+	return err
+}
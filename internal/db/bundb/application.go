@@ -19,8 +19,12 @@ package bundb
 
 import (
 	"context"
+	"slices"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -73,6 +77,112 @@ func (a *applicationDB) PutApplication(ctx context.Context, app *gtsmodel.Applic
 	})
 }
 
+func (a *applicationDB) GetApplications(ctx context.Context, page *paging.Page) ([]*gtsmodel.Application, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		appIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("applications"), bun.Ident("application")).
+		Column("application.id")
+
+	// Return only apps with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("application.id"), maxID)
+	}
+
+	// Return only apps with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("application.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// apps returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("application.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("application.id"))
+	}
+
+	if err := q.Scan(ctx, &appIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no apps early.
+	if len(appIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want apps
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(appIDs)
+	}
+
+	// Load all app IDs via cache loader callback.
+	apps, err := a.state.Caches.GTS.Application.LoadIDs("ID",
+		appIDs,
+		func(uncached []string) ([]*gtsmodel.Application, error) {
+			// Preallocate expected length of uncached apps.
+			apps := make([]*gtsmodel.Application, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) app IDs.
+			if err := a.db.NewSelect().
+				Model(&apps).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return apps, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the apps by their
+	// IDs to ensure in correct order.
+	getID := func(app *gtsmodel.Application) string { return app.ID }
+	util.OrderBy(apps, appIDs, getID)
+
+	return apps, nil
+}
+
+func (a *applicationDB) UpdateApplication(ctx context.Context, app *gtsmodel.Application, columns ...string) error {
+	app.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return a.state.Caches.GTS.Application.Store(app, func() error {
+		_, err := a.db.NewUpdate().
+			Model(app).
+			Where("? = ?", bun.Ident("application.id"), app.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (a *applicationDB) DeleteApplicationByClientID(ctx context.Context, clientID string) error {
 	// Attempt to delete application.
 	if _, err := a.db.NewDelete().
@@ -97,6 +207,66 @@ func (a *applicationDB) DeleteApplicationByClientID(ctx context.Context, clientI
 	return nil
 }
 
+func (a *applicationDB) GetApplicationTokenStats(ctx context.Context, clientID string) (int, time.Time, error) {
+	var stats struct {
+		Count    int
+		LastUsed time.Time
+	}
+
+	// Count access tokens, ignoring tokens
+	// that only hold an authorization code.
+	if err := a.db.NewSelect().
+		Table("tokens").
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		ColumnExpr("MAX(?) AS ?", bun.Ident("last_used"), bun.Ident("last_used")).
+		Where("? = ?", bun.Ident("client_id"), clientID).
+		Where("? != ''", bun.Ident("access")).
+		Scan(ctx, &stats); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return stats.Count, stats.LastUsed, nil
+}
+
+func (a *applicationDB) GetApplicationBlocks(ctx context.Context) ([]*gtsmodel.ApplicationBlock, error) {
+	blocks := []*gtsmodel.ApplicationBlock{}
+
+	if err := a.db.NewSelect().
+		Model(&blocks).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+func (a *applicationDB) GetApplicationBlockByID(ctx context.Context, id string) (*gtsmodel.ApplicationBlock, error) {
+	block := new(gtsmodel.ApplicationBlock)
+
+	if err := a.db.NewSelect().
+		Model(block).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+func (a *applicationDB) PutApplicationBlock(ctx context.Context, block *gtsmodel.ApplicationBlock) error {
+	_, err := a.db.NewInsert().Model(block).Exec(ctx)
+	return err
+}
+
+func (a *applicationDB) DeleteApplicationBlockByID(ctx context.Context, id string) error {
+	_, err := a.db.NewDelete().
+		Table("application_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
 func (a *applicationDB) GetClientByID(ctx context.Context, id string) (*gtsmodel.Client, error) {
 	return a.state.Caches.GTS.Client.LoadOne("ID", func() (*gtsmodel.Client, error) {
 		var client gtsmodel.Client
@@ -223,6 +393,23 @@ func (a *applicationDB) PutToken(ctx context.Context, token *gtsmodel.Token) err
 	})
 }
 
+func (a *applicationDB) UpdateToken(ctx context.Context, token *gtsmodel.Token, columns ...string) error {
+	token.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return a.state.Caches.GTS.Token.Store(token, func() error {
+		_, err := a.db.NewUpdate().
+			Model(token).
+			Where("? = ?", bun.Ident("token.id"), token.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (a *applicationDB) DeleteTokenByID(ctx context.Context, id string) error {
 	_, err := a.db.NewDelete().
 		Table("tokens").
@@ -274,3 +461,19 @@ func (a *applicationDB) DeleteTokenByRefresh(ctx context.Context, refresh string
 	a.state.Caches.GTS.Token.Invalidate("Refresh", refresh)
 	return nil
 }
+
+func (a *applicationDB) DeleteTokensByClientID(ctx context.Context, clientID string) error {
+	var tokenIDs []string
+
+	if _, err := a.db.NewDelete().
+		Table("tokens").
+		Where("? = ?", bun.Ident("client_id"), clientID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &tokenIDs); err != nil {
+		return err
+	}
+
+	// Invalidate all deleted tokens by IDs.
+	a.state.Caches.GTS.Token.InvalidateIDs("ID", tokenIDs)
+	return nil
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type ApplicationTestSuite struct {
@@ -131,6 +133,112 @@ func (suite *ApplicationTestSuite) TestGetAllTokens() {
 	suite.NotEmpty(tokens)
 }
 
+func (suite *ApplicationTestSuite) TestGetApplications() {
+	apps, err := suite.db.GetApplications(context.Background(), &paging.Page{
+		Limit: 2,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Newest apps should be first.
+	suite.Len(apps, 2)
+	suite.Equal(suite.testApplications["instance_application"].ID, apps[0].ID)
+	suite.Equal(suite.testApplications["application_2"].ID, apps[1].ID)
+
+	// Page down to the rest.
+	apps, err = suite.db.GetApplications(context.Background(), &paging.Page{
+		Max:   paging.MaxID(apps[1].ID),
+		Limit: 10,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(apps, 2)
+	suite.Equal(suite.testApplications["application_1"].ID, apps[0].ID)
+	suite.Equal(suite.testApplications["admin_account"].ID, apps[1].ID)
+}
+
+func (suite *ApplicationTestSuite) TestApplicationTokens() {
+	ctx := context.Background()
+	app := suite.testApplications["application_1"]
+
+	// App has two access tokens, and
+	// one token with only a code.
+	count, lastUsed, err := suite.db.GetApplicationTokenStats(ctx, app.ClientID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, count)
+	suite.Zero(lastUsed)
+
+	// Use a token.
+	token, err := suite.db.GetTokenByAccess(ctx, suite.testTokens["local_account_1"].Access)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	token.LastUsed = testrig.TimeMustParse("2024-06-10T15:22:08Z")
+	if err := suite.db.UpdateToken(ctx, token, "last_used"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	count, lastUsed, err = suite.db.GetApplicationTokenStats(ctx, app.ClientID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, count)
+	suite.True(token.LastUsed.Equal(lastUsed))
+
+	// Delete all the app's tokens.
+	if err := suite.db.DeleteTokensByClientID(ctx, app.ClientID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	count, _, err = suite.db.GetApplicationTokenStats(ctx, app.ClientID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(count)
+
+	// Deleted token shouldn't still be cached.
+	_, err = suite.db.GetTokenByAccess(ctx, token.Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ApplicationTestSuite) TestApplicationBlocks() {
+	ctx := context.Background()
+
+	block := &gtsmodel.ApplicationBlock{
+		ID:                 "01J16BSGQE4JZ7P4F1J1E8WTXC",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		NamePattern:        "(?i)scraper",
+	}
+	if err := suite.db.PutApplicationBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	blocks, err := suite.db.GetApplicationBlocks(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(blocks, 1)
+	suite.Equal("(?i)scraper", blocks[0].NamePattern)
+	suite.Empty(blocks[0].WebsitePattern)
+
+	dbBlock, err := suite.db.GetApplicationBlockByID(ctx, block.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(block.CreatedByAccountID, dbBlock.CreatedByAccountID)
+
+	if err := suite.db.DeleteApplicationBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetApplicationBlockByID(ctx, block.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestApplicationTestSuite(t *testing.T) {
 	suite.Run(t, new(ApplicationTestSuite))
 }
@@ -20,14 +20,21 @@ package bundb
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/uptrace/bun"
 )
 
 type basicDB struct {
-	db *bun.DB
+	db       *bun.DB
+	replicas *replicas
+
+	// vacuumMu protects against
+	// concurrent sqlite maintenance.
+	vacuumMu sync.Mutex
 }
 
 func (b *basicDB) Put(ctx context.Context, i interface{}) error {
@@ -136,5 +143,19 @@ func (b *basicDB) Ready(ctx context.Context) error {
 
 func (b *basicDB) Close() error {
 	log.Info(nil, "closing db connection")
-	return b.db.Close()
+	errs := gtserror.NewMultiError(1)
+
+	if err := b.db.Close(); err != nil {
+		errs.Append(err)
+	}
+
+	// Close any replica connection
+	// pools alongside the primary.
+	for _, replica := range b.replicas.dbs {
+		if err := replica.Close(); err != nil {
+			errs.Appendf("error closing replica: %w", err)
+		}
+	}
+
+	return errs.Combine()
 }
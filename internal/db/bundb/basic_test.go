@@ -135,6 +135,15 @@ func (suite *BasicTestSuite) TestGetAllNotNull() {
 	}
 }
 
+func (suite *BasicTestSuite) TestVacuum() {
+	ran, err := suite.db.Vacuum(context.Background())
+	suite.NoError(err)
+	suite.True(ran)
+
+	// Database should still be usable afterwards.
+	suite.NoError(suite.db.Ready(context.Background()))
+}
+
 func TestBasicTestSuite(t *testing.T) {
 	suite.Run(t, new(BasicTestSuite))
 }
@@ -61,6 +61,7 @@ type DBService struct {
 	db.HeaderFilter
 	db.Instance
 	db.Filter
+	db.FollowSuggestion
 	db.List
 	db.Marker
 	db.Media
@@ -81,7 +82,9 @@ type DBService struct {
 	db.Timeline
 	db.User
 	db.Tombstone
-	db *bun.DB
+	db.WordFilter
+	db       *bun.DB
+	replicas *replicas
 }
 
 // GetDB returns the underlying database connection pool.
@@ -90,6 +93,19 @@ func (dbService *DBService) DB() *bun.DB {
 	return dbService.db
 }
 
+// DBPrimary returns the primary database connection pool,
+// to which all write queries (and most reads) are sent.
+func (dbService *DBService) DBPrimary() *bun.DB {
+	return dbService.replicas.Primary()
+}
+
+// DBReplica returns a read-only database connection pool,
+// selected round-robin from any configured replicas, else
+// falling back to the primary connection pool if none set.
+func (dbService *DBService) DBReplica() *bun.DB {
+	return dbService.replicas.Replica()
+}
+
 func doMigration(ctx context.Context, db *bun.DB) error {
 	migrator := migrate.NewMigrator(db, migrations.Migrations)
 
@@ -126,6 +142,7 @@ func doMigration(ctx context.Context, db *bun.DB) error {
 // Under the hood, it uses https://github.com/uptrace/bun to create and maintain a database connection.
 func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 	var db *bun.DB
+	var replicaDBs []*bun.DB
 	var err error
 	t := strings.ToLower(config.GetDbType())
 
@@ -135,22 +152,38 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		if err != nil {
 			return nil, err
 		}
+		replicaDBs, err = pgReplicaConns(ctx)
+		if err != nil {
+			return nil, err
+		}
 	case "sqlite":
 		db, err = sqliteConn(ctx)
 		if err != nil {
 			return nil, err
 		}
+		if len(config.GetDbReplicaAddresses()) > 0 {
+			log.Warnf(ctx, "'%s' is not supported for sqlite, ignoring", config.DbReplicaAddressesFlag())
+		}
 	default:
 		return nil, fmt.Errorf("database type %s not supported for bundb", t)
 	}
 
 	// Add database query hooks.
-	db.AddQueryHook(queryHook{})
-	if config.GetTracingEnabled() {
-		db.AddQueryHook(tracing.InstrumentBun())
+	for _, db := range append([]*bun.DB{db}, replicaDBs...) {
+		db.AddQueryHook(queryHook{})
+		if config.GetTracingEnabled() {
+			db.AddQueryHook(tracing.InstrumentBun())
+		}
+		if config.GetMetricsEnabled() {
+			db.AddQueryHook(metrics.InstrumentBun())
+		}
 	}
-	if config.GetMetricsEnabled() {
-		db.AddQueryHook(metrics.InstrumentBun())
+
+	// Wrap primary and replicas for
+	// round-robin read replica selection.
+	replicas := &replicas{
+		primary: db,
+		dbs:     replicaDBs,
 	}
 
 	// table registration is needed for many-to-many, see:
@@ -185,7 +218,8 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			state: state,
 		},
 		Basic: &basicDB{
-			db: db,
+			db:       db,
+			replicas: replicas,
 		},
 		Domain: &domainDB{
 			db:    db,
@@ -207,6 +241,11 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		FollowSuggestion: &followSuggestionDB{
+			db:       db,
+			replicas: replicas,
+			state:    state,
+		},
 		List: &listDB{
 			db:    db,
 			state: state,
@@ -275,8 +314,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			state: state,
 		},
 		Timeline: &timelineDB{
-			db:    db,
-			state: state,
+			db:       db,
+			replicas: replicas,
+			state:    state,
 		},
 		User: &userDB{
 			db:    db,
@@ -286,7 +326,12 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
-		db: db,
+		WordFilter: &wordFilterDB{
+			db:    db,
+			state: state,
+		},
+		db:       db,
+		replicas: replicas,
 	}
 
 	// we can confidently return this useable service now
@@ -299,6 +344,18 @@ func pgConn(ctx context.Context) (*bun.DB, error) {
 		return nil, fmt.Errorf("could not create bundb postgres options: %w", err)
 	}
 
+	db, err := pgOpen(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "connected to POSTGRES database")
+	return db, nil
+}
+
+// pgOpen opens a new postgres connection
+// pool using given pgx connection config.
+func pgOpen(ctx context.Context, opts *pgx.ConnConfig) (*bun.DB, error) {
 	cfg := stdlib.RegisterConnConfig(opts)
 
 	sqldb, err := sql.Open("pgx-gts", cfg)
@@ -320,7 +377,6 @@ func pgConn(ctx context.Context) (*bun.DB, error) {
 		return nil, fmt.Errorf("postgres ping: %w", err)
 	}
 
-	log.Info(ctx, "connected to POSTGRES database")
 	return db, nil
 }
 
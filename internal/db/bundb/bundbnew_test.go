@@ -46,6 +46,21 @@ func (suite *BundbNewTestSuite) TestCreateNewSqliteDBNoAddress() {
 	suite.Nil(db)
 }
 
+func (suite *BundbNewTestSuite) TestCreateNewSqliteDBReplicasIgnored() {
+	// replicas are not supported for sqlite,
+	// so they should just fall back to primary.
+	config.SetDbReplicaAddresses([]string{"postgres://replica.example.org/gotosocial"})
+	defer config.SetDbReplicaAddresses(nil)
+
+	db, err := bundb.NewBunDBService(context.Background(), nil)
+	suite.NoError(err)
+	suite.NotNil(db)
+	defer db.Close()
+
+	suite.Same(db.DBPrimary(), db.DBReplica())
+	suite.Same(db.DBPrimary(), db.DBReplica())
+}
+
 func TestBundbNewTestSuite(t *testing.T) {
 	suite.Run(t, new(BundbNewTestSuite))
 }
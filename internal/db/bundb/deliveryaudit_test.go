@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DeliveryAuditTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *DeliveryAuditTestSuite) putAudits(ctx context.Context) []*gtsmodel.DeliveryAudit {
+	var (
+		now     = time.Now()
+		entries = make([]*gtsmodel.DeliveryAudit, 0, 10)
+	)
+
+	for i := 0; i < 10; i++ {
+		domain, activityType := "example.org", "Create"
+		if i%2 == 0 {
+			domain, activityType = "fossbros-anonymous.io", "Delete"
+		}
+
+		createdAt := now.Add(time.Duration(i-10) * time.Minute)
+		ulid, err := id.NewULIDFromTime(createdAt)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		entries = append(entries, &gtsmodel.DeliveryAudit{
+			ID:           ulid,
+			CreatedAt:    createdAt,
+			Inbox:        "https://" + domain + "/inbox",
+			Domain:       domain,
+			ActivityType: activityType,
+			ObjectURI:    "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+			StatusCode:   202,
+			Attempt:      1,
+		})
+	}
+
+	if err := suite.db.PutDeliveryAudits(ctx, entries); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return entries
+}
+
+func (suite *DeliveryAuditTestSuite) TestGetDeliveryAuditsFiltered() {
+	ctx := context.Background()
+	entries := suite.putAudits(ctx)
+
+	audits, err := suite.db.GetDeliveryAudits(ctx,
+		"example.org",
+		"Create",
+		entries[4].CreatedAt,
+		time.Time{},
+		&paging.Page{Limit: 20},
+	)
+	suite.NoError(err)
+
+	// Odd entries from index 4, newest first.
+	if suite.Len(audits, 3) {
+		suite.Equal(entries[9].ID, audits[0].ID)
+		suite.Equal(entries[7].ID, audits[1].ID)
+		suite.Equal(entries[5].ID, audits[2].ID)
+	}
+}
+
+func (suite *DeliveryAuditTestSuite) TestPruneDeliveryAudits() {
+	ctx := context.Background()
+	entries := suite.putAudits(ctx)
+
+	pruned, err := suite.db.PruneDeliveryAudits(ctx, 4)
+	suite.NoError(err)
+	suite.Equal(6, pruned)
+
+	audits, err := suite.db.GetDeliveryAudits(ctx,
+		"",
+		"",
+		time.Time{},
+		time.Time{},
+		&paging.Page{Limit: 20},
+	)
+	suite.NoError(err)
+
+	// Only the newest 4 should remain.
+	if suite.Len(audits, 4) {
+		suite.Equal(entries[9].ID, audits[0].ID)
+		suite.Equal(entries[6].ID, audits[3].ID)
+	}
+
+	// Pruning again within max is a no-op.
+	pruned, err = suite.db.PruneDeliveryAudits(ctx, 4)
+	suite.NoError(err)
+	suite.Zero(pruned)
+}
+
+func TestDeliveryAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryAuditTestSuite))
+}
@@ -20,6 +20,8 @@ package bundb
 import (
 	"context"
 	"net/url"
+	"slices"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -51,7 +53,7 @@ func (d *domainDB) CreateDomainAllow(ctx context.Context, allow *gtsmodel.Domain
 	}
 
 	// Clear the domain allow cache (for later reload)
-	d.state.Caches.GTS.DomainAllow.Clear()
+	d.state.Caches.ClearDomainAllow()
 
 	return nil
 }
@@ -64,8 +66,7 @@ func (d *domainDB) GetDomainAllow(ctx context.Context, domain string) (*gtsmodel
 	}
 
 	// Check for easy case, domain referencing *us*
-	if domain == "" || domain == config.GetAccountDomain() ||
-		domain == config.GetHost() {
+	if domain == "" || config.IsLocalDomain(domain) {
 		return nil, db.ErrNoEntries
 	}
 
@@ -126,7 +127,7 @@ func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) error {
 	}
 
 	// Clear the domain allow cache (for later reload)
-	d.state.Caches.GTS.DomainAllow.Clear()
+	d.state.Caches.ClearDomainAllow()
 
 	return nil
 }
@@ -146,8 +147,9 @@ func (d *domainDB) CreateDomainBlock(ctx context.Context, block *gtsmodel.Domain
 		return err
 	}
 
-	// Clear the domain block cache (for later reload)
-	d.state.Caches.GTS.DomainBlock.Clear()
+	// Clear the domain block caches (for later reload)
+	d.state.Caches.ClearDomainBlock()
+	d.state.Caches.ClearDomainLimit()
 
 	return nil
 }
@@ -160,8 +162,7 @@ func (d *domainDB) GetDomainBlock(ctx context.Context, domain string) (*gtsmodel
 	}
 
 	// Check for easy case, domain referencing *us*
-	if domain == "" || domain == config.GetAccountDomain() ||
-		domain == config.GetHost() {
+	if domain == "" || config.IsLocalDomain(domain) {
 		return nil, db.ErrNoEntries
 	}
 
@@ -192,6 +193,33 @@ func (d *domainDB) GetDomainBlocks(ctx context.Context) ([]*gtsmodel.DomainBlock
 	return blocks, nil
 }
 
+func (d *domainDB) UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) error {
+	// Update the block's last-updated
+	block.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.db.
+		NewUpdate().
+		Model(block).
+		Where("? = ?", bun.Ident("domain_block.id"), block.ID).
+		Column(columns...).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) == 0 || slices.Contains(columns, "severity") {
+		// Severity may have changed, clear the
+		// domain block caches (for later reload)
+		d.state.Caches.ClearDomainBlock()
+		d.state.Caches.ClearDomainLimit()
+	}
+
+	return nil
+}
+
 func (d *domainDB) GetDomainBlockByID(ctx context.Context, id string) (*gtsmodel.DomainBlock, error) {
 	var block gtsmodel.DomainBlock
 
@@ -221,8 +249,9 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 		return err
 	}
 
-	// Clear the domain block cache (for later reload)
-	d.state.Caches.GTS.DomainBlock.Clear()
+	// Clear the domain block caches (for later reload)
+	d.state.Caches.ClearDomainBlock()
+	d.state.Caches.ClearDomainLimit()
 
 	return nil
 }
@@ -235,8 +264,7 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, er
 	}
 
 	// Domain referencing *us* cannot be blocked.
-	if domain == "" || domain == config.GetAccountDomain() ||
-		domain == config.GetHost() {
+	if domain == "" || config.IsLocalDomain(domain) {
 		return false, nil
 	}
 
@@ -262,10 +290,11 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, er
 	explicitBlock, err := d.state.Caches.GTS.DomainBlock.Matches(domain, func() ([]string, error) {
 		var domains []string
 
-		// Scan list of all blocked domains from DB
+		// Scan list of all suspended domains from DB
 		q := d.db.NewSelect().
 			Table("domain_blocks").
-			Column("domain")
+			Column("domain").
+			Where("? = ?", bun.Ident("severity"), gtsmodel.DomainBlockSuspend)
 		if err := q.Scan(ctx, &domains); err != nil {
 			return nil, err
 		}
@@ -303,6 +332,65 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, er
 	}
 }
 
+func (d *domainDB) IsDomainLimited(ctx context.Context, domain string) (bool, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// Domain referencing *us* cannot be limited.
+	if domain == "" || config.IsLocalDomain(domain) {
+		return false, nil
+	}
+
+	// Check the cache for a domain limit (hydrating the cache with callback if necessary)
+	explicitLimit, err := d.state.Caches.GTS.DomainLimit.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all limited domains from DB
+		q := d.db.NewSelect().
+			Table("domain_blocks").
+			Column("domain").
+			Where("? = ?", bun.Ident("severity"), gtsmodel.DomainBlockLimit)
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+	if err != nil || !explicitLimit {
+		return false, err
+	}
+
+	if config.GetInstanceFederationMode() == config.InstanceFederationModeAllowlist {
+		// Allowlist mode: an explicit
+		// limit always takes precedence.
+		return true, nil
+	}
+
+	// Blocklist/default mode: explicit allow
+	// takes precedence over explicit limit.
+	explicitAllow, err := d.state.Caches.GTS.DomainAllow.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all explicitly allowed domains from DB
+		q := d.db.NewSelect().
+			Table("domain_allows").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return !explicitAllow, nil
+}
+
 func (d *domainDB) AreDomainsBlocked(ctx context.Context, domains []string) (bool, error) {
 	for _, domain := range domains {
 		if blocked, err := d.IsDomainBlocked(ctx, domain); err != nil {
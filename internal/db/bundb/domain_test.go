@@ -56,6 +56,48 @@ func (suite *DomainTestSuite) TestIsDomainBlocked() {
 	suite.WithinDuration(time.Now(), domainBlock.CreatedAt, 10*time.Second)
 }
 
+func (suite *DomainTestSuite) TestIsDomainLimited() {
+	ctx := context.Background()
+
+	domainBlock := &gtsmodel.DomainBlock{
+		ID:                 "01G204214Y9TNJEBX39C7G88SW",
+		Domain:             "some.bad.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		CreatedByAccount:   suite.testAccounts["admin_account"],
+		Severity:           gtsmodel.DomainBlockLimit,
+	}
+
+	// no domain block exists for the given domain yet
+	limited, err := suite.db.IsDomainLimited(ctx, domainBlock.Domain)
+	suite.NoError(err)
+	suite.False(limited)
+
+	err = suite.db.CreateDomainBlock(ctx, domainBlock)
+	suite.NoError(err)
+
+	// domain (and subdomains) now limited, but not blocked
+	limited, err = suite.db.IsDomainLimited(ctx, "sub."+domainBlock.Domain)
+	suite.NoError(err)
+	suite.True(limited)
+
+	blocked, err := suite.db.IsDomainBlocked(ctx, domainBlock.Domain)
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// upgrade the limit to a suspension
+	domainBlock.Severity = gtsmodel.DomainBlockSuspend
+	err = suite.db.UpdateDomainBlock(ctx, domainBlock, "severity")
+	suite.NoError(err)
+
+	limited, err = suite.db.IsDomainLimited(ctx, domainBlock.Domain)
+	suite.NoError(err)
+	suite.False(limited)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, domainBlock.Domain)
+	suite.NoError(err)
+	suite.True(blocked)
+}
+
 func (suite *DomainTestSuite) TestIsDomainBlockedWithAllow() {
 	ctx := context.Background()
 
@@ -56,6 +56,11 @@ func (e *emojiDB) UpdateEmoji(ctx context.Context, emoji *gtsmodel.Emoji, column
 		columns = append(columns, "updated_at")
 	}
 
+	// Drop any cached copy by ID first, since updated
+	// fields (e.g. shortcode) may form other index keys,
+	// which would otherwise still point at the old model.
+	e.state.Caches.GTS.Emoji.Invalidate("ID", emoji.ID)
+
 	// Update the emoji model in the database.
 	return e.state.Caches.GTS.Emoji.Store(emoji, func() error {
 		_, err := e.db.
@@ -527,6 +532,71 @@ func (e *emojiDB) GetEmojiCategoryByName(ctx context.Context, name string) (*gts
 	)
 }
 
+func (e *emojiDB) UpdateEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory, columns ...string) error {
+	emojiCategory.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Drop any cached copy by ID first, as the name
+	// forms another index key. This also invalidates
+	// cached emojis in the category, which would
+	// otherwise still hold the old category.
+	e.state.Caches.GTS.EmojiCategory.Invalidate("ID", emojiCategory.ID)
+
+	return e.state.Caches.GTS.EmojiCategory.Store(emojiCategory, func() error {
+		_, err := e.db.
+			NewUpdate().
+			Model(emojiCategory).
+			Where("? = ?", bun.Ident("emoji_category.id"), emojiCategory.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (e *emojiDB) DeleteEmojiCategory(ctx context.Context, id string) error {
+	// Load category into cache before attempting a delete,
+	// as we need it cached in order to trigger the invalidate
+	// callback. This in turn invalidates emojis in category.
+	_, err := e.GetEmojiCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	// On return, ensure that category with ID is invalidated.
+	defer e.state.Caches.GTS.EmojiCategory.Invalidate("ID", id)
+
+	return e.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Uncategorize any emojis in this category.
+		if _, err := tx.
+			NewUpdate().
+			Table("emojis").
+			Set("? = NULL", bun.Ident("category_id")).
+			Set("? = ?", bun.Ident("updated_at"), time.Now()).
+			Where("? = ?", bun.Ident("category_id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error updating emojis: %w", err)
+		}
+
+		// Delete the category itself.
+		if _, err := tx.
+			NewDelete().
+			Table("emoji_categories").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting emoji category: %w", err)
+		}
+
+		return nil
+	})
+}
+
 func (e *emojiDB) getEmoji(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Emoji) error, keyParts ...any) (*gtsmodel.Emoji, error) {
 	// Fetch emoji from database cache with loader callback
 	emoji, err := e.state.Caches.GTS.Emoji.LoadOne(lookup, func() (*gtsmodel.Emoji, error) {
@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type followSuggestionDB struct {
+	db       *bun.DB
+	replicas *replicas
+	state    *state.State
+}
+
+func (f *followSuggestionDB) GetFeaturedSuggestions(ctx context.Context) ([]*gtsmodel.FeaturedSuggestion, error) {
+	suggestions := []*gtsmodel.FeaturedSuggestion{}
+
+	if err := f.db.NewSelect().
+		Model(&suggestions).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+func (f *followSuggestionDB) GetFeaturedSuggestionByAccountID(ctx context.Context, accountID string) (*gtsmodel.FeaturedSuggestion, error) {
+	suggestion := new(gtsmodel.FeaturedSuggestion)
+
+	if err := f.db.NewSelect().
+		Model(suggestion).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+func (f *followSuggestionDB) PutFeaturedSuggestion(ctx context.Context, suggestion *gtsmodel.FeaturedSuggestion) error {
+	_, err := f.db.NewInsert().Model(suggestion).Exec(ctx)
+	return err
+}
+
+func (f *followSuggestionDB) DeleteFeaturedSuggestionByAccountID(ctx context.Context, accountID string) error {
+	_, err := f.db.NewDelete().
+		Table("featured_suggestions").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
+func (f *followSuggestionDB) GetFriendsOfFriendsIDs(ctx context.Context, accountID string, limit int) ([]string, error) {
+	var accountIDs []string
+
+	// Select IDs of accounts the
+	// given account already follows.
+	followingQ := f.replicas.Replica().NewSelect().
+		Table("follows").
+		Column("target_account_id").
+		Where("? = ?", bun.Ident("account_id"), accountID)
+
+	// This is an expensive query, as it walks two
+	// hops of the social graph, so run it on a replica.
+	if err := f.replicas.Replica().NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("f1")).
+		ColumnExpr("?", bun.Ident("f2.target_account_id")).
+		// Join on follows of the accounts the given account follows.
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"), bun.Ident("f2"),
+			bun.Ident("f2.account_id"), bun.Ident("f1.target_account_id"),
+		).
+		// Join on those followed accounts to check discoverability.
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("f2.target_account_id"),
+		).
+		Where("? = ?", bun.Ident("f1.account_id"), accountID).
+		Where("? != ?", bun.Ident("f2.target_account_id"), accountID).
+		Where("? NOT IN (?)", bun.Ident("f2.target_account_id"), followingQ).
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		GroupExpr("?", bun.Ident("f2.target_account_id")).
+		// Rank by number of follows that
+		// follow the account, then by
+		// most recently followed.
+		OrderExpr("COUNT(*) DESC").
+		OrderExpr("MAX(?) DESC", bun.Ident("f2.created_at")).
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (f *followSuggestionDB) GetMostFollowedLocalAccountIDs(ctx context.Context, limit int) ([]string, error) {
+	var accountIDs []string
+
+	if err := f.replicas.Replica().NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		ColumnExpr("?", bun.Ident("follow.target_account_id")).
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("follow.target_account_id"),
+		).
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		GroupExpr("?", bun.Ident("follow.target_account_id")).
+		OrderExpr("COUNT(*) DESC").
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (f *followSuggestionDB) GetFollowSuggestionDismissedIDs(ctx context.Context, accountID string) ([]string, error) {
+	var accountIDs []string
+
+	if err := f.db.NewSelect().
+		Table("follow_suggestion_dismissals").
+		Column("target_account_id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (f *followSuggestionDB) PutFollowSuggestionDismissal(ctx context.Context, dismissal *gtsmodel.FollowSuggestionDismissal) error {
+	_, err := f.db.NewInsert().Model(dismissal).Exec(ctx)
+	return err
+}
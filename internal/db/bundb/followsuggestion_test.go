@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type FollowSuggestionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FollowSuggestionTestSuite) TestGetFriendsOfFriendsIDs() {
+	ctx := context.Background()
+
+	// local_account_2 follows local_account_1,
+	// who follows admin_account, so admin_account
+	// should be suggested. local_account_2 itself
+	// shouldn't be, even though local_account_1
+	// follows it back.
+	ids, err := suite.db.GetFriendsOfFriendsIDs(ctx, suite.testAccounts["local_account_2"].ID, 10)
+	suite.NoError(err)
+	suite.Equal([]string{suite.testAccounts["admin_account"].ID}, ids)
+
+	// admin_account follows local_account_1, who
+	// follows only admin_account (self) and
+	// local_account_2, who isn't discoverable.
+	ids, err = suite.db.GetFriendsOfFriendsIDs(ctx, suite.testAccounts["admin_account"].ID, 10)
+	suite.NoError(err)
+	suite.Empty(ids)
+}
+
+func (suite *FollowSuggestionTestSuite) TestGetMostFollowedLocalAccountIDs() {
+	ctx := context.Background()
+
+	// local_account_1 has two followers, admin_account
+	// has one, and local_account_2 isn't discoverable.
+	ids, err := suite.db.GetMostFollowedLocalAccountIDs(ctx, 10)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testAccounts["local_account_1"].ID,
+		suite.testAccounts["admin_account"].ID,
+	}, ids)
+
+	ids, err = suite.db.GetMostFollowedLocalAccountIDs(ctx, 1)
+	suite.NoError(err)
+	suite.Equal([]string{suite.testAccounts["local_account_1"].ID}, ids)
+}
+
+func (suite *FollowSuggestionTestSuite) TestFeaturedSuggestions() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	err := suite.db.PutFeaturedSuggestion(ctx, &gtsmodel.FeaturedSuggestion{
+		ID:                 id.NewULID(),
+		AccountID:          account.ID,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.NoError(err)
+
+	// Featuring the same account twice should fail.
+	err = suite.db.PutFeaturedSuggestion(ctx, &gtsmodel.FeaturedSuggestion{
+		ID:                 id.NewULID(),
+		AccountID:          account.ID,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	featured, err := suite.db.GetFeaturedSuggestions(ctx)
+	suite.NoError(err)
+	suite.Len(featured, 1)
+	suite.Equal(account.ID, featured[0].AccountID)
+
+	err = suite.db.DeleteFeaturedSuggestionByAccountID(ctx, account.ID)
+	suite.NoError(err)
+
+	_, err = suite.db.GetFeaturedSuggestionByAccountID(ctx, account.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FollowSuggestionTestSuite) TestFollowSuggestionDismissals() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_2"]
+	target := suite.testAccounts["admin_account"]
+
+	ids, err := suite.db.GetFollowSuggestionDismissedIDs(ctx, account.ID)
+	suite.NoError(err)
+	suite.Empty(ids)
+
+	err = suite.db.PutFollowSuggestionDismissal(ctx, &gtsmodel.FollowSuggestionDismissal{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: target.ID,
+	})
+	suite.NoError(err)
+
+	ids, err = suite.db.GetFollowSuggestionDismissedIDs(ctx, account.ID)
+	suite.NoError(err)
+	suite.Equal([]string{target.ID}, ids)
+}
+
+func TestFollowSuggestionTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowSuggestionTestSuite))
+}
@@ -101,7 +101,7 @@ func (h *headerFilterDB) PutAllowHeaderFilter(ctx context.Context, filter *gtsmo
 		Exec(ctx); err != nil {
 		return err
 	}
-	h.state.Caches.AllowHeaderFilters.Clear()
+	h.state.Caches.ClearAllowHeaderFilters()
 	return nil
 }
 
@@ -111,7 +111,7 @@ func (h *headerFilterDB) PutBlockHeaderFilter(ctx context.Context, filter *gtsmo
 		Exec(ctx); err != nil {
 		return err
 	}
-	h.state.Caches.BlockHeaderFilters.Clear()
+	h.state.Caches.ClearBlockHeaderFilters()
 	return nil
 }
 
@@ -129,7 +129,7 @@ func (h *headerFilterDB) UpdateAllowHeaderFilter(ctx context.Context, filter *gt
 		Exec(ctx); err != nil {
 		return err
 	}
-	h.state.Caches.AllowHeaderFilters.Clear()
+	h.state.Caches.ClearAllowHeaderFilters()
 	return nil
 }
 
@@ -147,7 +147,7 @@ func (h *headerFilterDB) UpdateBlockHeaderFilter(ctx context.Context, filter *gt
 		Exec(ctx); err != nil {
 		return err
 	}
-	h.state.Caches.BlockHeaderFilters.Clear()
+	h.state.Caches.ClearBlockHeaderFilters()
 	return nil
 }
 
@@ -158,7 +158,7 @@ func (h *headerFilterDB) DeleteAllowHeaderFilter(ctx context.Context, id string)
 		Exec(ctx); err != nil {
 		return err
 	}
-	h.state.Caches.AllowHeaderFilters.Clear()
+	h.state.Caches.ClearAllowHeaderFilters()
 	return nil
 }
 
@@ -169,7 +169,7 @@ func (h *headerFilterDB) DeleteBlockHeaderFilter(ctx context.Context, id string)
 		Exec(ctx); err != nil {
 		return err
 	}
-	h.state.Caches.BlockHeaderFilters.Clear()
+	h.state.Caches.ClearBlockHeaderFilters()
 	return nil
 }
 
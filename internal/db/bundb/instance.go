@@ -19,6 +19,7 @@ package bundb
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -28,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -46,7 +48,7 @@ func (i *instanceDB) CountInstanceUsers(ctx context.Context, domain string) (int
 		Where("? != ?", bun.Ident("account.username"), domain).
 		Where("? IS NULL", bun.Ident("account.suspended_at"))
 
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if config.IsLocalDomain(domain) {
 		// If the domain is *this* domain, just
 		// count where the domain field is null.
 		q = q.Where("? IS NULL", bun.Ident("account.domain"))
@@ -66,7 +68,7 @@ func (i *instanceDB) CountInstanceStatuses(ctx context.Context, domain string) (
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status"))
 
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if config.IsLocalDomain(domain) {
 		// if the domain is *this* domain, just count where local is true
 		q = q.Where("? = ?", bun.Ident("status.local"), true)
 	} else {
@@ -250,6 +252,51 @@ func (i *instanceDB) UpdateInstance(ctx context.Context, instance *gtsmodel.Inst
 	})
 }
 
+func (i *instanceDB) GetInstanceSettings(ctx context.Context, instanceID string) (*gtsmodel.InstanceSettings, error) {
+	// Fetch settings from db cache with loader callback.
+	return i.state.Caches.GTS.InstanceSettings.LoadOne(
+		"InstanceID",
+		func() (*gtsmodel.InstanceSettings, error) {
+			// Not cached! Perform database query.
+			var settings gtsmodel.InstanceSettings
+			if err := i.db.
+				NewSelect().
+				Model(&settings).
+				Where("? = ?", bun.Ident("instance_settings.instance_id"), instanceID).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+			return &settings, nil
+		},
+		instanceID,
+	)
+}
+
+func (i *instanceDB) PutInstanceSettings(ctx context.Context, settings *gtsmodel.InstanceSettings) error {
+	return i.state.Caches.GTS.InstanceSettings.Store(settings, func() error {
+		_, err := i.db.NewInsert().Model(settings).Exec(ctx)
+		return err
+	})
+}
+
+func (i *instanceDB) UpdateInstanceSettings(ctx context.Context, settings *gtsmodel.InstanceSettings, columns ...string) error {
+	// Update the settings' last-updated
+	settings.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	return i.state.Caches.GTS.InstanceSettings.Store(settings, func() error {
+		_, err := i.db.
+			NewUpdate().
+			Model(settings).
+			Where("? = ?", bun.Ident("instance_settings.instance_id"), settings.InstanceID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (i *instanceDB) GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, error) {
 	instanceIDs := []string{}
 
@@ -290,6 +337,22 @@ func (i *instanceDB) GetInstancePeers(ctx context.Context, includeSuspended bool
 	return instances, nil
 }
 
+func (i *instanceDB) CountInstanceAccounts(ctx context.Context, domain string) (int, error) {
+	// Normalize the domain as punycode.
+	var err error
+	domain, err = util.Punify(domain)
+	if err != nil {
+		return 0, gtserror.Newf("error punifying domain %s: %w", domain, err)
+	}
+
+	return i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? = ?", bun.Ident("account.domain"), domain).
+		Count(ctx)
+}
+
 func (i *instanceDB) GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, error) {
 	// Ensure reasonable
 	if limit < 0 {
@@ -350,6 +413,90 @@ func (i *instanceDB) GetInstanceAccounts(ctx context.Context, domain string, max
 	return accounts, nil
 }
 
+func (i *instanceDB) GetInstanceAccountsPage(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.Account, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+	)
+
+	// Normalize the domain as punycode.
+	var err error
+	domain, err = util.Punify(domain)
+	if err != nil {
+		return nil, gtserror.Newf("error punifying domain %s: %w", domain, err)
+	}
+
+	// Make educated guess for slice size
+	accountIDs := make([]string, 0, limit)
+
+	q := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		// Select just the account ID.
+		Column("account.id").
+		// Select accounts belonging to given domain.
+		Where("? = ?", bun.Ident("account.domain"), domain)
+
+	// Return only accounts with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account.id"), maxID)
+	}
+
+	// Return only accounts with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("account.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// accounts returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("account.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("account.id"))
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no accounts early.
+	if len(accountIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want accounts
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(accountIDs)
+	}
+
+	// Select each account by its ID.
+	accounts := make([]*gtsmodel.Account, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		account, err := i.state.DB.GetAccountByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting account %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice.
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 func (i *instanceDB) GetInstanceModeratorAddresses(ctx context.Context) ([]string, error) {
 	addresses := []string{}
 
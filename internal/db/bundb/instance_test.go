@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -92,6 +93,23 @@ func (suite *InstanceTestSuite) TestGetInstanceAccounts() {
 	suite.Len(accounts, 1)
 }
 
+func (suite *InstanceTestSuite) TestGetInstanceAccountsPage() {
+	ctx := context.Background()
+
+	accounts, err := suite.db.GetInstanceAccountsPage(ctx, "fossbros-anonymous.io", &paging.Page{
+		Limit: 10,
+	})
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+
+	// Paging down from the only account should return nothing.
+	_, err = suite.db.GetInstanceAccountsPage(ctx, "fossbros-anonymous.io", &paging.Page{
+		Max:   paging.MaxID(accounts[0].ID),
+		Limit: 10,
+	})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *InstanceTestSuite) TestGetInstanceModeratorAddressesOK() {
 	// We have one admin user by default.
 	addresses, err := suite.db.GetInstanceModeratorAddresses(context.Background())
@@ -98,9 +98,27 @@ func (m *mediaDB) getAttachment(ctx context.Context, lookup string, dbQuery func
 }
 
 func (m *mediaDB) PutAttachment(ctx context.Context, media *gtsmodel.MediaAttachment) error {
+	size := quotaMediaSize(media)
+	if size != 0 {
+		// On return, ensure stats for owning
+		// account are invalidated, as we'll
+		// have updated media used directly.
+		defer m.state.Caches.GTS.AccountStats.Invalidate("AccountID", media.AccountID)
+	}
+
 	return m.state.Caches.GTS.Media.Store(media, func() error {
-		_, err := m.db.NewInsert().Model(media).Exec(ctx)
-		return err
+		return m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.NewInsert().Model(media).Exec(ctx); err != nil {
+				return err
+			}
+
+			if size != 0 {
+				// Count new media towards account quota.
+				return addAccountMediaUsed(ctx, tx, media.AccountID, size)
+			}
+
+			return nil
+		})
 	})
 }
 
@@ -137,6 +155,14 @@ func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 	// On return, ensure that media with ID is invalidated.
 	defer m.state.Caches.GTS.Media.Invalidate("ID", id)
 
+	size := quotaMediaSize(media)
+	if size != 0 {
+		// On return, also ensure stats for owning
+		// account are invalidated, as we'll have
+		// updated media used directly.
+		defer m.state.Caches.GTS.AccountStats.Invalidate("AccountID", media.AccountID)
+	}
+
 	// Delete media attachment in new transaction.
 	err = m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		if media.AccountID != "" {
@@ -206,6 +232,13 @@ func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 			}
 		}
 
+		if size != 0 {
+			// Remove media from account quota.
+			if err := addAccountMediaUsed(ctx, tx, media.AccountID, -size); err != nil {
+				return gtserror.Newf("error updating account stats: %w", err)
+			}
+		}
+
 		// Finally delete this media.
 		if _, err := tx.NewDelete().
 			Table("media_attachments").
@@ -220,6 +253,38 @@ func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 	return err
 }
 
+// quotaMediaSize returns the size in bytes that given media
+// counts towards its owning account's media quota. This is
+// the original + thumbnail size of cached local media, and
+// 0 for remote media, which never counts towards quota.
+//
+// Must be kept in line with mediaDB{}.GetAccountMediaSize().
+func quotaMediaSize(media *gtsmodel.MediaAttachment) int64 {
+	if media.AccountID == "" ||
+		media.RemoteURL != "" ||
+		!util.PtrValueOr(media.Cached, false) {
+		return 0
+	}
+	return int64(media.File.FileSize + media.Thumbnail.FileSize)
+}
+
+// addAccountMediaUsed adds delta bytes to the media used stat
+// of account with given ID, clamping the result to zero. This
+// is done directly in the database to avoid racing updates,
+// so the caller must invalidate the cached account stats.
+//
+// If account has no stats yet this is a no-op, as the media
+// will be included when stats are first counted.
+func addAccountMediaUsed(ctx context.Context, tx bun.IDB, accountID string, delta int64) error {
+	col := bun.Ident("media_used_bytes")
+	_, err := tx.NewUpdate().
+		Table("account_stats").
+		Set("? = CASE WHEN ? + ? < 0 THEN 0 ELSE ? + ? END", col, col, delta, col, delta).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
 func (m *mediaDB) GetAttachments(ctx context.Context, page *paging.Page) ([]*gtsmodel.MediaAttachment, error) {
 	maxID := page.GetMax()
 	limit := page.GetLimit()
@@ -295,3 +360,26 @@ func (m *mediaDB) GetCachedAttachmentsOlderThan(ctx context.Context, olderThan t
 
 	return m.GetAttachmentsByIDs(ctx, attachmentIDs)
 }
+
+func (m *mediaDB) GetAccountMediaSize(ctx context.Context, accountID string) (int64, error) {
+	var size int64
+
+	// SELECT COALESCE(SUM("file_file_size" + "thumbnail_file_size"), 0)
+	// FROM "media_attachments"
+	// WHERE ("account_id" = ?) AND ("cached" = true) AND ("remote_url" IS NULL)
+	if err := m.db.
+		NewSelect().
+		Table("media_attachments").
+		ColumnExpr("COALESCE(SUM(? + ?), 0)",
+			bun.Ident("file_file_size"),
+			bun.Ident("thumbnail_file_size"),
+		).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? = ?", bun.Ident("cached"), true).
+		Where("? IS NULL", bun.Ident("remote_url")).
+		Scan(ctx, &size); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
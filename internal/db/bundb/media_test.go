@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type MediaTestSuite struct {
@@ -50,6 +51,85 @@ func (suite *MediaTestSuite) TestGetCachedAttachmentsOlderThan() {
 	suite.Len(attachments, 3)
 }
 
+func (suite *MediaTestSuite) TestGetAccountMediaSize() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	// Sum up expected size from
+	// cached test attachments.
+	var expected int64
+	for _, attachment := range suite.testAttachments {
+		if attachment.AccountID == account.ID && *attachment.Cached {
+			expected += int64(attachment.File.FileSize + attachment.Thumbnail.FileSize)
+		}
+	}
+	suite.NotZero(expected)
+
+	size, err := suite.db.GetAccountMediaSize(ctx, account.ID)
+	suite.NoError(err)
+	suite.Equal(expected, size)
+
+	// Account with no media.
+	size, err = suite.db.GetAccountMediaSize(ctx, suite.testAccounts["unconfirmed_account"].ID)
+	suite.NoError(err)
+	suite.Zero(size)
+}
+
+func (suite *MediaTestSuite) TestAccountMediaUsedStats() {
+	ctx := context.Background()
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["local_account_1"]
+
+	mediaUsed := func() int64 {
+		stats, err := suite.db.GetAccountStats(ctx, account.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *stats.MediaUsedBytes
+	}
+
+	// Generate stats, which will
+	// count existing media used.
+	if err := suite.db.RegenerateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	expected, err := suite.db.GetAccountMediaSize(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(expected, mediaUsed())
+
+	// Put a new local attachment,
+	// this should add to media used.
+	attachment := new(gtsmodel.MediaAttachment)
+	*attachment = *suite.testAttachments["local_account_1_unattached_1"]
+	attachment.ID = "01J2M1SQTVPA6ENQ4Y4ZVZF4XA"
+	if err := suite.db.PutAttachment(ctx, attachment); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	size := int64(attachment.File.FileSize + attachment.Thumbnail.FileSize)
+	suite.Equal(expected+size, mediaUsed())
+
+	// Deleting it should take it away again.
+	if err := suite.db.DeleteAttachment(ctx, attachment.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(expected, mediaUsed())
+
+	// Remote media cached for the
+	// account should never count.
+	remote := new(gtsmodel.MediaAttachment)
+	*remote = *attachment
+	remote.ID = "01J2M1WCKBX0JCB0R7A1Q41ZAE"
+	remote.RemoteURL = "http://example.org/media/whatever.jpg"
+	if err := suite.db.PutAttachment(ctx, remote); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(expected, mediaUsed())
+}
+
 func TestMediaTestSuite(t *testing.T) {
 	suite.Run(t, new(MediaTestSuite))
 }
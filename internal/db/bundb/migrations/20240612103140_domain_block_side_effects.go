@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		for _, column := range []struct {
+			name string
+			expr string
+		}{
+			{"side_effects_cursor", "CHAR(26)"},
+			{"side_effects_processed", "INTEGER NOT NULL DEFAULT 0"},
+			{"side_effects_total", "INTEGER NOT NULL DEFAULT 0"},
+			{"side_effects_completed_at", "TIMESTAMPTZ"},
+		} {
+			// Add each new side effects
+			// column to domain blocks table.
+			_, err := db.ExecContext(ctx,
+				"ALTER TABLE ? ADD COLUMN ? "+column.expr,
+				bun.Ident("domain_blocks"),
+				bun.Ident(column.name),
+			)
+			if err != nil && !(strings.Contains(err.Error(), "already exists") ||
+				strings.Contains(err.Error(), "duplicate column name") ||
+				strings.Contains(err.Error(), "SQLSTATE 42701")) {
+				return err
+			}
+		}
+
+		// Side effects of existing domain blocks were
+		// processed in one go on creation, so assume
+		// they're complete rather than resuming them.
+		_, err := db.NewUpdate().
+			Table("domain_blocks").
+			Set("? = ?", bun.Ident("side_effects_completed_at"), bun.Ident("created_at")).
+			Where("? IS NULL", bun.Ident("side_effects_completed_at")).
+			Exec(ctx)
+		return err
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "adding account status indexes; this may take some time, please be patient and don't interrupt this!")
+
+			// Partial indexes on account origin (local accounts
+			// have no domain) for suspended / silenced accounts,
+			// as used when filtering the admin accounts view.
+			//
+			// A plain index on the timestamp columns isn't picked
+			// by the query planner for `IS NOT NULL` conditions,
+			// whereas a partial index with the same condition is.
+			//
+			// No (created_at, id) index is needed for paging: the
+			// admin accounts view already pages by keyset, on the
+			// `[domain]/@[username]` expression indexed by
+			// accounts_paging_idx. That key is unique per account
+			// and doesn't depend on a cursor row still existing,
+			// so pages stay stable when accounts are added or
+			// removed, and the page bounds given out in the Link
+			// header (and relied on by the settings panel) keep
+			// their current meaning.
+			for index, column := range map[string]string{
+				"accounts_suspended_domain_idx": "suspended_at",
+				"accounts_silenced_domain_idx":  "silenced_at",
+			} {
+				if _, err := tx.
+					NewCreateIndex().
+					Table("accounts").
+					Index(index).
+					Column("domain").
+					Where("? IS NOT NULL", bun.Ident(column)).
+					IfNotExists().
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, column := range []struct {
+				table string
+				name  string
+			}{
+				{"applications", "revoked_at"},
+				{"tokens", "last_used"},
+			} {
+				// Add each new timestamp column.
+				_, err := tx.ExecContext(ctx,
+					"ALTER TABLE ? ADD COLUMN ? TIMESTAMPTZ",
+					bun.Ident(column.table),
+					bun.Ident(column.name),
+				)
+				if err != nil && !(strings.Contains(err.Error(), "already exists") ||
+					strings.Contains(err.Error(), "duplicate column name") ||
+					strings.Contains(err.Error(), "SQLSTATE 42701")) {
+					return err
+				}
+			}
+
+			// Index tokens by client ID, for
+			// counting and revoking an app's tokens.
+			if _, err := tx.
+				NewCreateIndex().
+				Model(&gtsmodel.Token{}).
+				Index("tokens_client_id_idx").
+				Column("client_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Create new application blocks table.
+			if _, err := tx.
+				NewCreateTable().
+				Model(&gtsmodel.ApplicationBlock{}).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,265 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	text "github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations/20240702100000_tag_folding"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Add display name column to tags table.
+			_, err := tx.ExecContext(ctx,
+				"ALTER TABLE ? ADD COLUMN ? TEXT",
+				bun.Ident("tags"),
+				bun.Ident("display_name"),
+			)
+			if err != nil && !(strings.Contains(err.Error(), "already exists") ||
+				strings.Contains(err.Error(), "duplicate column name") ||
+				strings.Contains(err.Error(), "SQLSTATE 42701")) {
+				return err
+			}
+
+			// Page through existing tags, oldest first,
+			// so the oldest of any tags that fold to the
+			// same name is the one that's kept.
+			const batchSize = 500
+			var (
+				tags   []tagName
+				lastID string
+
+				// IDs of newer tags already merged
+				// into an older one, to skip them.
+				merged = make(map[string]struct{})
+			)
+
+			for {
+				tags = tags[:0]
+
+				q := tx.NewSelect().
+					Table("tags").
+					Column("id", "name").
+					Order("id ASC").
+					Limit(batchSize)
+
+				if lastID != "" {
+					q = q.Where("? > ?", bun.Ident("id"), lastID)
+				}
+
+				if err := q.Scan(ctx, &tags); err != nil {
+					return err
+				}
+
+				if len(tags) == 0 {
+					// Done.
+					break
+				}
+
+				for _, tag := range tags {
+					if _, ok := merged[tag.ID]; ok {
+						// Already merged.
+						continue
+					}
+
+					mergedID, err := foldTag(ctx, tx, tag)
+					if err != nil {
+						return err
+					}
+
+					if mergedID != "" {
+						merged[mergedID] = struct{}{}
+					}
+				}
+
+				lastID = tags[len(tags)-1].ID
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
+
+// tagName is an existing tag row,
+// as selected by the migration.
+type tagName struct {
+	ID   string `bun:"id"`
+	Name string `bun:"name"`
+}
+
+// foldTag folds the name of the given tag, merging it with any
+// tag which already has the folded name. Tags are folded oldest
+// first, so the older of the two tags is kept. As tag names are
+// unique, at most one other tag can have the folded name: either
+// an older tag already folded by this migration, or a newer tag
+// whose name needed no folding. In the latter case, the ID of
+// the newer tag that was merged into this one is returned.
+func foldTag(ctx context.Context, tx bun.Tx, tag tagName) (string, error) {
+	name := text.FoldHashtag(tag.Name)
+
+	// Look for any other tag
+	// with the folded name.
+	var otherIDs []string
+	if err := tx.NewSelect().
+		Table("tags").
+		Column("id").
+		Where("? = ?", bun.Ident("name"), name).
+		Where("? != ?", bun.Ident("id"), tag.ID).
+		Scan(ctx, &otherIDs); err != nil {
+		return "", err
+	}
+
+	var mergedID string
+	if len(otherIDs) != 0 {
+		otherID := otherIDs[0]
+
+		if otherID < tag.ID {
+			// Older tag with this name
+			// is kept, merge this one.
+			log.Infof(ctx, "merging duplicate tag %s into tag %s (%s)", tag.ID, otherID, name)
+			return "", mergeTags(ctx, tx, otherID, []string{tag.ID})
+		}
+
+		// This tag is older, so keep it and merge the newer
+		// one, freeing up the folded name for this tag.
+		log.Infof(ctx, "merging duplicate tag %s into tag %s (%s)", otherID, tag.ID, name)
+		if err := mergeTags(ctx, tx, tag.ID, []string{otherID}); err != nil {
+			return "", err
+		}
+		mergedID = otherID
+	}
+
+	if tag.Name == name {
+		// Already folded.
+		return mergedID, nil
+	}
+
+	// Store folded name on the kept tag,
+	// keeping its old name for display.
+	_, err := tx.NewUpdate().
+		Table("tags").
+		Set("? = ?", bun.Ident("name"), name).
+		Set("? = ?", bun.Ident("display_name"), tag.Name).
+		Where("? = ?", bun.Ident("id"), tag.ID).
+		Exec(ctx)
+	return mergedID, err
+}
+
+// mergeTags repoints statuses using any of the duplicate
+// tags with dupIDs to the tag with keepID, and then deletes
+// the duplicate tags.
+func mergeTags(ctx context.Context, tx bun.Tx, keepID string, dupIDs []string) error {
+	// Get IDs of all statuses
+	// using any of the duplicates.
+	var statusIDs []string
+	if err := tx.NewSelect().
+		Table("status_to_tags").
+		Column("status_id").
+		Where("? IN (?)", bun.Ident("tag_id"), bun.In(dupIDs)).
+		Scan(ctx, &statusIDs); err != nil {
+		return err
+	}
+	slices.Sort(statusIDs)
+	statusIDs = slices.Compact(statusIDs)
+
+	// Repoint status_to_tags entries from each duplicate to
+	// the kept tag, first deleting entries for statuses which
+	// already use the kept tag, as repointing those would
+	// violate the unique index on status ID + tag ID.
+	for _, dupID := range dupIDs {
+		if _, err := tx.NewDelete().
+			Table("status_to_tags").
+			Where("? = ?", bun.Ident("tag_id"), dupID).
+			Where("? IN (?)", bun.Ident("status_id"),
+				tx.NewSelect().
+					Table("status_to_tags").
+					Column("status_id").
+					Where("? = ?", bun.Ident("tag_id"), keepID),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewUpdate().
+			Table("status_to_tags").
+			Set("? = ?", bun.Ident("tag_id"), keepID).
+			Where("? = ?", bun.Ident("tag_id"), dupID).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Rewrite the tag IDs
+	// stored on each status.
+	for _, statusID := range statusIDs {
+		var status struct {
+			bun.BaseModel `bun:"table:statuses"`
+			ID            string   `bun:",pk"`
+			TagIDs        []string `bun:"tags,array"`
+		}
+		if err := tx.NewSelect().
+			Model(&status).
+			Column("id", "tags").
+			Where("? = ?", bun.Ident("id"), statusID).
+			Scan(ctx); err != nil {
+			return err
+		}
+
+		tagIDs := make([]string, 0, len(status.TagIDs))
+		for _, id := range status.TagIDs {
+			if slices.Contains(dupIDs, id) {
+				id = keepID
+			}
+			if !slices.Contains(tagIDs, id) {
+				tagIDs = append(tagIDs, id)
+			}
+		}
+		status.TagIDs = tagIDs
+
+		if _, err := tx.NewUpdate().
+			Model(&status).
+			Column("tags").
+			WherePK().
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Finally delete the duplicate tags.
+	_, err := tx.NewDelete().
+		Table("tags").
+		Where("? IN (?)", bun.Ident("id"), bun.In(dupIDs)).
+		Exec(ctx)
+	return err
+}
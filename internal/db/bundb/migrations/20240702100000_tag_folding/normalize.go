@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// FoldHashtag is a frozen copy of text.FoldHashtag as it was when
+// the tag folding migration was written, so that the migration
+// folds existing tags the same way, even if the live folding
+// logic changes in future (which would need its own migration).
+func FoldHashtag(name string) string {
+	name = strings.TrimPrefix(name, "#")
+	name = norm.NFKC.String(name)
+	name = cases.Fold().String(name)
+
+	// Decompose the folded name so that
+	// combining marks can be considered
+	// separately from their base letters.
+	decomposed := norm.NFD.String(name)
+
+	var (
+		b     strings.Builder
+		latin bool // prev base char was Latin
+	)
+
+	b.Grow(len(decomposed))
+	for _, r := range decomposed {
+		if unicode.IsMark(r) {
+			if !latin {
+				// Keep marks on
+				// non-Latin chars.
+				b.WriteRune(r)
+			}
+			continue
+		}
+
+		latin = unicode.Is(unicode.Latin, r)
+		if isPermittedInHashtag(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	// Recompose any remaining marks.
+	return norm.NFC.String(b.String())
+}
+
+func isPermittedInHashtag(r rune) bool {
+	return unicode.IsLetter(r) ||
+		unicode.IsNumber(r) ||
+		r == '_'
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -202,6 +203,7 @@ func (n *notificationDB) GetAccountNotifications(
 	limit int,
 	types []string,
 	excludeTypes []string,
+	includeDismissed bool,
 ) ([]*gtsmodel.Notification, error) {
 	// Ensure reasonable
 	if limit < 0 {
@@ -251,6 +253,11 @@ func (n *notificationDB) GetAccountNotifications(
 	// Return only notifs for this account.
 	q = q.Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
 
+	if !includeDismissed {
+		// Filter out dismissed notifs.
+		q = q.Where("? = ?", bun.Ident("notification.dismissed"), false)
+	}
+
 	if limit > 0 {
 		q = q.Limit(limit)
 	}
@@ -284,6 +291,48 @@ func (n *notificationDB) GetAccountNotifications(
 	return n.GetNotificationsByIDs(ctx, notifIDs)
 }
 
+func (n *notificationDB) CountAccountNotificationsSince(
+	ctx context.Context,
+	accountID string,
+	sinceID string,
+	limit int,
+	types []string,
+	excludeTypes []string,
+) (int, error) {
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Column("notification.id").
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Where("? = ?", bun.Ident("notification.dismissed"), false)
+
+	if sinceID != "" {
+		// Count only notifs HIGHER (ie., newer) than sinceID.
+		q = q.Where("? > ?", bun.Ident("notification.id"), sinceID)
+	}
+
+	if len(types) > 0 {
+		// Include only requested notification types.
+		q = q.Where("? IN (?)", bun.Ident("notification.notification_type"), bun.In(types))
+	}
+
+	if len(excludeTypes) > 0 {
+		// Filter out unwanted notif types.
+		q = q.Where("? NOT IN (?)", bun.Ident("notification.notification_type"), bun.In(excludeTypes))
+	}
+
+	if limit > 0 {
+		// Stop counting at limit, by
+		// counting a limited subquery.
+		q = q.Limit(limit)
+	}
+
+	return n.db.
+		NewSelect().
+		TableExpr("(?) AS ?", q, bun.Ident("notifications")).
+		Count(ctx)
+}
+
 func (n *notificationDB) PutNotification(ctx context.Context, notif *gtsmodel.Notification) error {
 	return n.state.Caches.GTS.Notification.Store(notif, func() error {
 		_, err := n.db.NewInsert().Model(notif).Exec(ctx)
@@ -291,6 +340,52 @@ func (n *notificationDB) PutNotification(ctx context.Context, notif *gtsmodel.No
 	})
 }
 
+func (n *notificationDB) UpdateNotification(ctx context.Context, notif *gtsmodel.Notification, columns ...string) error {
+	notif.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return n.state.Caches.GTS.Notification.Store(notif, func() error {
+		_, err := n.db.NewUpdate().
+			Model(notif).
+			Where("? = ?", bun.Ident("notification.id"), notif.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (n *notificationDB) DismissNotifications(ctx context.Context, targetAccountID string, maxID string) error {
+	q := n.db.
+		NewUpdate().
+		Table("notifications").
+		Set("? = ?", bun.Ident("dismissed"), true).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("dismissed"), false)
+
+	if maxID != "" {
+		// Dismiss only notifs LOWER (ie., older)
+		// than or equal to maxID.
+		q = q.Where("? <= ?", bun.Ident("id"), maxID)
+	}
+
+	var notifIDs []string
+	q = q.Returning("?", bun.Ident("id"))
+
+	// Dismiss in DB.
+	if _, err := q.
+		Exec(ctx, &notifIDs); err != nil {
+		return err
+	}
+
+	// Invalidate all dismissed notifications by IDs.
+	n.state.Caches.GTS.Notification.InvalidateIDs("ID", notifIDs)
+	return nil
+}
+
 func (n *notificationDB) DeleteNotificationByID(ctx context.Context, id string) error {
 	// Delete notif from DB.
 	if _, err := n.db.
@@ -71,6 +71,7 @@ func (suite *NotificationTestSuite) spamNotifs() {
 			OriginAccountID:  originAccountID,
 			StatusID:         statusID,
 			Read:             util.Ptr(false),
+			Dismissed:        util.Ptr(false),
 		}
 
 		if err := suite.db.PutNotification(context.Background(), notif); err != nil {
@@ -98,6 +99,7 @@ func (suite *NotificationTestSuite) TestGetAccountNotificationsWithSpam() {
 		20,
 		nil,
 		nil,
+		false,
 	)
 	suite.NoError(err)
 	timeTaken := time.Since(before)
@@ -121,6 +123,7 @@ func (suite *NotificationTestSuite) TestGetAccountNotificationsWithoutSpam() {
 		20,
 		nil,
 		nil,
+		false,
 	)
 	suite.NoError(err)
 	timeTaken := time.Since(before)
@@ -146,6 +149,7 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsWithSpam() {
 		20,
 		nil,
 		nil,
+		false,
 	)
 	if err != nil {
 		suite.FailNow(err.Error())
@@ -167,6 +171,7 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsWithSpam() {
 		20,
 		nil,
 		nil,
+		false,
 	)
 	if err != nil {
 		suite.FailNow(err.Error())
@@ -189,6 +194,7 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsWithTwoAccounts() {
 		20,
 		nil,
 		nil,
+		false,
 	)
 	suite.NoError(err)
 	suite.Nil(notifications)
@@ -263,6 +269,94 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsPertainingToStatusID(
 	}
 }
 
+func (suite *NotificationTestSuite) TestDismissNotifications() {
+	suite.spamNotifs()
+	var (
+		ctx         = gtscontext.SetBarebones(context.Background())
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	notifications, err := suite.db.GetAccountNotifications(ctx, testAccount.ID, "", "", "", 20, nil, nil, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(notifications, 20)
+
+	// Dismiss all but the newest 5 notifs.
+	maxID := notifications[5].ID
+	if err := suite.db.DismissNotifications(context.Background(), testAccount.ID, maxID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the newest 5 should be returned by default.
+	notifications, err = suite.db.GetAccountNotifications(ctx, testAccount.ID, "", "", "", 20, nil, nil, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(notifications, 5)
+	for _, n := range notifications {
+		suite.Greater(n.ID, maxID)
+		suite.False(*n.Dismissed)
+	}
+
+	// Dismissed notifs should still be there if requested.
+	notifications, err = suite.db.GetAccountNotifications(ctx, testAccount.ID, "", "", "", 20, nil, nil, true)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(notifications, 20)
+	suite.True(*notifications[5].Dismissed)
+
+	// Dismissing everything should leave nothing.
+	if err := suite.db.DismissNotifications(context.Background(), testAccount.ID, ""); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	notifications, err = suite.db.GetAccountNotifications(ctx, testAccount.ID, "", "", "", 20, nil, nil, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(notifications)
+}
+
+func (suite *NotificationTestSuite) TestCountAccountNotificationsSince() {
+	suite.spamNotifs()
+	var (
+		ctx         = gtscontext.SetBarebones(context.Background())
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	notifications, err := suite.db.GetAccountNotifications(ctx, testAccount.ID, "", "", "", 20, nil, nil, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Count notifs newer than the 10th newest.
+	count, err := suite.db.CountAccountNotificationsSince(ctx, testAccount.ID, notifications[9].ID, 0, nil, nil)
+	suite.NoError(err)
+	suite.Equal(9, count)
+
+	// Count should stop at the limit.
+	count, err = suite.db.CountAccountNotificationsSince(ctx, testAccount.ID, "", 100, nil, nil)
+	suite.NoError(err)
+	suite.Equal(100, count)
+
+	// All of the account's notifs are faves,
+	// so excluding faves should count none.
+	count, err = suite.db.CountAccountNotificationsSince(ctx, testAccount.ID, "", 0, nil, []string{string(gtsmodel.NotificationFave)})
+	suite.NoError(err)
+	suite.Zero(count)
+
+	// Dismissed notifs shouldn't be counted.
+	if err := suite.db.DismissNotifications(context.Background(), testAccount.ID, notifications[5].ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	count, err = suite.db.CountAccountNotificationsSince(ctx, testAccount.ID, notifications[9].ID, 0, nil, nil)
+	suite.NoError(err)
+	suite.Equal(5, count)
+}
+
 func TestNotificationTestSuite(t *testing.T) {
 	suite.Run(t, new(NotificationTestSuite))
 }
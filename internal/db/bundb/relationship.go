@@ -179,7 +179,7 @@ func (r *relationshipDB) GetAccountBlocks(ctx context.Context, accountID string,
 }
 
 func (r *relationshipDB) GetAccountFollowIDs(ctx context.Context, accountID string, page *paging.Page) ([]string, error) {
-	return loadPagedIDs(&r.state.Caches.GTS.FollowIDs, ">"+accountID, page, func() ([]string, error) {
+	return loadPagedIDsKeyset(&r.state.Caches.GTS.FollowIDs, ">"+accountID, page, func() ([]string, error) {
 		var followIDs []string
 
 		// Follow IDs not in cache, perform DB query!
@@ -189,6 +189,26 @@ func (r *relationshipDB) GetAccountFollowIDs(ctx context.Context, accountID stri
 			return nil, err
 		}
 
+		return followIDs, nil
+	}, func(page *paging.Page) ([]string, error) {
+		followIDs := make([]string, 0, page.GetLimit())
+
+		// Select only requested page of follow IDs.
+		q := r.db.
+			NewSelect().
+			Table("follows").
+			Column("id").
+			Where("? = ?", bun.Ident("account_id"), accountID)
+
+		q, err := whereFollowPage(ctx, q, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := q.Scan(ctx, &followIDs); err != nil {
+			return nil, err
+		}
+
 		return followIDs, nil
 	})
 }
@@ -209,7 +229,7 @@ func (r *relationshipDB) GetAccountLocalFollowIDs(ctx context.Context, accountID
 }
 
 func (r *relationshipDB) GetAccountFollowerIDs(ctx context.Context, accountID string, page *paging.Page) ([]string, error) {
-	return loadPagedIDs(&r.state.Caches.GTS.FollowIDs, "<"+accountID, page, func() ([]string, error) {
+	return loadPagedIDsKeyset(&r.state.Caches.GTS.FollowIDs, "<"+accountID, page, func() ([]string, error) {
 		var followIDs []string
 
 		// Follow IDs not in cache, perform DB query!
@@ -219,6 +239,26 @@ func (r *relationshipDB) GetAccountFollowerIDs(ctx context.Context, accountID st
 			return nil, err
 		}
 
+		return followIDs, nil
+	}, func(page *paging.Page) ([]string, error) {
+		followIDs := make([]string, 0, page.GetLimit())
+
+		// Select only requested page of follower IDs.
+		q := r.db.
+			NewSelect().
+			Table("follows").
+			Column("id").
+			Where("? = ?", bun.Ident("target_account_id"), accountID)
+
+		q, err := whereFollowPage(ctx, q, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := q.Scan(ctx, &followIDs); err != nil {
+			return nil, err
+		}
+
 		return followIDs, nil
 	})
 }
@@ -307,7 +347,77 @@ func newSelectFollows(db *bun.DB, accountID string) *bun.SelectQuery {
 		Table("follows").
 		Column("id").
 		Where("? = ?", bun.Ident("account_id"), accountID).
-		OrderExpr("? DESC", bun.Ident("created_at"))
+		OrderExpr("? DESC, ? DESC", bun.Ident("created_at"), bun.Ident("id"))
+}
+
+// whereFollowPage adds the min / max bounds, limit and ordering
+// of given page to the follows select query. Follows are ordered
+// by (created_at, id), matching newSelectFollows() and
+// newSelectFollowers(), with page bounds given as follow IDs
+// so the cursor follow's created_at is seeked from.
+func whereFollowPage(ctx context.Context, q *bun.SelectQuery, page *paging.Page) (*bun.SelectQuery, error) {
+	var err error
+
+	if maxID := page.GetMax(); maxID != "" {
+		// Return only follows older than max.
+		q, err = whereFollowCursor(ctx, q, "<", maxID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if minID := page.GetMin(); minID != "" {
+		// Return only follows newer than min.
+		q, err = whereFollowCursor(ctx, q, ">", minID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if limit := page.GetLimit(); limit > 0 {
+		// Limit amount of IDs returned.
+		q = q.Limit(limit)
+	}
+
+	if page.GetOrder().Ascending() {
+		// Page up.
+		q = q.OrderExpr("? ASC, ? ASC", bun.Ident("created_at"), bun.Ident("id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC, ? DESC", bun.Ident("created_at"), bun.Ident("id"))
+	}
+
+	return q, nil
+}
+
+// whereFollowCursor adds a comparison with the given operator
+// ("<" or ">") against the (created_at, id) keyset of follow with
+// ID to the follows select query. If the cursor follow no longer
+// exists (e.g. it was removed since the page link was served), its
+// keyset can't be selected, so this falls back to comparing by ID
+// alone, as IDs are ULIDs which sort by creation time. This is also
+// how the cached follow IDs are paged.
+func whereFollowCursor(ctx context.Context, q *bun.SelectQuery, op string, id string) (*bun.SelectQuery, error) {
+	cursorQ := q.DB().
+		NewSelect().
+		Table("follows").
+		Column("created_at", "id").
+		Where("? = ?", bun.Ident("id"), id)
+
+	exists, err := cursorQ.Exists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		// Cursor follow is gone, compare by ID.
+		return q.Where("? "+op+" ?", bun.Ident("id"), id), nil
+	}
+
+	return q.Where("(?, ?) "+op+" (?)",
+		bun.Ident("created_at"), bun.Ident("id"),
+		cursorQ,
+	), nil
 }
 
 // newSelectLocalFollows returns a new select query for all rows in the follows table with
@@ -334,7 +444,7 @@ func newSelectFollowers(db *bun.DB, accountID string) *bun.SelectQuery {
 		Table("follows").
 		Column("id").
 		Where("? = ?", bun.Ident("target_account_id"), accountID).
-		OrderExpr("? DESC", bun.Ident("created_at"))
+		OrderExpr("? DESC, ? DESC", bun.Ident("created_at"), bun.Ident("id"))
 }
 
 // newSelectLocalFollowers returns a new select query for all rows in the follows table with
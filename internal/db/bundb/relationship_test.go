@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -817,6 +818,94 @@ func (suite *RelationshipTestSuite) TestGetAccountFollowers() {
 	suite.Len(follows, 2)
 }
 
+func (suite *RelationshipTestSuite) TestGetAccountFollowsPaged() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	for _, test := range []struct {
+		name string
+		key  string
+		get  func(context.Context, string, *paging.Page) ([]*gtsmodel.Follow, error)
+	}{
+		{
+			name: "follows",
+			key:  ">" + account.ID,
+			get:  suite.db.GetAccountFollows,
+		},
+		{
+			name: "followers",
+			key:  "<" + account.ID,
+			get:  suite.db.GetAccountFollowers,
+		},
+	} {
+		// Load all follows, to compare pages against.
+		all, err := test.get(ctx, account.ID, nil)
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+		suite.Len(all, 2, test.name)
+
+		getPages := func() []string {
+			var (
+				ids  []string
+				page = &paging.Page{Limit: 1}
+			)
+
+			for {
+				follows, err := test.get(ctx, account.ID, page)
+				if err != nil {
+					suite.FailNow(err.Error(), test.name)
+				}
+
+				if len(follows) == 0 {
+					return ids
+				}
+
+				suite.Len(follows, 1, test.name)
+				ids = append(ids, follows[0].ID)
+				page = page.Next(follows[0].ID, follows[0].ID)
+			}
+		}
+
+		// Pages served from IDs cached by the load above.
+		cached := getPages()
+
+		// Pages selected directly from db by keyset.
+		suite.state.Caches.GTS.FollowIDs.Invalidate(test.key)
+		keyset := getPages()
+
+		suite.Equal([]string{all[0].ID, all[1].ID}, cached, test.name)
+		suite.Equal(cached, keyset, test.name)
+
+		// Paging up from the oldest follow
+		// should return the newest one.
+		follows, err := test.get(ctx, account.ID, &paging.Page{
+			Min:   paging.MinID(all[1].ID),
+			Limit: 1,
+		})
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+		suite.Len(follows, 1, test.name)
+		suite.Equal(all[0].ID, follows[0].ID, test.name)
+
+		// Paging down from a follow which no longer
+		// exists should fall back to paging by ID.
+		suite.state.Caches.GTS.FollowIDs.Invalidate(test.key)
+		follows, err = test.get(ctx, account.ID, &paging.Page{
+			Max:   paging.MaxID(id.NewULID()),
+			Limit: 1,
+		})
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+		suite.Len(follows, 1, test.name)
+		suite.Equal(all[0].ID, follows[0].ID, test.name)
+	}
+}
+
 func (suite *RelationshipTestSuite) TestUnfollowExisting() {
 	originAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+// replicas wraps a primary database connection
+// pool alongside any number of read-only replica
+// connection pools, selecting between replicas in
+// a round-robin fashion for read-only queries.
+type replicas struct {
+	primary *bun.DB
+	dbs     []*bun.DB
+	next    atomic.Uint32
+}
+
+// Primary returns the primary database connection pool.
+func (r *replicas) Primary() *bun.DB {
+	return r.primary
+}
+
+// Replica returns the next read-only replica database connection
+// pool in round-robin order, or the primary if none are configured.
+//
+// Note that replicas may lag behind the primary, so only heavy
+// read-only listings that can tolerate slightly stale data should
+// be sent here. Lookups by key (e.g. cache loaders), which may
+// directly follow a write, should always go to the primary.
+func (r *replicas) Replica() *bun.DB {
+	if len(r.dbs) == 0 {
+		return r.primary
+	}
+	i := r.next.Add(1) - 1
+	return r.dbs[i%uint32(len(r.dbs))]
+}
+
+// pgReplicaConns opens a new postgres connection pool
+// for each of the configured replica database addresses.
+func pgReplicaConns(ctx context.Context) ([]*bun.DB, error) {
+	addrs := config.GetDbReplicaAddresses()
+	dbs := make([]*bun.DB, 0, len(addrs))
+
+	for _, addr := range addrs {
+		// Parse the replica address as postgres DSN / URL.
+		cfg, err := pgx.ParseConfig(addr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse postgres replica address: %w", err)
+		}
+		cfg.RuntimeParams["application_name"] = config.GetApplicationName()
+
+		db, err := pgOpen(ctx, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("postgres replica: %w", err)
+		}
+
+		log.Infof(ctx, "connected to POSTGRES replica database at %s", cfg.Host)
+		dbs = append(dbs, db)
+	}
+
+	return dbs, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
 )
@@ -443,9 +444,10 @@ func (s *searchDB) SearchForTags(
 		frontToBack = false
 	}
 
-	// Normalize tag 'name' string.
+	// Fold tag 'name' string to
+	// match canonical tag names.
 	name := strings.TrimSpace(query)
-	name = strings.ToLower(name)
+	name = text.FoldHashtag(name)
 
 	// Search using LIKE for tags that start with `name`.
 	q = whereStartsLike(q, bun.Ident("tag.name"), name)
@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -539,6 +540,57 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 	})
 }
 
+func (s *statusDB) GetStatusesOlderThan(ctx context.Context, local bool, maxID string, limit int) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.local"), local).
+		Where("? < ?", bun.Ident("status.id"), maxID).
+		Order("status.id DESC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *statusDB) GetStatusesWithoutWordCount(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = 0", bun.Ident("status.word_count")).
+		Where("? IS NOT NULL", bun.Ident("status.content")).
+		Where("? != ''", bun.Ident("status.content")).
+		Order("status.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error) {
 	var statusIDs []string
 
@@ -556,13 +608,19 @@ func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([
 	return s.GetStatusesByIDs(ctx, statusIDs)
 }
 
-func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Status, error) {
+func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status, maxDepth int) ([]*gtsmodel.Status, bool, error) {
 	var parents []*gtsmodel.Status
 
 	for id := status.InReplyToID; id != ""; {
+		if maxDepth > 0 && len(parents) >= maxDepth {
+			// Further parents
+			// exist, stop here.
+			return parents, true, nil
+		}
+
 		parent, err := s.GetStatusByID(ctx, id)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		// Append parent status to slice
@@ -572,34 +630,47 @@ func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status
 		id = parent.InReplyToID
 	}
 
-	return parents, nil
+	return parents, false, nil
 }
 
-func (s *statusDB) GetStatusChildren(ctx context.Context, statusID string) ([]*gtsmodel.Status, error) {
+func (s *statusDB) GetStatusChildren(ctx context.Context, statusID string, maxDepth int) ([]*gtsmodel.Status, bool, error) {
+	return s.getStatusChildren(ctx, statusID, 1, maxDepth)
+}
+
+func (s *statusDB) getStatusChildren(ctx context.Context, statusID string, depth int, maxDepth int) ([]*gtsmodel.Status, bool, error) {
+	if maxDepth > 0 && depth > maxDepth {
+		// Past max depth, only check (cached)
+		// reply IDs to see if anything was left out.
+		replyIDs, err := s.getStatusReplyIDs(ctx, statusID)
+		return nil, len(replyIDs) > 0, err
+	}
+
 	// Get all replies for the currently set status.
 	replies, err := s.GetStatusReplies(ctx, statusID)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	// Make estimated preallocation based on direct replies.
 	children := make([]*gtsmodel.Status, 0, len(replies)*2)
 
+	var truncated bool
 	for _, status := range replies {
 		// Append status to children.
 		children = append(children, status)
 
 		// Further, recursively get all children for this reply.
-		grandChildren, err := s.GetStatusChildren(ctx, status.ID)
+		grandChildren, trunc, err := s.getStatusChildren(ctx, status.ID, depth+1, maxDepth)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		// Append all sub children after status.
 		children = append(children, grandChildren...)
+		truncated = truncated || trunc
 	}
 
-	return children, nil
+	return children, truncated, nil
 }
 
 func (s *statusDB) GetStatusReplies(ctx context.Context, statusID string) ([]*gtsmodel.Status, error) {
@@ -634,8 +705,8 @@ func (s *statusDB) getStatusReplyIDs(ctx context.Context, statusID string) ([]st
 	})
 }
 
-func (s *statusDB) GetStatusBoosts(ctx context.Context, statusID string) ([]*gtsmodel.Status, error) {
-	statusIDs, err := s.getStatusBoostIDs(ctx, statusID)
+func (s *statusDB) GetStatusBoosts(ctx context.Context, statusID string, page *paging.Page) ([]*gtsmodel.Status, error) {
+	statusIDs, err := s.getStatusBoostIDs(ctx, statusID, page)
 	if err != nil {
 		return nil, err
 	}
@@ -655,12 +726,12 @@ func (s *statusDB) IsStatusBoostedBy(ctx context.Context, statusID string, accou
 }
 
 func (s *statusDB) CountStatusBoosts(ctx context.Context, statusID string) (int, error) {
-	statusIDs, err := s.getStatusBoostIDs(ctx, statusID)
+	statusIDs, err := s.getStatusBoostIDs(ctx, statusID, nil)
 	return len(statusIDs), err
 }
 
-func (s *statusDB) getStatusBoostIDs(ctx context.Context, statusID string) ([]string, error) {
-	return s.state.Caches.GTS.BoostOfIDs.Load(statusID, func() ([]string, error) {
+func (s *statusDB) getStatusBoostIDs(ctx context.Context, statusID string, page *paging.Page) ([]string, error) {
+	return loadPagedIDsKeyset(&s.state.Caches.GTS.BoostOfIDs, statusID, page, func() ([]string, error) {
 		var statusIDs []string
 
 		// Status boost IDs not in cache, perform DB query!
@@ -674,6 +745,22 @@ func (s *statusDB) getStatusBoostIDs(ctx context.Context, statusID string) ([]st
 			return nil, err
 		}
 
+		return statusIDs, nil
+	}, func(page *paging.Page) ([]string, error) {
+		statusIDs := make([]string, 0, page.GetLimit())
+
+		// Select only requested page of status boost IDs.
+		q := s.db.
+			NewSelect().
+			Table("statuses").
+			Column("id").
+			Where("? = ?", bun.Ident("boost_of_id"), statusID)
+
+		if err := whereIDPage(q, "id", page).
+			Scan(ctx, &statusIDs); err != nil {
+			return nil, err
+		}
+
 		return statusIDs, nil
 	})
 }
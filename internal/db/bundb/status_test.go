@@ -23,8 +23,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type StatusTestSuite struct {
@@ -185,8 +187,9 @@ func (suite *StatusTestSuite) TestGetStatusReplies() {
 
 func (suite *StatusTestSuite) TestGetStatusChildren() {
 	targetStatus := suite.testStatuses["local_account_1_status_1"]
-	children, err := suite.db.GetStatusChildren(context.Background(), targetStatus.ID)
+	children, truncated, err := suite.db.GetStatusChildren(context.Background(), targetStatus.ID, 0)
 	suite.NoError(err)
+	suite.False(truncated)
 	suite.Len(children, 2)
 	for _, c := range children {
 		suite.Equal(targetStatus.URI, c.InReplyToURI)
@@ -195,6 +198,63 @@ func (suite *StatusTestSuite) TestGetStatusChildren() {
 	}
 }
 
+func (suite *StatusTestSuite) TestGetStatusParentsAndChildrenMaxDepth() {
+	ctx := context.Background()
+	rootStatus := suite.testStatuses["local_account_1_status_1"]
+	parentStatus := suite.testStatuses["admin_account_status_3"]
+
+	// Reply to a reply of the root status,
+	// so that the thread is 2 levels deep.
+	reply := &gtsmodel.Status{
+		ID:                       "01J2M1Q9X3ZD6W3V7K8B0Y6T5N",
+		URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01J2M1Q9X3ZD6W3V7K8B0Y6T5N",
+		URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01J2M1Q9X3ZD6W3V7K8B0Y6T5N",
+		Content:                  "reply to a reply",
+		Local:                    util.Ptr(true),
+		AccountURI:               rootStatus.AccountURI,
+		AccountID:                rootStatus.AccountID,
+		InReplyToID:              parentStatus.ID,
+		InReplyToURI:             parentStatus.URI,
+		InReplyToAccountID:       parentStatus.AccountID,
+		Visibility:               gtsmodel.VisibilityPublic,
+		ActivityStreamsType:      ap.ObjectNote,
+		Federated:                util.Ptr(true),
+		Boostable:                util.Ptr(true),
+		Replyable:                util.Ptr(true),
+		Likeable:                 util.Ptr(true),
+		Sensitive:                util.Ptr(false),
+		CreatedWithApplicationID: rootStatus.CreatedWithApplicationID,
+	}
+	if err := suite.db.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Limited to 1 level, the reply should
+	// be left out, and marked as truncated.
+	children, truncated, err := suite.db.GetStatusChildren(ctx, rootStatus.ID, 1)
+	suite.NoError(err)
+	suite.True(truncated)
+	suite.Len(children, 2)
+
+	children, truncated, err = suite.db.GetStatusChildren(ctx, rootStatus.ID, 2)
+	suite.NoError(err)
+	suite.False(truncated)
+	suite.Len(children, 3)
+
+	// Same in the other direction.
+	parents, truncated, err := suite.db.GetStatusParents(ctx, reply, 1)
+	suite.NoError(err)
+	suite.True(truncated)
+	suite.Len(parents, 1)
+	suite.Equal(parentStatus.ID, parents[0].ID)
+
+	parents, truncated, err = suite.db.GetStatusParents(ctx, reply, 0)
+	suite.NoError(err)
+	suite.False(truncated)
+	suite.Len(parents, 2)
+	suite.Equal(rootStatus.ID, parents[1].ID)
+}
+
 func (suite *StatusTestSuite) TestDeleteStatus() {
 	// Take a copy of the status.
 	targetStatus := &gtsmodel.Status{}
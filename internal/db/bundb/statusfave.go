@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -106,9 +107,9 @@ func (s *statusFaveDB) getStatusFave(ctx context.Context, lookup string, dbQuery
 	return fave, nil
 }
 
-func (s *statusFaveDB) GetStatusFaves(ctx context.Context, statusID string) ([]*gtsmodel.StatusFave, error) {
+func (s *statusFaveDB) GetStatusFaves(ctx context.Context, statusID string, page *paging.Page) ([]*gtsmodel.StatusFave, error) {
 	// Fetch the status fave IDs for status.
-	faveIDs, err := s.getStatusFaveIDs(ctx, statusID)
+	faveIDs, err := s.getStatusFaveIDs(ctx, statusID, page)
 	if err != nil {
 		return nil, err
 	}
@@ -168,12 +169,12 @@ func (s *statusFaveDB) IsStatusFavedBy(ctx context.Context, statusID string, acc
 }
 
 func (s *statusFaveDB) CountStatusFaves(ctx context.Context, statusID string) (int, error) {
-	faveIDs, err := s.getStatusFaveIDs(ctx, statusID)
+	faveIDs, err := s.getStatusFaveIDs(ctx, statusID, nil)
 	return len(faveIDs), err
 }
 
-func (s *statusFaveDB) getStatusFaveIDs(ctx context.Context, statusID string) ([]string, error) {
-	return s.state.Caches.GTS.StatusFaveIDs.Load(statusID, func() ([]string, error) {
+func (s *statusFaveDB) getStatusFaveIDs(ctx context.Context, statusID string, page *paging.Page) ([]string, error) {
+	return loadPagedIDsKeyset(&s.state.Caches.GTS.StatusFaveIDs, statusID, page, func() ([]string, error) {
 		var faveIDs []string
 
 		// Status fave IDs not in cache, perform DB query!
@@ -182,6 +183,23 @@ func (s *statusFaveDB) getStatusFaveIDs(ctx context.Context, statusID string) ([
 			Table("status_faves").
 			Column("id").
 			Where("? = ?", bun.Ident("status_id"), statusID).
+			Order("id DESC").
+			Scan(ctx, &faveIDs); err != nil {
+			return nil, err
+		}
+
+		return faveIDs, nil
+	}, func(page *paging.Page) ([]string, error) {
+		faveIDs := make([]string, 0, page.GetLimit())
+
+		// Select only requested page of status fave IDs.
+		q := s.db.
+			NewSelect().
+			Table("status_faves").
+			Column("id").
+			Where("? = ?", bun.Ident("status_id"), statusID)
+
+		if err := whereIDPage(q, "id", page).
 			Scan(ctx, &faveIDs); err != nil {
 			return nil, err
 		}
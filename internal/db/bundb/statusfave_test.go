@@ -25,6 +25,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 type StatusFaveTestSuite struct {
@@ -34,7 +36,7 @@ type StatusFaveTestSuite struct {
 func (suite *StatusFaveTestSuite) TestGetStatusFaves() {
 	testStatus := suite.testStatuses["admin_account_status_1"]
 
-	faves, err := suite.db.GetStatusFaves(context.Background(), testStatus.ID)
+	faves, err := suite.db.GetStatusFaves(context.Background(), testStatus.ID, nil)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -47,10 +49,81 @@ func (suite *StatusFaveTestSuite) TestGetStatusFaves() {
 	}
 }
 
+func (suite *StatusFaveTestSuite) TestGetStatusFavesPaged() {
+	var (
+		ctx        = context.Background()
+		testStatus = suite.testStatuses["admin_account_status_1"]
+		faver      = suite.testAccounts["local_account_2"]
+	)
+
+	// Status only has one fave in the
+	// testrig, so add another to page.
+	if err := suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              id.NewULID(),
+		AccountID:       faver.ID,
+		TargetAccountID: testStatus.AccountID,
+		StatusID:        testStatus.ID,
+		URI:             faver.URI + "/fave/" + testStatus.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Load all faves, to compare pages against.
+	all, err := suite.db.GetStatusFaves(ctx, testStatus.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(all, 2)
+
+	getPages := func() []string {
+		var (
+			ids  []string
+			page = &paging.Page{Limit: 1}
+		)
+
+		for {
+			faves, err := suite.db.GetStatusFaves(ctx, testStatus.ID, page)
+			if err != nil {
+				suite.FailNow(err.Error())
+			}
+
+			if len(faves) == 0 {
+				return ids
+			}
+
+			suite.Len(faves, 1)
+			ids = append(ids, faves[0].ID)
+			page = page.Next(faves[0].ID, faves[0].ID)
+		}
+	}
+
+	// Pages served from IDs cached by the load above.
+	cached := getPages()
+
+	// Pages selected directly from db by keyset.
+	suite.state.Caches.GTS.StatusFaveIDs.Invalidate(testStatus.ID)
+	keyset := getPages()
+
+	suite.Equal([]string{all[0].ID, all[1].ID}, cached)
+	suite.Equal(cached, keyset)
+
+	// Paging up from the oldest fave
+	// should return the newest one.
+	faves, err := suite.db.GetStatusFaves(ctx, testStatus.ID, &paging.Page{
+		Min:   paging.MinID(all[1].ID),
+		Limit: 1,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(faves, 1)
+	suite.Equal(all[0].ID, faves[0].ID)
+}
+
 func (suite *StatusFaveTestSuite) TestGetStatusFavesNone() {
 	testStatus := suite.testStatuses["admin_account_status_4"]
 
-	faves, err := suite.db.GetStatusFaves(context.Background(), testStatus.ID)
+	faves, err := suite.db.GetStatusFaves(context.Background(), testStatus.ID, nil)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
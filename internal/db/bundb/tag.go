@@ -23,6 +23,7 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 )
@@ -50,9 +51,9 @@ func (t *tagDB) GetTag(ctx context.Context, id string) (*gtsmodel.Tag, error) {
 }
 
 func (t *tagDB) GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, error) {
-	// Normalize 'name' string.
+	// Fold 'name' string to its canonical form.
 	name = strings.TrimSpace(name)
-	name = strings.ToLower(name)
+	name = text.FoldHashtag(name)
 
 	return t.state.Caches.GTS.Tag.LoadOne("Name", func() (*gtsmodel.Tag, error) {
 		var tag gtsmodel.Tag
@@ -110,9 +111,13 @@ func (t *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) error {
 	t2 := new(gtsmodel.Tag)
 	*t2 = *tag
 
-	// Normalize name on new pointer.
+	// Normalize name on new pointer, keeping
+	// the given spelling as display name.
 	t2.Name = strings.TrimSpace(t2.Name)
-	t2.Name = strings.ToLower(t2.Name)
+	if t2.DisplayName == "" {
+		t2.DisplayName = t2.Name
+	}
+	t2.Name = text.FoldHashtag(t2.Name)
 
 	// Insert the copy.
 	if err := t.state.Caches.GTS.Tag.Store(t2, func() error {
@@ -124,6 +129,8 @@ func (t *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) error {
 
 	// Update original tag with
 	// field values populated by db.
+	tag.Name = t2.Name
+	tag.DisplayName = t2.DisplayName
 	tag.CreatedAt = t2.CreatedAt
 	tag.UpdatedAt = t2.UpdatedAt
 	tag.Useable = t2.Useable
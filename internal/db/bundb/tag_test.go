@@ -52,6 +52,8 @@ func (suite *TagTestSuite) TestGetTagByName() {
 		"welcome",
 		"Welcome",
 		"WELCoME ",
+		"ＷＥＬＣＯＭＥ",
+		"wélcome",
 	} {
 		dbTag, err := suite.db.GetTagByName(context.Background(), name)
 		suite.NoError(err)
@@ -89,6 +91,26 @@ func (suite *TagTestSuite) TestPutTag() {
 	}
 }
 
+func (suite *TagTestSuite) TestPutTagDisplayName() {
+	tag := &gtsmodel.Tag{
+		ID:   id.NewULID(),
+		Name: "Café",
+	}
+
+	err := suite.db.PutTag(context.Background(), tag)
+	suite.NoError(err)
+
+	// Name should be folded, but
+	// the original spelling kept.
+	suite.Equal("cafe", tag.Name)
+	suite.Equal("Café", tag.DisplayName)
+
+	dbTag, err := suite.db.GetTagByName(context.Background(), "CAFÉ")
+	suite.NoError(err)
+	suite.Equal(tag.ID, dbTag.ID)
+	suite.Equal("Café", dbTag.DisplayName)
+}
+
 func TestTagTestSuite(t *testing.T) {
 	suite.Run(t, new(TagTestSuite))
 }
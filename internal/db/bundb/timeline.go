@@ -34,8 +34,9 @@ import (
 )
 
 type timelineDB struct {
-	db    *bun.DB
-	state *state.State
+	db       *bun.DB
+	replicas *replicas
+	state    *state.State
 }
 
 func (t *timelineDB) GetHomeTimeline(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int, local bool) ([]*gtsmodel.Status, error) {
@@ -170,7 +171,7 @@ func (t *timelineDB) GetPublicTimeline(ctx context.Context, maxID string, sinceI
 		frontToBack = true
 	)
 
-	q := t.db.
+	q := t.replicas.Replica().
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		// Public only.
@@ -258,7 +259,7 @@ func (t *timelineDB) GetFavedTimeline(ctx context.Context, accountID string, max
 	// Make educated guess for slice size
 	faves := make([]*gtsmodel.StatusFave, 0, limit)
 
-	fq := t.db.
+	fq := t.replicas.Replica().
 		NewSelect().
 		Model(&faves).
 		Where("? = ?", bun.Ident("status_fave.account_id"), accountID).
@@ -437,6 +438,31 @@ func (t *timelineDB) GetTagTimeline(
 	minID string,
 	limit int,
 ) ([]*gtsmodel.Status, error) {
+	return t.GetTagsTimeline(ctx,
+		[]string{tagID},
+		nil,
+		nil,
+		maxID,
+		sinceID,
+		minID,
+		limit,
+	)
+}
+
+func (t *timelineDB) GetTagsTimeline(
+	ctx context.Context,
+	anyTagIDs []string,
+	allTagIDs []string,
+	noneTagIDs []string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) ([]*gtsmodel.Status, error) {
+	if len(anyTagIDs) == 0 {
+		return nil, gtserror.New("no tag ids provided")
+	}
+
 	// Ensure reasonable
 	if limit < 0 {
 		limit = 0
@@ -448,7 +474,7 @@ func (t *timelineDB) GetTagTimeline(
 		frontToBack = true
 	)
 
-	q := t.db.
+	q := t.replicas.Replica().
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
 		Column("status_to_tag.status_id").
@@ -459,9 +485,28 @@ func (t *timelineDB) GetTagTimeline(
 			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
 		).
 		// Public only.
-		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic)
+
+	if len(anyTagIDs) == 1 {
 		// This tag only.
-		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID)
+		q = q.Where("? = ?", bun.Ident("status_to_tag.tag_id"), anyTagIDs[0])
+	} else {
+		// Any of these tags, only
+		// including each status once.
+		q = q.
+			Where("? IN (?)", bun.Ident("status_to_tag.tag_id"), bun.In(anyTagIDs)).
+			Distinct()
+	}
+
+	for _, tagID := range allTagIDs {
+		// Status must also use this tag.
+		q = q.Where("EXISTS (?)", statusUsesTags(q.DB(), tagID))
+	}
+
+	if len(noneTagIDs) > 0 {
+		// Status must use none of these tags.
+		q = q.Where("NOT EXISTS (?)", statusUsesTags(q.DB(), noneTagIDs...))
+	}
 
 	if maxID == "" || maxID >= id.Highest {
 		const future = 24 * time.Hour
@@ -524,3 +569,15 @@ func (t *timelineDB) GetTagTimeline(
 	// Return status IDs loaded from cache + db.
 	return t.state.DB.GetStatusesByIDs(ctx, statusIDs)
 }
+
+// statusUsesTags returns a subquery selecting the
+// status_to_tags entries of the status being selected
+// in a tag timeline query, for any of the given tag IDs.
+func statusUsesTags(db *bun.DB, tagIDs ...string) *bun.SelectQuery {
+	return db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("other_tag")).
+		Column("other_tag.status_id").
+		Where("? = ?", bun.Ident("other_tag.status_id"), bun.Ident("status_to_tag.status_id")).
+		Where("? IN (?)", bun.Ident("other_tag.tag_id"), bun.In(tagIDs))
+}
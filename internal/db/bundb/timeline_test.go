@@ -323,6 +323,62 @@ func (suite *TimelineTestSuite) TestGetTagTimelineNoParams() {
 	suite.Equal("01F8MH75CBF9JFX4ZAD54N0W0R", s[0].ID)
 }
 
+func (suite *TimelineTestSuite) TestGetTagsTimeline() {
+	var (
+		ctx     = context.Background()
+		welcome = suite.testTags["welcome"]
+		hashtag = suite.testTags["Hashtag"]
+	)
+
+	for _, test := range []struct {
+		name     string
+		anyIDs   []string
+		allIDs   []string
+		noneIDs  []string
+		expectID string
+	}{
+		{
+			name:     "any",
+			anyIDs:   []string{hashtag.ID, welcome.ID},
+			expectID: "01F8MH75CBF9JFX4ZAD54N0W0R",
+		},
+		{
+			name:   "all",
+			anyIDs: []string{welcome.ID},
+			allIDs: []string{hashtag.ID},
+		},
+		{
+			name:     "none not used",
+			anyIDs:   []string{welcome.ID},
+			noneIDs:  []string{hashtag.ID},
+			expectID: "01F8MH75CBF9JFX4ZAD54N0W0R",
+		},
+		{
+			name:    "none used",
+			anyIDs:  []string{welcome.ID, hashtag.ID},
+			noneIDs: []string{welcome.ID},
+		},
+	} {
+		s, err := suite.db.GetTagsTimeline(ctx,
+			test.anyIDs,
+			test.allIDs,
+			test.noneIDs,
+			"", "", "", 20,
+		)
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+
+		if test.expectID == "" {
+			suite.Empty(s, test.name)
+			continue
+		}
+
+		suite.checkStatuses(s, id.Highest, id.Lowest, 1)
+		suite.Equal(test.expectID, s[0].ID, test.name)
+	}
+}
+
 func TestTimelineTestSuite(t *testing.T) {
 	suite.Run(t, new(TimelineTestSuite))
 }
@@ -157,6 +157,85 @@ func loadPagedIDs(cache *cache.SliceCache[string], key string, page *paging.Page
 	return ids, nil
 }
 
+// loadPagedIDsKeyset is like loadPagedIDs, except that when paging and
+// no IDs are already cached under key, rather than loading (and caching)
+// every ID under key just to return a single page of them, loadPage is
+// called to select only the requested page of IDs from the database, see
+// whereIDPage(). Returned IDs are always in descending order.
+func loadPagedIDsKeyset(
+	cache *cache.SliceCache[string],
+	key string,
+	page *paging.Page,
+	loadDESC func() ([]string, error),
+	loadPage func(page *paging.Page) ([]string, error),
+) ([]string, error) {
+	if page == nil {
+		// Not paging, load all.
+		return loadPagedIDs(cache,
+			key,
+			nil,
+			loadDESC,
+		)
+	}
+
+	// Check cache for IDs.
+	ids, ok := cache.Get(key)
+	if ok {
+		// Cached IDs are ALWAYS descending,
+		// see loadPagedIDs() for details.
+		if page.GetOrder().Ascending() {
+			slices.Reverse(ids)
+		}
+
+		// Page the cached IDs.
+		return page.Page(ids), nil
+	}
+
+	// Select just this page of IDs.
+	ids, err := loadPage(page)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we're paging up, we still want IDs
+	// sorted descending, so reverse slice.
+	if page.GetOrder().Ascending() {
+		slices.Reverse(ids)
+	}
+
+	return ids, nil
+}
+
+// whereIDPage adds the min / max ID bounds, limit
+// and ordering of given page to the select query,
+// as a keyset page on the given ID column.
+func whereIDPage(q *bun.SelectQuery, column string, page *paging.Page) *bun.SelectQuery {
+	if maxID := page.GetMax(); maxID != "" {
+		// Return only IDs lower than max.
+		q = q.Where("? < ?", bun.Ident(column), maxID)
+	}
+
+	if minID := page.GetMin(); minID != "" {
+		// Return only IDs greater than min.
+		q = q.Where("? > ?", bun.Ident(column), minID)
+	}
+
+	if limit := page.GetLimit(); limit > 0 {
+		// Limit amount of IDs returned.
+		q = q.Limit(limit)
+	}
+
+	if page.GetOrder().Ascending() {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident(column))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident(column))
+	}
+
+	return q
+}
+
 // updateWhere parses []db.Where and adds it to the given update query.
 func updateWhere(q *bun.UpdateQuery, where []db.Where) {
 	for _, w := range where {
@@ -0,0 +1,184 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// pgMaintenanceLockID is the postgres advisory lock
+// key used to ensure only one GoToSocial instance at
+// a time performs maintenance on a shared database.
+const pgMaintenanceLockID int64 = 0x67747376616375 // "gtsvacu"
+
+// vacuumTables are the tables that see most row churn, and
+// so are vacuumed during postgres maintenance. SQLite has
+// no per-table vacuum, so the whole database file is done.
+var vacuumTables = []string{
+	"accounts",
+	"statuses",
+	"status_to_tags",
+	"status_faves",
+	"status_bookmarks",
+	"media_attachments",
+	"notifications",
+	"mentions",
+	"tokens",
+	"follows",
+	"follow_requests",
+	"polls",
+	"poll_votes",
+	"tombstones",
+}
+
+func (b *basicDB) Vacuum(ctx context.Context) (bool, error) {
+	switch b.db.Dialect().Name() {
+	case dialect.SQLite:
+		return b.sqliteVacuum(ctx)
+	case dialect.PG:
+		return b.pgVacuum(ctx)
+	default:
+		return false, gtserror.Newf("unsupported dialect: %s", b.db.Dialect().Name())
+	}
+}
+
+func (b *basicDB) sqliteVacuum(ctx context.Context) (bool, error) {
+	// SQLite databases can't be shared between
+	// instances, so a local lock is sufficient.
+	if !b.vacuumMu.TryLock() {
+		return false, nil
+	}
+	defer b.vacuumMu.Unlock()
+
+	before, err := sqlitePageCount(ctx, b.db)
+	if err != nil {
+		return true, err
+	}
+
+	start := time.Now()
+	log.Infof(ctx, "running VACUUM on database with %d pages", before)
+
+	if _, err := b.db.ExecContext(ctx, "VACUUM"); err != nil {
+		return true, gtserror.Newf("error running vacuum: %w", err)
+	}
+
+	after, err := sqlitePageCount(ctx, b.db)
+	if err != nil {
+		return true, err
+	}
+
+	log.Infof(ctx, "finished VACUUM after %s; database pages %d -> %d", time.Since(start), before, after)
+	return true, nil
+}
+
+func (b *basicDB) pgVacuum(ctx context.Context) (bool, error) {
+	// Advisory locks are held per-session,
+	// so use a single dedicated connection.
+	conn, err := b.db.Conn(ctx)
+	if err != nil {
+		return false, gtserror.Newf("error acquiring connection: %w", err)
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.NewRaw(
+		"SELECT pg_try_advisory_lock(?)", pgMaintenanceLockID,
+	).Scan(ctx, &locked); err != nil {
+		return false, gtserror.Newf("error acquiring maintenance lock: %w", err)
+	}
+
+	if !locked {
+		// Another instance
+		// is already on it.
+		return false, nil
+	}
+
+	defer func() {
+		// Release lock even if the passed context was canceled.
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx),
+			"SELECT pg_advisory_unlock(?)", pgMaintenanceLockID,
+		); err != nil {
+			log.Errorf(ctx, "error releasing maintenance lock: %v", err)
+		}
+	}()
+
+	before, err := pgPageCount(ctx, conn)
+	if err != nil {
+		return true, err
+	}
+
+	start := time.Now()
+	log.Infof(ctx, "running VACUUM ANALYZE on %d tables with %d pages", len(vacuumTables), before)
+
+	for _, table := range vacuumTables {
+		if _, err := conn.NewRaw(
+			"VACUUM ANALYZE ?", bun.Ident(table),
+		).Exec(ctx); err != nil {
+			return true, gtserror.Newf("error running vacuum on %s: %w", table, err)
+		}
+	}
+
+	after, err := pgPageCount(ctx, conn)
+	if err != nil {
+		return true, err
+	}
+
+	log.Infof(ctx, "finished VACUUM ANALYZE after %s; table pages %d -> %d", time.Since(start), before, after)
+	return true, nil
+}
+
+// sqlitePageCount returns the number of pages in use (i.e.
+// total page count minus free pages) by the sqlite database.
+func sqlitePageCount(ctx context.Context, db bun.IDB) (int64, error) {
+	var pages, free int64
+
+	if err := db.NewRaw("PRAGMA page_count").Scan(ctx, &pages); err != nil {
+		return 0, gtserror.Newf("error getting page count: %w", err)
+	}
+
+	if err := db.NewRaw("PRAGMA freelist_count").Scan(ctx, &free); err != nil {
+		return 0, gtserror.Newf("error getting freelist count: %w", err)
+	}
+
+	return pages - free, nil
+}
+
+// pgPageCount returns the total number of
+// on-disk pages used by the vacuumed tables.
+func pgPageCount(ctx context.Context, db bun.IDB) (int64, error) {
+	var total int64
+
+	for _, table := range vacuumTables {
+		var pages int64
+		if err := db.NewRaw(
+			"SELECT pg_relation_size(?::regclass) / current_setting('block_size')::bigint",
+			table,
+		).Scan(ctx, &pages); err != nil {
+			return 0, gtserror.Newf("error getting page count of %s: %w", table, err)
+		}
+		total += pages
+	}
+
+	return total, nil
+}
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+	"github.com/uptrace/bun"
+)
+
+type wordFilterDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (w *wordFilterDB) WordFilterMatch(ctx context.Context, in *wordfilter.Input) ([]*wordfilter.Filter, error) {
+	return w.state.Caches.WordFilters.Match(in, func() ([]*gtsmodel.WordFilter, error) {
+		return w.GetWordFilters(ctx)
+	})
+}
+
+func (w *wordFilterDB) GetWordFilter(ctx context.Context, id string) (*gtsmodel.WordFilter, error) {
+	filter := new(gtsmodel.WordFilter)
+	if err := w.db.NewSelect().
+		Model(filter).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (w *wordFilterDB) GetWordFilters(ctx context.Context) ([]*gtsmodel.WordFilter, error) {
+	var filters []*gtsmodel.WordFilter
+	err := w.db.NewSelect().
+		Model(&filters).
+		Order("id").
+		Scan(ctx)
+	return filters, err
+}
+
+func (w *wordFilterDB) PutWordFilter(ctx context.Context, filter *gtsmodel.WordFilter) error {
+	if _, err := w.db.NewInsert().
+		Model(filter).
+		Exec(ctx); err != nil {
+		return err
+	}
+	w.state.Caches.ClearWordFilters()
+	return nil
+}
+
+func (w *wordFilterDB) UpdateWordFilter(ctx context.Context, filter *gtsmodel.WordFilter, cols ...string) error {
+	filter.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+	if _, err := w.db.NewUpdate().
+		Model(filter).
+		Column(cols...).
+		Where("? = ?", bun.Ident("id"), filter.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	w.state.Caches.ClearWordFilters()
+	return nil
+}
+
+func (w *wordFilterDB) IncrementWordFilterMatches(ctx context.Context, id string, matchedAt time.Time) error {
+	// Counts aren't part of the
+	// compiled filters, so there's
+	// no need to clear the cache.
+	_, err := w.db.NewUpdate().
+		Table("word_filters").
+		Set("? = ? + 1", bun.Ident("matches_count"), bun.Ident("matches_count")).
+		Set("? = ?", bun.Ident("last_matched_at"), matchedAt).
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (w *wordFilterDB) DeleteWordFilter(ctx context.Context, id string) error {
+	if _, err := w.db.NewDelete().
+		Table("word_filters").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return err
+	}
+	w.state.Caches.ClearWordFilters()
+	return nil
+}
@@ -17,6 +17,8 @@
 
 package db
 
+import "github.com/uptrace/bun"
+
 const (
 	// DBTypePostgres represents an underlying POSTGRES database type.
 	DBTypePostgres string = "POSTGRES"
@@ -33,6 +35,7 @@ type DB interface {
 	HeaderFilter
 	Instance
 	Filter
+	FollowSuggestion
 	List
 	Marker
 	Media
@@ -53,4 +56,16 @@ type DB interface {
 	Timeline
 	User
 	Tombstone
+	WordFilter
+
+	// DBPrimary returns the primary database connection pool,
+	// to which all write queries (and most reads) are sent.
+	DBPrimary() *bun.DB
+
+	// DBReplica returns a read-only database connection pool,
+	// selected round-robin from any configured replicas, else
+	// falling back to the primary connection pool if none set.
+	// Replicas may lag behind the primary, so this should only
+	// be used for queries that can tolerate slightly stale data.
+	DBReplica() *bun.DB
 }
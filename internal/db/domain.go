@@ -57,6 +57,9 @@ type Domain interface {
 	// GetDomainBlocks returns all instance-level domain blocks currently enforced by this instance.
 	GetDomainBlocks(ctx context.Context) ([]*gtsmodel.DomainBlock, error)
 
+	// UpdateDomainBlock updates the given instance-level domain block, or all columns if none specified.
+	UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) error
+
 	// DeleteDomainBlock deletes an instance-level domain block with the given domain, if it exists.
 	DeleteDomainBlock(ctx context.Context, domain string) error
 
@@ -68,6 +71,10 @@ type Domain interface {
 	// Will check allows first, so an allowed domain will always return false, even if it's also blocked.
 	IsDomainBlocked(ctx context.Context, domain string) (bool, error)
 
+	// IsDomainLimited checks if domain is limited (silenced) by a domain block with limit severity.
+	// Like IsDomainBlocked, in blocklist mode an explicit allow takes precedence over the limit.
+	IsDomainLimited(ctx context.Context, domain string) (bool, error)
+
 	// AreDomainsBlocked calls IsDomainBlocked for each domain.
 	// Will return true if even one of the given domains is blocked.
 	AreDomainsBlocked(ctx context.Context, domains []string) (bool, error)
@@ -89,4 +89,11 @@ type Emoji interface {
 
 	// GetEmojiCategoryByName gets one emoji category by its name.
 	GetEmojiCategoryByName(ctx context.Context, name string) (*gtsmodel.EmojiCategory, error)
+
+	// UpdateEmojiCategory updates one emoji category in the database.
+	UpdateEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory, columns ...string) error
+
+	// DeleteEmojiCategory deletes one emoji category by its id, moving
+	// any emojis that were in the category back to being uncategorized.
+	DeleteEmojiCategory(ctx context.Context, id string) error
 }
@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FollowSuggestion interface {
+	// GetFeaturedSuggestions fetches all accounts picked by admins as follow suggestions.
+	GetFeaturedSuggestions(ctx context.Context) ([]*gtsmodel.FeaturedSuggestion, error)
+
+	// GetFeaturedSuggestionByAccountID fetches the featured suggestion for the given account ID.
+	GetFeaturedSuggestionByAccountID(ctx context.Context, accountID string) (*gtsmodel.FeaturedSuggestion, error)
+
+	// PutFeaturedSuggestion inserts the given featured suggestion into the database.
+	PutFeaturedSuggestion(ctx context.Context, suggestion *gtsmodel.FeaturedSuggestion) error
+
+	// DeleteFeaturedSuggestionByAccountID deletes the featured suggestion for the given account ID.
+	DeleteFeaturedSuggestionByAccountID(ctx context.Context, accountID string) error
+
+	// GetFriendsOfFriendsIDs returns the IDs of up to limit discoverable accounts
+	// followed by accounts that the given account follows, but which the given account
+	// doesn't follow itself. Accounts followed by more of the given account's follows
+	// come first, with ties broken by how recently the accounts were followed.
+	GetFriendsOfFriendsIDs(ctx context.Context, accountID string, limit int) ([]string, error)
+
+	// GetMostFollowedLocalAccountIDs returns the IDs of up to limit
+	// discoverable local accounts, ordered by how many follows they have.
+	GetMostFollowedLocalAccountIDs(ctx context.Context, limit int) ([]string, error)
+
+	// GetFollowSuggestionDismissedIDs returns the IDs of all accounts
+	// the given account has dismissed as follow suggestions.
+	GetFollowSuggestionDismissedIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// PutFollowSuggestionDismissal inserts the given follow suggestion dismissal into the database.
+	PutFollowSuggestionDismissal(ctx context.Context, dismissal *gtsmodel.FollowSuggestionDismissal) error
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Instance contains functions for instance-level actions (counting instance users etc.).
@@ -49,9 +50,25 @@ type Instance interface {
 	// UpdateInstance updates the given instance entry.
 	UpdateInstance(ctx context.Context, instance *gtsmodel.Instance, columns ...string) error
 
+	// GetInstanceSettings returns the settings of the instance with the given id, if they exist.
+	GetInstanceSettings(ctx context.Context, instanceID string) (*gtsmodel.InstanceSettings, error)
+
+	// PutInstanceSettings inserts the given instance settings into the database.
+	PutInstanceSettings(ctx context.Context, settings *gtsmodel.InstanceSettings) error
+
+	// UpdateInstanceSettings updates the given instance settings.
+	UpdateInstanceSettings(ctx context.Context, settings *gtsmodel.InstanceSettings, columns ...string) error
+
+	// CountInstanceAccounts returns the number of known accounts from the given
+	// domain, including suspended accounts, ie., those that GetInstanceAccounts returns.
+	CountInstanceAccounts(ctx context.Context, domain string) (int, error)
+
 	// GetInstanceAccounts returns a slice of accounts from the given instance, arranged by ID.
 	GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, error)
 
+	// GetInstanceAccountsPage is like GetInstanceAccounts, but pages through accounts using the given page.
+	GetInstanceAccountsPage(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.Account, error)
+
 	// GetInstancePeers returns a slice of instances that the host instance knows about.
 	GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, error)
 
@@ -51,4 +51,12 @@ type Media interface {
 	// GetCachedAttachmentsOlderThan gets limit n remote attachments (including avatars and headers) older than
 	// the given time. These will be returned in order of attachment.created_at descending (i.e. newest to oldest).
 	GetCachedAttachmentsOlderThan(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.MediaAttachment, error)
+
+	// GetAccountMediaSize returns the total size in bytes of all
+	// cached local media attachments (including thumbnails) belonging
+	// to the account with the given ID. Cached remote media is ignored.
+	//
+	// This is a full recount; callers checking media quota should
+	// prefer the MediaUsedBytes counter in the account's stats.
+	GetAccountMediaSize(ctx context.Context, accountID string) (int64, error)
 }
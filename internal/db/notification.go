@@ -29,7 +29,14 @@ type Notification interface {
 	//
 	// Returned notifications will be ordered ID descending (ie., highest/newest to lowest/oldest).
 	// If types is empty, *all* notification types will be included.
-	GetAccountNotifications(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int, types []string, excludeTypes []string) ([]*gtsmodel.Notification, error)
+	// Dismissed notifications are only included if includeDismissed is true.
+	GetAccountNotifications(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int, types []string, excludeTypes []string, includeDismissed bool) ([]*gtsmodel.Notification, error)
+
+	// CountAccountNotificationsSince counts undismissed notifications that pertain to the given
+	// accountID, with an ID higher (ie., newer) than sinceID, up to a maximum of limit.
+	//
+	// If types is empty, *all* notification types will be counted.
+	CountAccountNotificationsSince(ctx context.Context, accountID string, sinceID string, limit int, types []string, excludeTypes []string) (int, error)
 
 	// GetNotificationByID returns one notification according to its id.
 	GetNotificationByID(ctx context.Context, id string) (*gtsmodel.Notification, error)
@@ -47,6 +54,14 @@ type Notification interface {
 	// PutNotification will insert the given notification into the database.
 	PutNotification(ctx context.Context, notif *gtsmodel.Notification) error
 
+	// UpdateNotification updates the given notification in the database. If columns is empty, all columns will be updated.
+	UpdateNotification(ctx context.Context, notif *gtsmodel.Notification, columns ...string) error
+
+	// DismissNotifications marks all undismissed notifications targeting
+	// targetAccountID with an ID lower than or equal to maxID as dismissed,
+	// in a single query. If maxID is empty, all of them will be dismissed.
+	DismissNotifications(ctx context.Context, targetAccountID string, maxID string) error
+
 	// DeleteNotificationByID deletes one notification according to its id,
 	// and removes that notification from the in-memory cache.
 	DeleteNotificationByID(ctx context.Context, id string) error
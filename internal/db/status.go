@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Status contains functions for getting statuses, creating statuses, and checking various other fields on statuses.
@@ -55,6 +56,14 @@ type Status interface {
 	// GetStatuses gets a slice of statuses corresponding to the given status IDs.
 	GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Status, error)
 
+	// GetStatusesOlderThan fetches up to limit local or remote statuses with ID
+	// lower (ie., older) than maxID, ordered by ID descending (newest to oldest).
+	GetStatusesOlderThan(ctx context.Context, local bool, maxID string, limit int) ([]*gtsmodel.Status, error)
+
+	// GetStatusesWithoutWordCount fetches up to limit statuses with non-empty content
+	// but no word count stored, with ID lower than maxID (if set), ordered by ID descending.
+	GetStatusesWithoutWordCount(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Status, error)
+
 	// GetStatusesUsingEmoji fetches all status models using emoji with given ID stored in their 'emojis' column.
 	GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error)
 
@@ -64,8 +73,8 @@ type Status interface {
 	// CountStatusReplies returns the number of stored *direct* (i.e. in_reply_to_id column) replies to this status ID.
 	CountStatusReplies(ctx context.Context, statusID string) (int, error)
 
-	// GetStatusBoosts returns all statuses whose boost_of_id column refer to given status ID.
-	GetStatusBoosts(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
+	// GetStatusBoosts returns statuses whose boost_of_id column refer to given status ID, newest first, paged by boost ID (nil page = all).
+	GetStatusBoosts(ctx context.Context, statusID string, page *paging.Page) ([]*gtsmodel.Status, error)
 
 	// CountStatusBoosts returns the number of stored boosts for status ID.
 	CountStatusBoosts(ctx context.Context, statusID string) (int, error)
@@ -73,9 +82,13 @@ type Status interface {
 	// IsStatusBoostedBy checks whether the given status ID is boosted by account ID.
 	IsStatusBoostedBy(ctx context.Context, statusID string, accountID string) (bool, error)
 
-	// GetStatusParents gets the parent statuses of a given status.
-	GetStatusParents(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Status, error)
+	// GetStatusParents gets the parent statuses of a given status, closest parent first, up to
+	// maxDepth parents (0 means no limit). The returned bool indicates whether further parents
+	// were left out because of maxDepth.
+	GetStatusParents(ctx context.Context, status *gtsmodel.Status, maxDepth int) ([]*gtsmodel.Status, bool, error)
 
-	// GetStatusChildren gets the child statuses of a given status.
-	GetStatusChildren(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
+	// GetStatusChildren gets the child statuses of a given status, up to maxDepth levels of
+	// replies deep (0 means no limit). The returned bool indicates whether deeper replies
+	// were left out because of maxDepth.
+	GetStatusChildren(ctx context.Context, statusID string, maxDepth int) ([]*gtsmodel.Status, bool, error)
 }
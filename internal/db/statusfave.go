@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 type StatusFave interface {
@@ -30,9 +31,9 @@ type StatusFave interface {
 	// GetStatusFave returns one status fave with the given id.
 	GetStatusFaveByID(ctx context.Context, id string) (*gtsmodel.StatusFave, error)
 
-	// GetStatusFaves returns a slice of faves/likes of the status with given ID.
+	// GetStatusFaves returns a slice of faves/likes of the status with given ID, newest first, paged by fave ID (nil page = all).
 	// This slice will be unfiltered, not taking account of blocks and whatnot, so filter it before serving it back to a user.
-	GetStatusFaves(ctx context.Context, statusID string) ([]*gtsmodel.StatusFave, error)
+	GetStatusFaves(ctx context.Context, statusID string, page *paging.Page) ([]*gtsmodel.StatusFave, error)
 
 	// PopulateStatusFave ensures that all sub-models of a fave are populated (account, status, etc).
 	PopulateStatusFave(ctx context.Context, statusFave *gtsmodel.StatusFave) error
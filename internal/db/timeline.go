@@ -52,4 +52,9 @@ type Timeline interface {
 	// GetTagTimeline returns a slice of public-visibility statuses that use the given tagID.
 	// Statuses should be returned in descending order of when they were created (newest first).
 	GetTagTimeline(ctx context.Context, tagID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Status, error)
+
+	// GetTagsTimeline returns a slice of public-visibility statuses that use any of the given anyTagIDs,
+	// all of the given allTagIDs, and none of the given noneTagIDs. anyTagIDs must not be empty.
+	// Statuses should be returned in descending order of when they were created (newest first).
+	GetTagsTimeline(ctx context.Context, anyTagIDs []string, allTagIDs []string, noneTagIDs []string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Status, error)
 }
@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+)
+
+type WordFilter interface {
+	// WordFilterMatch performs a wordfilter.Filters.Match() on cached word filters.
+	// (Note: the actual matching code can be found under ./internal/wordfilter/ ).
+	WordFilterMatch(ctx context.Context, in *wordfilter.Input) ([]*wordfilter.Filter, error)
+
+	// GetWordFilter fetches the word filter with ID from the database.
+	GetWordFilter(ctx context.Context, id string) (*gtsmodel.WordFilter, error)
+
+	// GetWordFilters fetches all word filters from the database.
+	GetWordFilters(ctx context.Context) ([]*gtsmodel.WordFilter, error)
+
+	// PutWordFilter inserts the given word filter into the database.
+	PutWordFilter(ctx context.Context, filter *gtsmodel.WordFilter) error
+
+	// UpdateWordFilter updates the given word filter in the database, only updating given columns if provided.
+	UpdateWordFilter(ctx context.Context, filter *gtsmodel.WordFilter, cols ...string) error
+
+	// IncrementWordFilterMatches increments the matches count of the word filter with ID,
+	// and sets its last matched time to the given time. The word filter cache is left as-is.
+	IncrementWordFilterMatches(ctx context.Context, id string, matchedAt time.Time) error
+
+	// DeleteWordFilter deletes the word filter with ID from the database.
+	DeleteWordFilter(ctx context.Context, id string) error
+}
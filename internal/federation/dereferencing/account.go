@@ -149,7 +149,7 @@ func (d *Dereferencer) getAccountByURI(ctx context.Context, requestUser string,
 
 	if account == nil {
 		// Ensure that this is isn't a search for a local account.
-		if uri.Host == config.GetHost() || uri.Host == config.GetAccountDomain() {
+		if config.IsLocalDomain(uri.Host) {
 			return nil, nil, gtserror.SetUnretrievable(err) // this will be db.ErrNoEntries
 		}
 
@@ -237,7 +237,7 @@ func (d *Dereferencer) getAccountByUsernameDomain(
 	username string,
 	domain string,
 ) (*gtsmodel.Account, ap.Accountable, error) {
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if config.IsLocalDomain(domain) {
 		// We do local lookups using an empty domain,
 		// else it will fail the db search below.
 		domain = ""
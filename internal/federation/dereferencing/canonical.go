@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// ResolveCanonicalURI attempts to resolve the canonical ActivityPub URI
+// of the object at the given URI, which may be a web URL of a status or
+// account (e.g. https://example.org/@user/12345). This does NOT deref the
+// object itself, the result should be passed to the usual Get___ByURI().
+func (d *Dereferencer) ResolveCanonicalURI(ctx context.Context, requestUser string, uri *url.URL) (*url.URL, error) {
+	// Check whether this URI is a blocked domain / subdomain.
+	if blocked, err := d.state.DB.IsDomainBlocked(ctx, uri.Host); err != nil {
+		return nil, gtserror.Newf("error checking blocked domain: %w", err)
+	} else if blocked {
+		err = gtserror.Newf("%s is blocked", uri.Host)
+		return nil, gtserror.SetUnretrievable(err)
+	}
+
+	tsport, err := d.transportController.NewTransportForUsername(ctx, requestUser)
+	if err != nil {
+		return nil, gtserror.Newf("couldn't create transport: %w", err)
+	}
+
+	canonical, err := tsport.DereferenceCanonicalURI(ctx, uri)
+	if err != nil {
+		err := gtserror.Newf("error resolving canonical uri of %s: %w", uri, err)
+		return nil, gtserror.SetUnretrievable(err)
+	}
+
+	return canonical, nil
+}
@@ -143,11 +143,25 @@ func (d *Dereferencer) getStatusByURI(ctx context.Context, requestUser string, u
 	if status == nil {
 		// Ensure not a failed search for a local
 		// status, if so we know it doesn't exist.
-		if uri.Host == config.GetHost() ||
-			uri.Host == config.GetAccountDomain() {
+		if config.IsLocalDomain(uri.Host) {
 			return nil, nil, false, gtserror.SetUnretrievable(err)
 		}
 
+		if !gtscontext.ExplicitLookup(ctx) {
+			// Check whether this status was previously purged
+			// from the instance, in which case we don't re-fetch
+			// it unless explicitly looked up by a local user.
+			tombstoned, err := d.state.DB.TombstoneExistsWithURI(ctx, uriStr)
+			if err != nil {
+				return nil, nil, false, gtserror.Newf("error checking database for tombstone %s: %w", uriStr, err)
+			}
+
+			if tombstoned {
+				err := gtserror.Newf("status %s has been tombstoned", uriStr)
+				return nil, nil, false, gtserror.SetUnretrievable(err)
+			}
+		}
+
 		// Create and pass-through a new bare-bones model for deref.
 		return d.enrichStatusSafely(ctx, requestUser, uri, &gtsmodel.Status{
 			Local: util.Ptr(false),
@@ -501,6 +515,7 @@ func (d *Dereferencer) enrichStatus(
 	latestStatus.UpdatedAt = status.UpdatedAt
 	latestStatus.FetchedAt = time.Now()
 	latestStatus.Local = status.Local
+	latestStatus.MediaEditedAt = status.MediaEditedAt
 
 	// Check if this is a permitted status we should accept.
 	permit, err := d.isPermittedStatus(ctx, status, latestStatus)
@@ -536,12 +551,18 @@ func (d *Dereferencer) enrichStatus(
 	}
 
 	// Ensure the status' media attachments are populated, passing in existing to check for changes.
-	if err := d.fetchStatusAttachments(ctx, tsport, status, latestStatus); err != nil {
+	mediaChanged, removedMedia, err := d.fetchStatusAttachments(ctx, tsport, status, latestStatus)
+	if err != nil {
 		return nil, nil, gtserror.Newf("error populating attachments for status %s: %w", uri, err)
 	}
 
-	// Ensure the status' emoji attachments are populated, (changes are expected / okay).
-	if err := d.fetchStatusEmojis(ctx, requestUser, latestStatus); err != nil {
+	if !isNew && mediaChanged {
+		// Note that media was changed in this edit.
+		latestStatus.MediaEditedAt = latestStatus.FetchedAt
+	}
+
+	// Ensure the status' emoji attachments are populated, passing in existing to check for changes.
+	if err := d.fetchStatusEmojis(ctx, requestUser, status, latestStatus); err != nil {
 		return nil, nil, gtserror.Newf("error populating emojis for status %s: %w", uri, err)
 	}
 
@@ -556,6 +577,14 @@ func (d *Dereferencer) enrichStatus(
 		if err := d.state.DB.UpdateStatus(ctx, latestStatus); err != nil {
 			return nil, nil, gtserror.Newf("error updating database: %w", err)
 		}
+
+		// Now no longer referenced, remove any
+		// media that disappeared from the status.
+		for _, attachment := range removedMedia {
+			if err := d.removeAttachment(ctx, attachment); err != nil {
+				log.Errorf(ctx, "error removing attachment: %v", err)
+			}
+		}
 	}
 
 	return latestStatus, apubStatus, nil
@@ -990,7 +1019,24 @@ func (d *Dereferencer) fetchStatusPoll(ctx context.Context, existing, status *gt
 	}
 }
 
-func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transport.Transport, existing, status *gtsmodel.Status) error {
+// fetchStatusAttachments populates the media attachments of status,
+// diffing them against those of existing by remote URL. Unchanged
+// attachments are reused, only updating description / blurhash
+// where changed, and only genuinely new attachments are downloaded.
+// Returns whether media changed, and the existing attachments that
+// have disappeared from the status, to be removed by the caller.
+func (d *Dereferencer) fetchStatusAttachments(
+	ctx context.Context,
+	tsport transport.Transport,
+	existing *gtsmodel.Status,
+	status *gtsmodel.Status,
+) (
+	bool,
+	[]*gtsmodel.MediaAttachment,
+	error,
+) {
+	var changed bool
+
 	// Allocate new slice to take the yet-to-be fetched attachment IDs.
 	status.AttachmentIDs = make([]string, len(status.Attachments))
 
@@ -998,11 +1044,19 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 		attachment := status.Attachments[i]
 
 		// Look for existing media attachment with remote URL first.
-		existing, ok := existing.GetAttachmentByRemoteURL(attachment.RemoteURL)
-		if ok && existing.ID != "" {
+		prev, ok := existing.GetAttachmentByRemoteURL(attachment.RemoteURL)
+		if ok && prev.ID != "" {
+
+			// Check for changed details. Note that focus
+			// is not federated, so is never updated here.
+			if prev.Description != attachment.Description ||
+				util.PtrValueOr(prev.Sensitive, false) != util.PtrValueOr(attachment.Sensitive, false) ||
+				(attachment.Blurhash != "" && prev.Blurhash != attachment.Blurhash) {
+				changed = true
+			}
 
 			// Ensure the existing media attachment is up-to-date and cached.
-			existing, err := d.updateAttachment(ctx, tsport, existing, attachment)
+			updated, err := d.updateAttachment(ctx, tsport, prev, attachment)
 			if err != nil {
 				log.Errorf(ctx, "error updating existing attachment: %v", err)
 
@@ -1012,9 +1066,13 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 				// log that an update for it failed.
 			}
 
+			if updated != nil && updated.ID != "" {
+				prev = updated
+			}
+
 			// Set the existing attachment.
-			status.Attachments[i] = existing
-			status.AttachmentIDs[i] = existing.ID
+			status.Attachments[i] = prev
+			status.AttachmentIDs[i] = prev.ID
 			continue
 		}
 
@@ -1029,6 +1087,7 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 				RemoteURL:   &attachment.RemoteURL,
 				Description: &attachment.Description,
 				Blurhash:    &attachment.Blurhash,
+				Sensitive:   attachment.Sensitive,
 			},
 		)
 		if err != nil && attachment == nil {
@@ -1058,14 +1117,54 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 		i++
 	}
 
-	return nil
+	// Added, removed or
+	// reordered attachments.
+	if !slices.Equal(
+		existing.AttachmentIDs,
+		status.AttachmentIDs,
+	) {
+		changed = true
+	}
+
+	// Gather existing attachments of this
+	// status that are no longer attached.
+	var removed []*gtsmodel.MediaAttachment
+	for _, prev := range existing.Attachments {
+		if prev.StatusID == existing.ID &&
+			!slices.Contains(status.AttachmentIDs, prev.ID) {
+			removed = append(removed, prev)
+		}
+	}
+
+	return changed, removed, nil
 }
 
-func (d *Dereferencer) fetchStatusEmojis(ctx context.Context, requestUser string, status *gtsmodel.Status) error {
-	// Fetch the full-fleshed-out emoji objects for our status.
-	emojis, err := d.populateEmojis(ctx, status.Emojis, requestUser)
-	if err != nil {
-		return gtserror.Newf("failed to populate emojis: %w", err)
+// fetchStatusEmojis populates the emojis of status, diffing them
+// against those of existing by shortcode and domain. Unchanged
+// emojis are reused as-is, only new or changed emojis are fetched.
+func (d *Dereferencer) fetchStatusEmojis(
+	ctx context.Context,
+	requestUser string,
+	existing *gtsmodel.Status,
+	status *gtsmodel.Status,
+) error {
+	emojis := make([]*gtsmodel.Emoji, 0, len(status.Emojis))
+
+	for _, e := range status.Emojis {
+		// Look for unchanged existing emoji with shortcode first.
+		prev, ok := existing.GetEmojiByShortcodeDomain(e.Shortcode, e.Domain)
+		if ok && prev.ID != "" && !emojiChanged(prev, e) {
+			emojis = append(emojis, prev)
+			continue
+		}
+
+		// Fetch the full-fleshed-out new (or changed) emoji.
+		got, err := d.populateEmojis(ctx, []*gtsmodel.Emoji{e}, requestUser)
+		if err != nil {
+			return gtserror.Newf("failed to populate emojis: %w", err)
+		}
+
+		emojis = append(emojis, got...)
 	}
 
 	// Iterate over and get their IDs.
@@ -1080,3 +1179,11 @@ func (d *Dereferencer) fetchStatusEmojis(ctx context.Context, requestUser string
 
 	return nil
 }
+
+// emojiChanged returns whether the incoming emoji has changed since
+// existing was fetched, using the same checks as populateEmojis().
+func emojiChanged(existing, incoming *gtsmodel.Emoji) bool {
+	return incoming.UpdatedAt.Unix() > existing.ImageUpdatedAt.Unix() ||
+		incoming.URI != existing.URI ||
+		incoming.ImageRemoteURL != existing.ImageRemoteURL
+}
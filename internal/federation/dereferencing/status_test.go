@@ -23,9 +23,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -241,6 +245,89 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithNonMatchingURI() {
 	suite.Nil(fetchedStatus)
 }
 
+func (suite *StatusTestSuite) TestDereferenceTombstonedStatus() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+	statusURL := testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839")
+
+	// Tombstone the status, as if purged by the retention policy.
+	suite.NoError(suite.db.PutTombstone(context.Background(), &gtsmodel.Tombstone{
+		ID:     id.NewULID(),
+		Domain: statusURL.Host,
+		URI:    statusURL.String(),
+	}))
+
+	// It should not be dereferenced.
+	status, _, err := suite.dereferencer.GetStatusByURI(context.Background(), fetchingAccount.Username, statusURL)
+	suite.True(gtserror.IsUnretrievable(err))
+	suite.Nil(status)
+
+	// Unless explicitly looked up.
+	ctx := gtscontext.SetExplicitLookup(context.Background())
+	status, _, err = suite.dereferencer.GetStatusByURI(ctx, fetchingAccount.Username, statusURL)
+	suite.NoError(err)
+	suite.NotNil(status)
+}
+
+func (suite *StatusTestSuite) TestDereferenceStatusUpdateMedia() {
+	ctx := context.Background()
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	const statusURI = "https://turnip.farm/users/turniplover6969/statuses/70c53e54-3146-42d5-a630-83c8b6c7c042"
+
+	// Dereference the status with its single attachment.
+	status, _, err := suite.dereferencer.GetStatusByURI(ctx, fetchingAccount.Username, testrig.URLMustParse(statusURI))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(status.AttachmentIDs, 1)
+	attachmentID := status.AttachmentIDs[0]
+
+	// Refresh status with unchanged note, the
+	// attachment should be reused, not re-created.
+	note := suite.client.TestRemoteStatuses[statusURI]
+	status, _, err = suite.dereferencer.RefreshStatus(ctx, fetchingAccount.Username, status, note, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{attachmentID}, status.AttachmentIDs)
+	suite.Zero(status.MediaEditedAt)
+
+	// Change the attachment description.
+	image := note.GetActivityStreamsAttachment().At(0).GetActivityStreamsImage()
+	nameProp := streams.NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString("a lovely turnip")
+	image.SetActivityStreamsName(nameProp)
+
+	// Refresh again, the attachment should be
+	// reused, with only description updated.
+	status, _, err = suite.dereferencer.RefreshStatus(ctx, fetchingAccount.Username, status, note, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{attachmentID}, status.AttachmentIDs)
+	suite.Equal("a lovely turnip", status.Attachments[0].Description)
+	suite.NotZero(status.MediaEditedAt)
+
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("a lovely turnip", dbAttachment.Description)
+
+	// Remove the attachment from the note.
+	note.SetActivityStreamsAttachment(streams.NewActivityStreamsAttachmentProperty())
+
+	status, _, err = suite.dereferencer.RefreshStatus(ctx, fetchingAccount.Username, status, note, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(status.AttachmentIDs)
+
+	// The disappeared attachment should now be deleted.
+	_, err = suite.db.GetAttachmentByID(ctx, attachmentID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
@@ -19,13 +19,16 @@ package dereferencing
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/url"
 	"slices"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -75,7 +78,7 @@ func (d *Dereferencer) updateAttachment(
 ) {
 	if media != nil {
 		// Possible changed media columns.
-		changed := make([]string, 0, 3)
+		changed := make([]string, 0, 4)
 
 		// Check if attachment description has changed.
 		if existing.Description != media.Description {
@@ -83,6 +86,12 @@ func (d *Dereferencer) updateAttachment(
 			existing.Description = media.Description
 		}
 
+		// Check if attachment sensitivity has changed.
+		if media.Sensitive != nil && util.PtrValueOr(existing.Sensitive, false) != *media.Sensitive {
+			changed = append(changed, "sensitive")
+			existing.Sensitive = media.Sensitive
+		}
+
 		// Check if attachment blurhash has changed (i.e. content change).
 		if existing.Blurhash != media.Blurhash && media.Blurhash != "" {
 			changed = append(changed, "blurhash", "cached")
@@ -134,6 +143,37 @@ func (d *Dereferencer) updateAttachment(
 	return existing, err
 }
 
+// removeAttachment handles the case of an existing media attachment
+// that has been removed from its status, deleting its stored files
+// (if any) and then the attachment itself from the database.
+func (d *Dereferencer) removeAttachment(
+	ctx context.Context,
+	attachment *gtsmodel.MediaAttachment,
+) error {
+	for _, path := range []string{
+		attachment.File.Path,
+		attachment.Thumbnail.Path,
+	} {
+		if path == "" {
+			continue
+		}
+
+		// Remove file from storage, ignoring if already gone.
+		if err := d.state.Storage.Delete(ctx, path); err != nil &&
+			!storage.IsNotFound(err) {
+			return gtserror.Newf("error removing media file %s: %w", path, err)
+		}
+	}
+
+	// Delete the attachment from the database, ignoring if already gone.
+	if err := d.state.DB.DeleteAttachment(ctx, attachment.ID); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting media %s: %w", attachment.ID, err)
+	}
+
+	return nil
+}
+
 // pollChanged returns whether a poll has changed in way that
 // indicates that this should be an entirely new poll. i.e. if
 // the available options have changed, or the expiry has increased.
@@ -170,11 +170,6 @@ func prepMentions(
 	ctx context.Context,
 	mentions []*gtsmodel.Mention,
 ) []preppedMention {
-	var (
-		host          = config.GetHost()
-		accountDomain = config.GetAccountDomain()
-	)
-
 	parsedMentions := make([]preppedMention, 0, len(mentions))
 	for _, mention := range mentions {
 		// Start by just embedding
@@ -223,7 +218,7 @@ func prepMentions(
 		}
 
 		// It's a mention of a local account if the target host is us.
-		parsedMention.local = parsedMention.domain == host || parsedMention.domain == accountDomain
+		parsedMention.local = config.IsLocalDomain(parsedMention.domain)
 
 		// Done with this one.
 		parsedMentions = append(parsedMentions, parsedMention)
@@ -24,7 +24,6 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 // AccountVisible will check if given account is visible to requester, accounting for requester with no auth (i.e is nil), suspensions, disabled local users and account blocks.
@@ -39,6 +38,11 @@ func (f *Filter) AccountVisible(ctx context.Context, requester *gtsmodel.Account
 		requesterID = requester.ID
 	}
 
+	if explaining(ctx) != nil {
+		// Bypass cache in explain mode.
+		return f.isAccountVisibleTo(ctx, requester, account)
+	}
+
 	visibility, err := f.state.Caches.Visibility.LoadOne("Type,RequesterID,ItemID", func() (*cache.CachedVisibility, error) {
 		// Visibility not yet cached, perform visibility lookup.
 		visible, err := f.isAccountVisibleTo(ctx, requester, account)
@@ -70,7 +74,7 @@ func (f *Filter) isAccountVisibleTo(ctx context.Context, requester *gtsmodel.Acc
 	}
 
 	if !visible {
-		log.Trace(ctx, "target account is not visible to anyone")
+		explain(ctx, "target account is not visible to anyone")
 		return false, nil
 	}
 
@@ -87,7 +91,7 @@ func (f *Filter) isAccountVisibleTo(ctx context.Context, requester *gtsmodel.Acc
 	}
 
 	if !visible {
-		log.Trace(ctx, "requesting account cannot see other accounts")
+		explain(ctx, "requesting account cannot see other accounts")
 		return false, nil
 	}
 
@@ -101,7 +105,7 @@ func (f *Filter) isAccountVisibleTo(ctx context.Context, requester *gtsmodel.Acc
 	}
 
 	if blocked {
-		log.Trace(ctx, "block exists between accounts")
+		explain(ctx, "block exists between accounts")
 		return false, nil
 	}
 
@@ -127,7 +131,7 @@ func (f *Filter) isAccountVisible(ctx context.Context, account *gtsmodel.Account
 
 		// Make sure that user is active (i.e. not disabled, not approved etc).
 		if *user.Disabled || !*user.Approved || user.ConfirmedAt.IsZero() {
-			log.Trace(ctx, "local account not active")
+			explain(ctx, "local account not active")
 			return false, nil
 		}
 	} else {
@@ -140,13 +144,13 @@ func (f *Filter) isAccountVisible(ctx context.Context, account *gtsmodel.Account
 		}
 
 		if blocked {
-			log.Trace(ctx, "remote account domain blocked")
+			explain(ctx, "remote account domain blocked")
 			return false, nil
 		}
 	}
 
 	if !account.SuspendedAt.IsZero() {
-		log.Trace(ctx, "account suspended")
+		explain(ctx, "account suspended")
 		return false, nil
 	}
 
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// explainKey is the context key under which
+// an *Explanation is stored in explain mode.
+type explainKey struct{}
+
+// Explanation collects the reasons given by visibility
+// checks run in explain mode, for debugging why an item
+// is or isn't visible to a requester. See Explain().
+type Explanation struct {
+	reasons []string
+}
+
+// Reasons returns the reasons recorded so far, in the
+// order the checks were run. Checks only give a reason
+// when they deny visibility, so an empty slice along
+// with a positive result means nothing was in the way.
+func (e *Explanation) Reasons() []string {
+	return e.reasons
+}
+
+// Explain returns a copy of ctx with explain mode set, and the
+// Explanation that visibility checks run with it will record to.
+//
+// In explain mode visibility results are neither read from nor
+// stored in the visibility cache, so each check is run in full.
+// This is slow, so it is only intended for debugging purposes.
+func Explain(ctx context.Context) (context.Context, *Explanation) {
+	e := new(Explanation)
+	return context.WithValue(ctx, explainKey{}, e), e
+}
+
+// explaining returns the Explanation set on ctx, if in explain mode.
+func explaining(ctx context.Context) *Explanation {
+	e, _ := ctx.Value(explainKey{}).(*Explanation)
+	return e
+}
+
+// explain logs the given reason for a visibility check
+// outcome at trace level, also recording it to the ctx
+// Explanation if in explain mode.
+func explain(ctx context.Context, reason string) {
+	log.Trace(ctx, reason)
+	if e := explaining(ctx); e != nil {
+		e.reasons = append(e.reasons, reason)
+	}
+}
@@ -42,6 +42,17 @@ func (f *Filter) StatusHomeTimelineable(ctx context.Context, owner *gtsmodel.Acc
 		requesterID = owner.ID
 	}
 
+	if explaining(ctx) != nil {
+		// Bypass cache in explain mode.
+		visible, err := f.isStatusHomeTimelineable(ctx, owner, status)
+		if err == cache.SentinelError {
+			// Filter-out our temporary
+			// race-condition error.
+			return false, nil
+		}
+		return visible, err
+	}
+
 	visibility, err := f.state.Caches.Visibility.LoadOne("Type,RequesterID,ItemID", func() (*cache.CachedVisibility, error) {
 		// Visibility not yet cached, perform timeline visibility lookup.
 		visible, err := f.isStatusHomeTimelineable(ctx, owner, status)
@@ -74,6 +85,7 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 	if status.CreatedAt.After(time.Now().Add(24 * time.Hour)) {
 		// Statuses made over 1 day in the future we don't show...
 		log.Warnf(ctx, "status >24hrs in the future: %+v", status)
+		explain(ctx, "status more than 24 hours in the future")
 		return false, nil
 	}
 
@@ -84,7 +96,7 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 	}
 
 	if !visible {
-		log.Trace(ctx, "status not visible to timeline owner")
+		explain(ctx, "status not visible to timeline owner")
 		return false, nil
 	}
 
@@ -134,7 +146,7 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 		}
 
 		if notVisible {
-			log.Tracef(ctx, "conversation not visible to timeline owner")
+			explain(ctx, "conversation not visible to timeline owner")
 			return false, nil
 		}
 
@@ -157,6 +169,7 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 		// Check parent is deref'd.
 		if next.InReplyToID == "" {
 			log.Debugf(ctx, "status not (yet) deref'd: %s", next.InReplyToURI)
+			explain(ctx, "status in conversation not yet dereferenced")
 			return false, cache.SentinelError
 		}
 
@@ -171,7 +184,7 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 	}
 
 	if next != status && !oneAuthor && !visible {
-		log.Trace(ctx, "ignoring visible reply in conversation irrelevant to owner")
+		explain(ctx, "ignoring visible reply in conversation irrelevant to owner")
 		return false, nil
 	}
 
@@ -190,13 +203,14 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 	}
 
 	if follow == nil {
-		log.Trace(ctx, "ignoring status from unfollowed author")
+		explain(ctx, "ignoring status from unfollowed author")
 		return false, nil
 	}
 
 	if status.BoostOfID != "" && !*follow.ShowReblogs {
 		// Status is a boost, but the owner of this follow
 		// doesn't want to see boosts from this account.
+		explain(ctx, "owner hides boosts from author")
 		return false, nil
 	}
 
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// StatusListEligible checks if the given status is eligible for inclusion
+// in the list with given ID, based on the replies policy of the list. This
+// does not check visibility to the list owner, for which callers should
+// also check Filter{}.StatusHomeTimelineable().
+func (f *Filter) StatusListEligible(ctx context.Context, listID string, status *gtsmodel.Status) (bool, error) {
+	if status.InReplyToURI == "" {
+		// If status is not a reply,
+		// then it's all gravy baby.
+		return true, nil
+	}
+
+	if status.InReplyToID == "" {
+		// Status is a reply but we don't
+		// have the replied-to account!
+		explain(ctx, "replied-to status not yet dereferenced")
+		return false, nil
+	}
+
+	// Status is a reply to a known account.
+	// We need to fetch the list in order
+	// to check the list's replies policy.
+	list, err := f.state.DB.GetListByID(ctx, listID)
+	if err != nil {
+		err := gtserror.Newf("db error getting list %s: %w", listID, err)
+		return false, err
+	}
+
+	switch list.RepliesPolicy {
+	case gtsmodel.RepliesPolicyNone:
+		// This list should not show
+		// replies at all, so skip it.
+		explain(ctx, "list does not show replies")
+		return false, nil
+
+	case gtsmodel.RepliesPolicyList:
+		// This list should show replies
+		// only to other people in the list.
+		//
+		// Check if replied-to account is
+		// also included in this list.
+		includes, err := f.state.DB.ListIncludesAccount(
+			ctx,
+			list.ID,
+			status.InReplyToAccountID,
+		)
+		if err != nil {
+			err := gtserror.Newf(
+				"db error checking if account %s in list %s: %w",
+				status.InReplyToAccountID, list.ID, err,
+			)
+			return false, err
+		}
+
+		if !includes {
+			explain(ctx, "list only shows replies to list members")
+		}
+
+		return includes, nil
+
+	case gtsmodel.RepliesPolicyFollowed:
+		// This list should show replies
+		// only to people that the list
+		// owner also follows.
+		//
+		// Check if replied-to account is
+		// followed by list owner account.
+		follows, err := f.state.DB.IsFollowing(
+			ctx,
+			list.AccountID,
+			status.InReplyToAccountID,
+		)
+		if err != nil {
+			err := gtserror.Newf(
+				"db error checking if account %s is followed by %s: %w",
+				status.InReplyToAccountID, list.AccountID, err,
+			)
+			return false, err
+		}
+
+		if !follows {
+			explain(ctx, "list only shows replies to followed accounts")
+		}
+
+		return follows, nil
+
+	default:
+		// HUH??
+		err := gtserror.Newf(
+			"reply policy '%s' not recognized on list %s",
+			list.RepliesPolicy, list.ID,
+		)
+		return false, err
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // StatusHomeTimelineable checks if given status should be included on requester's public timeline. Primarily relying on status visibility to requester and the AP visibility setting, and ignoring conversation threads.
@@ -116,8 +117,59 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 		}
 	}
 
+	if !util.PtrValueOr(status.Local, false) {
+		// Statuses from limited domains are only
+		// shown to those following the author.
+		limited, err := f.isAuthorDomainLimited(ctx, status)
+		if err != nil {
+			return false, err
+		}
+
+		if limited {
+			if requester == nil {
+				log.Trace(ctx, "status author domain limited")
+				return false, nil
+			}
+
+			following, err := f.state.DB.IsFollowing(ctx, requester.ID, status.AccountID)
+			if err != nil {
+				return false, gtserror.Newf("error checking follow %s->%s: %w", requester.ID, status.AccountID, err)
+			}
+
+			if !following {
+				log.Trace(ctx, "status author domain limited, and not followed by requester")
+				return false, nil
+			}
+		}
+	}
+
 	// This is either a visible status in a
 	// single-author thread, or a visible top
 	// level status. Show on public timeline.
 	return true, nil
 }
+
+// isAuthorDomainLimited checks whether the
+// domain of the status author is limited.
+func (f *Filter) isAuthorDomainLimited(ctx context.Context, status *gtsmodel.Status) (bool, error) {
+	author := status.Account
+	if author == nil {
+		var err error
+
+		// Author not populated, fetch from DB.
+		author, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			status.AccountID,
+		)
+		if err != nil {
+			return false, gtserror.Newf("error getting status author %s: %w", status.AccountID, err)
+		}
+	}
+
+	limited, err := f.state.DB.IsDomainLimited(ctx, author.Domain)
+	if err != nil {
+		return false, gtserror.Newf("error checking domain limit %s: %w", author.Domain, err)
+	}
+
+	return limited, nil
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type StatusPublicTimelineableTestSuite struct {
+	FilterStandardTestSuite
+}
+
+func (suite *StatusPublicTimelineableTestSuite) limitDomain(domain string) {
+	if err := suite.db.CreateDomainBlock(context.Background(), &gtsmodel.DomainBlock{
+		ID:                 id.NewULID(),
+		Domain:             domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Obfuscate:          util.Ptr(false),
+		Severity:           gtsmodel.DomainBlockLimit,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *StatusPublicTimelineableTestSuite) TestRemoteStatusPublicTimelineable() {
+	testStatus := suite.testStatuses["remote_account_1_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	timelineable, err := suite.filter.StatusPublicTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
+func (suite *StatusPublicTimelineableTestSuite) TestLimitedDomainStatusPublicTimelineable() {
+	testStatus := suite.testStatuses["remote_account_1_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	suite.limitDomain("fossbros-anonymous.io")
+
+	// Limited domain isn't blocked.
+	blocked, err := suite.db.IsDomainBlocked(ctx, "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// Not shown to unauthed requesters.
+	timelineable, err := suite.filter.StatusPublicTimelineable(ctx, nil, testStatus)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// Not shown to requesters who don't follow the author.
+	timelineable, err = suite.filter.StatusPublicTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// Follow the author.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              id.NewULID(),
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/01J1B9XZ0QZ7MGAAAQJ1JN2WRN",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Shown to followers of the author.
+	timelineable, err = suite.filter.StatusPublicTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
+func (suite *StatusPublicTimelineableTestSuite) TestLimitedDomainLocalStatusPublicTimelineable() {
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	// Limits never apply to our own domain.
+	suite.limitDomain("localhost:8080")
+
+	timelineable, err := suite.filter.StatusPublicTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
+func TestStatusPublicTimelineableTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusPublicTimelineableTestSuite))
+}
@@ -53,6 +53,11 @@ func (f *Filter) StatusVisible(ctx context.Context, requester *gtsmodel.Account,
 		requesterID = requester.ID
 	}
 
+	if explaining(ctx) != nil {
+		// Bypass cache in explain mode.
+		return f.isStatusVisible(ctx, requester, status)
+	}
+
 	visibility, err := f.state.Caches.Visibility.LoadOne("Type,RequesterID,ItemID", func() (*cache.CachedVisibility, error) {
 		// Visibility not yet cached, perform visibility lookup.
 		visible, err := f.isStatusVisible(ctx, requester, status)
@@ -97,7 +102,7 @@ func (f *Filter) isStatusVisible(ctx context.Context, requester *gtsmodel.Accoun
 
 	if requester == nil {
 		// This request is WITHOUT auth, and status is NOT public.
-		log.Trace(ctx, "unauthorized request to non-public status")
+		explain(ctx, "unauthorized request to non-public status")
 		return false, nil
 	}
 
@@ -143,7 +148,7 @@ func (f *Filter) isStatusVisible(ctx context.Context, requester *gtsmodel.Accoun
 		}
 
 		if !follows {
-			log.Trace(ctx, "follow-only status not visible to requester")
+			explain(ctx, "follow-only status not visible to requester")
 			return false, nil
 		}
 
@@ -160,18 +165,19 @@ func (f *Filter) isStatusVisible(ctx context.Context, requester *gtsmodel.Accoun
 		}
 
 		if !mutuals {
-			log.Trace(ctx, "mutual-only status not visible to requester")
+			explain(ctx, "mutual-only status not visible to requester")
 			return false, nil
 		}
 
 		return true, nil
 
 	case gtsmodel.VisibilityDirect:
-		log.Trace(ctx, "direct status not visible to requester")
+		explain(ctx, "direct status not visible to requester")
 		return false, nil
 
 	default:
 		log.Warnf(ctx, "unexpected status visibility %s for %s", status.Visibility, status.URI)
+		explain(ctx, "unexpected status visibility")
 		return false, nil
 	}
 }
@@ -185,7 +191,7 @@ func (f *Filter) areStatusAccountsVisible(ctx context.Context, requester *gtsmod
 	}
 
 	if !visible {
-		log.Trace(ctx, "status author not visible to requester")
+		explain(ctx, "status author not visible to requester")
 		return false, nil
 	}
 
@@ -204,7 +210,7 @@ func (f *Filter) areStatusAccountsVisible(ctx context.Context, requester *gtsmod
 		}
 
 		if !visible {
-			log.Trace(ctx, "boosted status author not visible to requester")
+			explain(ctx, "boosted status author not visible to requester")
 			return false, nil
 		}
 	}
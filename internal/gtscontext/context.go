@@ -44,6 +44,9 @@ const (
 	httpSigPubKeyIDKey
 	dryRunKey
 	httpClientSignFnKey
+	obfuscateIDsKey
+	maxRedirectsKey
+	explicitLookupKey
 )
 
 // DryRun returns whether the "dryrun" context key has been set. This can be
@@ -60,6 +63,34 @@ func SetDryRun(ctx context.Context) context.Context {
 	return context.WithValue(ctx, dryRunKey, struct{}{})
 }
 
+// ExplicitLookup returns whether the "explicitlookup" context key has been set.
+// This indicates that a remote resource is being looked up explicitly on behalf
+// of a local user, (e.g. by URL in search), and so should be fetched even if it
+// has previously been tombstoned.
+func ExplicitLookup(ctx context.Context) bool {
+	_, ok := ctx.Value(explicitLookupKey).(struct{})
+	return ok
+}
+
+// SetExplicitLookup sets the "explicitlookup" context flag and returns this wrapped
+// context. See ExplicitLookup() for further information on the "explicitlookup" flag.
+func SetExplicitLookup(ctx context.Context) context.Context {
+	return context.WithValue(ctx, explicitLookupKey, struct{}{})
+}
+
+// ObfuscateIDs returns whether the "obfuscateids" context key has been set.
+// This indicates that IDs in client API responses should be obfuscated.
+func ObfuscateIDs(ctx context.Context) bool {
+	_, ok := ctx.Value(obfuscateIDsKey).(struct{})
+	return ok
+}
+
+// SetObfuscateIDs sets the "obfuscateids" context flag and returns this wrapped
+// context. See ObfuscateIDs() for further information on the "obfuscateids" flag.
+func SetObfuscateIDs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, obfuscateIDsKey, struct{}{})
+}
+
 // RequestID returns the request ID associated with context. This value will usually
 // be set by the request ID middleware handler, either pulling an existing supplied
 // value from request headers, or generating a unique new entry. This is useful for
@@ -195,6 +226,20 @@ func SetFastFail(ctx context.Context) context.Context {
 	return context.WithValue(ctx, fastFailKey, struct{}{})
 }
 
+// MaxRedirects returns the maximum number of redirects an http client
+// should follow for an outgoing request, if the "maxredirects" context
+// key has been set. Otherwise the http client's default is used.
+func MaxRedirects(ctx context.Context) (int, bool) {
+	max, ok := ctx.Value(maxRedirectsKey).(int)
+	return max, ok
+}
+
+// SetMaxRedirects sets the "maxredirects" context key and returns this wrapped
+// context. See MaxRedirects() for further information on the "maxredirects" key.
+func SetMaxRedirects(ctx context.Context, max int) context.Context {
+	return context.WithValue(ctx, maxRedirectsKey, max)
+}
+
 // Barebones returns whether the "barebones" context key has been set. This
 // can be used to indicate to the database, for example, that only a barebones
 // model need be returned, Allowing it to skip populating sub models.
@@ -27,6 +27,15 @@ type errkey int
 // ErrorType denotes the type of an error, if set.
 type ErrorType string
 
+// Error types that may be served to API
+// callers as a machine-readable error_code.
+const (
+	// TypeMediaDescriptionMissing indicates that a status
+	// could not be created because one of its attachments
+	// was missing a required description (alt text).
+	TypeMediaDescriptionMissing ErrorType = "ERR_MEDIA_DESCRIPTION_MISSING"
+)
+
 const (
 	// error value keys.
 	_ errkey = iota
@@ -92,6 +101,20 @@ func SetWrongType(err error) error {
 	return errors.WithValue(err, wrongTypeKey, struct{}{})
 }
 
+// Type checks error for a stored error type value, returning
+// an empty string if no type is set. For example an API error
+// that clients are expected to handle in a particular way.
+func Type(err error) ErrorType {
+	t, _ := errors.Value(err, errorTypeKey).(ErrorType)
+	return t
+}
+
+// SetType will wrap the given error to store provided error
+// type, returning wrapped error. See Type() for example use-cases.
+func SetType(err error, errType ErrorType) error {
+	return errors.WithValue(err, errorTypeKey, errType)
+}
+
 // StatusCode checks error for a stored status code value. For example
 // an error from an outgoing HTTP request may be stored, or an API handler
 // expected response status code may be stored.
@@ -79,13 +79,46 @@ type Account struct {
 	SilencedAt              time.Time        `bun:"type:timestamptz,nullzero"`                                   // When was this account silenced (eg., statuses only visible to followers, not public)?
 	SuspendedAt             time.Time        `bun:"type:timestamptz,nullzero"`                                   // When was this account suspended (eg., don't allow it to log in/post, don't accept media/posts from this account)
 	SuspensionOrigin        string           `bun:"type:CHAR(26),nullzero"`                                      // id of the database entry that caused this account to become suspended -- can be an account ID or a domain block ID
+	MediaQuotaBytes         int64            `bun:",nullzero"`                                                   // Max total size in bytes of media this (local) account may upload. 0 = use instance default, < 0 = no limit.
+	CharacterLimitOverride  int              `bun:",nullzero"`                                                   // Max characters permitted in statuses created by this (local) account. 0 = use instance default.
+	AccountDomain           string           `bun:",nullzero"`                                                   // Account domain used in the name of this (local) account, if not the default account-domain. Must be one of the configured account-domains.
 	Settings                *AccountSettings `bun:"-"`                                                           // gtsmodel.AccountSettings for this account.
 	Stats                   *AccountStats    `bun:"-"`                                                           // gtsmodel.AccountStats for this account.
 }
 
 // IsLocal returns whether account is a local user account.
 func (a *Account) IsLocal() bool {
-	return a.Domain == "" || a.Domain == config.GetHost() || a.Domain == config.GetAccountDomain()
+	return a.Domain == "" || config.IsLocalDomain(a.Domain)
+}
+
+// NameDomain returns the domain used in the
+// namestring of this account, ie., the "example.org"
+// in "@someone@example.org". For remote accounts this
+// is just the account domain. For local accounts this
+// is the account domain they chose, if any, falling
+// back to the instance's default account-domain.
+func (a *Account) NameDomain() string {
+	if !a.IsLocal() {
+		return a.Domain
+	}
+
+	if a.AccountDomain != "" {
+		return a.AccountDomain
+	}
+
+	return config.GetAccountDomain()
+}
+
+// MediaQuota returns the max total size in bytes of media
+// this (local) account may upload, falling back to the
+// instance default if not set on the account itself.
+// A return value of 0 means there is no limit.
+func (a *Account) MediaQuota() int64 {
+	quota := a.MediaQuotaBytes
+	if quota == 0 {
+		quota = int64(config.GetMediaAccountQuota())
+	}
+	return max(quota, 0)
 }
 
 // IsRemote returns whether account is a remote user account.
@@ -187,6 +220,34 @@ func (a *Account) IsMoving() bool {
 	return a.MovedToURI != "" || a.MoveID != ""
 }
 
+// HidesInteractionCountsFrom returns true if account has
+// opted to hide the fave + boost counts of its statuses,
+// and requester (which may be nil) is not account itself.
+//
+// Only local accounts have settings, so this is always
+// false for remote accounts, or if settings aren't populated.
+func (a *Account) HidesInteractionCountsFrom(requester *Account) bool {
+	if a.Settings == nil ||
+		a.Settings.HideInteractionCounts == nil ||
+		!*a.Settings.HideInteractionCounts {
+		return false
+	}
+
+	return requester == nil || requester.ID != a.ID
+}
+
+// HidesOutbox returns true if account has opted
+// to serve only the statuses count of its outbox,
+// and not the statuses themselves, over ActivityPub.
+//
+// Only local accounts have settings, so this is always
+// false for remote accounts, or if settings aren't populated.
+func (a *Account) HidesOutbox() bool {
+	return a.Settings != nil &&
+		a.Settings.HideOutbox != nil &&
+		*a.Settings.HideOutbox
+}
+
 // AccountToEmoji is an intermediate struct to facilitate the many2many relationship between an account and one or more emojis.
 type AccountToEmoji struct {
 	AccountID string   `bun:"type:CHAR(26),unique:accountemoji,nullzero,notnull"`
@@ -206,20 +267,21 @@ type Field struct {
 
 // Relationship describes a requester's relationship with another account.
 type Relationship struct {
-	ID                  string // The account id.
-	Following           bool   // Are you following this user?
-	ShowingReblogs      bool   // Are you receiving this user's boosts in your home timeline?
-	Notifying           bool   // Have you enabled notifications for this user?
-	FollowedBy          bool   // Are you followed by this user?
-	Blocking            bool   // Are you blocking this user?
-	BlockedBy           bool   // Is this user blocking you?
-	Muting              bool   // Are you muting this user?
-	MutingNotifications bool   // Are you muting notifications from this user?
-	Requested           bool   // Do you have a pending follow request targeting this user?
-	RequestedBy         bool   // Does the user have a pending follow request targeting you?
-	DomainBlocking      bool   // Are you blocking this user's domain?
-	Endorsed            bool   // Are you featuring this user on your profile?
-	Note                string // Your note on this account.
+	ID                  string   // The account id.
+	Following           bool     // Are you following this user?
+	ShowingReblogs      bool     // Are you receiving this user's boosts in your home timeline?
+	Notifying           bool     // Have you enabled notifications for this user?
+	FollowedBy          bool     // Are you followed by this user?
+	Blocking            bool     // Are you blocking this user?
+	BlockedBy           bool     // Is this user blocking you?
+	Muting              bool     // Are you muting this user?
+	MutingNotifications bool     // Are you muting notifications from this user?
+	Requested           bool     // Do you have a pending follow request targeting this user?
+	RequestedBy         bool     // Does the user have a pending follow request targeting you?
+	DomainBlocking      bool     // Are you blocking this user's domain?
+	Endorsed            bool     // Are you featuring this user on your profile?
+	Note                string   // Your note on this account.
+	Languages           []string // Which languages are you following from this user? Nil means all languages.
 }
 
 // Theme represents a user-selected
@@ -32,4 +32,19 @@ type AccountSettings struct {
 	CustomCSS         string     `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
 	EnableRSS         *bool      `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
 	HideCollections   *bool      `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
+
+	HideInteractionCounts *bool `bun:",nullzero,notnull,default:false"` // Hide fave + boost counts of this account's statuses from accounts other than itself.
+	HideOutbox            *bool `bun:",nullzero,notnull,default:false"` // Serve only the statuses count of this account's outbox (and no pinned statuses) over ActivityPub.
+
+	StatusRetentionDays           int   `bun:",notnull,default:0"`             // Delete own statuses older than this many days. 0 means account has not opted in to status retention.
+	StatusRetentionKeepPinned     *bool `bun:",nullzero,notnull,default:true"` // Never delete own statuses that are pinned.
+	StatusRetentionKeepBookmarked *bool `bun:",nullzero,notnull,default:true"` // Never delete own statuses that are bookmarked by this account.
+	StatusRetentionKeepFavesAbove int   `bun:",notnull,default:0"`             // Never delete own statuses favourited more than this many times. 0 disables this check.
+}
+
+// StatusRetentionEnabled returns whether this
+// account has opted in to status retention,
+// ie., automatic deletion of its old statuses.
+func (s *AccountSettings) StatusRetentionEnabled() bool {
+	return s.StatusRetentionDays > 0
 }
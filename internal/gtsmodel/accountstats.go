@@ -30,4 +30,5 @@ type AccountStats struct {
 	StatusesCount       *int      `bun:",nullzero,notnull"`                        // Number of statuses created by AccountID.
 	StatusesPinnedCount *int      `bun:",nullzero,notnull"`                        // Number of statuses pinned by AccountID.
 	LastStatusAt        time.Time `bun:"type:timestamptz,nullzero"`                // Time of most recent status created by AccountID.
+	MediaUsedBytes      *int64    `bun:",nullzero,notnull,default:0"`              // Total size in bytes of local media uploaded by AccountID, counted towards media quota.
 }
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AccountWarningAppealState describes the state
+// of an appeal against an AccountWarning, if any.
+type AccountWarningAppealState uint8
+
+// Only ever add new appeal states to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	AccountWarningAppealNone AccountWarningAppealState = iota
+	AccountWarningAppealPending
+	AccountWarningAppealApproved
+	AccountWarningAppealRejected
+)
+
+func (s AccountWarningAppealState) String() string {
+	switch s {
+	case AccountWarningAppealPending:
+		return "pending"
+	case AccountWarningAppealApproved:
+		return "approved"
+	case AccountWarningAppealRejected:
+		return "rejected"
+	default:
+		return "none"
+	}
+}
+
+// AccountWarning models a formal moderation warning
+// issued to a local account by an instance administrator.
+type AccountWarning struct {
+	ID              string                    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt       time.Time                 `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
+	UpdatedAt       time.Time                 `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Last updated time of this item.
+	TargetAccountID string                    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account that was warned.
+	TargetAccount   *Account                  `bun:"-"`                                                           // Account corresponding to TargetAccountID.
+	AccountID       string                    `bun:"type:CHAR(26),nullzero,notnull"`                              // Admin account that issued the warning.
+	Account         *Account                  `bun:"-"`                                                           // Account corresponding to AccountID.
+	Text            string                    `bun:",nullzero,notnull"`                                           // Text of the warning, shown to the warned account.
+	AppealState     AccountWarningAppealState `bun:",notnull,default:0"`                                          // State of any appeal against this warning.
+}
+
+// Active returns whether this warning still stands,
+// ie., it hasn't been overturned by a successful appeal.
+func (w *AccountWarning) Active() bool {
+	return w.AppealState != AccountWarningAppealApproved
+}
@@ -73,6 +73,13 @@ const (
 	AdminActionSuspend
 	AdminActionUnsuspend
 	AdminActionExpireKeys
+	AdminActionApprove
+	AdminActionReject
+	AdminActionWarn
+	AdminActionDebugVisibility
+	AdminActionSetMediaQuota
+	AdminActionSetCharacterLimit
+	AdminActionImportEmojis
 )
 
 func (t AdminActionType) String() string {
@@ -91,6 +98,20 @@ func (t AdminActionType) String() string {
 		return "unsuspend"
 	case AdminActionExpireKeys:
 		return "expire-keys"
+	case AdminActionApprove:
+		return "approve"
+	case AdminActionReject:
+		return "reject"
+	case AdminActionWarn:
+		return "warn"
+	case AdminActionDebugVisibility:
+		return "debug-visibility"
+	case AdminActionSetMediaQuota:
+		return "set-media-quota"
+	case AdminActionSetCharacterLimit:
+		return "set-character-limit"
+	case AdminActionImportEmojis:
+		return "import-emojis"
 	default:
 		return "unknown"
 	}
@@ -112,6 +133,20 @@ func NewAdminActionType(in string) AdminActionType {
 		return AdminActionUnsuspend
 	case "expire-keys":
 		return AdminActionExpireKeys
+	case "approve":
+		return AdminActionApprove
+	case "reject":
+		return AdminActionReject
+	case "warn":
+		return AdminActionWarn
+	case "debug-visibility":
+		return AdminActionDebugVisibility
+	case "set-media-quota":
+		return AdminActionSetMediaQuota
+	case "set-character-limit":
+		return AdminActionSetCharacterLimit
+	case "import-emojis":
+		return AdminActionImportEmojis
 	default:
 		return AdminActionUnknown
 	}
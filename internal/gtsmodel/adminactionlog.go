@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AdminActionLog models a permanent record of an action
+// taken by an instance administrator towards an account.
+// Unlike AdminAction, which tracks processing state of
+// long-running actions, entries here are never removed.
+type AdminActionLog struct {
+	ID              string          `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt       time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
+	AccountID       string          `bun:"type:CHAR(26),nullzero,notnull"`                              // Who performed this admin action.
+	Account         *Account        `bun:"-"`                                                           // Account corresponding to AccountID.
+	TargetAccountID string          `bun:"type:CHAR(26),nullzero,notnull"`                              // Account targeted by this admin action.
+	TargetAccount   *Account        `bun:"-"`                                                           // Account corresponding to TargetAccountID.
+	Action          AdminActionType `bun:",nullzero,notnull"`                                           // Type of action that was taken.
+	Reason          string          `bun:",nullzero"`                                                   // Reason given for taking this action, if any.
+}
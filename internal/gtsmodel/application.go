@@ -31,4 +31,17 @@ type Application struct {
 	ClientID     string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the associated oauth client entity in the db
 	ClientSecret string    `bun:",nullzero,notnull"`                                           // secret of the associated oauth client entity in the db
 	Scopes       string    `bun:",notnull"`                                                    // scopes requested when this app was created
+	RevokedAt    time.Time `bun:"type:timestamptz,nullzero"`                                   // when was this app revoked by an admin, if at all
+}
+
+// ApplicationBlock blocks new applications matching its
+// patterns from being registered on this instance.
+type ApplicationBlock struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that created this block
+	CreatedByAccount   *Account  `bun:"-"`                                                           // account corresponding to CreatedByAccountID
+	NamePattern        string    `bun:",nullzero"`                                                   // regular expression matched against application names
+	WebsitePattern     string    `bun:",nullzero"`                                                   // regular expression matched against application websites
 }
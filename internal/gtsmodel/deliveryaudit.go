@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DeliveryAudit models an entry in the federation audit
+// log, recording a single attempt at delivering an outgoing
+// activity to a remote inbox. The table is size-capped, with
+// the oldest entries pruned as new entries are written.
+type DeliveryAudit struct {
+	ID           string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt    time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time of the delivery attempt.
+	Inbox        string    `bun:",nullzero,notnull"`                                           // Inbox URI the activity was delivered to.
+	Domain       string    `bun:",nullzero,notnull"`                                           // Domain of the target inbox.
+	ActivityType string    `bun:",nullzero"`                                                   // ActivityStreams type of the delivered activity, eg., "Create".
+	ObjectURI    string    `bun:",nullzero"`                                                   // URI of the object of the delivered activity, if any.
+	StatusCode   int       `bun:",nullzero"`                                                   // HTTP response status code, or zero if no response was received.
+	Attempt      int       `bun:",nullzero,notnull"`                                           // Attempt number of this delivery, starting at 1.
+}
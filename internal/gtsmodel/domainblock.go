@@ -19,18 +19,70 @@ package gtsmodel
 
 import "time"
 
+// DomainBlockSeverity describes how
+// severely a DomainBlock limits a domain.
+type DomainBlockSeverity uint8
+
+// Only ever add new severities to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	// DomainBlockSuspend blocks all federation
+	// with the domain, and suspends its accounts.
+	DomainBlockSuspend DomainBlockSeverity = iota
+
+	// DomainBlockLimit still federates with the
+	// domain, but keeps statuses from its accounts
+	// off public timelines, unless the viewer
+	// follows the author. Called "silence" by
+	// older versions of the Mastodon API.
+	DomainBlockLimit
+)
+
+func (s DomainBlockSeverity) String() string {
+	switch s {
+	case DomainBlockLimit:
+		return "limit"
+	default:
+		return "suspend"
+	}
+}
+
+// ParseDomainBlockSeverity parses the given string as a domain block
+// severity, accepting "silence" as an alias of "limit". An empty string
+// is parsed as "suspend". Returns false if the string is not recognized.
+func ParseDomainBlockSeverity(in string) (DomainBlockSeverity, bool) {
+	switch in {
+	case "", "suspend":
+		return DomainBlockSuspend, true
+	case "limit", "silence":
+		return DomainBlockLimit, true
+	default:
+		return 0, false
+	}
+}
+
 // DomainBlock represents a federation block against a particular domain
 type DomainBlock struct {
-	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Domain             string    `bun:",nullzero,notnull"`                                           // domain to block. Eg. 'whatever.com'
-	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this block
-	CreatedByAccount   *Account  `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
-	PrivateComment     string    `bun:""`                                                            // Private comment on this block, viewable to admins
-	PublicComment      string    `bun:""`                                                            // Public comment on this block, viewable (optionally) by everyone
-	Obfuscate          *bool     `bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
-	SubscriptionID     string    `bun:"type:CHAR(26),nullzero"`                                      // if this block was created through a subscription, what's the subscription ID?
+	ID                 string              `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain             string              `bun:",nullzero,notnull"`                                           // domain to block. Eg. 'whatever.com'
+	CreatedByAccountID string              `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this block
+	CreatedByAccount   *Account            `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	PrivateComment     string              `bun:""`                                                            // Private comment on this block, viewable to admins
+	PublicComment      string              `bun:""`                                                            // Public comment on this block, viewable (optionally) by everyone
+	Obfuscate          *bool               `bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
+	SubscriptionID     string              `bun:"type:CHAR(26),nullzero"`                                      // if this block was created through a subscription, what's the subscription ID?
+	Severity           DomainBlockSeverity `bun:",notnull,default:0"`                                          // how severely the domain is blocked
+	BlockListURL       string              `bun:",nullzero"`                                                   // if this block was applied from a shared block list, what's the URL of the list?
+	Pinned             *bool               `bun:",nullzero,notnull,default:false"`                             // whether this block is pinned, so that updates to its block list can't change it
+
+	// Progress of processing this block's side effects, so they can be resumed.
+	SideEffectsCursor      string    `bun:"type:CHAR(26),nullzero"`    // ID of the last account processed by side effects of this block, if in progress
+	SideEffectsProcessed   int       `bun:",notnull,default:0"`        // number of accounts processed so far by side effects of this block
+	SideEffectsTotal       int       `bun:",notnull,default:0"`        // total number of accounts to be processed by side effects of this block
+	SideEffectsCompletedAt time.Time `bun:"type:timestamptz,nullzero"` // when side effects of this block were completed (or skipped), zero if in progress
 }
 
 func (d *DomainBlock) GetID() string {
@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainDeliveryHealth models the health of outgoing
+// deliveries to a remote domain, as tracked by the
+// delivery workers to back off from failing domains.
+type DomainDeliveryHealth struct {
+	Domain              string                 `bun:",pk,nullzero,notnull,unique"`                                 // Punycode domain this entry tracks.
+	UpdatedAt           time.Time              `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was this entry last updated.
+	ConsecutiveFailures int                    `bun:",notnull,default:0"`                                          // No. delivery attempts failed in a row.
+	LastFailureAt       time.Time              `bun:"type:timestamptz,nullzero"`                                   // Time of the most recent failed attempt, if any.
+	LastSuccessAt       time.Time              `bun:"type:timestamptz,nullzero"`                                   // Time of the most recent successful attempt, if any.
+	NextAttemptAt       time.Time              `bun:"type:timestamptz,nullzero"`                                   // Deliveries to this domain aren't attempted before this time, if set.
+	ErrorSamples        []*DeliveryErrorSample `bun:""`                                                            // Most recent delivery errors, newest first.
+}
+
+// BackingOff returns whether deliveries to
+// the domain are currently being held back.
+func (h *DomainDeliveryHealth) BackingOff() bool {
+	return !h.NextAttemptAt.IsZero() && time.Now().Before(h.NextAttemptAt)
+}
+
+// DeliveryErrorSample records a single failed
+// delivery attempt, for display to admins.
+type DeliveryErrorSample struct {
+	Time       time.Time // Time of the failed attempt.
+	Inbox      string    // Inbox URI delivered to.
+	StatusCode int       // HTTP response status code, or zero if no response.
+	Error      string    // Error returned by the attempt.
+}
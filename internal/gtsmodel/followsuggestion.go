@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// FollowSuggestionSource describes the reason
+// why an account was suggested to be followed.
+type FollowSuggestionSource uint8
+
+// Only ever add new suggestion sources to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	FollowSuggestionSourceUnknown FollowSuggestionSource = iota
+	FollowSuggestionSourceFeatured
+	FollowSuggestionSourceMostFollowed
+	FollowSuggestionSourceMostInteractions
+	FollowSuggestionSourceSimilarToRecentlyFollowed
+	FollowSuggestionSourceFriendsOfFriends
+)
+
+func (s FollowSuggestionSource) String() string {
+	switch s {
+	case FollowSuggestionSourceFeatured:
+		return "featured"
+	case FollowSuggestionSourceMostFollowed:
+		return "most_followed"
+	case FollowSuggestionSourceMostInteractions:
+		return "most_interactions"
+	case FollowSuggestionSourceSimilarToRecentlyFollowed:
+		return "similar_to_recently_followed"
+	case FollowSuggestionSourceFriendsOfFriends:
+		return "friends_of_friends"
+	default:
+		return "unknown"
+	}
+}
+
+// FollowSuggestion models one account suggested
+// to another account as someone they may wish to
+// follow, along with the reason for the suggestion.
+type FollowSuggestion struct {
+	AccountID       string                 // ID of the account this suggestion is for.
+	TargetAccountID string                 // ID of the account being suggested.
+	TargetAccount   *Account               // Account corresponding to TargetAccountID.
+	Source          FollowSuggestionSource // Reason for this suggestion.
+}
+
+// FeaturedSuggestion models an account picked by
+// an admin to be suggested to all local accounts.
+type FeaturedSuggestion struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	AccountID          string    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // id of the account being suggested
+	Account            *Account  `bun:"-"`                                                           // account corresponding to AccountID
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that picked this account
+}
+
+// FollowSuggestionDismissal models one account having dismissed
+// a suggestion to follow another account, so that the other
+// account isn't suggested to them again.
+type FollowSuggestionDismissal struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                               // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                            // when was item created
+	AccountID       string    `bun:"type:CHAR(26),nullzero,notnull,unique:follow_suggestion_dismissals_account_target_uniq"` // id of the account that dismissed the suggestion
+	TargetAccountID string    `bun:"type:CHAR(26),nullzero,notnull,unique:follow_suggestion_dismissals_account_target_uniq"` // id of the account that was suggested
+}
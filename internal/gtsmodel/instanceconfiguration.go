@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "github.com/superseriousbusiness/gotosocial/internal/config"
+
+// InstanceConfiguration models the limits enforced by
+// this instance on content created by local accounts.
+//
+// It is the single source of truth for these limits:
+// both the validators that enforce them, and the instance
+// endpoints that advertise them to clients, read from it,
+// so the two can't drift apart.
+type InstanceConfiguration struct {
+	StatusesMaxChars           int   // Max characters in a status (text + content warning).
+	StatusesSpoilerMaxChars    int   // Max characters in a status content warning.
+	StatusesMinChars           int   // Min characters in a status text without media or poll (0 = no min).
+	StatusesMediaMaxFiles      int   // Max media attachments on a status.
+	StatusesPollMaxOptions     int   // Max options in a poll.
+	StatusesPollOptionMaxChars int   // Max characters in a single poll option.
+	MediaDescriptionMinChars   int   // Min characters in a media description (alt text).
+	MediaDescriptionMaxChars   int   // Max characters in a media description (alt text).
+	MediaDescriptionRequired   bool  // Whether media attached to new statuses must have a description.
+	MediaImageMaxSize          int64 // Max size of uploaded images, in bytes.
+	MediaVideoMaxSize          int64 // Max size of uploaded videos, in bytes.
+	MediaEmojiLocalMaxSize     int64 // Max size of local custom emoji images, in bytes.
+	AccountsAllowCustomCSS     bool  // Whether accounts may set custom CSS.
+	AccountsNoteMaxChars       int   // Max characters in a private note on an account.
+}
+
+// NewInstanceConfiguration returns the InstanceConfiguration
+// of this instance, as currently set in the global config.
+func NewInstanceConfiguration() *InstanceConfiguration {
+	return &InstanceConfiguration{
+		StatusesMaxChars:           config.GetStatusesMaxChars(),
+		StatusesSpoilerMaxChars:    config.GetMaxSpoilerTextChars(),
+		StatusesMinChars:           config.GetMinStatusChars(),
+		StatusesMediaMaxFiles:      config.GetStatusesMediaMaxFiles(),
+		StatusesPollMaxOptions:     config.GetStatusesPollMaxOptions(),
+		StatusesPollOptionMaxChars: config.GetStatusesPollOptionMaxChars(),
+		MediaDescriptionMinChars:   config.GetMediaDescriptionMinChars(),
+		MediaDescriptionMaxChars:   config.GetMediaDescriptionMaxChars(),
+		MediaDescriptionRequired:   config.GetMediaDescriptionRequired(),
+		MediaImageMaxSize:          int64(config.GetMediaImageMaxSize()),
+		MediaVideoMaxSize:          int64(config.GetMediaVideoMaxSize()),
+		MediaEmojiLocalMaxSize:     int64(config.GetMediaEmojiLocalMaxSize()),
+		AccountsAllowCustomCSS:     config.GetAccountsAllowCustomCSS(),
+
+		// Not currently configurable, this
+		// is the same limit Mastodon uses.
+		AccountsNoteMaxChars: 2000,
+	}
+}
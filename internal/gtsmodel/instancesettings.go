@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// InstanceSettings models settings of the local instance
+// that aren't federated, and that aren't exposed via the
+// instance model, eg., admin customizations of the web UI.
+type InstanceSettings struct {
+	InstanceID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // InstanceID that owns this settings.
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created.
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item was last updated.
+	CustomCSS          string    `bun:",nullzero"`                                                   // Custom CSS injected into every page of the web UI.
+	DeliveryWorkers    int       `bun:",nullzero"`                                                   // Size override for the delivery worker pool, 0 = use config.
+	ClientWorkers      int       `bun:",nullzero"`                                                   // Size override for the client API worker pool, 0 = use config.
+	FederatorWorkers   int       `bun:",nullzero"`                                                   // Size override for the fedi API worker pool, 0 = use config.
+	DereferenceWorkers int       `bun:",nullzero"`                                                   // Size override for the dereference worker pool, 0 = use config.
+	MediaWorkers       int       `bun:",nullzero"`                                                   // Size override for the media worker pool, 0 = use config.
+}
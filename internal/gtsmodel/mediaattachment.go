@@ -42,6 +42,7 @@ type MediaAttachment struct {
 	Avatar            *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as an avatar?
 	Header            *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as a header?
 	Cached            *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment currently cached by our instance?
+	Sensitive         *bool            `bun:",nullzero,notnull,default:false"`                             // Should this attachment be hidden behind a sensitive media warning?
 }
 
 // File refers to the metadata for the whole file
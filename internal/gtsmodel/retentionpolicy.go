@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// RetentionPolicy models the instance-wide data retention
+// policy set by the admin. There is at most one of these.
+type RetentionPolicy struct {
+	ID               string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt        time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt        time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	MaxStatusAgeDays int       `bun:",notnull,default:0"`                                          // delete statuses older than this many days, 0 = keep forever
+	MaxMediaAgeDays  int       `bun:",notnull,default:0"`                                          // unreference media attachments older than this many days, 0 = keep forever
+	AppliesToLocal   *bool     `bun:",nullzero,notnull,default:false"`                             // apply this policy to content of local accounts
+	AppliesToRemote  *bool     `bun:",nullzero,notnull,default:false"`                             // apply this policy to content of remote accounts
+}
+
+// Enabled returns whether this retention
+// policy should delete anything at all.
+func (r *RetentionPolicy) Enabled() bool {
+	return (r.MaxStatusAgeDays > 0 || r.MaxMediaAgeDays > 0) &&
+		(*r.AppliesToLocal || *r.AppliesToRemote)
+}
+
+// AppliesTo returns whether this retention
+// policy applies to content of the given account.
+func (r *RetentionPolicy) AppliesTo(account *Account) bool {
+	if account.IsLocal() {
+		return *r.AppliesToLocal
+	}
+	return *r.AppliesToRemote
+}
@@ -29,6 +29,7 @@ type Status struct {
 	UpdatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time.
+	MediaEditedAt            time.Time          `bun:"type:timestamptz,nullzero"`                                   // when were media attachments (remote) last changed by an edit.
 	URI                      string             `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                   // web url for viewing this status
 	Content                  string             `bun:""`                                                            // content of this status; likely html-formatted but not guaranteed
@@ -65,6 +66,7 @@ type Status struct {
 	CreatedWithApplication   *Application       `bun:"rel:belongs-to"`                                              // application corresponding to createdWithApplicationID
 	ActivityStreamsType      string             `bun:",nullzero,notnull"`                                           // What is the activitystreams type of this status? See: https://www.w3.org/TR/activitystreams-vocabulary/#object-types. Will probably almost always be Note but who knows!.
 	Text                     string             `bun:""`                                                            // Original text of the status without formatting
+	WordCount                int                `bun:",notnull,default:0"`                                          // Number of words in the content of this status, with all formatting stripped
 	Federated                *bool              `bun:",notnull"`                                                    // This status will be federated beyond the local timeline(s)
 	Boostable                *bool              `bun:",notnull"`                                                    // This status can be boosted/reblogged
 	Replyable                *bool              `bun:",notnull"`                                                    // This status can be replied to
@@ -105,6 +107,23 @@ func (s *Status) AttachmentsPopulated() bool {
 	return true
 }
 
+// IsSensitive returns whether the status is sensitive, either because it's
+// explicitly marked as such, or because any of its attachments are marked
+// as sensitive. Only populated attachments are taken into account.
+func (s *Status) IsSensitive() bool {
+	if s.Sensitive != nil && *s.Sensitive {
+		return true
+	}
+	for _, attachment := range s.Attachments {
+		if attachment != nil &&
+			attachment.Sensitive != nil &&
+			*attachment.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
 // TagsPopulated returns whether tags are populated according to current TagIDs.
 func (s *Status) TagsPopulated() bool {
 	if len(s.TagIDs) != len(s.Tags) {
@@ -173,6 +192,17 @@ func (s *Status) GetAttachmentByRemoteURL(url string) (*MediaAttachment, bool) {
 	return nil, false
 }
 
+// GetEmojiByShortcodeDomain searches status for Emoji{} with shortcode and domain.
+func (s *Status) GetEmojiByShortcodeDomain(shortcode string, domain string) (*Emoji, bool) {
+	for _, emoji := range s.Emojis {
+		if emoji.Shortcode == shortcode &&
+			emoji.Domain == domain {
+			return emoji, true
+		}
+	}
+	return nil, false
+}
+
 // GetMentionByTargetURI searches status for Mention{} with target URI.
 func (s *Status) GetMentionByTargetURI(uri string) (*Mention, bool) {
 	for _, mention := range s.Mentions {
@@ -32,3 +32,8 @@ type StatusFave struct {
 	Status          *Status   `bun:"-"`                                                             // the faved status
 	URI             string    `bun:",nullzero,notnull,unique"`                                      // ActivityPub URI of this fave
 }
+
+// GetID implements typeutils.PageItemID{}.
+func (f *StatusFave) GetID() string {
+	return f.ID
+}
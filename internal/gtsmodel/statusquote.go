@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// StatusQuote models the quoting of one
+// status by another status, ie., a quote post.
+type StatusQuote struct {
+	ID             string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt      time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt      time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	StatusID       string     `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the quoting status
+	Status         *Status    `bun:"-"`                                                           // the quoting status
+	QuotedStatusID string     `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the quoted status
+	QuotedStatus   *Status    `bun:"-"`                                                           // the quoted status
+	State          QuoteState `bun:",nullzero,notnull,default:'pending'"`                         // whether the author of the quoted status has approved the quote
+}
+
+// QuoteState denotes whether the author of
+// a quoted status has approved being quoted.
+type QuoteState string
+
+const (
+	// QuoteStatePending means the quote has
+	// not (yet) been approved or rejected.
+	QuoteStatePending QuoteState = "pending"
+	// QuoteStateAccepted means the author of
+	// the quoted status approved the quote.
+	QuoteStateAccepted QuoteState = "accepted"
+	// QuoteStateRejected means the author of
+	// the quoted status rejected the quote.
+	QuoteStateRejected QuoteState = "rejected"
+)
@@ -21,11 +21,33 @@ import "time"
 
 // Tag represents a hashtag for gathering public statuses together.
 type Tag struct {
-	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Name      string    `bun:",unique,nullzero,notnull"`                                    // (lowercase) name of the tag without the hash prefix
-	Useable   *bool     `bun:",nullzero,notnull,default:true"`                              // Tag is useable on this instance.
-	Listable  *bool     `bun:",nullzero,notnull,default:true"`                              // Tagged statuses can be listed on this instance.
-	Href      string    `bun:"-"`                                                           // Href of the hashtag. Will only be set on freshly-extracted hashtags from remote AP messages. Not stored in the database.
+	ID          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Name        string    `bun:",unique,nullzero,notnull"`                                    // canonical (folded) name of the tag without the hash prefix, see text.FoldHashtag
+	DisplayName string    `bun:",nullzero"`                                                   // name of the tag as first used, without the hash prefix, for display. Falls back to Name if not set.
+	Useable     *bool     `bun:",nullzero,notnull,default:true"`                              // Tag is useable on this instance.
+	Listable    *bool     `bun:",nullzero,notnull,default:true"`                              // Tagged statuses can be listed on this instance.
+	Href        string    `bun:"-"`                                                           // Href of the hashtag. Will only be set on freshly-extracted hashtags from remote AP messages. Not stored in the database.
+}
+
+// GetDisplayName returns the display name
+// of the tag, falling back to the tag name.
+func (t *Tag) GetDisplayName() string {
+	if t.DisplayName != "" {
+		return t.DisplayName
+	}
+	return t.Name
+}
+
+// TagStats contains stats about one account's use of a tag.
+type TagStats struct {
+	StatusesCount int       // Number of the account's statuses using the tag.
+	LastStatusAt  time.Time // Time of the account's latest status using the tag. Zero if never used.
+}
+
+// TagUsage contains the number of an account's statuses using a tag.
+type TagUsage struct {
+	TagID string // ID of the tag.
+	Count int    // Number of the account's statuses using the tag.
 }
@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+// TranslationResult represents the result of
+// translating a status with a translation backend.
+//
+// Translated media descriptions and poll options
+// are given in the same order as the attachments
+// and poll options of the translated status.
+type TranslationResult struct {
+	Content                string   // translated content of the status, as HTML
+	SpoilerText            string   // translated content warning of the status
+	MediaDescriptions      []string // translated descriptions of the status' media attachments
+	PollOptions            []string // translated options of the status' poll
+	DetectedSourceLanguage string   // language the backend detected the status to be in
+	Provider               string   // name of the service that provided the translation
+}
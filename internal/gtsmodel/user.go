@@ -97,4 +97,5 @@ type NewSignup struct {
 	EmailVerified bool   // Mark submitted email address as already verified (optional).
 	ExternalID    string // ID of this user in external OIDC system (optional).
 	Admin         bool   // Mark new user as an admin user (optional).
+	AccountDomain string // Account domain to use in the new account's name, if not the default account-domain (optional).
 }
@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// WordFilterTarget describes which part
+// of an incoming status a WordFilter matches.
+type WordFilterTarget uint8
+
+// Only ever add new targets to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	// WordFilterTargetContent matches the text
+	// of a status and its content warning.
+	WordFilterTargetContent WordFilterTarget = iota
+
+	// WordFilterTargetLinks matches links
+	// included in a status or its content warning.
+	WordFilterTargetLinks
+
+	// WordFilterTargetDisplayName matches the
+	// display name of the author of a status.
+	WordFilterTargetDisplayName
+)
+
+func (t WordFilterTarget) String() string {
+	switch t {
+	case WordFilterTargetLinks:
+		return "links"
+	case WordFilterTargetDisplayName:
+		return "display_name"
+	default:
+		return "content"
+	}
+}
+
+// ParseWordFilterTarget parses the given string as a word
+// filter target, returning false if it is not recognized.
+func ParseWordFilterTarget(in string) (WordFilterTarget, bool) {
+	switch in {
+	case "content":
+		return WordFilterTargetContent, true
+	case "links":
+		return WordFilterTargetLinks, true
+	case "display_name":
+		return WordFilterTargetDisplayName, true
+	default:
+		return 0, false
+	}
+}
+
+// WordFilterAction describes what happens to
+// an incoming status which matches a WordFilter.
+type WordFilterAction uint8
+
+// Only ever add new actions to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	// WordFilterActionDrop silently
+	// drops the matching status.
+	WordFilterActionDrop WordFilterAction = iota
+
+	// WordFilterActionReject drops the matching
+	// status, logging that it was rejected.
+	WordFilterActionReject
+
+	// WordFilterActionSensitive marks the
+	// matching status as sensitive.
+	WordFilterActionSensitive
+)
+
+func (a WordFilterAction) String() string {
+	switch a {
+	case WordFilterActionReject:
+		return "reject"
+	case WordFilterActionSensitive:
+		return "sensitive"
+	default:
+		return "drop"
+	}
+}
+
+// ParseWordFilterAction parses the given string as a word
+// filter action, returning false if it is not recognized.
+func ParseWordFilterAction(in string) (WordFilterAction, bool) {
+	switch in {
+	case "drop":
+		return WordFilterActionDrop, true
+	case "reject":
+		return WordFilterActionReject, true
+	case "sensitive":
+		return WordFilterActionSensitive, true
+	default:
+		return 0, false
+	}
+}
+
+// WordFilter represents an instance-level rule matching
+// statuses coming in over federation by keyword or regular
+// expression, and what to do with statuses which match.
+type WordFilter struct {
+	ID            string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AuthorID      string           `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that created this filter
+	Author        *Account         `bun:"-"`                                                           // account corresponding to AuthorID
+	Pattern       string           `bun:",nullzero,notnull"`                                           // keyword or regular expression to match
+	Regex         *bool            `bun:",nullzero,notnull,default:false"`                             // whether Pattern is a regular expression, else a case-insensitive keyword
+	Target        WordFilterTarget `bun:",notnull,default:0"`                                          // part of the status to match against
+	Action        WordFilterAction `bun:",notnull,default:0"`                                          // what to do with matching statuses
+	Comment       string           `bun:""`                                                            // comment on this filter, visible to admins
+	MatchesCount  int              `bun:",notnull,default:0"`                                          // number of statuses this filter has matched
+	LastMatchedAt time.Time        `bun:"type:timestamptz,nullzero"`                                   // when this filter last matched a status, if ever
+}
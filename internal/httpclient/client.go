@@ -52,8 +52,15 @@ var (
 
 	// ErrBodyTooLarge is returned when a received response body is above predefined limit (default 40MB).
 	ErrBodyTooLarge = errors.New("body size too large")
+
+	// ErrTooManyRedirects is returned when a request would follow more than its permitted number of redirects.
+	ErrTooManyRedirects = errors.New("too many redirects")
 )
 
+// defaultMaxRedirects is the maximum number of redirects
+// followed for a request, when not set in its context.
+const defaultMaxRedirects = 10
+
 // Config provides configuration details for setting up a new
 // instance of httpclient.Client{}. Within are a subset of the
 // configuration values passed to initialized http.Transport{}
@@ -151,6 +158,7 @@ func New(cfg Config) *Client {
 
 	// Prepare client fields.
 	c.client.Timeout = cfg.Timeout
+	c.client.CheckRedirect = checkRedirect
 	c.bodyMax = cfg.MaxBodySize
 
 	// Prepare transport TLS config.
@@ -261,8 +269,10 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 	// attempts.
 	r.attempts++
 
-	// Reset backoff.
+	// Reset backoff
+	// and status.
 	r.backoff = 0
+	r.status = 0
 
 	// Perform main routine.
 	rsp, retry, err = c.do(r)
@@ -292,7 +302,7 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 		// when reached max attempts.
 		retry = false
 
-	case c.badHosts.Has(r.Host):
+	case !r.IgnoreBadHosts && c.badHosts.Has(r.Host):
 		// When retry is still permitted,
 		// check host hasn't been marked
 		// as a "badhost", i.e. erroring.
@@ -315,14 +325,14 @@ func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
 			context.Canceled,
 			ErrBodyTooLarge,
 			ErrReservedAddr,
+			ErrTooManyRedirects,
 		) {
 			// Non-retryable errors.
 			return nil, false, err
 		}
 
 		if errstr := err.Error(); //
-		strings.Contains(errstr, "stopped after 10 redirects") ||
-			strings.Contains(errstr, "tls: ") ||
+		strings.Contains(errstr, "tls: ") ||
 			strings.Contains(errstr, "x509: ") {
 			// These error types aren't wrapped
 			// so we have to check the error string.
@@ -338,8 +348,12 @@ func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
 
 		// A retryable error.
 		return nil, true, err
+	}
 
-	} else if rsp.StatusCode >= 500 ||
+	// Store response status.
+	r.status = rsp.StatusCode
+
+	if rsp.StatusCode >= 500 ||
 		rsp.StatusCode == http.StatusTooManyRequests {
 
 		// Codes over 500 (and 429: too many requests)
@@ -413,3 +427,22 @@ var discard = io.Discard.(interface { //nolint
 	io.StringWriter
 	io.ReaderFrom
 })
+
+// checkRedirect is the http.Client{}.CheckRedirect policy, stopping
+// once a request would follow more than the number of redirects set
+// in its context with gtscontext.SetMaxRedirects(), else the default.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	max, ok := gtscontext.MaxRedirects(req.Context())
+	if !ok {
+		max = defaultMaxRedirects
+	}
+
+	// Each entry in via is a previous
+	// request, i.e. all but the first
+	// of them followed a redirect.
+	if len(via) > max {
+		return ErrTooManyRedirects
+	}
+
+	return nil
+}
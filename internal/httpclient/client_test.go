@@ -19,13 +19,17 @@ package httpclient_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/netip"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 )
 
@@ -129,6 +133,52 @@ func _TestHTTPClientWithBody(t *testing.T, body []byte, max int) {
 	}
 }
 
+func TestHTTPClientMaxRedirects(t *testing.T) {
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+	})
+
+	// Set handler redirecting /{n} to /{n-1}, until /0.
+	handler := func(rw http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/"))
+		if n > 0 {
+			http.Redirect(rw, r, "/"+strconv.Itoa(n-1), http.StatusFound)
+			return
+		}
+		_, _ = rw.Write([]byte("ok"))
+	}
+
+	// Start the test server
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	defer srv.Close()
+
+	for _, test := range []struct {
+		redirects int
+		expectErr error
+	}{
+		{redirects: 0},
+		{redirects: 3},
+		{redirects: 4, expectErr: httpclient.ErrTooManyRedirects},
+	} {
+		// Create the test HTTP request, permitting max 3 redirects.
+		ctx := gtscontext.SetMaxRedirects(context.Background(), 3)
+		url := srv.URL + "/" + strconv.Itoa(test.redirects)
+		req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+
+		// Perform the test request
+		rsp, err := client.Do(req)
+		if !errors.Is(err, test.expectErr) {
+			t.Fatalf("unexpected error following %d redirects: %v", test.redirects, err)
+		} else if err != nil {
+			continue // expected error
+		}
+		rsp.Body.Close()
+	}
+}
+
 func TestHTTPClientPrivateIP(t *testing.T) {
 	client := httpclient.New(httpclient.Config{})
 
@@ -38,6 +38,14 @@ type Request struct {
 	// Delivery attempts.
 	attempts uint
 
+	// Status code of last response.
+	status int
+
+	// IgnoreBadHosts disables cutting short retries
+	// to hosts the Client{} has marked as erroring,
+	// for callers that track host health themselves.
+	IgnoreBadHosts bool
+
 	// log fields.
 	log.Entry
 
@@ -73,3 +81,16 @@ func (r *Request) BackOff() time.Duration {
 	}
 	return r.backoff
 }
+
+// Attempts returns the number of
+// attempts made at this request.
+func (r *Request) Attempts() uint {
+	return r.attempts
+}
+
+// StatusCode returns the HTTP status code of the
+// response to the most recent attempt at this
+// request, or zero if no response was received.
+func (r *Request) StatusCode() int {
+	return r.status
+}
@@ -0,0 +1,316 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package language
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// missingRank is the rank assumed for n-grams
+	// missing from a language's profile, which holds
+	// only the most frequent n-grams of each length.
+	missingRank = 1000
+
+	// zipfOffset smooths the frequencies of the
+	// highest ranked n-grams, (Zipf-Mandelbrot).
+	zipfOffset = 10
+
+	// minTrigrams is the minimum number of trigrams
+	// text must contain for its language to be told
+	// apart from others written in the same script.
+	minTrigrams = 8
+
+	// minLetters is the minimum number of letters
+	// text must contain for its language to be
+	// detected by its script alone.
+	minLetters = 3
+
+	// minMargin is the minimum difference, per n-gram,
+	// between the log likelihoods of the most likely and
+	// second most likely languages to choose the former.
+	minMargin = 0.05
+)
+
+// japanese is the pseudo-script of text
+// mixing kana (and usually han) letters.
+var japanese = &unicode.RangeTable{}
+
+// scripts are the writing systems that
+// text and languages are classified by.
+var scripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Cyrillic,
+	unicode.Greek,
+	unicode.Arabic,
+	unicode.Hebrew,
+	unicode.Armenian,
+	unicode.Georgian,
+	unicode.Devanagari,
+	unicode.Bengali,
+	unicode.Gurmukhi,
+	unicode.Gujarati,
+	unicode.Tamil,
+	unicode.Telugu,
+	unicode.Thai,
+	unicode.Hangul,
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Katakana,
+	japanese,
+}
+
+// Detector detects the language of plain text,
+// choosing from a limited set of candidate languages.
+//
+// Languages written in a script of their own are detected
+// by script alone. Others are told apart by the n-grams
+// (letters, and sequences of two or three letters within
+// a word) of the text, scored against the profile of each
+// candidate language, ie., its most frequent n-grams.
+type Detector struct {
+	langs []*candidate
+}
+
+// candidate is a language
+// that may be detected.
+type candidate struct {
+	code   string
+	script *unicode.RangeTable
+	ranks  map[string]int
+}
+
+// NewDetector returns a new Detector for the candidate
+// languages with the given ISO 639-1 codes, eg., "en".
+// At least two languages must be given, to choose from.
+func NewDetector(codes []string) (*Detector, error) {
+	langs := make([]*candidate, 0, len(codes))
+	for _, code := range codes {
+		profile, ok := profiles[code]
+		if !ok {
+			return nil, fmt.Errorf("%s is not an ISO 639-1 code of a detectable language", code)
+		}
+
+		if slices.ContainsFunc(langs, func(c *candidate) bool {
+			return c.code == code
+		}) {
+			continue
+		}
+
+		ngrams := strings.Fields(profile)
+		script, _ := dominantScript(profile)
+		lang := &candidate{
+			code:   code,
+			script: script,
+			ranks:  make(map[string]int, len(ngrams)),
+		}
+
+		// Rank n-grams among
+		// those of same length.
+		var next [4]int
+		for _, ngram := range ngrams {
+			n := utf8.RuneCountInString(ngram)
+			lang.ranks[ngram] = next[n]
+			next[n]++
+		}
+
+		langs = append(langs, lang)
+	}
+
+	if len(langs) < 2 {
+		return nil, errors.New("at least two languages are needed to detect from")
+	}
+
+	return &Detector{langs: langs}, nil
+}
+
+// Detect attempts to detect the language of the given
+// plain text, returning the detected Language and true
+// on success. If the language can't be told apart from
+// others with reasonable confidence, false is returned.
+func (d *Detector) Detect(text string) (*Language, bool) {
+	script, letters := dominantScript(text)
+	if letters < minLetters {
+		return nil, false
+	}
+
+	// Only languages written in the
+	// text's script are candidates.
+	var langs []*candidate
+	for _, lang := range d.langs {
+		if lang.script == script {
+			langs = append(langs, lang)
+		}
+	}
+
+	var code string
+	switch len(langs) {
+	case 0:
+		return nil, false
+
+	case 1:
+		code = langs[0].code
+
+	default:
+		var ok bool
+		code, ok = closest(langs, text)
+		if !ok {
+			return nil, false
+		}
+	}
+
+	// ISO 639-1 codes are valid
+	// BCP47 primary language tags.
+	lang, err := Parse(code)
+	if err != nil {
+		return nil, false
+	}
+
+	return lang, true
+}
+
+// closest returns the code of the candidate language
+// most likely to have produced the trigrams of text, or
+// false if no language is clearly the most likely.
+func closest(langs []*candidate, text string) (string, bool) {
+	ngrams, trigrams := countNgrams(text)
+	if trigrams < minTrigrams {
+		return "", false
+	}
+
+	// Score each language by the log likelihood of the
+	// text's n-grams, approximating the frequency of
+	// each from its rank in the profile, (Zipf's law).
+	var total int
+	scores := make([]float64, len(langs))
+	for ngram, count := range ngrams {
+		total += count
+		for i, lang := range langs {
+			rank, ok := lang.ranks[ngram]
+			if !ok {
+				rank = missingRank
+			}
+
+			scores[i] -= float64(count) * math.Log(float64(rank+zipfOffset))
+		}
+	}
+
+	// Find the most, and second most likely.
+	best, second := -1, -1
+	for i := range scores {
+		switch {
+		case best < 0 || scores[i] > scores[best]:
+			best, second = i, best
+		case second < 0 || scores[i] > scores[second]:
+			second = i
+		}
+	}
+
+	// Ensure the most likely language is
+	// more likely by a clear margin, relative
+	// to the number of n-grams in the text.
+	if (scores[best]-scores[second])/float64(total) < minMargin {
+		return "", false
+	}
+
+	return langs[best].code, true
+}
+
+// countNgrams returns the count of each n-gram, (letters,
+// bigrams and trigrams), found within the words of text,
+// and the number of trigrams among them.
+func countNgrams(text string) (map[string]int, int) {
+	var (
+		counts   = make(map[string]int)
+		trigrams int
+		word     []rune
+	)
+
+	for _, r := range strings.ToLower(text) + " " {
+		if unicode.IsLetter(r) {
+			word = append(word, r)
+			continue
+		}
+
+		for n := 1; n <= 3; n++ {
+			for i := 0; i+n <= len(word); i++ {
+				counts[string(word[i:i+n])]++
+			}
+		}
+
+		if len(word) >= 3 {
+			trigrams += len(word) - 2
+		}
+		word = word[:0]
+	}
+
+	return counts, trigrams
+}
+
+// dominantScript returns the script most letters of
+// text are written in, and the number of letters. Text
+// containing any kana is classified as japanese, with
+// han letters counted towards it too.
+func dominantScript(text string) (*unicode.RangeTable, int) {
+	var (
+		counts  = make(map[*unicode.RangeTable]int)
+		letters int
+	)
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+
+		for _, script := range scripts {
+			if unicode.Is(script, r) {
+				counts[script]++
+				break
+			}
+		}
+	}
+
+	if kana := counts[unicode.Hiragana] + counts[unicode.Katakana]; kana > 0 {
+		counts[japanese] = kana + counts[unicode.Han]
+		delete(counts, unicode.Hiragana)
+		delete(counts, unicode.Katakana)
+		delete(counts, unicode.Han)
+	}
+
+	var (
+		dominant *unicode.RangeTable
+		max      int
+	)
+
+	// Iterate in a fixed order,
+	// so ties are deterministic.
+	for _, script := range scripts {
+		if counts[script] > max {
+			dominant, max = script, counts[script]
+		}
+	}
+
+	return dominant, letters
+}
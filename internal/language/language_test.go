@@ -140,3 +140,128 @@ func TestInstanceLangs(t *testing.T) {
 		}
 	}
 }
+
+func TestDetect(t *testing.T) {
+	detector, err := language.NewDetector([]string{"en", "de", "fr", "es", "nl"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, test := range []struct {
+		Text     string
+		Expected string
+	}{
+		{
+			Text:     "Bonjour tout le monde, comment allez-vous aujourd'hui ?",
+			Expected: "fr",
+		},
+		{
+			Text:     "Dies ist ein ganz normaler Satz auf Deutsch, der hoffentlich erkannt wird.",
+			Expected: "de",
+		},
+		{
+			Text:     "This is a perfectly ordinary English sentence about my cat.",
+			Expected: "en",
+		},
+		{
+			Text:     "El perro del vecino ladra todas las noches y no puedo dormir.",
+			Expected: "es",
+		},
+		{
+			Text:     "Vandaag ben ik naar de markt gegaan om brood te kopen.",
+			Expected: "nl",
+		},
+		{
+			// Too short to tell.
+			Text:     "ok",
+			Expected: "",
+		},
+		{
+			Text:     "",
+			Expected: "",
+		},
+	} {
+		lang, ok := detector.Detect(test.Text)
+		if test.Expected == "" {
+			if ok {
+				t.Errorf("test %d expected no language, got %s", i, lang.TagStr)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Errorf("test %d expected %s, detected no language", i, test.Expected)
+			continue
+		}
+
+		if lang.TagStr != test.Expected {
+			t.Errorf("test %d expected %s, got %s", i, test.Expected, lang.TagStr)
+		}
+	}
+}
+
+func TestDetectScript(t *testing.T) {
+	detector, err := language.NewDetector([]string{"en", "ru", "ja", "ko", "zh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, test := range []struct {
+		Text     string
+		Expected string
+	}{
+		{
+			Text:     "今日は市場にパンと果物を買いに行きました。",
+			Expected: "ja",
+		},
+		{
+			Text:     "今天我去市场买了一周的面包和水果。",
+			Expected: "zh",
+		},
+		{
+			Text:     "오늘 나는 시장에 갔다.",
+			Expected: "ko",
+		},
+		{
+			// Only candidate written in cyrillic.
+			Text:     "Сегодня я ходил на рынок.",
+			Expected: "ru",
+		},
+		{
+			// No candidate written in greek.
+			Text:     "Σήμερα πήγα στην αγορά.",
+			Expected: "",
+		},
+	} {
+		lang, ok := detector.Detect(test.Text)
+		if test.Expected == "" {
+			if ok {
+				t.Errorf("test %d expected no language, got %s", i, lang.TagStr)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Errorf("test %d expected %s, detected no language", i, test.Expected)
+			continue
+		}
+
+		if lang.TagStr != test.Expected {
+			t.Errorf("test %d expected %s, got %s", i, test.Expected, lang.TagStr)
+		}
+	}
+}
+
+func TestNewDetectorInvalid(t *testing.T) {
+	for i, codes := range [][]string{
+		nil,
+		{"en"},
+		{"en", "en"},
+		{"en", "xx"},
+		{"en", "en-US"},
+	} {
+		if _, err := language.NewDetector(codes); err == nil {
+			t.Errorf("test %d expected error for %v", i, codes)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package language
+
+// profiles maps the ISO 639-1 code of each detectable language to
+// the n-grams most frequently found within its words, separated by
+// spaces: its 40 most frequent letters, then its 150 most frequent
+// bigrams, then its 300 most frequent trigrams, each most frequent
+// first. Languages that are told apart by their script alone only
+// have letters in their profile.
+//
+// The profiles are derived from the language models of lingua-go,
+// (https://github.com/pemistahl/lingua-go, Apache License 2.0).
+var profiles = map[string]string{
+	"af": "e i a n r o s d t l g k m v u w b p h y f j c ê ë é z á x ó ï ö ŉ q í ô ü è ñ ú ie er di an en aa in te ge de ee et el es oo nd is or ar oe ng st se at va on be re le he me wa ek it we al ni ve rd as om da li rs ke la ro si ns ri op ui ko ka ei ik ra ed ma nt na ig to vo ne sk wo ou ho vi so ta sa eg ur ak ag em pe ul ll ti am mi id pr bo tr gr ir ol ot hu ha ew rk rt no mo lo ba gs eu sp pa ry ok wi ad af ru os us pl il gt ga ki dr tu by ts do ev ld br of eb nk ja ap ep kl gi sl og bi rg du od kr sy un ss hy ds ks kk po nn die van ing aar ver aan nde ers het oor nie ste and der ter wat ord eer sie wor ond lik een dat lan lle vir ens uit met dit ges ies maa wee hul est bes end ere erd erk ang nge oer kan sta ier daa ngs rde ede gro laa kom eur rin wer pro voo eel tel ind eid ewe eke ent gel eri ien ele ull del toe eli mee ese tee moe raa aak men ook pla gen ont ker gte waa per oet ree ger oen ike ska sal eld ons ige geb asi taa roo ant was nte dig ees aat lin ans str gew deu ken vol sel boe tre hei voe ite din aal ron kke ate rie jaa gin oek gev min aas tot rik nse uur nee dag eie oed rdi gaa roe eni sen hoe ard art esi esk eko kon han eme ors ort rst reg sko mer rui hoo nne ber ete isi nis ika lei rsk saa era oon ale ran ert lee uid win tin erw rek gem eek ela ene tig man ist oot bet hie doe kte erl uis sti net oop ren els sse bel lie hou kin soo nam kap lig tie kaa tyd koo ank ein fri dee ged ege oei afr spe ins bie ema all stu ari iek bou gee erg tan agt soe nst ten ndi twe eem den ame edi kry kel ske gek spr ekt ern ong pre tra ski kli sui ebr aie eed tuu son ami erm olg lui oos bru erb tte ide iet org erv sit kie gra haa dié sla aam ooi sin wil elk rsi uik res loo tei eds beh ied bai rte elf nin",
+	"ar": "ا ل ي م و ن ر ت ب ع ة د ف س ه ق ك ح أ ج ش ص ط ى خ إ ض ذ ز ث ئ غ ء ظ ؤ آ ی ک ﻻ ڤ ال لم لا في ية وا ان من ات ما ار ين را لي لت ري يا عل ها لى با ول ام نا ير لأ بي ني لع عا دي ون لل قا لس اد أن اس ور دا سي اب مي ست لك رة اء لو وم لح مع اع لب لق سا كا يد تي لد مو مر ائ مل لن دو وي ته لف عد له مس هم اف لج نه يم نت عن لة حا رو تم وق بر تر تح يل اق جا دة فا يو مة تو رب يس إل مت بع تع شر لإ رك قد لش يق بل أم اح قي ذا حد اي عم هذ وت لر تا تق يت عر حي ود اج أو عي طا كل وب مد نو يع كو در فر يه قو سل صا شا مح دم جم كي وع سب او خل عب وس الم الت وال الأ الا الع على الس الح الب الد الق الج بال الن الي الش الإ الف الر إلى الو اني كان است لتي نية الل الخ الذ الص لية الك لعا رات دول انت يات لذي لما لام عام هذا لمس رية بين نها لات ولا لأم اري قال علي لدو لمن وري بية لمت ولي الث لمو تها سيا لال عمل مال هذه لان ارة الط موا مية بعد ارا مست لمر ذلك لاس بار ريا يين عال لعر اله شار ديد يرا دين رئي لقا ئيس ائي لسي يوم ربي لاق لمع قبل خلا ليو كون ترا الى مان امي أنه ران ادي ليا اته مار وات اسي علا ملي ولة لنا يرة لمح ليه لها ادة دية منا بات راء يها لشر لكن وقا يار لله لأو تحد لتع رها ريق يني يون مات غير رين ركة لعم سية عرب لمج تما بنا أول وان لمد لمي حال كما تفا سلا معا الغ ليم لدي اية وفي يدة قرا عود دار رار لمش مين لأس لاح ياس لجم مسا مرا لسو محا تعا بير وما لسل ورة الة لسا قدم جتم مدي جدي اما للم تقد فيه نوا يان دور سور قات لمق اول رائ نيا كوم أمر تجا ارك تعل مثل تصا عات ينا لتح لحر لرئ عية صاد عاد مقا قيق مبا ابي لوا وني انو ماع تهم تحا اضي ركي مام وية ضاف مير اعت لمص ستق مشا عما لفر ائر نسا ساع لأخ لاع وطن لخا وكا لاي ايا امل حدة مكن وار دون حكو افة الآ ودي اعي لتو وسي حيا دما للا شكل لين لبن كثر جلس لحا لحك تمر حري قول نان برا خاص تنا لجن عدد هنا لبر بها نون لعل ومة يست حمد ساب ليس انه اعة منه ترك اعد ارت لبي بعض ابا وقع",
+	"az": "a i ə n r l d m ı s t y b e u k o q ü z v ş h c ö x ç g f p ğ j ä w ž å ì æ ÿ â in ər lə ar la an də da ir ın il ri ən di mə ya nd li ma ra si ki ni əl iy al ət bi ti tə mi ay ba na rə əs ol un nı rı sə as ad bu sa is az nə yə və at ün yi ək lı am on er im dı hə ır sı rd aq əd qa əm rl ta st lm ha aş ur ik nl mü ed ey əy ca ib iz iş bə zə de nu zi lu ld id et cə va kə ll ak gö ağ ye ıl tl rm qə yy əh za or ax ah əb en du ğı ür el gə ab ul ve xa rb ru eç əz şı tı ıq şə pa şa ka ör mı öz ql çi fə ış um dü re td çı ro rt ci av lər lar əri arı ini nda rin ilə ndə dir ələ əsi dən ind bir ara ası nin nın iya anı ını dan rın əti sin iri nla edi lan ınd iyy sın yyə zər əli ədə əni ərə yət dır imi ili bil inə lma liy tin ərb ist ver nun rlə məs siy ala bay ada ola iyi can rba mən unu olu mək alı azə gör yca baş ayc mas lən yan eri əyi kim stə tlə ına ayı and ril əmi dey eyi rla ild min man anl ama ana ilm qla tər aya hər rmə ərd əyə adı isə ali irl onu yin çün ard əcə lik diy dər dil rdə asi azı ətl lmə atı elə məl yar əmə qar rda ığı lun son mət ənd rdi bun etm yas ağı örə tan ıla əsə ldi ran üçü maq edə lla olm irə ədi art par kil həm aca gün keç lkə ill lin miş mal aki ari caq bağ lay gəl rma ndi tmə ölk onl idi lıq arə lət biz çox aha iki səl may rad tir izi miz raq vlə eçi var ğın əki ağl aza ərl arl rib məy erm uğu yən ent isi yer mil tdi bər akı dur ırı sta əbə döv sti sən old tla yev azi aql ava əzi klə ılı san xal lli aşı yat ldu rli aşl bel qal eni iyə pro ird əfə nlə adi zlə əra tın llə bar övl etd daş lam ide ras yir rıl nra onr axı aşa und cək kin una ğlı ata şla çıx şdı çir lir eyd ldə tür mir ura adə miy ina şlə ahi dar ünü nsa məd eti kən qəd həl məh şdi yen tən lib ılm mat özü amı ənə ins rdı",
+	"be": "а н і р ы с к т л е я д у м о в п ц з ь ў б г э ч ш й х ж ю ё ф и щ ъ ґ ї є ӯ ѵ ра на ка та ва па ад ар ал ан ас пр ав ам лі да ла ць аў ст ры дз ма не кі га ні ны за ац ак ьн то аг ль ск ты вы ай ня сь ат ін мі аб ле ча са ля аз ыя ым ру цы рэ ел ус чы ца нь ве зе ро ен тр як эт ві ся ае ік ку од ер шт бе ба оў эн ту іц ап ме ко го ьц гэ іс ол ых ці зь ая ды ов во цц ўн ян пе бы ач ын кр зі дн су сп іл жа мо уд сі вя нт ну бо ду ім ўс эр ах ык аш ія іч но ыц яр ыл ор іх му ец тэ ей нс аю се ул чн іт ло нк шы бу ыс он ед ур пра ава алі дзе ста ньн ага што гэт ска цца ела ару аць сьц бел рус лар ара пры эта пад ась ала адз ада рад льн нік пер дзі але аль так рас раз ьня оль ана ань рац ацы кал ама іка амі ера кан нас рав ьні най тар кам ван вал скі ныя аве лад ьне аст раў тра кра ары пав ных рам дзя кай які іст ьці нав сам аро наг там цыя іць зна аві ова буд ыць нск был кія вац таў рым ака пал аны тры каз цыі стр юць іна тва уск тан лас пар аўн адн ень ецц ным аля мен сва ран спа аза апа удз аюц час усі энт ады оўн пас дна ата лен аво каг дар ьць ілі вед ыма раб ўні одз льк ача ная ыка асе анд овы вае чын аму літ год бар гад кар раі нар рат ліс эты тым ўна ачы чна арт ькі мал аін нам каў ычн віч ьна лік еда уць ані аса аты ель нта гра кав кіх мін атр ася яго ыта нка йск нал вар тав маг ніц ход спр тал ств іра кла прэ адк аба род шэн аец дам ако трэ ыст кая чал кім тэр сту ука вай аго энь яна пак кса вод одн тур дав льш нач чны лав ваў таг рак для тыч жна кры дал ган мов зен або ыва акі ашэ мож ікі зва нов тол рыс пам сав бол ску сьв вык іты ўся яць ман іцы бра ьве чым авы абі апр рап суд даў пач рын іва ына він іла аку іта сты акт пан рал аду піс нае сьл уль наў ылі рэс каб ўны жан дэн ялі кол аўс адо",
+	"bg": "а и е о т н р с в д к л п м з я б ъ г у ч ж ц щ х й ш ф ю ь і ѝ ы є ѕ ё э ђ ґ ѓ на та ни то ат те ра ст ва ен ит от пр ка но ре за да ан ко не по ли ри ов ия се ти ед ет ме ал ар ин ро ор ма ав че ве ле ви си ос из де ел ди во ес ла ер од ки ск ол об ят ци го ак аз до тр ис ми га са ам мо ър им ил он ие съ ас тв ир ад нт ло ем ом зи па ик ич ще сл лн въ би ек ог ез дн ев бе ив бо ок же ча ъл пе иц ай ой сп тн ру оп ът ня гр кр бъ нс чи тъ ап иг ба ха пи ур яв бр вр со кт ше ля вс зв ги ож ои ег пъ къ ид ах ус чн сн рн ащ жи ите ата пре ени ето ото ост ред про кат ова ани ста ств ест ния ира нат ава ият тел али нит ане при мен ран раз ват ние ски ент ато тов ина ван нал сти ист рав ове нов пра ори сто стр ска или рат ята ари има лед еди ция ели оди дин вен ден сле пол тра нос ици тво аст гра ини едн ика сте ави лен ана под аци как ком ате ява пос оме рит ито тан тен аме нта ово мес гар алн ена кол тор лни рез лиз дат вър ълг рад нет ник кон ати рия вет ече ора год ови тар оже ери лит лга изи тат тав кои бъл оли ете оит жда каз лно ено мат ога рем род зна вот иет кой тни мин тит ара еме чес кит пар иче сам мож ого тва тър обр ъде пор кра ичн ака гов яма гна ков ако два рен так еле ета уме тер нот ион доб нск елн сиг лко зир дан жен бра нен вит сре игн ита ити спо дна ано зва мно ниц ров гат вор ива акт общ нап акв оре она чен дър аза ува амо веч дни ока нас анс тно мал ско рах ням бил ичк ера мер тур зап раб ежд нти оло рес бъд або дел дно ърж вал иск тро лич час пла лат бот най ект иде рис ала рск лас еск оти ади мет ози цен оят оле кия еде ода той еми дру ети със тве арт тив кан ног пов бор ези три сич тре няк слу ази ица рос еше вре сно нар азв еда нач цит неу едс вод вни евр ржа дст руг въз ико аде",
+	"bn": "র ন ক ব ত স ম ল প দ য় য হ জ ট শ গ এ ছ ই আ চ ষ ও থ ধ ভ অ খ ণ উ ড ফ ড় ঠ ঙ ঘ ঞ ৎ ঝ",
+	"bs": "a i o e n r j s t u d k v m l p z b g c h š č ć ž f đ y w x è æ ð q ü ö é ç á ő je na ra ni an ko st ij no re pr da ta ti po en ov ja li ne ka od va la ri ma in os nj vi za ed ar om av to im al sa oj ro vo bi or ci ak di at ad og is sk on te aj ih er ju se il lj ve it iz ji do et em tr lo am go mo mi su ic me ik dn el ek as ol tu es de ob io iv bo ki ru le št ir ev ac sl ao ku ok pa ke ba dr ce sv nt az tv si vr nu ga ić ot će eg op gr br vn zi sn ut ns us ič če ca ud lu kr up du či sp iš zn pi tn ač un vl eć ez že ur ap ze ije sta nje pre ost koj anj cij ima jed ija pro ovi pri sti red ist ako ara ova ani ran ali nja iti jen edn rij rad ovo odi aci ati pos tra kom nov nij eni ili nik nos ana oji bih nic lje sko ina ada din gra sto van gov ava ine ici str ona est kon ira ski rav ika ini ora nog dan ent god ori raj aje dni oli ila men tav lja avi oje dno nih jer rat aju enj jev ena voj avn stv nsk eno nom tak tan pra tar lik bil ica aln nji ren mje vje sam zna naj ove ala raz ama iji jel tor ego kog kao nim ast ske vij sje jav vor ans rem ema jes era osl iva nar pod edi eta pol lan osn tre vić lju por eds man odn ite dje ion ano jet nis eli jem adi vno ene eda vat sar vni što nal eri bra dru rod rek ata ičk nju nas bos las vin vla iko ilo ter kak tva min elj amo ita oja iju tiv oko tvo vlj ice kol svo aka sni oda šta reb nak eka pot drž ari oni ome jan tim avl eko ste tal kad odr ave tit bit slo ust rža lad vod nek eti lov kih jeg ekt olj spo val iza san sve jek aja kan bor adn šnj ska isa oga alo ral mil ono sla gla ore čin tsk nta bij lit lic dob obi psk stu već tri ede ant ris đen pla rom sno tni lji mog išt pis poz dst zbo ele rug obr rov tič jim jsk nap aro tin vim lij tno izv jih tom sli dnj mij",
+	"ca": "e a s r i n l t o d u c m p v b g q f h é ó x j à y è í ò z ç ú k ï w á ü ñ ö º es de en er el re ar la ta an qu nt al ue ra at ci on st ca co se pe un na or te in le ri va me ia ti ns it ls tr am pr ic ma ac di pa li os ll si po da ro is sa om no ha és ad ts ol em ve ni ir ió nc ec mb rt ur ne so et to as ss vi gu eg ot fe ba mp ct mi io im ix eu ny lt av il ua mo ce nd ui oc sp ei fi rs rr ut ga do lo id tu ob aq ge ie br ig us ab bl ai gi pl mé ap sc ul gr ev cu hi iu iv su ex rà fo fa rm au go cr lu rc dr du ed ja op vo que ent per est del res els men les con tat sta ant ció amb com ons aci tre des una ues ita pre ona ica cia tra era ion par aqu ada pro esp nci ran tar ist any ter nta més ntr ici tes car ame ten eix art ser als ria cio ara ass tal nte sen nts ort cat man ens ect sti ell pos ver tot fer tor van seg tan ura ari ats lla ers lit ina str rec bre por ste arr tic rti tam qua mar eri ont ssa tit lar ost alt act ava lic ora ssi nes int egu ana ren ali for nya ata ess all rre ins omp ime nar ies nal enc emp nti qui aix gra mer mat rat rar den seu ret ble bar unt esc ènc ans rta fin olt cte ide rac dor ome lle sev ual ial gen ere gui osa mes rma ene erò tin tur sos eta obr ili què mol end cal eva nse ner ral dia pas han ade pri ven can ert lan nic one eni orm ors cap ure ori dir cor tem cre inc uni itz sar eur ate vol pan uan cen via ron cas ove llo ida rad ill ves ern ltr reg ado rim nys sit dis ese ala arc ota err fic dre spe nca mbé tza ord col ega ien tac ena mil nit cci der rop rqu min exp cti ani ris ego pod nat lta gon tiv dic dem oci and vis sse ele rit sat reb ixe nom igu ave ema pla avi eco oca tiu uta esa ban iva sió pli tua mpr gir cam spa ive ini imp ind cie itu lor ode cos rri",
+	"cs": "o e a n t i s l v r k d p m u í c á h z j y b ě é ř ž ý č š ů f g ì ø ú è ù x ň st ní po ro ov na ch en ne le te je pr to ko ou ra od li se ho la al do ta no an os ce em ed va er lo at ti sk in ak de el ni ře ně př ol it ob za il ve ka ic es av dn ad ná or vo et tr že mi on ku me by ot re rá om vá mo vy ej íc ma kt ké so ci is tu pa ze ím ar ek né jí as da ec vý sl ří di vi vě án ky ok ru am ud op sp oz ji ři tn hl ál mě nt bo vn si če ja ac mu ýc ez tě ší ik aj ný tí ck vé dy át uj oh ln ví áv ri eb iv bu ev us ut ká oc dl pro ost sta ova ter ení ých pře kte pod pra ého sti ist kon jak ích sou tak nov ské ová ale ent pol sto ech ick val řed hod edn tel nos str ové ání byl vat při rav est spo kov vní roz nou oli let ali rov ako uje pří bud dní odn ole ním nej ají tra ran kol nic jed lov den tní kou cho ast led ský ste ván níc stu tře pos tov ili jen neb stá dob tav lní dal rod ate ros lad esk ude ího ový prá kla ele vět áln ice ovo cen ani nem lav rad ich ečn kdy oto cké tro len dno ala stn pad lit ovi odl ník oho rot oku ace hla ují vol hra men tic ční nýc rok lid dle alo sko děl nik tom eré zem dos rac vel min dov ede ebo van jso sle ráv por ila ina oje tor lou sem nes ště čes ovn ite cel erý oce las nsk kéh sku ezi pov dou oru sla ved sob měs kýc pok níh ekt žen ohl nce vod vou ven výc ici lat lic mil zen eho pot zna lik pla ěst čas íst osl ící roc eno ten aké rob stí nec avi ete tal stv jej ilo cký ati vše slo nám odi měl res rop pom něj oti adn oko stř tiv hov din kra hle olo jse jší dne tví ode kom rát svě poz vin ejn ame ide ově elk tup pre man jíc něk ika ále prv áva moh víc lší tat mez mus tec udo mat eck chn tím dem raz nep alš roj tou mís ych nen néh och ách aci erá anc ené lád ave nal cha",
+	"cy": "a d e n y r i o l w g h f s t c u m b p k v j ô â ŵ z x ê é ŷ q î ï á ö ó í ë ü dd yn yd an ed ar wy ae th en di er ll ol ei ia od ad ch da ai on ma ra el ne ga la ri in au ym ro yr ha fe le oe re de io ni gy we cy id or do yw na et no he gw es al li ir go hy ys yf ng ff ef af st il ca ac eu nt dy rh am nn wr ho eg un fo ly aw wa me as nw fa wn is tr ry lo it if ig dr fr si rd gr sy ta nd fy ew dw sa lw hi at rw ge ny og os te se rt hw be sg ur pe wi co by br ti iw lu fi bl ce ba gl bo to pa mr so yl ag rf du ru ic hr mo mi ydd edd wyd oed eth ddi gan mae odd aet ddo iad ith wed edi aid idd enw ion fel nol han lwy rdd cyn lla rth fod dia dwy dol ned roe ymr rif rwy lle nyd dda neu gyf cyf wyr ynn rha ill dau hyn lad fer lan ain ref fyd lia gol yng cym nia eit iau wyn din ewn ria yda nod yfr gwy ell chw ant ael gyd rai rae enn lyn eir ysg tho gor dio ait wys rch hyd lli ent lly tre cha ard nwy all led gwa ach ada iae dde dod efy oli dae pen ddy eid diw ara gwe ddw yst mew rio ryd gyn ynt hol ydy afo ian wai ych llo chy yfe ann nna dro ngh lod gae thi ond ein ane dyn can add nes lae wei iai neg rhy and arw ang awr dig ano ini nig dal eri for tha mru rol efn yny aes ert the der ail red art era dir war ymu mer ben hef fre ord wel est ene iol dar dai dyd law ter ffi sgo ina gri syd hwn nas gym fyn har lai yno dra hoe ran dan hen dei sia res fan cae aen tra wer byd ros loe len ffr ife eil hym odi ste sae nau don bod str ren sne ber ntr eul rei far ade bar gen ffo che erd hau adw nno sta nni byn wrt ewi fei llu fen eis new ers yma sen eol obl rad ulu bri iri nta iod ech mun ger lei eni rau cei gwr ffe esn hai yll ngo fra ana hwy yho mwy iff gle lio uni fro hed une taf oeg per pan ono hon saf cho nnw ola pri",
+	"da": "e r n t a i s d l o g k m v f u h b p å ø æ j y c w x z é q ü ö ä á è ó í ë ç ñ er de en et re an ge te or nd st le ti in me ed ne og ar il at ig ke se ve el li fo sk ng al es om vi ha ri is ll be ka ra si ns la ag ik ma af un da on ta ol på kk he rs eg ko em id so ør je nt va ni rt tr ds rn ær ld gt mi to mm sa am ud fr år iv bl ro di dt ls tt pe ru it rd så ad av hv pr op gs dr kr lt væ ov ev rk ba sp ie od nn as ss br ho io ej ku ul ft fa no us ek na ga lo mo vo kt ur bo fi æl kl gr ef rg lg po hu pa fø æn ræ ny sl ce ks der for det nde den til ere ing ter lle and ger kke lig ste med nge ver ede ige ler end men gen ind har ikk mme sen ske som rne ern tte man els ret ill nin ens age ent ang ive ska ner kan var ser sig und res est lse vær han ren mer dag nne ker ove vis lan ten del get ion ene fra ist ære igt kom ell ans kal rin jeg omm dan vil ers rer eri dre ort red isk lev fte ide nte tor vor sto hed ord ale lde str sta sam ati sti old ble tio ege hol ors liv tal ved min eli hav ore sse one ven all bru tid tet are nsk rke sel ndt øre ken lin mar bli per rig eve hel nes hvo enn kon dig pro ber fre ave ris iti kun oli alt ise rug lli len art dst sid nen rst elt lge led ogs amm lit ndr tag gså des rde eft gan kri rbe lad let tig ele før nse nog sin mod bor bil ine ngs tis hun ade vet org her gge nds elv ark åde tre bes par god kel hvi ald bet oge igh pri fin tra rte lem ghe ran gte spi ett arb ert ons ejd pol ude orm bej vin jer mel ted sda rre pla eds rem ess kla tik sko rie ket vid akt esk yde hen att køb net reg rli int eks gør ekt erf gel ole ørs ate kti ant ass ben val uge avi alg ppe tan skr sku meg emm tur rat rge nst dem eng tin kol kab rti mil fle gle lag nem nye bar ann rsk tiv ned ode run",
+	"de": "e n i r t s a d h l u g o m c b f k w z p v ü ä ö j y ß x q é á è ç ë í ó ø å à en er ch de ei in te ie ge st un nd be an ne re es di he ic it au se sc le ng is el on li al nt si ar da as we me ll ha ht rt ti or ra at ss ri mi hr et us zu em wi ve la ni ig ur vo ta ns ma na nn eh rd ro rs ab ze uf am ol so ac im lt il tr eg eu ag ts ke fe wa ut ru um ür tt sp pr tz sa rn fü ko ah ir hl eb uc ka io kt mm hi ed om ls ft fa ba gr ck tu to rg ga oc bi rk nk gt fr ec nz hn pa nu no wo ue os ho pe rb gs gi fo ef af lu üb rl zi vi der ich ein sch die che den ten und ine gen cht ter ung nde ste ver eit hen ber das nen ist mit auf ere nge ach ren ers ent nte ier and lic lle rei ert aus rde men ern ben bei ige abe von sic end sen sta uch wei sei ner ion des ges her sse hre für sie isc len ass ger rte ind dem wer ite all nic vor ang ell och tte iel est ege wir ing run ese lan mme ann auc ens wie nac als ahr oll tio erd lte cha hat übe lei rst ech ies eis age ien war pro tra tel ler chl art man zei fen eic ehr ene ngs hte nne lie hei ati ebe eri ede rie ser tsc etz zen tig unt eut uss tei ran ort itt ele bes str tli ete omm alt kom eil mer nst erl ehe enn erg elt ins tun geb sti eru ess sin hab gel ken tag rau one tet erk spi nis tzt chi att geg rge pie kei sol lin kan ric ied erh int jah vie esc hal rbe ate ide haf ill kon era chs ffe nem ihr erb nnt iti rec tie wen ode fra eig hin hne aft noc eue neu anz for rin nsc tre son ant eur geh rsc chw ute ird ini res meh deu erf hme tze ank mal rch gan spr ord akt sel rer per nie chr han cke gew imm zie mei ris fer tar rne chn sam min rat err erw zum uro kti sag bis gte ieg mar lli hie rag nze llt ale lau nun hau tan sst lun agt ans chu ück ise kön was hri tri uts rit",
+	"el": "α ο τ ι ε ν σ ρ κ π η μ υ ς λ ί ό ά έ δ γ ω ή χ θ ύ φ ώ β ξ ζ ψ ϊ ϋ ΐ ἀ ΰ ἐ ὰ ἰ",
+	"en": "e t a o i n s r h l d c u m f p g w y b v k j x z q é â á ó ñ í ö ü ú è ç ä õ ï th he in er an re on at en or nd es to ar te st ng ed it ti al ou nt is ha as ve le se ea co me of ne ro ll de ri hi li ra io ce ic be om il ho ch ca fo ur ma la ta si el rs un pe wi ee ac di ec us ut wa id ai ns et we pr ot lo no rt so ge tr ad ni ay ol ts am ow ly sa ss sh ie nc mo ct po na pa mi wh em ir ke fi oo vi ul pl os ld da iv op ig im ci ia wo su ev gh ry ty do av fe bo bu ba fr tu ov rd yo mp ag ab gr bl ck sp ga ey go tt ei rn ls cl the ing and ion ent for tio her ter hat tha ate ati all ers ver ere are ill ith res his wit thi con ted com ear men pro our sta rea eve est ive was out nce ome tin oun ons you ave ess one ove per ide ect int art ort ore ist cou igh aid hav rom ine not nte ity fro man sai und der iti hin ain ste par wil tor ght ant str can day tra pla din ice pre rin cti ame ies han nts ica red den has lin cal end oul sti but ast eas rat rou ple ard uld oth eat tur wor hey use min she age cha sin ust ran por hou nal lle ble ree lea mor eri een ont son nde ren kin nti ber wer whe rec unt ake own lan ven era ure tic als yea inc act hen ind ead anc ell ces enc tat sho ugh lly whi tim nin nes rie hei ost sed ime sto ssi ial ack ric uni ose ite tho eir mon any off nat ins who ass ten ona lit new tte ous lic mer ner mar ern ser tes che omm oug cen sid les chi abo eal bou gra ope hea tiv ina har tri eme sit eco ong ade spe ned mil ans ace lat ese how ery ire thr ded now app ase ach sio ork dis ral nit oin hil cia omp som pri get tan pen led ich ini ord ndi car ele abl ntr nge lli cat tal fic ond way ood fir sen win rit ars ook oli mbe ali its hic bee oll had ene gre pos old cor ang las att ays ile orm rep",
+	"eo": "a e o i n t r l s k j d u p m v g c f b z h ĝ ŭ ĉ ŝ ĵ y w x q ĥ é ā á ü ö í ó è la ta aj en st an oj on de ti is ka er to es as al ro li nt ra re te or ri ar it in ko po ma ia io lo no tr el pr do ek ki jn un ig ni at mo le ci me va si na di nd il aŭ pl da pe ne vi gi om se iu am ur ad ik ol mi kt ie pa em ve rt ku ul su et co du ns ga ĝi nk tu ov mp ce ec zi fi fa so im ak fo gr id iv sp ir sa rm ke nu vo os ok nc lu lt ed ru bo av kr mu ap um ng us ks bl ha ac go uj ba ez sk ic iĝ op ej zo eg fe ut od ev ĉi br ua ab aĵ pi est kaj ita sta sti tas ant taj kon tis toj ojn por ist ent nte nta pro mal igi nto ado ter tra ran ajn per iel sto ili pre kie str roj pli int kun aro kiu ali and vas gra ekt kom tan iaj lia ple loj iuj ono lan par eri ont for men nda cio tro ank tiu ion eco ova ort noj ato ult man git art aĵo era res ton tem ori ala ost ika unu ver mon ara enc aci tri ons iko ntr kto ata don dis rit iga pri ste ris olo end pos kaŭ alo far emp rto ero tit ndo ren ona ron ten moj pov ndi ilo eks sur nka eni ari cia hav ĝis ekz kti gis spe mul oni ive lon doj lig nst ati raj ano ond are ian sen orm tur ioj rio tik tio ava ers akt eno ini mpl ome ven lit lta oro lin iam tat rad nis rti sia duk ene tor ina emo ind ate ame ide ing nti nom jar mil rma ern ila ani ser ino tru kva fer vid van rig lej kta lek tig reg ana elo ama ura sim taŭ vis ukt omp rez sek kri ele iĝi erm cen bro den uzi son laj las ere igo kci koj ulo ebl nco zis tal dan gan eto sis ito egi kan alt uti dum stu ovi ast ici lik kre rak uro min oli pon ena uni erv tar fin rat tiv rib ial sed bla ale met rek omi esp nci nio eci maj gas lar mer mar ksi ner anc sub nit nan ert iti tek ret ite iri kia ĉiu omo ras ejo tre sup ord iva nde ora",
+	"es": "e a o s n r i l d c u t p m b g v q h y ó f í j á z é ñ x ú k w ü ç à º è ö ã ª de en es os la er ar ue el ra re as on co ci an nt do ad qu al ta or te se lo un ro st ca na in da to pa po ic le ri no ac ie ti si ma tr io di id me ia ec pr ne ha nd pe li ió mi is so sa mo ón nc su om am ce ni em cu ab ba vi ol ve eg ga rt im ll it il at mp ed ía ur ir bi oc gu va ch us br ns go fi pu sp ui ho ct ua mu et tu ob za od gr iv mb rr bl ot rm má ig jo vo ea ev ás uc fu añ ul pl lu be rá ap fe rs ex sc pi cr nu ge ay rc rd ño ib op que ent con ado nte los est res ión par por sta aci del ció ien ara las tra per com cia era ica ero una ida men nci cio ant dos des dad ion pre nes ada rec one ido pro nto ndo les nta ici ier ist ntr and enc ter ona ran esp ene ten tar ron tos más ari ale rio nos ina tad tro man ras qui ico tes ali mos end ora uer eci str ros art den der tor ste car aba omo ont ita esa bre lic lar fue rad tic sti seg ios pue tan ser cas ura nal ren nde emp gra mer mar dic ana ver uni eri rma ere año cer ide ner int ade ese dor ect ons das ore cad can son ndi ers cua egu gen min tre edi sto ría ern esi cto cie ert tie cho ria lle ble ace ano tas tal tam nad lla inc amb rte tiv pri ues aro llo ele ort anc mie ial mil are for sid ame lan fic eso mbi nas rar hac orm rac ens iza cos tod cue cen sus ill ema ena nic ece uie uen ili ven nda rti omp cha bie nti esc asa ond spe hab sin ede pos ori cal rta mis cam err ami ces rea ued rim und nid ime dis pas emo ell oci ome sen cre gar sió ber ata isi cci cor odo ral ega mun ños nar nue ech obr ias gan dem lid sar arr act ast eco rid cid leg mpo ual reg mpl dec med tur mas ani imp ama hor ism tid unt mpr iva uno abl bar dia otr uda ela ini ará imi rso rre sal",
+	"et": "a e i s t l u n k o d m r v p g j h ä õ b ü ö f c y w z š x ž q ð é þ ô ō ø á å se st is ta as te us es al le el li ma va in ja tu mi si ee id ku da on aa ka ko et ik at ri ni an ti ud it ol ad ne me ks la ii en na sa ei ra oo ga nd ar ul im il ll ak am er de nu em su ts ke ed re gi uu ek nn ut lt eg ju õi lu ki du av gu ea pa ha di he ai ah ur võ ig or ab ev vi ag pe ng aj mu är un ui po ge ve ht om to sk ro vä um pi os hi ir ot tt ss oh nt uk kk ld lj ää au pr äi tl eh pä ia jä ül ru öö uh lm ba ub lg rv iv ap tä so ae äl est ast ist ise mis sel use sta ust sti nud ste val ees aja ava tus lis aks ali ele kui ole nna oli ema eks iku end sed lle lik või ime min dus saa ine ell ing and ata eva ida aas aga ide ses nda ega atu maa eri stu ama las vad tud kon ada tsi inn ami ita tas ate ini lin eel kes see pea tul oma tse tee tel its koh lus all uur lli vas sid ale kas esi oni alt ima tte aal lii ule ase iis eda mee teg sii elt les uta tam ndi rii kus ool eta sus kor pol oon inu asi eid lit nis tat igi mas eis iga tal tis töö ade eer suu rah anu tes lla nim ene mal sse eli imi aha kul ile ari itu ala mat des väl gus tei nin sin aid sek lek koo ots tav umi mus ahe dis ent ndu rit mes tsu lja isi ani jal iig ame lem ter sei kse tad uid tun mil kog juh ris ite emi tea isa pal äev met aar ras ika kku päe kir tab ult ane mei ära asu ind ogu kõi vat sim res tak era ost lda nii uli sam arv alu roo uma idu ald esk pro ähe etu usi nik aad ako htu uud egi ili imu mak tle loo ikk vii nni are ese par üle uri kin iks ngu ill das ete võt sio ure ina ead iti õig del oht eal ike aan rik ett nas ati vee ngi iva aat van sis onn mid ilm aeg pan hel rak ela tan uba men iit kel vai ord ond nde mit uht õim pär sea seg alg kuu",
+	"eu": "a e i r t n o k u z d l b g s h m p j x f c v y ñ w q û é í ó á æ à ü ť ç ú è ö en ar er ta an ra te ko re at ak et tz in ri rr it ze ba tu ai za ik ek na la be al or ia da ea di du ka ur de ez rt iz zi nt ha es eg ga gi go st oa ir on gu ut ti ne un bi az ki el nd le as au ag ma ue io sa zu il ld ua sk ro ke us ho ab ie hi ku uz li ni eh ge uk zk si ru zt pa me he id no ol ot so is to ng ts ad tx os do ok su pe em eu rd ja se lo oe po lt ah rk am ed ei pr oi ug bu mo mi ib ul ee bo tr ud oz mu ig lu jo nb nu zo xi sp ap pi eta tze ren era zen arr rre ako are rri eko ain ten ber bat atu egi ate tza atz ari ere err ntz art ald ean itu bai ela ste iza ara har itz rra tik uen tan ina tea hor iko ria rak ena ira tek est rte ute kar koa zan orr ent rik gin ago gar ita ala ori ait rat dut iak ite dir ert ter ska dit ant kin rtz eki tar rek tat ene tak beh lde ide urr den zio gun ika aur tzi rtu ire men eha zek git kon end zte bes zer kat ema lan zat rai nak oak bil esk uru lak zue abe raz ngo usk tua ora eus ura zai eak nda int dea rit zea azi sta ale uzt zia ien ger bid ran ati esa ort iar ist kal and aba uko urt eza ndi gai ust her ine tzu ian ola izi ont una zar gur uta lit ioa rta bur ndu zal eti due ego aki asu nar ind zit agu lar nea egu ret tor ona ket ile oan rab dia ltz man nde tuz ugu ail ker nah iku tur ero ahi nik ldi sun eri ila tut ana res izk sko ehi zak aka ali uan ida nek ldu rea dak ino ord ure hau zke dag bak kus rie tas une ama bad net iti azk azt tue dat ond txi ask bal ear zko par ezk iri unt rdi iru der san dio mat adi ast nen tal sat abi dar ete edo lea bet del uak ang one biz pen pro ing ada ore ota bar han oar ehe dug tuk ion tel sku jar iek gia usi hal goe rua nte ezi gau kas mar",
+	"fa": "ا ر د ن ه و م ی ت ب ي س ل ش ز ک ف گ ع خ ق ج ح آ ك پ ص ط چ ض ظ ذ ى غ ئ ث ژ أ ء ؤ ان را ار ای در ست ند اي با دا اس از ها به ام ما رد ده اد بر ور ود ال ين وا می رو لا سا ری ین نا اه ات تا که نی يا شد هم اب ته مي فت خو ون نه اش ري ير ني گر شو زا مو تر ره دی شت لی مر دو مه تو يد ول کر وز یا نو من بو دي یر اف فر کا جا قا هر گا رس کن شا دن خا او آن اع وی يم سی نت تی مل مد سي نگ ید لي رت بی اق مت رف عا تن هد رن يت وي كه مع حا نش بي نم زی رم تم مس وم اح فا پا دم اخ گف یم جم شر تي رگ سر زم عل رش يس جه وس خت هن نن زن يش کش له رب رک یت است ران اين های دار این مان برا کرد رای بود اند وان زار دان ستا داد انی شور سال ارد اری خوا اشت گفت خود روز شده هاي تان نند داش شود ندا اره يرا اده کار گاه امه انه نها نام رده باز اما انت اير لام يان نده راي ورد واه بار گزا توا دند شته ارا ردا دست رفت فته کشو دام امی نان یرا ایر تما باش اني شان ساز زما ارش اخت جام رار بان اهد رها تند ادی ارت شهر دول سان نوا اري ایی ولت یان مرد وار انو نون راه قرا سيا فرا كرد وری هست افز مور انس تخا ايد وند ادا هان انش سلا خبر رما ردم نما بات الا کند ورا زند نتخ ردن رات خاب گان ديد نجا کنن يست بال حال الی ازم برن صاد رند ابا هور اسا جمه اسل برگ اصل شار رون باي مای مين راس مهو ستن گرف ماه جلس دگا علا اسی هار گرا يگر مسا رگز خان اعت تصا ماي افت تار هرا مقا وجو ديگ نظر سته رنا ازی ياس وده ايي ندگ اشد لات مرا زاد مین روه وره نگا نيز تفا آنه گير ولی هند مام عال اگر جود دور ورت حمد منا ربا مار امر ساس رست قاب زود اور رسا مال صور شگا موا تبا رکت لان قان برخ امل هنگ يار جها بخش طرح انج نظا رسی اقت همچ دها ارن شتر شدن امي دید ايی شما ابر جان جرا توس گذش ذشت مجل عنو فزو گذا تهر راد مدی نشا پيش اجر ناس قوق ولا حقو ابل ايش كار اعل تيم ظام قلا مات برد بای قتص مچن واد یار تری ترا همه ايت نيا پرو انن فعا بين خار شنا لاح هزا آزا یلی ایش اول رين الم کان ارس الي معه",
+	"fi": "a i t n e s l o k u ä m v r j p h y d ö g b f c w z x q é å ü š ğ á ó ž í ø ç è ta en is in st an si aa tt ll it ka se ai va sa te al tä li la ja ti on tu el oi et ma mi ki at ko as il ss to ii le ut ku ol es ne ik ke ei ks us ää nt un uu ee uo ri än na er jo nn ar os pa vi lu ie uk de me kk ia su ot ak ty ul ra lä au ni im mu vä sä om ht ha pi lo yt he av iv kä ok am sk pu no nk ur ns vo mä mm lt pe em aj ät ve äi jä nä ui pä ir ek yö or nu lm id vu ih rk ro äl ah rj ou ap ys po hd so äs op eh lk oh tk re hi äk eu är je äy ist sta ssa aan lla tta ise ett taa sen itt een nen ais ksi ttä all isi ell lle lis ill ast est ine iin kse lli ste den stä ain mis ään aik oit vat maa oli utt ust kaa ten nta sti uks toi tti lai llä ava tel kin ikk oll iss min tte kun tai ess kan val ssä itä tää aja voi sia ala ent ole ois ita ien men nna kka oma ott ses ide kai uut vuo suu saa tee sin ass ika lii eis aut tii att vai stu ina kau suo tei int sii uom tav eri tun vaa unn lin sel ite tus tul ost utu pää ali tet sit lta ant nne oim nki eli sto vas pal ttu imi äyt mat taj kes ans aat set kuu itu uol ila ytt uka uus ama tam oin vii iva nee asi per ann aal iik ude nsa van iit enn aks yks tar unt rja man til tuk iel muk mie kir pai joi kki elu käy tie see nti ana esi san ova ime lee hän enk uot ulu nyt alt laa muu sis työ myö kui rin nut sil ken tal ike äll alo lan ens inn isu sku lit uva ker mit esk yös lut ämä mal ilm kko rit ivä hal eet uun lma vät tan äis nii nni täm vie iko ari ene oht hte irj tto kas jan apa tuu kon ton elä ami nte mme emm var yht ail yvä hti ter nnu oka kok oss ele koi isä hyv ity atk äst pit jen jat tis alu hta sal tuo ome ano mas eit osi nan puo han päi nis eks tin las uud nto ran iis ark des ati",
+	"fr": "e s a n i t r u o l d c p m é v f g q b h à j x è y k ê z w ç ô â î û ù ï œ ë ü es de le en on nt re ou an er ur te la ti qu is ai it in me ne se ns co ce ra et ar ue ie st tr io pa at au eu un ri po il pr al ma li us ro ta em ve ir or so si ui oi ll el ré ss ut nd ch di té nc rs om rt sa du as na ni mi pe to no da su dé av ci ca ic pl ec vi nn ac ge és lo mo vo lu ée fa ct ét ts ux va ol am ér mm ha mp ag fi iq bl tt he éc im ul uv os tu iv fo ap do né id ab oc ia ga ot je ba rm rn op sé rd gr fr cr rr ad cu ng jo dr mb ff ent ion les que tio our des men est ont ati ant par eur con tre lle ons pou res ans eme ire une ien ait son dan qui ais iqu com nce pro urs nte ell ous tou ter ain air sur pas ran ill anc onn omm ntr mme ier ouv che tra ale mai out sse nne ité ist tte rai art ort tai tes ren ine end ser ure and int ssi aut pré ers ten uve plu lus fai ett ins oir ère ver ces nts cha enc nou aux cti ess ave ens ass ise eux ect age rés ble pre ite leu iss ois rie iti ste ven ris jou ali ses ava cou rti ues sti voi tan ern pri lit man ond tat per san rat por éri nde ute nes ide mar sio mes cet éta ive été nti nis sou vai for mon str vec pos nal sen teu min eau rem tie app lem rit onc tro lis omp uis ert rou ieu nta dre bre ièr sit fra ron nat rès don lai mis den lan era ndi ici ini tiq rte ées lie act ili gra mat sta ndr der oit abl ssa lon ita ica uel uss emb née tem tur oin all nda peu éra ina nse fin sem ani emp roi qua uni rec vou déc rme ard ann uit uti err rta mil cer ang van oli nie isa pla vie tri tit ign rop ate cor dis isi orm ric mbr ési ori ari bli ils att moi avo utr oup ime ace ail enn aie ona aus ura nom emi acc cie nco deu tés dit nan sai ice sid mer itu ler pui tiv ême imp éco gne nst",
+	"ga": "a i n h r e s t c l o g d m u b á í f é ú ó p y v w k ě j z x q à ò è ì ù ç ü ö ch an ai ea ar in ir na ha ac th ag bh is le il nn ta ei dh mh oi he ra ad id it ái on gu as la us ui nt eo se ht te sa ío éi re al ga ia ma gh hu am ao ne at ua tá éa ig st aí rt de si go co sc ca om li ho im ri ba oc da os ol io fa iú or hr rí hí ll há ní ti ic do ur hi ce úi cu hé ge lá fh ng ói hf lt di án gc ae hl sé ib en tr ph ab ob fo lo un be rá ro mi nu fu sh gl ná ni cl mb fe eá ci fé nó ór tu rr tí cr me lu bl rs br ns lg cé nd ru dt ach gus agu cht ann air ith hai ean eac adh inn chu tha ain hea cha rea ear the ait seo eis nta amh hta ire aoi idh igh uai bha tea lea ais ile lei rai nna ead eil och bhí omh ana ine har mha aid bhf oin sin onn irt aig ist ail mar oir áil hái int nac íoc áin cho bai ant uil éir ion tai ath hui nua atá idi aío abh eas irí éan héa lia ibh sea hei áir oil bhe aga íon iri che nne eag lta eit fui eoi hfu ill art cai dir nte gha san ian féi cea arr iad eir rth aei nea ste gae fao ilg ont sta coi lai lge lac sco hun eid aon nai con hra nga oba uir uid ama mai éin dea aib óir bli eal hur cui héi ola imh tar all taí iar uin isi nío úil gan río fea íos ise sia thu dar éid ras ria áth bea alt éad ina ois tac ang lái oca léi ili ala gai gur áis nam hoi rac eam lua ona asa áid rsa eat com tas mac fad hom áit déa siú úin ara mór thr ide ich hla dei ige los rra roi hao glo uas ada ini mea bal éal ite iai tio cal don iom eol hal ial foc han aim céa rei iúi ran bhr hre ars len ura dui liú raí hua cin has ché dhé éil gac isc sca bei éar naí obh réi dao gea hin mhá mbe iún bre heo odh den ghl ard oma chá úda tái leo laí ort ogh rin agh gth teo aca íom uig hac fho ost fai nnt ast thi olá eor rit lla",
+	"gu": "ર ન ક મ વ ત સ પ ય લ જ હ ટ દ ગ બ આ થ ડ છ શ ણ અ એ ધ ચ ભ ખ ળ ફ ઈ ઇ ઓ ષ ઉ ઘ ઝ ઠ ઢ ઊ",
+	"he": "י ו ה ל ר ת ב מ א ש נ ע ם ד ח כ ק פ ס ג צ ן ט ז ך ף ץ וּ וֹ בֿ בּ כֿ פֿ כּ פּ הּ ײ שׁ אּ מּ",
+	"hi": "क र न स ह म त ल प य व द ज ब ग ट श ए च अ भ ड इ आ थ ख ध उ फ ष ई औ ण छ घ ठ ओ ढ झ ड़ कर पर इस और रह रत सक सम उन एक रन सर नह पन रक कह अप तर नक यह गय जन वर पह लग हर उस यक बन मह कत वह तक गर हत दर हम अन रण पत रव शन अध इन हल टर मन बर जर बत रम रद मल आर कम आप हन गई कल पक यर सल एस चल जब लन रख यम लत रस मर सभ टन बह उप आय ऐस वस वन सद सह सन उत बल सब गल अब गए बच सस लक जल अल आई गत तन मत बस एम अभ एग सप नम लय मय यत नर बढ घर आत दल नत शर रप अस जम खन कई नव चन अम अग इल पड एव भर मक आद षण धन आज वक बड खर मस दस जह नस मद आग अव अर जप यन पय लव फर चर पस बद करन अपन इसक उनक सरक पहल सकत करत उसक समय तरह आपक इसस नगर सबस घटन इसम बदल शहर अगर यवस समझ अलग रहन रहत जबक कहन हमल करण इनक समस इसल रदर तहत जनत सदस लकर महत नजर जगह वजह रखन कदम चलत रपत समर उसन इतन गठन सफल अवस करव खबर लगत उपल मदद नसभ तरफ करक पलब एसए गलव हतर भगव अमर यकर वसर मजब अपर एसप लगभ गभग नवर कहत अगल सहय नकर गलत रवर शनल बनन खकर अहम बजट रकर एयर पकड पहर लखन तलब भवन असल पहच उपस आवश मतद उतर पटन आईए खनऊ फलत इनम टकर आकर सलम परम ओवर खतर असर रखत उपय ऊपर चरण जनव फरव उसस सबक मगर वजन वरण चलन एमए एनए उनस आदम उसम लगन हमन मजद एसड अफस सएस मकर कमर टरन रमण इनल आईप एसट कसभ सकर षमत आसप फसल तकन एमस पहन सड़क फसर रअस दरअ कमज बहन परव एसआ आरप चयन अवध शरण जनस ऑनल आउट धमक हरण उपच तरण यकत यरल रतल आरए उपभ अरब अपड तरन आईट रथम गठब आरक गहर कलन एशन जमक सहम सफर हमद सपन हमत आपस बनत रहण अजय आएग अगस जनक महस यरम एकत उधर ऑपर बसप उनम डकर पदक रकम चकर रकट जबर कपड मनम सगढ उपर तरर नकद जनर ईएस गरण अरव समक रणब यवह मतल धरन तकर तहस रवक बदम सदन कमल नरल दशक टनर हनत रणन कतर अथव दलन बनक रचन यटन इधर यजल जनप आइए आईआ आपन सदर एसई हकर सआई एएस सपर जयप वहन रकत सरप आदर असम ममत रसन नकल महज उपक आईस ययन सरस एसस बरस मसल मरन दरव उतन बचन आयक भरत झटक भरन रगत बरत टवर यरप तहर ऑफर बनव कटप इजर एफआ नतम ओपन पकड़ इमर आजम शपथ ठहर पकर मएस नएस अफग आवज लड़क अवश",
+	"hr": "a i o e n r j t s u k v d l m p z g b c č š h ć ž f đ è æ y w ð x q ü é ö á â ä je na ra ni ko st an ij ti no po pr re va en li ta ri ov od ja la nj ka ma in ne da os oj za at vi ro im om to av sk vo lj al or ed og te di ak it ve ar ju ad ji il se el is et ci do er on su lo aj tr bi iz go mo ol ik ih am em ic ob me sa ki ku iv es dn sv as mi ru ek ot pa io le dr ga tu ke gr ir az tv št bo nu de sl up će ba si ok vn ao vr kr op rv ut če iš ur ca či zi ac ič un us br ač sp du eg lu nt eć ud ce pi ts tn ns ev rn zn že sn ag ži ije koj sta nje ost anj pro pre ima jed sti pri cij ako iti rij ran ija ati ovi ist nja lje ani odi pos rad sko ova red ili jen ali nos oji vat nik gra eni edn tra nic ina nov ski sto ovo tsk nij est ana ava ira kom din van elj vje god jel oje ici rav str ika rva lja ada kon nog jet aci ora ats jer hrv voj dan ori ine nji oli mje dje iva nim stv aju ila nih ama lik eno nom enj tak avi ast tav naj gov ara raz oga dno lju ini vij ena ica pod dni vor dru bil eli jem pra rat avn jes ske ren tel ala tan ite ove što sam iji rem kog reb zna nsk iju lij edi men jav ičk tre bit sve ona oja eta vlj obi aln lov sje lji ano eda tar vno una svo adi oda jek amo kol pot pol ema ent ari odn nju vni osl vod kao eti ter tvo oni kak por nas ene tiv već gla tor avl jsk tva ice drž stu sku kup ska eri ita jeg lan bor tal pov spo man živ olj nal rod ris tni rža eko nak las tom val ate jim kih ome odr ilo rug išt ata raj tim bro sni ust rov era jev roj eme ego nat aje svi dob etn ven rom lad iše ans eds bra lit vla jan ore jih var tič lav ela uje agr mog azi tit poz viš iko mil sla osi kov kra čin laz izv rsk iza inu alo tri dov vel ovn prv aja zbo ivo pla eba slo ral ola gre šnj tro adn min dio vin nis",
+	"hu": "e a t l s n k i r z o á m g é y b d v h p j u ö ó f c ő í ü õ ú ű û w x q ă ş ô sz el gy et en te er le eg an az at és al ze tt ta me ek ak ne es re em ke or is nt ár ny be ol on ál in la ás lt ez ve na tá mi ko ba rt án ll se ar to ha zt ho ra ka ok ér og ik ma va té os cs de ég ly ot ye ag ki én zá ni sa nd kö ro lá za át bb am sé ül ri so vá má he oz ap as él ké fe li ti st ít sá ág ad ét il zo ga ya mé ék fo ss ge it lé ed rá nk já tó je mo om ig di vi ja zé öz vé ut po ai do si áb tö ól rs nn zi pe ia ul da ák lm aj né sze egy meg ett ban ele ogy zer ott ben hog nem agy ere int szá len szt nek nak zet let tet ség min köz fel kor ete esz gye ell sza eze gya áll ter tás ész ely ala tal ent hat ság ény tot ány tel het ért leg csa mag olt val ssz mel lle tés ato szo tte eke isz kel ren tek jel kat ind sok end tat nye ese lat alá kez ket rin lye asz eri nte vál ált vol ker lla ése vez nde ték áro atá ami lta már eté ors sen ége hoz vet for zte art elő ert ába unk hel ont rsz nag ber yar lás tak mer rül att enn tta ros nap zon tár vel elm tán tan ame ató szé ond ill zen ata tar mbe mán koz tes áso emb ára éve oly elé szi ásá ehe kül ene ött men sak ten yan ves más les vár zág elt dig zel mén fog ezt gat aki yen öbb est ját lés öve res töb vis zés zám zás lap sem nyi van lam szí tos gyo éle mon kap tör zta zat ege nál sít zak rés ked erü cso den azo ább ébe ták éte ült oka lem nyo ető rté dés azt lis tud gaz ént eti még kép ére els leh ara tik dás kal ésé szü ebb áza két oga eme uta özö lte olg edi lak vég orm ide mil rek gyi utá zot ról rom get lát tén lya éke vag alm elk kén ály öss zem lék rán dik abb eve rte aka oro yel nne apo ála del lan ágo zal sek dta alo ház árt emé biz ika ani ása ztá dol jár",
+	"hy": "ա ն ր ո ե ի ւ մ կ տ յ ս վ հ լ ց թ դ ք ը գ պ է ղ բ չ խ շ ծ ռ զ ջ ժ ձ փ օ ճ և ֆ ՙ",
+	"id": "a n i e t r k u s m d g l p b h o y j w c f v z x q é â á ü ç í î ö ó ã ñ à ć è an ng ka er en da ar ta me la ya di in ak at ga ra se pe sa ma al as ah pa ba si un em na te tu am ri ke ny be ti ha el nt ad ja it ia ik uk ni ap is ai li bu nd ru ut ua es su eb de us il wa on re mb mi ku ur ek lu ag et or ju um ko ki mp mu rt gi id bi le ir ul ol pu im gg rs ih gu pi ne ca ep du ay aa gk ro st nj pr ab ud ge po to au hi nu up ib hu ed rk aw eg ot om tr lo aj do ug uh ok ks rn ns eh nc mo ip rg rb kt no so nn os rj je ji ej rm ang kan men nya eng nga yan ber aka ter dan ara ala gan per ata ela ada tan asi ran ing ari era pen ini lah lan mem ung ntu ngg emb ika seb ana ama itu ena ban ngk apa asa lam ers man eri nta den mas tuk dar aya unt san any kat nda han mer ert mba dal adi kar nan pem pat ant ita end aga ida aha uka ian gka ema dak har ent ali rta pad isa sia ila pan tah awa aan tar bag eka but tid gga ebu sel lak mpa eba eme dia rse epa uga jad uan and tak aku eny rin nja lai rus tas dah elu uda sem enj mel aru ain eru uru bah ati ind amp isi emp int ren rak ten kem kal atu par gar gun tin ili ere esi dik pro min agi ina esa bel ami art ula iha dap emi enu sam ket emu jug bar rka mbe eta sar are nam erj kep sal gat sek ora aja yak erb dir sat ahu nny kas sen ndi tel pun upa jak ngi kit ras ngu kam ebe bis rek sud tik ura gai alu dis rat rga mat usa erk rja rah ser ima rik eni jar ega nti pas saa iri uku ene kon sep car bat amb tam arg pak mun aat nak tem ndo ota erl tap rti las rap mbu aik uta dit aks ker enc una one rba mak lik mil pel nas kuk aki set dil has din lal iny rma api nge don ann nca hal ebi pol ggu uma ole lum asu eti wan iba hka ani res war nal tur ern erh kap nes yar hun ete nju epe",
+	"is": "a r n i e s t u l ð g m k f v o h á í d j þ ó b y æ ö p ú é ý c x w z q ø ü ä å ar in að st an nn er um ið ir ur ei na ri ti ta nd ra ve en la ni og il ng un se ga ði eg le ki nu af ha sk al vi tt ðu ða ka ma am rð ns em gi he sa me ef va li ll kk ja is da tu re di si gu þe mi jó or ag fr yr lu rs ld fa fi ss þa es ru ku eð fy ek rn fu as ig sl au ge it el rá ví ko ik ey ár et ál rf ba ór gr rt du te rk já fn on kr gn sv fl mu má br ær uð ft öl rr æð sí ör ög rg ak vo æt tj ðs ík ke be ne jö sé up ón yn us pp ís at fj ól íð inn ver ing ann sem and nar til nna við ndi ður sta fyr var ein nni num gar ins leg rir yri haf lan erð sam nin ega sin ekk með han nda kki nga lei enn und inu það ast rið ngu rin ist hef tir ram fra eir ess end stu all fur man rði jór þes tur því tta tar aði stj ill ðar egi ngi lag ari tjó ski ðin ban ndu efu þei nir rðu rei ald unn mar gin ara eik kom eri eng eið afa mál seg upp rða dur eim rst fti mil eru eit gir tti gur frá arf men þar rna hei veg ars tin est len efn kur ger eft jar ust lið gre gja for dag una okk rey tað afi æði eig ett ska síð rra nds órn lin jón far ráð átt sti ísl dar ber gna gum nnu aðu nað kar ran son íða din fir ldi lut aða vei ama kin era lög arn lli dir ang eða sér étt jóð sig tal yfi lla ina vin fél tan nns ank hve ynd arð rík kan kka vor lda ans rét ljó mið sku kip ðum rni mun lok mei sla kja egn ste unu stö rum gun nka ttu slu éla tak ita þeg her ætt hlu lum iki iðs ags kku ild iði sto kið gan nsk ræð ark lau org ðan kað mik sso jör þet sle itt kil lar fer skr ors ygg jár ótt mur orð llj myn ldu jál min ðið þjó vel iðu afn nan lað uri kvæ tæk ert sín ðis nið hel mað svo bor fjá álf erk eld sjá jöl íki hún nig rfi öld kis ðir bre hal aga iss tum lit",
+	"it": "a i e o n r t l s c d p u m g v f h b z q è à k ù y ì w ò j é x ç á ü ª â ó ö í on er re to an co di in ta al ri la en at ti no ra de nt ar io te el or ll st li le ne ia es si ro il ch tt na ca un pe ni ma it tr me se ic so ol pr ci po is he da zi ss ve nd gi pa sa os mo lo am do mi et vi ce qu om ie im as rt sc va nc su pi ut fi eg ag ue gl az tu lt iv ur vo ov nn em cc ot av ua mp bi ha sp ed ac fa ir ec hi za nz oc op ad ul ga rs be ap iz og ig uo ge id ba gg us rr fe rc zz cu ai rn ns ei fo ui gr ev lu bb pp gn tà rd nu ent del ell con che per ion ato lla one nte zio sta are all men gli est ett tra tto nti pre ere ale att azi pro tat ter ess ali non gio com ono ant lle nto ist ano anc ati chi ann que tor res ita ica era ont tro par oni una tti and str nel ran ari eri ver ori ssi ndo sti ore ata rat ost nta ini son sto tta enz ina qua ico ass ort tan ntr cia lia cor ito ggi art col dal nno ian ame pri ser olo izi ora ani ona ond acc int tte end ome ris ste man ili utt ric nza llo oli rim nch sso rti olt tic nal tre ues tal ren tar por ior tut ici iam ten sul pos sse ria ese cat ire nda nat ven ino amo ers gra ima ndi tiv sco erc rit inc tri ate ott rio esi sen ero der ine ura ità ove dei spe lio alt min cos den cen car ssa fin zza oss lit nde sar ene bil sol ara ola sio rte ide orn mer for vol ltr iat agg ier mar itt uto più iti fer imo ive rso ile iva can rop pol zia rma tit fic mil van ova ert nci cco tin tur ien ime app uni rta cam opo dic ice ede spo edi emp tim sci gen ind igl ave tes ual mat ber imp rov mon dis ial iar mpo nco nzi cer cit uro cas ron ana isc lan ret ons riv ebb rie anz err ern ole giu uel tem alc nni lic lta oro ner ult rna ord ivi far rin nic erv san lli avo erm sia vis reg",
+	"ja": "の に た い を し と る で が は て な か す っ れ ン ま ら も り う 日 こ ス さ く ん ト き ル だ 人 年 イ 大 け あ 国",
+	"ka": "ა ი ე ს რ მ ო ლ ნ დ ბ ვ თ უ გ ტ შ ხ ც კ ქ პ ზ წ ფ ყ ღ ძ ჩ ჯ ჭ ჰ ჟ ჲ ჳ ჴ ჱ ჵ",
+	"kk": "а е ы н т р л д с і қ м к о б з п ғ й ж у и ң ш г ұ ө ү ә я в х ц ф ь э ч ю һ щ ан ар да ын та ал ен қа де ға ты ер нд ла ай ке ды ас ат сы ба лы ны ме ра ст на ың ол жа ақ ін ге те ет ыл ық ма ры са ағ ғы ел ек ле аз лд ді ті ес ау не тт ре рд ыс ам ыр қы за ад се бе ей бо ып шы ір ор ым қт ем ег рі ұр лі ия он па мы ыз ша лғ ің ап йт йы бі сі кө рт қо со йд ні өз зд же ед гі іл рл уы кі ұл ік кт ту еп рғ бұ із ім ығ рг ше мд іс ос ші лм ин жы то қс от ру оқ жо тк ха тү құ ой уд үр кү аң аб аш нш рм ур ез ко еу ли ең ни пт ған нда ала ның ста ары ынд алы мен аты тан сын асы аза ген ара лар тар аны бол ағы ына лық анд лға бас аға тын нде ыны қаз дар айт рын ард қар тер кен рды ады аст лды қан ылы дың зақ ері еге тыр қта ама дан қал кел бір еле дағ бар ада еке айы рға ана ола дай жыл лер дег бер дер сты ден ақс алғ айд тал нды лда алд ыла рге ерд ықт тты етт атт лма ысы кер деп ақт қты ығы ыст інд ғын бұл жас еті лып осы тке тта дық ауы кет іне есе ере тың енд йын рал қст нан тін нің оны кте дей ақы тқа дам еме нын мет тық рат ісі іні аса шыл еді тұр ылд сқа ент рін жат ейд арт ата сал зде рес лде қат пар рек уға ерг алм ыры көр оты лан нал елд мыс лай тағ арл рла йды ске олд жет лад ойы оры ект ені йла ілі тті ұра есі етк ман ция айл лге дің иял рақ тте рді егі олы йты қай қыз кез нша ұры лік мес лас түс бөл мат май сте бай мыз ылғ тап тыс күн шығ ірі әне жән арғ мда оға рде уын сан өлі лат рме нді жаз йда елг құр йді ура гер сін жүр ыға сат ынш онд қыл лыс уда жар ігі ауд мақ нен жұм бек рма асқ бал аев қол қор ліс қыр атқ сен ымы жер жоқ иде олғ лек амы лау рле рет рна ғар кей нға ушы ырғ пай пен ққа жан аба тур йта ерл ете пре ист ымд түр рып елі хал рыс сай ызд рас тау ұмы лын абы ыра рта шін ікт",
+	"ko": "이 다 는 에 을 의 지 고 가 한 로 하 기 서 사 대 은 를 도 시 해 인 전 자 수 정 일 리 으 원 장 부 있 주 과 어 들 보 아 상",
+	"la": "i e a t s n u r o m c l p d b g v h f q x y k z w j é ö ü ë á ā è ä ó í ú ă ī ō er in um an us at is ti es ri it ae tu ta nt ra st on li ia en ni or ic re ar te et co di ci ca io no qu si am ne la em ur un na ru de ma al cu se vi iu pe ec om mi le ro ul ol pr su ns nn ct ve el im to ua ie me tr po ui ce nu nd os ll mo as il pa nc ac bu ad ib mu lo ab sa ed rt oc gi ss ut ha lu sc ue be ii ge hi ba ch id op au so du ep pi ap ho ex eg pu bi da ng mp iv od ir he fi do rc va th ot fe cr ip ov eb ea gr ig ei ag av ud ga br fa rs est rum ati ann tur ent ion atu ita ter tus tio per ica ali tat con qua nno unt ant eri rat era nis ari one rae ius nti nte tum iae oni ori bus ens tor ibu cum itu com que min ris ium oru ici ili tia die aru ani pro inc ist ini tem icu qui tis lis men ell iam omi col ere nat vit num ria ver ect uit sit ine man ntu int pra ctu tra ran ina lic nia sti ula str eru cul ola reg mun ian tri edi res ate ore lia uni ata pos lar ons sun nes pri nsi iti lla par ice tin nic dit ssi ndi bat ura nco cti sta cat ric gra aec mer tes nta ste eti act omm nci tan ess ato lat anu cae quo egi anc nom and lit sse gen ber eba rti ale nem avi tic art ivi nus ecu uae ien ona ert ort ing dic ide rim ima rio cta ers lum nse der ost ven iss non ana end irc cir sol cit mmu cus esi les ont lin fui lli mar mus lem fra the rma und fac oli car lan ior rit cia mat dis cri mor uli riu ult tal oma tae fic nae eni are uam cha rii tit vic sis nni eci run idi mon ten ill gio den rom eli dia ole lib cto dem bri mit ies imu mil urb ove sae scr ite eta his nit ano cen sim lae rop dum pel rib nda nde liu sed ins ame fec sia ene mag lus tar emp nst rca abu hab can ede ser aes rem ico ang imp nor sto ele ust uar inu mpe ren",
+	"lg": "a e u i n b o k m l g y w t d s r z j f p v c h â x q œ ŋ é á è í ç º à ä ï ñ ô ba mu ku an wa ab ka ya ga al ng la am ul ak ok na aa ir li dd bu er en ki ra de mb uk bi in om sa ma we at ag si ol zi ye ko ny nt ta nd te ee ek za as ti un bw on tu ne ri nn eb da yi em us ub ge lu is be um ob ay ky yo se ik ut ug by no im ad ii aw it le ez iz uu ze gi ib wo kw gu so ss az re ja oo mi ed es lw go bo id uz lo eg fu ke ey kk di ni to me gw jj mw gg ig gy zz tt ud ro nz ns et su vu uw af yu nk nj aj pa mp uy dw va av oz bb og ot aba oku dde ala nga omu ali aka era ira amu ula aga ang iri add nti uli amb nda ban wan mba ama kub baa ana bwa bul kul ako any obu bak muk uka nny bwe uba kya and edd mus eki usa mul ebi nya nyi bal isi aan lin nna ere aku uga ate idd ent asa eer ina bir mbe kir ulu ola emb buk wal yal end ngi ntu kol ata bye jja bas kwa sse asi nge lir ann kut udd zza kuk lwa gam gan imu ayi bad ndi aal bam awa bee ika ibw dwa atu gen azi kwe kat isa gal ong mbi imb abu uzi mwa aab uku bat olu ita bag ajj izi ung ngo uma kus tuu eya ddw yam ule mut eri kka kit ing awo iis abi aak san ger ege oli sen wak dda uko ati eka nte yak ber bya aso ant uke ale uta umb eek riz uwa iza bab lii gwa iko ezi una yin yag kum eza teg saa tee okw ino ked ano man mir kug nja iro mun ubi usi sin pol kan yan aki mug kam ayo utu ole iki eby nde umu avu zaa ufu tan uyi eng und alo kis lab gye gul ine nyo lam fun iik ngu olw izz afu nay emi kin enn age yon omw gir aam lun baz ono kun waa bug ssa oba alu kyo eky but abw nyu ubu twa tta nam wat aya teb nak emu bin kal yab aza saj itu ise aas uva esa olo zib eba kib ani agg kab asu yum aye eko nye agi sit mbu yok ond ton wab uuk nzi mya eez aay men ulo use gee iir nsi taa",
+	"lt": "i a s t o e r n u k m l p d v j g ė b y ų š ž ą į c č ū z f ę ë ð ø h þ à á è û ai in ti as au ta ia ie ka os is li ri us si pa ar en ir al ra ni ik an ki st ma vi to at ne im da va na ei et am io mo uo pr er la jo me ko nt tu ja te es oj ga sa mi ad re ij di iu su it ur ro vo iš ių no či on av ku nk je ve ės el gi om ek or tr ži nu rt pi ba ak sk ok ap ot id il uv ėj nė ng be se do po ci kt nd ip ty kl ši bu pe le ru uk ol ug de ut tų em ke dė yt ji mu kr ig ju ks bi ms yb ul lo yr šk od gr um un ov vy ab sp ge rb až ly dž ini iau usi ali aus tai kai tin ien iai pas ijo pri sta jos gal tik ais ink kad ant iet uri vie oje lai mas kur etu lie ina uvo asi čia tas dar ius ent aug eik aip pra met iki ist int rin min vai ima oja avo aik imo nti vis sav rie išk lin cij dži sti ama ria pat nin kas eli val rei ies eri tar nuo inė per ija lia tuv art ios uot iek tur kia uos toj kar par die tei pro iam nes ras ika nės kla men var and ing eni buv kit ino ori tra jau arb sia tie rti oli tis sto kal ven kin lau yra mon oki nia mok lio iti sak din ris sio avi nas ose auk ats nių yti ari nau nis aut oti dau api kel man isi ame ran ėjo gia adi ska nka ena eis mis asa iko est ren omi žia nim ite pir ati gyv jam ter ili tos kom nio tat gin pie ver vos nta uoj ami sau aci iuo end mai vei auj aty sus kti uli žin ast net ams rau dėl aud kos oni riu irt nam esi tor ala liu ome rad kon aba nus ste ita bai lis nor iem ait ekt yve nči ank lan čio sis tus oma kie irm tuo nai sit ači čių mos pre rai ruo lik uti sie eta eti dal eno vyk rij kra ara ada žmo būt enk eig ias did ojo rim imi rio ato nki niu imu str jai sij tok osi kus eči eng ieš aul rod čiu aly vir ugi lim ski ėti ger bet ybė kam raš lių kim jus iči pag nos tam yje",
+	"lv": "a i s t e r u n k ā m o l d p v j ī ē z b g c š ņ ū ļ f ž ķ ģ č h w x y q á ŗ é ie as ar ka ti ja es st ai pa vi ta at is va tā au en la sa un no in em ir an ma ra ne da li na tu ot ij ga um ek ri ik al pi ku ci ju am dz nā ko ni ad et iz kā rī us ba to re ro īb jā te ei āj er sk pr ās āk tr ār di mi si ša ur ks mu vē rā ād il ēj av ēt āt tī zi ts ed bi ve uz mā os el it īt de kt ak on ap iņ ēr me or nt ām lī pē rt ru sp īg om dī do pā ev ce ol ms rs nu ec ls id mē aj zī lā ši be jo ud ji du oj im āl ep dā lē gu ab ns ki bu iem ies ija tie ien pie vie par iek jas nie lie ība inā šan arī iet lai tik kas jum ska tas jau dar bas var vai kur gad val vis rie cij tāj ied umu die ika stā pār sta aud ist ana oti pro nas kai āju lat arb viņ dzī ais iec ent isk ums str atv kum aut iel līd sav not als ēja sti lst īdz pat ras tra tur man vij bij bet nes stī pil pas tvi āja paš edz spē evi ajā vēl rād aks iju nāt pri iņa gan āci ību ieš zin vei nav ina kst aik jie stu tei kat dzi ijā kon ena eik mēr iku pēc est rau ris tās aun uma ama ast las vēr sko zīv ada lab sie ikt udz ini vad kār ald usi būt adī nis iev ārt dīt ekt ēju umi īju kol pēj jam iep oša aid kād ter ieg ier eci dom sts aiz pir tād tīb aug mie cen uši mum ādā tis lik ici esa ils oli tam pre air trā nāk āka āku rēt maz min īgi āda mas nāj ara bal alī cīb rei jās dzē cil dau ldī vas oju ņēm avu nos āji iks nās esp ilv rāk īga eks ens aga auk ali īvo kri ram sāk cie nev otā tor kam men izs ībā anu lvē jām nek irm umā ēmu juš red rot aka brī cit sai mes atr anā vēk eiz des tīt das kar nov gal ant rak res atī mat rīg itā sar nod eri āti ava roj kom sas tāt dīb ene ņem ati ārs mak eid arē ērn lis zie ītā iro pal cin ecī jus ere dīj oja kop ētu enā tad mai",
+	"mi": "a i t e k o n h r u m g ā w p ō ē s ū l c d ī y b f v j z ä x q ö ë ü é â ï ô º te ng ta ka an ha ga ki ak hi ra wh at ma ah ai au ar na ko re he to ia ri ua tu gā ti ei ro or pa in ne er ke it wa ho me ur on hu ir pu ku ea oa nu mā ae en ap ot oh ru no ut am rā ui ou ok āt ik un mo tē aw uk ān aa ih ao gi po iw et ēn ēt up āo mō ek tā kō hā āh wā mu kā pi pā uh ip āk pe eh ni io mi pū ōr tū nō ār wi tō go eo nā we oi om em um oe im ōn ew op th āw ōh ue st le al nd la āi āp ēr es nt pē ūt ie kē ōt li ōp pō āu gu ūr is ep ed nga wha aka ang ngā hak ahi ana ata tau nei tah tan ara atu ran aha ere mah ing tak ake mat mai ama aki aro ori kat kai ura ari rer apa kau nui hia iti ngi han whi iri āor kar ēta ore kia ung hok hei rau ong tou wai ato hua oki gat ati ako kaa ēne ero are rot kah ira oto āta hae aer har māo tua tia mar kei hin ite kap eng ton ika uta man tēn awa kua kor kam uri tai ahu tea ita toa rit ina uka rua tir tēt apu pap hau aut ohi kan toh iki ngo mea puk hai whā utu aua tae oko aur whe kōr ōre tik uku tin roa āto ait reo ohu eke gar māt hit eta awh uru tuk aia uhi ora upa oho kaw iwi ino iro oro ene kit aup kon pak hen aru ihi āra ten iwh onu kur pai anō put ate hir tar rat tuh rāt hān ipu tam noh anu āna enu tok oti aku ono rar her uto uat kin aat nua roh rah wāh ota upu era hur ine rik āng ōna tor koh iho tur pur ram ano una ona run ain wah āne āhu tat ron nau uma rin mau ear noa ote raw tap ima hio aor koe par ter aun aar aea hun aot nak hor rān kot uar āko rei āhi ria pir aho rak aiw eka ket iha ehe pae pou por kup ure ērā wae pat aih nan ete ion ānu and āka rek aum ohe air mak kāo oru ane ini hoa mua ito ōhi oha gai wak aah hea uak emi tōn omo hui kao tān mur ēnā koi eir lan aui mār",
+	"mk": "а о и е т н р с в д к п л м у ј з г б ш ц ч ж ф ќ њ х ѓ џ љ ѕ й я ў ъ щ ь ѝ ю ы на ат та ни от те ра во ка то ст ко ја ен од ит ре ва по пр ан за се но ти де ри ов ед да не ин ск ро ет ор иј до ма ли го ар ле ек со ки ви ак ал ве ав ме он ци ос ер ла ел ув ди ис ол ио ад ми ем тр ес ој па би ил ам из ло шт мо вр об ба ас ир аа ај им ик си ив дн нс ом бе га ев ќе гр иц тв бо ње ањ ог ку ок оп нт че сп ги ид ру лу са др сл ие ег ту аш лн еш бр ке чи вн ше зи ич аб пе ап кр аз це ои ац оз зн ез тс оч св оа сн пи чк жа ус ач су ата ите ија ува пре ски ста иот нат ина ост про ени ани ека ред ска ист ето дек циј ање нит ран ден ира ото ови ако јат аат кат сто ати оди ици сти што нск при дон вањ едо гра ово ств кој рот ини едн дин они мак ори ари аке ави нов ена ова год ава ест ниј дат кед аци нос пор ваа тво нио мен пра ент стр кон тан тер ана как тел еко тра вен ниц тот ора ика мин рад тор пол пос има тре вор гов рет али дел иде тат кол рит ват ате кит або рат нај тоа оја рав анс еде кио ник ско тит под бот јав алн ове нот еле кот спо ери ели или ено бид раб раз але оли оле тен ара кои лни аме вск вни пов ади нал лем вој еди тив пот бил ема зна нес род сте вет ион еме дни рем рен дно оти евр тав иск етс пат пар лен онс еда мет аде држ ано оре ене нув вот мож бра дна одн аст цит еше доб гол еми њет лед ога тој ица вла ичк она ака ато ети ман риј рис его лат ште три лас ние ера ело лит око авн лад мал обр реб оби чки ком нци зем кан ити нар вре нти еро тин ела ада есе ета ита цен тар нис сам оде бар бор све кра ков изв овс иле аро ода инс луч сит пла ект мес лку вер ивн лиц вит сед рес нап ете ега сво нем нас прв рск лот вра слу одо нст оже вар ико нег ржа ции екс име вел нек рек амо еба ого оро тни оме јан",
+	"mn": "а н э г л о р д и х й т у с ү б ө м ж ы в ч з е ь ш ц к я п ю ф ё є ъ ї щ ѐ ѳ ң ий ан ар йн аа ай ээ ба ол га он да ал эр аг уу эн эг ла са ын ха та үү гэ дэ ул эл нд ор бо нь хэ нэ то тэ на ло йг ра ил лэ ам ги ны ни ин ри оо өр ур хи хо ах ог ад сэ эд өө лд рг рэ нг ли ас хү лт уд үн го жи эх лс өн эс ма үй лг гд өг ши до за чи үр ро ав гү со ту йл рт үл ыг аж лж ва ху тө ой эм но ир гу гт ер гл ох гө өл од мэ бү ат ди жэ ом рд су иг эй йд ду лы ун ст би рх рл бу нх тү ры эж ус ца хө мж өд лб гч ос мо ру рс тг цэ юм уг лл ийн бай бол сан гий ний уул оло ийг гаа аар ээр сэн эрэ ууд даг тай лий аан айн хий ара жээ лон ула лаг ага рэг гүй дээ тэй жил улс сон энэ дэг гээ үүн онд гуу оны рий үүл рын даа алт гар ари гол эдэ оро лын айд айг руу өөр онг рга раа нэг хам хар бар аас лаа уда дар анд нго ала түү хаа гөө ары өгө йна эсэ үүд айс дал дий алд лга ана арг рээ шин аны лэг дын лах ээс хэр баг үйл сар йса тог ээн үни эгд хэл эрг лда бөг хан агд тан тал лан гэж анг гал лал оор йда аса рал үлэ лээ сын хүн мон өөд төр охи уга ада дах зар тэр одо үрэ уур ээл лго той хүр өрө ата үнд дэл лса хув ура тоо ард чин ргэ лэл уха хүү глэ гдс лла ааг шиг ажи хэм дуу амж лэх нэр луу йга нээ өлө айл хай энд эгт лог наа уун лта тын мөн нги аши аал суу лэн гэн ава ург эхэ хэд анх тус мэд тгэ лба уды ман элт газ амг гүү лсы эгл рон ото хой бүр мги агт олд олг дөр элэ хол ээд эмэ тий рла лох тээ алг оно гло арт айр лдэ гэд дла эрх илл ган айх аад дог огл нар гэр вар дсэ йгу нуу илг лүү ран рил хэн гад рги цаа мын лжэ хот аги олт гоо тар ару гла игл оос аха ута олб нут сөн алы гда ори лбо рлэ йлд үтэ раг ахи олж ист үүс нга гэх мжи эрл ртэ хио олс лсо лсэ лыг айв огт инг эмж өри сур ван бүт гдэ мий оёр олы рүү хоё дэх тод",
+	"mr": "र त य क ल न स व म ह च प ण द आ ग ज श अ ब ट ड ध ळ ष भ ख ठ थ घ उ झ फ ए ई ढ ऱ इ ऊ य़ कर आह अस वर रण तर पर रत रक सर आण आल मध सल यक मह रव रम सम वस सत एक दर हण कड रस तल ऱय पण पल गर आप अन रच बर जन उप अध कल अश लक वल नव सह टक गल वड वण हत वत यत मत गण रल गत शक मन यम पत आर तक रप नस षण पक आय डण हर कम टन डल पन रश लय शन तस अर कत सन पद आत उत आम रद यव भर बत पड वळ मच ऊन घट मद जप दल ईल खर मर नग वक पस बद नच टल टर गळ पय कस आव लन सद पट ळव घर सभ तप नक जय वन जर सक हज तव आज रज णत यल लव सव यच अप उद शह ठर रय बस सच तच चर रह जव हन षक पह मल डक अभ आद नल खल करण असल आपल असत करत सरक घटन नगर वडण उपस रकर शहर रयत यवस महत शकत हणज आपण नसल पडल बदल मतद दरम गरज आमद रपट असण जवळ यकर जनत इतर रकल वकर मदत समज उपल पलब तकऱ आवश कऱय सदस सहक हटल वरण सहभ आमच आठव रमध सऱय दहश हशत ठरल घडल वरच परत इतक तकर शतव समस डकर रवर सगळ परव लकर गरस अपघ लवक आणख बनव अटक उघड रथम सदर रवठ रदर नसत नसभ जखम नमध ओळख एकत एवढ ठरव बसल पडत यवह एकद लढत जपच अहव गळव आणण बरच उपक आणल वजन समध अडच डचण लमध हणत गदर उपच ठवड गरप ऑनल घसर उतर पथक करच कसभ तवण बतच तरर रवण समर यशस बईत यवर तरत कदम तरण एकम ळकर उपय धरण चबर आढळ बनल षटक आरक फटक तरच उचल दरव टमध पसर सरण बसव रमण आजच अगद टवर उलट समभ यटन शकल नवर ठरत षमत वटच अवघ सरल हणण णकर पकड आवड औषध आयए ऐवज वडय़ मजल रपर घडव वळप आदर वरह भरण णपण पडण षपद रगत यकल सवर णपत रकड शतक रपत रवल सरप चलन ठवण तपण डमध कचर मनस ठरण भरत अथव हरण अवल लवण घटक उपन दखल यटक ळवल सहज सतत मजत भरल रपण अवस फडण टरन दगड डणव आकर घडत एकच लवर शनच ळवण मलब यरत आकड कमध लबज ममध महस भरप ऑगस गळय़ एसट अडक टकर नजर कवल तहस धडक पदक णवत एमए ठवल धरल आजह शरद चषक भवन शनल रचन हटव सलग समव आयट सळल नतळ सभर टरच हजर परद बऱय सहन एसए करव यकत तरह पणज गभर उदय डवल जगभ खवल रकत अरब जपल परल जपन कळत ळवळ कलम लकम पमध फरक लढव कपड गणप तपत डकल शभर वगळ मरण रकम जवर कमत गदप एनए सवण जगण दपत आयस बरल वसभ मनप कडक तरल नकर पटक लमत बसण",
+	"ms": "a n e i k r u t m s d g l p b h y o j c w f z v q x ï â é ñ í î á û ü ö à ë º ä an ng er ka da en la me ra ta ar at ya ma pe se ak ah ga di al in ke pa ba am sa be em as na tu ha un el te ri ti ad ia it si ny ai ik ja ua uk nt li ni ap ru eb mb wa nd bu le ek ag ut lu mp il us is ki ur ay pu es de gi ku et su ep id mu um ul ir bi du ih ju rk aa ne ol rt mi or aw on ab gg re im eg gu aj au ca pi hi ge nj hu rs eh gk ko st ed ud ro ib ip rl uh nu rm nc ji up pr rb bo po ej ug rj lo ok je kh ye sy ks no to rn ub os kt om oh rd ang kan men ber ala eng nga dan ada ara per nya yan ata gan ran ter tan aka era ela pen ama lam ana mem ari lah ing lan pad asa ini kat ika emb eri itu aha dal aan ntu apa seb awa aya ngg ban ena man ert han tuk epa nda ema mas any erk dak mer mba dar ers ngk bag asi san den unt ung nan end eka aga uka ian rka eba ida ita ker ent agi pat har nta sem ula eru emp sel eny gka ant ila atu iha kep erl uru bah tid ega mpu ali gar uan sia mat mel ole adi pem tah ahu leh dia bel aja dap mal pan neg rta tar ere lak elu nak eli and nja pas ain mpa kal pun rus gai enj kit ser gga dah emu ora pel eta erb tik rak sam rek rse mbe gun ebu mah sek bar tin ket ira erj ele esa kem ina lay usa amp eti rik dik ati aru wan aki lai kes bat tem bil rah uga ene amb eme ngu suk una lua nge tam uta sat ram bua yak sep ndi sar ima ras enu rja uda sin mak uar dir ong rut but jad ebe lum ken kel ura nam alu iti ind ipa tka sah leb nye ger hat ili ebi say dua kar jug eja pak iri erm tel ggu lia rat yar pro ami min kam nca erh bih emi car hun aku uma rti aik sen nti lal had uat ten rin mbu jar aba kua usi pul tia kin atk nte sal ngs set dis mai rba rip bol ngi buk erd asu ham tur sya dit tau mbi iba epe jan rke",
+	"nb": "e r t n s a i l o d k g m v p f u h å b j ø y c æ w z x é q á ö ü ä è í š ó đ ô er en de et te re an ne or st le ar se me in ti ke ge il li el ng om tt fo ha sk ve nd at es og ed al nn ri ik is ra ll rt vi la ns si ta ig je so kk on ka av på eg nt rs ol tr un to be pe da it va ie he ma ag ør id sa ko bl am ro ut as no kt år ss ak fr em ni di ld mm rd jo ei pp ek mi dr op na ba lt ts ot sl sj sp ru rk pr gs ls ad kr po ær os us mo ga lo rn fø ir ov gj ul gr br rg fe fi kl kj så ho iv ks ku ev ur pa fa jø ok pi ap ny hu gi dd for det ter til ing tte ste ere ene nge ett som den der kke ten lle men ikk har nne sen ver med gen and ler lig nde ner ren han ger ist mme ent est var ske inn opp lan ser ang ier ska ker lit dag ell man ert mer sta enn nen end fra ans ret ens ill ort rin len rte nte tet att ann ove ers ors dre all str one ede nin ide sie bli ble jon nor und els sjo res ord kom kan art per eri før tor tre ken rer omm jen ige vil oli nes net eng ete iti sto tid lse del kal vær itt age sse het are ran isk seg lag ise nsk ale gje eld hel ern pol tal unn vis kje ngs lde nse rik sam pen rne kte ber kon jør øre ele sti ikt set lik rge sel ven eli jeg ant ndr fot org old ore rst mot asj let red lin tra mel bar tro tat min pro vel get jer ære kri ake rde gge ate rsk gan ass dde alt ved eve oto ogs dig sin ris lge gså ive amm akt elt ite mar dis rke ket spi hun par vin ekt bil noe ike sis pet kla tie hol iet les utt son ørs leg lar lli ons ess ien mil run tel ine led ppe tri fle ått pre fre van rti gre ute reg skj tin bru oen sik pla pil erg sje tis kel sat met jor tar ært rek tan eks erd erk las vor sid føl bes egg tig ull kam amp vik sli kre val lis nrk ese hen skr lir nal kap mpe ade eie elv sak fin kti",
+	"nl": "e n a i t r o d s l g v m h k u p b j w c z f y x ë é q ï è ó ü ö â á ç ê à í ä en er de an te ee in ge aa et ie el ij he st ar nd or oo re ve va me at le ch on al da li be is ng we ri oe ti ke rd es op vo it ro di la nt ma ra ne ni ed zi se ze ns om ui na rs ek wa ei eg ta sc ig to ag em ht pe ur ha rt am ho ol tr jk jn zo ll mi as eu ev id do ld ou bi il wi no mo wo ak lo ic hi pr ac ot ts ko ec ik ov rk og je ad ef ls gr eb ka pa ru un uw ss br us ga kt ok rg ba si sp co bo nn vi ez ja tu rl ep dr ft nk gi bl po jd sl ce van een het aar ver oor nde gen der den ing ten ste aan ter and ers eer voo cht sch erd dat ond ren ere nie ijk ijn ken tie lij rde men nge zij ens ent die maa iet met uit end est ord lan ede lle eli rij len ove sta ele aat gel wer eld ege ven nen eel mee erk bij eve eke dag ijd ach nte ind wee als ige ang ati naa ich nne sen hee eid wor ger ant eri ete tel doo ook ate ien pen ker erl eef pro hte oen art tij hij laa aal daa waa ert all eme eft che gro del rin eur ist ber raa vol ame ier str eze ite sse lin moe oet gev taa ech uur mer euw rst oud tra rie kom dan ome sti bes ard ieu ben ran lee man kel per ale lie ont ari roe age ges bel are isc nse erg ron iti wel kan aak oek nog bli cha tte rge hei geb ans was kke ide jaa gaa toe gin lde ope heb nst ein oed dig ort gee jke rec min uwe chi ene ies ake res haa tre iek ude twe hoo din wij rda gem zen vee nda zic ast nis ree ouw con ats bbe rui wil zie wat era kun par tot pla int teg eni dit sla oge ijf olg org lei han rke akt ong voe ell erv hou dri ela lit nin elf ote ebb uis rdt spe nds ees nke roo lge tig tee ali doe ema rla tin zel aag stu erw zoe tal pre och ine tro oer val ngs rti hui ill sto lic eek cti ern ank kin els gew dez",
+	"nn": "e r a t n i s l o d g k m v f u p å h j b ø y c æ w z x ò é q è ê ô ó ü ö ä á ï er de en ar et in an ei te re le st or ne og ti me om ng el il ve se sk je ra ta al tt nd la eg nn va fo ha ka li ed es ri ik so rt at ll ge da is ke on vi ga it na av på kk ko un kj ma sa ns as ek am si id nt rs tr ag no he fr ut be rd sj to kt ss pe ak jo ør år ig gj rå em ul pp ru lt ro ni op ld pr ku ad ol dr di ok gs us ir ur kr lo sp iv mi tu gr bl rk sl os fe ev mm ks od ba ov pa fa fø br ie gg kv ot ho kl ls uk mo fi rn ts så ær yr må ds det ing for til som ein var kje and med ver ter ett ste tte den lle leg nde har dei ikk der ane ell gje nga inn men lan sta nne ten est eit nge ert ent opp art enn ska han ord frå ere kkj rin lei ang gar ele sam sen gen are kom kan jon sjo ist ret ren len man tar all tor før ser ran ler lag sto kul jer del dag lin und nin ene kal ske isk lik ans nte dre hei tre ann ast ngs unn omm eri ale tet ore set ort nor sti tan ara nar rte ove ors rar jen itt sku era eir bli sse end ndr kar ram str eng ida kva tid mar eid tur lit ekk let kon eld res ers lar seg ber dan nok ass ule nen vil nna gan ill pro nes asj rsk att nsk ite fra tra dde bei ner kri akt tta pla ved arb erk ekt kke sin ess tal rbe net mun rst jor bru reg ine jel vis rde mel egg age lde ald ens las spe ige dal les van vik ege ruk ise mmu ide ike tek min ona kti per nta lev rle mei elt alt god nse rda nst vin rei ven run vel kla par hel ate her rer ama ris rre kor ger fin skj ile eis eil skr kap ken rne mme dar ive ker ikt gru ytt eks åde ire tad val one ong byg els des sid nda ski rek tiv kte ete tak ørs ade nan eig une tin ins eve kre add ern gra sli mer sjø pen jøl øre ogs ons fle lom int erd ust kun mot had ant mål hal riv",
+	"pa": "ਰ ਦ ਕ ਹ ਸ ਨ ਤ ਲ ਵ ਮ ਪ ਆ ਜ ਬ ਗ ਚ ਅ ਇ ਣ ਉ ਟ ਖ ਈ ਡ ਸ਼ ਧ ਭ ਫ ੜ ਥ ਘ ਏ ਜ਼ ਝ ਛ ਯ ਠ ਐ ਓ ਢ",
+	"pl": "a i e o n z r w s t c y k d p m l u j ł b g h ę ą ó ż ś f ć ń ê ź v æ ù x ñ à ý ie ni na po ow st ze cz rz ra pr an ch wi ro zy za ia wa sz ta ki dz ko od ar en mi ka ci ej er li je zi do ac to sk go on si or al te ty wy yc es os le ał eg ne em re ak ię ny ad in at ma ol tr ic ów ed aw ła ja da am ec no la aj zn as mo ek ob ym ce wo pi cj om ło sp by oc ry ku że we el is pa kt ws ys nt cy dn ot ok zo ez tu bi az de ją op io oś dy tó ur gr ba oz ik ru kr ln us me et wn ór uj śc og yw sa ew ji ud im tw yn bo lo my il rt ać so nie dzi rze prz ego owa ani wie sta nia ych kie ski eni się rzy zie czy est owi iej cze ier pol mie cie pro czn pra wan jes pod ści ach ost szy sze pow ent któ tór acj str ale iał owe nyc nik iem pie owy dni ien jak zen iec trz wia ali cji odz raw kon now arz nic ied iel awi icz ami ich zys cza yst zna ols row ają rac kow ała ter spo tak lsk cho ieg ska tow naj tyc ośc ecz esz tra acz dow rod wsz ist raz jed wni roz oni cen ycz zia szc tor tan bie sza gra ona cja zcz aln sto neg edn zas ies edz ran adz art ocz tar kom wał oli sie any era nym pre war nej był uje mia lic zed wsk ędz ada ora ole dla tni zes stw jąc rad wyc eci rok ane ejs men oda ros ywa poz tem roc ast obi wię oku będ ini spr zni wal zec kar asz orz min ków osz zyc tym erw ony nta sty ows por ina moż ńsk zym cia aki pos odn kol lat niu zac zos rez zne świ zez tro rów tyl woj ana teg szk kra usz zeg ele owo ste ało ion pis tów iad ata ron ano omi noś pom oce hod ięc tał bra pot ują mar zon nal ika for uro bar nas nad taw rdz oje res nio óry eli ech ard mow awa dan par kic rob zny wej zap one cha mów erz ość ate kim aty omo mac nac orm zan ekt sow ały sam zaw óre ato zej dob tur den emi obr ian and kan bli twa ome god",
+	"pt": "a e o s r i d n t m c u p l v g f b q ã h ç á é j z í x ó ê õ ú à k â w y ô º ª de es ra do os re ar as co en nt da er te ta or ad an to se em ma qu st ão al in ia ri is ca pa ro me na on ue pr no ci po am om nd ti tr el di ic ss pe id io ei ve sa li ir it mo um at la so le ai ou im çã si ec aç ce va ni nc vi ua mi lo ol un rt il mp fo ns et eg ac fe ur fi ho ga ui ne tu iv ha br ul gu ba go nh oc ap lh ut vo od ed su oi cu mu us ab eu fa ev ag ov gr rm sc be sp iz rr nã cr av õe ge ex ça mb gi ár ob lt du za ao ot he ch rn ent que nte com ado par est ara con res ção men sta nto dos ida pre açã tra ant ndo por ica cia ada pro dad ess ade ria des and eir ais nta ont ist uma das ter ito sso rio ora ram tos ser nos era não ran ver nci ntr ele ela ões ame mai end ira for tar tad ita rec ura per ass ime tem ido ras ali pel ina ano tro tes str ico ssa eit tiv nda são mos qua art nde dor tor car cio sen ort ári min tam sse sti ece rad ici ona iro ste ten eri mas uni egu dia emp iza cor omo esp ind ome nal iss ros man pos ore ons gra cas bra mar nha der çõe fic ion tic foi qui seg eve int ese lic ere ias elo eci cad rma fei inh mpr pri nic den ens lho ati tur esc tas und ndi dis nas vid ios sem tan orm cid liz nti ava rea mun tre amb rti ret nça ato ide ari rta are ode lei ern cer omp ren reg rte oss aci obr ênc ssi tal col pes ost enc sid eto mes cam ven ove iva ema ima mpo ori cri raç tin ena ili edi oca lar efe pod ata nad can açõ anç rim spe ana ial tod ate uit ula rna sto tim fer ini eta mil ama odo ual aco esa aba gun ois rre rar pas rat cen imp ipa orr cre nho cul ral alh nov out vel tri tão erá ref ilh seu sua inc ert ian lta pol cip lha emo rei mei ces sco mbé ive bém pen ega cos ond amo uto lid gar",
+	"ro": "a e i r t n u c l o s d p m ă v f b g z î ţ ş h j ã â x þ ț ș º k y w q ª ä ƒ å re in de ar at te ri st ul ca nt ta ti or er an le en ra la tr ce ea al un ni pe cu ma es it pr di ie ia ic ne el co ur ru il on si li ac lu ii în na ec ci nu tu se ro me as lo sa to mi ui au im pa ut is oa nd ei am da ai fi po va ţi su ol om ve os pu vi sc ns rt ct fo nc io sp et du iu em şi um oc că ad ot zi mu ap ed tă us do mp ba fa no lt ga iv ch ir uc fe za rm cr bi pi op să bu ep ez vo mo hi iz az ân ge ua pl eg up ev id ju so eu oi av ră are ent est ntr din ate tat ste car ele rea tru lui int con lor pre tul eri tre pen tor ile sta ace ulu pri uri mai ati pro nte ori ita rul ost ari ani ici ter rat ist men tra ere ale par ine sti nta art ata ilo ara cat ril ica tea str oar rii ast ces mar ali fos chi eni ect ion tar ona ina mul res rec uni per ant tur tel ili nal ult min rie nic edi era cur cel com rim tin ini nul rin une ons iar dec man nat aţi ier and rma esc anu lit imp nti loc iti cea tic ame tri tim ind rit tii eci rom unt dat iza ite tiv atu tan cul rti inc scu ato ura cut fac cum lar iei ala cer rte cal imi ran bil rez cti ire tie lic act vor oli ont mat col nea put iun lul nst des elo iul por eze ort ene ins ice cia sun dar pun ora ric ima ide cre ana asi ria tit spe rop uro ial nii spu ane der fer can iil oru lat nit nce leg ute eaz înt fic ner eas mân ven unc ian ime for tia ten toa sit itu tal vin alt asa aca eta ocu ase oat ată ver inu oca ers nci ecu nde gra orm eur nia dic zat acu rep ţii ndu mil cla ond eme stu rad ntu dup tot ivi ren ări mit şti nis abi aru ern oan iat înc cto lte ioa ive ând pus pol eru dac duc ave una che pec ede num ean esp rel ţie uta ndi reb mun ece riv mer lin pla ope ete ctu",
+	"ru": "о е а и н т с р в л к м д п у я ы г б з ь ч й х ж ю ц ш ф щ э ъ ё і ї є ґ ј ћ ѐ ст ен но ни ов то на ра ро ко по пр ре ос ан го ер та ор не ли ет од во ал ва те ом от ол ти де ле ка ит ск ны ль ел ат ри ес ин он за ог ла об ме ве ть да ед ло тр со ав ил до ой ас ар ми ки че ак ем ия мо тв ви ис ие нн ам ии ся се из аз ци им ма ик ру вы же ди бо пе си ей сл нт ий ьн сс тс ег ок ек ля ир ых па чт ев ад дн ив сп ча оп кр уд ше их са ая бы ае чи ры вл ое ич ду тн эт ту кт ые ож га ку вс ый оз нс ут хо бе ще гр вн ез ги ты ур ят ени ост про ого ств ста ани тел ова льн тор пре ско при ест ния ров сти нов что енн ред ель стр ние ово рос сто пол ать ком ент оро ите пер тся мен лен тра аст ото ере ски нно оль его ных ной али ает аль ран ног ник нны ист это ков год ден кон раз нос пос тве аци под ить ван ьно том или вер рав ода чес ави дел сси ате етс тер тов пра ове одн ные род кот лов ион так ции иче кой тав каз еск сле сть оло осс ный еле оди ког ном аза вит нии ска ным как рас тро лас был тре иро ват она ход сов вал ина жен рес нал ьны анн вле тан чен бол шен вен все тво кол рем общ еде иде рат дет ако час оры тно ика гра дан спо вод оли кра ами ера нск овы мер мос рез дст ала для тал тат ало ели або дит раб ект буд дер вил оле ико ива тив ори рен сте жно тва авл сво гов дол мин нен ита дов гла ора ают ини соо пор ана едс льс мож ром вос ооб воз оже осл тог бот мес ано нач лис оск ици бра ких вор ело вно цен анс лед йск вед лет еди арт отр нта одо оно ати опр ерн вет асс ока аме гор сло ыва чит обр рит ато ний спе луч стн ери ому соб ним зна зал мет тол дно име суд рин уде сно ная кто бор сам ься ное вре тьс рои анд лей ети вля ила нес кий кие обл мат слу уча вто пар пла они ари лав инс омп ики вой рег але",
+	"sk": "o a e n i r t s v k l d p m u c h z j á b y í č ý š ú ž é ť f ľ g ô ó ň ď x ä w ov pr st ne po to na en ie re ko ch ro ra ni an al od la om ho li ia va ri te or sk ve ta no do ti ed je er lo le ak vo aj sa in tr os me ej ol de mi ci at ad za il es ka ob ok vi av el si is ku ma et sl ic as ar on dn kt vy ná di ot ce da it am bo ný mo rá em sp né ýc ke že nt vý ky oz by ek ní tu so pe ud pa tn ac eb ru uj ou us ať oj čn ny oč kr bu oc mu br se ns nu vn áv ik rí ác ba či ck be ec éh az op ur jú lá ži št ez ár ši ča zn ja tv pl pre ova nie ých pri tor sta ost ove kto ani pod lov est ali kov nov str sti red ého eni ent kon rov ako nej nsk van val tre pro hod slo rok ist tov nos ale pol ven ran ili spo uje pra tak bud men prí ick eho nia ens kom len tra ovi nýc odn ati ast sku rav och tom sto roz sko ich áci voj bol den bra cho ter naj olo rie očn cie edn kej ala oko sla pos dne ovo ved ajú lad čas ste ame oli rad rat vať mal ren iac dov ate lav mer por ver ový rob nom tav tro mie áva ske ten ria cov pov stn prá kla min nem áln ebo teľ jed eda osť era via ite hra kra ude cen néh ori šie ele oto mes ová eur raj alo odo dob lne nik vie ský tie ami tis hla ina ila oro iad avi ové oku ies cia svo tvo ska raz rod hov ekt ovn eto dno stu dos ráv iek res nic dal ujú vne ych dom tal pot ide las anc vor ane dľa isk oje nes pla iel ret isl tri adn lat odľ sia oho cel kol ere orý ene stv tic vod poz uto kýc nto rej nan leb ete som eri oré ede osl mil eko vej sle sme ini edo vol tne zna die odi lan áro tan eli eme ade ení ech poč ach iny ské rom vin rsk pad ilo obi spe adi odp ava nen nep lit pok ave mus per šet ník ero oti lia veľ ano obr dan spr dia med tel aní iat vet ným sve sie ách nis sob ola pom výc akt tia ern",
+	"sl": "a e o i n r s l t j v k d p m z u b g č h š c ž f y w ć x è q ü ö đ é á ä ç í ó je na ni pr po ra st re ko en ov ne an al in li no la te ve za se ri ti ja il da od ka ta em el ed nj lo to av le aj or ar ro va me os er lj ki et sk ih vi ij do di ol bi mo de at vo ga so it ot bo ev om ma tr pa im ob ak es is jo ji am eg mi ad ik iz ke go ek as on če sl ci ce dn tu oč ej dr ju og či ic si ud sa az še sp ča oj op kr iv vn eč tn ič ok ru br ir be ez iš vs čn že pi ns pe rj ku tv ač ah gr rn ln ur šk vr zn ap vl up us sv gl nt aš pre pri ost anj nje sta ega ali red ove sti rav bil eni pra ova udi let sto lov del ili nik ako pos nov ist ila ter pro jen tud nih nos raz naj ija avi ski ven por nsk iti str eli ran ora ani lja oli kov val lje men pod ati est nja ske ite pol ovi slo ijo tem ala kot cij ate olj več elo dru van edn tre lju ovo jan voj tak ilo ajo oči avn jih vse ved enj eva iko ene ije gov rat vlj rad nal nji ena kon ste neg ral pot pov tra med eri jem eda lik sem stv tni gra eno bol vil tev odo ina ogo dob sko mor ira tov ome ori nim elj mer oda dal ime tal nek tav adi kra spo ame ičn ele eta aln nem uje nar vni kat res ika ast aja ese kaj ima eti rja ela led edi tor ara dni rez tek jal rej jav avl rem tro ede svo nam daj vel jsk zna kar etn arj ini alo ent prv ane imi sed dan rij lni nas rug aci ska pom nic oma oto dno roč ice amo bra lah nij kih jev kom las vet eka otr nis kol raj eto bre ahk ens ril var lad ici tan ato ren ave hko dst spr ose nil ava obr zad odn nap išk žav oje odi dnj reb rje min drž ore oro mes čas tel met iji čni čil man ust pla sam ari ale obi ana rov omo sla lan čno gla rep kak vno rit den ica top ano evr rža tri lit sve vor ote dov zar reč bod vro tno ans nju dar oko eds",
+	"sn": "a i n u e k r o m v d t h z s w y g b c p f l j x q é ì ò an ku va ka ak wa mu ch ra ri ar ir nd na ne ma ti dz ng zv in no ha hi am ut zi re ur ta da at we mb vi si ya un ik pa di ai ga ek as ro on en ba sa or um er is it em ad kw av ve ko im hu ye mw ru za us sh ud ny ac uk he vo nh id te ts uy se ot nz ap rw ni ab ge ok mi ho iv gu sv ic me ed go az to zo iy os ay wo om de yi bo ke et ev aw ez es uv yo od iz ub au pe bi ze bv ag po do ec be fa up ip wi ki uc gw yu fu uz bu pi aa hw mo mh du tu so af aka chi ana kut dzi ndi iri zvi uti vak eku ano van ang ari ira ino nga kan ach ika nda amb dza kwa mba and ara wan mwe asi uru ati zva ita kun adz kur che kat ane ata sha nge cha han idz kad isa ore nhu mak ako udz rwa ava ura nya ich aro mus ngu kor nek dar era not muk are ere nem ani ema mbo eng kum end kus imb var dir mur emu isi ung mun uri rum uta vin uka vik amw rir kud ada din ume uye zim aya nde anh mum edz uva oro ake hin avo mbi ona zvo kwe iti ech mwa ezv eva mut amu ina ama zve wak ing uch vac kub uno ong pam mai asa ngo ush ine vir pan nzi ait oku tam ash uku uda ora hur hir sin iko sva kar irw aku nod hik ete bat non ndo tsi yak mar hit usi nha awo iro sir dze mud san ikw vam umb tsv uma tan oda gwa har oti aga svi izv nen yan man zir gan ima gar ngw vav imw iva onz vai ond unh sik aba ham aur dzo nyi cho ats eny uyu bva uro uny mbe any vag mas kas iyi vat ris kui tau kai vek kam ziv kup awa ram mat iki ain ave mir nev yik ato rim wem uko azv unz umw zwa yay kaz vim apo rin uts ten ber oma rar uit wek zan kuz ose apa swa ind dak umu war hwa yek aru bud hen ish sho vas kom nis ran rek het ure hak tor tar her rer kuv ota bab tin ive gor pak azo tir und kab nok oit ini",
+	"so": "a i o d y n e u s l h k r m g b w t x c q f j p v z á ø í ü ú à ì ç é è ó ö ý ā aa ay da ad an ka oo ha la ma al ee ar ga ya ii in wa ah na ba sa ta iy ra sh ax uu dh ku xa as ab ag is ey id am di so hi li si yo ca ir do qa gu he nt ri en lk ac il ho rk nk dd mi yn eg le de ig ed or ur ys ki om ul ye ti lo re mu go ci ug bi er on xi aq we od yi ji ge el aw ni af du ol ud xu dk su un og im ow fa to bo ib no rt ob ro qo at us ko be me st sk nd nu um ub wl ak bu ne ru te uq rs ll se ja ia hu kh es ih mo ux yd qd uw ke fi oy bt ada aan aha yaa aya axa wax aal iya aga ara ala aad iyo gaa dha soo ana maa sha aas ali hay nay aba een aar lka mad ama xaa doo nka nta hee mar add ida ina alk dhi lad kii dii waa san rka eeg ark baa had laa haa she daa bad dan eed oma taa lag kaa aca ank int dda adi kal iis iga gay ega raa iin isa hii naa ale aab day ray qaa ila saa eyn yad yay ash ood ayn lay ays tay oon ysa iid mal loo eey agu mid adk dal som dka ari aam dad yee lan dee hor eer ado sii sid xay ira jir asi dam shi koo yah liy wey ugu yna lah cii hal eys and isk oog asa han war ayo ima wad goo gan ree lin are oom rta dag ade wla ihi caa aqa oga ant mag eya gal iye ska qab rad sho sad aay aye ata may ish dhe uma hac gee aah iri dax kan sta dah uga aro oob haq oda har cad ink bee gud art iir lam ula bar bay hin owl xuu uxu kar awa eli hab gar dow mee aaf kuw lee max yey rki dib rin wux uwa esh aag ona tii uur ami dis ooy eda iba nuu ban eel yuu dar lab ayd era say ool lia deg was ame aso ran ani ora rar nah uqd rsa tir aat kul too abt irk oox hir adh unt bta cab gey ola haw una cay afa rii arr hoo gob xil idh gac nac ore uul tah soc sla obo hig bal muq ahi sag all lla bka oor ddi idi yih isl qay ayu aki qdi abo inu",
+	"sq": "e i t ë a r n s h o m u k d j l p g v b f q z y c ç x w é ö ü á í ä ş ć è ė ó â të sh në ar it he et ër ri in te dh ti re me je nd ës an is er ra ht or en si at ve pë mi li im on es ta rë ur ua ni gj nj as ka ën ll pa al jë ko ku di to më st ma tu ik ja ng ro ga se së tr la le il de kë un pr rr hë ne po do ët ke hi nt ha aj th vi që ek na ak ba sa mb rt pe ul ji lo hu ia ci ol dë kr el fi ru kt hk os ej om uk em oh ed ij ev mu ir ip um lu dr am jo us io qe du mo so ot oj jt ad pu da bë ki rs rm lë iz no zi pi qi bi fa nu tj sht dhe për një ish imi uar ësh nga htë tet tar shk end gji hte jet eri rit ist ash anë etë ndë min het tin oni und par ore tit shi ara esh pas eti ësi eve atë ndi tor tur iti ori vit mit shë dër edh ull gje ali arë ani ion isë lli jes rim per tje ati tua erë ime ris ohe rin jen kon are shu ike nte tij ite ush tër mun jit kët kom shm jer tës ill rej ven ret cil akt lin ale ran kur art pre ith ndo tri shq pro inë hum ent tra tim ëri ari hme lit ave men tik ter hqi ues rat jan duk met gja nde der sis hin esi ëve rën era rës por qip ndr kan rre tyr arr hën kës nuk ënd str lim she rua ili nin orë rri vet dit llo lla shp for ant dhë nis nda man sti hje dis umë res ete hen all mar fil kis uke kri jnë ton dor ind esë nit uri sit hur ont ini sha pje and ban eta ver jat rti ren ria itu tre ërf ata ejt koh dim ste saj kry htu her tha sta itë ura bas oli yre ërb uan ekt nti lua oll ona ane ers rye reg ive lar ato kul adh nje ërs kat ajt qen pri dës ktu uaj ikë pul ron ojn rik nën fsh ërk ast the ipë ina dre ita sip fun gra nës ërt tën pra ipt ëta tat orm mad mbi otë ërm bër mes hëm pop kal irë ror sin cio opu hës llë tiv sto det ëhe one ano rma faq ila ami ate pun lis mat sim dje rje hat ele",
+	"sr": "а и о е н р с т у в д к м ј п л з г б ш ч ц њ ћ х ж љ ф ђ џ я й ѣ є ї ь ъ ю ы і на је ра ст ни ко ре пр но да по та ов ти ва ан иј ли не од ка ма ен ла ав ри ве ос им во за ом ој то ин ро ви ог ор ед те се ад са ар ал ја ск ак ат ис ди ме ци мо ет би он ај из ло до ик ит ил ем ам тр де ер ле су их ње го ас ек св ив об ањ шт ру ол ми ју дн ес ји ел иц ку ки др сл ту аз ке ио га гр па ао от ок тв че ич вр бо си вн ће ба ев ац ну оп чи ић ир ња кр ср бр бе ду сп зи ус иш ач це нт аш ег ут же уд уп ше ећ пи сн лу уч нс пе ењ ца ста ије пре ост има кој ија про ред ова сти рад при циј ист ови ово али пос ако гра ани нос нов ени ник оји или ава ана едн тра ада аци ско ати све ити рав ање ком сто ног ика ина оди вој ран ара ски ниј ниц ори стр пра оје ств тав лик јед мен ове дно риј ест ици раз дин ају них ном ора аст ено гов оли вањ кон ави ена ања кол так ама авн дан сам као ила дни ине она ним ији ива рем осл ини под нас алн ели што зна вић ког ано род ала биј дру вор одн рат ета нар тор амо ичк вно ске ади ент бил ите срб вет сво бра ико вни еди тре ица ема пор еда тер нај рби тар аве год тво ира нск ван ављ ене рен тин оја вод тва ило ију ери ата ита тив вре сте тан ека огр спо лов лан ари ога ује пот држ рис тим ење ети шта они ода шти ион обр рек сту кад већ нал ков едс шко иза оре јав бор оме руг пол еко жив вим рај нис сно еме одр ате его еле сло ера лас кра вал ржа тал уче мог оно лад как уст лед мат нач пск пис аро вар тич иса лав рим сле ице том наш ска дов нек реб ише сни сла ере лит доб ких иво аје ало ват вен ака ичн поз анс ект вер вла тур ела ров инс ола гла љен рој дст пла пов око ења емо сад аја ане изв еде ази рет оже мет вом ску рам ром сре лог вог вел нам ике аво јер виш тит тро овн чин",
+	"st": "a e o l t h n s i k m b g r d w p y u f j c v q z x š ō á ÿ le ts ho ng la se an ka ha di ba en na el lo et sa wa mo ya bo on tl ke ma at ol he me re th al ne hl so eb is ah or sh be kg ok te ko ot it ak pa oh we eh il ta nt sw si ph ek ra ar ap in ik ab ro ny em mm pe am ll es hi fe ut op to er no ed ao lw fa go ae ga ef ri eo po as fu im hw um od ti om gw tj os ob af nn ip bi oo li jh jw of ge ep hu ki ye fo mp fi ad us yo du ul bu nk ai lh mi rw ih ib kw fr pi oe ru oi ir nd ea tu pu ee de st ni je ei eng tse ets tsa ang ela ele tla tsh ona olo ana ile tso ong tho tsw ane len seb let ebe ats ena ore lan lok hla hlo swa sen ala dit she aha wan edi hor elo man nya its tsi ola hel mme isa apa ken hol bon ale ell kgo swe lel alo mon aka mel lwa nts ban han odi ete phe ohl bak din eba tle eth oko tlo ata oho lek tjh hle hwa ole aba pel yan bat dik shw eha set wal the mor mol emo hah tha atl bet jwa ing oth mat kap ots dis oke ako san dim mot ika kga het dip kel leh hal pha boh bel wen bol ake ate moh ara kge fat ath ahl iso nak otl ntl oha oka hae nen kan ama ano gwe met bed lon eke hab ekg adi get nan ora lla imo lef jha ehl lem bor fet gat efa lah sel aro aho lao hat tel loh uma ngw tlh son nah any ant ape rik ase rwa isi utl eka kgw are his ent nga har hil nye abe fum ame lal leb ese mpa kar ume ten pan ahi orw ihl isw ron thu llo kop oph emp afr kol ebo fri gwa kwa heb hen ony ith ere mok lat seh sho eny hon bop eta ehe bot amo yon ise etl ebi meh oba eho sit net opo moo mah lha gol lle hod ikg les ren ahe lwe men opa rol ket okg abo non mos nng era hao pho fut ngo iko hap heh mod eto ose iph sam boi ehi and bok bil kot lak mab got pal ome uts uta hob rek dib all seo eme ene dil",
+	"sv": "e a r t n s i l d o m g k v h ä f p å u b ö c j y x w z é q ü á è ð ø ó í ç ñ ë en er de ar an et in tt te st at ra ll re ör om ta ti la nd na ka ng är ge me sk fö oc or ch li on ha ns il ri ig le el so al ed ad nt va da ma ag is se vi ga ve ni ne än sa på rs ko es tr ck as rn nn ro it ke si be un av ss ol am ut år rt ts pe he kt mi to pp ik ån id fr ot äl mm vä sä ja pr ba em pa rd dr di kr bl no rä sl ls sv sp rk äg gt lä mo gr io rå ak ft lt ho bo fa us ur kl ld så iv gs fi ät ef up br nä po ek ku ds ju tä ie lo ul gå lu för att och det ing ter ill and som nde gen ade den ska til med var rna nin nte sta der har are lig int nge era han ver ett ger ste lle men lan ens ten all ans gar und kan ern lla man kom nga ara ner lar ler sen eri ent inn igt ätt örs upp ell ers son ion age ren arn isk mer dag omm nsk tta rin nna tar iga one lag ran ort tte frå jag ser dan mma fte sam tor ist äge mar sto dra tio ång eda tal tan het ete nne äll tid ven pro ann del art rån ker per säg ock res nen änd ela str sve ati kar när bar spe rar sig nda itt tre des ber ige cke min bli mot stä rad rde yck sin eft mme tra ndr nst est ngs kon lin bet nor rat ord öve get kti kla pel vil tet rig oli dig vis rst ken len ast nar öre ets red ket par sso ats akt ret rik fin nad els ons ick ris sti cka for ina let mat hel eta kri nat nom ram gra någ ess sko bor tad lit pla mil äst äng där tat ilj hon ins tro ite erk ale öra llt vin ari nta tig amm vid bil rit ika tis gör ono änn ant ull lat fra org ark nan ala kte pen arb lis lls rbe vän ågo cen kor oll vär nas lut ras går ikt sla ena tag dni iss pol mån lad ron vår rän opp che nns län ike rät kul led ege här bes cks ott dar nis uta slu kad ate pre väl iti kro lev lde sat ske sät bra",
+	"sw": "a i k n u m e w o h l t s y z b d r g j p c f v é q x ô è à ö ã ç û ü í wa na ku an ka li ma ha ya am at ni ki al ak ik ta hi in ba ng sh il za is ch ia mb ti zi kw la en nd as si ar ri um sa ji im yo we da un ra mi uw tu hu di it le ad ny aa to ut me on fa mu ai em ja ke aj ab pa az ga se iw ao ul us ez ye ua bu ay uk go te mw bi gu el nz af iz aw ur vi ok pi gi ap po fu io ko es om iy vy zo mo ek ne ir ah ot ib ea id ge ip uz he er dh ho de if up ag uo bo uu mk wi et re be ub uf au do no ru oj iv uh nc ac pe uj ic ali ana kwa ili ika ati kuw uwa mba aka wan sha kat ish cha ani amb ata ama wak aki iki iwa tik aji ina ini ema kut shi ngi ara lis ang nda ing uli nga wen ita wat sem iyo asi kam azi ari ung wal ele end awa ake mwa fan atu anz chi eza ala any lim ndi ngo nye tan iri aba eng wam ada nza uta and ngu uzi iku ong aku nge nya tak ami tok lik vyo kan eny rik asa adi una kwe ise ayo hak uto ash nch kin hin ame ima ach ila nde ind hat kup oka hiy ifa kil mas oja imu mbo ham kiw idi uch isi mbe kus kis uku kum dha aad ion esh bal ano hil afa zan moj umb ich iti har lia lio bar nzi wez apo yak ahi kub pat omb han ush tum baa umi aid ibu ivy kul cho nia kik kur ine ura vya ato mia aha imb kuf liy izo ime agu mat eri nyi ais amu kuu ian gin ote adh abu kit man oto dhi ume aya usi kuj liz sik naf lip hiv was ndo mku had chu kaz iba kun liw uma nas abi lak mam che hag kua kia afu zin nao oni eka jan nan hir ufa maa hal nay uri aye iji guz apa iza yan fun del hus kal bab huo bay bwa jin aan maj uki uka mar ilo mbu upa waz bun bad lin ria she huk izi ipa uji mak aza upi wap amo mbi uhu eke ewa mwe kuk ias uon pen ozi uni imi goz isa tar tat ipo pan iko shu eli awe lez kim mik mal sab taa ten ubw",
+	"ta": "க த ப ம ர ட வ ன ல ய ற ள ச ந அ ண இ எ ங ழ உ ஆ ஒ ஸ ஜ ஏ ஷ ஞ ஓ ஹ ஐ ஊ ஈ ஃ ஔ ஶ",
+	"te": "న ర ల క త ప వ స ద మ చ ట య గ డ అ జ బ శ ష ధ ఆ భ హ ణ ఇ ఎ ఉ ళ థ ఈ ఫ ఏ ఖ ఒ ఐ ఘ ఓ ఠ ఛ",
+	"th": "า น ร ก อ ม เ ง ย ว ล ท ด ส ต ค บ ห พ ป ะ จ ช แ ไ ข ใ ำ โ ศ ผ ษ ธ ภ ซ ถ ณ ญ ฟ ฐ าร าย กา อง าน ระ ปร รา กร นา าค เม นเ าก ยน าง มา อน าม หา คม เป ให แล นท าว หน คร ละ ภา ลา อก ใน งเ ตร ไม ะเ พร ษา ขอ รร เพ าท เก อย วา เส ยก ชา งก หล เท รม เร ทย กล อา สา ได หม นอ เด รอ เว อบ มษ บา เล าเ ยา อม นต ไท เอ เช นก งค จา คว อร าช เข วน เห นส นว ไป วย บร งห อเ ยว รค จะ าพ สม าด คน ทร รว งส มเ รก นห มก นไ นน งา วง าล าต นค เต กษ ผล ลก อด กเ เจ งท ตา มน งไ ดเ รณ ยเ พล นธ วม าส หว รเ แต ออ ทำ รง มร ะท โด นร งแ ชน หร งข เน วล พฤ สน ะก นำ การ ประ าคม ายน และ เมษ มษา ษาย นาย ของ ไทย ายก ราย ควา วาม จาก ระเ งาน ภาพ รรม ภาค กระ นาค ยกา หาร ารเ ระช เทศ ารค สาร ทาง โดย นกา เวล ะเท วลา งกา ราค นเส บาท กรา ออก ผลก นอา ลกา องเ พฤษ ษภา ฤษภ ใหม นหา พระ ราช ากา กษา อาท รวจ รอง กรร มาร ละเ มภา เสา ตาม องค ชาต ากร รรค ระบ ระท งปร ระก องก พรร ในเ เผย นตร ารณ านส มกร ฒนา ะชา ยแล ธรร บาล านเ นเช เหต ลาย มกา ยาย มหา นบา รอบ ายเ แดง าคา อเช ราะ สถา กรอ นวา ลาก านบ ยวข หมา เตร องท ใหญ าชน โลก ขณะ สมา มนต พรา นมา เหล สอบ ามเ เทพ มาก งไม ลาด กลา นปร นขอ มาย ะเว ขอเ กาย ละพ ำรว สภา เคร ไปใ วาค งหา ทหา ารป นทร ชาว เพร ชาย กาศ งกร คณะ รมก านก นาเ งหน ธาน ไซต ำไป นำไ ารก ามา บไซ อกข างเ ารส าเว แรก นเด นยา รอก ยกร งเป นไท อหา ฐมน อนำ สละ นหน ระจ งให ปใช ระส ฐบา นสล ตำร หาค ารท พฤศ ฤศจ ยกฯ างก คาร กอง นคร ญหา ระด ะกา ชาช ารแ กรณ ายใ ายส หาท นให องไ รเม รปร หมด ลาค ำนว งจา งเท จะเ ขาย มาช รวม นาม แรง ตลา หลา ระธ หาก โรง สาม ถาน านต ปรา ทยา ลาง ในก ะธา ครม งชา ระห บกา อนเ นใจ ณะท เสน โคร ตอร ระม ทอง ตรว องร นทา กำล งกล ยาก ศาล ายแ ฐาน งหม องส รมว สาว ารต ารศ อกต ารา นใน ครอ งเร งได ยใน รวง ะทร นไม องน ยงา ะจำ เสร นรา เอา เตอ อกา กรก กให ทรว ะกร สำน ากก คาด แชม งหว าวก ำหน งคว นเก ารร สนอ ชอบ ายไ งเก นไป ยอด ารพ รกฎ อาจ งใน อไม ายอ กฎา อนไ องป",
+	"tl": "a n i g s o t l m e r p k u y d b h c w f v j z q x ñ é è ü à á ì ï ö ng an na sa in ma la ka pa al at ag ga on ay ni ta si ya ar ba as am ak il it ra ti li to ha da er hi ab un ap is ah nd di ri wa en bi yo re aw iy nt no ro es mi lo ul mg st pi um or te ko ad ki gi po ku tu ig aa le su se de os pe us ik el me ai bu gu ne im do ny co oo ib ip so io sy go tr gk ot mu id ns rt lu ol ho ic mo pu bo ia pr ca ur ir ce mp ch ut ea ac nu ll et he ed ve be om up rs oy ie th od em mb ou gg ao ci uw vi wi ge ec uk ob gs uh ang ala ing lan ina ong ama man ila aka mga iya ara pag yan asa ata ito aba nag hin aga apa nan yon pan mag nga ndi kan ati aha aya awa ali ind ana ung tin gan nak san ahi uma pin han par ban nam ami kay kas nil sin aki tan ani abi isa ent niy may ayo siy hil and any kun gin rin ula ili nya ita ion min pat nat ano mal wal lin dah gay ini mat agk gka aan ran lam res per kin nit nas ari kat mar lal nal syo mak oon wan agi din ant kal ero alo sab kap tio dal sta una mas bin ngg lit nda kak tal nte ter ulo bag ton ist kam ako lag agp atu apo gal sam nta iga iba nap ino sil tra isi nin tat raw hal bil lak est ado ags ago big noo tul bal ers uli lon uha lab kit kar art pos kah law sal mul nab ine kon gpa men bab iti ipi hay int pal pro asi las tay ada mah ram ibi pap inu den asy pre ika lip bas tao sto tap amp gaw und bat gga kai tor bah agt ide agb kab ans pas pam bay era ipa isy tim sen sak mam lis tag lik iwa yun ate nsa con api sis ima ort ust por rap ain pon ngu hat mab ill rat ngi aso sus ngk ira lar mil mpa nto are cha ver eng son nti ess nay sti agu lay ona usa pak tun ity dat ail agd lat the ele igi gya non gam gta lah har sya esi gul upa dan ard aro gsa ern aon ast ndo sas mit",
+	"tn": "a e o l t g n s i k m b r w h d p y u f j c v z x š q ê â ô á ë í ó ŝ le ts go se ba di ng an wa la el mo ka tl ga na re bo lo sa ne on en et we ma at ol al ha ya lh ot me ke or it gw kg sh ho fa si th ko ra nt ag og so ak mm il he ok sw is ar ir am ek pe lw kw te ik ta fe ja os ro ab aa pa nn in hu eg ny be ti ap ut no to ph om ed op ri eb ad er em po yo as hw ip ge hi od im ao us iw ai ob bi ae af bu ru es eo ye ay ep pi ki um ee jw up ef gi su mi oo du fo pu rr fi ul of tw oe rw li id tu oi un mp ua jo kh ib ku tse tlh ets tsa eng ela ele ana tsh gwe ang ngw olo gor ore one ong tsw wan tla bon lha kwa lel mme ile lho ots ats kgo sen its alo nts let otl ala elo ane dit lwa ole kga dir ban tsi swa edi atl bat aka gan ona mot len tso adi set ale dik aga fel she ola gwa gon tho thu bot ogo hat swe shw oga nna ata oko mon gol tha isa din tlo tle nya hwa any sha itl mol mog mel jaa kan bol pel ora odi mor ara iwa ntl ama lan ing log san etl hok ago sel osi elw ikg lhe kol ira agw ako gag man eka ame ena aba jwa isi sek are nan ape hal mat jal abo pha iri ath leb rag ano ene dip imo lon tir lol mal ere got emo ant hel ega hut nen ego ent mos oka wel kgw aya oth los lwe aak nye hab eko dis hus laa bog osa abe ten gel iti utl son sup bor lek wen ika han aro aan sho bel hol met oge ete ith nak ire rre lat mai fet ome gat iro opa mas kop lef fat sim ase ina amo oro gap rek tlw ham sad lem mad bil eny upa bal ate ebo iko ebe gos rat gal wag tel mma nne ume rel wal eba oph eke ise apa usa non ris uso seg afe rwa jan dil lot ont the yan sit nel ron ael pal mok gom sep ton akg ota wet bak egi mab les mar oba ope ato gak eme ula gao twe omo sam siw dim men war hop ann led kel heg leg obe afa ose ril eki",
+	"tr": "a e i r l n k ı d t m s u y o b ü z g ş v h c ç p ğ ö f j ä w â ã x î ÿ å û q í ar la er le an in de ir il en ri ma da li ın ya ra al nd bi me ak ek te ka el si ve di ta re ni ti et ol ne rı ay as sa nı ki am un lı es na mi ye ik or em is on ul at kl bu im sı ha ge ed ad nl ıl ba ur rl iy lm st iz se ır ru lu kt iş rd yo ür tı ün dı aş gi nu ke ku ey it ce ği ap ko az ca ll be ld ze ab çi ık tü ml du rm ış şt ön yı rk rt um gö so iç ğı ah ağ ör nc mı ım ev tl eğ ok va yl çe za dü nm ec pa mu kı ci iğ ro he su tu üz nt ac hi lar ler eri arı ara bir ile lan nda rin ini ili nde esi bil ind ası ele ınd ama ını rın ala ola edi anı den dir ere sin nla eli nin eti dan len alı lma eni tir lir aya rak ine yor anl sın kle nın lik yap uru kla içi iri mas kar mek iye ede rle ekt ana ıla eme çin mak eği ste onu ulu ver ist ilm ada ard iği tan ril ayı man ter rek lam unu ece mal ığı rla gör isi ına eki tar ula kon baş lla mes tür imi gel emi oru lme ene ekl kte ger son abi ari ndi kur ılı kan dil adı der end erl dır öne iyo bul olu yan lem üze lık yar tim erd kul atı aca ren cak ali ken san rma nle aki rum ret irl akt lgi sta ikl tır niz yle tem ilg nun şti kta rme tle apı nma mle kal yet yon ted and ull değ min eye miş rde mad olm bel arl aha üre akl şma rda ağı art yıl rul yer lin mel tek ağl kil uğu ras işi ürk nce und ğin mla yen lay ldu ild tme may say ran diğ pla pro old hal rke met gün net azı lun yük miz dur lış kli çal nel tes tur etm ımı ldi her aşa ebi let ılm işt ite ell enl aşı cek dah etl iti izi men gil yla raf anm irm ğın kat ürü lle nan kad rta yön öre zer eml tal şle rli rar ısı mış asa dar çok tel şla rdi ıkl idi sür aka ort gen amı zel ers sun pıl örü ğer iya ştı dak laş nem liğ tla kay ram una",
+	"ts": "a i n e k l u h o w s t m y r v b g d x f z p c j ú q ã ç é ü š è ô á ä ó ō ʃ na ka an ku la le wa sw va ng hi ti in ya el ri wi ha en ak nd ma yi is ni lo ik on ek mb xi ta ko ga dz si al rh sa am mi iw un es ts um we hu av hl il wu ul at er ne ar he vu ho ir it be ke ah nt ok ye im mu wo kw em dl ut as et yo fu uk xa aw ra nh me nk ny by te ba fa ih om su lu ki vo sh se iv pf tl ay zi ur bi gu bu ot kh uv ve so li to no ey iy ge vi go us dy ow za lh mo ex ro af ns uh ab ol zh uy or ch zo fi op zu fr ov mp nw ux lw ip ph swi nga ndz ela eka ana aka ona iwa esw swa ele les aku isa eri ani ang mbe isi ndl ile eni rhi wak ava amb win irh wan ala and hla oko ika end ula lok xik han lan ing van tsh tan ung ati swo lel arh emb dzi pfu ngu hin ong awu ale tir elo iku ena aha ume umb siw tin kwa lah riw eke yen ley kum nts mba kwe fan wen ari hum ina won mbu lav ler kar eyi ham mbi dla mat iko law hel ink kut nge ane ikw ngo ris uva asw mel kon yin min nel kuv von nhl nya ine ene bya ama kel eti hik tlh uri yan tik kul low ulu ahl yis nhu rha nen hak exi uta tim gan uka dzh dza rik und kam his ini lek wal nkw zha dle ind owu asi lam wem nyi tsa iva hle kan iti hun nka dzo iri uma let man fum lak omb ila mal hen hlo yon nas kho dzu iso rin ise mis eki iha byi any wil sha dya kom iki anh iyi mil tal ota uku afr fri ete kun lhe int nti yik fun nak ayi awa its vul xin sak una ita dze uti iny lay alo tsw haw yim ata ats wit eng tek iwe len sun lex tso rho imb nsi sis avi lem kus olo suk ihl amu tiv ema zon tih ike ima son kot imi nis tiy rhu ake gul mak opf uya yes tel iwi esu ule ngi ond ngh ano xak vut ave vek nan tis usu xit fik mah mun oni nwa wih eta kah sel gop kuy har yak oyi ban loy fam huk bis yel ril",
+	"uk": "о а н и і в р т е с к у д л п м з я ь г б ч й ц х ї ж ю ш є щ ф ґ ы э ъ ё ѕ ў ј на ро ст ов ра ко ти пр но по ни ер ен та ва ні ан ві го за ви ал ре ор ом ог ід то ли ат ри не ів до од ав ть во ос ня ка нн ла ці ол ль он ло ки ьк ит ар ін мо сь ма де рі об тр ми лі мі кр ся им ин ас да их ад ку ив іс пе ис що те ак ик ам ди ті кі ий ле ту іл ок як бу му ій ув пі дн вн ук ої ну от ме ді ве ля чи ча га оз нт іт сп ру ил же сі ії це бо па ут аї ьн їн ем аз че вс ду ек ед зн оп ес ет ож чн нс ає ез ба нк сл се ул ац бі ше ьс су ого про ння від ськ ати ере кра ува ста пер енн при льн раї іст аїн анн ому укр іль ови ост ько ові оло ван пов них аль ова али пра тьс ься ент ник ити ово ють роз кон ком аці так під ног ьки ний рав одн нов тор тан оро стр ват вал рок роб сто лов сті пре тра ків ред ами ден зна мен лен аст міс оди ист ції ані мін час ися ков сти ної нсь она род буд рез вер кол ніс рів але рос тер мож пол ном алі сть вни дом тис ним или ьог лас ано олі нал кий ако гол віт оку дно том ори ког рим ров дер біл лад овн ими бул три ага ств ода вор ьно обл пор дні орі ала пос ичн ика ают для ідо вно под ідн рад лив тат чер чно бор тим дов ран дин мов сво кої кла тав тів оли чен ьни ить їни рат ійн енк шен нів спо оді ада сту ічн літ пар ких опо арт трі зак єть ідп уть нач вон жен рес ром він год кор лос ері рац ини тво тов авн вин нко які ома ико ара осі сер ато сві раз обо рем рит ерн спр дже нас аро кри яки іні ина всь нар ила одо зав іти ькі ало льш тив ива ені льк рот ади ики ень отр лис ерж дан омі они іон йсь цій най сам чни рив івн ани чин анд люд нос ект тич ита ове над тур оно нав ено ави важ тро сте тал ора нні без каз ход роп їнс уло йог вла нці цьо ову оби екс емо вої асн нен аме гра ній ска",
+	"ur": "ا ی ر ک و ن م ے ہ ل س ت د ب ں پ ج ئ گ ف ع ٹ ش ق ھ ز ح خ چ ص ڈ آ ط ظ ڑ ض غ ذ ث ء ان یں می کی کے ار ور ری یا نے کا ال کر را ائ کو وا لی لا وں ام او سے کہ ہے ہو ئی اس ہی ین رو ما ات ای پر دی تا ئے جا با نی یک اک اد یر نا نہ دا ہا سی اہ اب ست دو سا مل پا یل یو رد تی بھ اف ون رہ ٹی گی ھی زی یم رن یس مت من رک کس ول رت پو لے ند اع عل نو ھا تھ ٹر گر پی ید یش اق نگ مو وم بر وز بی در قا جو وی از کم شن لو یٹ نٹ فر سل سر گا خا تو پن بل مر تر دہ رٹ یت فی کھ وئ اپ زا ہم لہ یہ عا چی شا لک قی اج حک فا لئ بن ہر رم سٹ ڈی طا تع شر مد تے سک گئ میں اور ہیں ائی تان ستا انہ پاک اری بھی وال کہا نہی کار کست ران کیا اکس لئے ہوں ردو کرن زیر ایک انی ائے وزی دار جائ الی گیا یشن وائ رنے دیا نہو امی کیل مان ارت یوں ائن انے اپن ولی علی ہوئ یلئ ورٹ بار کوم رین کرا فرا کام حکو ومت الے ملک ریک است روں میر وری پور خان مار سال علا رائ جان ایا ارو طاب باد نیا تھا یرا ردی گئی جار لیس لام آئی انو اتھ وئی بھا انت کرد وئے ابق خلا لاف سائ لاق پول ہون نوں کری دور مطا رہے راد روا لان تار کرت رٹی سات ھار ادا رمی حمد اسل ٹری خوا وام لیا ریف ئنٹ گئے کمی سلا اہم ہور مال پری ہوگ افر پوا اعل یار مرا بات لیک مین سین فیص صوب سند ارک بنا راہ تعل بعد ورا اعت پار ئیں وان یاد الا ایس آبا کوئ ونے میا قوم اما کور عظم محم وار عوا کیو اتی لائ نجا ادی شری انا ارہ اعظ کٹر دنی ملا رتے رہا مات یاں گرد راچ اچی وجو اہو تما راع دال ارٹ زار لیے ردا انس ائر نام معا رفت نوا ندھ پنے پنی ایم یات حال روپ ہشت فتا دہش اکہ رہی قات ارا امن کان ریں ونی لیم روف اکی سکت لوں جاب پنج شمی لاس رتی پرو لات ینی یان مام یڈی عدا توں لاک برا سیک واز نیو رکھ تری امل جلا لاہ بڑی ارے پیش حکم غیر اہر دین کشم یون رات یلی سیا یصل ماع ومی ائل یاس دری ہار گرف ورت تیا ہائ شاہ اجل اند میٹ خدم یور جوا چاہ مری وبا الت داد تھی حری مبر امر ریش نما روز جود شہر بہت پان مقا مصر علق اسی جما مسل یری تھے عام",
+	"vi": "n h t c i g a u đ m o à r v l ư p á b y k s ô ế ạ ệ d ộ ả ê ó ớ ố ấ e ờ ề ủ q ợ ng nh th ch tr hi kh ph an ôn iệ gi ên ác àn và qu ho hư on uy là ườ hà iế cá ới hu củ ượ ủa há ại có in ời ha hô ti vi đư ất ến iê ơn ện ìn ro án ản iề ướ ra ết ợc ày ột gh ăn đã mộ đi ều gư ia ay hữ ua vớ ần hí ai rư ào ờn ớc ải ạn iể na ữn ội ốn cô nà ươ ôi hấ uố nă bi hả am ao ki họ độ ăm ầu òn ồn ức oà hứ sa hủ ực ận tạ ài hậ hế ệt hì ín ền hú tu đó tô un ối gà ri để ũn đế he ộn hị từ cũ át về au số cả đạ lạ oạ ba àm tư ọc ốc li ca áo hơ hó ông của ong các hôn ình ược ron tro hiệ đượ iên hàn một khô anh ngh inh ười ngư cho iện ành gườ với iều việ ước ườn như ờng trư ững nhi hữn nhữ ươn ơng ang gia qua iến côn àng khi hiế năm này ồng iệt ũng ính ngà ống chi đến hiề chí ung thu phá ăng huy ộng cũn hải ách tiế tôi thi chu oàn giá nhà vào iết làm phả khá nướ ùng thà tha ưng tại trê áng rên hươ thư ghi hất gày lại thể đồn chỉ giả tra hai đầu iệc học hín nam hưn uốc tri ảng nhậ quy the hức còn hiê rườ uan độn hội iệu heo ngu úng hác tru con thá chứ thế ằng quố iểm hát sau uộc ịnh tuy chú biế ượn ịch hơn thì ợng hán òng yên sin địn nhấ guy thô kho thứ hìn han điề ánh ngo uyế iền chư mới thờ ởng ưởn iệp uyê iển thủ thự hời đan quả óng hực rướ tin ảnh đại hận tiê ích nay rất ran viê thấ uất yền uyề uyệ chủ ứng uốn hún trì ạnh thị lên hoạ kin điể run mìn hợp hốn đối oại văn iếp lượ sản cuộ ọng cùn ừng cao nói iểu hoa vẫn tăn rìn ổng hay tác xuấ phi kết iải nội iêu yện oan hoà nên iệm ắng khu mạn tiề phư báo tìn yết nha cầu đườ iễn hần bản chấ oài nào bạn nga tổn hưa uổi ụng ian chị hoả ỉnh giớ iới đán cần giữ háp toà ướn ạng tới hườ ớng ham riể yển uyể hấy oản phụ lần mặt uật ban biể chế lớn hết quá liê khó cấp hật tín goà bìn",
+	"xh": "a e i n u o k l h s b w m z t y g d p c f q r x v j é â ã à á ê ù è ë ú ç í ñ š ku ng an el ba la in zi en le wa th is ha we al lo si un ma nd ok kw uk li ab na ka ak ub se am ga ni ye on ph um ya ul nt ko ek he yo hu ne be ez kh hi ho es ik lu ny sh ay za at lw go ge il ol em sa az nz it fu ze ts ke di ut bo as im iz eb no hl yi om mb et nk wi mi ib zo ap da ey dl bi so me bu us iy aw ii ki uz wo iw bh ty uy xa do nj to af de ce ob ip if gu up uf ti sw uq qa gq va tu ee ca nc ci mn qi ic ad qu os wu fa ac ot hw te mv je mp nga uku oku kub aba uba ele ela nge ngo ama ezi thi ath ala kwa ang enz lel ise fun kwe nye pha kwi ing eli esi ban ndi tsh aph lwa the tha ith eth kun uth and akh nzi aka ini ana und ali ung isa wen isi eni elo azi elw kho ebe zin ile eyo gok izi ulu lwe olo aku ant hla eng nda int ben sha man thu uma han seb ane ayo lis nya wan nts ase eka any iko phe hel sel kul kol hul ndl ona khu lal ula kut nto kan uph ind ent kuk ndo gen alo lan zik uny eki ale ani lun aye kum ntu lek isw ley oko hal iya zis kuf ule bon ayi ngu iin onk hat nke amb iph ika iso way dla ong mbi phu ume shi imi esh ukh ikh nok nis hon sik khe ufu yak kuz gam kus het ili kuq nde abo ulo nje end mal awu zel mba kel lok iny kha swa emi bha sin hub hum yen kuy use yok lin dle afu ham uts mel mfu bal pho sit uhl lul sho alu nan ifu awo nek eko yan ink nza wab sek gqi nel oni wak tho asi uya len kup ene eku lon iwe hin hil kok aza nze ekh ina ubo tsi bak iba waz wel eke iwa qal kil phi abe wul iza zwe its enk del baf zan wes uka bel yin aya ube olu ngc ihl ngi gab okw zit fum gan ahl ntl cel emv ngq gel kis oka men akw mhl ziy sis ond law win hwa nak dwa ima ibe bab wam une daw eza een jen umb gap kal mak nik bek zim",
+	"yo": "i n a o e l r t b s í k u à g d w j m ì p á y ó ọ è ò f é ú ẹ h c ù ṣ ń v z x q ni ti gb le an in ri il or wo un ar ba on ra ní je la at er el ka ko re al to lo si tí de te se bi àw ol is wọ pa so rí be ún mo os na aw bo lá ta ag di ed ak àn ir ro ọn as fi en am sí mi om lu pe ma ib bá jo ia ló it àt ik po da wa aa ru ju oj bí ad ìí bà ín me ay lé lè ok nu ab ig wó yì áà nd ye gu es ip jẹ og tó ùn kò ki ap ob yi ná lẹ li ai id sì ke st fu ji sa ha od ge op ij ur pé ìn lú ng nt kó át yà he tà ár ìl em ya nl us aj ja pi th dè ile ati gba won ele ori kan tel ara wọn awo agb ede rin àwo ril gbo ala àti gbà lat yìí náà are àwọ aar olo gbe ako igb ran eri ika fún fun orí ere gun èdè ose nin inu iri ria ibi ber gbó rik lát ari nín oni ínú omo run kos áti hun oko and elu oso aye omi oba oru mer ame ára gbé ogb yàn pin lak àgb ìyà máa ìgb eni dún apa ise oju ilé ohu ina sel ìlú iji ira rùn nri abi aso min alá ojo nìy owo tor ini bog inl eyi asi ogu nai tan yan aij oro lor ràn dun idi fin jul jir egb los ida ita the ani lan ser pop mar yor kun iti ènì olu tun awọ yin ipi kej oló eji nle tún san ojú ígb àbí nig lag gbá ilẹ tab aju ipa iya ada ulo tàb aka ana níg sin èyí isi ilè ade dar lór aki per gbè sil kok aba opo bal man odu par ila pel ans yìn ọmọ ato ion sis ent tàn ríl obi ọdú írí orù mbe gbò ope nsi uko ílè ípa ùbá órí rùb ito emb ilu pan joi uar ing ist ann ali gbọ lár ílẹ oib lar ary imo ùgb emi ang ibe bin tit ìjọ esi iwa sta ale níp ust ìtà niy oní àkó kùn soj ris àrù ter ant bon gom iye oku pín tin méj ero nti ill rúk wón ìkì bar pad nis pat ìwé ama era nit kor eré iko did ata opu ege nip apo áyé alu han ebu ijo gún ley ipo kin ind oun síl nít ura anu low pap bun ofi ona orú ban nka iki tio raj ìdí",
+	"zh": "的 一 國 是 在 人 中 有 不 大 年 為 會 了 上 出 和 以 這 日 他 到 個 時 來 對 十 民 發 要 成 家 地 政 生 也 現 行 後 多",
+	"zu": "a e i n u k l o h s m b z g w t y d p f c q j r v x é ë ü á ç è ò š à ä ì í ï ku ng an th la en el in le zi ba wa si uk ha hi is ka ma kh ab ni ok we al ak ga ul lo am na um he ho un ph se li nd be ez ut hu on es lu ek ne kw hl sh ik ye iz at go om sa ya az ge em ke ub za as im ny no il ay us mb nt nz ol yi yo lw mi dl ze ko zo bo eb bu da gi me nk ob et uz ap so ib it bi fu nj mu gu bh di ki de je wo aw fa tu uy ah zw uh os ip up iy od mp to mo iw ts ad if af ud ca su uf hw do sw uq ig id ot ns qo dw qa ti ih ey ic mn uv nga thi uku uth oku aba ela ngo ele ezi ath nge ama kut zin ing esi eni ang enz akh lel kho ulu pha izi ala aka kwa ngi ban the isi ini khu isa kwe wen ung eng aph hla kub nye and ane ben ikh eth kan kul azi kha hul ebe uma kus lwa nda eli kun nzi ise ndl ana lan ona thu ile tha ind hat han seb ani phe tho sik ith uba ule ume ula alo ase elo ant ali ngu eka lok ntu hel gen lal sha ayo nje lwe gok dla mel amb man ale nom elw uph hle any nya okh oba khe fun eke gan hon iph nde ush mbi zim ukh hol tsh kuz kuk uhl dle abe oma imi bon ham und sho ahl kel nok emi lul lun ika end nin lek yis abo yak iny wan aye int nza she aku mba gob sin pho okw onk eku ili kum zwe shi hin ube ayi nto nke hak wak zis nis waz mal eki siz phi nhl lis dwa isw lin nel ish use aza fan gam len ima hlo het sek gab kuh ese kup bal hum uka wab kuf nze jen bek sen kuy hal asi hen ola bas ndi gap wez sel uli odw kuq ash ihl olo ene hek uzo ike emb ong phu ges bhe yin zok awo eze fut lab kat uny nku wes mbu yok ubu sib ndo yel min nez eph lum swa uke kaz ena mun bha omb ekh ina anj bab wam mis iya uze izo mth iza way yen kod iwe hlu daw men sit ema lap ufa luk hay nam ink eza mbe ami hwa alu del bil lak dlu kil sis",
+}
@@ -96,6 +96,7 @@ func (m *Manager) PreProcessMedia(
 		Avatar:    util.Ptr(false),
 		Header:    util.Ptr(false),
 		Cached:    util.Ptr(false),
+		Sensitive: util.Ptr(false),
 	}
 
 	attachment.URL = uris.URIForAttachment(
@@ -157,6 +158,10 @@ func (m *Manager) PreProcessMedia(
 		if ai.FocusY != nil {
 			attachment.FileMeta.Focus.Y = *ai.FocusY
 		}
+
+		if ai.Sensitive != nil {
+			attachment.Sensitive = ai.Sensitive
+		}
 	}
 
 	processingMedia := &ProcessingMedia{
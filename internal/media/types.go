@@ -84,6 +84,8 @@ type AdditionalMediaInfo struct {
 	FocusX *float32
 	// Y focus coordinate for this media; defaults to 0.
 	FocusY *float32
+	// Mark this media as sensitive; defaults to false.
+	Sensitive *bool
 }
 
 // AdditionalEmojiInfo represents additional information
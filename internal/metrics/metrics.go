@@ -30,6 +30,7 @@ import (
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/extra/bunotel"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
@@ -124,6 +125,34 @@ func Initialize(db db.DB) error {
 		return err
 	}
 
+	_, err = meter.Int64ObservableGauge(
+		"gotosocial.http.throttle.in_flight",
+		metric.WithDescription("Number of requests currently being processed, per throttled route group"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			rangeThrottles(func(group string, stats throttleStats) {
+				o.Observe(stats.inFlight(), metric.WithAttributes(attribute.String("group", group)))
+			})
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableGauge(
+		"gotosocial.http.throttle.queued",
+		metric.WithDescription("Number of requests currently waiting in the backlog queue, per throttled route group"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			rangeThrottles(func(group string, stats throttleStats) {
+				o.Observe(stats.queued(), metric.WithAttributes(attribute.String("group", group)))
+			})
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package metrics
+
+import "sync"
+
+// throttleStats provides current request
+// counts of one throttling middleware.
+type throttleStats struct {
+	inFlight func() int64
+	queued   func() int64
+}
+
+var (
+	// throttles contains registered
+	// throttling middleware stats,
+	// keyed by route group name.
+	throttles   = make(map[string]throttleStats)
+	throttlesMu sync.Mutex
+)
+
+// RegisterThrottle registers in-flight and queued request
+// counters of the throttling middleware for the named route
+// group, to be exposed as gauges when metrics are enabled.
+// Registering the same group again replaces the counters.
+func RegisterThrottle(group string, inFlight, queued func() int64) {
+	throttlesMu.Lock()
+	throttles[group] = throttleStats{
+		inFlight: inFlight,
+		queued:   queued,
+	}
+	throttlesMu.Unlock()
+}
+
+// rangeThrottles calls fn for
+// each registered throttle.
+func rangeThrottles(fn func(group string, stats throttleStats)) {
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+	for group, stats := range throttles {
+		fn(group, stats)
+	}
+}
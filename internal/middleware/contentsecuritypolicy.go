@@ -18,15 +18,40 @@
 package middleware
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"strings"
 
 	"codeberg.org/gruf/go-debug"
 	"github.com/gin-gonic/gin"
 )
 
+// ContentSecurityPolicy returns a gin middleware which sets
+// the baseline Content-Security-Policy header on all responses.
 func ContentSecurityPolicy(extraURIs ...string) gin.HandlerFunc {
-	csp := BuildContentSecurityPolicy(extraURIs...)
+	return contentSecurityPolicy(BuildContentSecurityPolicy(extraURIs...))
+}
 
+// WebContentSecurityPolicy returns a gin middleware which sets
+// the stricter Content-Security-Policy header used by web UI
+// pages, overriding the baseline policy on those routes.
+//
+// mediaURIs are the origins media may be served from (eg., S3),
+// and extraSrc are any additional sources configured by the admin
+// for scripts, styles, fonts and connections (eg., font CDNs).
+func WebContentSecurityPolicy(mediaURIs []string, extraSrc []string) gin.HandlerFunc {
+	return contentSecurityPolicy(BuildWebContentSecurityPolicy(mediaURIs, extraSrc))
+}
+
+// CSPHashSource returns a CSP hash-source expression for
+// the given inline content, eg., "'sha256-[base64 hash]'",
+// suitable for passing as one of inlineStyleHashes.
+func CSPHashSource(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+}
+
+func contentSecurityPolicy(csp string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Inform the browser we only load
 		// CSS/JS/media using the given policy.
@@ -34,18 +59,56 @@ func ContentSecurityPolicy(extraURIs ...string) gin.HandlerFunc {
 	}
 }
 
-func BuildContentSecurityPolicy(extraURIs ...string) string {
-	const (
-		defaultSrc = "default-src"
-		objectSrc  = "object-src"
-		imgSrc     = "img-src"
-		mediaSrc   = "media-src"
-
-		self = "'self'"
-		none = "'none'"
-		blob = "blob:"
-	)
+const (
+	defaultSrc = "default-src"
+	objectSrc  = "object-src"
+	scriptSrc  = "script-src"
+	styleSrc   = "style-src"
+	fontSrc    = "font-src"
+	imgSrc     = "img-src"
+	mediaSrc   = "media-src"
+	connectSrc = "connect-src"
+	requireSRI = "require-sri-for"
+
+	cspSelf = "'self'"
+	cspNone = "'none'"
+	cspBlob = "blob:"
+)
+
+// selfSources returns the sources considered
+// to be the instance itself, which includes
+// localhost when running in debug mode.
+func selfSources() []string {
+	if !debug.DEBUG {
+		// Restrictive 'self' policy
+		return []string{cspSelf}
+	}
+
+	// If debug is enabled, allow
+	// serving things from localhost
+	// as well (regardless of port).
+	return []string{
+		cspSelf,
+		"localhost:*",
+		"ws://localhost:*",
+	}
+}
 
+// concat returns a new slice
+// containing all given slices.
+func concat(slices ...[]string) []string {
+	var n int
+	for _, s := range slices {
+		n += len(s)
+	}
+	out := make([]string, 0, n)
+	for _, s := range slices {
+		out = append(out, s...)
+	}
+	return out
+}
+
+func BuildContentSecurityPolicy(extraURIs ...string) string {
 	// CSP values keyed by directive.
 	values := make(map[string][]string, 4)
 
@@ -54,19 +117,7 @@ func BuildContentSecurityPolicy(extraURIs ...string) string {
 		https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy/default-src
 	*/
 
-	if !debug.DEBUG {
-		// Restrictive 'self' policy
-		values[defaultSrc] = []string{self}
-	} else {
-		// If debug is enabled, allow
-		// serving things from localhost
-		// as well (regardless of port).
-		values[defaultSrc] = []string{
-			self,
-			"localhost:*",
-			"ws://localhost:*",
-		}
-	}
+	values[defaultSrc] = selfSources()
 
 	/*
 		object-src
@@ -74,7 +125,7 @@ func BuildContentSecurityPolicy(extraURIs ...string) string {
 	*/
 
 	// Disallow object-src as recommended.
-	values[objectSrc] = []string{none}
+	values[objectSrc] = []string{cspNone}
 
 	/*
 		img-src
@@ -85,9 +136,9 @@ func BuildContentSecurityPolicy(extraURIs ...string) string {
 	// include extraURIs, and 'blob:'
 	// for previewing uploaded images
 	// (header, avi, emojis) in settings.
-	values[imgSrc] = append(
-		[]string{self, blob},
-		extraURIs...,
+	values[imgSrc] = concat(
+		[]string{cspSelf, cspBlob},
+		extraURIs,
 	)
 
 	/*
@@ -97,25 +148,118 @@ func BuildContentSecurityPolicy(extraURIs ...string) string {
 
 	// Restrictive 'self' policy,
 	// include extraURIs.
-	values[mediaSrc] = append(
-		[]string{self},
-		extraURIs...,
+	values[mediaSrc] = concat(
+		[]string{cspSelf},
+		extraURIs,
+	)
+
+	return assemblePolicy(values, []string{
+		defaultSrc,
+		objectSrc,
+		imgSrc,
+		mediaSrc,
+	})
+}
+
+// BuildWebContentSecurityPolicy builds the Content-Security-Policy
+// for web UI pages. Unlike the baseline policy, this explicitly
+// restricts script-src, style-src, font-src and connect-src, so
+// that extraSrc can be allowed for these without loosening default-src.
+//
+// Outside of debug mode, scripts and stylesheets are additionally
+// required to carry Subresource Integrity hashes.
+//
+// inlineStyleHashes are hash-sources of any inline <style> elements
+// rendered in the page (eg., instance custom CSS), see CSPHashSource.
+func BuildWebContentSecurityPolicy(mediaURIs []string, extraSrc []string, inlineStyleHashes ...string) string {
+	// CSP values keyed by directive.
+	values := make(map[string][]string, 9)
+
+	// Sources for the instance itself.
+	self := selfSources()
+
+	// Instance plus admin configured sources,
+	// used for everything the web UI loads
+	// outside of images and media.
+	selfExtra := concat(self, extraSrc)
+
+	values[defaultSrc] = self
+	values[objectSrc] = []string{cspNone}
+
+	/*
+		script-src, style-src, font-src
+		https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy/script-src
+		https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy/style-src
+		https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy/font-src
+	*/
+
+	values[scriptSrc] = selfExtra
+	values[styleSrc] = concat(selfExtra, inlineStyleHashes)
+	values[fontSrc] = selfExtra
+
+	/*
+		img-src, media-src
+	*/
+
+	// As with the baseline policy, include
+	// 'blob:' for previewing uploaded images.
+	values[imgSrc] = concat(
+		[]string{cspSelf, cspBlob},
+		mediaURIs,
+		extraSrc,
+	)
+	values[mediaSrc] = concat(
+		[]string{cspSelf},
+		mediaURIs,
 	)
 
 	/*
-		Assemble policy directives.
+		connect-src
+		https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy/connect-src
 	*/
 
-	// Iterate through an ordered slice rather than
-	// iterating through the map, since we want these
-	// policyDirectives in a determinate order.
-	policyDirectives := make([]string, 4)
-	for i, directive := range []string{
+	// Settings panel fetches from the
+	// API, and may fetch media directly.
+	values[connectSrc] = concat(
+		selfExtra,
+		mediaURIs,
+	)
+
+	directives := []string{
 		defaultSrc,
 		objectSrc,
+		scriptSrc,
+		styleSrc,
+		fontSrc,
 		imgSrc,
 		mediaSrc,
-	} {
+		connectSrc,
+	}
+
+	/*
+		require-sri-for
+		https://w3c.github.io/webappsec-subresource-integrity/#opt-in-require-sri-for
+	*/
+
+	// Web templates set integrity on all scripts
+	// and stylesheets. Skip this in debug mode, as
+	// the dev server may inject its own scripts.
+	if !debug.DEBUG {
+		values[requireSRI] = []string{"script", "style"}
+		directives = append(directives, requireSRI)
+	}
+
+	return assemblePolicy(values, directives)
+}
+
+// assemblePolicy joins the given directive values
+// into a policy string, in the order of directives.
+func assemblePolicy(values map[string][]string, directives []string) string {
+	// Iterate through an ordered slice rather than
+	// iterating through the map, since we want these
+	// policyDirectives in a determinate order.
+	policyDirectives := make([]string, len(directives))
+	for i, directive := range directives {
 		// Each policy directive should look like:
 		// `[directive] [value1] [value2] [etc]`
 
@@ -73,3 +73,58 @@ func TestBuildContentSecurityPolicy(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildWebContentSecurityPolicy(t *testing.T) {
+	type cspTest struct {
+		mediaURIs   []string
+		extraSrc    []string
+		styleHashes []string
+		expected    string
+	}
+
+	for _, test := range []cspTest{
+		{
+			mediaURIs: nil,
+			extraSrc:  nil,
+			expected:  "default-src 'self'; object-src 'none'; script-src 'self'; style-src 'self'; font-src 'self'; img-src 'self' blob:; media-src 'self'; connect-src 'self'; require-sri-for script style",
+		},
+		{
+			mediaURIs: []string{
+				"https://some-bucket-provider.com",
+			},
+			extraSrc: nil,
+			expected: "default-src 'self'; object-src 'none'; script-src 'self'; style-src 'self'; font-src 'self'; img-src 'self' blob: https://some-bucket-provider.com; media-src 'self' https://some-bucket-provider.com; connect-src 'self' https://some-bucket-provider.com; require-sri-for script style",
+		},
+		{
+			mediaURIs: nil,
+			extraSrc: []string{
+				"https://fonts.example.org",
+			},
+			expected: "default-src 'self'; object-src 'none'; script-src 'self' https://fonts.example.org; style-src 'self' https://fonts.example.org; font-src 'self' https://fonts.example.org; img-src 'self' blob: https://fonts.example.org; media-src 'self'; connect-src 'self' https://fonts.example.org; require-sri-for script style",
+		},
+		{
+			mediaURIs: []string{
+				"https://s3.nl-ams.scw.cloud",
+			},
+			extraSrc: []string{
+				"https://fonts.example.org",
+				"cdn.example.org",
+			},
+			expected: "default-src 'self'; object-src 'none'; script-src 'self' https://fonts.example.org cdn.example.org; style-src 'self' https://fonts.example.org cdn.example.org; font-src 'self' https://fonts.example.org cdn.example.org; img-src 'self' blob: https://s3.nl-ams.scw.cloud https://fonts.example.org cdn.example.org; media-src 'self' https://s3.nl-ams.scw.cloud; connect-src 'self' https://fonts.example.org cdn.example.org https://s3.nl-ams.scw.cloud; require-sri-for script style",
+		},
+		{
+			mediaURIs: nil,
+			extraSrc:  nil,
+			styleHashes: []string{
+				middleware.CSPHashSource("body { background: hotpink; }"),
+			},
+			expected: "default-src 'self'; object-src 'none'; script-src 'self'; style-src 'self' 'sha256-enSf3HWGLmSK+bQUra/DM6DrbBU/cPGCn4nm3nPIXAw='; font-src 'self'; img-src 'self' blob:; media-src 'self'; connect-src 'self'; require-sri-for script style",
+		},
+	} {
+		csp := middleware.BuildWebContentSecurityPolicy(test.mediaURIs, test.extraSrc, test.styleHashes...)
+		if csp != test.expected {
+			t.Logf("expected '%s', got '%s'", test.expected, csp)
+			t.Fail()
+		}
+	}
+}
@@ -18,19 +18,22 @@
 package middleware
 
 import (
+	"slices"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
 
 // CORS returns a new gin middleware which allows CORS requests to be processed.
 // This is necessary in order for web/browser-based clients like Semaphore to work.
+//
+// Allowed origins are taken from api-cors-allowed-origins. If these are limited
+// to specific (trusted) origins, credentials are also allowed, so that frontends
+// relying on session cookies can make requests from those origins.
 func CORS() gin.HandlerFunc {
 	cfg := cors.Config{
-		// todo: use config to customize this
-		AllowAllOrigins: true,
-
 		// adds the following:
 		// 	"chrome-extension://"
 		// 	"safari-extension://"
@@ -78,6 +81,9 @@ func CORS() gin.HandlerFunc {
 			"X-RateLimit-Remaining",
 			"X-Request-Id",
 
+			// needed so clients can read notes on paged lists
+			"X-Note",
+
 			// websocket stuff
 			"Connection",
 			"Sec-WebSocket-Accept",
@@ -86,5 +92,16 @@ func CORS() gin.HandlerFunc {
 		MaxAge: 2 * time.Minute,
 	}
 
+	origins := config.GetAPICORSAllowedOrigins()
+	if len(origins) == 0 || slices.Contains(origins, "*") {
+		// Browsers refuse credentials
+		// for requests allowed by "*".
+		cfg.AllowAllOrigins = true
+	} else {
+		cfg.AllowOrigins = origins
+		cfg.AllowWildcard = true
+		cfg.AllowCredentials = true
+	}
+
 	return cors.New(cfg)
 }
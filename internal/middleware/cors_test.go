@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+func TestCORS(t *testing.T) {
+	defer config.SetAPICORSAllowedOrigins([]string{"*"})
+
+	type corsTest struct {
+		allowed     []string
+		origin      string
+		expectAllow string
+		expectCreds string
+	}
+
+	for _, test := range []corsTest{
+		{
+			// Default, anything goes but without credentials.
+			allowed:     []string{"*"},
+			origin:      "https://frontend.example.org",
+			expectAllow: "*",
+			expectCreds: "",
+		},
+		{
+			// Trusted origin, credentials allowed.
+			allowed:     []string{"https://frontend.example.org"},
+			origin:      "https://frontend.example.org",
+			expectAllow: "https://frontend.example.org",
+			expectCreds: "true",
+		},
+		{
+			// Trusted origin matched by wildcard pattern.
+			allowed:     []string{"https://*.example.org"},
+			origin:      "https://frontend.example.org",
+			expectAllow: "https://frontend.example.org",
+			expectCreds: "true",
+		},
+		{
+			// Untrusted origin.
+			allowed:     []string{"https://frontend.example.org"},
+			origin:      "https://evil.example.org",
+			expectAllow: "",
+			expectCreds: "",
+		},
+	} {
+		config.SetAPICORSAllowedOrigins(test.allowed)
+
+		engine := gin.New()
+		engine.Use(middleware.CORS())
+		engine.GET("/api/v1/instance", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		r := httptest.NewRequest(http.MethodGet, "http://localhost:8080/api/v1/instance", nil)
+		r.Header.Set("Origin", test.origin)
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, r)
+
+		if allow := rec.Header().Get("Access-Control-Allow-Origin"); allow != test.expectAllow {
+			t.Errorf("origin %s with %v: expected allow origin '%s', got '%s'", test.origin, test.allowed, test.expectAllow, allow)
+		}
+
+		if creds := rec.Header().Get("Access-Control-Allow-Credentials"); creds != test.expectCreds {
+			t.Errorf("origin %s with %v: expected allow credentials '%s', got '%s'", test.origin, test.allowed, test.expectCreds, creds)
+		}
+	}
+}
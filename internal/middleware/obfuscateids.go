@@ -0,0 +1,163 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+const (
+	// maxObfuscateMemory is the max memory used when parsing
+	// multipart forms to deobfuscate IDs, same as gin's default.
+	maxObfuscateMemory = 32 << 20
+
+	// maxObfuscateBodySize is the max size of JSON
+	// and URL-encoded form bodies read to deobfuscate IDs.
+	maxObfuscateBodySize = int64(bytesize.MiB)
+)
+
+// ObfuscateIDs returns a new gin middleware which, if instance-obfuscate-ids
+// is enabled, maps obfuscated IDs in request path params, query params and
+// form / JSON request bodies back to internal IDs before they reach handlers.
+//
+// It also flags the request context so that IDs in the JSON response,
+// and in the Link header for paging, are obfuscated on the way out.
+// See apiutil.ObfuscateID() for how IDs are obfuscated.
+//
+// This must come after TokenCheck(), as request bodies are only read
+// (up to a limit) for authorized requests, so that anonymous callers
+// can't make us buffer arbitrarily large bodies.
+func ObfuscateIDs() gin.HandlerFunc {
+	if !config.GetInstanceObfuscateIDs() {
+		// Not enabled, return
+		// empty/stub function.
+		return func(c *gin.Context) {}
+	}
+
+	return func(c *gin.Context) {
+		// Deobfuscate path params, e.g. /api/v1/statuses/:id.
+		for i, param := range c.Params {
+			if apiutil.IsIDKey(param.Key) {
+				c.Params[i].Value = apiutil.DeobfuscateID(param.Value)
+			}
+		}
+
+		// Deobfuscate request body, e.g. in_reply_to_id.
+		//
+		// This must happen before deobfuscating the query,
+		// as parsed forms include (obfuscated) query values.
+		if _, ok := c.Get(oauth.SessionAuthorizedToken); ok {
+			if err := deobfuscateBody(c.Request); err != nil {
+				code := http.StatusBadRequest
+				text := "Bad Request: could not parse request body"
+				if errors.As(err, new(*http.MaxBytesError)) {
+					code = http.StatusRequestEntityTooLarge
+					text = "Request Entity Too Large: request body too large"
+				}
+
+				apiutil.JSON(c, code, map[string]string{"error": text})
+				_ = c.Error(err)
+				c.Abort()
+				return
+			}
+		}
+
+		// Deobfuscate query params, e.g. ?max_id=
+		if c.Request.URL.RawQuery != "" {
+			query := c.Request.URL.Query()
+			apiutil.DeobfuscateIDsValues(query)
+			c.Request.URL.RawQuery = query.Encode()
+		}
+
+		// Flag IDs for obfuscation in the response.
+		ctx := gtscontext.SetObfuscateIDs(c.Request.Context())
+		c.Request = c.Request.WithContext(ctx)
+	}
+}
+
+// deobfuscateBody deobfuscates IDs in the
+// JSON or form body of the given request.
+func deobfuscateBody(r *http.Request) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	ct := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(ct, "application/json"):
+		r.Body = http.MaxBytesReader(nil, r.Body, maxObfuscateBodySize)
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return gtserror.Newf("error reading body: %w", err)
+		}
+		_ = r.Body.Close()
+
+		if dec, err := apiutil.DeobfuscateIDsJSON(b); err == nil {
+			b = dec
+		} // else leave it to the handler to report bad JSON.
+
+		r.Body = io.NopCloser(bytes.NewReader(b))
+		r.ContentLength = int64(len(b))
+
+	case strings.HasPrefix(ct, "multipart/form-data"):
+		// Parse the form now, so later form binding
+		// by handlers uses the already parsed values.
+		r.Body = http.MaxBytesReader(nil, r.Body, maxMultipartSize())
+		if err := r.ParseMultipartForm(maxObfuscateMemory); err != nil {
+			return gtserror.Newf("error parsing multipart form: %w", err)
+		}
+		apiutil.DeobfuscateIDsValues(r.MultipartForm.Value)
+		apiutil.DeobfuscateIDsValues(r.PostForm)
+		apiutil.DeobfuscateIDsValues(r.Form)
+
+	case strings.HasPrefix(ct, "application/x-www-form-urlencoded"):
+		// As above.
+		r.Body = http.MaxBytesReader(nil, r.Body, maxObfuscateBodySize)
+		if err := r.ParseForm(); err != nil {
+			return gtserror.Newf("error parsing form: %w", err)
+		}
+		apiutil.DeobfuscateIDsValues(r.PostForm)
+		apiutil.DeobfuscateIDsValues(r.Form)
+	}
+
+	return nil
+}
+
+// maxMultipartSize returns the max size of multipart form
+// bodies read to deobfuscate IDs, i.e. the largest allowed
+// media upload, with some headroom for other form fields.
+func maxMultipartSize() int64 {
+	size := max(
+		config.GetMediaImageMaxSize(),
+		config.GetMediaVideoMaxSize(),
+		config.GetMediaEmojiLocalMaxSize(),
+	)
+	return int64(size + bytesize.MiB)
+}
@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4/models"
+)
+
+func TestObfuscateIDsMiddleware(t *testing.T) {
+	config.SetInstanceObfuscateIDs(true)
+	config.SetInstanceObfuscateIDsSecret("some secret")
+	defer config.SetInstanceObfuscateIDs(false)
+
+	var (
+		statusID    = "01F8MH75CBF9JFX4ZAD54N0W0R"
+		replyToID   = "01F8MHAMCHF6Y650WCRSCP4WMY"
+		maxID       = "01F8MH1H7YV1Z7D2C8K2730QBF"
+		obfStatusID = apiutil.ObfuscateID(statusID)
+	)
+
+	// Gin test http engine
+	// (used for ctx init).
+	e := gin.New()
+	e.Use(authorize, middleware.ObfuscateIDs())
+
+	// Handler checks it was given internal IDs,
+	// and responds with them to be obfuscated.
+	e.Handle("POST", "/api/v1/statuses/:id", func(c *gin.Context) {
+		if id := c.Param(apiutil.IDKey); id != statusID {
+			t.Errorf("expected path id %s, got %s", statusID, id)
+		}
+
+		if id := c.Query(apiutil.MaxIDKey); id != maxID {
+			t.Errorf("expected max_id %s, got %s", maxID, id)
+		}
+
+		if id := c.PostForm("in_reply_to_id"); id != replyToID {
+			t.Errorf("expected in_reply_to_id %s, got %s", replyToID, id)
+		}
+
+		c.Header("Link", `<https://example.org/api/v1/statuses/`+statusID+`/favourited_by?max_id=`+maxID+`>; rel="next"`)
+		apiutil.JSON(c, http.StatusOK, map[string]string{"id": statusID})
+	})
+
+	// Prepare request with obfuscated IDs.
+	form := url.Values{"in_reply_to_id": {apiutil.ObfuscateID(replyToID)}}
+	r := httptest.NewRequest("POST",
+		"/api/v1/statuses/"+obfStatusID+"?max_id="+apiutil.ObfuscateID(maxID),
+		strings.NewReader(form.Encode()),
+	)
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rw := httptest.NewRecorder()
+
+	// Pass req through
+	// engine handler.
+	e.ServeHTTP(rw, r)
+
+	// Get http result.
+	res := rw.Result()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := `{"id":"` + obfStatusID + `"}`; string(b) != expect {
+		t.Fatalf("expected body %s, got %s", expect, b)
+	}
+
+	expectLink := `<https://example.org/api/v1/statuses/` + obfStatusID + `/favourited_by?max_id=` + apiutil.ObfuscateID(maxID) + `>; rel="next"`
+	if link := res.Header.Get("Link"); link != expectLink {
+		t.Fatalf("expected link %s, got %s", expectLink, link)
+	}
+}
+
+func TestObfuscateIDsMiddlewareBodyLimit(t *testing.T) {
+	config.SetInstanceObfuscateIDs(true)
+	config.SetInstanceObfuscateIDsSecret("some secret")
+	defer config.SetInstanceObfuscateIDs(false)
+
+	var called bool
+
+	e := gin.New()
+	e.Use(authorize, middleware.ObfuscateIDs())
+	e.Handle("POST", "/api/v1/statuses", func(c *gin.Context) {
+		called = true
+	})
+
+	// Oversized JSON body should be rejected
+	// before ever reaching the handler.
+	body := `{"status":"` + strings.Repeat("a", 2<<20) + `"}`
+	r := httptest.NewRequest("POST", "/api/v1/statuses", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	rw := httptest.NewRecorder()
+	e.ServeHTTP(rw, r)
+
+	if rw.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, rw.Code)
+	}
+
+	if called {
+		t.Fatal("expected handler not to be called")
+	}
+}
+
+// authorize marks requests as authorized,
+// as TokenCheck() would for a valid token.
+func authorize(c *gin.Context) {
+	c.Set(oauth.SessionAuthorizedToken, &models.Token{})
+}
@@ -29,12 +29,26 @@ import (
 	"net/http"
 	"runtime"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+)
+
+const (
+	// maxBacklogWait is the longest a request will wait
+	// for room in a full backlog queue before it's rejected,
+	// so that brief spikes don't cause visible errors.
+	maxBacklogWait = 200 * time.Millisecond
+
+	// latencyWindowSize is the number of recent
+	// request latencies kept for estimating how
+	// long it'll take for the backlog to clear.
+	latencyWindowSize = 64
 )
 
 // token represents a request that is being processed.
@@ -61,33 +75,40 @@ type token struct{}
 //
 // Callers will first attempt to get a backlog token. Once they have that, they will
 // wait in the backlog queue until they can get a token to allow their request to be
-// processed.
+// processed. If the backlog queue is full, callers will wait up to 200ms for room in
+// the queue, to smooth over brief spikes in traffic.
 //
-// If the backlog queue is full, the request context is closed, or the caller has been
-// waiting in the backlog for too long, this function will abort the request chain,
-// write a JSON error into the response, set an appropriate Retry-After value, and set
-// the HTTP response code to 503: Service Unavailable.
+// If the backlog queue stays full, or the request context is closed, this function will
+// abort the request chain, write a JSON error into the response, set an appropriate
+// Retry-After value, and set the HTTP response code to 503: Service Unavailable.
 //
-// If the multiplier is <= 0, a noop middleware will be returned instead.
+// The Retry-After value is estimated from the current depth of the backlog queue and
+// the mean latency of recent requests, ie., roughly how long it will take for the queue
+// to clear. It is at least 1 second, and at most the given retryAfter. Until latency of
+// any requests is known, retryAfter is used.
 //
-// RetryAfter determines the Retry-After header value to be sent to throttled requests.
+// Current in-flight and queued request counts are registered with the metrics
+// package under the given route group name.
+//
+// If the multiplier is <= 0, a noop middleware will be returned instead.
 //
 // Useful links:
 //
 //   - https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After
 //   - https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/503
-func Throttle(cpuMultiplier int, retryAfter time.Duration) gin.HandlerFunc {
+func Throttle(group string, cpuMultiplier int, retryAfter time.Duration) gin.HandlerFunc {
 	if cpuMultiplier <= 0 {
 		// throttling is disabled, return a noop middleware
 		return func(c *gin.Context) {}
 	}
 
 	var (
-		limit         = runtime.GOMAXPROCS(0) * cpuMultiplier
-		queueLimit    = limit * cpuMultiplier
-		tokens        = make(chan token, limit)
-		requestCount  = atomic.Int64{}
-		retryAfterStr = strconv.FormatUint(uint64(retryAfter/time.Second), 10)
+		limit      = runtime.GOMAXPROCS(0) * cpuMultiplier
+		queueLimit = limit * cpuMultiplier
+		tokens     = make(chan token, limit)
+		backlog    = make(chan token, queueLimit)
+		inFlight   = atomic.Int64{}
+		latencies  = latencyWindow{}
 	)
 
 	// prefill token channel
@@ -95,19 +116,37 @@ func Throttle(cpuMultiplier int, retryAfter time.Duration) gin.HandlerFunc {
 		tokens <- token{}
 	}
 
+	// Backlog tokens are held by both in-flight
+	// and queued requests, so the difference is
+	// the number of requests waiting in the queue.
+	queued := func() int64 {
+		return int64(len(backlog)) - inFlight.Load()
+	}
+	metrics.RegisterThrottle(group, inFlight.Load, queued)
+
 	return func(c *gin.Context) {
-		// Always decrement request counter.
-		defer func() { requestCount.Add(-1) }()
+		ctx := c.Request.Context()
 
-		// Increment request count.
-		n := requestCount.Add(1)
+		// Try to get a place in the backlog,
+		// waiting a short while if it's full.
+		if !acquireBacklog(ctx.Done(), backlog) {
+			if ctx.Err() != nil {
+				// request context has
+				// been canceled already.
+				return
+			}
 
-		// Check whether the request
-		// count is over queue limit.
-		if n > int64(queueLimit) {
-			c.Header("Retry-After", retryAfterStr)
+			// Estimate when there may
+			// be room in the backlog.
+			retry := latencies.retryAfter(
+				queued(),
+				limit,
+				retryAfter,
+			)
+
+			c.Header("Retry-After", retry)
 			apiutil.Data(c,
-				http.StatusTooManyRequests,
+				http.StatusServiceUnavailable,
 				apiutil.AppJSON,
 				apiutil.ErrorCapacityExceeded,
 			)
@@ -115,11 +154,14 @@ func Throttle(cpuMultiplier int, retryAfter time.Duration) gin.HandlerFunc {
 			return
 		}
 
+		// Always release backlog place.
+		defer func() { <-backlog }()
+
 		// Sit and wait in the
 		// queue for free token.
 		select {
 
-		case <-c.Request.Context().Done():
+		case <-ctx.Done():
 			// request context has
 			// been canceled already.
 			return
@@ -128,10 +170,15 @@ func Throttle(cpuMultiplier int, retryAfter time.Duration) gin.HandlerFunc {
 			// caller has successfully
 			// received a token, allowing
 			// request to be processed.
+			inFlight.Add(1)
+			start := time.Now()
 
 			defer func() {
-				// when we're finished, return
-				// this token to the bucket.
+				// when we're finished, record
+				// latency and return this
+				// token to the bucket.
+				latencies.add(time.Since(start))
+				inFlight.Add(-1)
 				tokens <- tok
 			}()
 
@@ -141,3 +188,83 @@ func Throttle(cpuMultiplier int, retryAfter time.Duration) gin.HandlerFunc {
 		}
 	}
 }
+
+// acquireBacklog attempts to place a token in the backlog
+// queue, waiting up to maxBacklogWait if it is full. Returns
+// false if the queue is still full, or done is closed.
+func acquireBacklog(done <-chan struct{}, backlog chan token) bool {
+	select {
+	case backlog <- token{}:
+		return true
+	default:
+	}
+
+	// Queue is full, wait
+	// a short while for room.
+	timer := time.NewTimer(maxBacklogWait)
+	defer timer.Stop()
+
+	select {
+	case backlog <- token{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-done:
+		return false
+	}
+}
+
+// latencyWindow keeps a sliding
+// window of recent request latencies.
+type latencyWindow struct {
+	mu   sync.Mutex
+	buf  [latencyWindowSize]time.Duration
+	sum  time.Duration
+	next int
+	n    int
+}
+
+// add records latency of a
+// request, replacing the oldest.
+func (w *latencyWindow) add(d time.Duration) {
+	w.mu.Lock()
+	if w.n == len(w.buf) {
+		w.sum -= w.buf[w.next]
+	} else {
+		w.n++
+	}
+	w.buf[w.next] = d
+	w.sum += d
+	w.next = (w.next + 1) % len(w.buf)
+	w.mu.Unlock()
+}
+
+// mean returns the mean latency in window,
+// or zero if no latencies were recorded yet.
+func (w *latencyWindow) mean() time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.n == 0 {
+		return 0
+	}
+	return w.sum / time.Duration(w.n)
+}
+
+// retryAfter returns a Retry-After header value estimating how long
+// it'll take for the given number of queued requests to be processed
+// by limit concurrent workers at the current mean latency, clamped to
+// between 1 second and maxRetry. If no latency is known, maxRetry is used.
+func (w *latencyWindow) retryAfter(queued int64, limit int, maxRetry time.Duration) string {
+	retry := maxRetry
+
+	if mean := w.mean(); mean > 0 {
+		// Time for the queue to drain,
+		// rounded up to whole seconds.
+		wait := time.Duration(queued+1) * mean / time.Duration(limit)
+		wait = (wait + time.Second - 1).Truncate(time.Second)
+		retry = min(wait, maxRetry)
+	}
+
+	retry = max(retry, time.Second)
+	return strconv.FormatInt(int64(retry/time.Second), 10)
+}
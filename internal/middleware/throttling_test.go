@@ -57,7 +57,7 @@ func testThrottlingMiddleware(t *testing.T, cpuMulti int, retryAfter time.Durati
 	e := gin.New()
 
 	// Add middleware to the gin engine handler stack.
-	middleware := middleware.Throttle(cpuMulti, retryAfter)
+	middleware := middleware.Throttle("test", cpuMulti, retryAfter)
 	e.Use(middleware)
 
 	// Set the blocking gin handler.
@@ -80,6 +80,12 @@ func testThrottlingMiddleware(t *testing.T, cpuMulti int, retryAfter time.Durati
 		go e.ServeHTTP(rw, r)
 		time.Sleep(time.Millisecond)
 
+		if i >= queueLimit {
+			// Give time for the
+			// bounded backlog wait.
+			time.Sleep(250 * time.Millisecond)
+		}
+
 		// Get http result.
 		res := rw.Result()
 
@@ -96,8 +102,8 @@ func testThrottlingMiddleware(t *testing.T, cpuMulti int, retryAfter time.Durati
 		} else {
 
 			// Check the returned status code is expected.
-			if res.StatusCode != http.StatusTooManyRequests {
-				t.Fatalf("did not return status 429 (%d) with queueLimit=%d and request=%d", res.StatusCode, queueLimit, i)
+			if res.StatusCode != http.StatusServiceUnavailable {
+				t.Fatalf("did not return status 503 (%d) with queueLimit=%d and request=%d", res.StatusCode, queueLimit, i)
 			}
 
 			// Check the returned retry-after header is set.
@@ -141,6 +147,57 @@ func testThrottlingMiddleware(t *testing.T, cpuMulti int, retryAfter time.Durati
 	}
 }
 
+func TestThrottlingMiddlewareRetryAfterLatency(t *testing.T) {
+	const cpuMulti = 2
+
+	// Calculate expected request limit + queue.
+	limit := runtime.GOMAXPROCS(0) * cpuMulti
+	queueLimit := limit * cpuMulti
+
+	e := gin.New()
+	e.Use(middleware.Throttle("test", cpuMulti, time.Minute))
+
+	// Fast handler to record some
+	// request latencies, and the
+	// usual blocking handler.
+	e.Handle("GET", "/fast", func(c *gin.Context) {})
+	e.Handle("GET", "/", blockingHandler())
+
+	for i := 0; i < 10; i++ {
+		r := httptest.NewRequest("GET", "/fast", nil)
+		rw := httptest.NewRecorder()
+		e.ServeHTTP(rw, r)
+		if code := rw.Result().StatusCode; code != http.StatusOK {
+			t.Fatalf("fast request returned status %d", code)
+		}
+	}
+
+	// Fill up the backlog queue.
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+	for i := 0; i < queueLimit; i++ {
+		r := httptest.NewRequest("GET", "/", nil).WithContext(ctx)
+		go e.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	// This request should be rejected after the
+	// bounded backlog wait, with a Retry-After
+	// based on the (tiny) observed latencies
+	// rather than the configured maximum.
+	r := httptest.NewRequest("GET", "/", nil)
+	rw := httptest.NewRecorder()
+	e.ServeHTTP(rw, r)
+
+	res := rw.Result()
+	if res.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("did not return status 503 (%d)", res.StatusCode)
+	}
+	if retry := res.Header.Get("Retry-After"); retry != "1" {
+		t.Fatalf("expected retry-after 1, got %s", retry)
+	}
+}
+
 func blockingHandler() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		<-ctx.Done()
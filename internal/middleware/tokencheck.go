@@ -18,10 +18,15 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/oauth2/v4"
@@ -42,8 +47,11 @@ import (
 // Next, it will look up the *gtsmodel.Account for the User. If the Account has been suspended, then the
 // middleware will return early. Otherwise, it will set the Account on the gin context too.
 //
-// Finally, it will check the client ID of the token to see if a *gtsmodel.Application can be retrieved
-// for that client ID. This will also be set on the gin context.
+// Finally, the *gtsmodel.Application for the client ID of the token will also be set on the gin context.
+// This application is fetched before anything else, and if it has been revoked by an admin then the
+// request is aborted with 401 Unauthorized, so that the client can show its user a sensible error.
+//
+// The last used time of valid tokens is also updated, at most once per hour.
 //
 // If an invalid token is presented, or a user/account/application can't be found, then this middleware
 // won't abort the request, since the server might want to still allow public requests that don't have a
@@ -63,7 +71,38 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 			log.Debugf(ctx, "token was passed in Authorization header but we could not validate it: %s", err)
 			return
 		}
+
+		// Fetch the application for this token up front, as
+		// a revoked application's tokens are always rejected.
+		var app *gtsmodel.Application
+		if clientID := ti.GetClientID(); clientID != "" {
+			log.Tracef(ctx, "authenticated client %s with bearer token, scope is %s", clientID, ti.GetScope())
+
+			app, err = dbConn.GetApplicationByClientID(ctx, clientID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "database error looking for application with clientID %s: %s", clientID, err)
+				return
+			}
+
+			if app == nil {
+				log.Warnf(ctx, "no app found for client %s", clientID)
+			} else if !app.RevokedAt.IsZero() {
+				// Tell the client explicitly that its app was
+				// revoked, rather than letting the request fall
+				// through to a less helpful error further on.
+				log.Warnf(ctx, "rejecting token of revoked app %s", app.ID)
+				const text = "the application this token was issued to has been revoked"
+				errWithCode := gtserror.NewErrorUnauthorized(errors.New(text), text)
+				c.AbortWithStatusJSON(
+					errWithCode.Code(),
+					gin.H{"error": errWithCode.Safe()},
+				)
+				return
+			}
+		}
+
 		c.Set(oauth.SessionAuthorizedToken, ti)
+		touchToken(ctx, dbConn, ti.GetAccess())
 
 		// check for user-level token
 		if userID := ti.GetUserID(); userID != "" {
@@ -119,22 +158,43 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 			c.Set(oauth.SessionAuthorizedAccount, user.Account)
 		}
 
-		// check for application token
-		if clientID := ti.GetClientID(); clientID != "" {
-			log.Tracef(ctx, "authenticated client %s with bearer token, scope is %s", clientID, ti.GetScope())
-
-			// fetch app for this token
-			app, err := dbConn.GetApplicationByClientID(ctx, clientID)
-			if err != nil {
-				if err != db.ErrNoEntries {
-					log.Errorf(ctx, "database error looking for application with clientID %s: %s", clientID, err)
-					return
-				}
-				log.Warnf(ctx, "no app found for client %s", clientID)
-				return
-			}
-
+		if app != nil {
 			c.Set(oauth.SessionAuthorizedApplication, app)
 		}
 	}
 }
+
+// tokenLastUsedFreq is how often the
+// last used time of a token is updated.
+const tokenLastUsedFreq = time.Hour
+
+// touchToken updates the last used time of the token with the
+// given access code, if it's older than tokenLastUsedFreq. This
+// keeps database writes down while still giving admins a rough
+// idea of which applications are in use.
+func touchToken(ctx context.Context, dbConn db.DB, access string) {
+	if access == "" {
+		return
+	}
+
+	token, err := dbConn.GetTokenByAccess(ctx, access)
+	if err != nil {
+		log.Errorf(ctx, "database error looking for token: %v", err)
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(token.LastUsed) < tokenLastUsedFreq {
+		return
+	}
+
+	// Take a copy so we're not
+	// modifying the cached token.
+	token2 := new(gtsmodel.Token)
+	*token2 = *token
+	token2.LastUsed = now
+
+	if err := dbConn.UpdateToken(ctx, token2, "last_used"); err != nil {
+		log.Errorf(ctx, "database error updating token last used: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TokenCheckTestSuite struct {
+	suite.Suite
+	db          db.DB
+	state       state.State
+	oauthServer oauth.Server
+
+	testTokens       map[string]*gtsmodel.Token
+	testApplications map[string]*gtsmodel.Application
+}
+
+func (suite *TokenCheckTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.oauthServer = testrig.NewTestOauthServer(suite.db)
+
+	suite.testTokens = testrig.NewTestTokens()
+	suite.testApplications = testrig.NewTestApplications()
+
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *TokenCheckTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+// doRequest performs a request with the given access
+// token through TokenCheck, returning the response and
+// whether the request was authorized by the middleware.
+func (suite *TokenCheckTestSuite) doRequest(access string) (*httptest.ResponseRecorder, bool) {
+	var authorized bool
+
+	r := gin.New()
+	r.Use(middleware.TokenCheck(suite.db, suite.oauthServer.ValidationBearerToken))
+	r.GET("/", func(c *gin.Context) {
+		_, authorized = c.Get(oauth.SessionAuthorizedAccount)
+		c.Status(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+access)
+	r.ServeHTTP(rec, req)
+
+	return rec, authorized
+}
+
+func (suite *TokenCheckTestSuite) TestTokenCheckLastUsed() {
+	ctx := context.Background()
+	access := suite.testTokens["local_account_1"].Access
+
+	rec, authorized := suite.doRequest(access)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.True(authorized)
+
+	// Last used time of the token should now be set.
+	token, err := suite.db.GetTokenByAccess(ctx, access)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(time.Now(), token.LastUsed, time.Minute)
+}
+
+func (suite *TokenCheckTestSuite) TestTokenCheckRevokedApp() {
+	ctx := context.Background()
+
+	// Revoke the app, but leave its tokens in
+	// place, so the middleware check is tested.
+	app, err := suite.db.GetApplicationByID(ctx, suite.testApplications["application_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	app.RevokedAt = time.Now()
+	if err := suite.db.UpdateApplication(ctx, app, "revoked_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	rec, authorized := suite.doRequest(suite.testTokens["local_account_1"].Access)
+	suite.Equal(http.StatusUnauthorized, rec.Code)
+	suite.False(authorized)
+	suite.Equal(`{"error":"Unauthorized: the application this token was issued to has been revoked"}`, rec.Body.String())
+}
+
+func TestTokenCheckTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenCheckTestSuite))
+}
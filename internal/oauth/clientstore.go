@@ -19,10 +19,12 @@ package oauth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/oauth2/v4"
+	oautherr "github.com/superseriousbusiness/oauth2/v4/errors"
 	"github.com/superseriousbusiness/oauth2/v4/models"
 )
 
@@ -43,6 +45,16 @@ func (cs *clientStore) GetByID(ctx context.Context, clientID string) (oauth2.Cli
 	if err != nil {
 		return nil, err
 	}
+
+	// Clients of revoked applications can't
+	// be used to authorize or obtain tokens.
+	app, err := cs.db.GetApplicationByClientID(ctx, clientID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+	if app != nil && !app.RevokedAt.IsZero() {
+		return nil, oautherr.ErrInvalidClient
+	}
 	return models.New(
 		client.ID,
 		client.Secret,
@@ -19,14 +19,15 @@ package account
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
 	"slices"
+	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -62,69 +63,73 @@ func (p *Processor) Alias(
 
 	// We need to set new AKA URIs!
 	//
-	// First parse them to URI ptrs and
-	// normalized string representations.
-	//
-	// Use this cheeky type to avoid
-	// repeatedly calling uri.String().
-	type uri struct {
-		uri *url.URL // Parsed URI.
-		str string   // uri.String().
+	// First normalize them to URIs or
+	// namestrings, dropping self + dupes.
+	newAKAs, err := typeutils.APIAliasesToAlsoKnownAsURIs(newAKAURIStrs, account)
+	if err != nil {
+		err := fmt.Errorf("invalid also_known_as_uri provided in account alias request: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
-	newAKAs := make([]uri, newLen)
-	for i, newAKAURIStr := range newAKAURIStrs {
-		newAKAURI, err := url.Parse(newAKAURIStr)
-		if err != nil {
-			err := fmt.Errorf(
-				"invalid also_known_as_uri (%s) provided in account alias request: %w",
-				newAKAURIStr, err,
+	// For each entry, get and
+	// check the target account, and set.
+	for _, newAKA := range newAKAs {
+		var targetAccount *gtsmodel.Account
+
+		if strings.HasPrefix(newAKA, "@") {
+			// Remote namestring, resolve via webfinger.
+			username, domain, err := util.ExtractNamestringParts(newAKA)
+			if err != nil {
+				err := gtserror.Newf("error extracting normalized namestring %s: %w", newAKA, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			targetAccount, _, err = p.federator.GetAccountByUsernameDomain(ctx,
+				account.Username,
+				username,
+				domain,
 			)
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
-		}
-
-		// We only deref http or https, so check this.
-		if newAKAURI.Scheme != "https" && newAKAURI.Scheme != "http" {
-			err := fmt.Errorf(
-				"invalid also_known_as_uri (%s) provided in account alias request: %w",
-				newAKAURIStr, errors.New("uri must not be empty and scheme must be http or https"),
+			if err != nil {
+				err := fmt.Errorf(
+					"error dereferencing also_known_as_uri (%s) account: %w",
+					newAKA, err,
+				)
+				return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+			}
+		} else {
+			newAKAURI, err := url.Parse(newAKA)
+			if err != nil {
+				err := gtserror.Newf("error parsing normalized uri %s: %w", newAKA, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			// Ensure we have account dereferenced.
+			targetAccount, _, err = p.federator.GetAccountByURI(ctx,
+				account.Username,
+				newAKAURI,
 			)
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			if err != nil {
+				err := fmt.Errorf(
+					"error dereferencing also_known_as_uri (%s) account: %w",
+					newAKA, err,
+				)
+				return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+			}
 		}
 
-		newAKAs[i].uri = newAKAURI
-		newAKAs[i].str = newAKAURI.String()
-	}
-
-	// For each deduped entry, get and
-	// check the target account, and set.
-	for _, newAKA := range newAKAs {
 		// Don't let account do anything
-		// daft by aliasing to itself.
-		if newAKA.str == account.URI ||
-			newAKA.str == account.URL {
+		// daft by aliasing to itself, eg.,
+		// via a remote acct of a local account.
+		if targetAccount.ID == account.ID {
 			continue
 		}
 
-		// Ensure we have account dereferenced.
-		targetAccount, _, err := p.federator.GetAccountByURI(ctx,
-			account.Username,
-			newAKA.uri,
-		)
-		if err != nil {
-			err := fmt.Errorf(
-				"error dereferencing also_known_as_uri (%s) account: %w",
-				newAKA.str, err,
-			)
-			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
-		}
-
 		// Target must not be suspended.
 		if !targetAccount.SuspendedAt.IsZero() {
 			err := fmt.Errorf(
 				"target account %s is suspended from this instance; "+
 					"you will not be able to set alsoKnownAs to that account",
-				newAKA.str,
+				newAKA,
 			)
 			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
 		}
@@ -145,8 +150,7 @@ func (p *Processor) Alias(
 		},
 	)
 
-	err := p.state.DB.UpdateAccount(ctx, account, "also_known_as_uris")
-	if err != nil {
+	if err := p.state.DB.UpdateAccount(ctx, account, "also_known_as_uris"); err != nil {
 		err := gtserror.Newf("db error updating also_known_as_uri: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
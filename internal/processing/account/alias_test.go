@@ -93,7 +93,7 @@ func (suite *AliasTestSuite) TestAliasAccount() {
 		// Set bad alias.
 		{
 			newAliases:  []string{"oh no"},
-			expectedErr: "invalid also_known_as_uri (oh no) provided in account alias request: uri must not be empty and scheme must be http or https",
+			expectedErr: "invalid also_known_as_uri provided in account alias request: invalid alias (oh no): must be an http or https uri, or a namestring like @someone@example.org",
 		},
 		// Try to alias to self (won't do anything).
 		{
@@ -132,6 +132,18 @@ func (suite *AliasTestSuite) TestAliasAccount() {
 				"http://localhost:8080/users/admin",
 			},
 		},
+		// Alias zork to turtle + admin by namestring,
+		// local namestrings should resolve to URIs.
+		{
+			newAliases: []string{
+				"@1happyturtle",
+				"admin@localhost:8080",
+			},
+			expectedAliases: []string{
+				"http://localhost:8080/users/1happyturtle",
+				"http://localhost:8080/users/admin",
+			},
+		},
 		// Alias zork to turtle using both URI and URL
 		// for turtle. Only URI should end up being used.
 		{
@@ -364,6 +364,7 @@ statusLoop:
 			boosts, err := p.state.DB.GetStatusBoosts(
 				gtscontext.SetBarebones(ctx),
 				status.ID,
+				nil, // all boosts
 			)
 			if err != nil && !errors.Is(err, db.ErrNoEntries) {
 				return gtserror.Newf("error fetching status boosts for %s: %w", status.ID, err)
@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Directory returns a page of discoverable accounts for the profile
+// directory, ordered by most recent status ("active"), or by account
+// creation ("new"). Accounts on limited domains are left out, as are
+// accounts blocking or blocked by the requester, if one is given.
+func (p *Processor) Directory(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	local bool,
+	order string,
+	offset int,
+	limit int,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	accountIDs, err := p.state.DB.GetDirectoryAccountIDs(ctx,
+		local,
+		order == "new",
+		offset,
+		limit,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting directory account ids: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(accountIDs) == 0 {
+		return []*apimodel.Account{}, nil
+	}
+
+	accounts, err := p.state.DB.GetAccountsByIDs(ctx, accountIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting directory accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccounts := make([]*apimodel.Account, 0, len(accounts))
+	for _, account := range accounts {
+		ok, err := p.directoryVisible(ctx, requester, account)
+		if err != nil {
+			err := gtserror.Newf("error checking directory account %s: %w", account.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", account.ID, err)
+			continue
+		}
+
+		apiAccounts = append(apiAccounts, apiAccount)
+	}
+
+	return apiAccounts, nil
+}
+
+// directoryVisible returns whether the given
+// directory account should be shown to requester.
+func (p *Processor) directoryVisible(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	account *gtsmodel.Account,
+) (bool, error) {
+	if account.Domain != "" {
+		limited, err := p.state.DB.IsDomainLimited(ctx, account.Domain)
+		if err != nil || limited {
+			return false, err
+		}
+	}
+
+	if requester == nil {
+		return true, nil
+	}
+
+	blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, account.ID)
+	if err != nil || blocked {
+		return false, err
+	}
+
+	return true, nil
+}
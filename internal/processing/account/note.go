@@ -24,10 +24,19 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 // PutNote updates the requesting account's private note on the target account.
 func (p *Processor) PutNote(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string, comment string) (*apimodel.Relationship, gtserror.WithCode) {
+	comment, err := typeutils.APIAccountNoteToNote(
+		comment,
+		gtsmodel.NewInstanceConfiguration().AccountsNoteMaxChars,
+	)
+	if err != nil {
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
 	targetAccount, errWithCode := p.Get(ctx, requestingAccount, targetAccountID)
 	if errWithCode != nil {
 		return nil, errWithCode
@@ -39,8 +48,7 @@ func (p *Processor) PutNote(ctx context.Context, requestingAccount *gtsmodel.Acc
 		TargetAccountID: targetAccount.ID,
 		Comment:         comment,
 	}
-	err := p.state.DB.PutNote(ctx, note)
-	if err != nil {
+	if err := p.state.DB.PutNote(ctx, note); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
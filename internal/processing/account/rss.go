@@ -24,7 +24,6 @@ import (
 	"time"
 
 	"github.com/gorilla/feeds"
-	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -84,7 +83,7 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string)
 
 	return func() (string, gtserror.WithCode) {
 		// Assemble author namestring once only.
-		author := "@" + account.Username + "@" + config.GetAccountDomain()
+		author := "@" + account.Username + "@" + account.NameDomain()
 
 		// Derive image/thumbnail for this account (may be nil).
 		image, errWithCode := p.rssImageForAccount(ctx, account, author)
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// statisticsPeriods maps each supported
+// statistics period to the duration it covers.
+var statisticsPeriods = map[string]time.Duration{
+	"30d": 30 * 24 * time.Hour,
+	"90d": 90 * 24 * time.Hour,
+	"1y":  365 * 24 * time.Hour,
+}
+
+// statisticsTopTagsLimit is the max
+// number of top tags to return.
+const statisticsTopTagsLimit = 10
+
+// StatisticsGet returns personal usage statistics of the requesting
+// account, covering the given period (30d, 90d or 1y, default 30d).
+// Followers gained are always counted over the last 30 days.
+func (p *Processor) StatisticsGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	period string,
+) (*apimodel.AccountStatistics, gtserror.WithCode) {
+	if period == "" {
+		period = "30d"
+	}
+
+	duration, ok := statisticsPeriods[period]
+	if !ok {
+		text := fmt.Sprintf("period %s not recognized, must be one of 30d, 90d, 1y", period)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	var (
+		now   = time.Now()
+		since = now.Add(-duration)
+		stats = &apimodel.AccountStatistics{Period: period}
+		err   error
+	)
+
+	stats.StatusesCount, err = p.state.DB.CountAccountStatusesSince(ctx, requestingAccount.ID, since)
+	if err != nil {
+		err := gtserror.Newf("db error counting statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	stats.FavouritesReceived, err = p.state.DB.CountAccountFavesReceivedSince(ctx, requestingAccount.ID, since)
+	if err != nil {
+		err := gtserror.Newf("db error counting faves received: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	stats.BoostsReceived, err = p.state.DB.CountAccountBoostsReceivedSince(ctx, requestingAccount.ID, since)
+	if err != nil {
+		err := gtserror.Newf("db error counting boosts received: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	stats.FollowersGained30d, err = p.state.DB.CountAccountFollowersSince(ctx, requestingAccount.ID, now.Add(-statisticsPeriods["30d"]))
+	if err != nil {
+		err := gtserror.Newf("db error counting followers gained: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	usages, err := p.state.DB.GetAccountTopTags(ctx, requestingAccount.ID, since, statisticsTopTagsLimit)
+	if err != nil {
+		err := gtserror.Newf("db error getting top tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	stats.TopTags = make([]apimodel.AccountStatisticsTag, 0, len(usages))
+	for _, usage := range usages {
+		tag, err := p.state.DB.GetTag(ctx, usage.TagID)
+		if err != nil {
+			err := gtserror.Newf("db error getting tag %s: %w", usage.TagID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiTag, err := p.converter.TagToAPITag(ctx, tag, false)
+		if err != nil {
+			err := gtserror.Newf("error converting tag %s to api model: %w", tag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		stats.TopTags = append(stats.TopTags, apimodel.AccountStatisticsTag{
+			Name:          apiTag.Name,
+			URL:           apiTag.URL,
+			StatusesCount: usage.Count,
+		})
+	}
+
+	return stats, nil
+}
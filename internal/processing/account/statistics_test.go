@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatisticsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *StatisticsTestSuite) TestStatisticsGetNothingRecent() {
+	// Test statuses, faves and follows
+	// are all older than the default period.
+	stats, errWithCode := suite.accountProcessor.StatisticsGet(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+		"",
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("30d", stats.Period)
+	suite.Zero(stats.StatusesCount)
+	suite.Zero(stats.FavouritesReceived)
+	suite.Zero(stats.BoostsReceived)
+	suite.Zero(stats.FollowersGained30d)
+	suite.NotNil(stats.TopTags)
+	suite.Empty(stats.TopTags)
+}
+
+func (suite *StatisticsTestSuite) TestStatisticsGet() {
+	var (
+		ctx     = context.Background()
+		now     = time.Now()
+		account = suite.testAccounts["local_account_1"]
+		admin   = suite.testAccounts["admin_account"]
+		remote  = suite.testAccounts["remote_account_1"]
+		tag     = testrig.NewTestTags()["welcome"]
+	)
+
+	// Post a fresh status using a tag.
+	statusID := id.NewULID()
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+		Local:               util.Ptr(true),
+		AccountID:           account.ID,
+		AccountURI:          account.URI,
+		TagIDs:              []string{tag.ID},
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           util.Ptr(true),
+		Boostable:           util.Ptr(true),
+		Replyable:           util.Ptr(true),
+		Likeable:            util.Ptr(true),
+	}
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Have admin fave and boost it.
+	if err := suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              id.NewULID(),
+		AccountID:       admin.ID,
+		TargetAccountID: account.ID,
+		StatusID:        status.ID,
+		URI:             admin.URI + "/fave/" + status.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	boostID := id.NewULID()
+	if err := suite.db.PutStatus(ctx, &gtsmodel.Status{
+		ID:                  boostID,
+		URI:                 admin.URI + "/statuses/" + boostID,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+		Local:               util.Ptr(true),
+		AccountID:           admin.ID,
+		AccountURI:          admin.URI,
+		BoostOfID:           status.ID,
+		BoostOfAccountID:    account.ID,
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: ap.ActivityAnnounce,
+		Federated:           util.Ptr(true),
+		Boostable:           util.Ptr(true),
+		Replyable:           util.Ptr(true),
+		Likeable:            util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Have a remote account follow.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              id.NewULID(),
+		URI:             remote.URI + "/follow/" + account.ID,
+		AccountID:       remote.ID,
+		TargetAccountID: account.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	stats, errWithCode := suite.accountProcessor.StatisticsGet(ctx, account, "90d")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("90d", stats.Period)
+	suite.Equal(1, stats.StatusesCount)
+	suite.Equal(1, stats.FavouritesReceived)
+	suite.Equal(1, stats.BoostsReceived)
+	suite.Equal(1, stats.FollowersGained30d)
+	if suite.Len(stats.TopTags, 1) {
+		suite.Equal("welcome", stats.TopTags[0].Name)
+		suite.Equal("http://localhost:8080/tags/welcome", stats.TopTags[0].URL)
+		suite.Equal(1, stats.TopTags[0].StatusesCount)
+	}
+
+	// Boosts aren't counted
+	// as statuses posted.
+	stats, errWithCode = suite.accountProcessor.StatisticsGet(ctx, admin, "1y")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Zero(stats.StatusesCount)
+}
+
+func (suite *StatisticsTestSuite) TestStatisticsGetBadPeriod() {
+	_, errWithCode := suite.accountProcessor.StatisticsGet(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+		"forever",
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: period forever not recognized, must be one of 30d, 90d, 1y", errWithCode.Safe())
+}
+
+func TestStatisticsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatisticsTestSuite))
+}
@@ -288,6 +288,14 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		account.Settings.HideCollections = form.HideCollections
 	}
 
+	if form.HideInteractionCounts != nil {
+		account.Settings.HideInteractionCounts = form.HideInteractionCounts
+	}
+
+	if form.HideOutbox != nil {
+		account.Settings.HideOutbox = form.HideOutbox
+	}
+
 	if err := p.state.DB.UpdateAccount(ctx, account); err != nil {
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not update account %s: %s", account.ID, err))
 	}
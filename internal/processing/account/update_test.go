@@ -331,6 +331,72 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateNoteNotFields() {
 	suite.Equal(fieldsBefore, len(dbAccount.Fields))
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateSourcePartial() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	testAccount.Settings = &gtsmodel.AccountSettings{}
+	*testAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+
+	var (
+		ctx               = context.Background()
+		privacyBefore     = testAccount.Settings.Privacy
+		languageBefore    = testAccount.Settings.Language
+		contentTypeBefore = testAccount.Settings.StatusContentType
+		sensitive         = true
+	)
+
+	// Only update the default
+	// sensitive setting; other
+	// defaults shouldn't change.
+	apiAccount, errWithCode := suite.accountProcessor.Update(ctx, testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			Sensitive: &sensitive,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Returned source should be updated.
+	suite.True(apiAccount.Source.Sensitive)
+	suite.Equal(languageBefore, apiAccount.Source.Language)
+
+	// Check database model of settings as well.
+	dbSettings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbSettings.Sensitive)
+	suite.Equal(privacyBefore, dbSettings.Privacy)
+	suite.Equal(languageBefore, dbSettings.Language)
+	suite.Equal(contentTypeBefore, dbSettings.StatusContentType)
+
+	// Now only update the default language.
+	language := "fr"
+	apiAccount, errWithCode = suite.accountProcessor.Update(ctx, testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			Language: &language,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Sensitive should not have
+	// been clobbered by this.
+	suite.True(apiAccount.Source.Sensitive)
+	suite.Equal(language, apiAccount.Source.Language)
+
+	dbSettings, err = suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbSettings.Sensitive)
+	suite.Equal(language, dbSettings.Language)
+	suite.Equal(privacyBefore, dbSettings.Privacy)
+	suite.Equal(contentTypeBefore, dbSettings.StatusContentType)
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUpdateTestSuite))
 }
@@ -97,6 +97,10 @@ func (p *Processor) accountActionSuspend(
 			return nil
 		},
 	)
+	if errWithCode != nil {
+		return actionID, errWithCode
+	}
 
-	return actionID, errWithCode
+	p.logAction(ctx, adminAcct, targetAcct, gtsmodel.AdminActionSuspend, text)
+	return actionID, nil
 }
@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// AccountCharacterLimitSet sets the max number of characters
+// permitted in statuses created by the local account with the
+// given ID, and returns the updated account. A limit of 0 means
+// the instance default character limit applies to the account.
+func (p *Processor) AccountCharacterLimitSet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAccountID string,
+	limit int,
+) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	if limit < 0 {
+		const text = "character_limit must be 0 or greater"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	targetAcct, errWithCode := p.getLocalAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	targetAcct.CharacterLimitOverride = limit
+	if err := p.state.DB.UpdateAccount(ctx, targetAcct, "character_limit_override"); err != nil {
+		err := gtserror.Newf("db error updating account %s: %w", targetAcct.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.logAction(ctx, adminAcct, targetAcct,
+		gtsmodel.AdminActionSetCharacterLimit,
+		strconv.Itoa(limit),
+	)
+
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, targetAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s to admin api model: %w", targetAcct.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
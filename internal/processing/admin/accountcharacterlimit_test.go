@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountCharacterLimitTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountCharacterLimitTestSuite) TestAccountCharacterLimitSet() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+	)
+
+	// Set a custom limit of 5000 characters.
+	apiAccount, errWithCode := suite.adminProcessor.AccountCharacterLimitSet(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+		5000,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(5000, apiAccount.CharacterLimitOverride)
+
+	// Limit should be stored.
+	dbAccount, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(5000, dbAccount.CharacterLimitOverride)
+
+	// Reset to instance default.
+	apiAccount, errWithCode = suite.adminProcessor.AccountCharacterLimitSet(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+		0,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Zero(apiAccount.CharacterLimitOverride)
+
+	dbAccount, err = suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(dbAccount.CharacterLimitOverride)
+}
+
+func (suite *AccountCharacterLimitTestSuite) TestAccountCharacterLimitSetNegative() {
+	_, errWithCode := suite.adminProcessor.AccountCharacterLimitSet(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		suite.testAccounts["local_account_1"].ID,
+		-1,
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *AccountCharacterLimitTestSuite) TestAccountCharacterLimitSetRemote() {
+	// Remote accounts don't create
+	// statuses here, so have no limit.
+	_, errWithCode := suite.adminProcessor.AccountCharacterLimitSet(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		suite.testAccounts["remote_account_1"].ID,
+		5000,
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestAccountCharacterLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountCharacterLimitTestSuite))
+}
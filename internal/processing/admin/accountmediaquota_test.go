@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountMediaQuotaTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountMediaQuotaTestSuite) TestAccountMediaQuotaSet() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+	)
+
+	// Set a custom quota of 1GiB.
+	apiAccount, errWithCode := suite.adminProcessor.AccountMediaQuotaSet(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+		1073741824,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(int64(1073741824), apiAccount.MediaQuotaBytes)
+
+	// Quota should be stored.
+	dbAccount, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(int64(1073741824), dbAccount.MediaQuotaBytes)
+
+	// Reset to instance default.
+	apiAccount, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+		0,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Zero(apiAccount.MediaQuotaBytes)
+
+	dbAccount, err = suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(dbAccount.MediaQuotaBytes)
+}
+
+func (suite *AccountMediaQuotaTestSuite) TestAccountMediaQuotaSetRemote() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["remote_account_1"]
+	)
+
+	// Remote accounts can't upload
+	// media here, so have no quota.
+	_, errWithCode := suite.adminProcessor.AccountMediaQuotaSet(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+		1024,
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestAccountMediaQuotaTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountMediaQuotaTestSuite))
+}
@@ -0,0 +1,164 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// AccountWarningCreate issues a formal warning with the given
+// text to the local account with the given ID, and returns it.
+func (p *Processor) AccountWarningCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAccountID string,
+	text string,
+) (*apimodel.AccountWarning, gtserror.WithCode) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		const help = "warning text must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+	}
+
+	targetAcct, errWithCode := p.getLocalAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	warning := &gtsmodel.AccountWarning{
+		ID:              id.NewULID(),
+		TargetAccountID: targetAcct.ID,
+		TargetAccount:   targetAcct,
+		AccountID:       adminAcct.ID,
+		Account:         adminAcct,
+		Text:            text,
+	}
+
+	if err := p.state.DB.PutAccountWarning(ctx, warning); err != nil {
+		err := gtserror.Newf("db error storing account warning: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.logAction(ctx, adminAcct, targetAcct, gtsmodel.AdminActionWarn, text)
+
+	return p.apiAccountWarning(ctx, warning)
+}
+
+// AccountWarningsGet returns warnings issued to
+// the account with the given ID, newest first.
+func (p *Processor) AccountWarningsGet(
+	ctx context.Context,
+	targetAccountID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	targetAcct, errWithCode := p.getLocalAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	warnings, err := p.state.DB.GetAccountWarnings(ctx, targetAcct.ID, false, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account warnings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(warnings)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := warnings[count-1].ID
+	hi := warnings[0].ID
+
+	// Convert each warning to API model.
+	items := make([]interface{}, 0, count)
+	for _, warning := range warnings {
+		item, errWithCode := p.apiAccountWarning(ctx, warning)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items = append(items, item)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/accounts/" + targetAcct.ID + "/warnings",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// AccountWarningGet returns the warning with the given
+// ID, issued to the account with the given ID.
+func (p *Processor) AccountWarningGet(
+	ctx context.Context,
+	targetAccountID string,
+	warningID string,
+) (*apimodel.AccountWarning, gtserror.WithCode) {
+	warning, err := p.state.DB.GetAccountWarningByID(ctx, warningID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account warning %s: %w", warningID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if warning == nil || warning.TargetAccountID != targetAccountID {
+		err := fmt.Errorf("warning %s not found for account %s", warningID, targetAccountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return p.apiAccountWarning(ctx, warning)
+}
+
+// getLocalAccount fetches the local account with the given ID,
+// returning 404 if it doesn't exist or isn't a local account,
+// since only local accounts can see warnings issued to them.
+func (p *Processor) getLocalAccount(ctx context.Context, accountID string) (*gtsmodel.Account, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account == nil || !account.IsLocal() {
+		err := fmt.Errorf("local account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return account, nil
+}
+
+func (p *Processor) apiAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) (*apimodel.AccountWarning, gtserror.WithCode) {
+	apiWarning, err := p.converter.AccountWarningToAPIAccountWarning(ctx, warning)
+	if err != nil {
+		err := gtserror.Newf("error converting account warning %s to api model: %w", warning.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiWarning, nil
+}
@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type AccountWarningTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountWarningTestSuite) TestAccountWarning() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+	)
+
+	// Issue a warning.
+	warning, errWithCode := suite.adminProcessor.AccountWarningCreate(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+		" please stop posting spam ",
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.NotEmpty(warning.ID)
+	suite.Equal("none", warning.Action)
+	suite.Equal("please stop posting spam", warning.Text)
+	suite.Equal("none", warning.AppealState)
+	suite.Equal(targetAcct.ID, warning.TargetAccount.ID)
+
+	// It should be retrievable by admin.
+	got, errWithCode := suite.adminProcessor.AccountWarningGet(ctx, targetAcct.ID, warning.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(warning, got)
+
+	// But not via another account.
+	_, errWithCode = suite.adminProcessor.AccountWarningGet(ctx, adminAcct.ID, warning.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// It should be listed for admin.
+	resp, errWithCode := suite.adminProcessor.AccountWarningsGet(ctx, targetAcct.ID, &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+	suite.Equal(warning.ID, resp.Items[0].(*apimodel.AccountWarning).ID)
+
+	// And for the warned account itself.
+	resp, errWithCode = suite.processor.Account().WarningsGet(ctx, targetAcct, &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+	suite.Equal(warning.ID, resp.Items[0].(*apimodel.AccountWarning).ID)
+
+	// The warning should be in the admin action log.
+	resp, errWithCode = suite.adminProcessor.ActionLogGet(
+		ctx,
+		adminAcct.ID,
+		targetAcct.ID,
+		time.Time{},
+		time.Time{},
+		&paging.Page{Limit: 20},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+	suite.Equal("warn", resp.Items[0].(*apimodel.AdminActionLog).Action)
+}
+
+func (suite *AccountWarningTestSuite) TestAccountWarningInvalid() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	// Warning text is required.
+	_, errWithCode := suite.adminProcessor.AccountWarningCreate(
+		ctx,
+		adminAcct,
+		suite.testAccounts["local_account_1"].ID,
+		"  ",
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// Remote accounts can't be warned.
+	_, errWithCode = suite.adminProcessor.AccountWarningCreate(
+		ctx,
+		adminAcct,
+		suite.testAccounts["remote_account_1"].ID,
+		"please stop posting spam",
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestAccountWarningTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountWarningTestSuite))
+}
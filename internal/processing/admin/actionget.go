@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// ActionGet returns the admin action with the given ID, so
+// that the progress of an asynchronous action can be checked.
+func (p *Processor) ActionGet(ctx context.Context, id string) (*apimodel.AdminAction, gtserror.WithCode) {
+	action, err := p.state.DB.GetAdminAction(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("admin action %s not found", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err := gtserror.Newf("db error getting admin action %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.AdminActionToAdminAPIAdminAction(action), nil
+}
@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// ActionLogGet returns entries from the admin action log, newest
+// first, optionally filtered by admin account, target account, and
+// created_at range (startAt inclusive, endAt exclusive).
+func (p *Processor) ActionLogGet(
+	ctx context.Context,
+	accountID string,
+	targetAccountID string,
+	startAt time.Time,
+	endAt time.Time,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	entries, err := p.state.DB.GetAdminActionLogs(
+		ctx,
+		accountID,
+		targetAccountID,
+		startAt,
+		endAt,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting admin action log: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(entries)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := entries[count-1].ID
+	hi := entries[0].ID
+
+	// Convert each entry to API model.
+	items := make([]interface{}, 0, count)
+	for _, entry := range entries {
+		items = append(items, p.converter.AdminActionLogToAdminAPIAdminActionLog(ctx, entry))
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 4)
+	if accountID != "" {
+		query.Set(apiutil.AccountIDKey, accountID)
+	}
+	if targetAccountID != "" {
+		query.Set(apiutil.TargetAccountIDKey, targetAccountID)
+	}
+	if !startAt.IsZero() {
+		query.Set(apiutil.AdminStartAtKey, startAt.Format(time.RFC3339))
+	}
+	if !endAt.IsZero() {
+		query.Set(apiutil.AdminEndAtKey, endAt.Format(time.RFC3339))
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/action_log",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// logAction stores a record of an action taken by adminAcct
+// towards targetAcct in the admin action log. Failure to do
+// so is logged, but shouldn't prevent the action itself.
+func (p *Processor) logAction(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	action gtsmodel.AdminActionType,
+	reason string,
+) {
+	entry := &gtsmodel.AdminActionLog{
+		ID:              id.NewULID(),
+		AccountID:       adminAcct.ID,
+		Account:         adminAcct,
+		TargetAccountID: targetAcct.ID,
+		TargetAccount:   targetAcct,
+		Action:          action,
+		Reason:          reason,
+	}
+
+	if err := p.state.DB.PutAdminActionLog(ctx, entry); err != nil {
+		log.Errorf(ctx, "db error storing admin action log entry: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type ActionLogTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *ActionLogTestSuite) TestActionLogApprove() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["unconfirmed_account"]
+	)
+
+	// Approve the sign-up, which should be logged.
+	if _, errWithCode := suite.adminProcessor.SignupApprove(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	resp, errWithCode := suite.adminProcessor.ActionLogGet(
+		ctx,
+		"",
+		targetAcct.ID,
+		time.Time{},
+		time.Time{},
+		&paging.Page{Limit: 20},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(resp.Items, 1)
+	entry := resp.Items[0].(*apimodel.AdminActionLog)
+	suite.Equal("approve", entry.Action)
+	suite.Equal(adminAcct.ID, entry.Account.ID)
+	suite.Equal(targetAcct.ID, entry.TargetAccount.ID)
+
+	// Entry should be excluded
+	// by a date range in the past.
+	resp, errWithCode = suite.adminProcessor.ActionLogGet(
+		ctx,
+		adminAcct.ID,
+		"",
+		time.Time{},
+		time.Now().Add(-time.Hour),
+		&paging.Page{Limit: 20},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Empty(resp.Items)
+}
+
+func TestActionLogTestSuite(t *testing.T) {
+	suite.Run(t, new(ActionLogTestSuite))
+}
@@ -43,6 +43,7 @@ func errActionConflict(action *gtsmodel.AdminAction) gtserror.WithCode {
 
 type Actions struct {
 	r     map[string]*gtsmodel.AdminAction
+	c     map[string]*actionCancel
 	state *state.State
 
 	// Not embedded struct,
@@ -94,16 +95,26 @@ func (a *Actions) Run(
 	// store in map.
 	a.r[actionKey] = action
 
+	// Use a background context with existing values,
+	// and a cancellable child context to run the action.
+	ctx = gtscontext.WithValues(context.Background(), ctx)
+	fctx, cancel := context.WithCancel(ctx)
+
+	// Store means of cancelling
+	// the action while it runs.
+	cncl := &actionCancel{cancel: cancel, done: make(chan struct{})}
+	a.c[actionKey] = cncl
+
 	// UNLOCK THE MAP HERE, since
 	// we're done modifying it for now.
 	a.m.Unlock()
 
 	go func() {
-		// Use a background context with existing values.
-		ctx = gtscontext.WithValues(context.Background(), ctx)
-
 		// Run the thing and collect errors.
-		if errs := f(ctx); errs != nil {
+		errs := f(fctx)
+		cancel()
+
+		if errs != nil {
 			action.Errors = make([]string, 0, len(errs))
 			for _, err := range errs {
 				action.Errors = append(action.Errors, err.Error())
@@ -111,10 +122,12 @@ func (a *Actions) Run(
 		}
 
 		// Action is no longer running:
-		// remove from running map.
+		// remove from running maps.
 		a.m.Lock()
 		delete(a.r, actionKey)
+		delete(a.c, actionKey)
 		a.m.Unlock()
+		close(cncl.done)
 
 		// Mark as completed in the db,
 		// storing errors for later review.
@@ -127,6 +140,30 @@ func (a *Actions) Run(
 	return nil
 }
 
+// Cancel cancels the currently running action with the
+// given key, if any, and waits for it to return. Action
+// functions must check their context to be cancelled.
+func (a *Actions) Cancel(actionKey string) {
+	a.m.Lock()
+	cncl, ok := a.c[actionKey]
+	a.m.Unlock()
+
+	if !ok {
+		// Not running.
+		return
+	}
+
+	cncl.cancel()
+	<-cncl.done
+}
+
+// actionCancel wraps the means
+// to cancel a running action.
+type actionCancel struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
 // GetRunning sounds like a threat, but it actually just
 // returns all of the currently running actions held by
 // the Actions struct, ordered by ID descending.
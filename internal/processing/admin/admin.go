@@ -18,8 +18,11 @@
 package admin
 
 import (
+	"sync/atomic"
+
 	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
@@ -34,10 +37,15 @@ type Processor struct {
 	mediaManager        *media.Manager
 	transportController transport.Controller
 	emailSender         email.Sender
+	visFilter           *visibility.Filter
 
 	// admin Actions currently
 	// undergoing processing
 	actions *Actions
+
+	// time (unix nanoseconds)
+	// of last emoji import job
+	emojiImportLast *atomic.Int64
 }
 
 func (p *Processor) Actions() *Actions {
@@ -52,6 +60,7 @@ func New(
 	mediaManager *media.Manager,
 	transportController transport.Controller,
 	emailSender email.Sender,
+	visFilter *visibility.Filter,
 ) Processor {
 	return Processor{
 		state:               state,
@@ -60,10 +69,14 @@ func New(
 		mediaManager:        mediaManager,
 		transportController: transportController,
 		emailSender:         emailSender,
+		visFilter:           visFilter,
 
 		actions: &Actions{
 			r:     make(map[string]*gtsmodel.AdminAction),
+			c:     make(map[string]*actionCancel),
 			state: state,
 		},
+
+		emojiImportLast: new(atomic.Int64),
 	}
 }
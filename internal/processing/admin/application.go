@@ -0,0 +1,221 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// ApplicationsGet returns a page of applications
+// registered on this instance, newest first.
+func (p *Processor) ApplicationsGet(
+	ctx context.Context,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	apps, err := p.state.DB.GetApplications(ctx, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting applications: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(apps)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := apps[count-1].ID
+	hi := apps[0].ID
+
+	// Convert each app to API model.
+	items := make([]interface{}, 0, count)
+	for _, app := range apps {
+		item, errWithCode := p.apiAdminApplication(ctx, app)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items = append(items, item)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/applications",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// ApplicationRevoke revokes the application with the given ID,
+// deleting all tokens issued to it and preventing any further
+// authorizations using its client ID. Revoking an application
+// which has already been revoked is a no-op.
+func (p *Processor) ApplicationRevoke(
+	ctx context.Context,
+	appID string,
+) (*apimodel.AdminApplication, gtserror.WithCode) {
+	app, err := p.state.DB.GetApplicationByID(ctx, appID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting application %s: %w", appID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if app == nil {
+		err := fmt.Errorf("application %s not found", appID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	instanceApp, err := p.state.DB.GetInstanceApplication(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting instance application: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if app.ID == instanceApp.ID {
+		const text = "the instance application cannot be revoked"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if app.RevokedAt.IsZero() {
+		// Mark revoked first, so that the token check
+		// middleware rejects any in-flight requests even
+		// if deleting the tokens below fails partway.
+		app.RevokedAt = time.Now()
+		if err := p.state.DB.UpdateApplication(ctx, app, "revoked_at"); err != nil {
+			err := gtserror.Newf("db error revoking application %s: %w", appID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Always (re)try token deletion, in case a
+	// previous attempt at revocation failed.
+	if err := p.state.DB.DeleteTokensByClientID(ctx, app.ClientID); err != nil {
+		err := gtserror.Newf("db error deleting tokens of application %s: %w", appID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAdminApplication(ctx, app)
+}
+
+// ApplicationBlocksGet returns all application blocks.
+func (p *Processor) ApplicationBlocksGet(ctx context.Context) ([]*apimodel.AdminApplicationBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetApplicationBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting application blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiBlocks := make([]*apimodel.AdminApplicationBlock, len(blocks))
+	for i, block := range blocks {
+		apiBlocks[i] = p.converter.ApplicationBlockToAPIApplicationBlock(block)
+	}
+
+	return apiBlocks, nil
+}
+
+// ApplicationBlockCreate creates a new application block, which
+// prevents applications with a name and/or website matching the
+// given patterns from being registered. Existing applications
+// are unaffected, and can be revoked separately.
+func (p *Processor) ApplicationBlockCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AdminApplicationBlockRequest,
+) (*apimodel.AdminApplicationBlock, gtserror.WithCode) {
+	if form.NamePattern == "" && form.WebsitePattern == "" {
+		const text = "at least one of name_pattern or website_pattern must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	for _, pattern := range []string{
+		form.NamePattern,
+		form.WebsitePattern,
+	} {
+		if _, err := regexp.Compile(pattern); err != nil {
+			text := fmt.Sprintf("invalid pattern %q: %v", pattern, err)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	block := &gtsmodel.ApplicationBlock{
+		ID:                 id.NewULID(),
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+		NamePattern:        form.NamePattern,
+		WebsitePattern:     form.WebsitePattern,
+	}
+
+	if err := p.state.DB.PutApplicationBlock(ctx, block); err != nil {
+		err := gtserror.Newf("db error storing application block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.ApplicationBlockToAPIApplicationBlock(block), nil
+}
+
+// ApplicationBlockDelete deletes the application
+// block with the given ID, and returns it.
+func (p *Processor) ApplicationBlockDelete(
+	ctx context.Context,
+	blockID string,
+) (*apimodel.AdminApplicationBlock, gtserror.WithCode) {
+	block, err := p.state.DB.GetApplicationBlockByID(ctx, blockID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting application block %s: %w", blockID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if block == nil {
+		err := fmt.Errorf("application block %s not found", blockID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DeleteApplicationBlockByID(ctx, blockID); err != nil {
+		err := gtserror.Newf("db error deleting application block %s: %w", blockID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.ApplicationBlockToAPIApplicationBlock(block), nil
+}
+
+func (p *Processor) apiAdminApplication(ctx context.Context, app *gtsmodel.Application) (*apimodel.AdminApplication, gtserror.WithCode) {
+	tokensCount, lastUsed, err := p.state.DB.GetApplicationTokenStats(ctx, app.ClientID)
+	if err != nil {
+		err := gtserror.Newf("db error getting token stats of application %s: %w", app.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiApp, err := p.converter.AppToAPIAdminApp(ctx, app, tokensCount, lastUsed)
+	if err != nil {
+		err := gtserror.Newf("error converting application %s to api model: %w", app.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiApp, nil
+}
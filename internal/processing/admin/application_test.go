@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	oautherr "github.com/superseriousbusiness/oauth2/v4/errors"
+)
+
+type ApplicationTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *ApplicationTestSuite) TestApplicationsGet() {
+	resp, errWithCode := suite.adminProcessor.ApplicationsGet(
+		context.Background(),
+		&paging.Page{Limit: 20},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, len(suite.testApplications))
+
+	// Find application_1 in the list,
+	// it should have two access tokens.
+	var found bool
+	for _, item := range resp.Items {
+		app := item.(*apimodel.AdminApplication)
+		if app.ID != suite.testApplications["application_1"].ID {
+			continue
+		}
+		found = true
+		suite.Equal(2, app.TokensCount)
+		suite.Nil(app.RevokedAt)
+		suite.Nil(app.LastUsedAt)
+	}
+	suite.True(found)
+}
+
+func (suite *ApplicationTestSuite) TestApplicationRevoke() {
+	var (
+		ctx = context.Background()
+		app = suite.testApplications["application_1"]
+	)
+
+	apiApp, errWithCode := suite.adminProcessor.ApplicationRevoke(ctx, app.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotNil(apiApp.RevokedAt)
+	suite.Zero(apiApp.TokensCount)
+
+	// Tokens of the app should be gone.
+	_, err := suite.db.GetTokenByAccess(ctx, suite.testTokens["local_account_1"].Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// And the app's client can't be used anymore.
+	_, err = oauth.NewClientStore(suite.db).GetByID(ctx, app.ClientID)
+	suite.ErrorIs(err, oautherr.ErrInvalidClient)
+
+	// Revoking again is fine.
+	again, errWithCode := suite.adminProcessor.ApplicationRevoke(ctx, app.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(apiApp.RevokedAt, again.RevokedAt)
+
+	// The instance app can't be revoked.
+	_, errWithCode = suite.adminProcessor.ApplicationRevoke(ctx, suite.testApplications["instance_application"].ID)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *ApplicationTestSuite) TestApplicationBlocks() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	// At least one pattern is required.
+	_, errWithCode := suite.adminProcessor.ApplicationBlockCreate(ctx, adminAcct, &apimodel.AdminApplicationBlockRequest{})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// And patterns must be valid.
+	_, errWithCode = suite.adminProcessor.ApplicationBlockCreate(ctx, adminAcct, &apimodel.AdminApplicationBlockRequest{
+		NamePattern: "(scraper",
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	block, errWithCode := suite.adminProcessor.ApplicationBlockCreate(ctx, adminAcct, &apimodel.AdminApplicationBlockRequest{
+		NamePattern:    "(?i)scraper",
+		WebsitePattern: `^https://scraper\.example\.org`,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(adminAcct.ID, block.CreatedBy)
+
+	// Registering a matching app should now fail.
+	_, errWithCode = suite.processor.AppCreate(ctx, nil, &apimodel.ApplicationCreateRequest{
+		ClientName:   "Super Scraper",
+		RedirectURIs: "urn:ietf:wg:oauth:2.0:oob",
+		Website:      "https://scraper.example.org/about",
+	})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// But an app matching only one pattern is fine.
+	_, errWithCode = suite.processor.AppCreate(ctx, nil, &apimodel.ApplicationCreateRequest{
+		ClientName:   "Super Scraper",
+		RedirectURIs: "urn:ietf:wg:oauth:2.0:oob",
+		Website:      "https://example.org",
+	})
+	suite.Nil(errWithCode)
+
+	// Delete the block and it's gone.
+	if _, errWithCode := suite.adminProcessor.ApplicationBlockDelete(ctx, block.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	blocks, errWithCode := suite.adminProcessor.ApplicationBlocksGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(blocks)
+}
+
+func TestApplicationTestSuite(t *testing.T) {
+	suite.Run(t, new(ApplicationTestSuite))
+}
@@ -0,0 +1,535 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-kv"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// blockListsFetchEvery is the
+	// interval between fetches of
+	// configured block lists.
+	blockListsFetchEvery = 6 * time.Hour
+
+	// blockListMaxSize is the max size
+	// in bytes of a block list to read.
+	blockListMaxSize = 16 * 1024 * 1024
+
+	// blockListMaxPages is the max number
+	// of pages of a FIRES dataset to fetch.
+	blockListMaxPages = 100
+)
+
+// blockListEntry is one entry of a
+// shared domain block list, as served
+// by eg., Mastodon's public domain
+// blocks endpoint, and Fediblock feeds.
+type blockListEntry struct {
+	Domain        string `json:"domain"`
+	Severity      string `json:"severity"`
+	Comment       string `json:"comment"`
+	PublicComment string `json:"public_comment"`
+
+	// retracted is set for FIRES
+	// changes that withdraw any
+	// earlier recommendation.
+	retracted bool
+}
+
+// firesCollection is a (page of a) FIRES dataset,
+// an ActivityStreams ordered collection of changes.
+type firesCollection struct {
+	Type         string          `json:"type"`
+	OrderedItems []firesChange   `json:"orderedItems"`
+	First        json.RawMessage `json:"first"`
+	Next         string          `json:"next"`
+}
+
+// firesChange is one change in a FIRES dataset.
+type firesChange struct {
+	Type              string `json:"type"`
+	EntityKind        string `json:"entityKind"`
+	EntityKey         string `json:"entityKey"`
+	RecommendedPolicy string `json:"recommendedPolicy"`
+	Comment           string `json:"comment"`
+}
+
+// BlockListsSchedule schedules fetching of the block lists at
+// configured federation-block-list-urls, first shortly after
+// startup, and then every 6 hours. It should be called once
+// on startup, after the worker pools have been started.
+func (p *Processor) BlockListsSchedule(ctx context.Context) error {
+	if len(config.GetFederationBlockListURLs()) == 0 {
+		log.Info(ctx, "no block lists configured")
+		return nil
+	}
+
+	// Give the instance a minute to
+	// settle before the first fetch.
+	firstFetchAt := time.Now().Add(time.Minute)
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting block lists fetch")
+		p.BlockListsFetch(ctx)
+		log.Infof(ctx, "finished block lists fetch after %s", time.Since(start))
+	}
+
+	log.Infof(ctx,
+		"scheduling block lists fetch to run every %s; next fetch will be at %s",
+		blockListsFetchEvery, firstFetchAt,
+	)
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@blocklists",
+		firstFetchAt,
+		blockListsFetchEvery,
+		fn,
+	) {
+		return gtserror.New("failed to schedule @blocklists")
+	}
+
+	return nil
+}
+
+// BlockListsFetch fetches each of the block lists at configured
+// federation-block-list-urls, and applies them with BlockListApply.
+// Errors with any one list are logged, and don't stop the others.
+func (p *Processor) BlockListsFetch(ctx context.Context) {
+	for _, listURL := range config.GetFederationBlockListURLs() {
+		if err := p.fetchBlockList(ctx, listURL); err != nil {
+			log.Errorf(ctx, "error fetching block list %s: %v", listURL, err)
+		}
+	}
+}
+
+// fetchBlockList fetches the block list at given URL,
+// following the pages of a FIRES dataset, and applies it.
+func (p *Processor) fetchBlockList(ctx context.Context, listURL string) error {
+	// Fetch using the instance account.
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance transport: %w", err)
+	}
+
+	var (
+		entries []blockListEntry
+		pageURL = listURL
+		fetched = make(map[string]struct{})
+	)
+
+	for pageURL != "" {
+		if _, ok := fetched[pageURL]; ok {
+			return gtserror.Newf("page loop at %s", pageURL)
+		}
+
+		if len(fetched) >= blockListMaxPages {
+			return gtserror.Newf("more than %d pages", blockListMaxPages)
+		}
+
+		fetched[pageURL] = struct{}{}
+
+		// Fetch and decode the next page.
+		page, next, err := fetchBlockListPage(ctx, tsport, pageURL)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, page...)
+		pageURL = next
+	}
+
+	return p.applyBlockList(ctx, listURL, entries)
+}
+
+// fetchBlockListPage fetches and decodes the block list
+// (page) at given URL, returning the URL of the next page
+// to fetch, if any, as with decodeBlockList.
+func fetchBlockListPage(
+	ctx context.Context,
+	tsport transport.Transport,
+	pageURL string,
+) ([]blockListEntry, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, "", gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json, application/ld+json")
+
+	rsp, err := tsport.GET(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, "", gtserror.NewFromResponse(rsp)
+	}
+
+	return decodeBlockList(io.LimitReader(rsp.Body, blockListMaxSize))
+}
+
+// decodeBlockList decodes a block list, either a JSON array of
+// Mastodon style domain blocks, or a (page of a) FIRES dataset.
+// For a FIRES dataset split into pages, the URL of the next page
+// to fetch is also returned.
+func decodeBlockList(r io.Reader) ([]blockListEntry, string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", gtserror.Newf("error reading block list: %w", err)
+	}
+
+	data = bytes.TrimSpace(data)
+
+	if !bytes.HasPrefix(data, []byte("{")) {
+		// Not an object, so expect
+		// a Mastodon style array.
+		var entries []blockListEntry
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, "", gtserror.Newf("error decoding block list: %w", err)
+		}
+		return entries, "", nil
+	}
+
+	var coll firesCollection
+	if err := json.Unmarshal(data, &coll); err != nil {
+		return nil, "", gtserror.Newf("error decoding FIRES dataset: %w", err)
+	}
+
+	switch coll.Type {
+	case "OrderedCollection", "OrderedCollectionPage":
+	default:
+		return nil, "", gtserror.Newf("error decoding block list: not an array or FIRES dataset (type %q)", coll.Type)
+	}
+
+	next := coll.Next
+	items := coll.OrderedItems
+
+	if len(coll.First) > 0 {
+		// The first page is either
+		// a link, or embedded inline.
+		var first string
+		if json.Unmarshal(coll.First, &first) == nil {
+			next = first
+		} else {
+			var page firesCollection
+			if err := json.Unmarshal(coll.First, &page); err != nil {
+				return nil, "", gtserror.Newf("error decoding FIRES dataset first page: %w", err)
+			}
+			items = append(items, page.OrderedItems...)
+			next = page.Next
+		}
+	}
+
+	entries := make([]blockListEntry, 0, len(items))
+	for _, change := range items {
+		if change.EntityKind != "domain" {
+			// Only domains
+			// can be blocked.
+			continue
+		}
+
+		entry := blockListEntry{
+			Domain:  change.EntityKey,
+			Comment: change.Comment,
+		}
+
+		switch change.Type {
+
+		// Recommended policy for a domain,
+		// mapped onto domain block severity.
+		case "Recommendation":
+			switch change.RecommendedPolicy {
+			case "drop", "reject":
+				entry.Severity = "suspend"
+			case "filter":
+				entry.Severity = "silence"
+			default:
+				// "accept", or none,
+				// is not a block.
+				entry.retracted = true
+			}
+
+		// Earlier recommendation
+		// for domain withdrawn.
+		case "Retraction", "Tombstone":
+			entry.retracted = true
+
+		// Eg., "Advisory", which only
+		// labels a domain for humans.
+		default:
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, next, nil
+}
+
+// BlockListApply parses a shared domain block list, fetched from
+// listURL, and applies it. The list may be either a JSON array of
+// objects as served by Mastodon's public domain blocks endpoint, each
+// with a domain, and optionally a severity ("suspend", the default,
+// or "silence" / "limit") and a comment (or public_comment); or a FIRES
+// dataset, an ordered collection of changes to recommended policies.
+//
+// For a FIRES dataset, only domain changes are used, and the latest
+// change for each domain wins: a "drop" or "reject" recommendation is
+// a suspension, and "filter" a limit. A retraction, or a recommendation
+// to "accept", withdraws any earlier recommendation for the domain.
+// Advisories are ignored. Only the changes in the given reader are
+// applied, further pages of the dataset are not fetched.
+//
+// Any new blocks from the list are applied as blocks by the instance
+// account, noting the list URL in their private comment, and recorded
+// in the admin action log like any other domain block.
+//
+// Existing blocks which were applied from a block list are updated
+// to match the list, unless they have been pinned by an admin. As
+// when blocks are created via the API, a suspension can't be changed
+// into a limit, only the other way around. Blocks created by an admin,
+// rather than from a list, are never changed. Nor are blocks removed
+// if their domain is later removed from (or retracted by) the list.
+//
+// Entries with obfuscated domains, or invalid domains or severities,
+// are skipped. Each block applied or changed is logged at info level.
+func (p *Processor) BlockListApply(ctx context.Context, listURL string, r io.Reader) error {
+	entries, _, err := decodeBlockList(r)
+	if err != nil {
+		return err
+	}
+
+	return p.applyBlockList(ctx, listURL, entries)
+}
+
+// applyBlockList applies the given decoded entries
+// of block list at listURL, as described on BlockListApply.
+func (p *Processor) applyBlockList(ctx context.Context, listURL string, entries []blockListEntry) error {
+	// Blocks from lists are
+	// by the instance account.
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	// Collapse entries to the latest for
+	// each domain, in order of first entry,
+	// dropping any domains retracted since.
+	latest := make(map[string]int, len(entries))
+	collapsed := make([]*blockListEntry, 0, len(entries))
+	for i := range entries {
+		entry := &entries[i]
+
+		j, ok := latest[entry.Domain]
+		if !ok {
+			j = len(collapsed)
+			latest[entry.Domain] = j
+			collapsed = append(collapsed, nil)
+		}
+
+		if entry.retracted {
+			collapsed[j] = nil
+		} else {
+			collapsed[j] = entry
+		}
+	}
+
+	var errs gtserror.MultiError
+
+	for _, entry := range collapsed {
+		if entry == nil {
+			// Retracted.
+			continue
+		}
+
+		if err := p.applyBlockListEntry(ctx,
+			instanceAcct,
+			listURL,
+			*entry,
+		); err != nil {
+			errs.Appendf("error applying block of %s: %w", entry.Domain, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// applyBlockListEntry applies one entry from the block
+// list at listURL, as described on BlockListApply.
+func (p *Processor) applyBlockListEntry(
+	ctx context.Context,
+	instanceAcct *gtsmodel.Account,
+	listURL string,
+	entry blockListEntry,
+) error {
+	l := log.WithContext(ctx).WithFields(kv.Fields{
+		{"blockList", listURL},
+		{"domain", entry.Domain},
+	}...)
+
+	if strings.Contains(entry.Domain, "*") {
+		// Lists may obfuscate
+		// domains; we can't
+		// block these.
+		l.Debug("skipping obfuscated domain")
+		return nil
+	}
+
+	domain, err := util.Punify(strings.ToLower(strings.TrimSpace(entry.Domain)))
+	if err != nil || domain == "" {
+		l.Warn("skipping invalid domain")
+		return nil
+	}
+
+	severity, ok := gtsmodel.ParseDomainBlockSeverity(strings.ToLower(entry.Severity))
+	if !ok {
+		// Includes "noop", which
+		// is not a block at all.
+		l.Warnf("skipping unsupported severity %q", entry.Severity)
+		return nil
+	}
+
+	publicComment := entry.PublicComment
+	if publicComment == "" {
+		publicComment = entry.Comment
+	}
+	publicComment = text.SanitizeToPlaintext(publicComment)
+
+	domainBlock, err := p.state.DB.GetDomainBlock(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting domain block: %w", err)
+	}
+
+	switch {
+
+	// Not yet blocked,
+	// apply new block.
+	case domainBlock == nil:
+		if _, _, errWithCode := p.createDomainBlock(ctx,
+			instanceAcct,
+			domain,
+			false, // Not obfuscated.
+			publicComment,
+			"Applied from block list "+listURL,
+			"", // No sub ID for block lists.
+			listURL,
+			severity,
+		); errWithCode != nil {
+			return errWithCode
+		}
+
+		l.WithField("severity", severity.String()).Info("applied domain block from block list")
+		return nil
+
+	// Blocked by an admin,
+	// leave it to them.
+	case domainBlock.BlockListURL == "":
+		return nil
+
+	// Pinned by an admin,
+	// don't change it.
+	case util.PtrValueOr(domainBlock.Pinned, false):
+		return nil
+	}
+
+	if domainBlock.Severity != severity {
+		if severity == gtsmodel.DomainBlockLimit {
+			// Suspensions can't be
+			// downgraded to limits.
+			l.Debug("skipping downgrade of suspension to limit")
+		} else {
+			// Let block creation handle
+			// the change of severity.
+			if _, _, errWithCode := p.createDomainBlock(ctx,
+				instanceAcct,
+				domain,
+				false, // Not obfuscated.
+				publicComment,
+				domainBlock.PrivateComment,
+				"", // No sub ID for block lists.
+				listURL,
+				severity,
+			); errWithCode != nil {
+				return errWithCode
+			}
+
+			l.WithField("severity", severity.String()).Info("changed severity of domain block from block list")
+		}
+	}
+
+	if domainBlock.PublicComment != publicComment {
+		domainBlock.PublicComment = publicComment
+		if err := p.state.DB.UpdateDomainBlock(ctx, domainBlock,
+			"public_comment",
+		); err != nil {
+			return gtserror.Newf("db error updating domain block: %w", err)
+		}
+
+		l.Info("changed public comment of domain block from block list")
+	}
+
+	return nil
+}
+
+// DomainBlockPin pins or unpins the domain block with given ID.
+// Pinned blocks are not changed by updates to the block list they
+// were applied from, if any.
+func (p *Processor) DomainBlockPin(
+	ctx context.Context,
+	id string,
+	pinned bool,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	domainBlock, err := p.state.DB.GetDomainBlockByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no domain block exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	domainBlock.Pinned = &pinned
+	if err := p.state.DB.UpdateDomainBlock(ctx, domainBlock, "pinned"); err != nil {
+		err = gtserror.Newf("db error updating domain block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPerm(ctx, domainBlock, false)
+}
@@ -0,0 +1,168 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type BlockListTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *BlockListTestSuite) TestBlockListApply() {
+	const listURL = "https://blocklist.example.org/blocks.json"
+	ctx := context.Background()
+
+	apply := func(list string) {
+		if err := suite.adminProcessor.BlockListApply(ctx, listURL, strings.NewReader(list)); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	apply(`[
+  {"domain": "spam.example.org", "severity": "silence", "comment": "casino spam"},
+  {"domain": "replyguys.com", "severity": "silence", "comment": "nope"},
+  {"domain": "*.example.net", "severity": "suspend", "comment": "obfuscated"},
+  {"domain": "noop.example.org", "severity": "noop", "comment": "not a block"}
+]`)
+
+	// New block should have been applied
+	// by the instance account, noting
+	// where it came from.
+	block, err := suite.db.GetDomainBlock(ctx, "spam.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instanceAcct, err := suite.db.GetInstanceAccount(ctx, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.DomainBlockLimit, block.Severity)
+	suite.Equal("casino spam", block.PublicComment)
+	suite.Equal("Applied from block list "+listURL, block.PrivateComment)
+	suite.Equal(listURL, block.BlockListURL)
+	suite.Equal(instanceAcct.ID, block.CreatedByAccountID)
+	suite.False(*block.Pinned)
+
+	// Block created by an admin
+	// should have been left as-is.
+	block, err = suite.db.GetDomainBlock(ctx, "replyguys.com")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.DomainBlockSuspend, block.Severity)
+	suite.Equal("reply-guying to tech posts", block.PublicComment)
+	suite.Empty(block.BlockListURL)
+
+	// Nothing else should be blocked.
+	blocked, err := suite.db.IsDomainBlocked(ctx, "noop.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(blocked)
+
+	// Updates to the list should
+	// change its blocks, until pinned.
+	apply(`[{"domain": "spam.example.org", "severity": "silence", "comment": "casino and crypto spam"}]`)
+	block, err = suite.db.GetDomainBlock(ctx, "spam.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("casino and crypto spam", block.PublicComment)
+
+	apiBlock, errWithCode := suite.adminProcessor.DomainBlockPin(ctx, block.ID, true)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(util.Ptr(true), apiBlock.Pinned)
+
+	apply(`[{"domain": "spam.example.org", "severity": "silence", "comment": "spam"}]`)
+	block, err = suite.db.GetDomainBlock(ctx, "spam.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("casino and crypto spam", block.PublicComment)
+}
+
+func (suite *BlockListTestSuite) TestBlockListApplyFIRES() {
+	const listURL = "https://fires.example.org/datasets/01J1NB9Z3B1V7PZ3QZ3Q8K5GQA"
+	ctx := context.Background()
+
+	if err := suite.adminProcessor.BlockListApply(ctx, listURL, strings.NewReader(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    {"type": "Recommendation", "entityKind": "domain", "entityKey": "drop.example.org", "recommendedPolicy": "drop", "comment": "harassment"},
+    {"type": "Recommendation", "entityKind": "domain", "entityKey": "filter.example.org", "recommendedPolicy": "filter"},
+    {"type": "Recommendation", "entityKind": "domain", "entityKey": "retracted.example.org", "recommendedPolicy": "reject"},
+    {"type": "Retraction", "entityKind": "domain", "entityKey": "retracted.example.org"},
+    {"type": "Advisory", "entityKind": "domain", "entityKey": "advisory.example.org"},
+    {"type": "Recommendation", "entityKind": "actor", "entityKey": "https://actor.example.org/users/someone", "recommendedPolicy": "drop"}
+  ]
+}`)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	block, err := suite.db.GetDomainBlock(ctx, "drop.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.DomainBlockSuspend, block.Severity)
+	suite.Equal("harassment", block.PublicComment)
+	suite.Equal(listURL, block.BlockListURL)
+
+	block, err = suite.db.GetDomainBlock(ctx, "filter.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.DomainBlockLimit, block.Severity)
+
+	// Retracted recommendations, advisories
+	// and actors shouldn't result in blocks.
+	for _, domain := range []string{
+		"retracted.example.org",
+		"advisory.example.org",
+		"actor.example.org",
+	} {
+		blocked, err := suite.db.IsDomainBlocked(ctx, domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.False(blocked, domain)
+	}
+}
+
+func (suite *BlockListTestSuite) TestBlockListApplyInvalid() {
+	err := suite.adminProcessor.BlockListApply(
+		context.Background(),
+		"https://blocklist.example.org/blocks.json",
+		strings.NewReader(`{"domain": "not.a.list.example.org"}`),
+	)
+	suite.ErrorContains(err, "error decoding block list")
+}
+
+func TestBlockListTestSuite(t *testing.T) {
+	suite.Run(t, new(BlockListTestSuite))
+}
@@ -0,0 +1,279 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// DebugVisibility runs the visibility checks used when
+// serving and timelining statuses, for the given account
+// viewing the given status, and explains the outcome of
+// each. It doesn't change anything, nor use or populate
+// the visibility cache, but is recorded in the action log.
+//
+// Errors returned from this function should be fairly
+// verbose, to help with debugging.
+func (p *Processor) DebugVisibility(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+	statusID string,
+) (*apimodel.DebugVisibilityResponse, gtserror.WithCode) {
+	if accountID == "" || statusID == "" {
+		err := gtserror.New("both account_id and status_id must be provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account == nil {
+		err := gtserror.Newf("account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	status, err := p.state.DB.GetStatusByID(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status %s: %w", statusID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil {
+		err := gtserror.Newf("status %s not found", statusID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	resp := &apimodel.DebugVisibilityResponse{
+		AccountID: account.ID,
+		StatusID:  status.ID,
+	}
+
+	// Check whether status is visible to account at all.
+	check, err := explainCheck(ctx, "status_visible", func(ctx context.Context) (bool, error) {
+		return p.visFilter.StatusVisible(ctx, account, status)
+	})
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	resp.Checks = append(resp.Checks, check)
+	resp.Visible = check.Passed
+
+	// Check whether status would go in account's home timeline.
+	check, err = explainCheck(ctx, "home_timelineable", func(ctx context.Context) (bool, error) {
+		return p.visFilter.StatusHomeTimelineable(ctx, account, status)
+	})
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	resp.Checks = append(resp.Checks, check)
+
+	// Check whether status would go in any of the
+	// account's lists, via their follow of the author.
+	listChecks, err := p.debugListChecks(ctx, account, status)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	resp.Checks = append(resp.Checks, listChecks...)
+
+	// Check whether status is hidden or warned
+	// about by account's filters, or by mutes.
+	filterChecks, err := p.debugFilterChecks(ctx, account, status)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	resp.Checks = append(resp.Checks, filterChecks...)
+
+	// Record that admin has looked into this.
+	p.logAction(ctx, adminAcct, account,
+		gtsmodel.AdminActionDebugVisibility,
+		"visibility of status "+status.ID,
+	)
+
+	return resp, nil
+}
+
+// explainCheck runs the given visibility check in explain mode,
+// returning its outcome along with any reasons it has given.
+func explainCheck(
+	ctx context.Context,
+	name string,
+	check func(context.Context) (bool, error),
+) (apimodel.DebugVisibilityCheck, error) {
+	ctx, explanation := visibility.Explain(ctx)
+
+	passed, err := check(ctx)
+	if err != nil {
+		err := gtserror.Newf("error running %s check: %w", name, err)
+		return apimodel.DebugVisibilityCheck{}, err
+	}
+
+	return apimodel.DebugVisibilityCheck{
+		Name:    name,
+		Passed:  passed,
+		Reasons: explanation.Reasons(),
+	}, nil
+}
+
+// debugListChecks checks the eligibility of status for each of
+// the lists that account has put their follow of status author in.
+func (p *Processor) debugListChecks(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	status *gtsmodel.Status,
+) ([]apimodel.DebugVisibilityCheck, error) {
+	follow, err := p.state.DB.GetFollow(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		status.AccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting follow: %w", err)
+	}
+
+	if follow == nil {
+		// Not following author,
+		// so no lists to check.
+		return nil, nil
+	}
+
+	listEntries, err := p.state.DB.GetListEntriesForFollowID(
+		// We only need the list IDs.
+		gtscontext.SetBarebones(ctx),
+		follow.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting list entries: %w", err)
+	}
+
+	checks := make([]apimodel.DebugVisibilityCheck, 0, len(listEntries))
+	for _, listEntry := range listEntries {
+		check, err := explainCheck(ctx, "list", func(ctx context.Context) (bool, error) {
+			return p.visFilter.StatusListEligible(ctx, listEntry.ListID, status)
+		})
+		if err != nil {
+			return nil, err
+		}
+		check.ListID = listEntry.ListID
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+// debugFilterChecks checks whether status would be hidden from
+// or warned about to account in home / list timelines, by any of
+// their filters, or by their mutes, returning a check for each.
+func (p *Processor) debugFilterChecks(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	status *gtsmodel.Status,
+) ([]apimodel.DebugVisibilityCheck, error) {
+	const filterContext = statusfilter.FilterContextHome
+
+	filters, err := p.state.DB.GetFiltersForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting filters: %w", err)
+	}
+
+	filterCheck := apimodel.DebugVisibilityCheck{
+		Name:   "filters",
+		Passed: true,
+	}
+
+	// Check each filter separately,
+	// so we know which is responsible.
+	for _, filter := range filters {
+		apiStatus, err := p.converter.StatusToAPIStatus(ctx,
+			status,
+			account,
+			filterContext,
+			[]*gtsmodel.Filter{filter},
+			nil,
+		)
+
+		switch {
+		case errors.Is(err, statusfilter.ErrHideStatus):
+			filterCheck.Passed = false
+			filterCheck.Reasons = append(filterCheck.Reasons,
+				fmt.Sprintf("hidden by filter %q", filter.Title),
+			)
+
+		case err != nil:
+			return nil, gtserror.Newf("error converting status: %w", err)
+
+		case len(apiStatus.Filtered) > 0:
+			filterCheck.Reasons = append(filterCheck.Reasons,
+				fmt.Sprintf("warned about by filter %q", filter.Title),
+			)
+		}
+	}
+
+	mutes, err := p.state.DB.GetAccountMutes(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting mutes: %w", err)
+	}
+	compiledMutes := usermute.NewCompiledUserMuteList(mutes)
+
+	muteCheck := apimodel.DebugVisibilityCheck{
+		Name:   "mutes",
+		Passed: true,
+	}
+
+	_, err = p.converter.StatusToAPIStatus(ctx,
+		status,
+		account,
+		filterContext,
+		nil,
+		compiledMutes,
+	)
+
+	switch {
+	case errors.Is(err, statusfilter.ErrHideStatus):
+		muteCheck.Passed = false
+		if compiledMutes.Matches(status.AccountID, filterContext, time.Now()) {
+			muteCheck.Reasons = []string{"status author is muted"}
+		} else {
+			muteCheck.Reasons = []string{"all other accounts in conversation are muted or not visible"}
+		}
+
+	case err != nil:
+		return nil, gtserror.Newf("error converting status: %w", err)
+	}
+
+	return []apimodel.DebugVisibilityCheck{filterCheck, muteCheck}, nil
+}
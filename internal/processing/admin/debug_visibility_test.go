@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DebugVisibilityTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DebugVisibilityTestSuite) TestDebugVisibilityVisible() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		account   = suite.testAccounts["local_account_1"]
+		status    = suite.testStatuses["admin_account_status_1"]
+	)
+
+	resp, errWithCode := suite.adminProcessor.DebugVisibility(ctx, adminAcct, account.ID, status.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.True(resp.Visible)
+	for _, check := range resp.Checks {
+		suite.True(check.Passed, check.Name)
+		suite.Empty(check.Reasons, check.Name)
+	}
+
+	names := make([]string, 0, len(resp.Checks))
+	for _, check := range resp.Checks {
+		names = append(names, check.Name)
+	}
+	suite.Equal([]string{"status_visible", "home_timelineable", "list", "filters", "mutes"}, names)
+
+	// local_account_1 has admin in a list.
+	suite.Equal("01H0G8E4Q2J3FE3JDWJVWEDCD1", resp.Checks[2].ListID)
+
+	// The check should be in the action log.
+	entries, err := suite.db.GetAdminActionLogs(ctx, adminAcct.ID, account.ID, time.Time{}, time.Time{}, &paging.Page{Limit: 1})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(entries, 1)
+	suite.Equal(gtsmodel.AdminActionDebugVisibility, entries[0].Action)
+	suite.Equal("visibility of status "+status.ID, entries[0].Reason)
+}
+
+func (suite *DebugVisibilityTestSuite) TestDebugVisibilityBlocked() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		account   = suite.testAccounts["remote_account_1"]
+		status    = suite.testStatuses["local_account_2_status_1"]
+	)
+
+	// local_account_2 blocks remote_account_1.
+	resp, errWithCode := suite.adminProcessor.DebugVisibility(ctx, adminAcct, account.ID, status.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.False(resp.Visible)
+	suite.Equal(apimodel.DebugVisibilityCheck{
+		Name:   "status_visible",
+		Passed: false,
+		Reasons: []string{
+			"block exists between accounts",
+			"status author not visible to requester",
+		},
+	}, resp.Checks[0])
+	suite.Equal("home_timelineable", resp.Checks[1].Name)
+	suite.False(resp.Checks[1].Passed)
+	suite.Equal("status not visible to timeline owner", resp.Checks[1].Reasons[len(resp.Checks[1].Reasons)-1])
+}
+
+func (suite *DebugVisibilityTestSuite) TestDebugVisibilityNotFound() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		account   = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.adminProcessor.DebugVisibility(ctx, adminAcct, account.ID, "01HZZZZZZZZZZZZZZZZZZZZZZZ")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.adminProcessor.DebugVisibility(ctx, adminAcct, account.ID, "")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestDebugVisibilityTestSuite(t *testing.T) {
+	suite.Run(t, new(DebugVisibilityTestSuite))
+}
@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DeliveryAuditGet returns entries from the federation audit log,
+// newest first, optionally filtered by target domain, activity type,
+// and created_at range (startAt inclusive, endAt exclusive).
+func (p *Processor) DeliveryAuditGet(
+	ctx context.Context,
+	domain string,
+	activityType string,
+	startAt time.Time,
+	endAt time.Time,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	if domain != "" {
+		// Entries are stored with
+		// punycode domains, so
+		// match on the same.
+		var err error
+		domain, err = util.Punify(domain)
+		if err != nil {
+			err := gtserror.Newf("invalid domain %s: %w", domain, err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	entries, err := p.state.DB.GetDeliveryAudits(
+		ctx,
+		domain,
+		activityType,
+		startAt,
+		endAt,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting federation audit log: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(entries)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := entries[count-1].ID
+	hi := entries[0].ID
+
+	// Convert each entry to API model.
+	items := make([]interface{}, 0, count)
+	for _, entry := range entries {
+		items = append(items, p.converter.DeliveryAuditToAdminAPIDeliveryAudit(entry))
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 4)
+	if domain != "" {
+		query.Set(apiutil.AdminDomainKey, domain)
+	}
+	if activityType != "" {
+		query.Set(apiutil.AdminActivityTypeKey, activityType)
+	}
+	if !startAt.IsZero() {
+		query.Set(apiutil.AdminStartAtKey, startAt.Format(time.RFC3339))
+	}
+	if !endAt.IsZero() {
+		query.Set(apiutil.AdminEndAtKey, endAt.Format(time.RFC3339))
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/federation_audit",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DomainDeliveryStatusGet returns the health of
+// outgoing deliveries to the given remote domain.
+func (p *Processor) DomainDeliveryStatusGet(
+	ctx context.Context,
+	domain string,
+) (*apimodel.AdminDomainDeliveryStatus, gtserror.WithCode) {
+	health, errWithCode := p.getDomainDeliveryHealth(ctx, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.DomainDeliveryHealthToAdminAPIDomainDeliveryStatus(health), nil
+}
+
+// DomainDeliveryRetry clears any backoff from delivering to
+// the given remote domain, releasing deliveries to it held
+// back by this node's delivery workers to be retried now.
+func (p *Processor) DomainDeliveryRetry(
+	ctx context.Context,
+	domain string,
+) (*apimodel.AdminDomainDeliveryStatus, gtserror.WithCode) {
+	health, errWithCode := p.getDomainDeliveryHealth(ctx, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if health.ConsecutiveFailures != 0 || !health.NextAttemptAt.IsZero() {
+		// Clear failures + backoff, keeping
+		// the error samples for reference.
+		health.ConsecutiveFailures = 0
+		health.NextAttemptAt = time.Time{}
+
+		if err := p.state.DB.PutDomainDeliveryHealth(ctx, health); err != nil {
+			err := gtserror.Newf("db error storing delivery health of %s: %w", health.Domain, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if h := p.state.Workers.Delivery.Health; h != nil {
+		// Drop cached health and wake
+		// workers so deliveries held
+		// back are retried right away.
+		h.Forget(health.Domain)
+	}
+
+	return p.converter.DomainDeliveryHealthToAdminAPIDomainDeliveryStatus(health), nil
+}
+
+// getDomainDeliveryHealth gets the stored delivery health of domain,
+// returning a healthy entry for it if none is stored (i.e. no failures).
+func (p *Processor) getDomainDeliveryHealth(
+	ctx context.Context,
+	domain string,
+) (*gtsmodel.DomainDeliveryHealth, gtserror.WithCode) {
+	// Health is stored
+	// by punycode domain.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		err := gtserror.Newf("invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	health, err := p.state.DB.GetDomainDeliveryHealth(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting delivery health of %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if health == nil {
+		// Nothing stored,
+		// domain is healthy.
+		health = &gtsmodel.DomainDeliveryHealth{
+			Domain: domain,
+		}
+	}
+
+	return health, nil
+}
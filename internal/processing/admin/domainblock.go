@@ -33,9 +33,15 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 )
 
+// domainBlockBatchSize is the number of accounts
+// to process at a time for domain block side
+// effects, between updates to stored progress.
+const domainBlockBatchSize = 50
+
 func (p *Processor) createDomainBlock(
 	ctx context.Context,
 	adminAcct *gtsmodel.Account,
@@ -44,6 +50,8 @@ func (p *Processor) createDomainBlock(
 	publicComment string,
 	privateComment string,
 	subscriptionID string,
+	blockListURL string,
+	severity gtsmodel.DomainBlockSeverity,
 ) (*apimodel.DomainPermission, string, gtserror.WithCode) {
 	// Check if a block already exists for this domain.
 	domainBlock, err := p.state.DB.GetDomainBlock(ctx, domain)
@@ -63,6 +71,13 @@ func (p *Processor) createDomainBlock(
 			PublicComment:      text.SanitizeToPlaintext(publicComment),
 			Obfuscate:          &obfuscate,
 			SubscriptionID:     subscriptionID,
+			BlockListURL:       blockListURL,
+			Severity:           severity,
+		}
+
+		if severity == gtsmodel.DomainBlockLimit {
+			// Limits have no side effects to process.
+			domainBlock.SideEffectsCompletedAt = time.Now()
 		}
 
 		// Insert the new block into the database.
@@ -70,35 +85,95 @@ func (p *Processor) createDomainBlock(
 			err = gtserror.Newf("db error putting domain block %s: %w", domain, err)
 			return nil, "", gtserror.NewErrorInternalError(err)
 		}
+	} else if domainBlock.Severity != severity {
+		if severity == gtsmodel.DomainBlockLimit {
+			// Accounts of a suspended domain have already been
+			// suspended, so it can't just be downgraded to a limit.
+			const text = "domain is already suspended; remove the existing block before limiting it"
+			return nil, "", gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		// Upgrade the existing limit to a suspension,
+		// processing the side effects from the start.
+		domainBlock.Severity = severity
+		domainBlock.SideEffectsCompletedAt = time.Time{}
+		if err := p.state.DB.UpdateDomainBlock(ctx, domainBlock,
+			"severity",
+			"side_effects_completed_at",
+		); err != nil {
+			err = gtserror.Newf("db error updating domain block %s: %w", domain, err)
+			return nil, "", gtserror.NewErrorInternalError(err)
+		}
 	}
 
-	actionID := id.NewULID()
+	// Prepare the domain block to return, *before*
+	// side effects start updating its progress.
+	apiDomainBlock, errWithCode := p.apiDomainPerm(ctx, domainBlock, false)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	if domainBlock.Severity == gtsmodel.DomainBlockLimit {
+		// Limited domains are filtered when
+		// timelines are built; nothing to do.
+		return apiDomainBlock, "", nil
+	}
+
+	// Count known accounts that will be
+	// affected by the block's side effects.
+	affected, err := p.state.DB.CountInstanceAccounts(ctx, domain)
+	if err != nil {
+		err = gtserror.Newf("db error counting accounts for domain %s: %w", domain, err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+	apiDomainBlock.AffectedAccountsCount = &affected
 
 	// Process domain block side
 	// effects asynchronously.
-	if errWithCode := p.actions.Run(
+	actionID, errWithCode := p.runDomainBlockSideEffects(ctx, adminAcct.ID, domainBlock)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	return apiDomainBlock, actionID, nil
+}
+
+// runDomainBlockSideEffects runs an admin action processing
+// side effects of the given domain block, resuming from the
+// progress stored on the block if they weren't completed.
+func (p *Processor) runDomainBlockSideEffects(
+	ctx context.Context,
+	adminAcctID string,
+	domainBlock *gtsmodel.DomainBlock,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
 		ctx,
 		&gtsmodel.AdminAction{
 			ID:             actionID,
 			TargetCategory: gtsmodel.AdminActionCategoryDomain,
-			TargetID:       domain,
+			TargetID:       domainBlock.Domain,
 			Type:           gtsmodel.AdminActionSuspend,
-			AccountID:      adminAcct.ID,
+			AccountID:      adminAcctID,
 			Text:           domainBlock.PrivateComment,
 		},
 		func(ctx context.Context) gtserror.MultiError {
 			// Log start + finish.
 			l := log.WithFields(kv.Fields{
-				{"domain", domain},
+				{"domain", domainBlock.Domain},
 				{"actionID", actionID},
 			}...).WithContext(ctx)
 
-			skip, err := p.skipBlockSideEffects(ctx, domain)
+			skip, err := p.skipBlockSideEffects(ctx, domainBlock.Domain)
 			if err != nil {
 				return err
 			}
 			if skip != "" {
 				l.Infof("skipping domain block side effects: %s", skip)
+				if err := p.completeBlockSideEffects(ctx, domainBlock); err != nil {
+					return gtserror.MultiError{err}
+				}
 				return nil
 			}
 
@@ -107,16 +182,42 @@ func (p *Processor) createDomainBlock(
 
 			return p.domainBlockSideEffects(ctx, domainBlock)
 		},
-	); errWithCode != nil {
-		return nil, actionID, errWithCode
+	)
+
+	return actionID, errWithCode
+}
+
+// DomainBlocksResume resumes processing side effects of any
+// domain blocks for which they were not completed, eg., due
+// to a restart while processing. It should be called once on
+// startup, after the worker pools have been started.
+func (p *Processor) DomainBlocksResume(ctx context.Context) error {
+	domainBlocks, err := p.state.DB.GetDomainBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting domain blocks: %w", err)
 	}
 
-	apiDomainBlock, errWithCode := p.apiDomainPerm(ctx, domainBlock, false)
-	if errWithCode != nil {
-		return nil, actionID, errWithCode
+	for _, domainBlock := range domainBlocks {
+		if !domainBlock.SideEffectsCompletedAt.IsZero() {
+			// Nothing to resume.
+			continue
+		}
+
+		log.Infof(ctx,
+			"resuming side effects of domain block %s (%d/%d accounts processed)",
+			domainBlock.Domain, domainBlock.SideEffectsProcessed, domainBlock.SideEffectsTotal,
+		)
+
+		if _, errWithCode := p.runDomainBlockSideEffects(
+			ctx,
+			domainBlock.CreatedByAccountID,
+			domainBlock,
+		); errWithCode != nil {
+			log.Errorf(ctx, "error resuming side effects of domain block %s: %v", domainBlock.Domain, errWithCode)
+		}
 	}
 
-	return apiDomainBlock, actionID, nil
+	return nil
 }
 
 // skipBlockSideEffects checks if side effects of block creation
@@ -172,6 +273,11 @@ func (p *Processor) skipBlockSideEffects(
 //  1. Strip most info away from the instance entry for the domain.
 //  2. Pass each account from the domain to the processor for deletion.
 //
+// Accounts are processed in batches, storing progress on the domain
+// block after each batch so that processing may be resumed if it's
+// interrupted. If side effects were already completed, they will be
+// processed again from the start.
+//
 // It should be called asynchronously, since it can take a while when
 // there are many accounts present on the given domain.
 func (p *Processor) domainBlockSideEffects(
@@ -180,6 +286,13 @@ func (p *Processor) domainBlockSideEffects(
 ) gtserror.MultiError {
 	var errs gtserror.MultiError
 
+	if !block.SideEffectsCompletedAt.IsZero() {
+		// Side effects previously completed,
+		// process them again from the start.
+		block.SideEffectsCursor = ""
+		block.SideEffectsCompletedAt = time.Time{}
+	}
+
 	// If we have an instance entry for this domain,
 	// update it with the new block ID and clear all fields
 	instance, err := p.state.DB.GetInstance(ctx, block.Domain)
@@ -197,26 +310,113 @@ func (p *Processor) domainBlockSideEffects(
 		}
 	}
 
-	// For each account that belongs to this domain,
-	// process an account delete message to remove
-	// that account's posts, media, etc.
-	if err := p.rangeDomainAccounts(ctx, block.Domain, func(account *gtsmodel.Account) {
-		if err := p.state.Workers.Client.Process(ctx, &messages.FromClientAPI{
-			APObjectType:   ap.ActorPerson,
-			APActivityType: ap.ActivityDelete,
-			GTSModel:       block,
-			Origin:         account,
-			Target:         account,
-		}); err != nil {
-			errs.Append(err)
+	if block.SideEffectsCursor == "" {
+		// Starting from the beginning,
+		// count accounts to process.
+		total, err := p.state.DB.CountInstanceAccounts(ctx, block.Domain)
+		if err != nil {
+			errs.Appendf("db error counting accounts: %w", err)
+			return errs
 		}
-	}); err != nil {
-		errs.Appendf("db error ranging through accounts: %w", err)
+
+		block.SideEffectsProcessed = 0
+		block.SideEffectsTotal = total
+		if err := p.state.DB.UpdateDomainBlock(ctx, block,
+			"side_effects_cursor",
+			"side_effects_processed",
+			"side_effects_total",
+			"side_effects_completed_at",
+		); err != nil {
+			errs.Appendf("db error updating domain block: %w", err)
+			return errs
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			// Stopped, eg., by domain
+			// unblock or server shutdown.
+			errs.Appendf("interrupted: %w", err)
+			return errs
+		}
+
+		// Get next batch of accounts, from
+		// after the last one processed (if any).
+		accounts, err := p.state.DB.GetInstanceAccounts(ctx,
+			block.Domain,
+			block.SideEffectsCursor,
+			domainBlockBatchSize,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("db error getting instance accounts: %w", err)
+			return errs
+		}
+
+		if len(accounts) == 0 {
+			// No accounts left, we're done.
+			break
+		}
+
+		// For each account that belongs to this domain,
+		// process an account delete message to remove
+		// that account's posts, media, etc.
+		for _, account := range accounts {
+			if err := p.state.Workers.Client.Process(ctx, &messages.FromClientAPI{
+				APObjectType:   ap.ActorPerson,
+				APActivityType: ap.ActivityDelete,
+				GTSModel:       block,
+				Origin:         account,
+				Target:         account,
+			}); err != nil {
+				errs.Append(err)
+			}
+		}
+
+		// Store progress, to resume
+		// from here if interrupted.
+		block.SideEffectsCursor = accounts[len(accounts)-1].ID
+		block.SideEffectsProcessed += len(accounts)
+		if err := p.state.DB.UpdateDomainBlock(ctx, block,
+			"side_effects_cursor",
+			"side_effects_processed",
+		); err != nil {
+			errs.Appendf("db error updating domain block: %w", err)
+			return errs
+		}
+	}
+
+	if err := p.completeBlockSideEffects(ctx, block); err != nil {
+		errs.Append(err)
 	}
 
 	return errs
 }
 
+// completeBlockSideEffects marks side effects
+// of the given domain block as completed.
+func (p *Processor) completeBlockSideEffects(
+	ctx context.Context,
+	block *gtsmodel.DomainBlock,
+) error {
+	block.SideEffectsCursor = ""
+	block.SideEffectsCompletedAt = time.Now()
+
+	// Accounts may have been added or removed
+	// since counting, so the number processed
+	// is the true total now we're finished.
+	block.SideEffectsTotal = block.SideEffectsProcessed
+
+	if err := p.state.DB.UpdateDomainBlock(ctx, block,
+		"side_effects_cursor",
+		"side_effects_total",
+		"side_effects_completed_at",
+	); err != nil {
+		return gtserror.Newf("db error updating domain block: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Processor) deleteDomainBlock(
 	ctx context.Context,
 	adminAcct *gtsmodel.Account,
@@ -241,12 +441,26 @@ func (p *Processor) deleteDomainBlock(
 		return nil, "", errWithCode
 	}
 
-	// Delete the original domain block.
+	// Stop processing side effects of
+	// the domain block, if in progress.
+	p.actions.Cancel((&gtsmodel.AdminAction{
+		TargetCategory: gtsmodel.AdminActionCategoryDomain,
+		TargetID:       domainBlock.Domain,
+	}).Key())
+
+	// Delete the original domain block, and
+	// with it any side effects progress.
 	if err := p.state.DB.DeleteDomainBlock(ctx, domainBlock.Domain); err != nil {
 		err = gtserror.Newf("db error deleting domain block: %w", err)
 		return nil, "", gtserror.NewErrorInternalError(err)
 	}
 
+	if domainBlock.Severity == gtsmodel.DomainBlockLimit {
+		// Limits have no side
+		// effects to undo.
+		return apiDomainBlock, "", nil
+	}
+
 	actionID := id.NewULID()
 
 	// Process domain unblock side
@@ -348,3 +562,73 @@ func (p *Processor) domainUnblockSideEffects(
 
 	return errs
 }
+
+// DomainBlockAccountsGet returns a page of known accounts
+// from the domain targeted by the domain block with the
+// given ID, along with their local follow/follower counts.
+func (p *Processor) DomainBlockAccountsGet(
+	ctx context.Context,
+	domainBlockID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	domainBlock, err := p.state.DB.GetDomainBlockByID(ctx, domainBlockID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain block %s: %w", domainBlockID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if domainBlock == nil {
+		err := fmt.Errorf("domain block %s not found", domainBlockID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	accounts, err := p.state.DB.GetInstanceAccountsPage(ctx, domainBlock.Domain, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting accounts for domain %s: %w", domainBlock.Domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(accounts)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := accounts[count-1].ID
+	hi := accounts[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, account := range accounts {
+		apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, account)
+		if err != nil {
+			err := gtserror.Newf("error converting account %s to api model: %w", account.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		followerIDs, err := p.state.DB.GetAccountLocalFollowerIDs(ctx, account.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting local followers of %s: %w", account.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		followIDs, err := p.state.DB.GetAccountLocalFollowIDs(ctx, account.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting local follows of %s: %w", account.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		items = append(items, &apimodel.DomainBlockAccount{
+			Account:             apiAccount,
+			LocalFollowersCount: len(followerIDs),
+			LocalFollowingCount: len(followIDs),
+		})
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/domain_blocks/" + domainBlock.ID + "/accounts",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// domainBlocksCSVHeader is the header row of exported
+// domain blocks CSV files, using the same columns as
+// Mastodon's export, so the file can be imported there.
+var domainBlocksCSVHeader = []string{
+	"#domain",
+	"#severity",
+	"#reject_media",
+	"#reject_reports",
+	"#public_comment",
+	"#obfuscate",
+}
+
+// DomainBlocksExportCSV exports all domain blocks as CSV
+// records, calling write with first the header row, then
+// one record per block, which can be re-imported using
+// DomainBlocksImportCSV (or by Mastodon).
+//
+// Private comments are not included. Limit blocks are given
+// severity "silence", as Mastodon calls it, others "suspend".
+// Blocks always reject media and reports.
+//
+// An error is returned without write having been called if
+// the blocks couldn't be fetched. If write returns an error,
+// the export is stopped and an error is returned.
+func (p *Processor) DomainBlocksExportCSV(
+	ctx context.Context,
+	write func(record []string) error,
+) gtserror.WithCode {
+	blocks, err := p.state.DB.GetDomainBlocks(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting domain blocks: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Sort by domain so that exports
+	// of the same blocks are identical.
+	slices.SortFunc(blocks, func(a, b *gtsmodel.DomainBlock) int {
+		return strings.Compare(a.Domain, b.Domain)
+	})
+
+	if err := write(domainBlocksCSVHeader); err != nil {
+		err := gtserror.Newf("error writing header: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	for _, block := range blocks {
+		severity := "suspend"
+		if block.Severity == gtsmodel.DomainBlockLimit {
+			severity = "silence"
+		}
+
+		if err := write([]string{
+			block.Domain,
+			severity,
+			"true",
+			"true",
+			block.PublicComment,
+			strconv.FormatBool(util.PtrValueOr(block.Obfuscate, false)),
+		}); err != nil {
+			err := gtserror.Newf("error writing domain block %s: %w", block.Domain, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,304 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// domainBlocksCSVRow is one parsed row of
+// a domain blocks CSV file, as exported
+// by (eg.) Mastodon.
+type domainBlocksCSVRow struct {
+	domain         string
+	severity       string
+	rejectMedia    string
+	rejectReports  string
+	privateComment string
+	publicComment  string
+	obfuscate      string
+}
+
+// DomainBlocksImportCSV imports domain blocks from the given
+// CSV file, creating a new block for each row, or updating
+// the comments of an existing block for the same domain.
+//
+// The first row of the file must be a header, naming the
+// columns (domain, severity, reject_media, reject_reports,
+// private_comment, public_comment, obfuscate). A leading '#'
+// on column names is allowed, as in Mastodon exports. Only the
+// domain column is required.
+//
+// Severities "suspend" (the default) and "silence" are supported,
+// the latter creating a block with limit severity; "noop" is not.
+// Blocks always reject media and reports from the domain, so
+// reject_media and reject_reports are checked for validity, then
+// ignored.
+//
+// After each row is processed, progress is called with the result.
+// An error is only returned (before any progress is reported) if the
+// file as a whole couldn't be read; errors with individual rows are
+// instead reported in their progress.
+func (p *Processor) DomainBlocksImportCSV(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	domainsF *multipart.FileHeader,
+	progress func(*apimodel.DomainBlockImportProgress),
+) gtserror.WithCode {
+	rows, errWithCode := parseDomainBlocksCSV(domainsF)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	for i, row := range rows {
+		domain := row.domain
+		apiBlock, errWithCode := p.importDomainBlockRow(ctx, account, row)
+
+		result := &apimodel.DomainBlockImportProgress{
+			// Account for header and
+			// lines counting from 1.
+			Line:        i + 2,
+			Domain:      domain,
+			DomainBlock: apiBlock,
+			Processed:   i + 1,
+			Total:       len(rows),
+		}
+
+		if errWithCode != nil {
+			result.Status = errWithCode.Code()
+			result.Message = errWithCode.Safe()
+		} else {
+			result.Status = http.StatusOK
+			result.Message = http.StatusText(http.StatusOK)
+		}
+
+		progress(result)
+	}
+
+	return nil
+}
+
+// parseDomainBlocksCSV opens and parses the given
+// domain blocks CSV file into a slice of rows.
+func parseDomainBlocksCSV(domainsF *multipart.FileHeader) ([]*domainBlocksCSVRow, gtserror.WithCode) {
+	// Open the provided file.
+	file, err := domainsF.Open()
+	if err != nil {
+		err = gtserror.Newf("error opening attachment: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1 // Allow missing trailing columns.
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		err = gtserror.Newf("error parsing attachment as csv: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(records) < 2 {
+		err = gtserror.New("error importing domain blocks: 0 entries provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Map each known column name
+	// to the index it appears at.
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "#")
+		columns[strings.ToLower(name)] = i
+	}
+
+	if _, ok := columns["domain"]; !ok {
+		err = gtserror.New("error importing domain blocks: header row has no domain column")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	rows := make([]*domainBlocksCSVRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		// field returns the trimmed value
+		// for named column, if present.
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		rows = append(rows, &domainBlocksCSVRow{
+			domain:         field("domain"),
+			severity:       field("severity"),
+			rejectMedia:    field("reject_media"),
+			rejectReports:  field("reject_reports"),
+			privateComment: field("private_comment"),
+			publicComment:  field("public_comment"),
+			obfuscate:      field("obfuscate"),
+		})
+	}
+
+	return rows, nil
+}
+
+// importDomainBlockRow validates the given row
+// and then upserts a domain block from it.
+func (p *Processor) importDomainBlockRow(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	row *domainBlocksCSVRow,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	domain, err := util.Punify(strings.ToLower(row.domain))
+	if err != nil || domain == "" {
+		err := fmt.Errorf("invalid domain %q", row.domain)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	severity, ok := gtsmodel.ParseDomainBlockSeverity(strings.ToLower(row.severity))
+	if !ok {
+		if strings.EqualFold(row.severity, "noop") {
+			err := errors.New("severity noop is not supported, only suspend or silence")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		err := fmt.Errorf("invalid severity %q", row.severity)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Blocks always reject media and reports,
+	// so just ensure these are valid values.
+	for name, value := range map[string]string{
+		"reject_media":   row.rejectMedia,
+		"reject_reports": row.rejectReports,
+	} {
+		if _, errWithCode := parseCSVBool(name, value); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	obfuscate, errWithCode := parseCSVBool("obfuscate", row.obfuscate)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.upsertDomainBlock(ctx,
+		account,
+		domain,
+		obfuscate,
+		row.publicComment,
+		row.privateComment,
+		severity,
+	)
+}
+
+// upsertDomainBlock creates a domain block for the given domain
+// (processing side effects), or, if one already exists, updates
+// its severity, comments and obfuscation to the given values.
+func (p *Processor) upsertDomainBlock(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	domain string,
+	obfuscate bool,
+	publicComment string,
+	privateComment string,
+	severity gtsmodel.DomainBlockSeverity,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	domainBlock, err := p.state.DB.GetDomainBlock(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting domain block %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if domainBlock == nil {
+		// No block yet, create it.
+		apiBlock, _, errWithCode := p.createDomainBlock(ctx,
+			account,
+			domain,
+			obfuscate,
+			publicComment,
+			privateComment,
+			"", // No sub ID for imports.
+			"", // Nor block list URL.
+			severity,
+		)
+		return apiBlock, errWithCode
+	}
+
+	if domainBlock.Severity != severity {
+		// Let block creation handle
+		// the change of severity.
+		if _, _, errWithCode := p.createDomainBlock(ctx,
+			account,
+			domain,
+			obfuscate,
+			publicComment,
+			privateComment,
+			"", // No sub ID for imports.
+			"", // Nor block list URL.
+			severity,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+		domainBlock.Severity = severity
+	}
+
+	domainBlock.PublicComment = text.SanitizeToPlaintext(publicComment)
+	domainBlock.PrivateComment = text.SanitizeToPlaintext(privateComment)
+	domainBlock.Obfuscate = &obfuscate
+	if err := p.state.DB.UpdateDomainBlock(ctx, domainBlock,
+		"public_comment",
+		"private_comment",
+		"obfuscate",
+	); err != nil {
+		err = gtserror.Newf("db error updating domain block %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPerm(ctx, domainBlock, false)
+}
+
+// parseCSVBool parses the given value of named
+// column as a bool, defaulting to false if empty.
+func parseCSVBool(name string, value string) (bool, gtserror.WithCode) {
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		err := fmt.Errorf("invalid %s %q", name, value)
+		return false, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return b, nil
+}
@@ -56,6 +56,8 @@ func (p *Processor) apiDomainPerm(
 // If the same permission type already exists for the domain,
 // side effects will be retried.
 //
+// Severity only applies to blocks, and is ignored for allows.
+//
 // Return values for this function are the new or existing
 // domain permission, the ID of the admin action resulting
 // from this call, and/or an error if something goes wrong.
@@ -68,6 +70,7 @@ func (p *Processor) DomainPermissionCreate(
 	publicComment string,
 	privateComment string,
 	subscriptionID string,
+	severity gtsmodel.DomainBlockSeverity,
 ) (*apimodel.DomainPermission, string, gtserror.WithCode) {
 	switch permissionType {
 
@@ -81,6 +84,8 @@ func (p *Processor) DomainPermissionCreate(
 			publicComment,
 			privateComment,
 			subscriptionID,
+			"", // Not from a block list.
+			severity,
 		)
 
 	// Explicitly allow a domain.
@@ -199,6 +204,17 @@ func (p *Processor) DomainPermissionsImport(
 			errWithCode    gtserror.WithCode
 		)
 
+		severity, ok := gtsmodel.ParseDomainBlockSeverity(domainPerm.Severity)
+		if !ok {
+			err := fmt.Errorf("invalid severity %q", domainPerm.Severity)
+			multiStatusEntries = append(multiStatusEntries, apimodel.MultiStatusEntry{
+				Resource: domain,
+				Message:  err.Error(),
+				Status:   http.StatusBadRequest,
+			})
+			continue
+		}
+
 		domainPerm, _, errWithCode = p.DomainPermissionCreate(
 			ctx,
 			permissionType,
@@ -208,6 +224,7 @@ func (p *Processor) DomainPermissionsImport(
 			publicComment,
 			privateComment,
 			subscriptionID,
+			severity,
 		)
 
 		var entry *apimodel.MultiStatusEntry
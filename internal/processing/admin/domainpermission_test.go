@@ -28,6 +28,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -134,6 +136,7 @@ func (suite *DomainBlockTestSuite) createDomainPerm(
 		"",
 		"",
 		"",
+		gtsmodel.DomainBlockSuspend,
 	)
 	suite.NoError(errWithCode)
 	suite.NotNil(apiPerm)
@@ -526,6 +529,212 @@ func (suite *DomainBlockTestSuite) TestAllowAndBlockDomain() {
 	})
 }
 
+func (suite *DomainBlockTestSuite) TestBlockDomainProgress() {
+	const domain = "fossbros-anonymous.io"
+	ctx := context.Background()
+
+	config.SetInstanceFederationMode(config.InstanceFederationModeBlocklist)
+
+	apiBlock, actionID := suite.createDomainPerm(gtsmodel.DomainPermissionBlock, domain)
+	suite.awaitAction(actionID)
+
+	accounts, err := suite.db.GetInstanceAccounts(ctx, domain, "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Side effects should be complete,
+	// with every account processed.
+	block, err := suite.db.GetDomainBlock(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(block.SideEffectsCompletedAt)
+	suite.Empty(block.SideEffectsCursor)
+	suite.Equal(len(accounts), block.SideEffectsProcessed)
+	suite.Equal(len(accounts), block.SideEffectsTotal)
+
+	// Progress should be visible via the API.
+	apiBlock, errWithCode := suite.adminProcessor.DomainPermissionGet(
+		ctx,
+		gtsmodel.DomainPermissionBlock,
+		apiBlock.ID,
+		false,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(len(accounts), *apiBlock.Processed)
+	suite.Equal(len(accounts), *apiBlock.Total)
+}
+
+func (suite *DomainBlockTestSuite) TestLimitAndSuspendDomain() {
+	const domain = "fossbros-anonymous.io"
+	ctx := context.Background()
+
+	config.SetInstanceFederationMode(config.InstanceFederationModeBlocklist)
+
+	createBlock := func(severity gtsmodel.DomainBlockSeverity) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+		return suite.adminProcessor.DomainPermissionCreate(
+			ctx,
+			gtsmodel.DomainPermissionBlock,
+			suite.testAccounts["admin_account"],
+			domain,
+			false,
+			"",
+			"",
+			"",
+			severity,
+		)
+	}
+
+	// Limit the domain: no side effects to run.
+	apiBlock, actionID, errWithCode := createBlock(gtsmodel.DomainBlockLimit)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("limit", apiBlock.Severity)
+	suite.Empty(actionID)
+
+	account := suite.testAccounts["remote_account_1"]
+	dbAccount, err := suite.db.GetAccountByID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(dbAccount.SuspendedAt)
+
+	// Upgrade the limit to a suspension.
+	apiBlock, actionID, errWithCode = createBlock(gtsmodel.DomainBlockSuspend)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("suspend", apiBlock.Severity)
+	suite.NotEmpty(actionID)
+	suite.awaitAction(actionID)
+
+	dbAccount, err = suite.db.GetAccountByID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(dbAccount.SuspendedAt)
+
+	// Suspension can't be downgraded to a limit.
+	_, _, errWithCode = createBlock(gtsmodel.DomainBlockLimit)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+}
+
+func (suite *DomainBlockTestSuite) TestDomainBlockAccounts() {
+	const domain = "fossbros-anonymous.io"
+	var (
+		ctx           = context.Background()
+		localAccount  = suite.testAccounts["local_account_1"]
+		remoteAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	// Shield the domain with an allow so that
+	// block side effects don't remove follows.
+	config.SetInstanceFederationMode(config.InstanceFederationModeBlocklist)
+	_, actionID := suite.createDomainPerm(gtsmodel.DomainPermissionAllow, domain)
+	suite.awaitAction(actionID)
+
+	// Have a local account follow the remote one.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              id.NewULID(),
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/" + id.NewULID(),
+		AccountID:       localAccount.ID,
+		TargetAccountID: remoteAccount.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Creation response should count
+	// the accounts that'll be affected.
+	apiBlock, actionID := suite.createDomainPerm(gtsmodel.DomainPermissionBlock, domain)
+	suite.awaitAction(actionID)
+	suite.NotNil(apiBlock.AffectedAccountsCount)
+	suite.Equal(1, *apiBlock.AffectedAccountsCount)
+
+	resp, errWithCode := suite.adminProcessor.DomainBlockAccountsGet(ctx, apiBlock.ID, &paging.Page{
+		Limit: 40,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(resp.Items, 1)
+	item := resp.Items[0].(*apimodel.DomainBlockAccount)
+	suite.Equal(remoteAccount.ID, item.Account.ID)
+	suite.Equal(1, item.LocalFollowersCount)
+	suite.Equal(0, item.LocalFollowingCount)
+
+	// Unknown domain block should 404.
+	_, errWithCode = suite.adminProcessor.DomainBlockAccountsGet(ctx, id.NewULID(), &paging.Page{
+		Limit: 40,
+	})
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *DomainBlockTestSuite) TestResumeDomainBlock() {
+	const domain = "fossbros-anonymous.io"
+	ctx := context.Background()
+
+	config.SetInstanceFederationMode(config.InstanceFederationModeBlocklist)
+
+	accounts, err := suite.db.GetInstanceAccounts(ctx, domain, "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Create a block whose side effects were
+	// interrupted after processing the first
+	// account (accounts are paged by ID desc).
+	block := &gtsmodel.DomainBlock{
+		ID:                   id.NewULID(),
+		Domain:               domain,
+		CreatedByAccountID:   suite.testAccounts["admin_account"].ID,
+		Obfuscate:            util.Ptr(false),
+		SideEffectsCursor:    accounts[0].ID,
+		SideEffectsProcessed: 1,
+		SideEffectsTotal:     len(accounts),
+	}
+	if err := suite.db.CreateDomainBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.adminProcessor.DomainBlocksResume(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Side effects should now be complete.
+	block, err = suite.db.GetDomainBlock(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(block.SideEffectsCompletedAt)
+	suite.Equal(len(accounts), block.SideEffectsProcessed)
+	suite.Equal(len(accounts), block.SideEffectsTotal)
+
+	// Only accounts after the cursor
+	// should have been processed.
+	accounts, err = suite.db.GetInstanceAccounts(ctx, domain, "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	for i, account := range accounts {
+		if i == 0 {
+			suite.Zero(account.SuspendedAt, account.Username)
+		} else {
+			suite.NotZero(account.SuspendedAt, account.Username)
+		}
+	}
+}
+
 func TestDomainBlockTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainBlockTestSuite))
 }
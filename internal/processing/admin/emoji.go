@@ -318,6 +318,100 @@ func (p *Processor) EmojiUpdate(
 	}
 }
 
+// EmojiEdit edits the local emoji with the given id,
+// updating its shortcode, image, and/or category using
+// the provided form parameters. Only local emojis can be
+// edited; remote emojis must be copied first.
+func (p *Processor) EmojiEdit(
+	ctx context.Context,
+	id string,
+	form *apimodel.EmojiEditRequest,
+) (*apimodel.AdminEmoji, gtserror.WithCode) {
+	emoji, err := p.state.DB.GetEmojiByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil {
+		err := gtserror.Newf("no emoji with id %s found in the db", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if !emoji.IsLocal() {
+		err := fmt.Errorf("emoji %s is not a local emoji, cannot edit it via this endpoint", emoji.ID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	hasImage := form.Image != nil && form.Image.Size != 0
+	if form.Shortcode == nil && !hasImage && form.CategoryName == nil {
+		err := errors.New("none of new shortcode, image or category set, cannot edit")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Only update shortcode
+	// if it's changed.
+	if form.Shortcode != nil && *form.Shortcode != emoji.Shortcode {
+		sc := *form.Shortcode
+
+		// Ensure we don't already have an emoji
+		// stored locally with the new shortcode.
+		if errWithCode := p.checkEmojiShortcodeFree(ctx, sc); errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		emoji.Shortcode = sc
+		if err := p.state.DB.UpdateEmoji(ctx, emoji, "shortcode"); err != nil {
+			err := gtserror.Newf("db error updating emoji %s: %w", emoji.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !hasImage && form.CategoryName == nil {
+		// Nothing left to do.
+		adminEmoji, err := p.converter.EmojiToAdminAPIEmoji(ctx, emoji)
+		if err != nil {
+			err := gtserror.Newf("error converting emoji %s to admin emoji: %w", emoji.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		return adminEmoji, nil
+	}
+
+	// Pass on to modify for
+	// image and / or category.
+	var image *multipart.FileHeader
+	if hasImage {
+		image = form.Image
+	}
+
+	return p.emojiUpdateModify(ctx, emoji, image, form.CategoryName)
+}
+
+// EmojiCopy copies the emoji with the given id to a new
+// local emoji with the given shortcode and (optional)
+// category, preserving the same image. Unlike the `copy`
+// update action, this works for local emojis too, which
+// is useful for creating variants of an existing emoji.
+func (p *Processor) EmojiCopy(
+	ctx context.Context,
+	id string,
+	form *apimodel.EmojiCopyRequest,
+) (*apimodel.AdminEmoji, gtserror.WithCode) {
+	emoji, err := p.state.DB.GetEmojiByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil {
+		err := gtserror.Newf("no emoji with id %s found in the db", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return p.emojiCopy(ctx, emoji, form.Shortcode, form.CategoryName)
+}
+
 // EmojiCategoriesGet returns all custom emoji
 // categories that exist on this instance.
 func (p *Processor) EmojiCategoriesGet(
@@ -342,10 +436,101 @@ func (p *Processor) EmojiCategoriesGet(
 	return apiCategories, nil
 }
 
+// EmojiCategoryUpdate renames the custom emoji category
+// with the given name to newName. Emojis reference their
+// category by ID, so they'll show the new name right away.
+func (p *Processor) EmojiCategoryUpdate(
+	ctx context.Context,
+	name string,
+	newName string,
+) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	category, errWithCode := p.getEmojiCategoryByName(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if newName != category.Name {
+		// Ensure new name isn't taken by another category.
+		// Lookups are case-insensitive, so allow a rename
+		// that only changes the case of the same category.
+		existing, err := p.state.DB.GetEmojiCategoryByName(ctx, newName)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error checking for emoji category %s: %w", newName, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if existing != nil && existing.ID != category.ID {
+			err := fmt.Errorf("emoji category %s already exists", newName)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+
+		category.Name = newName
+		if err := p.state.DB.UpdateEmojiCategory(ctx, category, "name"); err != nil {
+			err := gtserror.Newf("db error updating emoji category %s: %w", category.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	apiCategory, err := p.converter.EmojiCategoryToAPIEmojiCategory(ctx, category)
+	if err != nil {
+		err := gtserror.Newf("error converting emoji category to api emoji category: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiCategory, nil
+}
+
+// EmojiCategoryDelete deletes the custom emoji category
+// with the given name. Any emojis in the category are
+// moved back to being uncategorized (the default).
+func (p *Processor) EmojiCategoryDelete(
+	ctx context.Context,
+	name string,
+) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	category, errWithCode := p.getEmojiCategoryByName(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deletion.
+	apiCategory, err := p.converter.EmojiCategoryToAPIEmojiCategory(ctx, category)
+	if err != nil {
+		err := gtserror.Newf("error converting emoji category to api emoji category: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteEmojiCategory(ctx, category.ID); err != nil {
+		err := gtserror.Newf("db error deleting emoji category %s: %w", category.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiCategory, nil
+}
+
 /*
 	UTIL FUNCTIONS
 */
 
+// getEmojiCategoryByName gets the emoji category with
+// the given name, returning 404 if it doesn't exist.
+func (p *Processor) getEmojiCategoryByName(
+	ctx context.Context,
+	name string,
+) (*gtsmodel.EmojiCategory, gtserror.WithCode) {
+	category, err := p.state.DB.GetEmojiCategoryByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting emoji category %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if category == nil {
+		err := fmt.Errorf("emoji category %s not found", name)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return category, nil
+}
+
 // getOrCreateEmojiCategory either gets an existing
 // category with the given name from the database,
 // or, if the category doesn't yet exist, it creates
@@ -414,7 +599,26 @@ func (p *Processor) emojiUpdateCopy(
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
-	sc := *shortcode
+	var categoryName string
+	if category != nil {
+		categoryName = *category
+	}
+
+	return p.emojiCopy(ctx, targetEmoji, *shortcode, categoryName)
+}
+
+// emojiCopy copies and stores the given emoji as
+// a new *local* emoji, preserving the same image,
+// and using the provided shortcode and category.
+//
+// The provided emoji model must correspond to an
+// emoji already stored in the database + storage.
+func (p *Processor) emojiCopy(
+	ctx context.Context,
+	targetEmoji *gtsmodel.Emoji,
+	sc string,
+	category string,
+) (*apimodel.AdminEmoji, gtserror.WithCode) {
 	if sc == "" {
 		err := errors.New("empty shortcode provided")
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
@@ -422,15 +626,8 @@ func (p *Processor) emojiUpdateCopy(
 
 	// Ensure we don't already have an emoji
 	// stored locally with this shortcode.
-	maybeExisting, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, sc, "")
-	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		err := gtserror.Newf("db error checking for emoji with shortcode %s: %w", sc, err)
-		return nil, gtserror.NewErrorInternalError(err)
-	}
-
-	if maybeExisting != nil {
-		err := fmt.Errorf("emoji with shortcode %s already exists on this instance", sc)
-		return nil, gtserror.NewErrorConflict(err, err.Error())
+	if errWithCode := p.checkEmojiShortcodeFree(ctx, sc); errWithCode != nil {
+		return nil, errWithCode
 	}
 
 	// We don't have an emoji with this
@@ -459,8 +656,8 @@ func (p *Processor) emojiUpdateCopy(
 	// category exists and provide it as
 	// additional info to emoji processing.
 	var ai *media.AdditionalEmojiInfo
-	if category != nil && *category != "" {
-		category, err := p.getOrCreateEmojiCategory(ctx, *category)
+	if category != "" {
+		category, err := p.getOrCreateEmojiCategory(ctx, category)
 		if err != nil {
 			return nil, gtserror.NewErrorInternalError(err)
 		}
@@ -495,6 +692,23 @@ func (p *Processor) emojiUpdateCopy(
 	return adminEmoji, nil
 }
 
+// checkEmojiShortcodeFree returns a conflict error if
+// a local emoji with the given shortcode already exists.
+func (p *Processor) checkEmojiShortcodeFree(ctx context.Context, sc string) gtserror.WithCode {
+	maybeExisting, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, sc, "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking for emoji with shortcode %s: %w", sc, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if maybeExisting != nil {
+		err := fmt.Errorf("emoji with shortcode %s already exists on this instance", sc)
+		return gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	return nil
+}
+
 // emojiUpdateDisable marks the given *remote*
 // emoji as disabled by setting disabled = true.
 //
@@ -617,9 +831,11 @@ func (p *Processor) emojiUpdateModify(
 			}
 		}
 
-		// Begin media processing.
+		// Begin media processing, as a refresh
+		// of the existing emoji so that the model
+		// is updated in place (and old images cleaned).
 		processingEmoji, err := p.mediaManager.PreProcessEmoji(ctx,
-			data, emoji.Shortcode, emoji.ID, emoji.URI, ai, false,
+			data, emoji.Shortcode, emoji.ID, emoji.URI, ai, true,
 		)
 		if err != nil {
 			err := gtserror.Newf("error processing emoji: %w", err)
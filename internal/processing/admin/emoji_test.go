@@ -19,12 +19,14 @@ package admin_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type EmojiTestSuite struct {
@@ -62,6 +64,65 @@ func (suite *EmojiTestSuite) TestUpdateEmojiCategory() {
 	}
 }
 
+func (suite *EmojiTestSuite) TestEmojisImportRateLimited() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	// Remote instance has no emoji list to
+	// fetch, but the job is still started.
+	actionID, errWithCode := suite.adminProcessor.EmojisImport(ctx,
+		adminAcct, "fossbros-anonymous.io", nil, true,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// A second job within the minute is refused.
+	_, errWithCode = suite.adminProcessor.EmojisImport(ctx,
+		adminAcct, "fossbros-anonymous.io", []string{"blobcat"}, false,
+	)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+
+	// Listing available emojis isn't rate limited.
+	_, errWithCode = suite.adminProcessor.EmojisImportList(ctx,
+		"fossbros-anonymous.io",
+	)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Wait for the job to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// The failed list fetch should be
+	// recorded on the completed action.
+	action, errWithCode := suite.adminProcessor.ActionGet(ctx, actionID)
+	suite.NoError(errWithCode)
+	suite.Equal("import-emojis", action.Type)
+	suite.Equal("fossbros-anonymous.io", action.TargetID)
+	suite.NotNil(action.CompletedAt)
+	suite.Len(action.Errors, 1)
+}
+
+func (suite *EmojiTestSuite) TestEmojisImportBlockedDomain() {
+	_, errWithCode := suite.adminProcessor.EmojisImportList(
+		context.Background(),
+		"replyguys.com",
+	)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Imports are refused up front too.
+	_, errWithCode = suite.adminProcessor.EmojisImport(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		"replyguys.com",
+		nil,
+		true,
+	)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+}
+
 func TestEmojiTestSuite(t *testing.T) {
 	suite.Run(t, new(EmojiTestSuite))
 }
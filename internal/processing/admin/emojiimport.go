@@ -0,0 +1,354 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+const (
+	// emojiImportEvery is the minimum
+	// interval between emoji import jobs,
+	// to avoid hammering remote instances.
+	emojiImportEvery = time.Minute
+
+	// emojiListMaxSize is the max size in
+	// bytes of a remote emoji list to read.
+	emojiListMaxSize = 8 * 1024 * 1024
+)
+
+// EmojisImportList fetches the list of custom emojis
+// available on the instance at the given domain, so
+// that an admin can select which of them to import.
+func (p *Processor) EmojisImportList(
+	ctx context.Context,
+	domain string,
+) ([]apimodel.Emoji, gtserror.WithCode) {
+	domain, errWithCode := p.emojiImportDomain(ctx, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	_, emojis, errWithCode := p.fetchRemoteEmojis(ctx, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return emojis, nil
+}
+
+// EmojisImport starts an admin action which fetches the list
+// of custom emojis available on the instance at the given domain,
+// then downloads and stores each of those with the given shortcodes
+// (or all of them, if all is true) as new *local* emojis, placed
+// in the same category as on the remote instance.
+//
+// The import is processed asynchronously, so only the ID of the
+// started action is returned. Any emojis which could not be imported
+// are recorded in the errors of the action, so that the caller can
+// retry those as they wish once the action has completed.
+//
+// Only one import job may be started per minute.
+func (p *Processor) EmojisImport(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domain string,
+	shortcodes []string,
+	all bool,
+) (string, gtserror.WithCode) {
+	if !all && len(shortcodes) == 0 {
+		err := errors.New("no emojis selected for import")
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	domain, errWithCode := p.emojiImportDomain(ctx, domain)
+	if errWithCode != nil {
+		return "", errWithCode
+	}
+
+	if !p.emojiImportAllowed() {
+		err := fmt.Errorf("an emoji import was started in the last %s, try again later", emojiImportEvery)
+		return "", gtserror.NewErrorTooManyRequests(err, err.Error())
+	}
+
+	actionID := id.NewULID()
+
+	// Process the import asynchronously.
+	if errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryDomain,
+			TargetID:       domain,
+			Type:           gtsmodel.AdminActionImportEmojis,
+			AccountID:      adminAcct.ID,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			return p.emojisImportSideEffects(ctx, domain, shortcodes, all)
+		},
+	); errWithCode != nil {
+		return actionID, errWithCode
+	}
+
+	return actionID, nil
+}
+
+func (p *Processor) emojisImportSideEffects(
+	ctx context.Context,
+	domain string,
+	shortcodes []string,
+	all bool,
+) gtserror.MultiError {
+	var errs gtserror.MultiError
+
+	tsport, emojis, errWithCode := p.fetchRemoteEmojis(ctx, domain)
+	if errWithCode != nil {
+		errs.Append(errWithCode)
+		return errs
+	}
+
+	if !all {
+		// Only keep the selected emojis.
+		emojis = slices.DeleteFunc(emojis, func(e apimodel.Emoji) bool {
+			return !slices.Contains(shortcodes, e.Shortcode)
+		})
+
+		// Note any selected shortcodes that weren't found.
+		for _, sc := range shortcodes {
+			if !slices.ContainsFunc(emojis, func(e apimodel.Emoji) bool {
+				return e.Shortcode == sc
+			}) {
+				errs.Appendf("emoji %s not found on %s", sc, domain)
+			}
+		}
+	}
+
+	var imported int
+	for _, emoji := range emojis {
+		if err := ctx.Err(); err != nil {
+			errs.Appendf("emoji import cancelled: %w", err)
+			break
+		}
+
+		if _, errWithCode := p.importRemoteEmoji(ctx, tsport, emoji); errWithCode != nil {
+			errs.Appendf("error importing emoji %s: %w", emoji.Shortcode, errWithCode)
+			continue
+		}
+
+		imported++
+	}
+
+	log.Infof(ctx, "imported %d of %d emojis from %s", imported, len(emojis), domain)
+	return errs
+}
+
+// emojiImportAllowed returns whether an emoji import
+// job may be started now, marking it as started if so.
+func (p *Processor) emojiImportAllowed() bool {
+	now := time.Now()
+	for {
+		last := p.emojiImportLast.Load()
+		if now.Sub(time.Unix(0, last)) < emojiImportEvery {
+			return false
+		}
+
+		if p.emojiImportLast.CompareAndSwap(last, now.UnixNano()) {
+			return true
+		}
+	}
+}
+
+// emojiImportDomain checks that emojis may be imported from the
+// given domain, returning its punycode form on success.
+func (p *Processor) emojiImportDomain(
+	ctx context.Context,
+	domain string,
+) (string, gtserror.WithCode) {
+	punyDomain, err := util.Punify(domain)
+	if err != nil {
+		err := fmt.Errorf("invalid domain %s: %w", domain, err)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	domain = punyDomain
+
+	if config.IsLocalDomain(domain) {
+		err := fmt.Errorf("domain %s is this instance", domain)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	blocked, err := p.state.DB.IsDomainBlocked(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error checking domain block for %s: %w", domain, err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		err := fmt.Errorf("domain %s is blocked", domain)
+		return "", gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	return domain, nil
+}
+
+// fetchRemoteEmojis fetches the list of custom emojis from the
+// (Mastodon API compatible) instance at the given domain, returning
+// the transport used so it can be reused to download them. The domain
+// is expected to have been checked with emojiImportDomain() already.
+func (p *Processor) fetchRemoteEmojis(
+	ctx context.Context,
+	domain string,
+) (transport.Transport, []apimodel.Emoji, gtserror.WithCode) {
+	// Fetch using the instance account.
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("error getting instance transport: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	listURL := &url.URL{
+		Scheme: "https",
+		Host:   domain,
+		Path:   "/api/v1/custom_emojis",
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL.String(), nil)
+	if err != nil {
+		err := gtserror.Newf("error creating request: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+	req.Header.Add("Accept", "application/json")
+
+	rsp, err := tsport.GET(req)
+	if err != nil {
+		err := fmt.Errorf("error fetching emojis from %s: %w", domain, err)
+		return nil, nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("error fetching emojis from %s: %w", domain, gtserror.NewFromResponse(rsp))
+		return nil, nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	var emojis []apimodel.Emoji
+	if err := json.NewDecoder(io.LimitReader(rsp.Body, emojiListMaxSize)).Decode(&emojis); err != nil {
+		err := fmt.Errorf("error decoding emojis from %s: %w", domain, err)
+		return nil, nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return tsport, emojis, nil
+}
+
+// importRemoteEmoji downloads the given emoji from a
+// remote instance's emoji list, and stores it as a new
+// *local* emoji with the same shortcode and category.
+func (p *Processor) importRemoteEmoji(
+	ctx context.Context,
+	tsport transport.Transport,
+	remote apimodel.Emoji,
+) (*apimodel.AdminEmoji, gtserror.WithCode) {
+	sc := remote.Shortcode
+	if err := validate.EmojiShortcode(sc); err != nil {
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Ensure we don't already have an emoji
+	// stored locally with this shortcode.
+	if errWithCode := p.checkEmojiShortcodeFree(ctx, sc); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	imageURL, err := url.Parse(remote.URL)
+	if err != nil || imageURL.Scheme != "https" && imageURL.Scheme != "http" {
+		err := fmt.Errorf("emoji %s has invalid url %q", sc, remote.URL)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Data function just fetches
+	// the image from its remote URL.
+	data := func(ctx context.Context) (io.ReadCloser, int64, error) {
+		return tsport.DereferenceMedia(ctx, imageURL)
+	}
+
+	// Generate new emoji ID and URI.
+	emojiID, err := id.NewRandomULID()
+	if err != nil {
+		err := gtserror.Newf("error creating id for new emoji: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	emojiURI := uris.URIForEmoji(emojiID)
+
+	// Keep the remote category,
+	// if it's valid for us too.
+	var ai *media.AdditionalEmojiInfo
+	if remote.Category != "" && validate.EmojiCategory(remote.Category) == nil {
+		category, err := p.getOrCreateEmojiCategory(ctx, remote.Category)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		ai = &media.AdditionalEmojiInfo{
+			CategoryID: &category.ID,
+		}
+	}
+
+	// Begin media processing.
+	processingEmoji, err := p.mediaManager.PreProcessEmoji(ctx,
+		data, sc, emojiID, emojiURI, ai, false,
+	)
+	if err != nil {
+		err := gtserror.Newf("error processing emoji: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Complete processing immediately.
+	emoji, err := processingEmoji.LoadEmoji(ctx)
+	if err != nil {
+		err := fmt.Errorf("error downloading emoji %s: %w", sc, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	adminEmoji, err := p.converter.EmojiToAdminAPIEmoji(ctx, emoji)
+	if err != nil {
+		err := gtserror.Newf("error converting emoji %s to admin emoji: %w", emoji.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return adminEmoji, nil
+}
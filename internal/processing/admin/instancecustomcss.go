@@ -0,0 +1,194 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// InstanceCustomCSSMaxLength is the maximum
+// permitted length of instance custom CSS.
+const InstanceCustomCSSMaxLength = 10000
+
+// InstanceCustomCSSSet validates and stores the given CSS
+// as custom CSS for the web UI of this instance. An empty
+// string clears any previously set custom CSS.
+func (p *Processor) InstanceCustomCSSSet(
+	ctx context.Context,
+	css string,
+) (*apimodel.AdminInstanceCustomCSS, gtserror.WithCode) {
+	if err := ValidateInstanceCustomCSS(css); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	settings, err := p.state.DB.GetInstanceSettings(ctx, instance.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting instance settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if settings == nil {
+		// No settings stored
+		// yet, create them now.
+		settings = &gtsmodel.InstanceSettings{
+			InstanceID: instance.ID,
+			CustomCSS:  css,
+		}
+
+		if err := p.state.DB.PutInstanceSettings(ctx, settings); err != nil {
+			err := gtserror.Newf("db error inserting instance settings: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	} else {
+		settings.CustomCSS = css
+		if err := p.state.DB.UpdateInstanceSettings(ctx, settings, "custom_css"); err != nil {
+			err := gtserror.Newf("db error updating instance settings: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return &apimodel.AdminInstanceCustomCSS{
+		CustomCSS: settings.CustomCSS,
+	}, nil
+}
+
+// ValidateInstanceCustomCSS checks that the given CSS is
+// short enough, and doesn't attempt to load any external
+// resources, or break out of the <style> element it's
+// rendered in. CSS escapes and comments are taken into
+// account, so they can't be used to disguise references.
+func ValidateInstanceCustomCSS(css string) error {
+	if l := len([]rune(css)); l > InstanceCustomCSSMaxLength {
+		return fmt.Errorf(
+			"custom_css must be %d characters or less, provided css was %d characters",
+			InstanceCustomCSSMaxLength, l,
+		)
+	}
+
+	norm := strings.ToLower(normalizeCSS(css))
+
+	for _, disallowed := range []struct {
+		substr string
+		reason string
+	}{
+		{"@import", "@import rules are not allowed"},
+		{"url(", "url() references are not allowed"},
+		{"image-set(", "image-set() references are not allowed"},
+		{"src(", "src() references are not allowed"},
+		{"<", "the < character is not allowed"},
+	} {
+		if strings.Contains(norm, disallowed.substr) {
+			return errors.New("custom_css invalid: " + disallowed.reason)
+		}
+	}
+
+	return nil
+}
+
+// normalizeCSS strips comments from the given css,
+// and decodes any backslash escapes, returning the
+// result. It's only meant for validation purposes;
+// the output is not necessarily valid css itself.
+func normalizeCSS(css string) string {
+	var b strings.Builder
+	b.Grow(len(css))
+
+	for i := 0; i < len(css); i++ {
+		switch c := css[i]; {
+
+		// Comment start, skip to end
+		// of comment (or end of css).
+		case c == '/' && i+1 < len(css) && css[i+1] == '*':
+			end := strings.Index(css[i+2:], "*/")
+			if end == -1 {
+				return b.String()
+			}
+			i += 2 + end + 1
+
+		// Escape, decode up to 6 hex
+		// digits, or a single literal.
+		case c == '\\' && i+1 < len(css):
+			var (
+				r rune
+				n int
+			)
+
+			for n < 6 && i+1+n < len(css) {
+				d := hexVal(css[i+1+n])
+				if d < 0 {
+					break
+				}
+				r = r<<4 | rune(d)
+				n++
+			}
+
+			if n == 0 {
+				// Escaped literal.
+				b.WriteByte(css[i+1])
+				i++
+				continue
+			}
+
+			b.WriteRune(r)
+			i += n
+
+			// A single whitespace char after a
+			// hex escape is part of the escape.
+			if i+1 < len(css) && isCSSWhitespace(css[i+1]) {
+				i++
+			}
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+func hexVal(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'a' <= c && c <= 'f':
+		return int(c-'a') + 10
+	case 'A' <= c && c <= 'F':
+		return int(c-'A') + 10
+	default:
+		return -1
+	}
+}
+
+func isCSSWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
+)
+
+type InstanceCustomCSSTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *InstanceCustomCSSTestSuite) TestInstanceCustomCSSSet() {
+	ctx := context.Background()
+
+	// Set some custom CSS.
+	const css = "body { background: hotpink; }"
+	apiCSS, errWithCode := suite.adminProcessor.InstanceCustomCSSSet(ctx, css)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(css, apiCSS.CustomCSS)
+
+	// CSS should be stored.
+	instance, err := suite.db.GetInstance(ctx, "localhost:8080")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings, err := suite.db.GetInstanceSettings(ctx, instance.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(css, settings.CustomCSS)
+
+	// Clear the CSS again.
+	apiCSS, errWithCode = suite.adminProcessor.InstanceCustomCSSSet(ctx, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiCSS.CustomCSS)
+
+	settings, err = suite.db.GetInstanceSettings(ctx, instance.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(settings.CustomCSS)
+}
+
+func (suite *InstanceCustomCSSTestSuite) TestInstanceCustomCSSSetInvalid() {
+	_, errWithCode := suite.adminProcessor.InstanceCustomCSSSet(
+		context.Background(),
+		"@import 'https://example.org/evil.css';",
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *InstanceCustomCSSTestSuite) TestValidateInstanceCustomCSS() {
+	for _, test := range []struct {
+		css   string
+		valid bool
+	}{
+		{css: "", valid: true},
+		{css: "body { color: red; }", valid: true},
+		{css: ".url-thing { content: 'url'; }", valid: true},
+		{css: "/* a comment */ a { color: blue; }", valid: true},
+		{css: strings.Repeat("a", admin.InstanceCustomCSSMaxLength), valid: true},
+		{css: strings.Repeat("a", admin.InstanceCustomCSSMaxLength+1), valid: false},
+		{css: "@import 'https://example.org/evil.css';", valid: false},
+		{css: "@IMPORT url(evil.css);", valid: false},
+		{css: "body { background: url(https://example.org/tracker.png); }", valid: false},
+		{css: "body { background: URL( 'https://example.org/tracker.png'); }", valid: false},
+		{css: `body { background: \75 rl(https://example.org/tracker.png); }`, valid: false},
+		{css: `body { background: u\rl(https://example.org/tracker.png); }`, valid: false},
+		{css: "body { background: ur/**/l(https://example.org/tracker.png); }", valid: false},
+		{css: `@\69 mport "evil.css";`, valid: false},
+		{css: "body { background: image-set('https://example.org/a.png' 1x); }", valid: false},
+		{css: "</style><script>alert(1)</script>", valid: false},
+	} {
+		err := admin.ValidateInstanceCustomCSS(test.css)
+		if test.valid {
+			suite.NoError(err, test.css)
+		} else {
+			suite.Error(err, test.css)
+		}
+	}
+}
+
+func TestInstanceCustomCSSTestSuite(t *testing.T) {
+	suite.Run(t, new(InstanceCustomCSSTestSuite))
+}
@@ -0,0 +1,119 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// RetentionPolicyGet returns the instance data retention policy.
+// If no policy has been set, the returned policy is disabled.
+func (p *Processor) RetentionPolicyGet(ctx context.Context) (*apimodel.AdminRetentionPolicy, gtserror.WithCode) {
+	policy, err := p.getRetentionPolicy(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.RetentionPolicyToAdminAPIRetentionPolicy(policy), nil
+}
+
+// RetentionPolicySet updates the instance data retention policy with
+// the values set in form, creating it if necessary, and returns it.
+// The policy is enforced by a nightly job, not immediately.
+func (p *Processor) RetentionPolicySet(
+	ctx context.Context,
+	form *apimodel.AdminRetentionPolicyRequest,
+) (*apimodel.AdminRetentionPolicy, gtserror.WithCode) {
+	if form.MaxStatusAgeDays == nil &&
+		form.MaxMediaAgeDays == nil &&
+		form.AppliesToLocal == nil &&
+		form.AppliesToRemote == nil {
+		const help = "no retention policy settings were provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+	}
+
+	policy, err := p.getRetentionPolicy(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if form.MaxStatusAgeDays != nil {
+		if *form.MaxStatusAgeDays < 0 {
+			const help = "max_status_age_days must be 0 (disabled) or greater"
+			return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+		}
+		policy.MaxStatusAgeDays = *form.MaxStatusAgeDays
+	}
+
+	if form.MaxMediaAgeDays != nil {
+		if *form.MaxMediaAgeDays < 0 {
+			const help = "max_media_age_days must be 0 (disabled) or greater"
+			return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+		}
+		policy.MaxMediaAgeDays = *form.MaxMediaAgeDays
+	}
+
+	if form.AppliesToLocal != nil {
+		policy.AppliesToLocal = form.AppliesToLocal
+	}
+
+	if form.AppliesToRemote != nil {
+		policy.AppliesToRemote = form.AppliesToRemote
+	}
+
+	if policy.ID == "" {
+		// Policy is new,
+		// give it an ID.
+		policy.ID = id.NewULID()
+		policy.CreatedAt = time.Now()
+	}
+
+	if err := p.state.DB.PutRetentionPolicy(ctx, policy); err != nil {
+		err := gtserror.Newf("db error putting retention policy: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.RetentionPolicyToAdminAPIRetentionPolicy(policy), nil
+}
+
+// getRetentionPolicy returns the stored retention
+// policy, or a new, disabled one if none is stored.
+func (p *Processor) getRetentionPolicy(ctx context.Context) (*gtsmodel.RetentionPolicy, error) {
+	policy, err := p.state.DB.GetRetentionPolicy(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting retention policy: %w", err)
+	}
+
+	if policy == nil {
+		policy = &gtsmodel.RetentionPolicy{
+			AppliesToLocal:  util.Ptr(false),
+			AppliesToRemote: util.Ptr(false),
+		}
+	}
+
+	return policy, nil
+}
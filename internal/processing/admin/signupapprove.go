@@ -64,6 +64,8 @@ func (p *Processor) SignupApprove(
 			Origin:         adminAcct,
 			Target:         user.Account,
 		})
+
+		p.logAction(ctx, adminAcct, user.Account, gtsmodel.AdminActionApprove, "")
 	}
 
 	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, user.Account)
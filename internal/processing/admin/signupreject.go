@@ -100,6 +100,8 @@ func (p *Processor) SignupReject(
 		Message:                message,
 	}
 
+	p.logAction(ctx, adminAcct, user.Account, gtsmodel.AdminActionReject, privateComment)
+
 	// Process rejection side effects asynschronously.
 	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
 		// Use ap.ObjectProfile here to
@@ -0,0 +1,130 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// FeaturedSuggestionsGet returns all accounts
+// picked by admins as follow suggestions.
+func (p *Processor) FeaturedSuggestionsGet(ctx context.Context) ([]*apimodel.Account, gtserror.WithCode) {
+	featured, err := p.state.DB.GetFeaturedSuggestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccounts := make([]*apimodel.Account, 0, len(featured))
+	for _, f := range featured {
+		account, err := p.state.DB.GetAccountByID(ctx, f.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting featured account %s: %v", f.AccountID, err)
+			continue
+		}
+
+		apiAccount, errWithCode := p.apiFeaturedAccount(ctx, account)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiAccounts = append(apiAccounts, apiAccount)
+	}
+
+	return apiAccounts, nil
+}
+
+// FeaturedSuggestionCreate picks the local account with the given ID to be
+// suggested to all local accounts as someone they may wish to follow.
+// Picking an already picked account is a no-op.
+func (p *Processor) FeaturedSuggestionCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+) (*apimodel.Account, gtserror.WithCode) {
+	account, errWithCode := p.getLocalAccount(ctx, accountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.PutFeaturedSuggestion(ctx, &gtsmodel.FeaturedSuggestion{
+		ID:                 id.NewULID(),
+		AccountID:          account.ID,
+		Account:            account,
+		CreatedByAccountID: adminAcct.ID,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		err := gtserror.Newf("db error storing featured suggestion: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Picks are included in everyone's
+	// suggestions, so drop all cached ones.
+	p.state.Caches.FollowSuggestions.Clear()
+
+	return p.apiFeaturedAccount(ctx, account)
+}
+
+// FeaturedSuggestionDelete stops the account with the
+// given ID from being suggested as an admin pick.
+func (p *Processor) FeaturedSuggestionDelete(
+	ctx context.Context,
+	accountID string,
+) (*apimodel.Account, gtserror.WithCode) {
+	featured, err := p.state.DB.GetFeaturedSuggestionByAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured suggestion %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if featured == nil {
+		err := fmt.Errorf("featured suggestion %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteFeaturedSuggestionByAccountID(ctx, accountID); err != nil {
+		err := gtserror.Newf("db error deleting featured suggestion %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Caches.FollowSuggestions.Clear()
+
+	return p.apiFeaturedAccount(ctx, account)
+}
+
+func (p *Processor) apiFeaturedAccount(ctx context.Context, account *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode) {
+	apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s to api model: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiAccount, nil
+}
@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SuggestionTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *SuggestionTestSuite) TestFeaturedSuggestionCreateGetDelete() {
+	var (
+		ctx     = context.Background()
+		admin   = suite.testAccounts["admin_account"]
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	// Feature the account, twice;
+	// the second should be a no-op.
+	for i := 0; i < 2; i++ {
+		apiAccount, errWithCode := suite.adminProcessor.FeaturedSuggestionCreate(ctx, admin, account.ID)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+		suite.Equal(account.ID, apiAccount.ID)
+	}
+
+	featured, errWithCode := suite.adminProcessor.FeaturedSuggestionsGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(featured, 1)
+	suite.Equal(account.ID, featured[0].ID)
+
+	// Unfeature the account.
+	apiAccount, errWithCode := suite.adminProcessor.FeaturedSuggestionDelete(ctx, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(account.ID, apiAccount.ID)
+
+	featured, errWithCode = suite.adminProcessor.FeaturedSuggestionsGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(featured)
+
+	// Unfeaturing again should 404.
+	_, errWithCode = suite.adminProcessor.FeaturedSuggestionDelete(ctx, account.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *SuggestionTestSuite) TestFeaturedSuggestionCreateRemote() {
+	_, errWithCode := suite.adminProcessor.FeaturedSuggestionCreate(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		suite.testAccounts["remote_account_1"].ID,
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestSuggestionTestSuite(t *testing.T) {
+	suite.Run(t, &SuggestionTestSuite{})
+}
@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+)
+
+// GetWordFilter fetches word filter with provided ID from the database.
+func (p *Processor) GetWordFilter(ctx context.Context, id string) (*apimodel.WordFilter, gtserror.WithCode) {
+	// Select filter by ID from db.
+	filter, err := p.state.DB.GetWordFilter(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return toAPIWordFilter(filter), nil
+
+	// Filter does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "filter not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// GetWordFilters fetches all word filters stored in the database.
+func (p *Processor) GetWordFilters(ctx context.Context) ([]*apimodel.WordFilter, gtserror.WithCode) {
+	// Select all filters from DB.
+	filters, err := p.state.DB.GetWordFilters(ctx)
+
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert word filters to apimodel filters.
+	apiFilters := make([]*apimodel.WordFilter, len(filters))
+	for i := range filters {
+		apiFilters[i] = toAPIWordFilter(filters[i])
+	}
+
+	return apiFilters, nil
+}
+
+// CreateWordFilter inserts the incoming word filter into the
+// database, marking as authored by provided admin account.
+func (p *Processor) CreateWordFilter(ctx context.Context, admin *gtsmodel.Account, request *apimodel.WordFilterRequest) (*apimodel.WordFilter, gtserror.WithCode) {
+	target, ok := gtsmodel.ParseWordFilterTarget(request.Target)
+	if !ok {
+		err := fmt.Errorf("invalid target %q, must be content, links, or display_name", request.Target)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	action, ok := gtsmodel.ParseWordFilterAction(request.Action)
+	if !ok {
+		err := fmt.Errorf("invalid action %q, must be drop, reject, or sensitive", request.Action)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Ensure the keyword or expression
+	// is usable before storing it.
+	if err := wordfilter.Validate(
+		request.Pattern,
+		request.Regex,
+	); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Create new database model with ID.
+	filter := &gtsmodel.WordFilter{
+		ID:       id.NewULID(),
+		AuthorID: admin.ID,
+		Author:   admin,
+		Pattern:  request.Pattern,
+		Regex:    &request.Regex,
+		Target:   target,
+		Action:   action,
+		Comment:  text.SanitizeToPlaintext(request.Comment),
+	}
+
+	// Insert new word filter into the database.
+	if err := p.state.DB.PutWordFilter(ctx, filter); err != nil {
+		err := gtserror.Newf("error inserting into database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Finally return API model response.
+	return toAPIWordFilter(filter), nil
+}
+
+// DeleteWordFilter deletes the word filter with provided ID from the database.
+func (p *Processor) DeleteWordFilter(ctx context.Context, id string) gtserror.WithCode {
+	if err := p.state.DB.DeleteWordFilter(ctx, id); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error deleting from database: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	return nil
+}
+
+// toAPIWordFilter performs a simple conversion of database model WordFilter to API model.
+func toAPIWordFilter(filter *gtsmodel.WordFilter) *apimodel.WordFilter {
+	apiFilter := &apimodel.WordFilter{
+		ID:           filter.ID,
+		Pattern:      filter.Pattern,
+		Regex:        util.PtrValueOr(filter.Regex, false),
+		Target:       filter.Target.String(),
+		Action:       filter.Action.String(),
+		Comment:      filter.Comment,
+		MatchesCount: filter.MatchesCount,
+		CreatedBy:    filter.AuthorID,
+		CreatedAt:    util.FormatISO8601(filter.CreatedAt),
+	}
+
+	if !filter.LastMatchedAt.IsZero() {
+		apiFilter.LastMatchedAt = util.FormatISO8601(filter.LastMatchedAt)
+	}
+
+	return apiFilter
+}
@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+)
+
+type WordFilterTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *WordFilterTestSuite) TestCreateGetDeleteWordFilter() {
+	var (
+		ctx   = context.Background()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	filter, errWithCode := suite.adminProcessor.CreateWordFilter(ctx, admin, &apimodel.WordFilterRequest{
+		Pattern: `(?i)casino\s+bonus`,
+		Regex:   true,
+		Target:  "content",
+		Action:  "reject",
+		Comment: "casino spam wave",
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(filter.Regex)
+	suite.Equal("content", filter.Target)
+	suite.Equal("reject", filter.Action)
+	suite.Equal(admin.ID, filter.CreatedBy)
+	suite.Zero(filter.MatchesCount)
+	suite.Empty(filter.LastMatchedAt)
+
+	filters, errWithCode := suite.adminProcessor.GetWordFilters(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(filters, 1)
+
+	// New filter should be used for matching.
+	matched, err := suite.db.WordFilterMatch(ctx, &wordfilter.Input{
+		Content: "Casino  Bonus!",
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(matched, 1) {
+		suite.Equal(filter.ID, matched[0].ID)
+	}
+
+	if errWithCode := suite.adminProcessor.DeleteWordFilter(ctx, filter.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.adminProcessor.GetWordFilter(ctx, filter.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Deleted filter should no longer match.
+	matched, err = suite.db.WordFilterMatch(ctx, &wordfilter.Input{
+		Content: "Casino  Bonus!",
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(matched)
+}
+
+func (suite *WordFilterTestSuite) TestCreateWordFilterInvalid() {
+	var (
+		ctx   = context.Background()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	for _, request := range []*apimodel.WordFilterRequest{
+		{Pattern: "casino(", Regex: true, Target: "content", Action: "drop"},
+		{Pattern: "", Target: "content", Action: "drop"},
+		{Pattern: "casino", Target: "username", Action: "drop"},
+		{Pattern: "casino", Target: "content", Action: "silence"},
+	} {
+		_, errWithCode := suite.adminProcessor.CreateWordFilter(ctx, admin, request)
+		if suite.NotNil(errWithCode) {
+			suite.Equal(http.StatusBadRequest, errWithCode.Code())
+		}
+	}
+}
+
+func TestWordFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(WordFilterTestSuite))
+}
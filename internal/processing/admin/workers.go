@@ -0,0 +1,210 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/workers"
+)
+
+// WorkerPoolMaxSize is the maximum size
+// a worker pool may be resized to.
+const WorkerPoolMaxSize = 1024
+
+// WorkersGet returns the current state of
+// each of this instance's worker pools.
+func (p *Processor) WorkersGet(ctx context.Context) ([]*apimodel.AdminWorkerPool, gtserror.WithCode) {
+	settings, _, err := p.instanceSettings(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiWorkerPools(settings), nil
+}
+
+// WorkersUpdate resizes the worker pools set in the given
+// form, persisting the new sizes as overrides so they're
+// kept across restarts. A size of 0 clears any override,
+// resizing that pool back to its configured size.
+func (p *Processor) WorkersUpdate(
+	ctx context.Context,
+	form *apimodel.AdminWorkersUpdateRequest,
+) ([]*apimodel.AdminWorkerPool, gtserror.WithCode) {
+	sizes := map[string]*int{
+		workers.PoolDelivery:    form.Delivery,
+		workers.PoolClient:      form.Client,
+		workers.PoolFederator:   form.Federator,
+		workers.PoolDereference: form.Dereference,
+		workers.PoolMedia:       form.Media,
+	}
+
+	// Validate all sizes before
+	// we start resizing anything.
+	for name, size := range sizes {
+		if size == nil {
+			continue
+		}
+
+		if *size < 0 || *size > WorkerPoolMaxSize {
+			err := fmt.Errorf("%s must be between 0 and %d", name, WorkerPoolMaxSize)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	settings, stored, err := p.instanceSettings(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var columns []string
+	for _, name := range workers.PoolNames {
+		size := sizes[name]
+		if size == nil {
+			continue
+		}
+
+		// Update stored override.
+		*workersOverride(settings, name) = *size
+		columns = append(columns, name+"_workers")
+	}
+
+	if len(columns) == 0 {
+		const text = "at least one worker pool size must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if !stored {
+		// No settings stored
+		// yet, create them now.
+		err = p.state.DB.PutInstanceSettings(ctx, settings)
+	} else {
+		err = p.state.DB.UpdateInstanceSettings(ctx, settings, columns...)
+	}
+	if err != nil {
+		err := gtserror.Newf("db error storing instance settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Apply the new sizes.
+	p.resizeWorkers(ctx, settings)
+
+	return p.apiWorkerPools(settings), nil
+}
+
+// WorkersResume resizes worker pools according to any overrides
+// stored via WorkersUpdate. Should be called once on startup,
+// after worker pools have been started at their configured sizes.
+func (p *Processor) WorkersResume(ctx context.Context) error {
+	settings, _, err := p.instanceSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.resizeWorkers(ctx, settings)
+	return nil
+}
+
+// resizeWorkers resizes each worker pool to its
+// override in settings, else its configured size.
+func (p *Processor) resizeWorkers(ctx context.Context, settings *gtsmodel.InstanceSettings) {
+	for _, name := range workers.PoolNames {
+		pool, _ := p.state.Workers.Pool(name)
+
+		n := *workersOverride(settings, name)
+		if n == 0 {
+			n = workers.DefaultPoolSize(name)
+		}
+
+		if n == pool.Size() {
+			continue
+		}
+
+		if pool.Resize(n) {
+			log.Infof(ctx, "resized %s workers to %d", name, n)
+		}
+	}
+}
+
+// apiWorkerPools converts the current state of each
+// worker pool to its frontend API representation.
+func (p *Processor) apiWorkerPools(settings *gtsmodel.InstanceSettings) []*apimodel.AdminWorkerPool {
+	apiPools := make([]*apimodel.AdminWorkerPool, 0, len(workers.PoolNames))
+	for _, name := range workers.PoolNames {
+		pool, _ := p.state.Workers.Pool(name)
+		apiPools = append(apiPools, &apimodel.AdminWorkerPool{
+			Name:     name,
+			Size:     pool.Size(),
+			Queued:   pool.Queued(),
+			Busy:     pool.Busy(),
+			Default:  workers.DefaultPoolSize(name),
+			Override: *workersOverride(settings, name),
+		})
+	}
+	return apiPools
+}
+
+// instanceSettings fetches the settings of this instance, returning
+// new settings and stored = false if none are stored in the db yet.
+func (p *Processor) instanceSettings(ctx context.Context) (*gtsmodel.InstanceSettings, bool, error) {
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return nil, false, gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	settings, err := p.state.DB.GetInstanceSettings(ctx, instance.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, false, gtserror.Newf("db error getting instance settings: %w", err)
+	}
+
+	if settings == nil {
+		settings = &gtsmodel.InstanceSettings{
+			InstanceID: instance.ID,
+		}
+		return settings, false, nil
+	}
+
+	return settings, true, nil
+}
+
+// workersOverride returns a pointer to the
+// stored size override for named worker pool.
+func workersOverride(settings *gtsmodel.InstanceSettings, name string) *int {
+	switch name {
+	case workers.PoolDelivery:
+		return &settings.DeliveryWorkers
+	case workers.PoolClient:
+		return &settings.ClientWorkers
+	case workers.PoolFederator:
+		return &settings.FederatorWorkers
+	case workers.PoolDereference:
+		return &settings.DereferenceWorkers
+	case workers.PoolMedia:
+		return &settings.MediaWorkers
+	default:
+		panic("unknown worker pool: " + name)
+	}
+}
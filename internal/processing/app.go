@@ -19,9 +19,12 @@ package processing
 
 import (
 	"context"
+	"errors"
+	"regexp"
 
 	"github.com/google/uuid"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -37,6 +40,16 @@ func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *api
 		scopes = form.Scopes
 	}
 
+	// make sure the app isn't blocked from registering
+	blocked, err := p.appBlocked(ctx, form.ClientName, form.Website)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	if blocked {
+		const text = "registration of this application is not permitted by this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
 	// generate new IDs for this application and its associated client
 	clientID, err := id.NewRandomULID()
 	if err != nil {
@@ -86,3 +99,42 @@ func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *api
 
 	return apiApp, nil
 }
+
+// appBlocked returns whether an application with the given
+// name and website matches any application block. Where a
+// block has both patterns set, both of them must match.
+func (p *Processor) appBlocked(ctx context.Context, name string, website string) (bool, error) {
+	blocks, err := p.state.DB.GetApplicationBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting application blocks: %w", err)
+	}
+
+	for _, block := range blocks {
+		matched, err := patternMatches(block.NamePattern, name)
+		if err != nil {
+			return false, gtserror.Newf("invalid name pattern of application block %s: %w", block.ID, err)
+		}
+		if !matched {
+			continue
+		}
+
+		matched, err = patternMatches(block.WebsitePattern, website)
+		if err != nil {
+			return false, gtserror.Newf("invalid website pattern of application block %s: %w", block.ID, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// patternMatches returns whether value matches the given
+// regular expression, treating an empty pattern as a match.
+func patternMatches(pattern string, value string) (bool, error) {
+	if pattern == "" {
+		return true, nil
+	}
+	return regexp.MatchString(pattern, value)
+}
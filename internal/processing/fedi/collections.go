@@ -27,6 +27,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
@@ -92,29 +94,36 @@ func (p *Processor) OutboxGet(
 		// just return barest stub of collection.
 		obj = ap.NewASOrderedCollection(params)
 
+	case receivingAcct.HidesOutbox():
+		// If account hides its outbox contents,
+		// return collection with total count
+		// of statuses, but no pages to follow.
+		params.Total = util.Ptr(*receivingAcct.Stats.StatusesCount)
+		obj = ap.NewASOrderedCollection(params)
+
 	case page == nil || auth.handshakingURI != nil:
 		// If paging disabled, or we're currently handshaking
 		// the requester, just return collection that links
-		// to first page (i.e. path below), with no items.
+		// to first and last pages (i.e. path below), with no items.
 		params.Total = util.Ptr(*receivingAcct.Stats.StatusesCount)
 		params.First = new(paging.Page)
+		params.Last = &paging.Page{
+			// Page up from the lowest
+			// possible ID, ie., oldest.
+			Min: paging.MinID(id.Lowest),
+			Max: paging.MaxID(""),
+		}
 		params.Query = make(url.Values, 1)
 		params.Query.Set("limit", "40") // enables paging
 		obj = ap.NewASOrderedCollection(params)
 
 	default:
 		// Paging enabled.
-		// Get page of full public statuses.
-		statuses, err := p.state.DB.GetAccountStatuses(
+		// Get page of public + unlisted statuses.
+		statuses, err := p.state.DB.GetAccountOutboxStatuses(
 			ctx,
 			receivingAcct.ID,
-			page.GetLimit(), // limit
-			true,            // excludeReplies
-			true,            // excludeReblogs
-			page.GetMax(),   // maxID
-			page.GetMin(),   // minID
-			false,           // mediaOnly
-			true,            // publicOnly
+			page,
 		)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
 			err := gtserror.Newf("error getting statuses: %w", err)
@@ -144,6 +153,17 @@ func (p *Processor) OutboxGet(
 		pageParams.Next = page.Next(lo, hi)
 		pageParams.Prev = page.Prev(lo, hi)
 
+		if limit := page.GetLimit(); limit > 0 && len(statuses) < limit {
+			// Short page means we reached the end
+			// of statuses in the direction we were
+			// paging, so don't link further that way.
+			if page.GetOrder() == paging.OrderAscending {
+				pageParams.Prev = nil
+			} else {
+				pageParams.Next = nil
+			}
+		}
+
 		// Set the collection item property builder function.
 		pageParams.Append = func(i int, itemsProp ap.ItemsPropertyBuilder) {
 			// Get status at index.
@@ -418,10 +438,18 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser str
 	}
 	receivingAcct := auth.receivingAcct
 
-	statuses, err := p.state.DB.GetAccountPinnedStatuses(ctx, receivingAcct.ID)
-	if err != nil {
-		if !errors.Is(err, db.ErrNoEntries) {
-			return nil, gtserror.NewErrorInternalError(err)
+	var statuses []*gtsmodel.Status
+
+	// Only fetch pinned statuses if the account
+	// doesn't hide its outbox contents, else
+	// just serve an empty featured collection.
+	if !receivingAcct.HidesOutbox() {
+		var err error
+		statuses, err = p.state.DB.GetAccountPinnedStatuses(ctx, receivingAcct.ID)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				return nil, gtserror.NewErrorInternalError(err)
+			}
 		}
 	}
 
@@ -24,6 +24,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 const (
@@ -116,15 +117,24 @@ func (p *Processor) HostMetaGet() *apimodel.HostMeta {
 }
 
 // WebfingerGet handles the GET for a webfinger resource. Most commonly, it will be used for returning account lookups.
-func (p *Processor) WebfingerGet(ctx context.Context, requestedUsername string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
+//
+// The requestedHost should be either our host, or one of our account domains. If it's an account
+// domain, it must be the account domain used by the requested account, otherwise 404 is returned.
+func (p *Processor) WebfingerGet(ctx context.Context, requestedUsername string, requestedHost string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
 	// Get the local account the request is referring to.
 	requestedAccount, err := p.state.DB.GetAccountByUsernameDomain(ctx, requestedUsername, "")
 	if err != nil {
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("database error getting account with username %s: %s", requestedUsername, err))
 	}
 
+	nameDomain := requestedAccount.NameDomain()
+	if requestedHost != config.GetHost() && requestedHost != nameDomain {
+		err := fmt.Errorf("account with username %s does not use account domain %s", requestedUsername, requestedHost)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
 	return &apimodel.WellKnownResponse{
-		Subject: webfingerAccount + ":" + requestedAccount.Username + "@" + config.GetAccountDomain(),
+		Subject: webfingerAccount + ":" + typeutils.AccountToWebfingerAcct(requestedAccount, nameDomain),
 		Aliases: []string{
 			requestedAccount.URI,
 			requestedAccount.URL,
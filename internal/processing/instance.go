@@ -19,6 +19,7 @@ package processing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -104,11 +105,18 @@ func (p *Processor) InstancePeersGet(ctx context.Context, includeSuspended bool,
 				d = obfuscate(d)
 			}
 
-			domains = append(domains, &apimodel.Domain{
+			domain := &apimodel.Domain{
 				Domain:        d,
-				SuspendedAt:   util.FormatISO8601(domainBlock.CreatedAt),
 				PublicComment: domainBlock.PublicComment,
-			})
+			}
+
+			if domainBlock.Severity == gtsmodel.DomainBlockLimit {
+				domain.SilencedAt = util.FormatISO8601(domainBlock.CreatedAt)
+			} else {
+				domain.SuspendedAt = util.FormatISO8601(domainBlock.CreatedAt)
+			}
+
+			domains = append(domains, domain)
 		}
 	}
 
@@ -136,6 +144,29 @@ func (p *Processor) InstanceGetRules(ctx context.Context) ([]apimodel.InstanceRu
 	return p.converter.InstanceRulesToAPIRules(i.Rules), nil
 }
 
+// InstanceGetCustomCSS returns admin-set custom CSS
+// for the web UI of this instance, or an empty string.
+func (p *Processor) InstanceGetCustomCSS(ctx context.Context) (string, gtserror.WithCode) {
+	i, err := p.getThisInstance(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error fetching instance: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	settings, err := p.state.DB.GetInstanceSettings(ctx, i.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Nothing set.
+			return "", nil
+		}
+
+		err := gtserror.Newf("db error fetching instance settings: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	return settings.CustomCSS, nil
+}
+
 func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.InstanceV1, gtserror.WithCode) {
 	// Fetch this instance from the db for processing.
 	instance, err := p.getThisInstance(ctx)
@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // Create creates a new media attachment belonging to the given account, using the request form.
@@ -41,11 +42,18 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
+	// Make sure this upload won't
+	// take account over its quota.
+	if errWithCode := p.checkMediaQuota(ctx, account, form.File.Size); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// process the media attachment and load it immediately
 	media := p.mediaManager.PreProcessMedia(data, account.ID, &media.AdditionalMediaInfo{
 		Description: &form.Description,
 		FocusX:      &focusX,
 		FocusY:      &focusY,
+		Sensitive:   &form.Sensitive,
 	})
 
 	attachment, err := media.LoadAttachment(ctx)
@@ -64,3 +72,31 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 
 	return &apiAttachment, nil
 }
+
+// checkMediaQuota checks whether uploading media of the given size
+// would take account over its media quota, returning an error if so.
+// The account's own quota takes precedence over the instance default.
+func (p *Processor) checkMediaQuota(ctx context.Context, account *gtsmodel.Account, size int64) gtserror.WithCode {
+	quota := account.MediaQuota()
+	if quota == 0 {
+		// No limit.
+		return nil
+	}
+
+	// Ensure account stats populated,
+	// these hold the media used count.
+	if account.Stats == nil {
+		if err := p.state.DB.PopulateAccountStats(ctx, account); err != nil {
+			err := gtserror.Newf("db error getting stats for account %s: %w", account.ID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	used := util.PtrValueOr(account.Stats.MediaUsedBytes, 0)
+	if used+size > quota {
+		err := fmt.Errorf("upload of %d bytes would exceed media quota of %d bytes (%d bytes used)", size, quota, used)
+		return gtserror.NewErrorRequestEntityTooLarge(err, err.Error())
+	}
+
+	return nil
+}
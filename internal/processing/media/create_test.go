@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"context"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type CreateTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *CreateTestSuite) TestCreateOverAccountQuota() {
+	ctx := context.Background()
+
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["local_account_1"]
+
+	used, err := suite.db.GetAccountMediaSize(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Leave only 1KiB of quota left.
+	account.MediaQuotaBytes = used + 1024
+
+	// Try to upload 2KiB, this
+	// should be rejected before
+	// the file is even opened.
+	apiAttachment, errWithCode := suite.mediaProcessor.Create(ctx, account, &apimodel.AttachmentRequest{
+		File: &multipart.FileHeader{
+			Filename: "big.jpg",
+			Size:     2048,
+		},
+	})
+	suite.Nil(apiAttachment)
+	suite.Equal(http.StatusRequestEntityTooLarge, errWithCode.Code())
+	suite.Contains(errWithCode.Error(), "would exceed media quota")
+}
+
+func (suite *CreateTestSuite) TestCreateOverInstanceQuota() {
+	ctx := context.Background()
+
+	// Set a tiny instance default quota.
+	config.SetMediaAccountQuota(1 * bytesize.KiB)
+
+	// Account with no quota of
+	// its own uses the default.
+	account := suite.testAccounts["local_account_1"]
+	suite.Zero(account.MediaQuotaBytes)
+
+	apiAttachment, errWithCode := suite.mediaProcessor.Create(ctx, account, &apimodel.AttachmentRequest{
+		File: &multipart.FileHeader{
+			Filename: "small.jpg",
+			Size:     512,
+		},
+	})
+	suite.Nil(apiAttachment)
+	suite.Equal(http.StatusRequestEntityTooLarge, errWithCode.Code())
+
+	// Unless it has no limit set.
+	unlimited := new(gtsmodel.Account)
+	*unlimited = *account
+	unlimited.MediaQuotaBytes = -1
+
+	// Upload will get past the quota
+	// check, but fail on the empty file.
+	_, errWithCode = suite.mediaProcessor.Create(ctx, unlimited, &apimodel.AttachmentRequest{
+		File: &multipart.FileHeader{
+			Filename: "small.jpg",
+			Size:     512,
+		},
+	})
+	suite.NotContains(errWithCode.Error(), "would exceed media quota")
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, &CreateTestSuite{})
+}
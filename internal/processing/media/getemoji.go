@@ -20,16 +20,29 @@ package media
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 // GetCustomEmojis returns a list of all useable local custom emojis stored on this instance.
 // 'useable' in this context means visible and picker, and not disabled.
-func (p *Processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode) {
+//
+// If category is set, only emojis in the category with that name (case-insensitive)
+// are returned. If search is set, only emojis with a shortcode containing the search
+// keyword (case-insensitive) are returned. Emojis are grouped by category, sorted by
+// category name, then alphabetically by shortcode within each category, with
+// uncategorized emojis last.
+func (p *Processor) GetCustomEmojis(
+	ctx context.Context,
+	category string,
+	search string,
+) ([]*apimodel.Emoji, gtserror.WithCode) {
 	emojis, err := p.state.DB.GetUseableEmojis(ctx)
 	if err != nil {
 		if err != db.ErrNoEntries {
@@ -37,14 +50,29 @@ func (p *Processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gts
 		}
 	}
 
+	if search != "" {
+		search = strings.ToLower(search)
+		emojis = slices.DeleteFunc(emojis, func(e *gtsmodel.Emoji) bool {
+			return !strings.Contains(strings.ToLower(e.Shortcode), search)
+		})
+	}
+
+	categories, err := p.converter.EmojisToAPIEmojisByCategory(ctx, emojis)
+	if err != nil {
+		// Log and continue with those
+		// emojis that did convert ok.
+		log.Errorf(ctx, "error converting emojis: %v", err)
+	}
+
 	apiEmojis := make([]*apimodel.Emoji, 0, len(emojis))
-	for _, gtsEmoji := range emojis {
-		apiEmoji, err := p.converter.EmojiToAPIEmoji(ctx, gtsEmoji)
-		if err != nil {
-			log.Errorf(ctx, "error converting emoji with id %s: %s", gtsEmoji.ID, err)
+	for _, c := range categories {
+		if category != "" && !strings.EqualFold(c.Name, category) {
 			continue
 		}
-		apiEmojis = append(apiEmojis, &apiEmoji)
+
+		for i := range c.Emojis {
+			apiEmojis = append(apiEmojis, &c.Emojis[i])
+		}
 	}
 
 	return apiEmojis, nil
@@ -29,13 +29,38 @@ type GetEmojiTestSuite struct {
 }
 
 func (suite *GetEmojiTestSuite) TestGetCustomEmojis() {
-	emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background())
+	emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background(), "", "")
 
 	suite.NoError(err)
 	suite.Equal(1, len(emojis))
 	suite.Equal("rainbow", emojis[0].Shortcode)
 }
 
+func (suite *GetEmojiTestSuite) TestGetCustomEmojisFiltered() {
+	for _, test := range []struct {
+		category string
+		search   string
+		expect   []string
+	}{
+		{category: "reactions", expect: []string{"rainbow"}},
+		{category: "REACTIONS", expect: []string{"rainbow"}},
+		{category: "cute stuff", expect: []string{}},
+		{search: "rain", expect: []string{"rainbow"}},
+		{search: "BOW", expect: []string{"rainbow"}},
+		{search: "blobcat", expect: []string{}},
+		{category: "reactions", search: "bow", expect: []string{"rainbow"}},
+	} {
+		emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background(), test.category, test.search)
+		suite.NoError(err)
+
+		shortcodes := make([]string, 0, len(emojis))
+		for _, emoji := range emojis {
+			shortcodes = append(shortcodes, emoji.Shortcode)
+		}
+		suite.Equal(test.expect, shortcodes, "category %q, search %q", test.category, test.search)
+	}
+}
+
 func TestGetEmojiTestSuite(t *testing.T) {
 	suite.Run(t, &GetEmojiTestSuite{})
 }
@@ -61,6 +61,11 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, media
 		updatingColumns = append(updatingColumns, "focus_x", "focus_y")
 	}
 
+	if form.Sensitive != nil {
+		attachment.Sensitive = form.Sensitive
+		updatingColumns = append(updatingColumns, "sensitive")
+	}
+
 	if err := p.state.DB.UpdateAttachment(ctx, attachment, updatingColumns...); err != nil {
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("database error updating media: %s", err))
 	}
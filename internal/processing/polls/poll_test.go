@@ -167,7 +167,7 @@ func (suite *PollTestSuite) testPollVote(ctx context.Context, requester *gtsmode
 	var check func(*apimodel.Poll, gtserror.WithCode) bool
 
 	switch {
-	case !poll.ClosedAt.IsZero():
+	case poll.Closed() || poll.Expired():
 		// Poll is already closed, i.e. no new votes allowed!
 		// This should return an error 422 (unprocessable entity).
 		check = func(poll *apimodel.Poll, err gtserror.WithCode) bool {
@@ -214,11 +214,17 @@ func voteChoicesAreValid(poll *gtsmodel.Poll, choices []int) bool {
 		// Invalid number of vote choices.
 		return false
 	}
+	seen := make(map[int]bool, len(choices))
 	for _, choice := range choices {
 		if choice < 0 || choice >= len(poll.Options) {
 			// Choice index out of range.
 			return false
 		}
+		if seen[choice] {
+			// Duplicate choice index.
+			return false
+		}
+		seen[choice] = true
 	}
 	return true
 }
@@ -26,8 +26,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 func (p *Processor) PollVote(ctx context.Context, requester *gtsmodel.Account, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode) {
@@ -44,36 +44,19 @@ func (p *Processor) PollVote(ctx context.Context, requester *gtsmodel.Account, p
 		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
 
 	// Poll has already closed, no more voting!
-	case poll.Closed():
+	case poll.Closed() || poll.Expired():
 		const text = "poll already closed"
 		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
-
-	// No choices given, or multiple given for single-choice poll.
-	case len(choices) == 0 || (!*poll.Multiple && len(choices) > 1):
-		const text = "invalid number of choices for poll"
-		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
-	}
-
-	for _, choice := range choices {
-		if choice < 0 || choice >= len(poll.Options) {
-			// This is an invalid choice (index out of range).
-			const text = "invalid option index for poll"
-			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
-		}
 	}
 
-	// Wrap the choices in a PollVote model.
-	vote := &gtsmodel.PollVote{
-		ID:        id.NewULID(),
-		Choices:   choices,
-		AccountID: requester.ID,
-		Account:   requester,
-		PollID:    pollID,
-		Poll:      poll,
+	// Validate the choices and wrap them in a PollVote model.
+	vote, err := typeutils.APIPollVoteToPollVote(poll, choices, requester)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
 	// Insert the new poll votes into the database.
-	err := p.state.DB.PutPollVote(ctx, vote)
+	err = p.state.DB.PutPollVote(ctx, vote)
 	switch {
 
 	case err == nil:
@@ -39,6 +39,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/processing/search"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/suggestions"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
@@ -70,22 +71,23 @@ type Processor struct {
 		SUB-PROCESSORS
 	*/
 
-	account   account.Processor
-	admin     admin.Processor
-	fedi      fedi.Processor
-	filtersv1 filtersv1.Processor
-	filtersv2 filtersv2.Processor
-	list      list.Processor
-	markers   markers.Processor
-	media     media.Processor
-	polls     polls.Processor
-	report    report.Processor
-	search    search.Processor
-	status    status.Processor
-	stream    stream.Processor
-	timeline  timeline.Processor
-	user      user.Processor
-	workers   workers.Processor
+	account     account.Processor
+	admin       admin.Processor
+	fedi        fedi.Processor
+	filtersv1   filtersv1.Processor
+	filtersv2   filtersv2.Processor
+	list        list.Processor
+	markers     markers.Processor
+	media       media.Processor
+	polls       polls.Processor
+	report      report.Processor
+	search      search.Processor
+	status      status.Processor
+	stream      stream.Processor
+	suggestions suggestions.Processor
+	timeline    timeline.Processor
+	user        user.Processor
+	workers     workers.Processor
 }
 
 func (p *Processor) Account() *account.Processor {
@@ -140,6 +142,10 @@ func (p *Processor) Stream() *stream.Processor {
 	return &p.stream
 }
 
+func (p *Processor) Suggestions() *suggestions.Processor {
+	return &p.suggestions
+}
+
 func (p *Processor) Timeline() *timeline.Processor {
 	return &p.timeline
 }
@@ -187,7 +193,7 @@ func NewProcessor(
 	// Instantiate the rest of the sub
 	// processors + pin them to this struct.
 	processor.account = account.New(&common, state, converter, mediaManager, federator, filter, parseMentionFunc)
-	processor.admin = admin.New(state, cleaner, converter, mediaManager, federator.TransportController(), emailSender)
+	processor.admin = admin.New(state, cleaner, converter, mediaManager, federator.TransportController(), emailSender, filter)
 	processor.fedi = fedi.New(state, &common, converter, federator, filter)
 	processor.filtersv1 = filtersv1.New(state, converter, &processor.stream)
 	processor.filtersv2 = filtersv2.New(state, converter, &processor.stream)
@@ -195,8 +201,9 @@ func NewProcessor(
 	processor.markers = markers.New(state, converter)
 	processor.polls = polls.New(&common, state, converter)
 	processor.report = report.New(state, converter)
-	processor.timeline = timeline.New(state, converter, filter)
+	processor.timeline = timeline.New(state, converter, filter, &processor.stream)
 	processor.search = search.New(state, federator, converter, filter)
+	processor.suggestions = suggestions.New(state, converter)
 	processor.status = status.New(state, &common, &processor.polls, federator, converter, filter, parseMentionFunc)
 	processor.user = user.New(state, converter, oauthServer, emailSender)
 
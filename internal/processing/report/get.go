@@ -47,7 +47,7 @@ func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, id strin
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
-	apiReport, err := p.converter.ReportToAPIReport(ctx, report)
+	apiReport, err := p.converter.ReportToAPIReport(ctx, report, account)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting report to api: %s", err))
 	}
@@ -88,7 +88,7 @@ func (p *Processor) GetMultiple(
 	// Convert each report to API model.
 	items := make([]interface{}, 0, count)
 	for _, r := range reports {
-		item, err := p.converter.ReportToAPIReport(ctx, r)
+		item, err := p.converter.ReportToAPIReport(ctx, r, account)
 		if err != nil {
 			err := fmt.Errorf("error converting report to api: %s", err)
 			return nil, gtserror.NewErrorInternalError(err)
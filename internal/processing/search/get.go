@@ -410,7 +410,7 @@ func (p *Processor) accountByUsernameDomain(
 	resolve bool,
 ) (*gtsmodel.Account, error) {
 	var usernameDomain string
-	if domain == "" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if domain == "" || config.IsLocalDomain(domain) {
 		// Local lookup, normalize domain.
 		domain = ""
 		usernameDomain = username
@@ -476,16 +476,79 @@ func (p *Processor) byURI(
 	appendAccount func(*gtsmodel.Account),
 	appendStatus func(*gtsmodel.Status),
 ) error {
+	found, err := p.lookupURI(
+		ctx,
+		requestingAccount,
+		uri,
+		queryType,
+		resolve,
+		appendAccount,
+		appendStatus,
+	)
+	if err != nil || found || !resolve {
+		return err
+	}
+
+	if config.IsLocalDomain(uri.Host) {
+		// Local URIs are already
+		// fully handled by lookup.
+		return nil
+	}
+
+	// No hits, but the URI may have been a web URL
+	// (e.g. https://example.org/@user/12345) that the
+	// remote doesn't serve ActivityStreams at. Try to
+	// resolve it to its canonical ActivityPub URI.
+	canonical, err := p.federator.ResolveCanonicalURI(
+		gtscontext.SetFastFail(ctx),
+		requestingAccount.Username,
+		uri,
+	)
+	if err != nil {
+		log.Debugf(ctx, "could not resolve canonical uri: %v", err)
+		return nil
+	}
+
+	if canonical.String() == uri.String() {
+		// Already tried this.
+		return nil
+	}
+
+	// Retry lookup using the canonical URI.
+	_, err = p.lookupURI(
+		ctx,
+		requestingAccount,
+		canonical,
+		queryType,
+		resolve,
+		appendAccount,
+		appendStatus,
+	)
+	return err
+}
+
+// lookupURI performs the actual account
+// and status lookups on behalf of byURI,
+// returning whether any hit was found.
+func (p *Processor) lookupURI(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	uri *url.URL,
+	queryType string,
+	resolve bool,
+	appendAccount func(*gtsmodel.Account),
+	appendStatus func(*gtsmodel.Status),
+) (bool, error) {
 	blocked, err := p.state.DB.IsURIBlocked(ctx, uri)
 	if err != nil {
 		err = gtserror.Newf("error checking domain block: %w", err)
-		return gtserror.NewErrorInternalError(err)
+		return false, gtserror.NewErrorInternalError(err)
 	}
 
 	if blocked {
 		// Don't search for
 		// blocked domains.
-		return nil
+		return false, nil
 	}
 
 	if includeAccounts(queryType) {
@@ -503,13 +566,13 @@ func (p *Processor) byURI(
 				)
 			default:
 				err = gtserror.Newf("error looking up %s as account: %w", uri, err)
-				return gtserror.NewErrorInternalError(err)
+				return false, gtserror.NewErrorInternalError(err)
 			}
 		} else {
 			// Hit! Return early since it's extremely unlikely
 			// a status and an account will have the same URL.
 			appendAccount(foundAccount)
-			return nil
+			return true, nil
 		}
 	}
 
@@ -529,19 +592,19 @@ func (p *Processor) byURI(
 				)
 			default:
 				err = gtserror.Newf("error looking up %s as status: %w", uri, err)
-				return gtserror.NewErrorInternalError(err)
+				return false, gtserror.NewErrorInternalError(err)
 			}
 		} else {
 			// Hit! Return early since it's extremely unlikely
 			// a status and an account will have the same URL.
 			appendStatus(foundStatus)
-			return nil
+			return true, nil
 		}
 	}
 
 	// No errors, but no hits
 	// either; that's fine.
-	return nil
+	return false, nil
 }
 
 // accountByURI looks for one account with the given URI.
@@ -616,6 +679,9 @@ func (p *Processor) statusByURI(
 	if resolve {
 		// We're allowed to resolve, leave the
 		// rest up to the dereferencer functions.
+		// This is an explicit lookup by a local
+		// user, so ignore any existing tombstone.
+		ctx := gtscontext.SetExplicitLookup(ctx)
 		status, _, err := p.federator.GetStatusByURI(
 			gtscontext.SetFastFail(ctx),
 			requestingAccount.Username,
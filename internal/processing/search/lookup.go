@@ -28,7 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
-	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 // Lookup does a quick, non-resolving search for accounts that
@@ -63,13 +63,6 @@ func (p *Processor) Lookup(
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
-	// Be nice and normalize query by prepending '@'.
-	// This will make it easier for accountsByNamestring
-	// to pick this up as a valid namestring.
-	if query[0] != '@' {
-		query = "@" + query
-	}
-
 	log.
 		WithContext(ctx).
 		WithFields(kv.Fields{
@@ -78,7 +71,7 @@ func (p *Processor) Lookup(
 		Debugf("beginning search")
 
 	// See if we have something that looks like a namestring.
-	username, domain, err := util.ExtractNamestringParts(query)
+	username, domain, err := typeutils.ParseAcct(query)
 	if err != nil {
 		err := errors.New("bad search query, must in the form '[username]' or '[username]@[domain]")
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
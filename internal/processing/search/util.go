@@ -21,7 +21,6 @@ import (
 	"context"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
-	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -91,82 +90,6 @@ func (p *Processor) packageAccounts(
 	return apiAccounts, nil
 }
 
-// packageStatuses is a util function that just
-// converts the given statuses into an apimodel
-// status slice, or errors appropriately.
-func (p *Processor) packageStatuses(
-	ctx context.Context,
-	requestingAccount *gtsmodel.Account,
-	statuses []*gtsmodel.Status,
-) ([]*apimodel.Status, gtserror.WithCode) {
-	apiStatuses := make([]*apimodel.Status, 0, len(statuses))
-
-	for _, status := range statuses {
-		// Ensure requester can see result status.
-		visible, err := p.filter.StatusVisible(ctx, requestingAccount, status)
-		if err != nil {
-			err = gtserror.Newf("error checking visibility of status %s for account %s: %w", status.ID, requestingAccount.ID, err)
-			return nil, gtserror.NewErrorInternalError(err)
-		}
-
-		if !visible {
-			log.Debugf(ctx, "status %s is not visible to account %s, skipping this result", status.ID, requestingAccount.ID)
-			continue
-		}
-
-		apiStatus, err := p.converter.StatusToAPIStatus(ctx, status, requestingAccount, statusfilter.FilterContextNone, nil, nil)
-		if err != nil {
-			log.Debugf(ctx, "skipping status %s because it couldn't be converted to its api representation: %s", status.ID, err)
-			continue
-		}
-
-		apiStatuses = append(apiStatuses, apiStatus)
-	}
-
-	return apiStatuses, nil
-}
-
-// packageHashtags is a util function that just
-// converts the given hashtags into an apimodel
-// hashtag slice, or errors appropriately.
-func (p *Processor) packageHashtags(
-	ctx context.Context,
-	requestingAccount *gtsmodel.Account,
-	tags []*gtsmodel.Tag,
-	v1 bool,
-) ([]any, gtserror.WithCode) {
-	apiTags := make([]any, 0, len(tags))
-
-	var rangeF func(*gtsmodel.Tag)
-	if v1 {
-		// If API version 1, just provide slice of tag names.
-		rangeF = func(tag *gtsmodel.Tag) {
-			apiTags = append(apiTags, tag.Name)
-		}
-	} else {
-		// If API not version 1, provide slice of full tags.
-		rangeF = func(tag *gtsmodel.Tag) {
-			apiTag, err := p.converter.TagToAPITag(ctx, tag, true)
-			if err != nil {
-				log.Debugf(
-					ctx,
-					"skipping tag %s because it couldn't be converted to its api representation: %s",
-					tag.Name, err,
-				)
-				return
-			}
-
-			apiTags = append(apiTags, &apiTag)
-		}
-	}
-
-	for _, tag := range tags {
-		rangeF(tag)
-	}
-
-	return apiTags, nil
-}
-
 // packageSearchResult wraps up the given accounts
 // and statuses into an apimodel SearchResult that
 // can be serialized to an API caller as JSON.
@@ -193,19 +116,34 @@ func (p *Processor) packageSearchResult(
 		return nil, errWithCode
 	}
 
-	apiStatuses, errWithCode := p.packageStatuses(ctx, requestingAccount, statuses)
-	if errWithCode != nil {
-		return nil, errWithCode
+	// Convert statuses and (for v2) full tags to
+	// their api models. Accounts are packaged separately,
+	// as search has its own rules about instance and
+	// blocked accounts, as are v1 tags, which are names.
+	apiTags := tags
+	if v1 {
+		apiTags = nil
 	}
 
-	apiTags, errWithCode := p.packageHashtags(ctx, requestingAccount, tags, v1)
-	if errWithCode != nil {
-		return nil, errWithCode
+	result, err := p.converter.SearchResultsToAPISearchResult(ctx,
+		nil,
+		statuses,
+		apiTags,
+		requestingAccount,
+	)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	result.Accounts = apiAccounts
+
+	if v1 {
+		// If API version 1, just
+		// provide slice of tag names.
+		for _, tag := range tags {
+			result.Hashtags = append(result.Hashtags, tag.GetDisplayName())
+		}
 	}
 
-	return &apimodel.SearchResult{
-		Accounts: apiAccounts,
-		Statuses: apiStatuses,
-		Hashtags: apiTags,
-	}, nil
+	return result, nil
 }
@@ -20,7 +20,6 @@ package status
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -28,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // BoostCreate processes the boost/reblog of target
@@ -160,80 +160,73 @@ func (p *Processor) BoostRemove(
 	return p.c.GetAPIStatus(ctx, requester, target)
 }
 
-// StatusBoostedBy returns a slice of accounts that have boosted the given status, filtered according to privacy settings.
-func (p *Processor) StatusBoostedBy(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) ([]*apimodel.Account, gtserror.WithCode) {
-	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
-	if err != nil {
-		wrapped := fmt.Errorf("BoostedBy: error fetching status %s: %s", targetStatusID, err)
-		if !errors.Is(err, db.ErrNoEntries) {
-			return nil, gtserror.NewErrorInternalError(wrapped)
-		}
-		return nil, gtserror.NewErrorNotFound(wrapped)
+// StatusBoostedBy returns a page of accounts that have boosted the given status, newest
+// boost first, paged by boost ID and filtered according to privacy settings.
+func (p *Processor) StatusBoostedBy(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requestingAccount,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
 	if boostOfID := targetStatus.BoostOfID; boostOfID != "" {
-		// the target status is a boost wrapper, redirect this request to the status it boosts
-		boostedStatus, err := p.state.DB.GetStatusByID(ctx, boostOfID)
-		if err != nil {
-			wrapped := fmt.Errorf("BoostedBy: error fetching status %s: %s", boostOfID, err)
-			if !errors.Is(err, db.ErrNoEntries) {
-				return nil, gtserror.NewErrorInternalError(wrapped)
-			}
-			return nil, gtserror.NewErrorNotFound(wrapped)
+		// The target status is a boost wrapper,
+		// redirect this request to the status it boosts.
+		targetStatus, errWithCode = p.c.GetVisibleTargetStatus(ctx,
+			requestingAccount,
+			boostOfID,
+			nil, // default freshness
+		)
+		if errWithCode != nil {
+			return nil, errWithCode
 		}
-		targetStatus = boostedStatus
 	}
 
-	visible, err := p.filter.StatusVisible(ctx, requestingAccount, targetStatus)
-	if err != nil {
-		err = fmt.Errorf("BoostedBy: error seeing if status %s is visible: %s", targetStatus.ID, err)
-		return nil, gtserror.NewErrorNotFound(err)
-	}
-	if !visible {
-		err = errors.New("BoostedBy: status is not visible")
-		return nil, gtserror.NewErrorNotFound(err)
+	// Get note to serve the list with, in case
+	// status author hides interaction counts.
+	note, errWithCode := p.interactionsNote(ctx, requestingAccount, targetStatus)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
-	statusBoosts, err := p.state.DB.GetStatusBoosts(ctx, targetStatus.ID)
-	if err != nil {
-		err = fmt.Errorf("BoostedBy: error seeing who boosted status: %s", err)
-		return nil, gtserror.NewErrorNotFound(err)
+	boosts, err := p.state.DB.GetStatusBoosts(ctx, targetStatus.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting boosts of status %s: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	// filter account IDs so the user doesn't see accounts they blocked or which blocked them
-	accountIDs := make([]string, 0, len(statusBoosts))
-	for _, s := range statusBoosts {
-		blocked, err := p.state.DB.IsEitherBlocked(ctx, requestingAccount.ID, s.AccountID)
-		if err != nil {
-			err = fmt.Errorf("BoostedBy: error checking blocks: %s", err)
-			return nil, gtserror.NewErrorNotFound(err)
-		}
-		if !blocked {
-			accountIDs = append(accountIDs, s.AccountID)
-		}
+	// Check for empty response.
+	count := len(boosts)
+	if count == 0 {
+		resp := paging.EmptyResponse()
+		resp.Note = note
+		return resp, nil
 	}
 
-	// TODO: filter other things here? suspended? muted? silenced?
-
-	// fetch accounts + create their API representations
-	apiAccounts := make([]*apimodel.Account, 0, len(accountIDs))
-	for _, accountID := range accountIDs {
-		account, err := p.state.DB.GetAccountByID(ctx, accountID)
-		if err != nil {
-			wrapped := fmt.Errorf("BoostedBy: error fetching account %s: %s", accountID, err)
-			if !errors.Is(err, db.ErrNoEntries) {
-				return nil, gtserror.NewErrorInternalError(wrapped)
-			}
-			return nil, gtserror.NewErrorNotFound(wrapped)
-		}
-
-		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
-		if err != nil {
-			err = fmt.Errorf("BoostedBy: error converting account to api model: %s", err)
-			return nil, gtserror.NewErrorInternalError(err)
-		}
-		apiAccounts = append(apiAccounts, apiAccount)
+	// Func to fetch boost author at index.
+	getIdx := func(i int) *gtsmodel.Account {
+		return boosts[i].Account
 	}
 
-	return apiAccounts, nil
+	// Get a filtered slice of public API account models,
+	// excluding eg., accounts blocking / blocked by requester.
+	items := p.c.GetVisibleAPIAccountsPaged(ctx,
+		requestingAccount,
+		getIdx,
+		count,
+	)
+
+	return &apimodel.PageableResponse{
+		Items:      items,
+		LinkHeader: pageLinks("/api/v1/statuses/"+targetStatusID+"/reblogged_by", boosts, page),
+		Note:       note,
+	}, nil
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -55,6 +56,45 @@ func (p *Processor) Create(
 		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
 	}
 
+	// Ensure status isn't too long for this account.
+	maxChars := statusMaxChars(requester)
+	if length := statusLength(form); length > maxChars {
+		text := fmt.Sprintf("status too long, %d characters provided (including spoiler/content warning) but limit is %d", length, maxChars)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Parse + format the form into a new status.
+	status, errWithCode := p.prepare(ctx, requester, form, p.parseMention)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	status.CreatedWithApplicationID = application.ID
+
+	// Store the status + queue side effects.
+	if errWithCode := p.store(ctx, requester, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// prepare parses the given form into a new status authored by
+// requester, resolving reply target, attachments, visibility and
+// language, and formatting content (with mentions, tags, emojis).
+//
+// This doesn't store the status itself, or queue any side effects.
+// If the context is marked with gtscontext.SetDryRun(), formatting
+// will also not store any new mentions or tags in the database.
+func (p *Processor) prepare(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.AdvancedStatusCreateForm,
+	parseMention gtsmodel.ParseMentionFunc,
+) (
+	*gtsmodel.Status,
+	gtserror.WithCode,
+) {
 	// Generate new ID for status.
 	statusID := id.NewULID()
 
@@ -65,19 +105,17 @@ func (p *Processor) Create(
 	now := time.Now()
 
 	status := &gtsmodel.Status{
-		ID:                       statusID,
-		URI:                      accountURIs.StatusesURI + "/" + statusID,
-		URL:                      accountURIs.StatusesURL + "/" + statusID,
-		CreatedAt:                now,
-		UpdatedAt:                now,
-		Local:                    util.Ptr(true),
-		Account:                  requester,
-		AccountID:                requester.ID,
-		AccountURI:               requester.URI,
-		ActivityStreamsType:      ap.ObjectNote,
-		Sensitive:                &form.Sensitive,
-		CreatedWithApplicationID: application.ID,
-		Text:                     form.Status,
+		ID:                  statusID,
+		URI:                 accountURIs.StatusesURI + "/" + statusID,
+		URL:                 accountURIs.StatusesURL + "/" + statusID,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+		Local:               util.Ptr(true),
+		Account:             requester,
+		AccountID:           requester.ID,
+		AccountURI:          requester.URI,
+		ActivityStreamsType: ap.ObjectNote,
+		Text:                form.Status,
 	}
 
 	if form.Poll != nil {
@@ -109,10 +147,6 @@ func (p *Processor) Create(
 		return nil, errWithCode
 	}
 
-	if errWithCode := p.processThreadID(ctx, status); errWithCode != nil {
-		return nil, errWithCode
-	}
-
 	if errWithCode := p.processMediaIDs(ctx, form, requester.ID, status); errWithCode != nil {
 		return nil, errWithCode
 	}
@@ -121,25 +155,44 @@ func (p *Processor) Create(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	if err := processLanguage(form, requester.Settings.Language, status); err != nil {
+	processSensitive(form, requester.Settings.Sensitive, status)
+
+	if err := p.processContent(ctx, parseMention, form, status); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	if err := p.processContent(ctx, p.parseMention, form, status); err != nil {
+	// Process language after content, as
+	// it may be detected from formatted text.
+	if err := p.processLanguage(form, requester.Settings.Language, status); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	return status, nil
+}
+
+// store inserts the given prepared status (and its thread + poll,
+// where necessary) into the database, then queues side effects of
+// status creation, and schedules expiry of the status poll (if any).
+func (p *Processor) store(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+) gtserror.WithCode {
+	if errWithCode := p.processThreadID(ctx, status); errWithCode != nil {
+		return errWithCode
+	}
+
 	if status.Poll != nil {
 		// Try to insert the new status poll in the database.
 		if err := p.state.DB.PutPoll(ctx, status.Poll); err != nil {
 			err := gtserror.Newf("error inserting poll in db: %w", err)
-			return nil, gtserror.NewErrorInternalError(err)
+			return gtserror.NewErrorInternalError(err)
 		}
 	}
 
 	// Insert this new status in the database.
 	if err := p.state.DB.PutStatus(ctx, status); err != nil {
-		return nil, gtserror.NewErrorInternalError(err)
+		return gtserror.NewErrorInternalError(err)
 	}
 
 	// send it back to the client API worker for async side-effects.
@@ -158,7 +211,7 @@ func (p *Processor) Create(
 		}
 	}
 
-	return p.c.GetAPIStatus(ctx, requester, status)
+	return nil
 }
 
 func (p *Processor) processInReplyTo(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status, inReplyToID string) gtserror.WithCode {
@@ -244,8 +297,14 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.Advanced
 		return nil
 	}
 
-	// Get minimum allowed char descriptions.
-	minChars := config.GetMediaDescriptionMinChars()
+	// Get allowed char descriptions, and whether
+	// a description is required at all. These are
+	// only ever checked here, on local composition,
+	// so remote statuses are never rejected for them.
+	limits := gtsmodel.NewInstanceConfiguration()
+	minChars := limits.MediaDescriptionMinChars
+	maxChars := limits.MediaDescriptionMaxChars
+	required := limits.MediaDescriptionRequired
 
 	attachments := []*gtsmodel.MediaAttachment{}
 	attachmentIDs := []string{}
@@ -272,11 +331,24 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.Advanced
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
-		if length := len([]rune(attachment.Description)); length < minChars {
+		length := len([]rune(attachment.Description))
+
+		if length == 0 && required {
+			text := fmt.Sprintf("media %s has no description, but descriptions are required on this instance", mediaID)
+			err := gtserror.SetType(errors.New(text), gtserror.TypeMediaDescriptionMissing)
+			return gtserror.NewErrorBadRequest(err, text)
+		}
+
+		if length < minChars {
 			text := fmt.Sprintf("media %s description too short, at least %d required", mediaID, minChars)
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
+		if length > maxChars {
+			text := fmt.Sprintf("media %s description too long, at most %d permitted", mediaID, maxChars)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
 		attachments = append(attachments, attachment)
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
@@ -359,9 +431,11 @@ func processVisibility(form *apimodel.AdvancedStatusCreateForm, accountDefaultVi
 	return nil
 }
 
-func processLanguage(form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error {
+func (p *Processor) processLanguage(form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error {
 	if form.Language != "" {
 		status.Language = form.Language
+	} else if lang, ok := p.detectLanguage(status); ok {
+		status.Language = lang
 	} else {
 		status.Language = accountDefaultLanguage
 	}
@@ -371,6 +445,44 @@ func processLanguage(form *apimodel.AdvancedStatusCreateForm, accountDefaultLang
 	return nil
 }
 
+// detectLanguage attempts to detect the language of the given
+// status from the plain text of its content and content warning,
+// if status language detection is enabled on this instance.
+func (p *Processor) detectLanguage(status *gtsmodel.Status) (string, bool) {
+	if !config.GetDetectStatusLanguage() || p.detector == nil {
+		return "", false
+	}
+
+	plain := strings.TrimSpace(
+		text.HTMLToPlaintext(status.ContentWarning) + " " +
+			text.HTMLToPlaintext(status.Content),
+	)
+	if plain == "" {
+		return "", false
+	}
+
+	lang, ok := p.detector.Detect(plain)
+	if !ok {
+		return "", false
+	}
+
+	return lang.TagStr, true
+}
+
+func processSensitive(form *apimodel.AdvancedStatusCreateForm, accountDefaultSensitive *bool, status *gtsmodel.Status) {
+	// If sensitive isn't set on the form, then
+	// take the account default, falling back to
+	// not sensitive if that's also not set.
+	switch {
+	case form.Sensitive != nil:
+		status.Sensitive = util.Ptr(*form.Sensitive)
+	case accountDefaultSensitive != nil:
+		status.Sensitive = util.Ptr(*accountDefaultSensitive)
+	default:
+		status.Sensitive = util.Ptr(false)
+	}
+}
+
 func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.ParseMentionFunc, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) error {
 	if form.ContentType == "" {
 		// If content type wasn't specified, use the author's preferred content-type.
@@ -412,6 +524,7 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 
 	// Collect formatted results.
 	status.Content = contentRes.HTML
+	status.WordCount = text.WordCount(status.Content)
 	status.Mentions = append(status.Mentions, contentRes.Mentions...)
 	status.Emojis = append(status.Emojis, contentRes.Emojis...)
 	status.Tags = append(status.Tags, contentRes.Tags...)
@@ -460,3 +573,21 @@ func gatherIDs[T any](in []T, getID func(T) string) []string {
 	}
 	return ids
 }
+
+// statusLength returns the number of characters in the
+// given form's status text and content warning combined,
+// as counted against the status character limit.
+func statusLength(form *apimodel.AdvancedStatusCreateForm) int {
+	return len([]rune(form.Status)) + len([]rune(form.SpoilerText))
+}
+
+// statusMaxChars returns the max number of characters
+// permitted in statuses created by the given account,
+// falling back to the instance default if the account
+// has no character limit override set.
+func statusMaxChars(account *gtsmodel.Account) int {
+	if account.CharacterLimitOverride > 0 {
+		return account.CharacterLimitOverride
+	}
+	return gtsmodel.NewInstanceConfiguration().StatusesMaxChars
+}
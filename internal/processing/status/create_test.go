@@ -19,13 +19,16 @@ package status_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type StatusCreateTestSuite struct {
@@ -44,7 +47,7 @@ func (suite *StatusCreateTestSuite) TestProcessContentWarningWithQuotationMarks(
 			MediaIDs:    []string{},
 			Poll:        nil,
 			InReplyToID: "",
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			SpoilerText: "\"test\"", // these should not be html-escaped when the final text is rendered
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
@@ -64,6 +67,7 @@ func (suite *StatusCreateTestSuite) TestProcessContentWarningWithQuotationMarks(
 	suite.NotNil(apiStatus)
 
 	suite.Equal("\"test\"", apiStatus.SpoilerText)
+	suite.Equal(2, apiStatus.WordCount)
 }
 
 func (suite *StatusCreateTestSuite) TestProcessContentWarningWithHTMLEscapedQuotationMarks() {
@@ -78,7 +82,7 @@ func (suite *StatusCreateTestSuite) TestProcessContentWarningWithHTMLEscapedQuot
 			MediaIDs:    []string{},
 			Poll:        nil,
 			InReplyToID: "",
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			SpoilerText: "&#34test&#34", // the html-escaped quotation marks should appear as normal quotation marks in the finished text
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
@@ -117,7 +121,7 @@ func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownWithUnderscoreEmoji
 			MediaIDs:    []string{},
 			Poll:        nil,
 			InReplyToID: "",
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
 			Language:    "en",
@@ -151,7 +155,7 @@ func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownWithSpoilerTextEmoj
 			MediaIDs:    []string{},
 			Poll:        nil,
 			InReplyToID: "",
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
 			Language:    "en",
@@ -188,7 +192,7 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 			MediaIDs:    []string{suite.testAttachments["local_account_1_unattached_1"].ID},
 			Poll:        nil,
 			InReplyToID: "",
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			SpoilerText: "",
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
@@ -208,6 +212,117 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	suite.Nil(apiStatus)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionRequired() {
+	ctx := context.Background()
+
+	config.SetMediaDescriptionRequired(true)
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Remove the description from the attachment.
+	attachment := new(gtsmodel.MediaAttachment)
+	*attachment = *suite.testAttachments["local_account_1_unattached_1"]
+	attachment.Description = ""
+	if err := suite.db.UpdateAttachment(ctx, attachment, "description"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "poopoo peepee",
+			MediaIDs:    []string{attachment.ID},
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.EqualError(err, "media 01F8MH8RMYQ6MSNY3JM2XT1CQ5 has no description, but descriptions are required on this instance")
+	suite.Equal(gtserror.TypeMediaDescriptionMissing, gtserror.Type(err))
+	suite.Nil(apiStatus)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooLong() {
+	ctx := context.Background()
+
+	config.SetMediaDescriptionMaxChars(5)
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "poopoo peepee",
+			MediaIDs:    []string{suite.testAttachments["local_account_1_unattached_1"].ID},
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.EqualError(err, "media 01F8MH8RMYQ6MSNY3JM2XT1CQ5 description too long, at most 5 permitted")
+	suite.Nil(apiStatus)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessStatusTooLong() {
+	ctx := context.Background()
+
+	config.SetStatusesMaxChars(10)
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "poopoo peepee",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.EqualError(err, "status too long, 13 characters provided (including spoiler/content warning) but limit is 10")
+	suite.Equal(http.StatusBadRequest, err.Code())
+	suite.Nil(apiStatus)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessStatusCharacterLimitOverride() {
+	ctx := context.Background()
+
+	config.SetStatusesMaxChars(10)
+
+	// Give a copy of the account
+	// a higher character limit.
+	creatingAccount := new(gtsmodel.Account)
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingAccount.CharacterLimitOverride = 20
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "poopoo peepee",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	// Override still applies
+	// when it's over the limit.
+	statusCreateForm.Status = "poopoo peepee poopoo peepee"
+	apiStatus, err = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.EqualError(err, "status too long, 27 characters provided (including spoiler/content warning) but limit is 20")
+	suite.Nil(apiStatus)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 	ctx := context.Background()
 
@@ -220,7 +335,7 @@ func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 			MediaIDs:    []string{},
 			Poll:        nil,
 			InReplyToID: "",
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			SpoilerText: "",
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
@@ -257,7 +372,7 @@ func (suite *StatusCreateTestSuite) TestProcessReplyToUnthreadedRemoteStatus() {
 			MediaIDs:    []string{},
 			Poll:        nil,
 			InReplyToID: inReplyTo.ID,
-			Sensitive:   false,
+			Sensitive:   util.Ptr(false),
 			SpoilerText: "this is a reply",
 			Visibility:  apimodel.VisibilityPublic,
 			ScheduledAt: "",
@@ -286,6 +401,124 @@ func (suite *StatusCreateTestSuite) TestProcessReplyToUnthreadedRemoteStatus() {
 	suite.NotEmpty(dbStatus.ThreadID)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessAccountDefaults() {
+	ctx := context.Background()
+
+	// Copy the account + settings so
+	// we don't modify the test models.
+	creatingAccount := new(gtsmodel.Account)
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingAccount.Settings = new(gtsmodel.AccountSettings)
+	*creatingAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Set some non-standard defaults on the account.
+	creatingAccount.Settings.Privacy = gtsmodel.VisibilityUnlocked
+	creatingAccount.Settings.Sensitive = util.Ptr(true)
+	creatingAccount.Settings.Language = "fr"
+	creatingAccount.Settings.StatusContentType = string(apimodel.StatusContentTypeMarkdown)
+
+	// Leave everything
+	// out of the form.
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status: "**bonjour**",
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	// Account defaults should be used.
+	suite.Equal(apimodel.VisibilityUnlisted, apiStatus.Visibility)
+	suite.True(apiStatus.Sensitive)
+	suite.Equal("fr", *apiStatus.Language)
+	suite.Equal("<p><strong>bonjour</strong></p>", apiStatus.Content)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessAccountDefaultsOverridden() {
+	ctx := context.Background()
+
+	// Copy the account + settings so
+	// we don't modify the test models.
+	creatingAccount := new(gtsmodel.Account)
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingAccount.Settings = new(gtsmodel.AccountSettings)
+	*creatingAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Set some non-standard defaults on the account.
+	creatingAccount.Settings.Privacy = gtsmodel.VisibilityUnlocked
+	creatingAccount.Settings.Sensitive = util.Ptr(true)
+	creatingAccount.Settings.Language = "fr"
+	creatingAccount.Settings.StatusContentType = string(apimodel.StatusContentTypeMarkdown)
+
+	// Explicitly set everything
+	// in the form, which should
+	// take precedence.
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "**hello**",
+			Sensitive:   util.Ptr(false),
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	// Form values should be used.
+	suite.Equal(apimodel.VisibilityPublic, apiStatus.Visibility)
+	suite.False(apiStatus.Sensitive)
+	suite.Equal("en", *apiStatus.Language)
+	suite.Equal("<p>**hello**</p>", apiStatus.Content)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessDetectLanguage() {
+	ctx := context.Background()
+	config.SetDetectStatusLanguage(true)
+
+	// Copy the account + settings so
+	// we don't modify the test models.
+	creatingAccount := new(gtsmodel.Account)
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingAccount.Settings = new(gtsmodel.AccountSettings)
+	*creatingAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	creatingAccount.Settings.Language = "en"
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Leave language out of
+	// the form, it should be
+	// detected from content.
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status: "Dies ist ein ganz normaler Satz auf Deutsch, der hoffentlich erkannt wird.",
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+	suite.Equal("de", *apiStatus.Language)
+
+	// Undetectable content should
+	// fall back to account default.
+	statusCreateForm = &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status: "ok",
+		},
+	}
+
+	apiStatus, err = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+	suite.Equal("en", *apiStatus.Language)
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
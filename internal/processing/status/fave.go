@@ -28,8 +28,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -148,8 +148,14 @@ func (p *Processor) FaveRemove(ctx context.Context, requestingAccount *gtsmodel.
 	return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 }
 
-// FavedBy returns a slice of accounts that have liked the given status, filtered according to privacy settings.
-func (p *Processor) FavedBy(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) ([]*apimodel.Account, gtserror.WithCode) {
+// FavedBy returns a page of accounts that have liked the given status, newest
+// fave first, paged by fave ID and filtered according to privacy settings.
+func (p *Processor) FavedBy(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
 	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
 		requestingAccount,
 		targetStatusID,
@@ -159,36 +165,43 @@ func (p *Processor) FavedBy(ctx context.Context, requestingAccount *gtsmodel.Acc
 		return nil, errWithCode
 	}
 
-	statusFaves, err := p.state.DB.GetStatusFaves(ctx, targetStatus.ID)
-	if err != nil {
-		return nil, gtserror.NewErrorNotFound(fmt.Errorf("FavedBy: error seeing who faved status: %s", err))
+	// Get note to serve the list with, in case
+	// status author hides interaction counts.
+	note, errWithCode := p.interactionsNote(ctx, requestingAccount, targetStatus)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
-	// For each fave, ensure that we're only showing
-	// the requester accounts that they don't block,
-	// and which don't block them.
-	apiAccounts := make([]*apimodel.Account, 0, len(statusFaves))
-	for _, fave := range statusFaves {
-		if blocked, err := p.state.DB.IsEitherBlocked(ctx, requestingAccount.ID, fave.AccountID); err != nil {
-			err = fmt.Errorf("FavedBy: error checking blocks: %w", err)
-			return nil, gtserror.NewErrorInternalError(err)
-		} else if blocked {
-			continue
-		}
+	faves, err := p.state.DB.GetStatusFaves(ctx, targetStatus.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting faves of status %s: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
 
-		if fave.Account == nil {
-			// Account isn't set for some reason, just skip.
-			log.WithContext(ctx).WithField("fave", fave).Warn("fave had no associated account")
-			continue
-		}
+	// Check for empty response.
+	count := len(faves)
+	if count == 0 {
+		resp := paging.EmptyResponse()
+		resp.Note = note
+		return resp, nil
+	}
 
-		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, fave.Account)
-		if err != nil {
-			err = fmt.Errorf("FavedBy: error converting account %s to frontend representation: %w", fave.AccountID, err)
-			return nil, gtserror.NewErrorInternalError(err)
-		}
-		apiAccounts = append(apiAccounts, apiAccount)
+	// Func to fetch fave author at index.
+	getIdx := func(i int) *gtsmodel.Account {
+		return faves[i].Account
 	}
 
-	return apiAccounts, nil
+	// Get a filtered slice of public API account models,
+	// excluding eg., accounts blocking / blocked by requester.
+	items := p.c.GetVisibleAPIAccountsPaged(ctx,
+		requestingAccount,
+		getIdx,
+		count,
+	)
+
+	return &apimodel.PageableResponse{
+		Items:      items,
+		LinkHeader: pageLinks("/api/v1/statuses/"+targetStatusID+"/favourited_by", faves, page),
+		Note:       note,
+	}, nil
 }
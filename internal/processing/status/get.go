@@ -23,11 +23,14 @@ import (
 	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -58,6 +61,7 @@ func (p *Processor) HistoryGet(ctx context.Context, requestingAccount *gtsmodel.
 			Poll:             apiStatus.Poll,
 			MediaAttachments: apiStatus.MediaAttachments,
 			Emojis:           apiStatus.Emojis,
+			MediaEdited:      !targetStatus.MediaEditedAt.IsZero(),
 		},
 	}, nil
 }
@@ -106,6 +110,20 @@ func (p *Processor) SourceGet(ctx context.Context, requestingAccount *gtsmodel.A
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	if targetStatus.Text == "" && targetStatus.Content != "" {
+		// No source text was stored for this status (e.g. it
+		// predates storing source text, or was migrated in), so
+		// reconstruct it from content, storing for next time.
+		targetStatus.Text = text.HTMLToSource(
+			targetStatus.Content,
+			targetStatus.Mentions,
+		)
+
+		if err := p.state.DB.UpdateStatus(ctx, targetStatus, "text"); err != nil {
+			log.Errorf(ctx, "error storing reconstructed source text: %v", err)
+		}
+	}
+
 	statusSource, err := p.converter.StatusToAPIStatusSource(ctx, targetStatus)
 	if err != nil {
 		err = gtserror.Newf("error converting status: %w", err)
@@ -137,6 +155,8 @@ func (p *Processor) contextGet(
 	ctx context.Context,
 	requestingAccount *gtsmodel.Account,
 	targetStatusID string,
+	maxAncestors int,
+	maxDescendants int,
 	convert func(context.Context, *gtsmodel.Status, *gtsmodel.Account) (*apimodel.Status, error),
 ) (*apimodel.Context, gtserror.WithCode) {
 	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
@@ -148,7 +168,7 @@ func (p *Processor) contextGet(
 		return nil, errWithCode
 	}
 
-	parents, err := p.state.DB.GetStatusParents(ctx, targetStatus)
+	parents, ancestorsTruncated, err := p.state.DB.GetStatusParents(ctx, targetStatus, maxAncestors)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -167,7 +187,7 @@ func (p *Processor) contextGet(
 		return strings.Compare(lhs.ID, rhs.ID)
 	})
 
-	children, err := p.state.DB.GetStatusChildren(ctx, targetStatus.ID)
+	children, descendantsTruncated, err := p.state.DB.GetStatusChildren(ctx, targetStatus.ID, maxDescendants)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -187,6 +207,7 @@ func (p *Processor) contextGet(
 	context := &apimodel.Context{
 		Ancestors:   make([]apimodel.Status, 0, len(ancestors)),
 		Descendants: make([]apimodel.Status, 0, len(descendants)),
+		Truncated:   ancestorsTruncated || descendantsTruncated,
 	}
 	for _, ancestor := range ancestors {
 		context.Ancestors = append(context.Ancestors, *ancestor)
@@ -281,8 +302,15 @@ func TopoSort(apiStatuses []*apimodel.Status, targetAccountID string) {
 	}
 }
 
-// ContextGet returns the context (previous and following posts) from the given status ID.
-func (p *Processor) ContextGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Context, gtserror.WithCode) {
+// ContextGet returns the context (previous and following posts) from the given status ID,
+// including at most maxAncestors ancestors, and descendants at most maxDescendants replies deep.
+func (p *Processor) ContextGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+	maxAncestors int,
+	maxDescendants int,
+) (*apimodel.Context, gtserror.WithCode) {
 	filters, err := p.state.DB.GetFiltersForAccountID(ctx, requestingAccount.ID)
 	if err != nil {
 		err = gtserror.Newf("couldn't retrieve filters for account %s: %w", requestingAccount.ID, err)
@@ -299,7 +327,7 @@ func (p *Processor) ContextGet(ctx context.Context, requestingAccount *gtsmodel.
 	convert := func(ctx context.Context, status *gtsmodel.Status, requestingAccount *gtsmodel.Account) (*apimodel.Status, error) {
 		return p.converter.StatusToAPIStatus(ctx, status, requestingAccount, statusfilter.FilterContextThread, filters, compiledMutes)
 	}
-	return p.contextGet(ctx, requestingAccount, targetStatusID, convert)
+	return p.contextGet(ctx, requestingAccount, targetStatusID, maxAncestors, maxDescendants, convert)
 }
 
 // WebContextGet is like ContextGet, but is explicitly
@@ -307,5 +335,9 @@ func (p *Processor) ContextGet(ctx context.Context, requestingAccount *gtsmodel.
 //
 // TODO: a more advanced threading model could be implemented here.
 func (p *Processor) WebContextGet(ctx context.Context, targetStatusID string) (*apimodel.Context, gtserror.WithCode) {
-	return p.contextGet(ctx, nil, targetStatusID, p.converter.StatusToWebStatus)
+	return p.contextGet(ctx, nil, targetStatusID,
+		config.GetStatusContextMaxDepthAncestors(),
+		config.GetStatusContextMaxDepthDescendants(),
+		p.converter.StatusToWebStatus,
+	)
 }
@@ -0,0 +1,126 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"slices"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Preview processes the given form as if creating a new status, returning
+// the rendered content, resolved mentions, tags and emojis, and character
+// count of the status, but without storing anything or queueing any side
+// effects. Mentions that can't be resolved to an account are reported.
+//
+// Unlike Create, a status over the requester's character limit is not an
+// error here, callers can compare character count and limit themselves.
+//
+// Precondition: the form's fields should have already been validated and normalized by the caller.
+func (p *Processor) Preview(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.AdvancedStatusCreateForm,
+) (
+	*apimodel.StatusPreview,
+	gtserror.WithCode,
+) {
+	// Ensure account populated; we'll need settings.
+	if err := p.state.DB.PopulateAccount(ctx, requester); err != nil {
+		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
+	}
+
+	// Wrap mention parsing to
+	// collect unresolvable mentions.
+	var unresolved []string
+	parseMention := func(ctx context.Context, namestring string, originAccountID string, statusID string) (*gtsmodel.Mention, error) {
+		mention, err := p.parseMention(ctx, namestring, originAccountID, statusID)
+		if err != nil && !slices.Contains(unresolved, namestring) {
+			unresolved = append(unresolved, namestring)
+		}
+		return mention, err
+	}
+
+	// Parse + format the form into a new status,
+	// as a dry run so nothing is stored in the db.
+	status, errWithCode := p.prepare(
+		gtscontext.SetDryRun(ctx),
+		requester,
+		form,
+		parseMention,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	preview := &apimodel.StatusPreview{
+		Content:            status.Content,
+		SpoilerText:        status.ContentWarning,
+		Mentions:           make([]apimodel.Mention, 0, len(status.Mentions)),
+		Tags:               make([]apimodel.Tag, 0, len(status.Tags)),
+		Emojis:             make([]apimodel.Emoji, 0, len(status.Emojis)),
+		UnresolvedMentions: make([]string, 0, len(unresolved)),
+		CharacterCount:     statusLength(form),
+		CharacterLimit:     statusMaxChars(requester),
+	}
+
+	for _, mention := range status.Mentions {
+		apiMention, err := p.converter.MentionToAPIMention(ctx, mention)
+		if err != nil {
+			err := gtserror.Newf("error converting mention to api model: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		preview.Mentions = append(preview.Mentions, apiMention)
+	}
+
+	for _, tag := range status.Tags {
+		apiTag, err := p.converter.TagToAPITag(ctx, tag, false)
+		if err != nil {
+			err := gtserror.Newf("error converting tag to api model: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		preview.Tags = append(preview.Tags, apiTag)
+	}
+
+	// Emojis may be used in both the
+	// content and content warning, so
+	// only include each one once.
+	emojiIDs := make([]string, 0, len(status.Emojis))
+	for _, emoji := range status.Emojis {
+		if slices.Contains(emojiIDs, emoji.ID) {
+			continue
+		}
+		emojiIDs = append(emojiIDs, emoji.ID)
+
+		apiEmoji, err := p.converter.EmojiToAPIEmoji(ctx, emoji)
+		if err != nil {
+			err := gtserror.Newf("error converting emoji to api model: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		preview.Emojis = append(preview.Emojis, apiEmoji)
+	}
+
+	preview.UnresolvedMentions = append(preview.UnresolvedMentions, unresolved...)
+
+	return preview, nil
+}
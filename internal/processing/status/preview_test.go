@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type StatusPreviewTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusPreviewTestSuite) TestPreview() {
+	ctx := context.Background()
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "hey @1happyturtle and @nobody, look at #BrandNewTag :rainbow:",
+			SpoilerText: "preview :rainbow:",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	preview, errWithCode := suite.status.Preview(ctx, suite.testAccounts["local_account_1"], form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal(`<p>hey <span class="h-card"><a href="http://localhost:8080/@1happyturtle" class="u-url mention" rel="nofollow noreferrer noopener" target="_blank">@<span>1happyturtle</span></a></span> and @nobody, look at <a href="http://localhost:8080/tags/brandnewtag" class="mention hashtag" rel="tag nofollow noreferrer noopener" target="_blank">#<span>BrandNewTag</span></a> :rainbow:</p>`, preview.Content)
+	suite.Equal("preview :rainbow:", preview.SpoilerText)
+
+	if suite.Len(preview.Mentions, 1) {
+		suite.Equal("1happyturtle", preview.Mentions[0].Acct)
+	}
+	suite.Equal([]string{"@nobody"}, preview.UnresolvedMentions)
+
+	if suite.Len(preview.Tags, 1) {
+		suite.Equal("BrandNewTag", preview.Tags[0].Name)
+		suite.Equal("http://localhost:8080/tags/brandnewtag", preview.Tags[0].URL)
+	}
+
+	// Emoji used in content and
+	// content warning only once.
+	if suite.Len(preview.Emojis, 1) {
+		suite.Equal("rainbow", preview.Emojis[0].Shortcode)
+	}
+
+	suite.Equal(78, preview.CharacterCount)
+	suite.Equal(5000, preview.CharacterLimit)
+
+	// New tag shouldn't have been stored.
+	_, err := suite.db.GetTagByName(ctx, "brandnewtag")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusPreviewTestSuite) TestPreviewTooLong() {
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "poopoo peepee",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	// Set a character limit lower
+	// than the length of the status.
+	account := suite.testAccounts["local_account_1"]
+	account.CharacterLimitOverride = 10
+
+	// Preview should still render it,
+	// but report the count and limit.
+	preview, errWithCode := suite.status.Preview(context.Background(), account, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("<p>poopoo peepee</p>", preview.Content)
+	suite.Equal(13, preview.CharacterCount)
+	suite.Equal(10, preview.CharacterLimit)
+	suite.Empty(preview.UnresolvedMentions)
+}
+
+func TestStatusPreviewTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusPreviewTestSuite))
+}
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusSourceTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusSourceTestSuite) TestSourceGetStored() {
+	ctx := context.Background()
+	requester := suite.testAccounts["local_account_1"]
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	source, errWithCode := suite.status.SourceGet(ctx, requester, status.ID)
+	suite.NoError(errWithCode)
+	suite.True(strings.HasSuffix(source.Text, status.Text))
+}
+
+func (suite *StatusSourceTestSuite) TestSourceGetReconstructed() {
+	ctx := context.Background()
+	requester := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["admin_account_status_3"]
+
+	// Set status content as our formatter would
+	// render it, with no source text stored.
+	status.Content = `<p>hi <span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention" rel="nofollow noreferrer noopener" target="_blank">@<span>the_mighty_zork</span></a></span> welcome to the instance!</p>`
+	status.Text = ""
+	if err := suite.db.UpdateStatus(ctx, status, "content", "text"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	source, errWithCode := suite.status.SourceGet(ctx, requester, status.ID)
+	suite.NoError(errWithCode)
+	suite.True(strings.HasSuffix(source.Text, "\n\nhi @the_mighty_zork welcome to the instance!"), source.Text)
+
+	// Reconstructed source
+	// should now be stored.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("hi @the_mighty_zork welcome to the instance!", dbStatus.Text)
+}
+
+func TestStatusSourceTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusSourceTestSuite))
+}
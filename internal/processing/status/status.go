@@ -18,9 +18,17 @@
 package status
 
 import (
+	"context"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/language"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
@@ -39,6 +47,11 @@ type Processor struct {
 	formatter    *text.Formatter
 	parseMention gtsmodel.ParseMentionFunc
 
+	// detector detects the language of statuses
+	// posted without one, if enabled in config.
+	// May be nil if configured languages are bad.
+	detector *language.Detector
+
 	// other processors
 	polls *polls.Processor
 }
@@ -53,6 +66,14 @@ func New(
 	filter *visibility.Filter,
 	parseMention gtsmodel.ParseMentionFunc,
 ) Processor {
+	// Languages are validated with config, and
+	// the detector only indexes small built-in
+	// profiles, so this is cheap even if disabled.
+	detector, err := language.NewDetector(config.GetDetectStatusLanguages())
+	if err != nil {
+		log.Errorf(nil, "error setting up status language detection: %v", err)
+	}
+
 	return Processor{
 		c:            common,
 		state:        state,
@@ -61,6 +82,54 @@ func New(
 		filter:       filter,
 		formatter:    text.NewFormatter(state.DB),
 		parseMention: parseMention,
+		detector:     detector,
 		polls:        polls,
 	}
 }
+
+// pageLinks returns a Link header for the given page of
+// (descending) items, served at path on this instance.
+func pageLinks[T typeutils.PageItemID](path string, items []T, page *paging.Page) string {
+	pageItems := make([]typeutils.PageItemID, len(items))
+	for i, item := range items {
+		pageItems[i] = item
+	}
+
+	return typeutils.BuildPageLinks(
+		&url.URL{
+			Scheme: config.GetProtocol(),
+			Host:   config.GetHost(),
+			Path:   path,
+		},
+		pageItems,
+		page.GetLimit(),
+		nil,
+	)
+}
+
+// interactionsNote returns a note to return with the faved by
+// / boosted by lists of status, if its author has opted to hide
+// interaction counts from the requester, else an empty string.
+// The lists are still served, as the setting is to reduce the
+// pressure of engagement numbers, not to hide who interacted.
+func (p *Processor) interactionsNote(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+) (string, gtserror.WithCode) {
+	// Status authors are only populated
+	// barebones, so ensure settings are set.
+	if status.Account.IsLocal() && status.Account.Settings == nil {
+		var err error
+		status.Account.Settings, err = p.state.DB.GetAccountSettings(ctx, status.Account.ID)
+		if err != nil {
+			err := gtserror.Newf("db error getting settings of account %s: %w", status.Account.ID, err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !status.Account.HidesInteractionCountsFrom(requester) {
+		return "", nil
+	}
+	return "status author has hidden interaction counts of this status", nil
+}
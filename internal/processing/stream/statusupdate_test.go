@@ -71,6 +71,7 @@ func (suite *StatusUpdateTestSuite) TestStreamNotification() {
   "bookmarked": false,
   "pinned": false,
   "content": "dark souls status bot: \"thoughts of dog\"",
+  "word_count": 0,
   "reblog": null,
   "account": {
     "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package suggestions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Dismiss stops the account with the given ID from being
+// suggested to the requesting account in future. Dismissing
+// an already dismissed suggestion is a no-op.
+func (p *Processor) Dismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountID string,
+) gtserror.WithCode {
+	target, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if target == nil {
+		err := fmt.Errorf("account %s not found", targetAccountID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.PutFollowSuggestionDismissal(ctx, &gtsmodel.FollowSuggestionDismissal{
+		ID:              id.NewULID(),
+		AccountID:       requester.ID,
+		TargetAccountID: target.ID,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		err := gtserror.Newf("db error storing dismissal of %s: %w", targetAccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package suggestions
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// candidatesLimit is the max number of candidates
+// selected from each source of suggestions. This
+// is larger than the max API limit, to leave room
+// for filtering out candidates at request time.
+const candidatesLimit = 200
+
+// Get returns up to limit follow suggestions for the
+// requesting account, drawn from accounts picked by
+// admins, accounts followed by accounts the requester
+// follows, and the most followed local accounts.
+//
+// Candidates are cached per account for a few hours,
+// and are filtered against the requester's current
+// follows, blocks, mutes and dismissals on every call.
+func (p *Processor) Get(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+) ([]*apimodel.Suggestion, gtserror.WithCode) {
+	candidates, err := p.candidates(ctx, requester)
+	if err != nil {
+		err := gtserror.Newf("error getting suggestion candidates: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	dismissedIDs, err := p.state.DB.GetFollowSuggestionDismissedIDs(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting dismissed suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	dismissed := make(map[string]struct{}, len(dismissedIDs))
+	for _, id := range dismissedIDs {
+		dismissed[id] = struct{}{}
+	}
+
+	suggestions := make([]*apimodel.Suggestion, 0, limit)
+	for i := range candidates {
+		if len(suggestions) >= limit {
+			break
+		}
+
+		suggestion := &candidates[i]
+		if _, ok := dismissed[suggestion.TargetAccountID]; ok {
+			continue
+		}
+
+		ok, err := p.suggestable(ctx, requester, suggestion)
+		if err != nil {
+			err := gtserror.Newf("error checking suggestion %s: %w", suggestion.TargetAccountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, suggestion.TargetAccount)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", suggestion.TargetAccountID, err)
+			continue
+		}
+
+		apiSuggestion, err := p.converter.SuggestionToAPISuggestion(suggestion, apiAccount)
+		if err != nil {
+			log.Errorf(ctx, "error converting suggestion %s: %v", suggestion.TargetAccountID, err)
+			continue
+		}
+
+		suggestions = append(suggestions, apiSuggestion)
+	}
+
+	return suggestions, nil
+}
+
+// candidates returns the cached suggestion candidates for
+// the given account, computing and caching them if needed.
+func (p *Processor) candidates(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]gtsmodel.FollowSuggestion, error) {
+	if candidates, ok := p.state.Caches.FollowSuggestions.Get(requester.ID); ok {
+		return candidates, nil
+	}
+
+	var (
+		candidates []gtsmodel.FollowSuggestion
+		seen       = make(map[string]struct{})
+	)
+
+	// appendIDs appends a candidate for each of the given
+	// account IDs not already seen, so that the earliest
+	// source an account is suggested from takes priority.
+	appendIDs := func(ids []string, source gtsmodel.FollowSuggestionSource) {
+		for _, id := range ids {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			candidates = append(candidates, gtsmodel.FollowSuggestion{
+				AccountID:       requester.ID,
+				TargetAccountID: id,
+				Source:          source,
+			})
+		}
+	}
+
+	// Admin picks come first.
+	featured, err := p.state.DB.GetFeaturedSuggestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting featured suggestions: %w", err)
+	}
+
+	featuredIDs := make([]string, len(featured))
+	for i, f := range featured {
+		featuredIDs[i] = f.AccountID
+	}
+	appendIDs(featuredIDs, gtsmodel.FollowSuggestionSourceFeatured)
+
+	// Then accounts followed by accounts the requester follows.
+	friendsOfFriendsIDs, err := p.state.DB.GetFriendsOfFriendsIDs(ctx, requester.ID, candidatesLimit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting friends of friends: %w", err)
+	}
+	appendIDs(friendsOfFriendsIDs, gtsmodel.FollowSuggestionSourceFriendsOfFriends)
+
+	// Then the most followed local accounts.
+	mostFollowedIDs, err := p.state.DB.GetMostFollowedLocalAccountIDs(ctx, candidatesLimit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting most followed accounts: %w", err)
+	}
+	appendIDs(mostFollowedIDs, gtsmodel.FollowSuggestionSourceMostFollowed)
+
+	p.state.Caches.FollowSuggestions.Set(requester.ID, candidates)
+	return candidates, nil
+}
+
+// suggestable returns whether the given suggestion may currently be
+// shown to the requester, populating the suggestion's target account.
+func (p *Processor) suggestable(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	suggestion *gtsmodel.FollowSuggestion,
+) (bool, error) {
+	if suggestion.TargetAccountID == requester.ID {
+		return false, nil
+	}
+
+	target, err := p.state.DB.GetAccountByID(ctx, suggestion.TargetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Account has been
+			// deleted since caching.
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Don't suggest undiscoverable, limited,
+	// suspended, or moved accounts.
+	if !util.PtrValueOr(target.Discoverable, false) ||
+		!target.SilencedAt.IsZero() ||
+		target.IsSuspended() ||
+		target.IsMoving() {
+		return false, nil
+	}
+
+	following, err := p.state.DB.IsFollowing(ctx, requester.ID, target.ID)
+	if err != nil || following {
+		return false, err
+	}
+
+	requested, err := p.state.DB.IsFollowRequested(ctx, requester.ID, target.ID)
+	if err != nil || requested {
+		return false, err
+	}
+
+	blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, target.ID)
+	if err != nil || blocked {
+		return false, err
+	}
+
+	muted, err := p.state.DB.IsMuted(ctx, requester.ID, target.ID)
+	if err != nil || muted {
+		return false, err
+	}
+
+	suggestion.TargetAccount = target
+	return true, nil
+}
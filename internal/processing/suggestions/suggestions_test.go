@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/suggestions"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SuggestionsTestSuite struct {
+	suite.Suite
+	state       state.State
+	suggestions suggestions.Processor
+
+	testAccounts map[string]*gtsmodel.Account
+}
+
+func (suite *SuggestionsTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+	testrig.NewTestDB(&suite.state)
+	testrig.StandardDBSetup(suite.state.DB, nil)
+	converter := typeutils.NewConverter(&suite.state)
+	suite.suggestions = suggestions.New(&suite.state, converter)
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *SuggestionsTestSuite) TearDownTest() {
+	testrig.StopWorkers(&suite.state)
+	testrig.StandardDBTeardown(suite.state.DB)
+}
+
+func (suite *SuggestionsTestSuite) TestGet() {
+	ctx := context.Background()
+	requester := suite.testAccounts["local_account_2"]
+
+	// admin_account is followed by local_account_1,
+	// whom local_account_2 follows. local_account_1
+	// is the most followed account, but it's already
+	// followed by local_account_2 so isn't suggested.
+	suggestions, errWithCode := suite.suggestions.Get(ctx, requester, 40)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(suggestions, 1)
+	suite.Equal(suite.testAccounts["admin_account"].ID, suggestions[0].Account.ID)
+	suite.Equal("past_interactions", suggestions[0].Source)
+	suite.Equal([]string{"friends_of_friends"}, suggestions[0].Sources)
+}
+
+func (suite *SuggestionsTestSuite) TestGetFeatured() {
+	ctx := context.Background()
+	requester := suite.testAccounts["local_account_2"]
+	admin := suite.testAccounts["admin_account"]
+
+	if err := suite.state.DB.PutFeaturedSuggestion(ctx, &gtsmodel.FeaturedSuggestion{
+		ID:                 id.NewULID(),
+		AccountID:          admin.ID,
+		CreatedByAccountID: admin.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Admin picks take priority over
+	// other sources of suggestions.
+	suggestions, errWithCode := suite.suggestions.Get(ctx, requester, 40)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(suggestions, 1)
+	suite.Equal(admin.ID, suggestions[0].Account.ID)
+	suite.Equal("staff", suggestions[0].Source)
+	suite.Equal([]string{"featured"}, suggestions[0].Sources)
+}
+
+func (suite *SuggestionsTestSuite) TestGetNothingToSuggest() {
+	ctx := context.Background()
+
+	// admin_account already follows local_account_1,
+	// and local_account_2 isn't discoverable.
+	suggestions, errWithCode := suite.suggestions.Get(ctx, suite.testAccounts["admin_account"], 40)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Empty(suggestions)
+}
+
+func (suite *SuggestionsTestSuite) TestDismiss() {
+	ctx := context.Background()
+	requester := suite.testAccounts["local_account_2"]
+
+	// Populate the cache of candidates.
+	suggestions, errWithCode := suite.suggestions.Get(ctx, requester, 40)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(suggestions, 1)
+
+	// Dismiss the one suggestion, twice.
+	for i := 0; i < 2; i++ {
+		errWithCode = suite.suggestions.Dismiss(ctx, requester, suggestions[0].Account.ID)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+	}
+
+	// Dismissed account shouldn't be
+	// suggested, despite being cached.
+	suggestions, errWithCode = suite.suggestions.Get(ctx, requester, 40)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(suggestions)
+}
+
+func (suite *SuggestionsTestSuite) TestDismissNotFound() {
+	errWithCode := suite.suggestions.Dismiss(
+		context.Background(),
+		suite.testAccounts["local_account_2"],
+		"01HZ4M3G6S0PTJXEN6Q44MZ9KA",
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestSuggestionsTestSuite(t *testing.T) {
+	suite.Run(t, new(SuggestionsTestSuite))
+}
@@ -43,6 +43,7 @@ func (p *Processor) NotificationsGet(
 	limit int,
 	types []string,
 	excludeTypes []string,
+	includeDismissed bool,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
 	notifs, err := p.state.DB.GetAccountNotifications(
 		ctx,
@@ -53,6 +54,7 @@ func (p *Processor) NotificationsGet(
 		limit,
 		types,
 		excludeTypes,
+		includeDismissed,
 	)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err = fmt.Errorf("NotificationsGet: db error getting notifications: %w", err)
@@ -166,15 +168,127 @@ func (p *Processor) NotificationGet(ctx context.Context, account *gtsmodel.Accou
 	return apiNotif, nil
 }
 
+// NotificationsUnreadCount returns the number of undismissed notifications
+// newer than the authorized account's notifications marker, up to limit.
+func (p *Processor) NotificationsUnreadCount(
+	ctx context.Context,
+	authed *oauth.Auth,
+	limit int,
+	types []string,
+	excludeTypes []string,
+) (*apimodel.NotificationsUnreadCount, gtserror.WithCode) {
+	// Count from the last read notification,
+	// or from the start if there's no marker.
+	var sinceID string
+	marker, err := p.state.DB.GetMarker(ctx, authed.Account.ID, gtsmodel.MarkerNameNotifications)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notifications marker: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if marker != nil {
+		sinceID = marker.LastReadID
+	}
+
+	count, err := p.state.DB.CountAccountNotificationsSince(
+		ctx,
+		authed.Account.ID,
+		sinceID,
+		limit,
+		types,
+		excludeTypes,
+	)
+	if err != nil {
+		err = gtserror.Newf("db error counting notifications: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.NotificationsUnreadCount{Count: count}, nil
+}
+
+// NotificationDismiss dismisses one notification
+// belonging to the authorized account.
+func (p *Processor) NotificationDismiss(ctx context.Context, authed *oauth.Auth, targetNotifID string) gtserror.WithCode {
+	notif, errWithCode := p.getOwnNotification(ctx, authed.Account, targetNotifID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if util.PtrValueOr(notif.Dismissed, false) {
+		// Nothing to do.
+		return nil
+	}
+
+	notif.Dismissed = util.Ptr(true)
+	if err := p.state.DB.UpdateNotification(ctx, notif, "dismissed"); err != nil {
+		err = gtserror.Newf("db error dismissing notification: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// NotificationsDismissAllBefore dismisses the given notification, and
+// all older notifications belonging to the authorized account.
+func (p *Processor) NotificationsDismissAllBefore(ctx context.Context, authed *oauth.Auth, targetNotifID string) gtserror.WithCode {
+	notif, errWithCode := p.getOwnNotification(ctx, authed.Account, targetNotifID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DismissNotifications(ctx, authed.Account.ID, notif.ID); err != nil {
+		err = gtserror.Newf("db error dismissing notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Let the account's other clients know.
+	p.stream.NotificationsCleared(ctx, authed.Account, notif.ID)
+
+	return nil
+}
+
+// NotificationsClear dismisses all notifications
+// belonging to the authorized account.
 func (p *Processor) NotificationsClear(ctx context.Context, authed *oauth.Auth) gtserror.WithCode {
-	// Delete all notifications of all types that target the authorized account.
-	if err := p.state.DB.DeleteNotifications(ctx, nil, authed.Account.ID, ""); err != nil && !errors.Is(err, db.ErrNoEntries) {
+	// Dismiss all notifications of all types that target the authorized account.
+	if err := p.state.DB.DismissNotifications(ctx, authed.Account.ID, ""); err != nil {
+		err = gtserror.Newf("db error dismissing notifications: %w", err)
 		return gtserror.NewErrorInternalError(err)
 	}
 
+	// Let the account's other clients know.
+	p.stream.NotificationsCleared(ctx, authed.Account, "")
+
 	return nil
 }
 
+// getOwnNotification gets the barebones notification with
+// the given ID, returning not found if it doesn't exist or
+// doesn't belong to the given account.
+func (p *Processor) getOwnNotification(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	targetNotifID string,
+) (*gtsmodel.Notification, gtserror.WithCode) {
+	notif, err := p.state.DB.GetNotificationByID(gtscontext.SetBarebones(ctx), targetNotifID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		// Real error.
+		err = gtserror.Newf("db error getting notification: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if notif.TargetAccountID != account.ID {
+		err = gtserror.Newf("notification %s does not belong to account %s", notif.ID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return notif, nil
+}
+
 func (p *Processor) notifVisible(
 	ctx context.Context,
 	n *gtsmodel.Notification,
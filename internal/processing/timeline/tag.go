@@ -21,6 +21,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -34,36 +36,135 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// TagTimelineMaxExtraTags is the maximum number of
+// additional tags that may be given to TagTimelineGet
+// across anyTags, allTags and noneTags, to keep the
+// cost of the resulting db query reasonable.
+const TagTimelineMaxExtraTags = 4
+
 // TagTimelineGet gets a pageable timeline for the given
 // tagName and given paging parameters. It will ensure
 // that each status in the timeline is actually visible
 // to requestingAcct before returning it.
+//
+// Statuses may additionally be required to use any of
+// anyTags (along with tagName), all of allTags, and none
+// of noneTags, as per the Mastodon tag timeline API.
 func (p *Processor) TagTimelineGet(
 	ctx context.Context,
 	requestingAcct *gtsmodel.Account,
 	tagName string,
+	anyTags []string,
+	allTags []string,
+	noneTags []string,
 	maxID string,
 	sinceID string,
 	minID string,
 	limit int,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
+	if extra := len(anyTags) + len(allTags) + len(noneTags); extra > TagTimelineMaxExtraTags {
+		const text = "too many additional tags provided"
+		err := fmt.Errorf("%s: %d > %d", text, extra, TagTimelineMaxExtraTags)
+		return nil, gtserror.NewErrorBadRequest(err, text)
+	}
+
 	tag, errWithCode := p.getTag(ctx, tagName)
 	if errWithCode != nil {
 		return nil, errWithCode
 	}
 
-	if tag == nil || !*tag.Useable || !*tag.Listable {
+	if !tagTimelineable(tag) {
 		// Obey mastodon API by returning 404 for this.
 		err := fmt.Errorf("tag was not found, or not useable/listable on this instance")
 		return nil, gtserror.NewErrorNotFound(err, err.Error())
 	}
 
-	statuses, err := p.state.DB.GetTagTimeline(ctx, tag.ID, maxID, sinceID, minID, limit)
+	// Statuses may use the path
+	// tag, or any of the any tags.
+	anyTagIDs := []string{tag.ID}
+	for _, name := range anyTags {
+		tag, errWithCode := p.getTag(ctx, name)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if !tagTimelineable(tag) {
+			// Can't match statuses
+			// we'd show, just skip.
+			continue
+		}
+
+		if !slices.Contains(anyTagIDs, tag.ID) {
+			anyTagIDs = append(anyTagIDs, tag.ID)
+		}
+	}
+
+	allTagIDs := make([]string, 0, len(allTags))
+	for _, name := range allTags {
+		tag, errWithCode := p.getTag(ctx, name)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if !tagTimelineable(tag) {
+			// No statuses we'd show
+			// can use this tag, so
+			// none can match them all.
+			return util.EmptyPageableResponse(), nil
+		}
+
+		allTagIDs = append(allTagIDs, tag.ID)
+	}
+
+	noneTagIDs := make([]string, 0, len(noneTags))
+	for _, name := range noneTags {
+		tag, errWithCode := p.getTag(ctx, name)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if tag == nil {
+			// Nothing uses
+			// this tag, skip.
+			continue
+		}
+
+		noneTagIDs = append(noneTagIDs, tag.ID)
+	}
+
+	statuses, err := p.state.DB.GetTagsTimeline(ctx,
+		anyTagIDs,
+		allTagIDs,
+		noneTagIDs,
+		maxID,
+		sinceID,
+		minID,
+		limit,
+	)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err = gtserror.Newf("db error getting statuses: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Carry the additional
+	// tags over into paging
+	// links, as given.
+	var extraQueryParams []string
+	for _, tags := range []struct {
+		key   string
+		names []string
+	}{
+		{"any[]", anyTags},
+		{"all[]", allTags},
+		{"none[]", noneTags},
+	} {
+		for _, name := range tags.names {
+			extraQueryParams = append(extraQueryParams,
+				tags.key+"="+url.QueryEscape(name),
+			)
+		}
+	}
+
 	return p.packageTagResponse(
 		ctx,
 		requestingAcct,
@@ -71,9 +172,18 @@ func (p *Processor) TagTimelineGet(
 		limit,
 		// Use API URL for tag.
 		"/api/v1/timelines/tag/"+tagName,
+		extraQueryParams,
 	)
 }
 
+// tagTimelineable returns whether statuses
+// using the given tag may be shown in a tag
+// timeline, ie., it exists and is useable
+// and listable on this instance.
+func tagTimelineable(tag *gtsmodel.Tag) bool {
+	return tag != nil && *tag.Useable && *tag.Listable
+}
+
 func (p *Processor) getTag(ctx context.Context, tagName string) (*gtsmodel.Tag, gtserror.WithCode) {
 	// Normalize + validate tag name.
 	tagNameNormal, ok := text.NormalizeHashtag(tagName)
@@ -99,6 +209,7 @@ func (p *Processor) packageTagResponse(
 	statuses []*gtsmodel.Status,
 	limit int,
 	requestPath string,
+	extraQueryParams []string,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
 	count := len(statuses)
 	if count == 0 {
@@ -151,10 +262,11 @@ func (p *Processor) packageTagResponse(
 	}
 
 	return util.PackagePageableResponse(util.PageableResponseParams{
-		Items:          items,
-		Path:           requestPath,
-		NextMaxIDValue: nextMaxIDValue,
-		PrevMinIDValue: prevMinIDValue,
-		Limit:          limit,
+		Items:            items,
+		Path:             requestPath,
+		NextMaxIDValue:   nextMaxIDValue,
+		PrevMinIDValue:   prevMinIDValue,
+		Limit:            limit,
+		ExtraQueryParams: extraQueryParams,
 	})
 }
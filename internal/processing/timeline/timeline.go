@@ -19,6 +19,7 @@ package timeline
 
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
@@ -27,12 +28,14 @@ type Processor struct {
 	state     *state.State
 	converter *typeutils.Converter
 	filter    *visibility.Filter
+	stream    *stream.Processor
 }
 
-func New(state *state.State, converter *typeutils.Converter, filter *visibility.Filter) Processor {
+func New(state *state.State, converter *typeutils.Converter, filter *visibility.Filter, stream *stream.Processor) Processor {
 	return Processor{
 		state:     state,
 		converter: converter,
 		filter:    filter,
+		stream:    stream,
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
@@ -37,6 +38,7 @@ type TimelineStandardTestSuite struct {
 	testAccounts map[string]*gtsmodel.Account
 
 	// module being tested
+	stream   stream.Processor
 	timeline timeline.Processor
 }
 
@@ -54,10 +56,12 @@ func (suite *TimelineStandardTestSuite) SetupTest() {
 	suite.db = testrig.NewTestDB(&suite.state)
 	suite.state.DB = suite.db
 
+	suite.stream = stream.New(&suite.state, testrig.NewTestOauthServer(suite.db))
 	suite.timeline = timeline.New(
 		&suite.state,
 		typeutils.NewConverter(&suite.state),
 		visibility.NewFilter(&suite.state),
+		&suite.stream,
 	)
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
@@ -117,6 +117,8 @@ func (p *Processor) Create(
 		SignUpIP: form.IP,
 		Locale:   form.Locale,
 		AppID:    app.ID,
+
+		AccountDomain: form.Domain,
 	})
 	if err != nil {
 		err := fmt.Errorf("db error creating new signup: %w", err)
@@ -158,10 +160,17 @@ func (p *Processor) TokenForNewUser(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	return &apimodel.Token{
-		AccessToken: accessToken.GetAccess(),
-		TokenType:   "Bearer",
-		Scope:       accessToken.GetScope(),
-		CreatedAt:   accessToken.GetAccessCreateAt().Unix(),
-	}, nil
+	token, err := p.state.DB.GetTokenByAccess(ctx, accessToken.GetAccess())
+	if err != nil {
+		err := fmt.Errorf("db error getting new access token for user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiToken, err := p.converter.TokenToAPIToken(token)
+	if err != nil {
+		err := fmt.Errorf("error converting access token for user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiToken, nil
 }
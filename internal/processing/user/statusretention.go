@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// StatusRetentionGet returns the status retention settings of the given account.
+func (p *Processor) StatusRetentionGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*apimodel.StatusRetention, gtserror.WithCode) {
+	settings, err := p.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.AccountSettingsToAPIStatusRetention(settings), nil
+}
+
+// StatusRetentionUpdate updates the status retention settings of the given
+// account with the values set in form, returning the updated settings.
+func (p *Processor) StatusRetentionUpdate(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	form *apimodel.StatusRetentionUpdateRequest,
+) (*apimodel.StatusRetention, gtserror.WithCode) {
+	settings, err := p.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var columns []string
+
+	if form.Days != nil {
+		if *form.Days < 0 {
+			const help = "days must be 0 (disabled) or greater"
+			return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+		}
+		settings.StatusRetentionDays = *form.Days
+		columns = append(columns, "status_retention_days")
+	}
+
+	if form.KeepPinned != nil {
+		settings.StatusRetentionKeepPinned = form.KeepPinned
+		columns = append(columns, "status_retention_keep_pinned")
+	}
+
+	if form.KeepBookmarked != nil {
+		settings.StatusRetentionKeepBookmarked = form.KeepBookmarked
+		columns = append(columns, "status_retention_keep_bookmarked")
+	}
+
+	if form.KeepFavesAbove != nil {
+		if *form.KeepFavesAbove < 0 {
+			const help = "keep_faves_above must be 0 (disabled) or greater"
+			return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+		}
+		settings.StatusRetentionKeepFavesAbove = *form.KeepFavesAbove
+		columns = append(columns, "status_retention_keep_faves_above")
+	}
+
+	if len(columns) == 0 {
+		const help = "no status retention settings were provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+	}
+
+	if err := p.state.DB.UpdateAccountSettings(ctx, settings, columns...); err != nil {
+		err := gtserror.Newf("db error updating account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.AccountSettingsToAPIStatusRetention(settings), nil
+}
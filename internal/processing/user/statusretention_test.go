@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusRetentionTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *StatusRetentionTestSuite) TestStatusRetentionGetDefault() {
+	account := testrig.NewTestAccounts()["local_account_1"]
+
+	retention, errWithCode := suite.user.StatusRetentionGet(context.Background(), account)
+	suite.NoError(errWithCode)
+
+	// Accounts are not opted in by default.
+	suite.Equal(&apimodel.StatusRetention{
+		Days:           0,
+		KeepPinned:     true,
+		KeepBookmarked: true,
+		KeepFavesAbove: 0,
+	}, retention)
+}
+
+func (suite *StatusRetentionTestSuite) TestStatusRetentionUpdate() {
+	ctx := context.Background()
+	account := testrig.NewTestAccounts()["local_account_1"]
+
+	retention, errWithCode := suite.user.StatusRetentionUpdate(ctx, account, &apimodel.StatusRetentionUpdateRequest{
+		Days:           util.Ptr(180),
+		KeepPinned:     util.Ptr(false),
+		KeepFavesAbove: util.Ptr(10),
+	})
+	suite.NoError(errWithCode)
+	suite.Equal(&apimodel.StatusRetention{
+		Days:           180,
+		KeepPinned:     false,
+		KeepBookmarked: true,
+		KeepFavesAbove: 10,
+	}, retention)
+
+	// Settings should be stored.
+	settings, err := suite.db.GetAccountSettings(ctx, account.ID)
+	suite.NoError(err)
+	suite.True(settings.StatusRetentionEnabled())
+	suite.Equal(180, settings.StatusRetentionDays)
+	suite.False(*settings.StatusRetentionKeepPinned)
+	suite.Equal(10, settings.StatusRetentionKeepFavesAbove)
+
+	// Account should now be opted in.
+	accountIDs, err := suite.db.GetStatusRetentionAccountIDs(ctx)
+	suite.NoError(err)
+	suite.Equal([]string{account.ID}, accountIDs)
+}
+
+func (suite *StatusRetentionTestSuite) TestStatusRetentionUpdateInvalid() {
+	account := testrig.NewTestAccounts()["local_account_1"]
+
+	for _, form := range []*apimodel.StatusRetentionUpdateRequest{
+		{},
+		{Days: util.Ptr(-1)},
+		{KeepFavesAbove: util.Ptr(-5)},
+	} {
+		_, errWithCode := suite.user.StatusRetentionUpdate(context.Background(), account, form)
+		if suite.NotNil(errWithCode) {
+			suite.Equal(http.StatusBadRequest, errWithCode.Code())
+		}
+	}
+}
+
+func TestStatusRetentionTestSuite(t *testing.T) {
+	suite.Run(t, &StatusRetentionTestSuite{})
+}
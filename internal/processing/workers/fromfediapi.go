@@ -216,6 +216,19 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		return nil
 	}
 
+	// Apply instance word filters, now that the
+	// status has been sanitized, which may drop it.
+	dropped, err := p.wordFilterStatus(ctx, status)
+	if err != nil {
+		log.Errorf(ctx, "error word filtering status: %v", err)
+	}
+
+	if dropped {
+		// Status was wiped,
+		// nothing more to do.
+		return nil
+	}
+
 	// Update stats for the remote account.
 	if err := p.utils.incrementStatusesCount(ctx, fMsg.Requesting, status); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
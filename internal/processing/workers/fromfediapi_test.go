@@ -572,6 +572,91 @@ func (suite *FromFediAPITestSuite) TestCreateStatusFromIRI() {
 	suite.Equal(statusCreator.URI, s.AccountURI)
 }
 
+func (suite *FromFediAPITestSuite) createForwardedStatus(testStructs *TestStructs) (*gtsmodel.Status, error) {
+	const statusURI = "http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1"
+	ctx := context.Background()
+
+	err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		Receiving:      suite.testAccounts["local_account_1"],
+		Requesting:     suite.testAccounts["remote_account_2"],
+		APIRI:          testrig.URLMustParse(statusURI),
+	})
+	suite.NoError(err)
+
+	return testStructs.State.DB.GetStatusByURI(ctx, statusURI)
+}
+
+func (suite *FromFediAPITestSuite) TestCreateStatusWordFilterDrop() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	ctx := context.Background()
+
+	filter := &gtsmodel.WordFilter{
+		ID:       "01J1KQ5TZ1J6X0Q0ZB8W2G1JQE",
+		AuthorID: suite.testAccounts["admin_account"].ID,
+		Pattern:  "PLEASE FORWARD",
+		Regex:    util.Ptr(false),
+		Target:   gtsmodel.WordFilterTargetContent,
+		Action:   gtsmodel.WordFilterActionDrop,
+	}
+	if err := testStructs.State.DB.PutWordFilter(ctx, filter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Status should have been dropped.
+	_, err := suite.createForwardedStatus(testStructs)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Match should have been counted.
+	filter, err = testStructs.State.DB.GetWordFilter(ctx, filter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, filter.MatchesCount)
+	suite.NotZero(filter.LastMatchedAt)
+}
+
+func (suite *FromFediAPITestSuite) TestCreateStatusWordFilterSensitive() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	ctx := context.Background()
+
+	for _, filter := range []*gtsmodel.WordFilter{
+		{
+			ID:       "01J1KQ5TZ1J6X0Q0ZB8W2G1JQE",
+			AuthorID: suite.testAccounts["admin_account"].ID,
+			Pattern:  "^some user$",
+			Regex:    util.Ptr(true),
+			Target:   gtsmodel.WordFilterTargetDisplayName,
+			Action:   gtsmodel.WordFilterActionSensitive,
+		},
+		{
+			// Doesn't match: target is links.
+			ID:       "01J1KQ6N3V1YHCQ3S5A7GQ8D4M",
+			AuthorID: suite.testAccounts["admin_account"].ID,
+			Pattern:  "forward",
+			Regex:    util.Ptr(false),
+			Target:   gtsmodel.WordFilterTargetLinks,
+			Action:   gtsmodel.WordFilterActionDrop,
+		},
+	} {
+		if err := testStructs.State.DB.PutWordFilter(ctx, filter); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Status should be kept, but sensitive.
+	status, err := suite.createForwardedStatus(testStructs)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*status.Sensitive)
+}
+
 func (suite *FromFediAPITestSuite) TestMoveAccount() {
 	testStructs := suite.SetupTestStructs()
 	defer suite.TearDownTestStructs(testStructs)
@@ -0,0 +1,158 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+)
+
+// wordFilterStatus matches the given new remote status
+// against the instance word filters, counting matches,
+// and performing the most severe action of any which
+// matched. Returns true if the status was dropped, in
+// which case it has already been wiped from the db, and
+// the caller should not process it any further.
+//
+// Statuses from local accounts are never filtered.
+func (p *fediAPI) wordFilterStatus(ctx context.Context, status *gtsmodel.Status) (bool, error) {
+	if util.PtrValueOr(status.Local, false) {
+		// Never filter
+		// our own posts.
+		return false, nil
+	}
+
+	author := status.Account
+	if author == nil {
+		var err error
+
+		// Author not populated, fetch from DB.
+		author, err = p.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			status.AccountID,
+		)
+		if err != nil {
+			return false, gtserror.Newf("error getting status author %s: %w", status.AccountID, err)
+		}
+	}
+
+	if author.IsLocal() {
+		// Never filter
+		// our own posts.
+		return false, nil
+	}
+
+	// Match against the content
+	// and links of the sanitized
+	// status, and author name.
+	concat := status.ContentWarning + "\n" + status.Content
+	in := &wordfilter.Input{
+		Content:     status.ContentWarning + "\n" + text.HTMLToPlaintext(status.Content),
+		Links:       regexes.LinkScheme.FindAllString(concat, -1),
+		DisplayName: author.DisplayName,
+	}
+
+	matched, err := p.state.DB.WordFilterMatch(ctx, in)
+	if errors.Is(err, wordfilter.ErrMatchTimeout) {
+		// Carry on with whatever
+		// matched before timing out.
+		log.Warnf(ctx, "word filters timed out matching status %s", status.URI)
+	} else if err != nil {
+		return false, gtserror.Newf("error matching word filters: %w", err)
+	}
+
+	if len(matched) == 0 {
+		// Nothing
+		// to do.
+		return false, nil
+	}
+
+	var (
+		now    = time.Now()
+		action = gtsmodel.WordFilterActionSensitive
+		filter *wordfilter.Filter
+	)
+
+	for _, f := range matched {
+		if err := p.state.DB.IncrementWordFilterMatches(ctx, f.ID, now); err != nil {
+			log.Errorf(ctx, "error counting match of word filter %s: %v", f.ID, err)
+		}
+
+		// Pick the most severe action: a
+		// reject is a logged drop, and a
+		// drop trumps marking sensitive.
+		if filter == nil || wordFilterSeverity(f.Action) > wordFilterSeverity(action) {
+			action = f.Action
+			filter = f
+		}
+	}
+
+	switch action {
+	case gtsmodel.WordFilterActionSensitive:
+		log.Debugf(ctx, "word filter %s marking status %s sensitive", filter.ID, status.URI)
+
+		if !util.PtrValueOr(status.Sensitive, false) {
+			status.Sensitive = util.Ptr(true)
+			if err := p.state.DB.UpdateStatus(ctx, status, "sensitive"); err != nil {
+				return false, gtserror.Newf("error marking status sensitive: %w", err)
+			}
+		}
+
+		return false, nil
+
+	case gtsmodel.WordFilterActionReject:
+		log.Warnf(ctx,
+			"word filter %s rejected status %s from %s",
+			filter.ID, status.URI, author.URI,
+		)
+
+	default:
+		log.Debugf(ctx, "word filter %s dropping status %s", filter.ID, status.URI)
+	}
+
+	// Drop the status entirely.
+	const deleteAttachments = true
+	if err := p.utils.wipeStatus(ctx, status, deleteAttachments); err != nil {
+		return true, gtserror.Newf("error wiping status: %w", err)
+	}
+
+	return true, nil
+}
+
+// wordFilterSeverity ranks word filter
+// actions from least to most severe.
+func wordFilterSeverity(action gtsmodel.WordFilterAction) int {
+	switch action {
+	case gtsmodel.WordFilterActionReject:
+		return 2
+	case gtsmodel.WordFilterActionDrop:
+		return 1
+	default:
+		return 0
+	}
+}
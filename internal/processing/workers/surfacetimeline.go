@@ -220,7 +220,7 @@ func (s *Surface) listTimelineStatusForFollow(
 
 	// Check eligibility for each list entry (if any).
 	for _, listEntry := range listEntries {
-		eligible, err := s.listEligible(ctx, listEntry, status)
+		eligible, err := s.Filter.StatusListEligible(ctx, listEntry.ListID, status)
 		if err != nil {
 			errs.Appendf("error checking list eligibility: %w", err)
 			continue
@@ -250,97 +250,6 @@ func (s *Surface) listTimelineStatusForFollow(
 	}
 }
 
-// listEligible checks if the given status is eligible
-// for inclusion in the list that that the given listEntry
-// belongs to, based on the replies policy of the list.
-func (s *Surface) listEligible(
-	ctx context.Context,
-	listEntry *gtsmodel.ListEntry,
-	status *gtsmodel.Status,
-) (bool, error) {
-	if status.InReplyToURI == "" {
-		// If status is not a reply,
-		// then it's all gravy baby.
-		return true, nil
-	}
-
-	if status.InReplyToID == "" {
-		// Status is a reply but we don't
-		// have the replied-to account!
-		return false, nil
-	}
-
-	// Status is a reply to a known account.
-	// We need to fetch the list that this
-	// entry belongs to, in order to check
-	// the list's replies policy.
-	list, err := s.State.DB.GetListByID(
-		ctx, listEntry.ListID,
-	)
-	if err != nil {
-		err := gtserror.Newf("db error getting list %s: %w", listEntry.ListID, err)
-		return false, err
-	}
-
-	switch list.RepliesPolicy {
-	case gtsmodel.RepliesPolicyNone:
-		// This list should not show
-		// replies at all, so skip it.
-		return false, nil
-
-	case gtsmodel.RepliesPolicyList:
-		// This list should show replies
-		// only to other people in the list.
-		//
-		// Check if replied-to account is
-		// also included in this list.
-		includes, err := s.State.DB.ListIncludesAccount(
-			ctx,
-			list.ID,
-			status.InReplyToAccountID,
-		)
-		if err != nil {
-			err := gtserror.Newf(
-				"db error checking if account %s in list %s: %w",
-				status.InReplyToAccountID, listEntry.ListID, err,
-			)
-			return false, err
-		}
-
-		return includes, nil
-
-	case gtsmodel.RepliesPolicyFollowed:
-		// This list should show replies
-		// only to people that the list
-		// owner also follows.
-		//
-		// Check if replied-to account is
-		// followed by list owner account.
-		follows, err := s.State.DB.IsFollowing(
-			ctx,
-			list.AccountID,
-			status.InReplyToAccountID,
-		)
-		if err != nil {
-			err := gtserror.Newf(
-				"db error checking if account %s is followed by %s: %w",
-				status.InReplyToAccountID, list.AccountID, err,
-			)
-			return false, err
-		}
-
-		return follows, nil
-
-	default:
-		// HUH??
-		err := gtserror.Newf(
-			"reply policy '%s' not recognized on list %s",
-			list.RepliesPolicy, list.ID,
-		)
-		return false, err
-	}
-}
-
 // timelineStatus uses the provided ingest function to put the given
 // status in a timeline with the given ID, if it's timelineable.
 //
@@ -557,7 +466,7 @@ func (s *Surface) listTimelineStatusUpdateForFollow(
 
 	// Check eligibility for each list entry (if any).
 	for _, listEntry := range listEntries {
-		eligible, err := s.listEligible(ctx, listEntry, status)
+		eligible, err := s.Filter.StatusListEligible(ctx, listEntry.ListID, status)
 		if err != nil {
 			errs.Appendf("error checking list eligibility: %w", err)
 			continue
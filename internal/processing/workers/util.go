@@ -118,7 +118,9 @@ func (u *utils) wipeStatus(
 		// as depending on where it came from the
 		// original BoostOf may already be gone.
 		gtscontext.SetBarebones(ctx),
-		statusToDelete.ID)
+		statusToDelete.ID,
+		nil, // all boosts
+	)
 	if err != nil {
 		errs.Appendf("error fetching status boosts: %w", err)
 	}
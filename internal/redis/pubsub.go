@@ -0,0 +1,229 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package redis wraps the go-redis client with what is
+// required for coordinating multiple GoToSocial instances
+// sharing a single database, i.e. distributing cache
+// invalidation events between them.
+package redis
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// minBackoff and maxBackoff bound the time
+	// between attempts to reconnect on error.
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+
+	// queueSize is the maximum number of
+	// outgoing messages that may be queued
+	// for publishing before they are dropped.
+	queueSize = 1024
+)
+
+// PubSub is a Redis pub/sub client publishing to, and
+// subscribed to, a single channel. Outgoing messages are
+// queued and published asynchronously, and dropped connections
+// are automatically re-established with exponential backoff.
+type PubSub struct {
+	client  *goredis.Client
+	channel string
+
+	// handlers for received
+	// messages, and reconnects.
+	onMessage   func([]byte)
+	onReconnect func()
+
+	queue  chan []byte
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPubSub returns a new PubSub client for the Redis server at addr,
+// authenticating with password (if set) and publishing / subscribing
+// to channel. Received messages are passed to onMessage, and onReconnect
+// is called each time the subscription is re-established after an error,
+// as any messages published in the meantime will have been missed.
+func NewPubSub(addr, password, channel string, onMessage func([]byte), onReconnect func()) *PubSub {
+	return &PubSub{
+		client: goredis.NewClient(&goredis.Options{
+			Addr:     addr,
+			Password: password,
+		}),
+		channel:     channel,
+		onMessage:   onMessage,
+		onReconnect: onReconnect,
+		queue:       make(chan []byte, queueSize),
+	}
+}
+
+// Start starts the background publishing
+// and subscribing routines of the PubSub.
+func (c *PubSub) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg.Add(2)
+	go c.publisher(ctx)
+	go c.subscriber(ctx)
+}
+
+// Stop stops the background routines of the PubSub, dropping
+// any queued messages, and waits for them to return.
+func (c *PubSub) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.wg.Wait()
+		c.client.Close()
+	}
+}
+
+// Publish queues msg to be published to the channel,
+// returning false if the queue is full and it was dropped.
+func (c *PubSub) Publish(msg []byte) bool {
+	select {
+	case c.queue <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// publisher is the main publishing routine of the PubSub,
+// publishing queued messages until the context is cancelled.
+func (c *PubSub) publisher(ctx context.Context) {
+	defer c.wg.Done()
+
+	var backoff time.Duration
+
+	for {
+		var msg []byte
+
+		select {
+		case <-ctx.Done():
+			return
+		case msg = <-c.queue:
+		}
+
+		for {
+			err := c.client.Publish(ctx, c.channel, msg).Err()
+			if err == nil {
+				backoff = 0
+				break
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Errorf(nil, "error publishing to redis: %v", err)
+
+			// Wait before retry.
+			backoff = nextBackoff(backoff)
+			if !sleep(ctx, backoff) {
+				return
+			}
+		}
+	}
+}
+
+// subscriber is the main subscribing routine of the PubSub, passing
+// received messages to handler until the context is cancelled.
+func (c *PubSub) subscriber(ctx context.Context) {
+	defer c.wg.Done()
+
+	// go-redis resubscribes on the next
+	// receive after any connection error.
+	ps := c.client.Subscribe(ctx, c.channel)
+
+	// Ensure subscription closed on context
+	// cancel, in turn unblocking any receive.
+	stop := context.AfterFunc(ctx, func() { ps.Close() })
+	defer func() {
+		if stop() {
+			ps.Close()
+		}
+	}()
+
+	var (
+		backoff   time.Duration
+		connected bool
+	)
+
+	for {
+		// Await next pushed reply,
+		// no deadline as it may be
+		// quite some time between.
+		msg, err := ps.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Errorf(nil, "error subscribing to redis: %v", err)
+
+			// Wait before retry.
+			backoff = nextBackoff(backoff)
+			if !sleep(ctx, backoff) {
+				return
+			}
+			continue
+		}
+
+		switch msg := msg.(type) {
+		case *goredis.Subscription:
+			if connected {
+				// We were previously subscribed,
+				// any messages in between missed.
+				c.onReconnect()
+			}
+			connected = true
+			backoff = 0
+
+		case *goredis.Message:
+			c.onMessage([]byte(msg.Payload))
+		}
+	}
+}
+
+// nextBackoff returns the next exponential
+// backoff duration following the previous.
+func nextBackoff(prev time.Duration) time.Duration {
+	if prev == 0 {
+		return minBackoff
+	}
+	return min(prev*2, maxBackoff)
+}
+
+// sleep sleeps for duration d, returning
+// false early if the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
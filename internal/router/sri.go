@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package router
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// assetsPathPrefix is the path at which
+// the web asset base dir is served.
+const assetsPathPrefix = "/assets/"
+
+// SRIHash returns the Subresource Integrity hash
+// of the given content, in the form "sha256-...",
+// suitable for use as an "integrity" attribute.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/Security/Subresource_Integrity
+func SRIHash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return "sha256-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// sriHashes calculates and caches the SRI hashes
+// of files served from the web asset base dir.
+//
+// Hashes are recalculated whenever the modtime
+// or size of a file changes, so that assets
+// rebuilt while running are still served
+// with the correct hash.
+type sriHashes struct {
+	dir   string
+	mu    sync.Mutex
+	cache map[string]sriEntry
+}
+
+type sriEntry struct {
+	modTime time.Time
+	size    int64
+	hash    string
+}
+
+func newSRIHashes(assetBaseDir string) *sriHashes {
+	return &sriHashes{
+		dir:   assetBaseDir,
+		cache: make(map[string]sriEntry),
+	}
+}
+
+// Integrity returns the SRI hash of the asset at the
+// given href, eg., "/assets/dist/base.css", or an empty
+// string if href is not an asset or can't be read.
+func (s *sriHashes) Integrity(href string) string {
+	name, ok := strings.CutPrefix(href, assetsPathPrefix)
+	if !ok {
+		// Not an asset.
+		return ""
+	}
+
+	// Clean the name so it can't
+	// escape from the asset dir.
+	name = path.Clean("/" + name)
+	fpath := filepath.Join(s.dir, filepath.FromSlash(name))
+
+	info, err := os.Stat(fpath)
+	if err != nil || info.IsDir() {
+		return ""
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.cache[name]
+	if ok &&
+		entry.modTime.Equal(info.ModTime()) &&
+		entry.size == info.Size() {
+		// Cached hash still valid.
+		return entry.hash
+	}
+
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		return ""
+	}
+
+	entry = sriEntry{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		hash:    SRIHash(b),
+	}
+	s.cache[name] = entry
+
+	return entry.hash
+}
@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package router
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSRIHash(t *testing.T) {
+	// Example from https://www.srihash.org/
+	const expect = "sha256-47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+	if hash := SRIHash(nil); hash != expect {
+		t.Fatalf("expected %s, got %s", expect, hash)
+	}
+}
+
+func TestSRIHashesIntegrity(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, "dist"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	fpath := filepath.Join(dir, "dist", "base.css")
+	if err := os.WriteFile(fpath, []byte("body { color: red; }"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	sri := newSRIHashes(dir)
+
+	// Asset should be hashed.
+	hash := sri.Integrity("/assets/dist/base.css")
+	if expect := SRIHash([]byte("body { color: red; }")); hash != expect {
+		t.Fatalf("expected %s, got %s", expect, hash)
+	}
+
+	// Non-assets, missing assets, directories and
+	// paths escaping the asset dir shouldn't be hashed.
+	for _, href := range []string{
+		"/@someone/custom.css",
+		"/assets/dist/missing.css",
+		"/assets/dist",
+		"/assets/../" + filepath.Base(dir) + "/dist/base.css",
+	} {
+		if hash := sri.Integrity(href); hash != "" {
+			t.Fatalf("expected no hash for %s, got %s", href, hash)
+		}
+	}
+
+	// Hash should be updated when the asset changes.
+	if err := os.WriteFile(fpath, []byte("body { color: blue; }"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(fpath, future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	hash = sri.Integrity("/assets/dist/base.css")
+	if expect := SRIHash([]byte("body { color: blue; }")); hash != expect {
+		t.Fatalf("expected %s, got %s", expect, hash)
+	}
+}
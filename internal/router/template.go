@@ -47,6 +47,10 @@ import (
 // to the template funcMap for use in any template. Use these "include"
 // functions when you need to pass a template through a pipeline.
 // Otherwise, prefer the built-in "template" function.
+//
+// The special function "integrity" will also be added, which returns
+// the Subresource Integrity hash of a file in `web-asset-base-dir`,
+// given its path, eg., "/assets/dist/base.css".
 func LoadTemplates(engine *gin.Engine) error {
 	templateBaseDir := config.GetWebTemplateBaseDir()
 	if templateBaseDir == "" {
@@ -72,6 +76,14 @@ func LoadTemplates(engine *gin.Engine) error {
 		)
 	}
 
+	assetBaseDirAbs, err := filepath.Abs(config.GetWebAssetBaseDir())
+	if err != nil {
+		return gtserror.Newf(
+			"error getting absolute path of web-asset-base-dir %s: %w",
+			config.GetWebAssetBaseDir(), err,
+		)
+	}
+
 	// Bring base template into scope.
 	tmpl := template.New("base")
 
@@ -95,6 +107,14 @@ func LoadTemplates(engine *gin.Engine) error {
 		return noescapeAttr(buf.String()), err
 	}
 
+	// Set "integrity" function to calculate
+	// SRI hashes for stylesheets and scripts.
+	funcMap["integrity"] = newSRIHashes(assetBaseDirAbs).Integrity
+
+	// Set "defaultTheme" function to render the
+	// instance's default web color theme.
+	funcMap["defaultTheme"] = config.GetWebDefaultTheme
+
 	// Load functions into the base template, and
 	// associate other templates with base template.
 	templateGlob := filepath.Join(templateDirAbs, "*")
@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/scheduler"
+)
+
+func TestCronNext(t *testing.T) {
+	// Wednesday 15th May 2024, 10:30:15 UTC.
+	now := time.Date(2024, time.May, 15, 10, 30, 15, 0, time.UTC)
+
+	for _, test := range []struct {
+		spec   string
+		expect time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.May, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.May, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 4 * * *", time.Date(2024, time.May, 16, 4, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2024, time.May, 16, 10, 30, 0, 0, time.UTC)},
+		{"0 4 * * 0", time.Date(2024, time.May, 19, 4, 0, 0, 0, time.UTC)},
+		{"0 4 * * 7", time.Date(2024, time.May, 19, 4, 0, 0, 0, time.UTC)},
+		{"0 4 * * sat,sun", time.Date(2024, time.May, 18, 4, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 9-17/4 * * mon-fri", time.Date(2024, time.May, 15, 13, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 1", time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, time.May, 19, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.May, 15, 11, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		cron, err := scheduler.ParseCron(test.spec)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %v", test.spec, err)
+			continue
+		}
+
+		if next := cron.Next(now); !next.Equal(test.expect) {
+			t.Errorf("unexpected next time for %q: expected=%s actual=%s", test.spec, test.expect, next)
+		}
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@fortnightly",
+	} {
+		if _, err := scheduler.ParseCron(spec); err == nil {
+			t.Errorf("expected error parsing %q", spec)
+		}
+	}
+}
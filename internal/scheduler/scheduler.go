@@ -64,6 +64,11 @@ func (sch *Scheduler) AddRecurring(id string, start time.Time, freq time.Duratio
 	return sch.schedule(id, fn, &sched.PeriodicAt{Once: sched.Once(start), Period: sched.Periodic(freq)})
 }
 
+// AddCron schedules the given task to run according to given cron timing, registered under given id. Returns false if task already exists for id.
+func (sch *Scheduler) AddCron(id string, cron *Cron, fn func(context.Context, time.Time)) bool {
+	return sch.schedule(id, fn, cron)
+}
+
 // Cancel attempts to cancel a scheduled task with id, returns false if no task found.
 func (sch *Scheduler) Cancel(id string) bool {
 	// Attempt to acquire and
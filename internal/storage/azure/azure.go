@@ -0,0 +1,446 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+)
+
+// ensure AzureStorage conforms to storage.Storage.
+var _ storage.Storage = (*AzureStorage)(nil)
+
+// maxBlobNameLen is the maximum
+// permitted length of a blob name.
+const maxBlobNameLen = 1024
+
+// ReaderSize is an extension of the io.Reader interface
+// that may be implemented by callers of WriteStream() in
+// order to improve performance. When the size is known
+// it is checked against the maximum object size upfront.
+type ReaderSize interface {
+	io.Reader
+	Size() int64
+}
+
+// Config defines options to be used when opening an AzureStorage.
+type Config struct {
+	// Credentials used to sign requests
+	// to the storage account endpoint.
+	Credentials Credentials
+
+	// BlockSize is the size (in bytes) of the
+	// blocks to upload when writing a stream
+	// larger than this.
+	BlockSize int64
+
+	// ListSize determines how many items
+	// to include in each list request, made
+	// during calls to .WalkKeys().
+	ListSize int
+
+	// MaxObjectBytes is the maximum size (in
+	// bytes) of blobs written during calls
+	// to .Write___(). Writes exceeding this
+	// are aborted with storage.ErrTooLarge.
+	// A value <= 0 means no limit.
+	MaxObjectBytes int64
+
+	// HTTPClient is the client used for requests.
+	// If nil, a client with connection and response
+	// header timeouts is used, see defaultHTTPClient().
+	HTTPClient *http.Client
+}
+
+// AzureStorage is a storage implementation that stores key-value
+// pairs as block blobs in an Azure storage account container.
+type AzureStorage struct {
+	client *container.Client
+	config Config
+}
+
+// Open opens a new AzureStorage instance for the container with given
+// name, using the given configuration, checking that it exists.
+func Open(containerName string, cfg Config) (*AzureStorage, error) {
+	if cfg.BlockSize <= 0 {
+		cfg.BlockSize = 4 * 1024 * 1024 // 4MiB
+	}
+
+	if cfg.ListSize <= 0 {
+		cfg.ListSize = 200
+	}
+
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = defaultHTTPClient()
+	}
+
+	cred, err := azblob.NewSharedKeyCredential(
+		cfg.Credentials.AccountName,
+		cfg.Credentials.AccountKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account credentials: %w", err)
+	}
+
+	// Container URL is the blob
+	// service endpoint + name.
+	containerURL := strings.TrimSuffix(cfg.Credentials.Endpoint, "/") +
+		"/" + containerName
+
+	client, err := container.NewClientWithSharedKeyCredential(
+		containerURL,
+		cred,
+		&container.ClientOptions{
+			ClientOptions: azcore.ClientOptions{
+				Transport: cfg.HTTPClient,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &AzureStorage{
+		client: client,
+		config: cfg,
+	}
+
+	// Check that provided container actually exists.
+	_, err = client.GetProperties(context.Background(), nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			return nil, errors.New("storage/azure: container does not exist")
+		}
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// defaultHTTPClient returns a client with the default transport
+// settings, plus a timeout on waiting for response headers, so
+// a stalled blob service request can't hang callers forever. No
+// overall request timeout is set, as that would cut off streamed
+// reads and writes of large blobs that are still progressing.
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 30 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+}
+
+// Clean: implements Storage.Clean().
+func (st *AzureStorage) Clean(ctx context.Context) error {
+	return nil // nothing to do for Azure
+}
+
+// ReadBytes: implements Storage.ReadBytes().
+func (st *AzureStorage) ReadBytes(ctx context.Context, key string) ([]byte, error) {
+	// Get stream reader for key
+	rc, err := st.ReadStream(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read all data to memory.
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+
+	// Close storage stream reader.
+	if err := rc.Close(); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// ReadStream: implements Storage.ReadStream().
+func (st *AzureStorage) ReadStream(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	// Get blob from container.
+	rsp, err := st.client.
+		NewBlobClient(key).
+		DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, transformError(err)
+	}
+
+	return rsp.Body, nil
+}
+
+// WriteBytes: implements Storage.WriteBytes().
+func (st *AzureStorage) WriteBytes(ctx context.Context, key string, value []byte) (int, error) {
+	n, err := st.WriteStream(ctx, key, bytes.NewReader(value))
+	return int(n), err
+}
+
+// WriteStream: implements Storage.WriteStream().
+func (st *AzureStorage) WriteStream(ctx context.Context, key string, r io.Reader) (int64, error) {
+	if err := checkKey(key); err != nil {
+		return 0, err
+	}
+
+	if rs, ok := r.(ReaderSize); ok {
+		// Check known size against max, if set.
+		if st.config.MaxObjectBytes > 0 &&
+			rs.Size() > st.config.MaxObjectBytes {
+			return 0, storage.ErrTooLarge
+		}
+	}
+
+	// Wrap the reader, if needed, to
+	// abort once max size is exceeded,
+	// and to count bytes written.
+	cr := &countReader{r: st.limitReader(r)}
+
+	// Upload the stream, in a single Put Blob
+	// if smaller than block size, else as a
+	// list of blocks committed at the end.
+	_, err := st.client.
+		NewBlockBlobClient(key).
+		UploadStream(ctx, cr, &blockblob.UploadStreamOptions{
+			BlockSize:   st.config.BlockSize,
+			Concurrency: 1,
+		})
+	if err != nil {
+		// Uncommitted blocks are garbage
+		// collected by Azure after a week,
+		// so there's nothing to abort here.
+		return 0, transformError(err)
+	}
+
+	return cr.n, nil
+}
+
+// Stat: implements Storage.Stat().
+func (st *AzureStorage) Stat(ctx context.Context, key string) (*storage.Entry, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	// Get blob properties.
+	rsp, err := st.client.
+		NewBlobClient(key).
+		GetProperties(ctx, nil)
+	if err != nil {
+		if err = transformError(err); errors.Is(err, storage.ErrNotFound) {
+			// Ignore err return
+			// for not-found.
+			err = nil
+		}
+		return nil, err
+	}
+
+	var size int64
+	if rsp.ContentLength != nil {
+		size = *rsp.ContentLength
+	}
+
+	return &storage.Entry{
+		Key:  key,
+		Size: size,
+	}, nil
+}
+
+// Remove: implements Storage.Remove().
+func (st *AzureStorage) Remove(ctx context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	// Delete blob from container.
+	_, err := st.client.
+		NewBlobClient(key).
+		Delete(ctx, nil)
+	if err != nil {
+		return transformError(err)
+	}
+
+	return nil
+}
+
+// WalkKeys: implements Storage.WalkKeys().
+//
+// Note that WalkKeysOpts.Concurrency is not
+// supported, entries are always walked serially.
+func (st *AzureStorage) WalkKeys(ctx context.Context, opts storage.WalkKeysOpts) error {
+	if opts.Step == nil {
+		panic("nil step fn")
+	}
+
+	listOpts := &container.ListBlobsFlatOptions{
+		MaxResults: to.Ptr(int32(st.config.ListSize)),
+	}
+
+	if opts.Prefix != "" {
+		listOpts.Prefix = &opts.Prefix
+	}
+
+	// Page through blobs in container.
+	pager := st.client.NewListBlobsFlatPager(listOpts)
+
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return transformError(err)
+		}
+
+		// Iterate through list result blobs.
+		for _, blob := range page.Segment.BlobItems {
+			key := *blob.Name
+
+			// Skip filtered blob keys.
+			if opts.Filter != nil &&
+				!opts.Filter(key) {
+				continue
+			}
+
+			var size int64
+			if blob.Properties != nil &&
+				blob.Properties.ContentLength != nil {
+				size = *blob.Properties.ContentLength
+			}
+
+			// Pass each blob through step func.
+			if err := opts.Step(storage.Entry{
+				Key:  key,
+				Size: size,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// transformError wraps blob service errors with
+// the relevant go-storage error types, if any.
+func transformError(err error) error {
+	var rspErr *azcore.ResponseError
+	if !errors.As(err, &rspErr) {
+		return err
+	}
+
+	switch {
+	case rspErr.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %w", storage.ErrNotFound, err)
+	case bloberror.HasCode(err,
+		bloberror.InvalidResourceName,
+		bloberror.InvalidURI,
+	):
+		return fmt.Errorf("%w: %w", storage.ErrInvalidKey, err)
+	case bloberror.HasCode(err, bloberror.BlobAlreadyExists):
+		return fmt.Errorf("%w: %w", storage.ErrAlreadyExists, err)
+	}
+
+	return err
+}
+
+// checkKey returns storage.ErrInvalidKey if
+// key is not a valid blob name for Azure.
+func checkKey(key string) error {
+	if key == "" || len(key) > maxBlobNameLen {
+		return storage.ErrInvalidKey
+	}
+	return nil
+}
+
+// limitReader wraps the given reader to return storage.ErrTooLarge
+// once more than the configured maximum object size has been read.
+func (st *AzureStorage) limitReader(r io.Reader) io.Reader {
+	if st.config.MaxObjectBytes <= 0 {
+		return r
+	}
+	return &limitedReader{r: r, n: st.config.MaxObjectBytes}
+}
+
+// limitedReader is an io.Reader that returns
+// storage.ErrTooLarge when more than n bytes
+// are read from the underlying reader.
+type limitedReader struct {
+	r io.Reader
+	n int64 // bytes remaining
+}
+
+func (l *limitedReader) Read(b []byte) (int, error) {
+	if l.n < 0 {
+		return 0, storage.ErrTooLarge
+	}
+
+	// Read up to one byte past
+	// limit, to detect overflow.
+	if int64(len(b)) > l.n+1 {
+		b = b[:l.n+1]
+	}
+
+	n, err := l.r.Read(b)
+	l.n -= int64(n)
+
+	if l.n < 0 {
+		// Limit exceeded.
+		return 0, storage.ErrTooLarge
+	}
+
+	return n, err
+}
+
+// countReader wraps an io.Reader
+// to count the bytes read from it.
+type countReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += int64(n)
+	return n, err
+}
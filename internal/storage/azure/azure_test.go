@@ -0,0 +1,405 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package azure_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"codeberg.org/gruf/go-storage"
+	"github.com/superseriousbusiness/gotosocial/internal/storage/azure"
+)
+
+const (
+	testAccount   = "devstoreaccount1"
+	testContainer = "gotosocial"
+)
+
+// testKey is a (fake) base64 account key.
+var testKey = base64.StdEncoding.EncodeToString([]byte("not a real account key"))
+
+// fakeAzure is a very minimal in-memory implementation
+// of the Azure blob service API, supporting only the
+// operations needed to test the azure storage driver.
+type fakeAzure struct {
+	mu     sync.Mutex
+	blobs  map[string][]byte
+	blocks map[string][]byte
+
+	// auth records the authorization
+	// header of the most recent request.
+	auth string
+}
+
+func (f *fakeAzure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.auth = r.Header.Get("Authorization")
+	if !strings.HasPrefix(f.auth, "SharedKey "+testAccount+":") ||
+		r.Header.Get("X-Ms-Date") == "" ||
+		r.Header.Get("X-Ms-Version") == "" {
+		writeError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	// Path-style, as with the Azurite emulator:
+	// /{account}/{container}/{blob...}
+	path := strings.TrimPrefix(r.URL.Path, "/"+testAccount+"/")
+	container, key, _ := strings.Cut(path, "/")
+	if container != testContainer {
+		writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	query := r.URL.Query()
+
+	switch {
+	// Get container properties.
+	case key == "" && query.Get("restype") == "container" && query.Get("comp") == "":
+		w.WriteHeader(http.StatusOK)
+
+	// List blobs.
+	case key == "" && r.Method == http.MethodGet && query.Get("comp") == "list":
+		names := make([]string, 0, len(f.blobs))
+		for name := range f.blobs {
+			if strings.HasPrefix(name, query.Get("prefix")) {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+
+		// Marker is the name to start from.
+		if marker := query.Get("marker"); marker != "" {
+			i, _ := slices.BinarySearch(names, marker)
+			names = names[i:]
+		}
+
+		var next string
+		max, _ := strconv.Atoi(query.Get("maxresults"))
+		if len(names) > max {
+			next = names[max]
+			names = names[:max]
+		}
+
+		fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+		for _, name := range names {
+			fmt.Fprintf(w, `<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length></Properties></Blob>`, name, len(f.blobs[name]))
+		}
+		fmt.Fprintf(w, `</Blobs><NextMarker>%s</NextMarker></EnumerationResults>`, next)
+
+	// Put block.
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		f.blocks[key+"#"+query.Get("blockid")] = readBody(r)
+		w.WriteHeader(http.StatusCreated)
+
+	// Put block list.
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.Unmarshal(readBody(r), &list); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+		var data []byte
+		for _, id := range list.Latest {
+			block, ok := f.blocks[key+"#"+id]
+			if !ok {
+				writeError(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			data = append(data, block...)
+			delete(f.blocks, key+"#"+id)
+		}
+		f.blobs[key] = data
+		w.WriteHeader(http.StatusCreated)
+
+	// Put blob.
+	case r.Method == http.MethodPut:
+		if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+			writeError(w, http.StatusBadRequest, "MissingRequiredHeader")
+			return
+		}
+		if strings.Contains(key, "\\") {
+			writeError(w, http.StatusBadRequest, "InvalidResourceName")
+			return
+		}
+		f.blobs[key] = readBody(r)
+		w.WriteHeader(http.StatusCreated)
+
+	// Get blob.
+	case r.Method == http.MethodGet:
+		data, ok := f.blobs[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = w.Write(data)
+
+	// Get blob properties.
+	case r.Method == http.MethodHead:
+		data, ok := f.blobs[key]
+		if !ok {
+			w.Header().Set("X-Ms-Error-Code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+
+	// Delete blob.
+	case r.Method == http.MethodDelete:
+		if _, ok := f.blobs[key]; !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(f.blobs, key)
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+// writeError writes a blob
+// service error response.
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("X-Ms-Error-Code", code)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func readBody(r *http.Request) []byte {
+	b, _ := io.ReadAll(r.Body)
+	return b
+}
+
+func openFakeAzure(t *testing.T, cfg azure.Config) (*azure.AzureStorage, *fakeAzure) {
+	fake := &fakeAzure{
+		blobs:  make(map[string][]byte),
+		blocks: make(map[string][]byte),
+	}
+
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	cfg.Credentials = azure.Credentials{
+		AccountName: testAccount,
+		AccountKey:  testKey,
+		Endpoint:    srv.URL + "/" + testAccount,
+	}
+
+	st, err := azure.Open(testContainer, cfg)
+	if err != nil {
+		t.Fatalf("error opening azure storage: %v", err)
+	}
+
+	return st, fake
+}
+
+func TestAzureOpenNoContainer(t *testing.T) {
+	srv := httptest.NewServer(&fakeAzure{})
+	defer srv.Close()
+
+	_, err := azure.Open("no-such-container", azure.Config{
+		Credentials: azure.Credentials{
+			AccountName: testAccount,
+			AccountKey:  testKey,
+			Endpoint:    srv.URL + "/" + testAccount,
+		},
+	})
+	if err == nil || err.Error() != "storage/azure: container does not exist" {
+		t.Fatalf("expected container error, got: %v", err)
+	}
+}
+
+func TestAzureReadWrite(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeAzure(t, azure.Config{BlockSize: 1024})
+
+	for _, test := range []struct {
+		name string
+		data []byte
+		r    func([]byte) io.Reader
+	}{
+		// Known size, single Put Blob.
+		{"single", bytes.Repeat([]byte{'a'}, 1000), func(b []byte) io.Reader {
+			return bytes.NewReader(b)
+		}},
+
+		// Known size, but larger than block.
+		{"large", bytes.Repeat([]byte{'b'}, 3000), func(b []byte) io.Reader {
+			return bytes.NewReader(b)
+		}},
+
+		// Wrap data in a MultiReader to hide its
+		// size, forcing a (chunked) block upload.
+		{"unknown", bytes.Repeat([]byte{'c'}, 2500), func(b []byte) io.Reader {
+			return io.MultiReader(bytes.NewReader(b))
+		}},
+
+		// Unknown size, but empty.
+		{"empty", []byte{}, func(b []byte) io.Reader {
+			return io.MultiReader(bytes.NewReader(b))
+		}},
+	} {
+		key := "some/dir/" + test.name
+
+		n, err := st.WriteStream(ctx, key, test.r(test.data))
+		if err != nil {
+			t.Fatalf("%s: unexpected error writing: %v", test.name, err)
+		}
+
+		if n != int64(len(test.data)) || !bytes.Equal(fake.blobs[key], test.data) {
+			t.Fatalf("%s: blob not stored correctly, wrote %d bytes", test.name, n)
+		}
+
+		if len(fake.blocks) != 0 {
+			t.Fatalf("%s: expected no uncommitted blocks, got %d", test.name, len(fake.blocks))
+		}
+
+		b, err := st.ReadBytes(ctx, key)
+		if err != nil {
+			t.Fatalf("%s: unexpected error reading: %v", test.name, err)
+		} else if !bytes.Equal(b, test.data) {
+			t.Fatalf("%s: unexpected blob contents", test.name)
+		}
+
+		entry, err := st.Stat(ctx, key)
+		if err != nil || entry == nil {
+			t.Fatalf("%s: unexpected stat result: %+v (err=%v)", test.name, entry, err)
+		} else if entry.Key != key || entry.Size != int64(len(test.data)) {
+			t.Fatalf("%s: unexpected stat result: %+v", test.name, entry)
+		}
+
+		if err := st.Remove(ctx, key); err != nil {
+			t.Fatalf("%s: unexpected error removing: %v", test.name, err)
+		}
+	}
+}
+
+func TestAzureErrors(t *testing.T) {
+	ctx := context.Background()
+	st, _ := openFakeAzure(t, azure.Config{MaxObjectBytes: 10})
+
+	// Missing blobs.
+	if _, err := st.ReadBytes(ctx, "missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound reading, got: %v", err)
+	}
+
+	if err := st.Remove(ctx, "missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound removing, got: %v", err)
+	}
+
+	if entry, err := st.Stat(ctx, "missing"); err != nil || entry != nil {
+		t.Fatalf("expected nil stat, got: %+v (err=%v)", entry, err)
+	}
+
+	// Invalid blob names.
+	if _, err := st.WriteBytes(ctx, "", []byte("hello")); !errors.Is(err, storage.ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey for empty key, got: %v", err)
+	}
+
+	if _, err := st.WriteBytes(ctx, "bad\\key", []byte("hello")); !errors.Is(err, storage.ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey from service, got: %v", err)
+	}
+
+	// Blobs too large, with known and unknown size.
+	if _, err := st.WriteBytes(ctx, "too-large", []byte("hello world")); !errors.Is(err, storage.ErrTooLarge) {
+		t.Fatalf("expected ErrTooLarge, got: %v", err)
+	}
+
+	r := io.MultiReader(strings.NewReader("hello world"))
+	if _, err := st.WriteStream(ctx, "too-large", r); !errors.Is(err, storage.ErrTooLarge) {
+		t.Fatalf("expected ErrTooLarge, got: %v", err)
+	}
+}
+
+func TestAzureWalkKeys(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeAzure(t, azure.Config{ListSize: 3})
+
+	for i := 0; i < 10; i++ {
+		fake.blobs[fmt.Sprintf("a/key-%d", i)] = []byte("hello")
+	}
+	fake.blobs["b/other"] = []byte("world")
+
+	var keys []string
+	if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+		Prefix: "a/",
+		Step: func(entry storage.Entry) error {
+			if entry.Size != 5 {
+				return fmt.Errorf("unexpected size for %s: %d", entry.Key, entry.Size)
+			}
+			keys = append(keys, entry.Key)
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error walking keys: %v", err)
+	}
+
+	// Walk should span several pages, only within prefix.
+	if len(keys) != 10 || keys[0] != "a/key-0" || keys[9] != "a/key-9" {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+}
+
+func TestParseConnectionString(t *testing.T) {
+	creds, err := azure.ParseConnectionString(
+		"DefaultEndpointsProtocol=https;AccountName=myaccount;AccountKey=" + testKey + ";EndpointSuffix=core.windows.net",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if creds.AccountName != "myaccount" ||
+		creds.AccountKey != testKey ||
+		creds.Endpoint != "https://myaccount.blob.core.windows.net" {
+		t.Fatalf("unexpected credentials: %+v", creds)
+	}
+
+	// Explicit blob endpoint, eg., for Azurite.
+	creds, err = azure.ParseConnectionString(
+		"AccountName=devstoreaccount1;AccountKey=" + testKey + ";BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if creds.Endpoint != "http://127.0.0.1:10000/devstoreaccount1" {
+		t.Fatalf("unexpected endpoint: %s", creds.Endpoint)
+	}
+
+	// Missing account key.
+	if _, err := azure.ParseConnectionString("AccountName=myaccount"); err == nil {
+		t.Fatal("expected error for missing account key")
+	}
+}
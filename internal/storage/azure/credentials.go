@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Credentials contains the Azure storage account
+// name and key used to sign requests, along with
+// the account's blob service endpoint.
+type Credentials struct {
+	// AccountName is the name
+	// of the storage account.
+	AccountName string
+
+	// AccountKey is the base64
+	// encoded storage account key.
+	AccountKey string
+
+	// Endpoint is the blob service endpoint,
+	// eg., "https://{account}.blob.core.windows.net".
+	Endpoint string
+}
+
+// ParseConnectionString parses credentials from an Azure
+// storage connection string, as shown in the Azure portal,
+// eg., "DefaultEndpointsProtocol=https;AccountName=...;
+// AccountKey=...;EndpointSuffix=core.windows.net".
+func ParseConnectionString(connStr string) (Credentials, error) {
+	var (
+		creds    Credentials
+		protocol = "https"
+		suffix   = "core.windows.net"
+	)
+
+	for _, part := range strings.Split(connStr, ";") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+
+		// Note the value may itself contain
+		// '=', as account keys are base64.
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return creds, fmt.Errorf("invalid connection string part: %s", k)
+		}
+
+		switch strings.ToLower(k) {
+		case "defaultendpointsprotocol":
+			protocol = v
+		case "accountname":
+			creds.AccountName = v
+		case "accountkey":
+			creds.AccountKey = v
+		case "endpointsuffix":
+			suffix = v
+		case "blobendpoint":
+			creds.Endpoint = v
+		}
+	}
+
+	if creds.AccountName == "" || creds.AccountKey == "" {
+		return creds, errors.New("connection string must contain AccountName and AccountKey")
+	}
+
+	if creds.Endpoint == "" {
+		// Build endpoint from account name and suffix.
+		creds.Endpoint = protocol + "://" + creds.AccountName + ".blob." + suffix
+	}
+
+	return creds, nil
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/storage/azure"
 )
 
 const (
@@ -71,7 +72,6 @@ type Driver struct {
 
 	// S3-only parameters
 	Proxy          bool
-	Bucket         string
 	PresignedCache *ttl.Cache[string, PresignedURL]
 }
 
@@ -108,10 +108,18 @@ func (d *Driver) Has(ctx context.Context, key string) (bool, error) {
 
 // WalkKeys walks the keys in the storage.
 func (d *Driver) WalkKeys(ctx context.Context, walk func(string) error) error {
+	return d.WalkKeysConcurrent(ctx, 1, walk)
+}
+
+// WalkKeysConcurrent is like WalkKeys, but calls walk from up to
+// 'concurrency' goroutines at once, so walk must be safe for
+// concurrent use. Keys are not passed to walk in any order.
+func (d *Driver) WalkKeysConcurrent(ctx context.Context, concurrency int, walk func(string) error) error {
 	return d.Storage.WalkKeys(ctx, storage.WalkKeysOpts{
 		Step: func(entry storage.Entry) error {
 			return walk(entry.Key)
 		},
+		Concurrency: concurrency,
 	})
 }
 
@@ -133,9 +141,17 @@ func (d *Driver) URL(ctx context.Context, key string) *PresignedURL {
 		return &e.Value
 	}
 
-	u, err := s3.Client().PresignedGetObject(ctx, d.Bucket, key, urlCacheTTL, url.Values{
+	params := url.Values{
 		"response-content-type": []string{mime.TypeByExtension(path.Ext(key))},
-	})
+	}
+
+	if config.GetStorageS3RequesterPays() {
+		// Presigned URLs for requester-pays buckets
+		// must acknowledge the charge in the query.
+		params.Set("x-amz-request-payer", "requester")
+	}
+
+	u, err := s3.Client().PresignedGetObject(ctx, s3.BucketFor(key), key, urlCacheTTL, params)
 	if err != nil {
 		// If URL request fails, fallback is to fetch the file. So ignore the error here
 		return nil
@@ -190,7 +206,7 @@ func (d *Driver) ProbeCSPUri(ctx context.Context) (string, error) {
 	}()
 
 	// Get a presigned URL for that empty file.
-	u, err := s3.Client().PresignedGetObject(ctx, d.Bucket, cspKey, 1*time.Second, nil)
+	u, err := s3.Client().PresignedGetObject(ctx, s3.BucketFor(cspKey), cspKey, 1*time.Second, nil)
 	if err != nil {
 		return "", err
 	}
@@ -209,6 +225,8 @@ func AutoConfig() (*Driver, error) {
 	switch backend := config.GetStorageBackend(); backend {
 	case "s3":
 		return NewS3Storage()
+	case "azure":
+		return NewAzureStorage()
 	case "local":
 		return NewFileStorage()
 	default:
@@ -260,6 +278,25 @@ func NewS3Storage() (*Driver, error) {
 		StatOpts:     minio.StatObjectOptions{},
 		RemoveOpts:   minio.RemoveObjectOptions{},
 		ListSize:     200,
+
+		// Refuse writes larger than any
+		// file we should ever be storing.
+		MaxObjectBytes: maxObjectBytes(),
+
+		// Acknowledge read request charges
+		// when using a requester-pays bucket.
+		RequesterPays: config.GetStorageS3RequesterPays(),
+
+		// Media is written without a content-type,
+		// so detect it for presigned URL downloads.
+		AutoDetectContentType: true,
+
+		// Back off when S3 responds "503 SlowDown".
+		RateLimit: s3.RateLimit{
+			Initial: config.GetStorageS3RateLimitInitial(),
+			Min:     config.GetStorageS3RateLimitMin(),
+			Max:     config.GetStorageS3RateLimitMax(),
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error opening s3 storage: %w", err)
@@ -271,8 +308,62 @@ func NewS3Storage() (*Driver, error) {
 
 	return &Driver{
 		Proxy:          config.GetStorageS3Proxy(),
-		Bucket:         config.GetStorageS3BucketName(),
 		Storage:        s3,
 		PresignedCache: presignedCache,
 	}, nil
 }
+
+func NewAzureStorage() (*Driver, error) {
+	// Load runtime configuration
+	connStr := config.GetStorageAzureConnectionString()
+	container := config.GetStorageAzureContainer()
+
+	var creds azure.Credentials
+	if connStr != "" {
+		// Take everything from connection string.
+		var err error
+		creds, err = azure.ParseConnectionString(connStr)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing azure connection string: %w", err)
+		}
+	} else {
+		creds = azure.Credentials{
+			AccountName: config.GetStorageAzureAccountName(),
+			AccountKey:  config.GetStorageAzureAccountKey(),
+			Endpoint:    config.GetStorageAzureEndpoint(),
+		}
+
+		if creds.AccountName == "" || creds.AccountKey == "" || creds.Endpoint == "" {
+			return nil, errors.New("azure storage requires either a connection string, or account name, key and endpoint")
+		}
+	}
+
+	// Open the azure storage implementation
+	st, err := azure.Open(container, azure.Config{
+		Credentials: creds,
+		BlockSize:   int64(config.GetStorageAzureBlockSize()),
+		ListSize:    200,
+
+		// Refuse writes larger than any
+		// file we should ever be storing.
+		MaxObjectBytes: maxObjectBytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error opening azure storage: %w", err)
+	}
+
+	return &Driver{
+		Storage: st,
+	}, nil
+}
+
+// maxObjectBytes returns the largest configured media size limit,
+// to be used as a hard limit on the size of objects written to storage.
+func maxObjectBytes() int64 {
+	return int64(max(
+		config.GetMediaImageMaxSize(),
+		config.GetMediaVideoMaxSize(),
+		config.GetMediaEmojiLocalMaxSize(),
+		config.GetMediaEmojiRemoteMaxSize(),
+	))
+}
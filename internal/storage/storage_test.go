@@ -0,0 +1,922 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+	"codeberg.org/gruf/go-storage/s3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const testBucket = "gotosocial"
+
+// fakeS3 is a very minimal in-memory implementation of
+// the S3 API, supporting only the object operations that
+// are needed to test writes (including multipart uploads),
+// reads, stats, removes and listing.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	nextID  int
+
+	// buckets maps bucket names to
+	// their objects, with the test
+	// bucket's objects being above.
+	buckets map[string]map[string][]byte
+
+	// payers records the "x-amz-request-payer"
+	// header sent with each (read) operation.
+	payers map[string]string
+
+	// listMetadata records the "metadata" query
+	// parameter sent with each list request.
+	listMetadata []string
+
+	// contentTypes records the content-type
+	// sent with each object write, by key.
+	contentTypes map[string]string
+
+	// headers records the user metadata, tagging
+	// and storage class headers of each object,
+	// by key, which are returned on stat.
+	headers map[string]http.Header
+
+	// noConditional causes conditional writes
+	// ("If-None-Match: *") to be rejected as
+	// not implemented, like older backends.
+	noConditional bool
+
+	// slowDown causes all object operations to
+	// be rejected with "503 SlowDown", recording
+	// the time each rejected request was received.
+	slowDown  bool
+	slowDowns []time.Time
+}
+
+func newFakeS3() *fakeS3 {
+	objects := make(map[string][]byte)
+	return &fakeS3{
+		objects: objects,
+		uploads: make(map[string]map[int][]byte),
+		payers:  make(map[string]string),
+
+		contentTypes: make(map[string]string),
+		headers:      make(map[string]http.Header),
+		buckets: map[string]map[string][]byte{
+			testBucket: objects,
+		},
+	}
+}
+
+// cutBucket splits a path-style
+// request path into bucket and key.
+func cutBucket(path string) (string, string) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return bucket, key
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var (
+		query       = r.URL.Query()
+		bucket, key = cutBucket(r.URL.Path)
+	)
+
+	objects, ok := f.buckets[bucket]
+	if !ok {
+		http.Error(w, "no such bucket", http.StatusNotFound)
+		return
+	}
+
+	if f.slowDown && key != "" {
+		f.slowDowns = append(f.slowDowns, time.Now())
+		writeError(w, http.StatusServiceUnavailable, "SlowDown")
+		return
+	}
+
+	payer := r.Header.Get("X-Amz-Request-Payer")
+
+	// Check conditional write header on object PUTs
+	// and multipart completions, this is only valid
+	// for the value "*", i.e. fail if key exists.
+	if cond := r.Header.Get("If-None-Match"); cond != "" &&
+		((r.Method == http.MethodPut && !query.Has("uploadId")) ||
+			(r.Method == http.MethodPost && query.Has("uploadId"))) {
+		if f.noConditional || cond != "*" {
+			writeError(w, http.StatusNotImplemented, "NotImplemented")
+			return
+		}
+		if _, ok := objects[key]; ok {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed")
+			return
+		}
+	}
+
+	switch {
+	// Bucket exists check.
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+
+	// List objects (v2).
+	case key == "" && r.Method == http.MethodGet && query.Get("list-type") == "2":
+		f.payers["list"] = payer
+		f.listMetadata = append(f.listMetadata, query.Get("metadata"))
+		keys := make([]string, 0, len(objects))
+		for key := range objects {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		fmt.Fprintf(w, `<ListBucketResult><Name>%s</Name><KeyCount>%d</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>`, bucket, len(keys))
+		for _, key := range keys {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><ETag>%s</ETag><LastModified>2006-01-02T15:04:05.000Z</LastModified><StorageClass>STANDARD</StorageClass></Contents>`, key, len(objects[key]), etag(objects[key]))
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+
+	// Initiate multipart upload.
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.nextID++
+		id := fmt.Sprintf("upload-%d", f.nextID)
+		f.uploads[id] = make(map[int][]byte)
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, bucket, key, id)
+
+	// Upload multipart part.
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			http.Error(w, "no such upload", http.StatusNotFound)
+			return
+		}
+		var n int
+		fmt.Sscan(query.Get("partNumber"), &n)
+		parts[n] = readBody(r)
+		w.Header().Set("ETag", etag(parts[n]))
+
+	// Complete multipart upload.
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			http.Error(w, "no such upload", http.StatusNotFound)
+			return
+		}
+		var data []byte
+		for i := 1; i <= len(parts); i++ {
+			data = append(data, parts[i]...)
+		}
+		delete(f.uploads, query.Get("uploadId"))
+		objects[key] = data
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>%s</ETag></CompleteMultipartUploadResult>`, bucket, key, etag(data))
+
+	// Abort multipart upload.
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	// Remove object.
+	case r.Method == http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	// Copy object (onto itself).
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		src := strings.TrimPrefix(r.Header.Get("X-Amz-Copy-Source"), "/")
+		if src != bucket+"/"+key {
+			http.Error(w, "only in-place copies supported", http.StatusNotImplemented)
+			return
+		}
+		data, ok := objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		if r.Header.Get("X-Amz-Metadata-Directive") != "REPLACE" {
+			http.Error(w, "only metadata replacement supported", http.StatusNotImplemented)
+			return
+		}
+		hdrs := objectHeaders(r.Header)
+		if r.Header.Get("X-Amz-Tagging-Directive") != "REPLACE" {
+			// Keep existing tags.
+			hdrs.Del("X-Amz-Tagging")
+			if tagging := f.headers[key].Get("X-Amz-Tagging"); tagging != "" {
+				hdrs.Set("X-Amz-Tagging", tagging)
+			}
+		}
+		f.headers[key] = hdrs
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+		fmt.Fprintf(w, `<CopyObjectResult><ETag>%s</ETag><LastModified>2006-01-02T15:04:05.000Z</LastModified></CopyObjectResult>`, etag(data))
+
+	// Put object.
+	case r.Method == http.MethodPut:
+		objects[key] = readBody(r)
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+		f.headers[key] = objectHeaders(r.Header)
+		w.Header().Set("ETag", etag(objects[key]))
+
+	// Get object.
+	case r.Method == http.MethodGet:
+		f.payers["get"] = payer
+		data, ok := objects[key]
+		if !ok {
+			http.Error(w, "no such key", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", etag(data))
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		_, _ = w.Write(data)
+
+	// Stat object.
+	case r.Method == http.MethodHead:
+		f.payers["stat"] = payer
+		data, ok := objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, v := range f.headers[key] {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Type", f.contentTypes[key])
+		w.Header().Set("ETag", etag(data))
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+
+	default:
+		http.Error(w, "not implemented", http.StatusNotImplemented)
+	}
+}
+
+// writeError writes an S3 XML
+// error response with code.
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+// objectHeaders returns the user metadata, tagging and
+// storage class headers from an object write request.
+func objectHeaders(h http.Header) http.Header {
+	hdrs := make(http.Header)
+	for k, v := range h {
+		if strings.HasPrefix(k, "X-Amz-Meta-") ||
+			k == "X-Amz-Tagging" ||
+			k == "X-Amz-Storage-Class" {
+			hdrs[k] = v
+		}
+	}
+	return hdrs
+}
+
+// readBody reads the request body, decoding it
+// from aws-chunked encoding where necessary.
+func readBody(r *http.Request) []byte {
+	b, _ := io.ReadAll(r.Body)
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return b
+	}
+
+	// Each chunk is in format:
+	// hex(size);chunk-signature=...\r\n<data>\r\n
+	var data []byte
+	for len(b) > 0 {
+		header, rest, _ := bytes.Cut(b, []byte("\r\n"))
+		size, _, _ := strings.Cut(string(header), ";")
+
+		var n int
+		fmt.Sscanf(size, "%x", &n)
+		if n == 0 || n > len(rest) {
+			break
+		}
+
+		data = append(data, rest[:n]...)
+		b = bytes.TrimPrefix(rest[n:], []byte("\r\n"))
+	}
+
+	return data
+}
+
+func etag(b []byte) string {
+	sum := md5.Sum(b)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// openFakeS3 opens S3 storage with given max object
+// size limit, against a new fake S3 test server.
+func openFakeS3(t *testing.T, maxObjectBytes int64) (*s3.S3Storage, *fakeS3) {
+	return openFakeS3Config(t, s3.Config{
+		PutChunkSize:   5 * 1024 * 1024, // 5MiB
+		MaxObjectBytes: maxObjectBytes,
+	})
+}
+
+// openFakeS3Config opens S3 storage with given
+// config against a new fake S3 test server.
+func openFakeS3Config(t *testing.T, cfg s3.Config) (*s3.S3Storage, *fakeS3) {
+	fake := newFakeS3()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	cfg.CoreOpts = minio.Options{
+		Creds:        credentials.NewStaticV4("access", "secret", ""),
+		Region:       "us-east-1",
+		BucketLookup: minio.BucketLookupPath,
+	}
+
+	st, err := s3.Open(strings.TrimPrefix(srv.URL, "http://"), testBucket, &cfg)
+	if err != nil {
+		t.Fatalf("error opening s3 storage: %v", err)
+	}
+
+	return st, fake
+}
+
+func TestS3WriteStreamTooLarge(t *testing.T) {
+	const mib = 1024 * 1024
+
+	for _, test := range []struct {
+		name  string
+		limit int64
+		size  int
+	}{
+		{"exceeded within first chunk", 1 * mib, 2 * mib},
+		{"exceeded after first chunk", 6 * mib, 6*mib + 1},
+		{"exceeded after many chunks", 16 * mib, 32 * mib},
+	} {
+		ctx := context.Background()
+		st, fake := openFakeS3(t, test.limit)
+
+		// Wrap data in a MultiReader to hide its
+		// size, forcing a (chunked) multipart upload.
+		data := bytes.Repeat([]byte{'a'}, test.size)
+		r := io.MultiReader(bytes.NewReader(data))
+
+		_, err := st.WriteStream(ctx, "too-large", r)
+		if !errors.Is(err, storage.ErrTooLarge) {
+			t.Fatalf("%s: expected ErrTooLarge, got: %v", test.name, err)
+		}
+
+		// Ensure nothing was left behind.
+		if len(fake.objects) != 0 {
+			t.Fatalf("%s: expected no objects, got %d", test.name, len(fake.objects))
+		}
+		if len(fake.uploads) != 0 {
+			t.Fatalf("%s: expected no pending uploads, got %d", test.name, len(fake.uploads))
+		}
+		if entry, err := st.Stat(ctx, "too-large"); err != nil || entry != nil {
+			t.Fatalf("%s: expected no object, got: %+v (err=%v)", test.name, entry, err)
+		}
+	}
+}
+
+func TestS3WriteStreamKnownSizeTooLarge(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3(t, 1024)
+
+	// Known size reader should be
+	// rejected before any upload.
+	_, err := st.WriteBytes(ctx, "too-large", make([]byte, 1025))
+	if !errors.Is(err, storage.ErrTooLarge) {
+		t.Fatalf("expected ErrTooLarge, got: %v", err)
+	}
+
+	if len(fake.objects) != 0 {
+		t.Fatalf("expected no objects, got %d", len(fake.objects))
+	}
+
+	// Within limit should succeed.
+	if _, err := st.WriteBytes(ctx, "small", make([]byte, 1024)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fake.objects["small"]) != 1024 {
+		t.Fatalf("expected 1024 byte object, got %d", len(fake.objects["small"]))
+	}
+}
+
+func TestS3WriteStreamWithinLimit(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3(t, 12*1024*1024)
+
+	// Multipart upload of
+	// exactly the limit.
+	data := bytes.Repeat([]byte{'b'}, 12*1024*1024)
+	n, err := st.WriteStream(ctx, "exact", io.MultiReader(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != int64(len(data)) || !bytes.Equal(fake.objects["exact"], data) {
+		t.Fatalf("object not stored correctly, wrote %d bytes", n)
+	}
+}
+
+func TestS3RequesterPays(t *testing.T) {
+	for _, requesterPays := range []bool{false, true} {
+		ctx := context.Background()
+		st, fake := openFakeS3Config(t, s3.Config{
+			RequesterPays: requesterPays,
+		})
+
+		// Put an object to read back.
+		fake.objects["some-key"] = []byte("hello world")
+
+		expect := ""
+		if requesterPays {
+			expect = "requester"
+		}
+
+		// Read the object.
+		if b, err := st.ReadBytes(ctx, "some-key"); err != nil {
+			t.Fatalf("unexpected error reading: %v", err)
+		} else if string(b) != "hello world" {
+			t.Fatalf("unexpected object contents: %s", b)
+		}
+
+		// Stat the object.
+		if entry, err := st.Stat(ctx, "some-key"); err != nil || entry == nil {
+			t.Fatalf("unexpected stat result: %+v (err=%v)", entry, err)
+		}
+
+		// List objects.
+		var keys []string
+		if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+			Step: func(entry storage.Entry) error {
+				keys = append(keys, entry.Key)
+				return nil
+			},
+		}); err != nil {
+			t.Fatalf("unexpected error walking keys: %v", err)
+		}
+		if len(keys) != 1 || keys[0] != "some-key" {
+			t.Fatalf("unexpected keys: %v", keys)
+		}
+
+		for _, op := range []string{"get", "stat", "list"} {
+			if payer := fake.payers[op]; payer != expect {
+				t.Errorf("requesterPays=%t: expected %s request payer %q, got %q", requesterPays, op, expect, payer)
+			}
+		}
+	}
+}
+
+func TestS3AutoDetectContentType(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3Config(t, s3.Config{
+		AutoDetectContentType: true,
+	})
+
+	// PNG signature followed by enough data
+	// that it spans past the peeked bytes.
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{'a'}, 1024)...)
+
+	for _, test := range []struct {
+		name string
+		r    io.Reader
+	}{
+		// Known size, single PutObject() call.
+		{"single", bytes.NewReader(png)},
+
+		// Wrap data in a MultiReader to hide its
+		// size, forcing a (chunked) multipart upload.
+		{"multipart", io.MultiReader(bytes.NewReader(png))},
+	} {
+		n, err := st.WriteStream(ctx, test.name, test.r)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if n != int64(len(png)) || !bytes.Equal(fake.objects[test.name], png) {
+			t.Fatalf("%s: object not stored correctly, wrote %d bytes", test.name, n)
+		}
+
+		if ctype := fake.contentTypes[test.name]; ctype != "image/png" {
+			t.Fatalf("%s: expected content-type image/png, got %q", test.name, ctype)
+		}
+	}
+}
+
+func TestS3WalkKeysFetchMetadata(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3Config(t, s3.Config{})
+	fake.objects["some-key"] = []byte("hello world")
+
+	walk := func(fetchMetadata bool) storage.Entry {
+		var entries []storage.Entry
+		if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+			Step: func(entry storage.Entry) error {
+				entries = append(entries, entry)
+				return nil
+			},
+			FetchMetadata: fetchMetadata,
+		}); err != nil {
+			t.Fatalf("fetchMetadata=%t: unexpected error walking keys: %v", fetchMetadata, err)
+		}
+		if len(entries) != 1 || entries[0].Key != "some-key" {
+			t.Fatalf("fetchMetadata=%t: unexpected entries: %+v", fetchMetadata, entries)
+		}
+		return entries[0]
+	}
+
+	// By default, the listing is lean
+	// and extended fields aren't set.
+	entry := walk(false)
+	if entry.Size != 11 ||
+		!entry.LastModified.IsZero() ||
+		entry.ETag != "" ||
+		entry.StorageClass != "" {
+		t.Fatalf("unexpected extended fields: %+v", entry)
+	}
+
+	// With metadata requested, they are.
+	entry = walk(true)
+	if entry.Size != 11 ||
+		!entry.LastModified.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)) ||
+		entry.ETag == "" ||
+		entry.StorageClass != "STANDARD" {
+		t.Fatalf("missing extended fields: %+v", entry)
+	}
+
+	// And the listing requested metadata too.
+	if len(fake.listMetadata) != 2 ||
+		fake.listMetadata[0] != "" ||
+		fake.listMetadata[1] != "true" {
+		t.Fatalf("unexpected list metadata params: %v", fake.listMetadata)
+	}
+}
+
+func TestS3WalkKeysConcurrent(t *testing.T) {
+	for _, requesterPays := range []bool{false, true} {
+		ctx := context.Background()
+		st, fake := openFakeS3Config(t, s3.Config{
+			RequesterPays: requesterPays,
+			ListSize:      10,
+		})
+
+		// Put some objects to walk.
+		for i := 0; i < 50; i++ {
+			fake.objects[fmt.Sprintf("key-%02d", i)] = []byte("hello world")
+		}
+
+		var (
+			keys   []string
+			keysMu sync.Mutex
+		)
+
+		if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+			Step: func(entry storage.Entry) error {
+				keysMu.Lock()
+				keys = append(keys, entry.Key)
+				keysMu.Unlock()
+				return nil
+			},
+			Concurrency: 4,
+		}); err != nil {
+			t.Fatalf("requesterPays=%t: unexpected error walking keys: %v", requesterPays, err)
+		}
+
+		// All keys should be walked, in any order.
+		slices.Sort(keys)
+		if len(keys) != 50 || keys[0] != "key-00" || keys[49] != "key-49" {
+			t.Fatalf("requesterPays=%t: unexpected keys: %v", requesterPays, keys)
+		}
+
+		// A step error should be returned from the walk.
+		errStep := errors.New("step error")
+		if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+			Step: func(entry storage.Entry) error {
+				if entry.Key == "key-25" {
+					return errStep
+				}
+				return nil
+			},
+			Concurrency: 4,
+		}); !errors.Is(err, errStep) {
+			t.Fatalf("requesterPays=%t: expected step error, got: %v", requesterPays, err)
+		}
+	}
+}
+
+func TestS3BucketRouter(t *testing.T) {
+	const videoBucket = "gotosocial-video"
+
+	ctx := context.Background()
+	st, fake := openFakeS3Config(t, s3.Config{
+		BucketRouter: func(key string) string {
+			if strings.HasPrefix(key, "video/") {
+				return videoBucket
+			}
+			return testBucket
+		},
+	})
+
+	// Add the second bucket.
+	videos := make(map[string][]byte)
+	fake.buckets[videoBucket] = videos
+
+	// Write one object to each bucket.
+	for _, key := range []string{"image/some-key", "video/some-key"} {
+		if _, err := st.WriteBytes(ctx, key, []byte(key)); err != nil {
+			t.Fatalf("unexpected error writing %s: %v", key, err)
+		}
+	}
+
+	if _, ok := fake.objects["image/some-key"]; !ok || len(fake.objects) != 1 {
+		t.Fatalf("unexpected objects in default bucket: %v", fake.objects)
+	}
+
+	if _, ok := videos["video/some-key"]; !ok || len(videos) != 1 {
+		t.Fatalf("unexpected objects in video bucket: %v", videos)
+	}
+
+	// Read, stat and remove from the routed bucket.
+	if b, err := st.ReadBytes(ctx, "video/some-key"); err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	} else if string(b) != "video/some-key" {
+		t.Fatalf("unexpected object contents: %s", b)
+	}
+
+	if entry, err := st.Stat(ctx, "video/some-key"); err != nil || entry == nil {
+		t.Fatalf("unexpected stat result: %+v (err=%v)", entry, err)
+	}
+
+	if err := st.Remove(ctx, "video/some-key"); err != nil {
+		t.Fatalf("unexpected error removing: %v", err)
+	} else if len(videos) != 0 {
+		t.Fatalf("unexpected objects in video bucket after remove: %v", videos)
+	}
+
+	// Put it back for walking.
+	videos["video/some-key"] = []byte("video/some-key")
+
+	// Walking keys only walks the default bucket,
+	// other buckets have to be walked by name.
+	for bucket, expect := range map[string]string{
+		testBucket:  "image/some-key",
+		videoBucket: "video/some-key",
+	} {
+		var keys []string
+		if err := st.WalkBucketKeys(ctx, bucket, storage.WalkKeysOpts{
+			Step: func(entry storage.Entry) error {
+				keys = append(keys, entry.Key)
+				return nil
+			},
+		}); err != nil {
+			t.Fatalf("unexpected error walking %s: %v", bucket, err)
+		}
+		if len(keys) != 1 || keys[0] != expect {
+			t.Fatalf("unexpected keys in %s: %v", bucket, keys)
+		}
+	}
+}
+
+func TestS3WriteIfAbsent(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3(t, 0)
+
+	for _, test := range []struct {
+		name string
+		data func() io.Reader
+	}{
+		// Known size, single PUT.
+		{"known size", func() io.Reader {
+			return bytes.NewReader([]byte("new data"))
+		}},
+
+		// Wrap data in a MultiReader to hide its
+		// size, forcing a (chunked) multipart upload.
+		{"unknown size", func() io.Reader {
+			return io.MultiReader(bytes.NewReader([]byte("new data")))
+		}},
+	} {
+		key := strings.ReplaceAll(test.name, " ", "-")
+
+		// Key is absent, write should succeed.
+		n, err := st.WriteIfAbsent(ctx, key, test.data())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if n != 8 || string(fake.objects[key]) != "new data" {
+			t.Fatalf("%s: object not stored correctly, wrote %d bytes", test.name, n)
+		}
+
+		// Change the stored data.
+		fake.objects[key] = []byte("old data")
+
+		// Key now exists, write should be rejected.
+		_, err = st.WriteIfAbsent(ctx, key, test.data())
+		if !errors.Is(err, storage.ErrAlreadyExists) {
+			t.Fatalf("%s: expected ErrAlreadyExists, got: %v", test.name, err)
+		}
+
+		// Ensure existing object was not overwritten,
+		// and that nothing was left behind.
+		if string(fake.objects[key]) != "old data" {
+			t.Fatalf("%s: object overwritten: %s", test.name, fake.objects[key])
+		}
+		if len(fake.uploads) != 0 {
+			t.Fatalf("%s: expected no pending uploads, got %d", test.name, len(fake.uploads))
+		}
+
+		// Regular write should still overwrite.
+		if _, err := st.WriteStream(ctx, key, test.data()); err != nil {
+			t.Fatalf("%s: unexpected error overwriting: %v", test.name, err)
+		}
+		if string(fake.objects[key]) != "new data" {
+			t.Fatalf("%s: object not overwritten: %s", test.name, fake.objects[key])
+		}
+	}
+}
+
+func TestS3WriteIfAbsentUnsupported(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3(t, 0)
+	fake.noConditional = true
+
+	_, err := st.WriteIfAbsent(ctx, "some-key", bytes.NewReader([]byte("data")))
+	if !errors.Is(err, s3.ErrConditionalWriteUnsupported) {
+		t.Fatalf("expected ErrConditionalWriteUnsupported, got: %v", err)
+	}
+
+	if len(fake.objects) != 0 {
+		t.Fatalf("expected no objects, got %d", len(fake.objects))
+	}
+}
+
+func TestS3RateLimitSlowDown(t *testing.T) {
+	ctx := context.Background()
+
+	// Don't let the S3 client retry
+	// internally, so that each read
+	// makes exactly one request.
+	maxRetry := minio.MaxRetry
+	minio.MaxRetry = 1
+	t.Cleanup(func() { minio.MaxRetry = maxRetry })
+
+	st, fake := openFakeS3Config(t, s3.Config{
+		RateLimit: s3.RateLimit{
+			Initial: 1000,
+			Min:     20,
+			Max:     1000,
+		},
+	})
+
+	fake.mu.Lock()
+	fake.slowDown = true
+	fake.mu.Unlock()
+
+	// Read concurrently, all operations
+	// should share the one rate limit.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 6; j++ {
+				_, _ = st.ReadBytes(ctx, "some-key")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Permitted rate should have
+	// backed off to (near) minimum.
+	if rate := st.RequestRate(); rate > 40 {
+		t.Fatalf("expected rate to back off, got %f", rate)
+	}
+
+	fake.mu.Lock()
+	times := slices.Clone(fake.slowDowns)
+	fake.slowDown = false
+	fake.mu.Unlock()
+
+	if len(times) != 24 {
+		t.Fatalf("expected 24 requests, got %d", len(times))
+	}
+
+	// issueRate returns the rate (requests/s)
+	// at which the given requests were received.
+	issueRate := func(times []time.Time) float64 {
+		span := times[len(times)-1].Sub(times[0])
+		return float64(len(times)-1) / max(span, time.Microsecond).Seconds()
+	}
+
+	// Compare rate of first requests
+	// (before any SlowDown) against
+	// the last, once backed off.
+	first := issueRate(times[:4])
+	last := issueRate(times[len(times)-6:])
+	if last > 30 || last > first/10 {
+		t.Fatalf("expected issue rate to drop, first=%f last=%f", first, last)
+	}
+
+	// Requests now succeed (albeit
+	// not found), rate should recover.
+	before := st.RequestRate()
+	for i := 0; i < 10; i++ {
+		_, _ = st.ReadBytes(ctx, "some-key")
+	}
+	if after := st.RequestRate(); after <= before {
+		t.Fatalf("expected rate to recover, before=%f after=%f", before, after)
+	}
+}
+
+func TestS3UpdateMetadata(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openFakeS3(t, 0)
+
+	const key = "some-key"
+	data := []byte("some data")
+
+	if _, err := st.WriteBytes(ctx, key, data); err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+	contentType := fake.contentTypes[key]
+
+	// Replace metadata, tags and storage class.
+	err := st.UpdateMetadata(ctx, key,
+		map[string]string{"cache": "long"},
+		map[string]string{"tier": "cold"},
+		"STANDARD_IA",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error updating metadata: %v", err)
+	}
+
+	hdrs := fake.headers[key]
+	if hdrs.Get("X-Amz-Meta-Cache") != "long" ||
+		hdrs.Get("X-Amz-Tagging") != "tier=cold" ||
+		hdrs.Get("X-Amz-Storage-Class") != "STANDARD_IA" {
+		t.Fatalf("metadata not replaced: %v", hdrs)
+	}
+	if fake.contentTypes[key] != contentType {
+		t.Fatalf("content-type changed from %s to %s", contentType, fake.contentTypes[key])
+	}
+
+	// Ensure the object itself was left as-is.
+	entry, err := st.Stat(ctx, key)
+	if err != nil {
+		t.Fatalf("unexpected error stating: %v", err)
+	}
+	if entry.Size != int64(len(data)) || !bytes.Equal(fake.objects[key], data) {
+		t.Fatalf("object changed, now has size %d", entry.Size)
+	}
+
+	// Replace metadata only, existing
+	// tags and storage class are kept.
+	err = st.UpdateMetadata(ctx, key,
+		map[string]string{"cache": "short"},
+		nil,
+		"",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error updating metadata: %v", err)
+	}
+
+	hdrs = fake.headers[key]
+	if hdrs.Get("X-Amz-Meta-Cache") != "short" ||
+		hdrs.Get("X-Amz-Tagging") != "tier=cold" ||
+		hdrs.Get("X-Amz-Storage-Class") != "STANDARD_IA" {
+		t.Fatalf("metadata not replaced correctly: %v", hdrs)
+	}
+
+	// Updating a missing object should fail.
+	err = st.UpdateMetadata(ctx, "missing", nil, nil, "GLACIER")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got: %v", err)
+	}
+}
@@ -46,6 +46,10 @@ const (
 	// EventTypeFiltersChanged -- the user's filters
 	// (including keywords and statuses) have changed.
 	EventTypeFiltersChanged = "filters_changed"
+
+	// EventTypeNotificationsCleared -- some or all of
+	// the user's notifications have been dismissed.
+	EventTypeNotificationsCleared = "notifications.cleared"
 )
 
 const (
@@ -143,7 +143,7 @@ func (cr *customRenderer) renderMention(
 // or '@localusername', and does the following:
 //
 //   - Parse the mention string into a *gtsmodel.Mention.
-//   - Insert mention into database if necessary.
+//   - Insert mention into database if necessary (and not a dry run).
 //   - Add mention to cr.results.Mentions slice.
 //   - Return mention rendered as nice HTML.
 //
@@ -156,7 +156,7 @@ func (cr *customRenderer) handleMention(text string) string {
 		return text
 	}
 
-	if cr.statusID != "" {
+	if cr.statusID != "" && !gtscontext.DryRun(cr.ctx) {
 		if err := cr.db.PutMention(cr.ctx, mention); err != nil {
 			log.Errorf(cr.ctx, "error putting mention in db: %s", err)
 			return text
@@ -248,7 +248,7 @@ func (cr *customRenderer) renderHashtag(
 // and does the following:
 //
 //   - Normalize + validate the hashtag.
-//   - Get or create hashtag in the db.
+//   - Get or create hashtag in the db (only get on dry runs).
 //   - Add hashtag to cr.results.Tags slice.
 //   - Return hashtag rendered as nice HTML.
 //
@@ -278,11 +278,20 @@ func (cr *customRenderer) handleHashtag(text string) string {
 			return tag, nil
 		}
 
-		// We didn't have a tag with
-		// this name, create one.
+		// We didn't have a tag with this name, create
+		// one, keeping the spelling used in this status
+		// as the display name. Name will be folded by db.
 		tag = &gtsmodel.Tag{
-			ID:   id.NewULID(),
-			Name: name,
+			ID:          id.NewULID(),
+			Name:        name,
+			DisplayName: name,
+		}
+
+		if gtscontext.DryRun(cr.ctx) {
+			// Don't store the tag on dry
+			// runs, just fold as db would.
+			tag.Name = FoldHashtag(name)
+			return tag, nil
 		}
 
 		if err = cr.db.PutTag(cr.ctx, tag); err != nil {
@@ -317,9 +326,12 @@ func (cr *customRenderer) handleHashtag(text string) string {
 
 	// Replace tag with the formatted tag content, eg. `#SomeHashtag` becomes:
 	// `<a href="https://example.org/tags/somehashtag" class="mention hashtag" rel="tag">#<span>SomeHashtag</span></a>`
+	//
+	// The href uses the canonical (folded) tag name,
+	// while the text keeps the spelling used by the author.
 	var b strings.Builder
 	b.WriteString(`<a href="`)
-	b.WriteString(uris.URIForTag(normalized))
+	b.WriteString(uris.URIForTag(tag.Name))
 	b.WriteString(`" class="mention hashtag" rel="tag">#<span>`)
 	b.WriteString(normalized)
 	b.WriteString(`</span></a>`)
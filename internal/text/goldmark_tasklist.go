@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	mdutil "github.com/yuin/goldmark/util"
+)
+
+// taskList fulfils the following goldmark interfaces:
+//
+//   - renderer.NodeRenderer
+//   - goldmark.Extender.
+//
+// It parses GFM task list items just like goldmark's own
+// extension.TaskList, but renders the checkboxes as unicode
+// ballot box characters instead of <input> elements, which
+// would be stripped by our sanitizer (and most clients).
+type taskList struct{}
+
+func (t *taskList) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(extast.KindTaskCheckBox, t.renderTaskCheckBox)
+}
+
+func (t *taskList) Extend(markdown goldmark.Markdown) {
+	markdown.Parser().AddOptions(parser.WithInlineParsers(
+		mdutil.Prioritized(extension.NewTaskCheckBoxParser(), 0),
+	))
+	markdown.Renderer().AddOptions(renderer.WithNodeRenderers(
+		mdutil.Prioritized(t, 500),
+	))
+}
+
+// renderTaskCheckBox takes a task
+// checkbox ast.Node and renders it.
+func (t *taskList) renderTaskCheckBox(
+	w mdutil.BufWriter,
+	source []byte,
+	node ast.Node,
+	entering bool,
+) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*extast.TaskCheckBox)
+	if n.IsChecked {
+		_, _ = w.WriteString("☑ ")
+	} else {
+		_, _ = w.WriteString("☐ ")
+	}
+
+	return ast.WalkContinue, nil
+}
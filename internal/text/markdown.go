@@ -22,12 +22,15 @@ import (
 	"context"
 
 	"codeberg.org/gruf/go-byteutil"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/regexes"
 	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/renderer/html"
+	mdutil "github.com/yuin/goldmark/util"
 )
 
 // FromMarkdown fulfils FormatFunc by parsing
@@ -67,6 +70,26 @@ func (f *Formatter) FromMarkdown(
 				extension.WithLinkifyURLRegexp(regexes.LinkScheme),
 			),
 			extension.Strikethrough,
+			extension.Table,
+			new(taskList),
+			extension.NewFootnote(
+				// Prefix footnote IDs with the status
+				// ID, so they don't clash when several
+				// statuses are shown on the same page.
+				extension.WithFootnoteIDPrefix(statusID+"-"),
+			),
+			// Syntax highlights fenced code blocks
+			// with a known language using classes
+			// (see web/source/css/chroma.css), as
+			// the sanitizer strips inline styles.
+			highlighting.NewHighlighting(
+				highlighting.WithFormatOptions(
+					chromahtml.PreventSurroundingPre(true),
+					chromahtml.WithClasses(true),
+					chromahtml.ClassPrefix("hl-"),
+				),
+				highlighting.WithWrapperRenderer(renderCodeBlockWrapper),
+			),
 		),
 	)
 
@@ -85,8 +108,31 @@ func (f *Formatter) FromMarkdown(
 
 	// Clean and shrink HTML.
 	result.HTML = byteutil.B2S(htmlBytes.Bytes())
-	result.HTML = SanitizeToHTML(result.HTML)
+	result.HTML = sanitizeMarkdownHTML(result.HTML)
 	result.HTML = MinifyHTML(result.HTML)
 
 	return result
 }
+
+// renderCodeBlockWrapper renders the <pre><code> wrapper
+// of highlighted code blocks, in the same form as goldmark
+// renders unhighlighted code blocks, ie., with the language
+// class on the code element (used by the web frontend).
+func renderCodeBlockWrapper(
+	w mdutil.BufWriter,
+	ctx highlighting.CodeBlockContext,
+	entering bool,
+) {
+	if !entering {
+		_, _ = w.WriteString("</code></pre>\n")
+		return
+	}
+
+	_, _ = w.WriteString("<pre><code")
+	if lang, ok := ctx.Language(); ok {
+		_, _ = w.WriteString(` class="language-`)
+		_, _ = w.Write(mdutil.EscapeHTML(lang))
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+}
@@ -43,7 +43,7 @@ that was some JSON :)
 const (
 	simpleMarkdown                  = "# Title\n\nHere's a simple text in markdown.\n\nHere's a [link](https://example.org)."
 	simpleMarkdownExpected          = "<h1>Title</h1><p>Here's a simple text in markdown.</p><p>Here's a <a href=\"https://example.org\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">link</a>.</p>"
-	withCodeBlockExpected           = "<h1>Title</h1><p>Below is some JSON.</p><pre><code class=\"language-json\">{\n  &#34;key&#34;: &#34;value&#34;,\n  &#34;another_key&#34;: [\n    &#34;value1&#34;,\n    &#34;value2&#34;\n  ]\n}\n</code></pre><p>that was some JSON :)</p>"
+	withCodeBlockExpected           = "<h1>Title</h1><p>Below is some JSON.</p><pre><code class=\"language-json\"><span class=\"hl-p\">{</span>\n  <span class=\"hl-nt\">&#34;key&#34;</span><span class=\"hl-p\">:</span> <span class=\"hl-s2\">&#34;value&#34;</span><span class=\"hl-p\">,</span>\n  <span class=\"hl-nt\">&#34;another_key&#34;</span><span class=\"hl-p\">:</span> <span class=\"hl-p\">[</span>\n    <span class=\"hl-s2\">&#34;value1&#34;</span><span class=\"hl-p\">,</span>\n    <span class=\"hl-s2\">&#34;value2&#34;</span>\n  <span class=\"hl-p\">]</span>\n<span class=\"hl-p\">}</span>\n</code></pre><p>that was some JSON :)</p>"
 	withInlineCode                  = "`Nobody tells you about the <code><del>SECRET CODE</del></code>, do they?`"
 	withInlineCodeExpected          = "<p><code>Nobody tells you about the &lt;code>&lt;del>SECRET CODE&lt;/del>&lt;/code>, do they?</code></p>"
 	withInlineCode2                 = "`Nobody tells you about the </code><del>SECRET CODE</del><code>, do they?`"
@@ -59,7 +59,13 @@ const (
 	mdCodeBlockWithNewlines         = "some code coming up\n\n```\n\n\n\n```\nthat was some code"
 	mdCodeBlockWithNewlinesExpected = "<p>some code coming up</p><pre><code>\n\n\n</code></pre><p>that was some code</p>"
 	mdWithFootnote                  = "fox mulder,fbi.[^1]\n\n[^1]: federated bureau of investigation"
-	mdWithFootnoteExpected          = "<p>fox mulder,fbi.[^1]</p><p>[^1]: federated bureau of investigation</p>"
+	mdWithFootnoteExpected          = "<p>fox mulder,fbi.<sup id=\"dummy_status_ID-fnref:1\"><a href=\"#dummy_status_ID-fn:1\" class=\"footnote-ref\" rel=\"noreferrer\">1</a></sup></p><div><hr><ol><li id=\"dummy_status_ID-fn:1\"><p>federated bureau of investigation\u00a0<a href=\"#dummy_status_ID-fnref:1\" class=\"footnote-backref\" rel=\"noreferrer\">↩︎</a></p></li></ol></div>"
+	mdWithRelativeLink              = "see <a href=\"/settings/admin\">here</a> or [there](/settings/user)"
+	mdWithRelativeLinkExpected      = "<p>see here or there</p>"
+	mdWithTable                     = "| planet | moons |\n| :--- | ---: |\n| earth | 1 |\n| mars | 2 |"
+	mdWithTableExpected             = "<table><thead><tr><th align=\"left\">planet</th><th align=\"right\">moons</th></tr></thead><tbody><tr><td align=\"left\">earth</td><td align=\"right\">1</td></tr><tr><td align=\"left\">mars</td><td align=\"right\">2</td></tr></tbody></table>"
+	mdWithTaskList                  = "todo:\n\n- [x] write code\n- [ ] write tests"
+	mdWithTaskListExpected          = "<p>todo:</p><ul><li>☑ write code</li><li>☐ write tests</li></ul>"
 	mdWithBlockQuote                = "get ready, there's a block quote coming:\n\n>line1\n>line2\n>\n>line3\n\n"
 	mdWithBlockQuoteExpected        = "<p>get ready, there's a block quote coming:</p><blockquote><p>line1<br>line2</p><p>line3</p></blockquote>"
 	mdHashtagAndCodeBlock           = "#Hashtag\n\n```\n#Hashtag\n```"
@@ -89,7 +95,7 @@ const (
 	// BEWARE: sneaky unicode business going on.
 	// the first ö is one rune, the second ö is an o with a combining diacritic.
 	mdUnnormalizedHashtag         = "#hellöthere #hellöthere"
-	mdUnnormalizedHashtagExpected = "<p><a href=\"http://localhost:8080/tags/hell%C3%B6there\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>hellöthere</span></a> <a href=\"http://localhost:8080/tags/hell%C3%B6there\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>hellöthere</span></a></p>"
+	mdUnnormalizedHashtagExpected = "<p><a href=\"http://localhost:8080/tags/hellothere\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>hellöthere</span></a> <a href=\"http://localhost:8080/tags/hellothere\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>hellöthere</span></a></p>"
 )
 
 type MarkdownTestSuite struct {
@@ -146,6 +152,21 @@ func (suite *MarkdownTestSuite) TestParseWithFootnote() {
 	suite.Equal(mdWithFootnoteExpected, formatted.HTML)
 }
 
+func (suite *MarkdownTestSuite) TestParseWithRelativeLink() {
+	formatted := suite.FromMarkdown(mdWithRelativeLink)
+	suite.Equal(mdWithRelativeLinkExpected, formatted.HTML)
+}
+
+func (suite *MarkdownTestSuite) TestParseWithTable() {
+	formatted := suite.FromMarkdown(mdWithTable)
+	suite.Equal(mdWithTableExpected, formatted.HTML)
+}
+
+func (suite *MarkdownTestSuite) TestParseWithTaskList() {
+	formatted := suite.FromMarkdown(mdWithTaskList)
+	suite.Equal(mdWithTaskListExpected, formatted.HTML)
+}
+
 func (suite *MarkdownTestSuite) TestParseWithBlockquote() {
 	formatted := suite.FromMarkdown(mdWithBlockQuote)
 	suite.Equal(mdWithBlockQuoteExpected, formatted.HTML)
@@ -19,7 +19,9 @@ package text
 
 import (
 	"strings"
+	"unicode"
 
+	"golang.org/x/text/cases"
 	"golang.org/x/text/unicode/norm"
 )
 
@@ -76,3 +78,56 @@ func NormalizeHashtag(text string) (string, bool) {
 
 	return normalized, (lengthOK && onlyPermittedChars && notJustUnderscores)
 }
+
+// FoldHashtag returns the canonical form of the given hashtag
+// name, which is used as the key when storing and looking up
+// tags, so that hashtags which differ only in case, character
+// width, or diacritics on Latin letters are treated as the
+// same tag (eg., #Café, #cafe, and #ＣＡＦＥ all fold to "cafe").
+//
+// The name is first normalized using Normalization Form KC,
+// which folds compatibility characters like full-width Latin
+// letters and half-width katakana into their regular forms, and
+// then case folded. Combining marks are then stripped from Latin
+// letters only, as in other scripts (eg., Japanese dakuten) they
+// change the meaning of the letter they're combined with. Note
+// that Turkish dotless i (ı) is a distinct letter, and so is not
+// folded into i, though dotted capital İ is folded into i.
+//
+// The input should already have been validated using NormalizeHashtag,
+// as FoldHashtag simply drops any chars not permitted in hashtags.
+func FoldHashtag(name string) string {
+	name = strings.TrimPrefix(name, "#")
+	name = norm.NFKC.String(name)
+	name = cases.Fold().String(name)
+
+	// Decompose the folded name so that
+	// combining marks can be considered
+	// separately from their base letters.
+	decomposed := norm.NFD.String(name)
+
+	var (
+		b     strings.Builder
+		latin bool // prev base char was Latin
+	)
+
+	b.Grow(len(decomposed))
+	for _, r := range decomposed {
+		if unicode.IsMark(r) {
+			if !latin {
+				// Keep marks on
+				// non-Latin chars.
+				b.WriteRune(r)
+			}
+			continue
+		}
+
+		latin = unicode.Is(unicode.Latin, r)
+		if isPermittedInHashtag(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	// Recompose any remaining marks.
+	return norm.NFC.String(b.String())
+}
@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type NormalizeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *NormalizeTestSuite) TestFoldHashtag() {
+	for _, test := range []struct {
+		in       string
+		expected string
+	}{
+		// Case, hash prefix, Latin diacritics.
+		{in: "#Welcome", expected: "welcome"},
+		{in: "Café", expected: "cafe"},
+		{in: "CAFÉ", expected: "cafe"},
+		{in: "café", expected: "cafe"},
+		{in: "Straße", expected: "strasse"},
+
+		// Full-width Latin + half-width katakana.
+		{in: "ＧｏＴｏＳｏｃｉａｌ", expected: "gotosocial"},
+		{in: "ｶﾞｯｺｳ", expected: "ガッコウ"},
+		{in: "ガッコウ", expected: "ガッコウ"},
+
+		// Dakuten are meaningful and must be kept.
+		{in: "がっこう", expected: "がっこう"},
+		{in: "日本語", expected: "日本語"},
+
+		// Turkish dotted capital I folds to i,
+		// but dotless i is a distinct letter.
+		{in: "İstanbul", expected: "istanbul"},
+		{in: "ISTANBUL", expected: "istanbul"},
+		{in: "ılık", expected: "ılık"},
+	} {
+		suite.Equal(test.expected, text.FoldHashtag(test.in), test.in)
+	}
+}
+
+func (suite *NormalizeTestSuite) TestFoldHashtagDistinct() {
+	// Dotless i must not be merged with i.
+	suite.NotEqual(text.FoldHashtag("ılık"), text.FoldHashtag("ilik"))
+
+	// Dakuten must not be stripped.
+	suite.NotEqual(text.FoldHashtag("が"), text.FoldHashtag("か"))
+}
+
+func (suite *NormalizeTestSuite) TestFoldHashtagIdempotent() {
+	for _, in := range []string{
+		"Café",
+		"ｶﾞｯｺｳ",
+		"İstanbul",
+		"ılık",
+	} {
+		folded := text.FoldHashtag(in)
+		suite.Equal(folded, text.FoldHashtag(folded), in)
+	}
+}
+
+func (suite *NormalizeTestSuite) TestNormalizeHashtagEmoji() {
+	// Emoji aren't permitted in
+	// hashtags, so these are invalid.
+	for _, in := range []string{
+		"#party🎉",
+		"#🎉",
+		"#日本🗾",
+	} {
+		_, ok := text.NormalizeHashtag(in)
+		suite.False(ok, in)
+	}
+
+	// Emoji can never make
+	// it into a folded name.
+	suite.Equal("party", text.FoldHashtag("party🎉"))
+}
+
+func TestNormalizeTestSuite(t *testing.T) {
+	suite.Run(t, new(NormalizeTestSuite))
+}
@@ -121,15 +121,22 @@ func (suite *PlainTestSuite) TestDeriveHashtagsOK() {
 	suite.Equal("also", tags[1].Name)
 	suite.Equal("thisshouldwork", tags[2].Name)
 	suite.Equal("dupe", tags[3].Name)
-	suite.Equal("ThisShouldAlsoWork", tags[4].Name)
+	suite.Equal("thisshouldalsowork", tags[4].Name)
 	suite.Equal("this_should_not_be_split", tags[5].Name)
 	suite.Equal("111111", tags[6].Name)
-	suite.Equal("alimentación", tags[7].Name)
-	suite.Equal("saúde", tags[8].Name)
-	suite.Equal("lävistää", tags[9].Name)
-	suite.Equal("ö", tags[10].Name)
+	suite.Equal("alimentacion", tags[7].Name)
+	suite.Equal("saude", tags[8].Name)
+	suite.Equal("lavistaa", tags[9].Name)
+	suite.Equal("o", tags[10].Name)
 	suite.Equal("네", tags[11].Name)
-	suite.Equal("ThisOneIsThirteyCharactersLong", tags[12].Name)
+	suite.Equal("thisoneisthirteycharacterslong", tags[12].Name)
+
+	// Tags keep the spelling used
+	// in the status for display.
+	suite.Equal("ThisShouldAlsoWork", tags[4].DisplayName)
+	suite.Equal("alimentación", tags[7].DisplayName)
+	suite.Equal("ö", tags[10].DisplayName)
+	suite.Equal("ThisOneIsThirteyCharactersLong", tags[12].DisplayName)
 
 	statusText = `#올빼미 hej`
 	tags = suite.FromPlain(statusText).Tags
@@ -28,7 +28,21 @@ import (
 // Regular HTML policy is an adapted version of the default
 // bluemonday UGC policy, with some tweaks of our own.
 // See: https://github.com/microcosm-cc/bluemonday#usage
-var regular *bluemonday.Policy = func() *bluemonday.Policy {
+var regular *bluemonday.Policy = newRegularPolicy(false)
+
+// Markdown HTML policy is the regular HTML policy, used for
+// HTML rendered from local markdown, additionally permitting
+// the in-status fragment links that footnotes are rendered with.
+var markdown *bluemonday.Policy = newRegularPolicy(true)
+
+// footnoteHref matches hrefs of footnote links and backlinks
+// rendered by goldmark, eg., "#01F8MH75CBF9JFX4ZAD54N0W0R-fn:1"
+// and "#01F8MH75CBF9JFX4ZAD54N0W0R-fnref:1", or an absolute URL.
+var footnoteHref = regexp.MustCompile(`^((?i:https?|mailto):|#[\w-]*fn(ref[0-9]*)?:[0-9]+$)`)
+
+// newRegularPolicy returns a new regular HTML policy,
+// optionally permitting the fragment links of footnotes.
+func newRegularPolicy(footnotes bool) *bluemonday.Policy {
 	p := bluemonday.NewPolicy()
 
 	// AllowStandardAttributes will enable "id", "title" and
@@ -121,12 +135,30 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 	// Don't sanitize HTML inside code blocks.
 	p.SkipElementsContent("code", "pre")
 
+	/*
+		TABLES
+	*/
+
+	// Enable tables, including
+	// cell alignment attributes.
+	p.AllowTables()
+
 	/*
 		LINKS AND LINK SAFETY.
 	*/
 
 	// Permit hyperlinks.
-	p.AllowAttrs("class", "href", "rel").OnElements("a")
+	p.AllowAttrs("class", "rel").OnElements("a")
+	if footnotes {
+		// Relative URLs must be permitted for
+		// footnote links to pass URL checks, so
+		// restrict hrefs to absolute URLs and
+		// the footnote fragment links only.
+		p.AllowAttrs("href").Matching(footnoteHref).OnElements("a")
+		p.AllowRelativeURLs(true)
+	} else {
+		p.AllowAttrs("href").OnElements("a")
+	}
 
 	// URLs must be parseable by net/url.Parse().
 	p.RequireParseableURLs(true)
@@ -151,7 +183,7 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 	p.AddTargetBlankToFullyQualifiedLinks(true)
 
 	return p
-}()
+}
 
 // '[C]an be thought of as equivalent to stripping all HTML
 // elements and their attributes as it has nothing on its allowlist.
@@ -175,6 +207,13 @@ func SanitizeToHTML(in string) string {
 	return regular.Sanitize(in)
 }
 
+// sanitizeMarkdownHTML sanitizes only risky html elements
+// from HTML rendered from local markdown, allowing safe ones
+// (including footnote links) through.
+func sanitizeMarkdownHTML(in string) string {
+	return markdown.Sanitize(in)
+}
+
 // SanitizeToPlaintext runs text through basic sanitization.
 // This removes any html elements that were in the string,
 // and returns clean plaintext.
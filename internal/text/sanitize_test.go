@@ -128,6 +128,18 @@ func (suite *SanitizeTestSuite) TestSanitizeInlineImg() {
 	suite.Equal(`<p>Here&#39;s an inline image: </p>`, sanitized)
 }
 
+func (suite *SanitizeTestSuite) TestSanitizeRelativeLinks() {
+	withRelativeLinks := `<p><a href="/settings/admin">click me</a> and <a href="#01F8MH75CBF9JFX4ZAD54N0W0R-fn:1" class="footnote-ref">1</a></p>`
+	sanitized := text.SanitizeToHTML(withRelativeLinks)
+	suite.Equal(`<p>click me and <a class="footnote-ref">1</a></p>`, sanitized)
+}
+
+func (suite *SanitizeTestSuite) TestSanitizeSpanStyle() {
+	withSpanStyle := `<p><span style="color: #ff0000; font-weight: bold">look at me</span></p>`
+	sanitized := text.SanitizeToHTML(withSpanStyle)
+	suite.Equal(`<p><span>look at me</span></p>`, sanitized)
+}
+
 func TestSanitizeTestSuite(t *testing.T) {
 	suite.Run(t, new(SanitizeTestSuite))
 }
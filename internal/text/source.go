@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"golang.org/x/net/html"
+)
+
+// HTMLToSource reconstructs approximate source text from the
+// given status HTML content, as produced by our own formatter.
+// This is useful for statuses with no stored source text, e.g.
+// those created before source text was stored, or migrated.
+//
+// Mentions are de-rendered back to their full @user@domain handle
+// where the target account can be found in the given mentions,
+// hashtags back to #hashtag, and custom emojis to :shortcode:.
+// Paragraphs are separated with blank lines, and line breaks
+// converted to newlines. All other markup is stripped.
+func HTMLToSource(in string, mentions []*gtsmodel.Mention) string {
+	var (
+		b strings.Builder
+		z = html.NewTokenizer(strings.NewReader(in))
+
+		// skip is the current depth of <a> tags
+		// whose text content we're skipping,
+		// i.e. replaced by a mention handle.
+		skip int
+	)
+
+	// block starts a new block of text,
+	// separated from any previous by sep.
+	block := func(sep string) {
+		if b.Len() == 0 {
+			return
+		}
+		s := b.String()
+		s = strings.TrimRight(s, " ")
+		if !strings.HasSuffix(s, sep) {
+			b.Reset()
+			b.WriteString(strings.TrimRight(s, "\n"))
+			b.WriteString(sep)
+		}
+	}
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// Finished.
+			return strings.TrimSpace(b.String())
+
+		case html.TextToken:
+			if skip == 0 {
+				b.Write(z.Text())
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			attrs := tagAttrs(z, hasAttr)
+
+			if skip > 0 {
+				if string(name) == "a" && tt == html.StartTagToken {
+					skip++
+				}
+				continue
+			}
+
+			switch string(name) {
+			case "br":
+				b.WriteByte('\n')
+
+			case "p", "div", "blockquote", "pre",
+				"ul", "ol", "h1", "h2", "h3",
+				"h4", "h5", "h6", "hr":
+				block("\n\n")
+
+			case "li":
+				block("\n")
+
+			case "img":
+				// Custom emojis are rendered as
+				// images with shortcode as alt text.
+				if alt := attrs["alt"]; strings.HasPrefix(alt, ":") {
+					b.WriteString(alt)
+				}
+
+			case "a":
+				classes := strings.Fields(attrs["class"])
+				if !slices.Contains(classes, "mention") ||
+					slices.Contains(classes, "hashtag") {
+					// Hashtags and links
+					// are used as-is.
+					continue
+				}
+
+				// Look for full handle of mentioned account.
+				handle := mentionHandle(attrs["href"], mentions)
+				if handle != "" && tt == html.StartTagToken {
+					b.WriteString(handle)
+					skip = 1
+				}
+			}
+
+		case html.EndTagToken:
+			if skip > 0 {
+				if name, _ := z.TagName(); string(name) == "a" {
+					skip--
+				}
+				continue
+			}
+
+			switch name, _ := z.TagName(); string(name) {
+			case "p", "div", "blockquote", "pre",
+				"ul", "ol", "h1", "h2", "h3",
+				"h4", "h5", "h6":
+				block("\n\n")
+			}
+		}
+	}
+}
+
+// HTMLToPlaintext strips all markup from the given single-line
+// HTML, e.g. a content warning, decoding entities exactly once.
+// Custom emojis are kept as :shortcode:, and any line breaks or
+// runs of whitespace are collapsed to single spaces.
+func HTMLToPlaintext(in string) string {
+	return strings.Join(strings.Fields(HTMLToSource(in, nil)), " ")
+}
+
+// tagAttrs returns the attributes
+// of the current tag in z, if any.
+func tagAttrs(z *html.Tokenizer, hasAttr bool) map[string]string {
+	if !hasAttr {
+		return nil
+	}
+	attrs := make(map[string]string)
+	for {
+		key, val, more := z.TagAttr()
+		attrs[string(key)] = string(val)
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// mentionHandle returns the @user[@domain] handle of the
+// account in mentions with given URL / URI, if found.
+func mentionHandle(href string, mentions []*gtsmodel.Mention) string {
+	if href == "" {
+		return ""
+	}
+
+	for _, mention := range mentions {
+		acct := mention.TargetAccount
+		if acct == nil {
+			continue
+		}
+
+		if acct.URL != href && acct.URI != href {
+			continue
+		}
+
+		if acct.Domain == "" {
+			return "@" + acct.Username
+		}
+
+		return "@" + acct.Username + "@" + acct.Domain
+	}
+
+	return ""
+}
+
+// WordCount returns the number of whitespace-separated words
+// in the given HTML content, e.g. a status, once all markup
+// has been stripped from it. Custom emojis count as words.
+func WordCount(in string) int {
+	return len(strings.Fields(HTMLToSource(in, nil)))
+}
@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type SourceTestSuite struct {
+	TextStandardTestSuite
+}
+
+func (suite *SourceTestSuite) TestPlainRoundTrip() {
+	for _, input := range []string{
+		simple,
+		withTag,
+		moreComplex,
+		withUTF8Link,
+		"mentioning a local account @the_mighty_zork, how nice",
+		"first line\nsecond line\n\nnew paragraph with & and <angle brackets>",
+	} {
+		formatted := suite.FromPlain(input)
+		source := text.HTMLToSource(formatted.HTML, formatted.Mentions)
+		suite.Equal(input, source)
+	}
+}
+
+func (suite *SourceTestSuite) TestMarkdownRoundTrip() {
+	for _, test := range []struct {
+		input  string
+		expect string
+	}{
+		{
+			input:  "first paragraph @foss_satan@fossbros-anonymous.io\n\nsecond paragraph #Hashtag",
+			expect: "first paragraph @foss_satan@fossbros-anonymous.io\n\nsecond paragraph #Hashtag",
+		},
+		{
+			input:  "some **bold** and _italic_ text",
+			expect: "some bold and italic text",
+		},
+		{
+			input:  "# heading\n\n> a quote\n\n- one\n- two",
+			expect: "heading\n\na quote\n\none\ntwo",
+		},
+	} {
+		formatted := suite.FromMarkdown(test.input)
+		source := text.HTMLToSource(formatted.HTML, formatted.Mentions)
+		suite.Equal(test.expect, source)
+	}
+}
+
+func (suite *SourceTestSuite) TestUnknownMention() {
+	// Without mentions to look up the handle in,
+	// the rendered @username text should be kept.
+	formatted := suite.FromPlain(moreComplex)
+	source := text.HTMLToSource(formatted.HTML, nil)
+	suite.Equal("Another test @foss_satan\n\n#Hashtag\n\nText\n\n:rainbow:", source)
+}
+
+func (suite *SourceTestSuite) TestEmoji() {
+	const content = `<p>hello <img src="https://example.org/emoji/rainbow.png" title=":rainbow:" alt=":rainbow:" class="emoji" width="25" height="25"/> world</p>`
+	suite.Equal("hello :rainbow: world", text.HTMLToSource(content, nil))
+}
+
+func (suite *SourceTestSuite) TestHTMLToPlaintext() {
+	for _, test := range []struct {
+		input  string
+		expect string
+	}{
+		{
+			input:  "just some plain text",
+			expect: "just some plain text",
+		},
+		{
+			input:  "<p>cw: food &amp; drink</p>",
+			expect: "cw: food & drink",
+		},
+		{
+			// Decoded exactly once.
+			input:  "literal &amp;lt;3 entity",
+			expect: "literal &lt;3 entity",
+		},
+		{
+			input:  "<p>first</p><p>second<br/>third</p>",
+			expect: "first second third",
+		},
+		{
+			input:  `spoilers <img src="https://example.org/emoji/rainbow.png" alt=":rainbow:" class="emoji"/>`,
+			expect: "spoilers :rainbow:",
+		},
+	} {
+		suite.Equal(test.expect, text.HTMLToPlaintext(test.input))
+	}
+}
+
+func (suite *SourceTestSuite) TestWordCount() {
+	for _, test := range []struct {
+		input  string
+		expect int
+	}{
+		{
+			input:  "",
+			expect: 0,
+		},
+		{
+			input:  "<p></p>",
+			expect: 0,
+		},
+		{
+			input:  "<p>first</p><p>second<br/>third</p>",
+			expect: 3,
+		},
+		{
+			input:  "<ul><li>one</li><li>two</li></ul><h1>three</h1>four",
+			expect: 4,
+		},
+		{
+			input:  `<p>hi <span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span> &lt;3</p>`,
+			expect: 3,
+		},
+	} {
+		suite.Equal(test.expect, text.WordCount(test.input), test.input)
+	}
+}
+
+func TestSourceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourceTestSuite))
+}
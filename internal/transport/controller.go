@@ -36,6 +36,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 )
 
@@ -74,9 +76,33 @@ func NewController(state *state.State, federatingDB federatingdb.DB, clock pub.C
 		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)", version, proto, host),
 	}
 
+	// Allow delivery workers to restore signing
+	// of deliveries fetched from a shared queue.
+	state.Workers.Delivery.Sign = c.signPOST
+
 	return c
 }
 
+// signPOST returns a POST signing function for given body,
+// using the transport of local account with pubKeyID.
+func (c *controller) signPOST(ctx context.Context, pubKeyID string, body []byte) (httpclient.SignFunc, error) {
+	acct, err := c.state.DB.GetAccountByPubkeyID(ctx, pubKeyID)
+	if err != nil {
+		return nil, gtserror.Newf("error getting account for %s: %w", pubKeyID, err)
+	}
+
+	if acct.PrivateKey == nil {
+		return nil, gtserror.Newf("account %s has no private key", acct.URI)
+	}
+
+	transp, err := c.NewTransport(pubKeyID, acct.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return transp.(*transport).signPOST(body), nil
+}
+
 func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Transport, error) {
 	// Generate public key string for cache key
 	//
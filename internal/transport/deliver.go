@@ -39,10 +39,6 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 
 		// accumulated preparation errs.
 		errs gtserror.MultiError
-
-		// Get current instance host info.
-		domain = config.GetAccountDomain()
-		host   = config.GetHost()
 	)
 
 	// Marshal object as JSON.
@@ -55,10 +51,11 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 	actID := getActorID(obj)
 	objID := getObjectID(obj)
 	tgtID := getTargetID(obj)
+	actType := getActivityType(obj)
 
 	for _, to := range recipients {
 		// Skip delivery to recipient if it is "us".
-		if to.Host == host || to.Host == domain {
+		if config.IsLocalDomain(to.Host) {
 			continue
 		}
 
@@ -67,6 +64,7 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 			actID,
 			objID,
 			tgtID,
+			actType,
 			b,
 			to,
 		)
@@ -88,7 +86,7 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 
 func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to *url.URL) error {
 	// if 'to' host is our own, skip as we don't need to deliver to ourselves...
-	if to.Host == config.GetHost() || to.Host == config.GetAccountDomain() {
+	if config.IsLocalDomain(to.Host) {
 		return nil
 	}
 
@@ -103,6 +101,7 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 		getActorID(obj),
 		getObjectID(obj),
 		getTargetID(obj),
+		getActivityType(obj),
 		b,
 		to,
 	)
@@ -124,6 +123,7 @@ func (t *transport) prepare(
 	actorID string,
 	objectID string,
 	targetID string,
+	activityType string,
 	data []byte,
 	to *url.URL,
 ) (
@@ -159,10 +159,11 @@ func (t *transport) prepare(
 	}
 
 	return &delivery.Delivery{
-		ActorID:  actorID,
-		ObjectID: objectID,
-		TargetID: targetID,
-		Request:  httpclient.WrapRequest(r),
+		ActorID:      actorID,
+		ObjectID:     objectID,
+		TargetID:     targetID,
+		ActivityType: activityType,
+		Request:      httpclient.WrapRequest(r),
 	}, nil
 }
 
@@ -204,3 +205,9 @@ func getTargetID(obj map[string]interface{}) string {
 		return ""
 	}
 }
+
+// getActivityType extracts an activity type from 'serialized' ActivityPub object map.
+func getActivityType(obj map[string]interface{}) string {
+	t, _ := obj["type"].(string)
+	return t
+}
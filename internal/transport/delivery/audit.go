@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// AuditLog records delivery attempts made by Worker{}s
+// to the federation audit log table, for querying by
+// admins. So as not to slow down deliveries, entries are
+// buffered in memory, and only written out in batches on
+// calls to Flush(), which also prunes the table down to
+// the configured maximum number of rows.
+type AuditLog struct {
+
+	// DB is the database
+	// entries are written to.
+	DB db.Admin
+
+	// internal fields.
+	mu  sync.Mutex
+	buf []*gtsmodel.DeliveryAudit
+}
+
+// Record buffers an audit log entry for the most
+// recent attempt at delivering the given Delivery{}.
+func (a *AuditLog) Record(dlv *Delivery) {
+	url := dlv.Request.URL
+
+	entry := &gtsmodel.DeliveryAudit{
+		ID:           id.NewULID(),
+		CreatedAt:    time.Now(),
+		Inbox:        url.String(),
+		Domain:       url.Hostname(),
+		ActivityType: dlv.ActivityType,
+		ObjectURI:    dlv.ObjectID,
+		StatusCode:   dlv.Request.StatusCode(),
+		Attempt:      int(dlv.Request.Attempts()),
+	}
+
+	a.mu.Lock()
+
+	// There's no point buffering more entries
+	// than would be kept after pruning, so drop
+	// the oldest if flushes aren't keeping up.
+	if max := config.GetFederationAuditLogMaxRows(); //
+	max > 0 && len(a.buf) >= max {
+		n := copy(a.buf, a.buf[len(a.buf)-max+1:])
+		a.buf = a.buf[:n]
+	}
+
+	a.buf = append(a.buf, entry)
+	a.mu.Unlock()
+}
+
+// Flush writes all buffered audit log entries
+// to the database in a single batch, then prunes
+// the oldest entries beyond the configured max.
+func (a *AuditLog) Flush(ctx context.Context) {
+	a.mu.Lock()
+	entries := a.buf
+	a.buf = nil
+	a.mu.Unlock()
+
+	if len(entries) == 0 {
+		// Nothing new
+		// to write.
+		return
+	}
+
+	if err := a.DB.PutDeliveryAudits(ctx, entries); err != nil {
+		log.Errorf(ctx, "db error writing %d audit log entries: %v", len(entries), err)
+		return
+	}
+
+	max := config.GetFederationAuditLogMaxRows()
+	pruned, err := a.DB.PruneDeliveryAudits(ctx, max)
+	if err != nil {
+		log.Errorf(ctx, "db error pruning audit log: %v", err)
+		return
+	}
+
+	log.Debugf(ctx, "wrote %d audit log entries, pruned %d", len(entries), pruned)
+}
@@ -20,13 +20,16 @@ package delivery
 import (
 	"context"
 	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"codeberg.org/gruf/go-runners"
-	"codeberg.org/gruf/go-structr"
+	"github.com/redis/go-redis/v9"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
-	"github.com/superseriousbusiness/gotosocial/internal/queue"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -52,13 +55,20 @@ type Delivery struct {
 	// being sent out by this request.
 	TargetID string
 
+	// ActivityType contains the ActivityStreams
+	// type (if any) of the activity being sent
+	// out by this request, eg., "Create".
+	ActivityType string
+
 	// Request is the prepared (+ wrapped)
 	// httpclient.Client{} request that
 	// constitutes this ActivtyPub delivery.
 	Request httpclient.Request
 
 	// internal fields.
-	next time.Time
+	next     time.Time
+	deferred bool
+	id       string
 }
 
 func (dlv *Delivery) backoff() time.Duration {
@@ -76,30 +86,79 @@ type WorkerPool struct {
 	// passed to each of delivery pool Worker{}s.
 	Client *httpclient.Client
 
-	// Queue is the embedded queue.StructQueue{}
-	// passed to each of delivery pool Worker{}s.
-	Queue queue.StructQueue[*Delivery]
+	// Queue is the delivery Queue{} passed
+	// to each of delivery pool Worker{}s.
+	Queue Queue
+
+	// Sign returns a signing function for deliveries
+	// by the local actor with given public key ID, and
+	// request body. This is used to restore signing of
+	// deliveries decoded from a non in-memory Queue{}.
+	Sign func(ctx context.Context, pubKeyID string, body []byte) (httpclient.SignFunc, error)
+
+	// Audit is the (optional) AuditLog{} passed
+	// to each of delivery pool Worker{}s, which
+	// records every delivery attempt made.
+	Audit *AuditLog
+
+	// Health is the (optional) DomainHealth{}
+	// passed to each of delivery pool Worker{}s,
+	// used to back off from failing domains.
+	Health *DomainHealth
 
 	// internal fields.
 	workers []*Worker
+	retired []*Worker
+	busy    atomic.Int64
+	mutex   sync.Mutex
 }
 
-// Init will initialize the Worker{} pool
-// with given http client, request queue to pull
-// from and number of delivery workers to spawn.
-func (p *WorkerPool) Init(client *httpclient.Client) {
+// Init will initialize the Worker{} pool with given
+// http client, and the delivery queue to pull from
+// according to the configured delivery backend. For
+// the redis backend, the server is checked to be
+// reachable (with our credentials), returning error
+// if not, rather than deliveries silently stalling.
+func (p *WorkerPool) Init(client *httpclient.Client) error {
 	p.Client = client
-	p.Queue.Init(structr.QueueConfig[*Delivery]{
-		Indices: []structr.IndexConfig{
-			{Fields: "ActorID", Multiple: true},
-			{Fields: "ObjectID", Multiple: true},
-			{Fields: "TargetID", Multiple: true},
-		},
-	})
+
+	switch config.GetFederationDeliveryBackend() {
+	case config.FederationDeliveryBackendRedis:
+		addr := config.GetFederationDeliveryRedisAddress()
+		rclient := redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: config.GetFederationDeliveryRedisPassword(),
+		})
+
+		ctx, cncl := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cncl()
+
+		if err := rclient.Ping(ctx).Err(); err != nil {
+			_ = rclient.Close()
+			return gtserror.Newf("error connecting to redis at %s: %w", addr, err)
+		}
+
+		p.Queue = newRedisQueue(
+			rclient,
+			config.GetFederationDeliveryRedisKey(),
+			nodeName(),
+			func(ctx context.Context, pubKeyID string, body []byte) (httpclient.SignFunc, error) {
+				return p.Sign(ctx, pubKeyID, body)
+			},
+		)
+
+	default:
+		p.Queue = newMemoryQueue()
+	}
+
+	return nil
 }
 
 // Start will attempt to start 'n' Worker{}s.
 func (p *WorkerPool) Start(n int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
 	// Check whether workers are
 	// set (is already running).
 	ok := (len(p.workers) > 0)
@@ -107,25 +166,21 @@ func (p *WorkerPool) Start(n int) {
 		return
 	}
 
-	// Allocate new workers slice.
-	p.workers = make([]*Worker, n)
-	for i := range p.workers {
-
-		// Allocate new Worker{}.
-		p.workers[i] = new(Worker)
-		p.workers[i].Client = p.Client
-		p.workers[i].Queue = &p.Queue
-
-		// Attempt to start worker.
-		// Return bool not useful
-		// here, as true = started,
-		// false = already running.
-		_ = p.workers[i].Start()
+	if q, ok := p.Queue.(*redisQueue); ok {
+		// Start prefetching deliveries
+		// from redis for our workers.
+		q.start(n)
 	}
+
+	// Start new workers.
+	p.grow(n)
 }
 
 // Stop will attempt to stop contained Worker{}s.
 func (p *WorkerPool) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
 	// Check whether workers are
 	// set (is currently running).
 	ok := (len(p.workers) == 0)
@@ -133,21 +188,120 @@ func (p *WorkerPool) Stop() {
 		return
 	}
 
-	// Stop all running workers.
-	for i := range p.workers {
+	// Stop all running workers,
+	// including any retired that
+	// are still mid-delivery.
+	for _, w := range append(p.workers, p.retired...) {
 
 		// return bool not useful
 		// here, as true = stopped,
 		// false = never running.
-		_ = p.workers[i].Stop()
+		_ = w.Stop()
+	}
+
+	if q, ok := p.Queue.(*redisQueue); ok {
+		// Stop prefetching.
+		q.stop()
 	}
 
-	// Unset workers slice.
+	if p.Audit != nil {
+		// Write out any audit
+		// log entries still
+		// waiting in buffer.
+		p.Audit.Flush(context.Background())
+	}
+
+	// Unset workers slices.
 	p.workers = p.workers[:0]
+	p.retired = p.retired[:0]
+}
+
+// Resize will grow or shrink the running pool to 'n' Worker{}s,
+// returning false if the pool is not running. Shrinking the pool
+// retires surplus Worker{}s, which exit after any current delivery,
+// handing their backlogged retries back to the Queue{}.
+func (p *WorkerPool) Resize(n int) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Check whether workers are
+	// set (is currently running).
+	if len(p.workers) == 0 {
+		return false
+	}
+
+	// Drop retired workers
+	// that have since exited.
+	p.retired = slices.DeleteFunc(p.retired, func(w *Worker) bool {
+		return !w.service.Running()
+	})
+
+	// Clamp to 1.
+	n = max(n, 1)
+
+	switch {
+	case n > len(p.workers):
+		p.grow(n - len(p.workers))
+
+	case n < len(p.workers):
+		for _, w := range p.workers[n:] {
+			close(w.retired)
+			p.retired = append(p.retired, w)
+		}
+		p.workers = slices.Clip(p.workers[:n])
+	}
+
+	if q, ok := p.Queue.(*redisQueue); ok {
+		// Update prefetch size.
+		q.resize(n)
+	}
+
+	return true
+}
+
+// Size returns the current number of running Worker{}s.
+func (p *WorkerPool) Size() int {
+	p.mutex.Lock()
+	n := len(p.workers)
+	p.mutex.Unlock()
+	return n
+}
+
+// Busy returns the number of Worker{}s currently attempting a delivery.
+func (p *WorkerPool) Busy() int {
+	return int(p.busy.Load())
+}
+
+// Queued returns the number of queued deliveries awaiting a worker.
+func (p *WorkerPool) Queued() int {
+	return p.Queue.Len()
+}
+
+// grow will start 'n' new Worker{}s, under lock.
+func (p *WorkerPool) grow(n int) {
+	for i := 0; i < n; i++ {
+
+		// Allocate new Worker{}.
+		w := new(Worker)
+		w.Client = p.Client
+		w.Queue = p.Queue
+		w.Audit = p.Audit
+		w.Health = p.Health
+		w.busy = &p.busy
+		w.retired = make(chan struct{})
+
+		// Attempt to start worker.
+		// Return bool not useful
+		// here, as true = started,
+		// false = already running.
+		_ = w.Start()
+
+		p.workers = append(p.workers, w)
+	}
 }
 
 // Worker wraps an httpclient.Client{} to feed
-// from queue.StructQueue{} for ActivityPub reqs
+// from a delivery Queue{} for ActivityPub reqs
 // to deliver. It does so while prioritizing new
 // queued requests over backlogged retries.
 type Worker struct {
@@ -158,11 +312,22 @@ type Worker struct {
 
 	// Queue is the Delivery{} message queue
 	// that delivery worker will feed from.
-	Queue *queue.StructQueue[*Delivery]
+	Queue Queue
+
+	// Audit is the (optional) AuditLog{}
+	// that delivery attempts are recorded to.
+	Audit *AuditLog
+
+	// Health is the (optional) DomainHealth{} that
+	// is checked before delivering to a domain, and
+	// updated with the outcome of each attempt.
+	Health *DomainHealth
 
 	// internal fields.
 	backlog []*Delivery
 	service runners.Service
+	retired chan struct{}
+	busy    *atomic.Int64
 }
 
 // Start will attempt to start the Worker{}.
@@ -195,9 +360,19 @@ func (w *Worker) process(ctx context.Context) bool {
 
 loop:
 	for {
+		if w.isRetired() {
+			// Worker was retired, return
+			// backlog to queue and exit.
+			w.handoff()
+			return true
+		}
+
 		// Get next delivery.
 		dlv, ok := w.next(ctx)
 		if !ok {
+			if w.isRetired() {
+				w.handoff()
+			}
 			return true
 		}
 
@@ -208,6 +383,13 @@ loop:
 			// Start backoff sleep timer.
 			backoff := time.NewTimer(d)
 
+			// Get channel awoken on domain
+			// backoff being cleared, if any.
+			var cleared <-chan struct{}
+			if w.Health != nil {
+				cleared = w.Health.Wait()
+			}
+
 			select {
 			case <-ctx.Done():
 				// Main ctx
@@ -215,6 +397,15 @@ loop:
 				backoff.Stop()
 				return true
 
+			case <-w.retired:
+				// Worker retired,
+				// hand back this +
+				// any backlog.
+				w.pushBacklog(dlv)
+				w.handoff()
+				backoff.Stop()
+				return true
+
 			case <-w.Queue.Wait():
 				// A new message was
 				// queued, re-add this
@@ -223,19 +414,83 @@ loop:
 				backoff.Stop()
 				continue loop
 
+			case <-cleared:
+				// A domain backoff was
+				// cleared, release any
+				// deliveries deferred.
+				w.pushBacklog(dlv)
+				w.releaseDeferred(ctx)
+				backoff.Stop()
+				continue loop
+
 			case <-backoff.C:
 				// success!
 			}
 		}
 
+		// Get the target domain.
+		domain := dlv.Request.URL.Hostname()
+
+		if w.Health != nil {
+			// Check domain isn't currently backing off.
+			at := w.Health.NextAttempt(ctx, domain)
+			if d := time.Until(at); d > 0 {
+				if d > healthMaxDefer {
+					// Domain has been failing too
+					// long to hold onto this, drop.
+					w.Queue.Done(dlv)
+					continue loop
+				}
+
+				// Defer delivery
+				// until backoff up.
+				dlv.next = at
+				dlv.deferred = true
+				w.pushBacklog(dlv)
+				continue loop
+			}
+
+			// Domain health is tracked
+			// by us, not the client.
+			dlv.deferred = false
+			dlv.Request.IgnoreBadHosts = true
+		}
+
+		// Acquire lock on the delivery inbox,
+		// if the queue is shared between nodes.
+		unlock, ok := w.lockInbox(ctx, dlv)
+		if !ok {
+			// Another node is delivering
+			// to this inbox, retry shortly.
+			dlv.next = time.Now().Add(lockedBackoff)
+			w.pushBacklog(dlv)
+			continue loop
+		}
+
 		// Attempt delivery of AP request.
+		w.setBusy(+1)
 		rsp, retry, err := w.Client.DoOnce(
 			&dlv.Request,
 		)
+		w.setBusy(-1)
+
+		// Release lock.
+		unlock()
+
+		if w.Audit != nil {
+			// Record this attempt.
+			w.Audit.Record(dlv)
+		}
+
+		if w.Health != nil {
+			// Update domain health.
+			w.Health.Record(ctx, domain, dlv, err)
+		}
 
 		if err == nil {
 			// Ensure body closed.
 			_ = rsp.Body.Close()
+			w.Queue.Done(dlv)
 			continue loop
 		}
 
@@ -243,6 +498,7 @@ loop:
 			// Drop deliveries when no
 			// retry requested, or they
 			// reached max (either).
+			w.Queue.Done(dlv)
 			continue loop
 		}
 
@@ -284,6 +540,10 @@ loop:
 			// Worker was stopped.
 			case <-ctx.Done():
 				return nil, false
+
+			// Worker was retired.
+			case <-w.retired:
+				return nil, false
 			}
 		}
 
@@ -295,6 +555,68 @@ loop:
 	}
 }
 
+// isRetired returns whether Worker{} has been retired from its pool.
+func (w *Worker) isRetired() bool {
+	select {
+	case <-w.retired:
+		return true
+	default:
+		return false
+	}
+}
+
+// handoff returns all backlogged deliveries to the
+// queue, so they may be picked up by other workers.
+func (w *Worker) handoff() {
+	if len(w.backlog) == 0 {
+		return
+	}
+
+	// Requeue backlog, then mark
+	// as done, so shared queues
+	// drop them from our node's
+	// in-progress deliveries.
+	w.Queue.Push(w.backlog...)
+	for _, dlv := range w.backlog {
+		w.Queue.Done(dlv)
+	}
+
+	w.backlog = nil
+}
+
+// setBusy updates pool busy counter by delta, if set.
+func (w *Worker) setBusy(delta int64) {
+	if w.busy != nil {
+		w.busy.Add(delta)
+	}
+}
+
+// lockInbox acquires a lock on the inbox of given delivery
+// if the queue supports it, returning an unlock function and
+// whether the lock was acquired (always true if unsupported).
+func (w *Worker) lockInbox(ctx context.Context, dlv *Delivery) (func(), bool) {
+	if l, ok := w.Queue.(inboxLocker); ok {
+		return l.lockInbox(ctx, dlv.Request.URL.String())
+	}
+	return func() {}, true
+}
+
+// releaseDeferred resets the backoff of deliveries in the backlog
+// that were deferred on a domain backoff that has since been cleared.
+func (w *Worker) releaseDeferred(ctx context.Context) {
+	for _, dlv := range w.backlog {
+		if !dlv.deferred {
+			continue
+		}
+
+		domain := dlv.Request.URL.Hostname()
+		if time.Until(w.Health.NextAttempt(ctx, domain)) <= 0 {
+			dlv.next = time.Time{}
+			dlv.deferred = false
+		}
+	}
+}
+
 // popBacklog pops next available from the backlog.
 func (w *Worker) popBacklog() *Delivery {
 	if len(w.backlog) == 0 {
@@ -13,7 +13,6 @@ import (
 	"codeberg.org/gruf/go-byteutil"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
-	"github.com/superseriousbusiness/gotosocial/internal/queue"
 	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 )
 
@@ -27,19 +26,21 @@ func TestDeliveryWorkerPool(t *testing.T) {
 
 func testDeliveryWorkerPool(t *testing.T, sz int, input []*testrequest) {
 	wp := new(delivery.WorkerPool)
-	wp.Init(httpclient.New(httpclient.Config{
+	if err := wp.Init(httpclient.New(httpclient.Config{
 		AllowRanges: config.MustParseIPPrefixes([]string{
 			"127.0.0.0/8",
 		}),
-	}))
+	})); err != nil {
+		t.Fatal(err)
+	}
 	wp.Start(sz)
 	defer wp.Stop()
-	test(t, &wp.Queue, input)
+	test(t, wp.Queue, input)
 }
 
 func test(
 	t *testing.T,
-	queue *queue.StructQueue[*delivery.Delivery],
+	queue delivery.Queue,
 	input []*testrequest,
 ) {
 	expect := make(chan *testrequest)
@@ -0,0 +1,236 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// healthThreshold is the number of consecutive
+	// failed attempts at delivering to a domain,
+	// after which deliveries to it are backed off.
+	healthThreshold = 5
+
+	// healthBaseBackoff is the backoff applied to a domain
+	// on reaching healthThreshold, doubling with every
+	// further failure up to healthMaxBackoff.
+	healthBaseBackoff = time.Minute
+	healthMaxBackoff  = 24 * time.Hour
+
+	// healthMaxDefer is the longest a delivery will be held
+	// back waiting on a domain's backoff. Deliveries to domains
+	// backed off for longer than this are dropped, to stop
+	// deliveries to long dead domains piling up in memory.
+	healthMaxDefer = time.Hour
+
+	// healthMaxSamples is the number of most
+	// recent delivery errors kept per domain.
+	healthMaxSamples = 5
+
+	// healthStale is how long a cached domain health entry
+	// is trusted for, before being reloaded from the database
+	// to pick up changes made by other nodes, or by admins.
+	healthStale = time.Minute
+
+	// healthMaxCached is the number of cached domain
+	// health entries above which stale entries are pruned.
+	healthMaxCached = 1000
+)
+
+// DomainHealth tracks the health of deliveries to remote domains,
+// so that Worker{}s may back off from domains that are failing.
+// Health is persisted to the database on every change in failure
+// state, and cached in memory, being reloaded once stale.
+type DomainHealth struct {
+
+	// DB is the database domain
+	// health is persisted to.
+	DB db.Admin
+
+	// internal fields.
+	mu      sync.Mutex
+	domains map[string]*cachedHealth
+	wait    chan struct{}
+}
+
+// cachedHealth wraps a cached domain
+// health entry with time it was fetched.
+type cachedHealth struct {
+	health  *gtsmodel.DomainDeliveryHealth
+	fetched time.Time
+}
+
+// NextAttempt returns the time before which deliveries to
+// domain should not be attempted, or zero time if none.
+func (h *DomainHealth) NextAttempt(ctx context.Context, domain string) time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.get(ctx, domain).NextAttemptAt
+}
+
+// Record updates the health of domain according
+// to the outcome of an attempt at delivering dlv,
+// given the error returned by the attempt, if any.
+func (h *DomainHealth) Record(ctx context.Context, domain string, dlv *Delivery, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	health := h.get(ctx, domain)
+	now := time.Now()
+
+	if !isDomainFailure(dlv, err) {
+		health.LastSuccessAt = now
+
+		if health.ConsecutiveFailures == 0 {
+			// Already healthy, there's no need
+			// to persist for every delivery.
+			return
+		}
+
+		// Domain has recovered.
+		health.ConsecutiveFailures = 0
+		health.NextAttemptAt = time.Time{}
+		h.put(ctx, health)
+		return
+	}
+
+	health.ConsecutiveFailures++
+	health.LastFailureAt = now
+
+	if n := health.ConsecutiveFailures - healthThreshold; n >= 0 {
+		// Back off exponentially from this domain.
+		backoff := healthBaseBackoff << min(n, 16)
+		backoff = min(backoff, healthMaxBackoff)
+		health.NextAttemptAt = now.Add(backoff)
+	}
+
+	// Prepend this error to the most recent samples.
+	samples := make([]*gtsmodel.DeliveryErrorSample, 0, healthMaxSamples)
+	samples = append(samples, &gtsmodel.DeliveryErrorSample{
+		Time:       now,
+		Inbox:      dlv.Request.URL.String(),
+		StatusCode: dlv.Request.StatusCode(),
+		Error:      err.Error(),
+	})
+	samples = append(samples, health.ErrorSamples...)
+	health.ErrorSamples = samples[:min(len(samples), healthMaxSamples)]
+
+	h.put(ctx, health)
+}
+
+// Forget drops the cached health of domain, so that it is
+// reloaded from the database, and wakes any Worker{}s waiting
+// on a backoff. This should be called on changing the health
+// of a domain in the database directly, eg. to clear backoff.
+func (h *DomainHealth) Forget(domain string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.domains, domain)
+
+	if h.wait != nil {
+		// Wake waiters.
+		close(h.wait)
+		h.wait = nil
+	}
+}
+
+// Wait returns a channel that is closed on the next
+// call to Forget(), waking Worker{}s waiting on backoff.
+func (h *DomainHealth) Wait() <-chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.wait == nil {
+		h.wait = make(chan struct{})
+	}
+
+	return h.wait
+}
+
+// get returns the health of domain, loading it from the
+// database if not cached or stale. Caller must hold lock.
+func (h *DomainHealth) get(ctx context.Context, domain string) *gtsmodel.DomainDeliveryHealth {
+	now := time.Now()
+
+	if c, ok := h.domains[domain]; ok &&
+		now.Sub(c.fetched) < healthStale {
+		return c.health
+	}
+
+	health, err := h.DB.GetDomainDeliveryHealth(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting delivery health of %s: %v", domain, err)
+		}
+
+		// No stored health, assume healthy.
+		health = &gtsmodel.DomainDeliveryHealth{
+			Domain: domain,
+		}
+	}
+
+	if h.domains == nil {
+		// Lazily allocate cache map.
+		h.domains = make(map[string]*cachedHealth)
+	} else if len(h.domains) >= healthMaxCached {
+		// Prune stale entries.
+		for d, c := range h.domains {
+			if now.Sub(c.fetched) >= healthStale {
+				delete(h.domains, d)
+			}
+		}
+	}
+
+	h.domains[domain] = &cachedHealth{
+		health:  health,
+		fetched: now,
+	}
+
+	return health
+}
+
+// put persists the given domain health. Caller must hold lock.
+func (h *DomainHealth) put(ctx context.Context, health *gtsmodel.DomainDeliveryHealth) {
+	if err := h.DB.PutDomainDeliveryHealth(ctx, health); err != nil {
+		log.Errorf(ctx, "db error storing delivery health of %s: %v", health.Domain, err)
+	}
+}
+
+// isDomainFailure returns whether an attempt at delivering dlv,
+// returning err, indicates the remote domain is failing. Client
+// errors in the response, eg. 404 or 410, do not, as they show
+// the domain is at least up and responding to requests.
+func isDomainFailure(dlv *Delivery, err error) bool {
+	if err == nil {
+		return false
+	}
+	code := dlv.Request.StatusCode()
+	return code == 0 || // i.e. no response
+		code >= 500 ||
+		code == http.StatusTooManyRequests
+}
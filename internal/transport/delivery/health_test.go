@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+// healthDB is a minimal in-memory db.Admin
+// implementation storing only domain health.
+type healthDB struct {
+	db.Admin
+	stored map[string]gtsmodel.DomainDeliveryHealth
+}
+
+func (h *healthDB) GetDomainDeliveryHealth(_ context.Context, domain string) (*gtsmodel.DomainDeliveryHealth, error) {
+	health, ok := h.stored[domain]
+	if !ok {
+		return nil, db.ErrNoEntries
+	}
+	return &health, nil
+}
+
+func (h *healthDB) PutDomainDeliveryHealth(_ context.Context, health *gtsmodel.DomainDeliveryHealth) error {
+	h.stored[health.Domain] = *health
+	return nil
+}
+
+func TestDomainHealth(t *testing.T) {
+	ctx := context.Background()
+	store := &healthDB{stored: make(map[string]gtsmodel.DomainDeliveryHealth)}
+	health := &delivery.DomainHealth{DB: store}
+
+	r, _ := http.NewRequest("POST", "https://example.org/inbox", nil)
+	dlv := &delivery.Delivery{Request: httpclient.WrapRequest(r)}
+	errFailed := errors.New("connection refused")
+
+	// Failures below the threshold
+	// are persisted, but not backed off.
+	for i := 0; i < 4; i++ {
+		health.Record(ctx, "example.org", dlv, errFailed)
+	}
+	if at := health.NextAttempt(ctx, "example.org"); !at.IsZero() {
+		t.Fatalf("expected no backoff yet, got %s", at)
+	}
+	if n := store.stored["example.org"].ConsecutiveFailures; n != 4 {
+		t.Fatalf("expected 4 failures stored, got %d", n)
+	}
+
+	// Reaching the threshold backs
+	// off, growing with each failure.
+	health.Record(ctx, "example.org", dlv, errFailed)
+	first := health.NextAttempt(ctx, "example.org")
+	if !first.After(time.Now()) {
+		t.Fatalf("expected backoff after threshold, got %s", first)
+	}
+	health.Record(ctx, "example.org", dlv, errFailed)
+	if next := health.NextAttempt(ctx, "example.org"); !next.After(first) {
+		t.Fatalf("expected backoff to grow, got %s after %s", next, first)
+	}
+
+	// Only most recent errors are kept.
+	stored := store.stored["example.org"]
+	if l := len(stored.ErrorSamples); l != 5 {
+		t.Fatalf("expected 5 error samples, got %d", l)
+	}
+	if inbox := stored.ErrorSamples[0].Inbox; inbox != "https://example.org/inbox" {
+		t.Fatalf("unexpected error sample inbox %s", inbox)
+	}
+
+	// Other domains are unaffected.
+	if at := health.NextAttempt(ctx, "fossbros-anonymous.io"); !at.IsZero() {
+		t.Fatalf("expected no backoff for other domain, got %s", at)
+	}
+
+	// Clearing stored backoff is picked up
+	// on forgetting, which wakes waiters.
+	wait := health.Wait()
+	stored.ConsecutiveFailures = 0
+	stored.NextAttemptAt = time.Time{}
+	store.stored["example.org"] = stored
+	health.Forget("example.org")
+	select {
+	case <-wait:
+	default:
+		t.Fatal("expected waiters to be woken")
+	}
+	if at := health.NextAttempt(ctx, "example.org"); !at.IsZero() {
+		t.Fatalf("expected backoff cleared, got %s", at)
+	}
+
+	// A success resets failures.
+	health.Record(ctx, "example.org", dlv, errFailed)
+	health.Record(ctx, "example.org", dlv, nil)
+	stored = store.stored["example.org"]
+	if stored.ConsecutiveFailures != 0 || stored.LastSuccessAt.IsZero() {
+		t.Fatalf("expected failures reset on success, got %+v", stored)
+	}
+}
@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"codeberg.org/gruf/go-structr"
+	"github.com/superseriousbusiness/gotosocial/internal/queue"
+)
+
+// lockedBackoff is the backoff applied to a delivery
+// when its target inbox is locked by another worker.
+const lockedBackoff = 2 * time.Second
+
+// Queue is the queue of Delivery{}s fed
+// from by delivery pool Worker{}s. Deliveries
+// may be dropped from the queue by any of the
+// "ActorID", "ObjectID" or "TargetID" indices.
+type Queue interface {
+	// Push pushes given deliveries to the queue.
+	Push(dlvs ...*Delivery)
+
+	// Pop pops the next delivery from the queue, if any.
+	Pop() (*Delivery, bool)
+
+	// Done marks given popped delivery as finished
+	// with, either as delivered or as dropped.
+	Done(dlv *Delivery)
+
+	// Delete drops all queued deliveries under index with key.
+	Delete(index string, key ...any)
+
+	// Len returns the current length of the queue.
+	Len() int
+
+	// Wait returns current wait channel, which may be
+	// blocked on to awaken when new value pushed to queue.
+	Wait() <-chan struct{}
+}
+
+// inboxLocker may optionally be implemented by
+// a Queue{} shared between multiple nodes, to
+// ensure only one worker at a time delivers to
+// a particular remote inbox.
+type inboxLocker interface {
+	// lockInbox attempts to acquire lock on given inbox
+	// URL, returning unlock function and success.
+	lockInbox(ctx context.Context, inbox string) (func(), bool)
+}
+
+// memoryQueue is the default in-memory Queue{}
+// implementation, wrapping a queue.StructQueue{}.
+type memoryQueue struct {
+	queue.StructQueue[*Delivery]
+}
+
+// newMemoryQueue returns a new initialized memoryQueue{}.
+func newMemoryQueue() *memoryQueue {
+	var q memoryQueue
+	q.Init(structr.QueueConfig[*Delivery]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ActorID", Multiple: true},
+			{Fields: "ObjectID", Multiple: true},
+			{Fields: "TargetID", Multiple: true},
+		},
+	})
+	return &q
+}
+
+// Done: no-op, popped deliveries
+// are no longer tracked in memory.
+func (q *memoryQueue) Done(*Delivery) {}
+
+// nodeName returns the name used to identify
+// this node's in-progress deliveries in a shared
+// queue, so they may be recovered on restart.
+func nodeName() string {
+	name, err := os.Hostname()
+	if err != nil || name == "" {
+		name = "gotosocial"
+	}
+	return name
+}
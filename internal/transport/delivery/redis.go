@@ -0,0 +1,609 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"codeberg.org/gruf/go-byteutil"
+	"codeberg.org/gruf/go-structr"
+	"github.com/redis/go-redis/v9"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/queue"
+)
+
+const (
+	// fetchTimeout is the timeout of each blocking pop
+	// from the redis queue, this also bounds how long
+	// stopping the queue may wait on an ongoing fetch.
+	fetchTimeout = time.Second
+
+	// lockTimeout is the timeout of an inbox lock,
+	// after which it is considered stale.
+	lockTimeout = time.Minute
+
+	// lenRefresh is how often the queue length
+	// reported by Len() is refreshed from redis.
+	lenRefresh = 5 * time.Second
+)
+
+// unlockScript deletes the inbox lock at KEYS[1] only
+// if it is still held with our token ARGV[1], as it may
+// have expired and been taken by another node since.
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// redisDelivery is the serialized form
+// of a Delivery{} as stored in redis.
+type redisDelivery struct {
+	ActorID  string      `json:"actor_id,omitempty"`
+	ObjectID string      `json:"object_id,omitempty"`
+	TargetID string      `json:"target_id,omitempty"`
+	Type     string      `json:"type,omitempty"`
+	PubKeyID string      `json:"pub_key_id"`
+	URL      string      `json:"url"`
+	Header   http.Header `json:"header,omitempty"`
+	Body     []byte      `json:"body"`
+}
+
+// redisQueue is a Queue{} implementation backed by redis,
+// allowing deliveries to be shared between multiple nodes.
+// Serialized deliveries are stored in a hash keyed by ID,
+// with the queue list itself holding only their IDs, and a
+// set of IDs per indexed field value so they may be deleted
+// without scanning the queue. IDs are atomically moved to a
+// per-node processing list when fetched, and only removed
+// from it when done, so that in-progress deliveries may be
+// requeued on restart should the node die. Fetched deliveries
+// are buffered locally in a queue.StructQueue{} for workers.
+type redisQueue struct {
+	client     *redis.Client
+	key        string
+	processing string
+	entries    string
+	sign       func(context.Context, string, []byte) (httpclient.SignFunc, error)
+
+	// local buffer.
+	local queue.StructQueue[*Delivery]
+
+	// cached length of the
+	// queue list in redis,
+	// and when last checked.
+	length  atomic.Int64
+	checked atomic.Int64
+
+	// fetcher state.
+	size atomic.Int64
+	cncl context.CancelFunc
+	done chan struct{}
+}
+
+// newRedisQueue returns a new redisQueue{} using given client, storing
+// deliveries under key, with node name used to track in-progress
+// deliveries, and sign used to restore request signing when fetched.
+func newRedisQueue(
+	client *redis.Client,
+	key string,
+	node string,
+	sign func(context.Context, string, []byte) (httpclient.SignFunc, error),
+) *redisQueue {
+	q := &redisQueue{
+		client:     client,
+		key:        key,
+		processing: key + ":processing:" + node,
+		entries:    key + ":deliveries",
+		sign:       sign,
+	}
+	q.local.Init(structr.QueueConfig[*Delivery]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ActorID", Multiple: true},
+			{Fields: "ObjectID", Multiple: true},
+			{Fields: "TargetID", Multiple: true},
+		},
+	})
+	return q
+}
+
+// start starts fetching deliveries from redis in the
+// background, buffering up to n deliveries locally.
+func (q *redisQueue) start(n int) {
+	q.size.Store(int64(n))
+	ctx, cncl := context.WithCancel(context.Background())
+	q.cncl = cncl
+	q.done = make(chan struct{})
+
+	go func() {
+		defer close(q.done)
+
+		// Requeue any deliveries left
+		// in-progress by this node.
+		q.requeue(ctx)
+
+		// Fetch until stopped.
+		q.fetch(ctx)
+	}()
+}
+
+// resize updates the number of deliveries
+// buffered locally by background fetching.
+func (q *redisQueue) resize(n int) {
+	q.size.Store(int64(n))
+}
+
+// stop stops the background fetching of deliveries.
+func (q *redisQueue) stop() {
+	if q.cncl == nil {
+		return
+	}
+	q.cncl()
+	<-q.done
+	q.cncl = nil
+}
+
+// requeue moves all delivery IDs from our
+// processing list back onto the main queue.
+func (q *redisQueue) requeue(ctx context.Context) {
+	var n int
+	for {
+		err := q.client.RPopLPush(ctx, q.processing, q.key).Err()
+		if errors.Is(err, redis.Nil) {
+			break
+		}
+
+		if err != nil {
+			log.Errorf(ctx, "error requeuing in-progress deliveries: %v", err)
+			return
+		}
+
+		n++
+	}
+
+	if n > 0 {
+		log.Infof(ctx, "requeued %d in-progress deliveries", n)
+	}
+}
+
+// fetch is the main fetching routine, moving delivery IDs
+// from the main queue to our processing list and buffering
+// the deliveries locally, until there are at least size buffered.
+func (q *redisQueue) fetch(ctx context.Context) {
+	for {
+		if int64(q.local.Len()) >= q.size.Load() {
+			// Wait for workers to catch up.
+			if !sleep(ctx, 100*time.Millisecond) {
+				return
+			}
+			continue
+		}
+
+		id, err := q.client.BRPopLPush(ctx,
+			q.key,
+			q.processing,
+			fetchTimeout,
+		).Result()
+		if errors.Is(err, redis.Nil) {
+			// Timed out.
+			continue
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Errorf(ctx, "error fetching deliveries: %v", err)
+			if !sleep(ctx, time.Second) {
+				return
+			}
+			continue
+		}
+
+		q.length.Add(-1)
+
+		raw, err := q.client.HGet(ctx, q.entries, id).Bytes()
+		if errors.Is(err, redis.Nil) {
+			// Deleted since queued.
+			q.remove(ctx, id, nil)
+			continue
+		}
+
+		if err != nil {
+			// Hand back to the queue
+			// for a later (re)fetch.
+			log.Errorf(ctx, "error fetching delivery %s: %v", id, err)
+			q.requeueID(ctx, id)
+			if !sleep(ctx, time.Second) {
+				return
+			}
+			continue
+		}
+
+		dlv, err := q.decode(ctx, id, raw)
+		if err != nil {
+			log.Errorf(ctx, "dropping delivery: %v", err)
+			q.remove(ctx, id, nil)
+			continue
+		}
+
+		q.local.Push(dlv)
+	}
+}
+
+// Push: implements Queue{}.
+func (q *redisQueue) Push(dlvs ...*Delivery) {
+	if len(dlvs) == 0 {
+		return
+	}
+
+	ctx := context.Background()
+	pipe := q.client.TxPipeline()
+	ids := make([]any, 0, len(dlvs))
+
+	for _, dlv := range dlvs {
+		rd, err := encodeDelivery(dlv)
+		if err != nil {
+			log.Errorf(ctx, "error encoding delivery: %v", err)
+			continue
+		}
+
+		raw, err := json.Marshal(rd)
+		if err != nil {
+			log.Errorf(ctx, "error encoding delivery: %v", err)
+			continue
+		}
+
+		// Store delivery by ID, and
+		// index the ID for deletion.
+		dlvID := id.NewULID()
+		pipe.HSet(ctx, q.entries, dlvID, raw)
+		for _, key := range q.indexKeys(rd) {
+			pipe.SAdd(ctx, key, dlvID)
+		}
+
+		ids = append(ids, dlvID)
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	// Only queue IDs after storing.
+	push := pipe.LPush(ctx, q.key, ids...)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Errorf(ctx, "error pushing deliveries: %v", err)
+		return
+	}
+
+	q.setLength(push.Val())
+}
+
+// Pop: implements Queue{}. Buffered deliveries are checked
+// to still be stored before being handed out, as they may
+// have since been deleted by another node.
+func (q *redisQueue) Pop() (*Delivery, bool) {
+	ctx := context.Background()
+	for {
+		dlv, ok := q.local.Pop()
+		if !ok {
+			return nil, false
+		}
+
+		ok, err := q.client.HExists(ctx, q.entries, dlv.id).Result()
+		if err != nil {
+			// Prefer a possibly deleted delivery
+			// going out to dropping a queued one.
+			log.Errorf(ctx, "error checking delivery %s: %v", dlv.id, err)
+			return dlv, true
+		}
+
+		if !ok {
+			// Deleted since fetched.
+			q.Done(dlv)
+			continue
+		}
+
+		return dlv, true
+	}
+}
+
+// Done: implements Queue{}.
+func (q *redisQueue) Done(dlv *Delivery) {
+	q.remove(context.Background(), dlv.id, &redisDelivery{
+		ActorID:  dlv.ActorID,
+		ObjectID: dlv.ObjectID,
+		TargetID: dlv.TargetID,
+	})
+}
+
+// Delete: implements Queue{}.
+func (q *redisQueue) Delete(index string, key ...any) {
+	q.local.Delete(index, key...)
+
+	if len(key) != 1 {
+		return
+	}
+
+	str, _ := key[0].(string)
+	ctx := context.Background()
+	set := q.indexKey(index, str)
+
+	// Get IDs of all matching deliveries.
+	ids, err := q.client.SMembers(ctx, set).Result()
+	if err != nil {
+		log.Errorf(ctx, "error listing deliveries: %v", err)
+		return
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	// Fetch matching deliveries, to
+	// drop them from other indices.
+	raws, err := q.client.HMGet(ctx, q.entries, ids...).Result()
+	if err != nil {
+		log.Errorf(ctx, "error listing deliveries: %v", err)
+		return
+	}
+
+	pipe := q.client.TxPipeline()
+	rems := make([]*redis.IntCmd, len(ids))
+
+	for i, id := range ids {
+		rems[i] = pipe.LRem(ctx, q.key, 1, id)
+
+		raw, _ := raws[i].(string)
+		if raw == "" {
+			// Already removed.
+			continue
+		}
+
+		var rd redisDelivery
+		if err := json.Unmarshal([]byte(raw), &rd); err != nil {
+			continue
+		}
+
+		for _, key := range q.indexKeys(&rd) {
+			pipe.SRem(ctx, key, id)
+		}
+	}
+
+	// Drop the stored deliveries, so any IDs
+	// fetched in the meantime are skipped.
+	pipe.HDel(ctx, q.entries, ids...)
+	pipe.Del(ctx, set)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Errorf(ctx, "error deleting deliveries: %v", err)
+		return
+	}
+
+	for _, rem := range rems {
+		q.length.Add(-rem.Val())
+	}
+}
+
+// Len: implements Queue{}.
+func (q *redisQueue) Len() int {
+	n := q.local.Len()
+
+	// Only occasionally check the length
+	// in redis, relying on Push / Delete /
+	// fetch to keep it updated in between.
+	if time.Since(time.Unix(0, q.checked.Load())) > lenRefresh {
+		l, err := q.client.LLen(context.Background(), q.key).Result()
+		if err != nil {
+			log.Errorf(nil, "error getting queue length: %v", err)
+		} else {
+			q.setLength(l)
+		}
+	}
+
+	return n + int(max(q.length.Load(), 0))
+}
+
+// Wait: implements Queue{}.
+func (q *redisQueue) Wait() <-chan struct{} {
+	return q.local.Wait()
+}
+
+// lockInbox: implements inboxLocker{}.
+func (q *redisQueue) lockInbox(ctx context.Context, inbox string) (func(), bool) {
+	key := q.key + ":lock:" + inbox
+	token := id.NewULID()
+
+	ok, err := q.client.SetNX(ctx, key, token, lockTimeout).Result()
+	if err != nil {
+		// Don't hold up deliveries
+		// on an unavailable lock.
+		log.Errorf(ctx, "error locking inbox %s: %v", inbox, err)
+		return func() {}, true
+	}
+
+	if !ok {
+		// Already locked.
+		return nil, false
+	}
+
+	return func() {
+		ctx := context.Background()
+
+		// Only release the lock if still ours, it
+		// may have expired and been taken since.
+		keys := []string{key}
+		if err := unlockScript.Run(ctx, q.client, keys, token).Err(); err != nil {
+			log.Errorf(ctx, "error unlocking inbox %s: %v", inbox, err)
+		}
+	}, true
+}
+
+// remove removes the delivery with ID from our processing
+// list and storage, along with its indices if rd is given.
+func (q *redisQueue) remove(ctx context.Context, id string, rd *redisDelivery) {
+	if id == "" {
+		return
+	}
+
+	pipe := q.client.TxPipeline()
+	pipe.LRem(ctx, q.processing, 1, id)
+	pipe.HDel(ctx, q.entries, id)
+	if rd != nil {
+		for _, key := range q.indexKeys(rd) {
+			pipe.SRem(ctx, key, id)
+		}
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Errorf(ctx, "error removing delivery: %v", err)
+	}
+}
+
+// requeueID moves the delivery with ID from our
+// processing list back onto the main queue.
+func (q *redisQueue) requeueID(ctx context.Context, id string) {
+	pipe := q.client.TxPipeline()
+	pipe.LRem(ctx, q.processing, 1, id)
+	pipe.LPush(ctx, q.key, id)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Errorf(ctx, "error requeuing delivery %s: %v", id, err)
+		return
+	}
+
+	q.length.Add(1)
+}
+
+// setLength updates the cached queue length.
+func (q *redisQueue) setLength(l int64) {
+	q.length.Store(l)
+	q.checked.Store(time.Now().UnixNano())
+}
+
+// indexKey returns the key of the set of delivery
+// IDs indexed under given field name and value.
+func (q *redisQueue) indexKey(index string, value string) string {
+	return q.key + ":index:" + index + ":" + value
+}
+
+// indexKeys returns the keys of the index
+// sets that given delivery belongs to.
+func (q *redisQueue) indexKeys(rd *redisDelivery) []string {
+	keys := make([]string, 0, 3)
+	if rd.ActorID != "" {
+		keys = append(keys, q.indexKey("ActorID", rd.ActorID))
+	}
+	if rd.ObjectID != "" {
+		keys = append(keys, q.indexKey("ObjectID", rd.ObjectID))
+	}
+	if rd.TargetID != "" {
+		keys = append(keys, q.indexKey("TargetID", rd.TargetID))
+	}
+	return keys
+}
+
+// decode decodes the raw delivery with ID fetched from redis,
+// rebuilding the POST request with signing function for the
+// sending actor.
+func (q *redisQueue) decode(ctx context.Context, id string, raw []byte) (*Delivery, error) {
+	var rd redisDelivery
+	if err := json.Unmarshal(raw, &rd); err != nil {
+		return nil, gtserror.Newf("error decoding delivery: %w", err)
+	}
+
+	sign, err := q.sign(ctx, rd.PubKeyID, rd.Body)
+	if err != nil {
+		return nil, gtserror.Newf("error getting signer for %s: %w", rd.PubKeyID, err)
+	}
+
+	// Use rewindable reader for body.
+	body := new(byteutil.ReadNopCloser)
+	body.Reset(rd.Body)
+
+	// Update to-be-used request context with signing details.
+	rctx := gtscontext.SetOutgoingPublicKeyID(context.Background(), rd.PubKeyID)
+	rctx = gtscontext.SetHTTPClientSignFunc(rctx, sign)
+
+	r, err := http.NewRequestWithContext(rctx, "POST", rd.URL, body)
+	if err != nil {
+		return nil, gtserror.Newf("error preparing request: %w", err)
+	}
+
+	for k, v := range rd.Header {
+		r.Header[k] = v
+	}
+
+	return &Delivery{
+		ActorID:      rd.ActorID,
+		ObjectID:     rd.ObjectID,
+		TargetID:     rd.TargetID,
+		ActivityType: rd.Type,
+		Request:      httpclient.WrapRequest(r),
+		id:           id,
+	}, nil
+}
+
+// encodeDelivery prepares given delivery for storage in redis.
+func encodeDelivery(dlv *Delivery) (*redisDelivery, error) {
+	r := dlv.Request.Request
+
+	rd := &redisDelivery{
+		ActorID:  dlv.ActorID,
+		ObjectID: dlv.ObjectID,
+		TargetID: dlv.TargetID,
+		Type:     dlv.ActivityType,
+		PubKeyID: gtscontext.OutgoingPublicKeyID(r.Context()),
+		URL:      r.URL.String(),
+		Header:   r.Header,
+	}
+
+	switch body := r.Body.(type) {
+	case nil:
+	case *byteutil.ReadNopCloser:
+		rd.Body = body.B
+	default:
+		return nil, gtserror.Newf("unsupported request body type %T", body)
+	}
+
+	return rd, nil
+}
+
+// sleep sleeps for d, returning false if ctx was cancelled.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	select {
+	case <-ctx.Done():
+		t.Stop()
+		return false
+	case <-t.C:
+		return true
+	}
+}
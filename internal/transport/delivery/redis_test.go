@@ -0,0 +1,280 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-byteutil"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+)
+
+func TestRedisQueueNoDuplicates(t *testing.T) {
+	srv := miniredis.RunT(t)
+
+	nodeA := newTestRedisQueue(srv, "a")
+	nodeB := newTestRedisQueue(srv, "b")
+
+	const n = 50
+	for i := 0; i < n; i++ {
+		nodeA.Push(newTestDelivery(t, i))
+	}
+
+	nodeA.start(4)
+	defer nodeA.stop()
+	nodeB.start(4)
+	defer nodeB.stop()
+
+	seen := make(map[string]int)
+	waitFor(t, "deliveries", func() bool {
+		for _, q := range []*redisQueue{nodeA, nodeB} {
+			for {
+				dlv, ok := q.Pop()
+				if !ok {
+					break
+				}
+				seen[dlv.ObjectID]++
+				q.Done(dlv)
+			}
+		}
+		return len(seen) == n
+	})
+
+	for id, count := range seen {
+		if count != 1 {
+			t.Errorf("delivery %s popped %d times", id, count)
+		}
+	}
+
+	for _, key := range []string{"test", "test:processing:a", "test:processing:b", "test:deliveries"} {
+		if srv.Exists(key) {
+			t.Errorf("expected %s to be empty", key)
+		}
+	}
+}
+
+func TestRedisQueueDelete(t *testing.T) {
+	srv := miniredis.RunT(t)
+
+	q := newTestRedisQueue(srv, "a")
+	for i := 0; i < 3; i++ {
+		q.Push(newTestDelivery(t, i))
+	}
+
+	q.Delete("ObjectID", "https://example.org/objects/1")
+	if l := q.Len(); l != 2 {
+		t.Fatalf("expected 2 queued deliveries, got %d", l)
+	}
+
+	if srv.Exists("test:index:ObjectID:https://example.org/objects/1") {
+		t.Fatal("expected deleted delivery index to be removed")
+	}
+
+	q.start(4)
+	defer q.stop()
+
+	var ids []string
+	waitFor(t, "deliveries", func() bool {
+		if dlv, ok := q.Pop(); ok {
+			ids = append(ids, dlv.ObjectID)
+		}
+		return len(ids) == 2
+	})
+
+	for _, id := range ids {
+		if id == "https://example.org/objects/1" {
+			t.Fatal("deleted delivery was popped")
+		}
+	}
+}
+
+func TestRedisQueueDeleteFetched(t *testing.T) {
+	srv := miniredis.RunT(t)
+
+	nodeA := newTestRedisQueue(srv, "a")
+	nodeB := newTestRedisQueue(srv, "b")
+
+	nodeA.Push(newTestDelivery(t, 0))
+	nodeA.start(1)
+	defer nodeA.stop()
+
+	// Wait for node A to buffer
+	// the delivery locally.
+	waitFor(t, "fetch", func() bool {
+		return nodeA.local.Len() == 1
+	})
+
+	// Delete on node B, node A
+	// must not then hand it out.
+	nodeB.Delete("ObjectID", "https://example.org/objects/0")
+	if _, ok := nodeA.Pop(); ok {
+		t.Fatal("deleted delivery was popped")
+	}
+
+	if srv.Exists("test:processing:a") {
+		t.Fatal("expected processing list to be empty")
+	}
+}
+
+func TestRedisQueueRequeue(t *testing.T) {
+	srv := miniredis.RunT(t)
+
+	q := newTestRedisQueue(srv, "a")
+	q.Push(newTestDelivery(t, 0))
+	q.start(1)
+
+	// Pop but never mark done,
+	// i.e. the node has died.
+	waitFor(t, "delivery", func() bool {
+		_, ok := q.Pop()
+		return ok
+	})
+	q.stop()
+
+	if l, _ := srv.List("test:processing:a"); len(l) != 1 {
+		t.Fatalf("expected 1 in-progress delivery, got %d", len(l))
+	}
+
+	// Restarted node should requeue
+	// and then fetch the delivery.
+	q = newTestRedisQueue(srv, "a")
+	q.start(1)
+	defer q.stop()
+
+	waitFor(t, "requeued delivery", func() bool {
+		dlv, ok := q.Pop()
+		return ok && dlv.ObjectID == "https://example.org/objects/0"
+	})
+}
+
+func TestRedisQueueLockInbox(t *testing.T) {
+	srv := miniredis.RunT(t)
+
+	ctx := context.Background()
+	nodeA := newTestRedisQueue(srv, "a")
+	nodeB := newTestRedisQueue(srv, "b")
+
+	const inbox = "https://example.org/inbox"
+
+	unlock, ok := nodeA.lockInbox(ctx, inbox)
+	if !ok {
+		t.Fatal("expected to acquire inbox lock")
+	}
+
+	if _, ok := nodeB.lockInbox(ctx, inbox); ok {
+		t.Fatal("expected inbox to be locked")
+	}
+
+	unlock()
+
+	unlock, ok = nodeB.lockInbox(ctx, inbox)
+	if !ok {
+		t.Fatal("expected to acquire released inbox lock")
+	}
+
+	// Let node B's lock expire and be
+	// taken by node A, a late unlock by
+	// B must not release A's lock.
+	srv.FastForward(lockTimeout)
+	unlockA, ok := nodeA.lockInbox(ctx, inbox)
+	if !ok {
+		t.Fatal("expected to acquire expired inbox lock")
+	}
+	unlock()
+
+	if _, ok := nodeB.lockInbox(ctx, inbox); ok {
+		t.Fatal("expected inbox to still be locked")
+	}
+	unlockA()
+}
+
+func TestRedisInitAuth(t *testing.T) {
+	srv := miniredis.RunT(t)
+	srv.RequireAuth("secret")
+
+	config.SetFederationDeliveryBackend(config.FederationDeliveryBackendRedis)
+	config.SetFederationDeliveryRedisAddress(srv.Addr())
+	defer config.SetFederationDeliveryBackend(config.FederationDeliveryBackendMemory)
+
+	var wp WorkerPool
+	if err := wp.Init(nil); err == nil {
+		t.Fatal("expected error initializing with wrong password")
+	}
+
+	config.SetFederationDeliveryRedisPassword("secret")
+	defer config.SetFederationDeliveryRedisPassword("")
+
+	if err := wp.Init(nil); err != nil {
+		t.Fatalf("unexpected error initializing: %v", err)
+	}
+}
+
+func newTestRedisQueue(srv *miniredis.Miniredis, node string) *redisQueue {
+	return newRedisQueue(
+		redis.NewClient(&redis.Options{Addr: srv.Addr()}),
+		"test",
+		node,
+		func(context.Context, string, []byte) (httpclient.SignFunc, error) {
+			return func(*http.Request) error { return nil }, nil
+		},
+	)
+}
+
+func newTestDelivery(t *testing.T, i int) *Delivery {
+	var body byteutil.ReadNopCloser
+	body.Reset([]byte(`{"type":"Create"}`))
+
+	ctx := gtscontext.SetOutgoingPublicKeyID(
+		context.Background(),
+		"https://localhost/users/test#main-key",
+	)
+
+	r, err := http.NewRequestWithContext(ctx,
+		"POST",
+		"https://example.org/users/"+strconv.Itoa(i)+"/inbox",
+		&body,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Delivery{
+		ObjectID: "https://example.org/objects/" + strconv.Itoa(i),
+		Request:  httpclient.WrapRequest(r),
+	}
+}
+
+// waitFor waits for up to
+// 5s for cond to be true.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	for i := 0; i < 500; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
@@ -0,0 +1,184 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"golang.org/x/net/html"
+)
+
+const (
+	// maxCanonicalRedirects is the maximum number of
+	// redirects permitted when resolving a canonical URI.
+	maxCanonicalRedirects = 5
+
+	// maxCanonicalBodySize is the maximum number of bytes
+	// of response body (whether HTML or ActivityStreams
+	// JSON) read when resolving a canonical URI.
+	maxCanonicalBodySize = 256 * 1024
+)
+
+func (t *transport) DereferenceCanonicalURI(ctx context.Context, iri *url.URL) (*url.URL, error) {
+	// Limit the redirects followed by the http client.
+	ctx = gtscontext.SetMaxRedirects(ctx, maxCanonicalRedirects)
+
+	// Prepare new HTTP request to endpoint
+	req, err := http.NewRequestWithContext(ctx, "GET", iri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prefer ActivityStreams content, but accept HTML
+	// too, as many implementations will only ever serve
+	// HTML at their web URLs regardless of Accept header.
+	req.Header.Add("Accept", string(apiutil.AppActivityLDJSON)+","+string(apiutil.AppActivityJSON)+","+apiutil.TextHTML+";q=0.9")
+	req.Header.Add("Accept-Charset", "utf-8")
+
+	// Perform the HTTP request
+	rsp, err := t.GET(req)
+	if errors.Is(err, httpclient.ErrTooManyRedirects) {
+		err := gtserror.Newf("too many redirects resolving %s: %w", iri, err)
+		return nil, gtserror.SetUnretrievable(err)
+	} else if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	// Ensure a non-error status response.
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+
+	// Final URL after following any redirects.
+	final := rsp.Request.URL
+
+	// Limit the amount of body we're willing to read.
+	body := io.LimitReader(rsp.Body, maxCanonicalBodySize)
+
+	var canonical *url.URL
+	switch ct := rsp.Header.Get("Content-Type"); {
+
+	// Content negotiation succeeded, the
+	// canonical URI is the object's own ID.
+	case apiutil.ASContentType(ct):
+		var obj struct {
+			ID string `json:"id"`
+		}
+
+		if err := json.NewDecoder(body).Decode(&obj); err != nil {
+			err := gtserror.Newf("error decoding activitystreams response: %w", err)
+			return nil, gtserror.SetMalformed(err)
+		}
+
+		if canonical, err = url.Parse(obj.ID); err != nil {
+			err := gtserror.Newf("invalid object id %q: %w", obj.ID, err)
+			return nil, gtserror.SetMalformed(err)
+		}
+
+	// Negotiation failed, look for an
+	// ActivityStreams alternate link.
+	case apiutil.HTMLContentType(ct):
+		href, ok := alternateASLink(body)
+		if !ok {
+			err := gtserror.Newf("no activitystreams alternate link found at %s", final)
+			return nil, gtserror.SetUnretrievable(err)
+		}
+
+		// Resolve link relative to final URL,
+		// as some implementations (e.g. GtS)
+		// only provide an absolute path here.
+		if canonical, err = final.Parse(href); err != nil {
+			err := gtserror.Newf("invalid alternate link %q: %w", href, err)
+			return nil, gtserror.SetMalformed(err)
+		}
+
+	default:
+		err := gtserror.Newf("non activitystreams or html response: %s", ct)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	if canonical.Scheme != "https" && canonical.Scheme != "http" {
+		err := gtserror.Newf("invalid canonical uri: %s", canonical)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	return canonical, nil
+}
+
+// alternateASLink reads the given HTML document looking for an
+// ActivityStreams alternate link in the document head, e.g.:
+//
+//	<link rel="alternate" type="application/activity+json" href="...">
+//
+// Returns the (possibly relative) href, and whether one was found.
+func alternateASLink(r io.Reader) (string, bool) {
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// EOF, or body size limit reached.
+			return "", false
+
+		case html.EndTagToken:
+			if name, _ := z.TagName(); string(name) == "head" {
+				// No point searching body.
+				return "", false
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			if string(name) != "link" || !hasAttr {
+				continue
+			}
+
+			var rel, typ, href string
+			for {
+				key, val, more := z.TagAttr()
+				switch string(key) {
+				case "rel":
+					rel = string(val)
+				case "type":
+					typ = string(val)
+				case "href":
+					href = string(val)
+				}
+				if !more {
+					break
+				}
+			}
+
+			if href != "" &&
+				slices.Contains(strings.Fields(strings.ToLower(rel)), "alternate") &&
+				apiutil.ASContentType(typ) {
+				return href, true
+			}
+		}
+	}
+}
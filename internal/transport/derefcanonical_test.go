@@ -0,0 +1,224 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// Trimmed web page of a status as served by Mastodon.
+const mastodonStatusHTML = `<!DOCTYPE html>
+<html lang='en'>
+<head>
+<meta charset='utf-8'>
+<meta content='width=device-width, initial-scale=1' name='viewport'>
+<link rel="icon" href="/favicon.ico" type="image/x-icon">
+<title>Eugen Rochko (@Gargron@mastodon.social)</title>
+<link href='https://mastodon.social/users/Gargron/statuses/112386427398580536' rel='alternate' type='application/activity+json'>
+<meta content="Eugen Rochko (@Gargron@mastodon.social)" property="og:title" />
+<link href='https://mastodon.social/api/oembed?format=json&amp;url=https%3A%2F%2Fmastodon.social%2F%40Gargron%2F112386427398580536' rel='alternate' type='application/json+oembed'>
+</head>
+<body class='app-body'>
+<div class='notranslate app-holder' id='mastodon'></div>
+</body>
+</html>`
+
+// Trimmed web page of a status as served by Akkoma.
+const akkomaStatusHTML = `<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width,initial-scale=1,user-scalable=no" />
+    <title>akkoma.example.org</title>
+    <meta content="hello world" property="og:description">
+    <link rel="alternate" type="application/atom+xml" href="https://akkoma.example.org/users/someone/feed.atom">
+    <link rel="alternate" type="application/activity+json" href="https://akkoma.example.org/objects/5b1a2e4c-1e2a-4b6d-8f0e-1c2d3e4f5a6b" />
+  </head>
+  <body>
+    <noscript>To use Akkoma, please enable JavaScript.</noscript>
+    <div id="app"></div>
+  </body>
+</html>`
+
+// Trimmed web page of a status as served by GoToSocial.
+const gtsStatusHTML = `<!DOCTYPE html>
+<html lang="en">
+    <head>
+        <meta charset="UTF-8">
+        <meta http-equiv="X-UA-Compatible" content="IE=edge">
+        <meta name="viewport" content="width=device-width, initial-scale=1.0">
+        <meta name="robots" content="noindex, nofollow">
+        <link rel="alternate" type="application/rss+xml" href="/@someone/feed.rss" title="gts.example.org">
+        <link rel="alternate" type="application/activity+json" href="/users/someone/statuses/01F8MH75CBF9JFX4ZAD54N0W0R">
+        <title>Public post by @someone@gts.example.org - GoToSocial</title>
+    </head>
+    <body>
+        <div class="page"></div>
+    </body>
+</html>`
+
+// Web page with no alternate link in the head,
+// only an (ignored) one in the document body.
+const noAlternateHTML = `<!DOCTYPE html>
+<html>
+<head><title>nothing here</title></head>
+<body><link rel="alternate" type="application/activity+json" href="https://example.org/sneaky"></body>
+</html>`
+
+type DereferenceCanonicalTestSuite struct {
+	TransportTestSuite
+}
+
+// transportFor returns a transport using a mock
+// http client that returns given response data,
+// following given number of (fake) redirects, as
+// limited by the request's context like httpclient.
+func (suite *DereferenceCanonicalTestSuite) transportFor(contentType string, body string, redirects int) transport.Transport {
+	do := func(req *http.Request) (*http.Response, error) {
+		if max, ok := gtscontext.MaxRedirects(req.Context()); ok && redirects > max {
+			return nil, &url.Error{
+				Op:  req.Method,
+				URL: req.URL.String(),
+				Err: httpclient.ErrTooManyRedirects,
+			}
+		}
+
+		final := req
+		for i := 0; i < redirects; i++ {
+			// Wrap request in redirect chain.
+			final = &http.Request{
+				Method: final.Method,
+				URL:    final.URL,
+				Header: final.Header,
+				Response: &http.Response{
+					StatusCode: http.StatusFound,
+					Request:    final,
+				},
+			}
+		}
+		return &http.Response{
+			Request:    final,
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": {contentType}},
+		}, nil
+	}
+
+	tc := testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(do, ""))
+	ts, err := tc.NewTransportForUsername(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return ts
+}
+
+func (suite *DereferenceCanonicalTestSuite) TestDereferenceCanonicalURI() {
+	for _, test := range []struct {
+		name        string
+		iri         string
+		contentType string
+		body        string
+		redirects   int
+		expect      string
+		expectErr   bool
+	}{
+		{
+			name:        "mastodon html",
+			iri:         "https://mastodon.social/@Gargron/112386427398580536",
+			contentType: "text/html; charset=utf-8",
+			body:        mastodonStatusHTML,
+			expect:      "https://mastodon.social/users/Gargron/statuses/112386427398580536",
+		},
+		{
+			name:        "akkoma html",
+			iri:         "https://akkoma.example.org/notice/AhJd9bQ5X6mE4Yd7Rw",
+			contentType: "text/html",
+			body:        akkomaStatusHTML,
+			expect:      "https://akkoma.example.org/objects/5b1a2e4c-1e2a-4b6d-8f0e-1c2d3e4f5a6b",
+		},
+		{
+			name:        "gotosocial html with relative link",
+			iri:         "https://gts.example.org/@someone/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+			contentType: "text/html; charset=utf-8",
+			body:        gtsStatusHTML,
+			expect:      "https://gts.example.org/users/someone/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+		},
+		{
+			name:        "content negotiation after redirects",
+			iri:         "https://mastodon.social/@Gargron/112386427398580536",
+			contentType: "application/activity+json; charset=utf-8",
+			body:        `{"@context":"https://www.w3.org/ns/activitystreams","id":"https://mastodon.social/users/Gargron/statuses/112386427398580536","type":"Note"}`,
+			redirects:   2,
+			expect:      "https://mastodon.social/users/Gargron/statuses/112386427398580536",
+		},
+		{
+			name:        "too many redirects",
+			iri:         "https://mastodon.social/@Gargron/112386427398580536",
+			contentType: "text/html",
+			body:        mastodonStatusHTML,
+			redirects:   6,
+			expectErr:   true,
+		},
+		{
+			name:        "no alternate link in head",
+			iri:         "https://example.org/some/page",
+			contentType: "text/html",
+			body:        noAlternateHTML,
+			expectErr:   true,
+		},
+		{
+			name:        "unexpected content type",
+			iri:         "https://example.org/some/image.png",
+			contentType: "image/png",
+			body:        "not really a png",
+			expectErr:   true,
+		},
+	} {
+		iri, err := url.Parse(test.iri)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		ts := suite.transportFor(test.contentType, test.body, test.redirects)
+		canonical, err := ts.DereferenceCanonicalURI(context.Background(), iri)
+		if test.expectErr {
+			suite.Error(err, test.name)
+			continue
+		}
+
+		if !suite.NoError(err, test.name) {
+			continue
+		}
+		suite.Equal(test.expect, canonical.String(), test.name)
+	}
+}
+
+func TestDereferenceCanonicalTestSuite(t *testing.T) {
+	suite.Run(t, new(DereferenceCanonicalTestSuite))
+}
@@ -67,6 +67,11 @@ type Transport interface {
 	// Dereference fetches the ActivityStreams object located at this IRI with a GET request.
 	Dereference(ctx context.Context, iri *url.URL) (*http.Response, error)
 
+	// DereferenceCanonicalURI attempts to determine the canonical ActivityPub URI of the object
+	// located at this IRI, which may be a web URL. It first tries content negotiation, following
+	// redirects, then falls back to an ActivityStreams alternate link in returned HTML.
+	DereferenceCanonicalURI(ctx context.Context, iri *url.URL) (*url.URL, error)
+
 	// DereferenceMedia fetches the given media attachment IRI, returning the reader and filesize.
 	DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error)
 
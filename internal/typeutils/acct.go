@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// ParseAcct parses the username and domain from the given
+// account namestring, eg., "user@example.org". A single
+// leading "@" and an "acct:" scheme prefix are permitted,
+// so "acct:user@example.org", "@user@example.org" and
+// "user@example.org" are all equivalent.
+//
+// A namestring without a domain, eg., "user" or "@user",
+// returns an empty domain, which callers should take to
+// mean the account is local (or the domain is unknown).
+//
+// The returned domain is normalized to lowercase punycode,
+// with any trailing "." of a fully-qualified name removed.
+//
+// Will error if username or domain are empty, if the string
+// contains more than one "@" after the optional leading "@",
+// or if the domain cannot be converted to punycode.
+func ParseAcct(acct string) (username, domain string, err error) {
+	s := strings.TrimSpace(acct)
+	s = strings.TrimPrefix(s, "acct:")
+	s = strings.TrimPrefix(s, "@")
+
+	username, domain, hasDomain := strings.Cut(s, "@")
+
+	switch {
+	case username == "":
+		return "", "", fmt.Errorf("no username in acct %q", acct)
+
+	case strings.ContainsAny(username, " \t\r\n/:"):
+		return "", "", fmt.Errorf("invalid username in acct %q", acct)
+
+	case !hasDomain:
+		// Local-only acct.
+		return username, "", nil
+
+	case strings.Contains(domain, "@"):
+		return "", "", fmt.Errorf("too many '@' in acct %q", acct)
+	}
+
+	domain = strings.TrimSuffix(domain, ".")
+	if domain == "" {
+		return "", "", fmt.Errorf("no domain in acct %q", acct)
+	}
+
+	domain, err = util.Punify(domain)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid domain in acct %q: %w", acct, err)
+	}
+
+	if strings.ContainsAny(domain, " \t\r\n/") {
+		return "", "", fmt.Errorf("invalid domain in acct %q", acct)
+	}
+
+	return username, domain, nil
+}
+
+// AccountToWebfingerAcct returns the namestring by which the
+// given account can be queried via webfinger, without leading
+// "@" or "acct:" scheme, eg., "user@example.org". Local accounts
+// (which have no stored domain) use the given localDomain, which
+// should usually be the configured account-domain.
+func AccountToWebfingerAcct(account *gtsmodel.Account, localDomain string) string {
+	domain := account.Domain
+	if domain == "" {
+		domain = localDomain
+	}
+	return account.Username + "@" + domain
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils_test
+
+import (
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+func TestParseAcct(t *testing.T) {
+	for _, test := range []struct {
+		acct     string
+		username string
+		domain   string
+		err      bool
+	}{
+		{acct: "@user@example.org", username: "user", domain: "example.org"},
+		{acct: "user@example.org", username: "user", domain: "example.org"},
+		{acct: "acct:user@example.org", username: "user", domain: "example.org"},
+		{acct: "acct:@user@example.org", username: "user", domain: "example.org"},
+		{acct: " user@Example.ORG. ", username: "user", domain: "example.org"},
+		{acct: "user@例え.jp", username: "user", domain: "xn--r8jz45g.jp"},
+		{acct: "user@example.org:8080", username: "user", domain: "example.org:8080"},
+		{acct: "user", username: "user"},
+		{acct: "@user", username: "user"},
+		{acct: "", err: true},
+		{acct: "@", err: true},
+		{acct: "@@user@example.org", err: true},
+		{acct: "user@", err: true},
+		{acct: "user@.", err: true},
+		{acct: "user@example.org@other.org", err: true},
+		{acct: "us er@example.org", err: true},
+		{acct: "https://example.org/users/user", err: true},
+	} {
+		username, domain, err := typeutils.ParseAcct(test.acct)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: expected error, got %q %q", test.acct, username, domain)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.acct, err)
+			continue
+		}
+
+		if username != test.username || domain != test.domain {
+			t.Errorf("%q: expected %q %q, got %q %q", test.acct, test.username, test.domain, username, domain)
+		}
+	}
+}
+
+func TestAccountToWebfingerAcct(t *testing.T) {
+	local := &gtsmodel.Account{Username: "user"}
+	if acct := typeutils.AccountToWebfingerAcct(local, "example.org"); acct != "user@example.org" {
+		t.Errorf("unexpected local acct %q", acct)
+	}
+
+	remote := &gtsmodel.Account{Username: "someone", Domain: "remote.example"}
+	if acct := typeutils.AccountToWebfingerAcct(remote, "example.org"); acct != "someone@remote.example" {
+		t.Errorf("unexpected remote acct %q", acct)
+	}
+
+	// Output should round trip.
+	username, domain, err := typeutils.ParseAcct(typeutils.AccountToWebfingerAcct(remote, "example.org"))
+	if err != nil || username != remote.Username || domain != remote.Domain {
+		t.Errorf("unexpected round trip %q %q %v", username, domain, err)
+	}
+}
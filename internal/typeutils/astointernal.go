@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -264,6 +265,9 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		ap.ExtractContent(statusable),
 	)
 
+	// status.WordCount
+	status.WordCount = text.WordCount(status.Content)
+
 	// status.Attachments
 	//
 	// Media attachments for later dereferencing.
@@ -314,11 +318,7 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	//
 	// Topic or content warning for this status;
 	// prefer Summary, fall back to Name.
-	if summary := ap.ExtractSummary(statusable); summary != "" {
-		status.ContentWarning = summary
-	} else {
-		status.ContentWarning = ap.ExtractName(statusable)
-	}
+	status.ContentWarning = contentWarning(statusable, &status)
 
 	// status.Published
 	//
@@ -285,6 +285,144 @@ func (suite *ASToInternalTestSuite) TestParseBookwyrmStatus() {
 	suite.Len(status.Attachments, 1)
 }
 
+func (suite *ASToInternalTestSuite) TestContentWarningRoundTrip() {
+	authorAccount := suite.testAccounts["remote_account_1"]
+
+	for _, test := range []struct {
+		name     string
+		raw      string
+		expectCW string
+	}{
+		{
+			// Mastodon sends summary as plain text,
+			// and media-only posts with empty content.
+			name: "mastodon media-only with cw",
+			raw: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + authorAccount.URI + `/statuses/112598456021238591",
+  "type": "Note",
+  "summary": "food & drink <3",
+  "inReplyTo": null,
+  "published": "2024-06-10T10:12:43Z",
+  "url": "` + authorAccount.URL + `/112598456021238591",
+  "attributedTo": "` + authorAccount.URI + `",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "cc": ["` + authorAccount.FollowersURI + `"],
+  "sensitive": true,
+  "content": "",
+  "contentMap": {"en": ""},
+  "attachment": [
+    {
+      "type": "Document",
+      "mediaType": "image/jpeg",
+      "url": "http://fossbros-anonymous.io/system/media_attachments/files/112/598/455/original/7c1d3ef4e6b2a9d0.jpeg",
+      "name": "A cup of coffee next to a croissant.",
+      "blurhash": "UFGb6F~VI=xa.8M{WBoLt7xa%2NGIVIoM{xZ",
+      "width": 1200,
+      "height": 900
+    }
+  ],
+  "tag": []
+}`,
+			expectCW: "food & drink <3",
+		},
+		{
+			// Akkoma sends summary with emojis
+			// and HTML-escaped special characters.
+			name: "akkoma escaped cw with emoji",
+			raw: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + authorAccount.URI + `/objects/9f2a4c1e-2b7d-4e0a-a1c3-5d6e7f809a1b",
+  "type": "Note",
+  "summary": "spoilers for &quot;Dune&quot; :blobcat:",
+  "published": "2024-06-10T11:02:17.118523Z",
+  "attributedTo": "` + authorAccount.URI + `",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "cc": ["` + authorAccount.FollowersURI + `"],
+  "sensitive": true,
+  "source": {"content": "the spice must flow", "mediaType": "text/plain"},
+  "content": "the spice must flow",
+  "attachment": [],
+  "tag": []
+}`,
+			expectCW: "spoilers for \"Dune\" :blobcat:",
+		},
+		{
+			// Friendica may send summary as HTML,
+			// with entities in the text escaped.
+			name: "friendica html cw",
+			raw: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + authorAccount.URI + `/objects/b1f32c66-1566-6ae3-a1c7-3c5154937488",
+  "type": "Note",
+  "summary": "<p>US politics &amp;amp; elections</p>",
+  "published": "2024-06-10T12:30:00Z",
+  "attributedTo": "` + authorAccount.URI + `",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "cc": ["` + authorAccount.FollowersURI + `"],
+  "sensitive": true,
+  "content": "<p>read this<br/>and weep</p>",
+  "attachment": [],
+  "tag": []
+}`,
+			expectCW: "US politics &amp; elections",
+		},
+		{
+			name: "name is media description",
+			raw: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + authorAccount.URI + `/objects/0f0e1d2c-3b4a-5968-7766-554433221100",
+  "type": "Note",
+  "name": "a photo of a sunset",
+  "published": "2024-06-10T13:45:00Z",
+  "attributedTo": "` + authorAccount.URI + `",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "cc": ["` + authorAccount.FollowersURI + `"],
+  "content": "",
+  "attachment": [
+    {
+      "type": "Image",
+      "mediaType": "image/png",
+      "url": "http://fossbros-anonymous.io/attachments/sunset.png",
+      "name": "a photo of a sunset"
+    }
+  ],
+  "tag": []
+}`,
+			expectCW: "",
+		},
+	} {
+		t := suite.jsonToType(test.raw)
+		statusable, ok := t.(ap.Statusable)
+		if !ok {
+			suite.FailNow("type not coercible", test.name)
+		}
+
+		// Incoming: summary should be stored
+		// as plain text, decoded exactly once.
+		status, err := suite.typeconverter.ASStatusToStatus(context.Background(), statusable)
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+		suite.Equal(test.expectCW, status.ContentWarning, test.name)
+
+		// Outgoing: summary should be the same
+		// plain text, and content never empty.
+		status.Account = authorAccount
+		asStatus, err := suite.typeconverter.StatusToAS(context.Background(), status)
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+
+		raw, err := ap.Serialize(asStatus)
+		if err != nil {
+			suite.FailNow(err.Error(), test.name)
+		}
+		suite.Equal(test.expectCW, raw["summary"], test.name)
+		suite.NotEmpty(raw["content"], test.name)
+	}
+}
+
 func (suite *ASToInternalTestSuite) TestParseFlag1() {
 	reportedAccount := suite.testAccounts["local_account_1"]
 	reportingAccount := suite.testAccounts["remote_account_1"]
@@ -473,19 +473,20 @@ const (
 
 type TypeUtilsTestSuite struct {
 	suite.Suite
-	db                 db.DB
-	state              state.State
-	testAccounts       map[string]*gtsmodel.Account
-	testStatuses       map[string]*gtsmodel.Status
-	testAttachments    map[string]*gtsmodel.MediaAttachment
-	testPeople         map[string]vocab.ActivityStreamsPerson
-	testEmojis         map[string]*gtsmodel.Emoji
-	testReports        map[string]*gtsmodel.Report
-	testMentions       map[string]*gtsmodel.Mention
-	testPollVotes      map[string]*gtsmodel.PollVote
-	testFilters        map[string]*gtsmodel.Filter
-	testFilterKeywords map[string]*gtsmodel.FilterKeyword
-	testFilterStatues  map[string]*gtsmodel.FilterStatus
+	db                  db.DB
+	state               state.State
+	testAccounts        map[string]*gtsmodel.Account
+	testStatuses        map[string]*gtsmodel.Status
+	testAttachments     map[string]*gtsmodel.MediaAttachment
+	testPeople          map[string]vocab.ActivityStreamsPerson
+	testEmojis          map[string]*gtsmodel.Emoji
+	testEmojiCategories map[string]*gtsmodel.EmojiCategory
+	testReports         map[string]*gtsmodel.Report
+	testMentions        map[string]*gtsmodel.Mention
+	testPollVotes       map[string]*gtsmodel.PollVote
+	testFilters         map[string]*gtsmodel.Filter
+	testFilterKeywords  map[string]*gtsmodel.FilterKeyword
+	testFilterStatues   map[string]*gtsmodel.FilterStatus
 
 	typeconverter *typeutils.Converter
 }
@@ -506,6 +507,7 @@ func (suite *TypeUtilsTestSuite) SetupTest() {
 	suite.testAttachments = testrig.NewTestAttachments()
 	suite.testPeople = testrig.NewTestFediPeople()
 	suite.testEmojis = testrig.NewTestEmojis()
+	suite.testEmojiCategories = testrig.NewTestEmojiCategories()
 	suite.testReports = testrig.NewTestReports()
 	suite.testMentions = testrig.NewTestMentions()
 	suite.testPollVotes = testrig.NewTestPollVotes()
@@ -18,8 +18,19 @@
 package typeutils
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 func APIVisToVis(m apimodel.Visibility) gtsmodel.Visibility {
@@ -57,3 +68,154 @@ func APIFilterActionToFilterAction(m apimodel.FilterAction) gtsmodel.FilterActio
 	}
 	return gtsmodel.FilterActionNone
 }
+
+// APIFeaturedTagToFeaturedTag creates a new gts model featured
+// tag for the given account from the raw tag name submitted on
+// the API, which may include the hash prefix. The name is
+// normalized in the same way as hashtags in statuses, and
+// an error is returned if it isn't a valid hashtag.
+func APIFeaturedTagToFeaturedTag(account *gtsmodel.Account, rawName string) (*gtsmodel.FeaturedTag, error) {
+	name, ok := text.NormalizeHashtag(rawName)
+	if !ok {
+		return nil, gtserror.Newf("%s is not a valid hashtag", rawName)
+	}
+
+	return &gtsmodel.FeaturedTag{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Account:   account,
+		Name:      name,
+	}, nil
+}
+
+// NoteTooLongError is returned by APIAccountNoteToNote
+// when a note is longer than the permitted max length.
+type NoteTooLongError struct {
+	Length int // No. characters in the (trimmed) note.
+	Max    int // Max permitted no. characters.
+}
+
+func (e *NoteTooLongError) Error() string {
+	return fmt.Sprintf("note too long, %d characters provided but limit is %d", e.Length, e.Max)
+}
+
+// APIAccountNoteToNote normalizes the comment of a private note
+// on an account submitted on the API, trimming surrounding space.
+// A *NoteTooLongError is returned if the trimmed comment is longer
+// than maxLen characters. An empty result clears the note.
+func APIAccountNoteToNote(comment string, maxLen int) (string, error) {
+	comment = strings.TrimSpace(comment)
+	if length := len([]rune(comment)); length > maxLen {
+		return "", &NoteTooLongError{Length: length, Max: maxLen}
+	}
+	return comment, nil
+}
+
+// APIPollVoteToPollVote creates a new gts model poll vote
+// in the given poll by voter, from the option indices
+// submitted on the API. An error is returned if the poll
+// has expired or closed, or if the choices are invalid for
+// the poll, i.e. empty, out of range, duplicated, or more
+// than one choice for a single-choice poll.
+func APIPollVoteToPollVote(poll *gtsmodel.Poll, choices []int, voter *gtsmodel.Account) (*gtsmodel.PollVote, error) {
+	if poll.Closed() || poll.Expired() {
+		return nil, errors.New("poll already closed")
+	}
+
+	if len(choices) == 0 || (!*poll.Multiple && len(choices) > 1) {
+		return nil, errors.New("invalid number of choices for poll")
+	}
+
+	seen := make(map[int]struct{}, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(poll.Options) {
+			return nil, fmt.Errorf("invalid option index %d for poll", choice)
+		}
+
+		if _, ok := seen[choice]; ok {
+			return nil, fmt.Errorf("duplicate option index %d for poll", choice)
+		}
+		seen[choice] = struct{}{}
+	}
+
+	return &gtsmodel.PollVote{
+		ID:        id.NewULID(),
+		Choices:   choices,
+		AccountID: voter.ID,
+		Account:   voter,
+		PollID:    poll.ID,
+		Poll:      poll,
+	}, nil
+}
+
+// APIAliasesToAlsoKnownAsURIs normalizes the account aliases
+// submitted on the API for the given account. Each alias may
+// be an http(s) account URI, or an account namestring such as
+// "@someone@example.org" (the leading "@" is optional).
+//
+// Namestrings of local accounts are resolved to the local account
+// URI. Namestrings of remote accounts are returned in the form
+// "@username@domain", as these can only be resolved to a URI
+// via webfinger, which is left to the caller.
+//
+// Duplicates and aliases of the account itself are dropped,
+// and an error is returned if any alias is malformed.
+func APIAliasesToAlsoKnownAsURIs(aliases []string, self *gtsmodel.Account) ([]string, error) {
+	var (
+		akaURIs = make([]string, 0, len(aliases))
+	)
+
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+
+		// Try the alias as a URI first.
+		if uri, err := url.Parse(alias); err == nil &&
+			(uri.Scheme == "https" || uri.Scheme == "http") && uri.Host != "" {
+			akaURI := uri.String()
+			if akaURI == self.URI || akaURI == self.URL {
+				// Don't alias to self.
+				continue
+			}
+
+			akaURIs = append(akaURIs, akaURI)
+			continue
+		}
+
+		// Else it must be a namestring.
+		namestring := alias
+		if !strings.HasPrefix(namestring, "@") {
+			namestring = "@" + namestring
+		}
+
+		username, domain, err := util.ExtractNamestringParts(namestring)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid alias (%s): must be an http or https uri, or a namestring like @someone@example.org",
+				alias,
+			)
+		}
+		domain = strings.ToLower(domain)
+
+		if domain == "" || config.IsLocalDomain(domain) {
+			// Local account, we
+			// can resolve this one.
+			akaURI := uris.GenerateURIsForAccount(username).UserURI
+			if akaURI == self.URI {
+				// Don't alias to self.
+				continue
+			}
+
+			akaURIs = append(akaURIs, akaURI)
+			continue
+		}
+
+		if self.Username == username && self.Domain == domain {
+			// Don't alias to self.
+			continue
+		}
+
+		akaURIs = append(akaURIs, "@"+username+"@"+domain)
+	}
+
+	return util.Deduplicate(akaURIs), nil
+}
@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FrontendToInternalTestSuite struct {
+	TypeUtilsTestSuite
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIPollVoteToPollVoteSingle() {
+	var (
+		poll  = testrig.NewTestPolls()["local_account_1_status_6_poll"]
+		voter = suite.testAccounts["local_account_2"]
+	)
+
+	vote, err := typeutils.APIPollVoteToPollVote(poll, []int{2}, voter)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(vote.ID)
+	suite.Equal([]int{2}, vote.Choices)
+	suite.Equal(voter.ID, vote.AccountID)
+	suite.Equal(poll.ID, vote.PollID)
+
+	// Multiple choices in a single-choice poll.
+	_, err = typeutils.APIPollVoteToPollVote(poll, []int{0, 1}, voter)
+	suite.EqualError(err, "invalid number of choices for poll")
+
+	// No choices at all.
+	_, err = typeutils.APIPollVoteToPollVote(poll, nil, voter)
+	suite.EqualError(err, "invalid number of choices for poll")
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIPollVoteToPollVoteMultiple() {
+	var (
+		poll  = testrig.NewTestPolls()["remote_account_1_status_3_poll"]
+		voter = suite.testAccounts["local_account_1"]
+	)
+
+	vote, err := typeutils.APIPollVoteToPollVote(poll, []int{0, 2}, voter)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]int{0, 2}, vote.Choices)
+	suite.Equal(voter.ID, vote.AccountID)
+	suite.Equal(poll.ID, vote.PollID)
+
+	// Same option given twice.
+	_, err = typeutils.APIPollVoteToPollVote(poll, []int{1, 1}, voter)
+	suite.EqualError(err, "duplicate option index 1 for poll")
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIPollVoteToPollVoteOutOfRange() {
+	var (
+		poll  = testrig.NewTestPolls()["remote_account_1_status_3_poll"]
+		voter = suite.testAccounts["local_account_1"]
+	)
+
+	_, err := typeutils.APIPollVoteToPollVote(poll, []int{0, 3}, voter)
+	suite.EqualError(err, "invalid option index 3 for poll")
+
+	_, err = typeutils.APIPollVoteToPollVote(poll, []int{-1}, voter)
+	suite.EqualError(err, "invalid option index -1 for poll")
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIPollVoteToPollVoteExpired() {
+	voter := suite.testAccounts["local_account_2"]
+
+	// Poll that's expired but
+	// not yet been closed.
+	poll := &gtsmodel.Poll{
+		ID:        "01J2GYCE5KBSK5HVQ2BXGQ4MGZ",
+		Multiple:  util.Ptr(false),
+		Options:   []string{"yes", "no"},
+		ExpiresAt: time.Now().Add(-time.Hour),
+	}
+
+	_, err := typeutils.APIPollVoteToPollVote(poll, []int{0}, voter)
+	suite.EqualError(err, "poll already closed")
+
+	// Poll that's been closed.
+	poll = testrig.NewTestPolls()["local_account_2_status_8_poll"]
+	_, err = typeutils.APIPollVoteToPollVote(poll, []int{0}, voter)
+	suite.EqualError(err, "poll already closed")
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAliasesToAlsoKnownAsURIsMixed() {
+	self := suite.testAccounts["local_account_1"]
+
+	akaURIs, err := typeutils.APIAliasesToAlsoKnownAsURIs([]string{
+		"http://localhost:8080/users/admin",
+		"@1happyturtle",
+		"1happyturtle@localhost:8080",
+		"@foss_satan@Fossbros-Anonymous.io",
+		"https://unknown-instance.com/users/brand_new_person",
+	}, self)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]string{
+		"http://localhost:8080/users/admin",
+		"http://localhost:8080/users/1happyturtle",
+		"@foss_satan@fossbros-anonymous.io",
+		"https://unknown-instance.com/users/brand_new_person",
+	}, akaURIs)
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAliasesToAlsoKnownAsURIsDuplicate() {
+	self := suite.testAccounts["local_account_1"]
+
+	akaURIs, err := typeutils.APIAliasesToAlsoKnownAsURIs([]string{
+		"http://localhost:8080/users/admin",
+		"@admin@localhost:8080",
+		"http://localhost:8080/users/admin",
+		"@foss_satan@fossbros-anonymous.io",
+		"foss_satan@fossbros-anonymous.io",
+	}, self)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]string{
+		"http://localhost:8080/users/admin",
+		"@foss_satan@fossbros-anonymous.io",
+	}, akaURIs)
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAliasesToAlsoKnownAsURIsSelf() {
+	self := suite.testAccounts["local_account_1"]
+
+	akaURIs, err := typeutils.APIAliasesToAlsoKnownAsURIs([]string{
+		self.URI,
+		self.URL,
+		"@the_mighty_zork",
+		"@the_mighty_zork@localhost:8080",
+		"http://localhost:8080/users/admin",
+	}, self)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]string{
+		"http://localhost:8080/users/admin",
+	}, akaURIs)
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAliasesToAlsoKnownAsURIsMalformed() {
+	self := suite.testAccounts["local_account_1"]
+
+	for _, alias := range []string{
+		"oh no",
+		"ftp://example.org/users/someone",
+		"@@example.org",
+		"",
+	} {
+		_, err := typeutils.APIAliasesToAlsoKnownAsURIs([]string{
+			"http://localhost:8080/users/admin",
+			alias,
+		}, self)
+		suite.Error(err, alias)
+	}
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAccountNoteToNoteAtLimit() {
+	// Surrounding space doesn't count.
+	note, err := typeutils.APIAccountNoteToNote("  "+strings.Repeat("ü", 10)+"\n", 10)
+	suite.NoError(err)
+	suite.Equal(strings.Repeat("ü", 10), note)
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAccountNoteToNoteOverLimit() {
+	_, err := typeutils.APIAccountNoteToNote(strings.Repeat("a", 11), 10)
+
+	var errTooLong *typeutils.NoteTooLongError
+	suite.ErrorAs(err, &errTooLong)
+	suite.Equal(11, errTooLong.Length)
+	suite.Equal(10, errTooLong.Max)
+	suite.EqualError(err, "note too long, 11 characters provided but limit is 10")
+}
+
+func (suite *FrontendToInternalTestSuite) TestAPIAccountNoteToNoteEmpty() {
+	// Empty, or only space,
+	// clears the note.
+	for _, comment := range []string{"", " \t\n"} {
+		note, err := typeutils.APIAccountNoteToNote(comment, 10)
+		suite.NoError(err)
+		suite.Empty(note)
+	}
+}
+
+func TestFrontendToInternalTestSuite(t *testing.T) {
+	suite.Run(t, new(FrontendToInternalTestSuite))
+}
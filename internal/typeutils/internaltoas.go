@@ -24,7 +24,6 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
-	"strings"
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
@@ -35,7 +34,9 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // AccountToAS converts a gts model account into an activity streams person, suitable for federation
@@ -108,14 +109,18 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	person.SetActivityStreamsOutbox(outboxProp)
 
 	// featured posts
-	// Pinned posts.
-	featuredURI, err := url.Parse(a.FeaturedCollectionURI)
-	if err != nil {
-		return nil, err
+	// Pinned posts. Omitted if the account
+	// hides its outbox, as the featured
+	// collection would expose statuses.
+	if !a.HidesOutbox() {
+		featuredURI, err := url.Parse(a.FeaturedCollectionURI)
+		if err != nil {
+			return nil, err
+		}
+		featuredProp := streams.NewTootFeaturedProperty()
+		featuredProp.SetIRI(featuredURI)
+		person.SetTootFeatured(featuredProp)
 	}
-	featuredProp := streams.NewTootFeaturedProperty()
-	featuredProp.SetIRI(featuredURI)
-	person.SetTootFeatured(featuredProp)
 
 	// featuredTags
 	// NOT IMPLEMENTED
@@ -466,9 +471,17 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	// type
 	// will be set automatically by go-fed
 
-	// summary aka cw
+	// summary aka cw; always sent as plain
+	// text, as this is how most implementations
+	// (Mastodon in particular) will interpret it.
+	summary := s.ContentWarning
+	if s.IsLocal() {
+		// Local content warnings are stored
+		// as HTML by our (emoji-only) formatter.
+		summary = text.HTMLToPlaintext(summary)
+	}
 	statusSummaryProp := streams.NewActivityStreamsSummaryProperty()
-	statusSummaryProp.AppendXMLSchemaString(s.ContentWarning)
+	statusSummaryProp.AppendXMLSchemaString(summary)
 	status.SetActivityStreamsSummary(statusSummaryProp)
 
 	// inReplyTo
@@ -628,12 +641,20 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 
 	// content -- the actual post
 	// itself, plus the language
+	content := s.Content
+	if content == "" {
+		// Some implementations drop the summary (CW)
+		// of a post with no content, e.g. media-only
+		// posts, so always send at least something.
+		content = "<p></p>"
+	}
+
 	contentProp := streams.NewActivityStreamsContentProperty()
-	contentProp.AppendXMLSchemaString(s.Content)
+	contentProp.AppendXMLSchemaString(content)
 
 	if s.Language != "" {
 		contentProp.AppendRDFLangString(map[string]string{
-			s.Language: s.Content,
+			s.Language: content,
 		})
 	}
 
@@ -667,9 +688,19 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	repliesProp.SetActivityStreamsCollection(repliesCollection)
 	status.SetActivityStreamsReplies(repliesProp)
 
-	// sensitive
+	// sensitive -- most implementations only
+	// look at status level sensitivity, so mark
+	// the status sensitive if any media is.
+	sensitive := *s.Sensitive
+	for _, a := range attachments {
+		if util.PtrValueOr(a.Sensitive, false) {
+			sensitive = true
+			break
+		}
+	}
+
 	sensitiveProp := streams.NewActivityStreamsSensitiveProperty()
-	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
+	sensitiveProp.AppendXMLSchemaBoolean(sensitive)
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
 	return status, nil
@@ -955,15 +986,9 @@ func (c *Converter) MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab
 	mention.SetActivityStreamsHref(hrefProp)
 
 	// name -- this should be the namestring of the mentioned user, something like @whatever@example.org
-	var domain string
-	if m.TargetAccount.Domain == "" {
-		accountDomain := config.GetAccountDomain()
-		if accountDomain == "" {
-			accountDomain = config.GetHost()
-		}
-		domain = accountDomain
-	} else {
-		domain = m.TargetAccount.Domain
+	domain := m.TargetAccount.NameDomain()
+	if domain == "" {
+		domain = config.GetHost()
 	}
 	username := m.TargetAccount.Username
 	nameString := fmt.Sprintf("@%s@%s", username, domain)
@@ -976,10 +1001,10 @@ func (c *Converter) MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab
 
 // TagToAS converts a gts model tag into a toot Hashtag, suitable for federation.
 func (c *Converter) TagToAS(ctx context.Context, t *gtsmodel.Tag) (vocab.TootHashtag, error) {
-	// This is probably already lowercase,
-	// but let's err on the safe side.
-	nameLower := strings.ToLower(t.Name)
-	tagURLString := uris.URIForTag(nameLower)
+	// Tag name is already folded to its canonical
+	// form, so use it for the URL, and use the
+	// display name for the tag's `name` property.
+	tagURLString := uris.URIForTag(t.Name)
 
 	// Create the tag.
 	tag := streams.NewTootHashtag()
@@ -995,7 +1020,7 @@ func (c *Converter) TagToAS(ctx context.Context, t *gtsmodel.Tag) (vocab.TootHas
 
 	// `name` should be the name of the tag with the # prefix.
 	nameProp := streams.NewActivityStreamsNameProperty()
-	nameProp.AppendXMLSchemaString("#" + nameLower)
+	nameProp.AppendXMLSchemaString("#" + t.GetDisplayName())
 	tag.SetActivityStreamsName(nameProp)
 
 	return tag, nil
@@ -1086,6 +1111,14 @@ func (c *Converter) AttachmentToAS(ctx context.Context, a *gtsmodel.MediaAttachm
 	blurProp.Set(a.Blurhash)
 	doc.SetTootBlurhash(blurProp)
 
+	// sensitive -- only set if true, as
+	// sensitivity is usually per-status
+	if util.PtrValueOr(a.Sensitive, false) {
+		sensitiveProp := streams.NewActivityStreamsSensitiveProperty()
+		sensitiveProp.AppendXMLSchemaBoolean(true)
+		doc.SetActivityStreamsSensitive(sensitiveProp)
+	}
+
 	// focalpoint
 	// TODO
 
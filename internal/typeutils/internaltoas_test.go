@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -85,6 +86,25 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
 }`, trimmed)
 }
 
+func (suite *InternalToASTestSuite) TestAccountToASHideOutbox() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+	testAccount.Settings = &gtsmodel.AccountSettings{
+		HideOutbox: util.Ptr(true),
+	}
+
+	asPerson, err := suite.typeconverter.AccountToAS(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asPerson)
+	suite.NoError(err)
+
+	// Outbox should still be linked, as
+	// it's required, but featured should not.
+	suite.Equal("http://localhost:8080/users/the_mighty_zork/outbox", ser["outbox"])
+	suite.NotContains(ser, "featured")
+}
+
 func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
 	testAccount := &gtsmodel.Account{}
 	*testAccount = *suite.testAccounts["local_account_2"]
@@ -409,6 +429,26 @@ func (suite *InternalToASTestSuite) TestStatusToAS() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestStatusToASMediaOnlyWithCW() {
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+	ctx := context.Background()
+
+	// Local content warnings are stored as HTML,
+	// and media-only statuses have no content.
+	testStatus.ContentWarning = "food &amp; drink"
+	testStatus.Content = ""
+
+	asStatus, err := suite.typeconverter.StatusToAS(ctx, testStatus)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asStatus)
+	suite.NoError(err)
+
+	suite.Equal("food & drink", ser["summary"])
+	suite.Equal("<p></p>", ser["content"])
+}
+
 func (suite *InternalToASTestSuite) TestStatusWithTagsToASWithIDs() {
 	// use the status with just IDs of attachments and emojis pinned on it
 	testStatus := suite.testStatuses["admin_account_status_1"]
@@ -18,10 +18,12 @@
 package typeutils
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -102,6 +104,17 @@ func (c *Converter) UserToAPIUser(ctx context.Context, u *gtsmodel.User) *apimod
 	return user
 }
 
+// AccountSettingsToAPIStatusRetention converts the status retention
+// fields of a *gtsmodel.AccountSettings to an API representation.
+func (c *Converter) AccountSettingsToAPIStatusRetention(s *gtsmodel.AccountSettings) *apimodel.StatusRetention {
+	return &apimodel.StatusRetention{
+		Days:           s.StatusRetentionDays,
+		KeepPinned:     util.PtrValueOr(s.StatusRetentionKeepPinned, true),
+		KeepBookmarked: util.PtrValueOr(s.StatusRetentionKeepBookmarked, true),
+		KeepFavesAbove: s.StatusRetentionKeepFavesAbove,
+	}
+}
+
 // AppToAPIAppSensitive takes a db model application as a param, and returns a populated apitype application, or an error
 // if something goes wrong. The returned application should be ready to serialize on an API level, and may have sensitive fields
 // (such as client id and client secret), so serve it only to an authorized user who should have permission to see it.
@@ -131,12 +144,14 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 
 	apiAccount.Source = &apimodel.Source{
 		Privacy:             c.VisToAPIVis(ctx, a.Settings.Privacy),
-		Sensitive:           *a.Settings.Sensitive,
+		Sensitive:           util.PtrValueOr(a.Settings.Sensitive, false),
 		Language:            a.Settings.Language,
 		StatusContentType:   statusContentType,
 		Note:                a.NoteRaw,
 		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
 		FollowRequestsCount: *a.Stats.FollowRequestsCount,
+		MediaUsedBytes:      util.PtrValueOr(a.Stats.MediaUsedBytes, 0),
+		MediaQuotaBytes:     a.MediaQuota(),
 		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
 	}
 
@@ -245,6 +260,8 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		theme           string
 		customCSS       string
 		hideCollections bool
+		hideCounts      bool
+		hideOutbox      bool
 	)
 
 	if a.IsRemote() {
@@ -279,9 +296,11 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 			theme = a.Settings.Theme
 			customCSS = a.Settings.CustomCSS
 			hideCollections = *a.Settings.HideCollections
+			hideCounts = util.PtrValueOr(a.Settings.HideInteractionCounts, false)
+			hideOutbox = a.HidesOutbox()
 		}
 
-		acct = a.Username // omit domain
+		acct = localAcct(a)
 	}
 
 	var (
@@ -294,32 +313,34 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 	// can be populated directly below.
 
 	accountFrontend := &apimodel.Account{
-		ID:              a.ID,
-		Username:        a.Username,
-		Acct:            acct,
-		DisplayName:     a.DisplayName,
-		Locked:          locked,
-		Discoverable:    discoverable,
-		Bot:             bot,
-		CreatedAt:       util.FormatISO8601(a.CreatedAt),
-		Note:            a.Note,
-		URL:             a.URL,
-		Avatar:          aviURL,
-		AvatarStatic:    aviURLStatic,
-		Header:          headerURL,
-		HeaderStatic:    headerURLStatic,
-		FollowersCount:  followersCount,
-		FollowingCount:  followingCount,
-		StatusesCount:   statusesCount,
-		LastStatusAt:    lastStatusAt,
-		Emojis:          apiEmojis,
-		Fields:          fields,
-		Suspended:       !a.SuspendedAt.IsZero(),
-		Theme:           theme,
-		CustomCSS:       customCSS,
-		EnableRSS:       enableRSS,
-		HideCollections: hideCollections,
-		Role:            role,
+		ID:                    a.ID,
+		Username:              a.Username,
+		Acct:                  acct,
+		DisplayName:           a.DisplayName,
+		Locked:                locked,
+		Discoverable:          discoverable,
+		Bot:                   bot,
+		CreatedAt:             util.FormatISO8601(a.CreatedAt),
+		Note:                  a.Note,
+		URL:                   a.URL,
+		Avatar:                aviURL,
+		AvatarStatic:          aviURLStatic,
+		Header:                headerURL,
+		HeaderStatic:          headerURLStatic,
+		FollowersCount:        followersCount,
+		FollowingCount:        followingCount,
+		StatusesCount:         statusesCount,
+		LastStatusAt:          lastStatusAt,
+		Emojis:                apiEmojis,
+		Fields:                fields,
+		Suspended:             !a.SuspendedAt.IsZero(),
+		Theme:                 theme,
+		CustomCSS:             customCSS,
+		EnableRSS:             enableRSS,
+		HideCollections:       hideCollections,
+		HideInteractionCounts: hideCounts,
+		HideOutbox:            hideOutbox,
+		Role:                  role,
 	}
 
 	// Bodge default avatar + header in,
@@ -388,7 +409,7 @@ func (c *Converter) AccountToAPIAccountBlocked(ctx context.Context, a *gtsmodel.
 			}
 		}
 
-		acct = a.Username // omit domain
+		acct = localAcct(a)
 	}
 
 	account := &apimodel.Account{
@@ -483,6 +504,13 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		return nil, fmt.Errorf("AccountToAdminAPIAccount: error converting account to api account for account id %s: %w", a.ID, err)
 	}
 
+	// Stats will have been populated
+	// when converting api account.
+	var mediaUsedBytes int64
+	if a.IsLocal() && a.Stats != nil {
+		mediaUsedBytes = util.PtrValueOr(a.Stats.MediaUsedBytes, 0)
+	}
+
 	return &apimodel.AdminAccountInfo{
 		ID:                     a.ID,
 		Username:               a.Username,
@@ -502,6 +530,9 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
 		InvitedByAccountID:     "", // not implemented (yet)
+		MediaQuotaBytes:        a.MediaQuotaBytes,
+		MediaUsedBytes:         mediaUsedBytes,
+		CharacterLimitOverride: a.CharacterLimitOverride,
 	}, nil
 }
 
@@ -526,11 +557,73 @@ func (c *Converter) AppToAPIAppPublic(ctx context.Context, a *gtsmodel.Applicati
 	}, nil
 }
 
+// AppToAPIAdminApp converts a gts model application into its admin api
+// representation, including the given count of access tokens issued to
+// the application, and the time any of those tokens was last used.
+func (c *Converter) AppToAPIAdminApp(
+	ctx context.Context,
+	a *gtsmodel.Application,
+	tokensCount int,
+	lastUsed time.Time,
+) (*apimodel.AdminApplication, error) {
+	apiApp := &apimodel.AdminApplication{
+		ID:          a.ID,
+		Name:        a.Name,
+		Website:     a.Website,
+		RedirectURI: a.RedirectURI,
+		ClientID:    a.ClientID,
+		Scopes:      a.Scopes,
+		CreatedAt:   util.FormatISO8601(a.CreatedAt),
+		TokensCount: tokensCount,
+	}
+
+	if !a.RevokedAt.IsZero() {
+		revokedAt := util.FormatISO8601(a.RevokedAt)
+		apiApp.RevokedAt = &revokedAt
+	}
+
+	if !lastUsed.IsZero() {
+		lastUsedAt := util.FormatISO8601(lastUsed)
+		apiApp.LastUsedAt = &lastUsedAt
+	}
+
+	return apiApp, nil
+}
+
+// ApplicationBlockToAPIApplicationBlock converts a gts
+// model application block into its admin api representation.
+func (c *Converter) ApplicationBlockToAPIApplicationBlock(b *gtsmodel.ApplicationBlock) *apimodel.AdminApplicationBlock {
+	return &apimodel.AdminApplicationBlock{
+		ID:             b.ID,
+		NamePattern:    b.NamePattern,
+		WebsitePattern: b.WebsitePattern,
+		CreatedBy:      b.CreatedByAccountID,
+		CreatedAt:      util.FormatISO8601(b.CreatedAt),
+	}
+}
+
+// TokenToAPIToken converts a gts model token into its api representation, as
+// returned to clients when the token is issued. created_at is always given in
+// unix seconds, and the space-separated scope string is normalized.
+func (c *Converter) TokenToAPIToken(token *gtsmodel.Token) (*apimodel.Token, error) {
+	if token.Access == "" {
+		return nil, gtserror.Newf("token %s has no access token", token.ID)
+	}
+
+	return &apimodel.Token{
+		AccessToken: token.Access,
+		TokenType:   "Bearer",
+		Scope:       strings.Join(strings.Fields(token.Scope), " "),
+		CreatedAt:   token.AccessCreateAt.Unix(),
+	}, nil
+}
+
 // AttachmentToAPIAttachment converts a gts model media attacahment into its api representation for serialization on the API.
 func (c *Converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.MediaAttachment) (apimodel.Attachment, error) {
 	apiAttachment := apimodel.Attachment{
-		ID:   a.ID,
-		Type: strings.ToLower(string(a.Type)),
+		ID:        a.ID,
+		Type:      strings.ToLower(string(a.Type)),
+		Sensitive: util.PtrValueOr(a.Sensitive, false),
 	}
 
 	// Don't try to serialize meta for
@@ -663,6 +756,76 @@ func (c *Converter) EmojiToAPIEmoji(ctx context.Context, e *gtsmodel.Emoji) (api
 	}, nil
 }
 
+// EmojisToAPIEmojis converts a slice of gts model emojis into
+// their api representations, keeping the given emoji order.
+func (c *Converter) EmojisToAPIEmojis(ctx context.Context, emojis []*gtsmodel.Emoji) ([]apimodel.Emoji, error) {
+	return c.convertEmojisToAPIEmojis(ctx, emojis, nil)
+}
+
+// EmojisToAPIEmojisByCategory converts a slice of gts model emojis
+// into api emoji categories containing their api representations.
+//
+// Categories are sorted alphabetically by name, with uncategorized
+// emojis in a final category with empty ID and name. Emojis are
+// sorted by shortcode within each category, so that the result is
+// stable regardless of the order of the given emojis.
+func (c *Converter) EmojisToAPIEmojisByCategory(ctx context.Context, emojis []*gtsmodel.Emoji) ([]apimodel.EmojiCategory, error) {
+	var (
+		errs       gtserror.MultiError
+		categories []apimodel.EmojiCategory
+		index      = make(map[string]int)
+	)
+
+	for _, emoji := range emojis {
+		apiEmoji, err := c.EmojiToAPIEmoji(ctx, emoji)
+		if err != nil {
+			errs.Appendf("error converting emoji %s to api emoji: %w", emoji.ID, err)
+			continue
+		}
+
+		// Look for existing category,
+		// else append a new one for it.
+		i, ok := index[emoji.CategoryID]
+		if !ok {
+			i = len(categories)
+			index[emoji.CategoryID] = i
+			categories = append(categories, apimodel.EmojiCategory{
+				ID:   emoji.CategoryID,
+				Name: apiEmoji.Category,
+			})
+		}
+
+		categories[i].Emojis = append(categories[i].Emojis, apiEmoji)
+	}
+
+	slices.SortFunc(categories, func(a, b apimodel.EmojiCategory) int {
+		switch {
+		case a.ID == b.ID:
+			return 0
+		case a.ID == "":
+			// Uncategorized last.
+			return 1
+		case b.ID == "":
+			return -1
+		}
+
+		// Sort by name, falling back to
+		// ID for stability on duplicates.
+		return cmp.Or(
+			strings.Compare(a.Name, b.Name),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+
+	for _, category := range categories {
+		slices.SortFunc(category.Emojis, func(a, b apimodel.Emoji) int {
+			return strings.Compare(a.Shortcode, b.Shortcode)
+		})
+	}
+
+	return categories, errs.Combine()
+}
+
 // EmojiToAdminAPIEmoji converts a gts model emoji into an API representation with extra admin information.
 func (c *Converter) EmojiToAdminAPIEmoji(ctx context.Context, e *gtsmodel.Emoji) (*apimodel.AdminEmoji, error) {
 	emoji, err := c.EmojiToAPIEmoji(ctx, e)
@@ -705,7 +868,7 @@ func (c *Converter) EmojiCategoryToAPIEmojiCategory(ctx context.Context, categor
 // If stubHistory is set to 'true', then the 'history' field of the tag will be populated with a pointer to an empty slice, for API compatibility reasons.
 func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistory bool) (apimodel.Tag, error) {
 	return apimodel.Tag{
-		Name: strings.ToLower(t.Name),
+		Name: t.GetDisplayName(),
 		URL:  uris.URIForTag(t.Name),
 		History: func() *[]any {
 			if !stubHistory {
@@ -718,6 +881,100 @@ func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistor
 	}, nil
 }
 
+// FeaturedTagToAPIFeaturedTag converts a gts model featured tag, along with
+// stats of the featuring account's use of the tag, into its api representation.
+//
+// If the tag has never been used by the account, LastStatusAt will be nil,
+// so that it's serialized as null rather than as an empty string.
+func (c *Converter) FeaturedTagToAPIFeaturedTag(ft *gtsmodel.FeaturedTag, stats gtsmodel.TagStats) (*apimodel.FeaturedTag, error) {
+	if ft.Name == "" {
+		return nil, gtserror.Newf("featured tag %s has no name", ft.ID)
+	}
+
+	var lastStatusAt *string
+	if !stats.LastStatusAt.IsZero() {
+		lastStatusAt = util.Ptr(util.FormatISO8601(stats.LastStatusAt))
+	}
+
+	return &apimodel.FeaturedTag{
+		ID:            ft.ID,
+		Name:          ft.Name,
+		URL:           uris.URIForTag(text.FoldHashtag(ft.Name)),
+		StatusesCount: stats.StatusesCount,
+		LastStatusAt:  lastStatusAt,
+	}, nil
+}
+
+// TranslationToAPITranslation converts the result of translating the given
+// source status into its api representation. Translated media descriptions
+// and poll options are matched with the status' attachments and poll options
+// by position, so their counts must be the same. Any attachments and poll
+// of the source status must already be populated.
+func (c *Converter) TranslationToAPITranslation(src *gtsmodel.Status, result gtsmodel.TranslationResult) (*apimodel.Translation, error) {
+	if !src.AttachmentsPopulated() {
+		return nil, gtserror.Newf("attachments of status %s not populated", src.ID)
+	}
+
+	if len(result.MediaDescriptions) != len(src.Attachments) {
+		return nil, gtserror.Newf(
+			"translation has %d media descriptions, but status %s has %d attachments",
+			len(result.MediaDescriptions), src.ID, len(src.Attachments),
+		)
+	}
+
+	apiTranslation := &apimodel.Translation{
+		Content:                result.Content,
+		SpoilerText:            result.SpoilerText,
+		MediaAttachments:       make([]apimodel.TranslationAttachment, len(src.Attachments)),
+		DetectedSourceLanguage: result.DetectedSourceLanguage,
+		Provider:               result.Provider,
+	}
+
+	for i, attachment := range src.Attachments {
+		apiTranslation.MediaAttachments[i] = apimodel.TranslationAttachment{
+			ID:          attachment.ID,
+			Description: result.MediaDescriptions[i],
+		}
+	}
+
+	if src.PollID == "" {
+		if len(result.PollOptions) != 0 {
+			return nil, gtserror.Newf(
+				"translation has %d poll options, but status %s has no poll",
+				len(result.PollOptions), src.ID,
+			)
+		}
+
+		// No poll,
+		// we're done.
+		return apiTranslation, nil
+	}
+
+	if src.Poll == nil {
+		return nil, gtserror.Newf("poll of status %s not populated", src.ID)
+	}
+
+	if len(result.PollOptions) != len(src.Poll.Options) {
+		return nil, gtserror.Newf(
+			"translation has %d poll options, but poll of status %s has %d options",
+			len(result.PollOptions), src.ID, len(src.Poll.Options),
+		)
+	}
+
+	apiTranslation.Poll = &apimodel.TranslationPoll{
+		ID:      src.Poll.ID,
+		Options: make([]apimodel.TranslationPollOption, len(result.PollOptions)),
+	}
+
+	for i, option := range result.PollOptions {
+		apiTranslation.Poll.Options[i] = apimodel.TranslationPollOption{
+			Title: option,
+		}
+	}
+
+	return apiTranslation, nil
+}
+
 // StatusToAPIStatus converts a gts model status into its api
 // (frontend) representation for serialization on the API.
 //
@@ -754,6 +1011,94 @@ func (c *Converter) StatusToAPIStatus(
 	return apiStatus, nil
 }
 
+// BoostToAPIStatus converts a gts model boost into its api
+// (frontend) wrapper status, for serialization on the API.
+// The wrapper has the boost's own metadata, and embeds the
+// boosted status as its reblog, from which its counts and
+// interactions are taken. The boost is not filtered.
+//
+// Requesting account can be nil.
+func (c *Converter) BoostToAPIStatus(
+	ctx context.Context,
+	boost *gtsmodel.Status,
+	requestingAccount *gtsmodel.Account,
+) (*apimodel.Status, error) {
+	if boost.BoostOfID == "" {
+		return nil, gtserror.Newf("status %s is not a boost", boost.ID)
+	}
+
+	return c.StatusToAPIStatus(ctx,
+		boost,
+		requestingAccount,
+		statusfilter.FilterContextNone,
+		nil,
+		nil,
+	)
+}
+
+// QuoteToAPIQuote converts a gts model status quote into its api
+// (frontend) representation for serialization on the API, for the
+// given requesting account (which may be nil if unauthenticated).
+//
+// The quoted status is only included if the quote has been accepted,
+// and the quoted status still exists and is visible to the requester.
+// Otherwise it's left nil, so that clients can show a placeholder.
+func (c *Converter) QuoteToAPIQuote(
+	ctx context.Context,
+	quote *gtsmodel.StatusQuote,
+	requester *gtsmodel.Account,
+) (*apimodel.Quote, error) {
+	apiQuote := &apimodel.Quote{
+		State: string(quote.State),
+	}
+
+	if quote.State != gtsmodel.QuoteStateAccepted {
+		// Don't show the quoted
+		// status without approval.
+		return apiQuote, nil
+	}
+
+	quoted := quote.QuotedStatus
+	if quoted == nil {
+		// Quoted status not populated, fetch it.
+		var err error
+		quoted, err = c.state.DB.GetStatusByID(ctx, quote.QuotedStatusID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("db error getting quoted status %s: %w", quote.QuotedStatusID, err)
+		}
+
+		if quoted == nil {
+			// Quoted status
+			// was deleted.
+			return apiQuote, nil
+		}
+	}
+
+	visible, err := c.filter.StatusVisible(ctx, requester, quoted)
+	if err != nil {
+		return nil, gtserror.Newf("error checking quoted status visibility: %w", err)
+	}
+
+	if !visible {
+		// Quoted status isn't
+		// visible to requester.
+		return apiQuote, nil
+	}
+
+	apiQuote.QuotedStatus, err = c.StatusToAPIStatus(ctx,
+		quoted,
+		requester,
+		statusfilter.FilterContextNone,
+		nil, // filters
+		nil, // mutes
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error converting quoted status: %w", err)
+	}
+
+	return apiQuote, nil
+}
+
 // statusToAPIFilterResults applies filters and mutes to a status and returns an API filter result object.
 // The result may be nil if no filters matched.
 // If the status should not be returned at all, it returns the ErrHideStatus error.
@@ -1014,10 +1359,12 @@ func (c *Converter) StatusToWebStatus(
 		webStatus.WebPollOptions = webPollOptions
 	}
 
-	// Set additional templating
-	// variables on media attachments.
+	// Set additional templating variables on media
+	// attachments. If the status itself is marked
+	// sensitive then all its media are hidden, else
+	// only media that are individually sensitive.
 	for _, a := range webStatus.MediaAttachments {
-		a.Sensitive = webStatus.Sensitive
+		a.Sensitive = a.Sensitive || *s.Sensitive
 	}
 
 	webStatus.Local = *s.Local
@@ -1055,41 +1402,132 @@ func (c *Converter) statusToFrontend(
 	*apimodel.Status,
 	error,
 ) {
-	apiStatus, err := c.baseStatusToFrontend(ctx,
+	if status.BoostOfID != "" {
+		return c.boostToFrontend(ctx,
+			status,
+			requestingAccount,
+			filterContext,
+			filters,
+			mutes,
+		)
+	}
+
+	return c.baseStatusToFrontend(ctx,
 		status,
 		requestingAccount,
 		filterContext,
 		filters,
 		mutes,
 	)
-	if err != nil {
+}
+
+// boostToFrontend is a package internal function for
+// parsing a boost into its frontend wrapper status.
+//
+// The wrapper has the boost's own ID, creation time,
+// author, URI / URL, visibility and application, and
+// no content of its own. The boosted status is fully
+// converted and embedded as the reblog, and the counts
+// and interactions of the wrapper are all taken from it,
+// so they're never counted for the boost itself.
+//
+// Requesting account can be nil.
+func (c *Converter) boostToFrontend(
+	ctx context.Context,
+	boost *gtsmodel.Status,
+	requestingAccount *gtsmodel.Account,
+	filterContext statusfilter.FilterContext,
+	filters []*gtsmodel.Filter,
+	mutes *usermute.CompiledUserMuteList,
+) (
+	*apimodel.Status,
+	error,
+) {
+	// Populate the boost fields we need,
+	// continuing on partial failure as
+	// long as these are all present.
+	if err := c.state.DB.PopulateStatus(ctx, boost); err != nil {
+		switch {
+		case boost.Account == nil:
+			return nil, gtserror.Newf("error(s) populating boost, required account not set: %w", err)
+
+		case boost.BoostOf == nil:
+			return nil, gtserror.Newf("error(s) populating boost, required boost not set: %w", err)
+
+		default:
+			log.Errorf(ctx, "error(s) populating boost, will continue: %v", err)
+		}
+	}
+
+	reblog, err := c.baseStatusToFrontend(ctx,
+		boost.BoostOf,
+		requestingAccount,
+		filterContext,
+		filters,
+		mutes,
+	)
+	if errors.Is(err, statusfilter.ErrHideStatus) {
+		// If we'd hide the original status, hide the boost.
 		return nil, err
+	} else if err != nil {
+		return nil, gtserror.Newf("error converting boosted status: %w", err)
 	}
 
-	if status.BoostOf != nil {
-		reblog, err := c.baseStatusToFrontend(ctx,
-			status.BoostOf,
-			requestingAccount,
-			filterContext,
-			filters,
-			mutes,
-		)
+	apiBoosterAccount, err := c.AccountToAPIAccountPublic(ctx, boost.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting boost author: %w", err)
+	}
+
+	apiStatus := &apimodel.Status{
+		ID:               boost.ID,
+		CreatedAt:        util.FormatISO8601(boost.CreatedAt),
+		Visibility:       c.VisToAPIVis(ctx, boost.Visibility),
+		URI:              boost.URI,
+		URL:              boost.URL,
+		RepliesCount:     reblog.RepliesCount,
+		ReblogsCount:     reblog.ReblogsCount,
+		FavouritesCount:  reblog.FavouritesCount,
+		Favourited:       reblog.Favourited,
+		Bookmarked:       reblog.Bookmarked,
+		Muted:            reblog.Muted,
+		Reblogged:        reblog.Reblogged,
+		Pinned:           reblog.Pinned,
+		Reblog:           &apimodel.StatusReblogged{reblog},
+		Account:          apiBoosterAccount,
+		MediaAttachments: []*apimodel.Attachment{},
+		Mentions:         []apimodel.Mention{},
+		Tags:             []apimodel.Tag{},
+		Emojis:           []apimodel.Emoji{},
+	}
+
+	if app := boost.CreatedWithApplication; app != nil {
+		apiStatus.Application, err = c.AppToAPIAppPublic(ctx, app)
+		if err != nil {
+			return nil, gtserror.Newf(
+				"error converting application %s: %w",
+				boost.CreatedWithApplicationID, err,
+			)
+		}
+	}
+
+	// If web URL is empty for whatever
+	// reason, provide AP URI as fallback.
+	if apiStatus.URL == "" {
+		apiStatus.URL = apiStatus.URI
+	}
+
+	// Apply filters to the boost itself,
+	// eg., to hide boosts by muted accounts.
+	filterResults, err := c.statusToAPIFilterResults(ctx, boost, requestingAccount, filterContext, filters, mutes)
+	if err != nil {
 		if errors.Is(err, statusfilter.ErrHideStatus) {
-			// If we'd hide the original status, hide the boost.
 			return nil, err
-		} else if err != nil {
-			return nil, gtserror.Newf("error converting boosted status: %w", err)
 		}
-
-		// Set boosted status and set interactions from original.
-		apiStatus.Reblog = &apimodel.StatusReblogged{reblog}
-		apiStatus.Favourited = apiStatus.Reblog.Favourited
-		apiStatus.Bookmarked = apiStatus.Reblog.Bookmarked
-		apiStatus.Muted = apiStatus.Reblog.Muted
-		apiStatus.Reblogged = apiStatus.Reblog.Reblogged
-		apiStatus.Pinned = apiStatus.Reblog.Pinned
+		return nil, fmt.Errorf("error applying filters: %w", err)
 	}
 
+	apiStatus.Filtered = filterResults
+
 	return apiStatus, nil
 }
 
@@ -1143,6 +1581,13 @@ func (c *Converter) baseStatusToFrontend(
 		return nil, gtserror.Newf("error counting faves: %w", err)
 	}
 
+	// Author may have opted to hide fave + boost
+	// counts from anyone but themself; zero them.
+	if s.Account.HidesInteractionCountsFrom(requestingAccount) {
+		reblogsCount = 0
+		favesCount = 0
+	}
+
 	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.Attachments, s.AttachmentIDs)
 	if err != nil {
 		log.Errorf(ctx, "error converting status attachments: %v", err)
@@ -1168,7 +1613,7 @@ func (c *Converter) baseStatusToFrontend(
 		CreatedAt:          util.FormatISO8601(s.CreatedAt),
 		InReplyToID:        nil, // Set below.
 		InReplyToAccountID: nil, // Set below.
-		Sensitive:          *s.Sensitive,
+		Sensitive:          s.IsSensitive(),
 		SpoilerText:        s.ContentWarning,
 		Visibility:         c.VisToAPIVis(ctx, s.Visibility),
 		Language:           nil, // Set below.
@@ -1178,6 +1623,7 @@ func (c *Converter) baseStatusToFrontend(
 		ReblogsCount:       reblogsCount,
 		FavouritesCount:    favesCount,
 		Content:            s.Content,
+		WordCount:          s.WordCount,
 		Reblog:             nil, // Set below.
 		Application:        nil, // Set below.
 		Account:            apiAuthorAccount,
@@ -1311,6 +1757,206 @@ func (c *Converter) InstanceRuleToAdminAPIRule(r *gtsmodel.Rule) *apimodel.Admin
 	}
 }
 
+// RetentionPolicyToAdminAPIRetentionPolicy converts the instance data retention
+// policy into its api equivalent for serving at /api/v1/admin/retention
+func (c *Converter) RetentionPolicyToAdminAPIRetentionPolicy(r *gtsmodel.RetentionPolicy) *apimodel.AdminRetentionPolicy {
+	policy := &apimodel.AdminRetentionPolicy{
+		MaxStatusAgeDays: r.MaxStatusAgeDays,
+		MaxMediaAgeDays:  r.MaxMediaAgeDays,
+		AppliesToLocal:   util.PtrValueOr(r.AppliesToLocal, false),
+		AppliesToRemote:  util.PtrValueOr(r.AppliesToRemote, false),
+	}
+
+	if !r.UpdatedAt.IsZero() {
+		policy.UpdatedAt = util.FormatISO8601(r.UpdatedAt)
+	}
+
+	return policy
+}
+
+// AdminActionToAdminAPIAdminAction converts a gts model admin action
+// into its admin API equivalent, used to check on its progress.
+func (c *Converter) AdminActionToAdminAPIAdminAction(a *gtsmodel.AdminAction) *apimodel.AdminAction {
+	action := &apimodel.AdminAction{
+		ID:             a.ID,
+		CreatedAt:      util.FormatISO8601(a.CreatedAt),
+		Type:           a.Type.String(),
+		TargetCategory: a.TargetCategory.String(),
+		TargetID:       a.TargetID,
+		Errors:         a.Errors,
+	}
+
+	if !a.CompletedAt.IsZero() {
+		action.CompletedAt = util.Ptr(util.FormatISO8601(a.CompletedAt))
+	}
+
+	if action.Errors == nil {
+		action.Errors = []string{}
+	}
+
+	return action
+}
+
+// AdminActionLogToAdminAPIAdminActionLog converts a gts model admin
+// action log entry into its admin API equivalent. Accounts which have
+// since been deleted, eg., on rejection, are left null on the result.
+func (c *Converter) AdminActionLogToAdminAPIAdminActionLog(ctx context.Context, l *gtsmodel.AdminActionLog) *apimodel.AdminActionLog {
+	entry := &apimodel.AdminActionLog{
+		ID:        l.ID,
+		CreatedAt: util.FormatISO8601(l.CreatedAt),
+		Action:    l.Action.String(),
+		Reason:    l.Reason,
+	}
+
+	var err error
+
+	if l.Account != nil {
+		entry.Account, err = c.AccountToAdminAPIAccount(ctx, l.Account)
+		if err != nil {
+			log.Warnf(ctx, "error converting account %s: %v", l.AccountID, err)
+		}
+	}
+
+	if l.TargetAccount != nil {
+		entry.TargetAccount, err = c.AccountToAdminAPIAccount(ctx, l.TargetAccount)
+		if err != nil {
+			log.Warnf(ctx, "error converting target account %s: %v", l.TargetAccountID, err)
+		}
+	}
+
+	return entry
+}
+
+// DeliveryAuditToAdminAPIDeliveryAudit converts a gts model federation audit log entry into its admin api equivalent.
+func (c *Converter) DeliveryAuditToAdminAPIDeliveryAudit(a *gtsmodel.DeliveryAudit) *apimodel.AdminDeliveryAudit {
+	return &apimodel.AdminDeliveryAudit{
+		ID:           a.ID,
+		CreatedAt:    util.FormatISO8601(a.CreatedAt),
+		Inbox:        a.Inbox,
+		Domain:       a.Domain,
+		ActivityType: a.ActivityType,
+		ObjectURI:    a.ObjectURI,
+		StatusCode:   a.StatusCode,
+		Attempt:      a.Attempt,
+	}
+}
+
+// DomainDeliveryHealthToAdminAPIDomainDeliveryStatus converts a gts model domain delivery health entry into its admin api equivalent.
+func (c *Converter) DomainDeliveryHealthToAdminAPIDomainDeliveryStatus(h *gtsmodel.DomainDeliveryHealth) *apimodel.AdminDomainDeliveryStatus {
+	optionalTime := func(t time.Time) *string {
+		if t.IsZero() {
+			return nil
+		}
+		return util.Ptr(util.FormatISO8601(t))
+	}
+
+	errs := make([]apimodel.AdminDeliveryError, 0, len(h.ErrorSamples))
+	for _, sample := range h.ErrorSamples {
+		errs = append(errs, apimodel.AdminDeliveryError{
+			CreatedAt:  util.FormatISO8601(sample.Time),
+			Inbox:      sample.Inbox,
+			StatusCode: sample.StatusCode,
+			Error:      sample.Error,
+		})
+	}
+
+	return &apimodel.AdminDomainDeliveryStatus{
+		Domain:              h.Domain,
+		ConsecutiveFailures: h.ConsecutiveFailures,
+		LastFailureAt:       optionalTime(h.LastFailureAt),
+		LastSuccessAt:       optionalTime(h.LastSuccessAt),
+		NextAttemptAt:       optionalTime(h.NextAttemptAt),
+		BackingOff:          h.BackingOff(),
+		RecentErrors:        errs,
+	}
+}
+
+// AccountWarningToAPIAccountWarning converts a gts model account warning into its api equivalent.
+func (c *Converter) AccountWarningToAPIAccountWarning(ctx context.Context, w *gtsmodel.AccountWarning) (*apimodel.AccountWarning, error) {
+	warning := &apimodel.AccountWarning{
+		ID:          w.ID,
+		Action:      "none",
+		Text:        w.Text,
+		AppealState: w.AppealState.String(),
+		CreatedAt:   util.FormatISO8601(w.CreatedAt),
+	}
+
+	if w.TargetAccount != nil {
+		targetAccount, err := c.AccountToAPIAccountPublic(ctx, w.TargetAccount)
+		if err != nil {
+			return nil, gtserror.Newf("error converting target account %s: %w", w.TargetAccountID, err)
+		}
+		warning.TargetAccount = targetAccount
+	}
+
+	return warning, nil
+}
+
+// InstanceToAPIExtendedDescription converts the long description of a gts
+// instance into its api equivalent for serving at /api/v1/instance/extended_description.
+//
+// If the instance has no description, the result will have empty content rather
+// than being nil, and updated_at will fall back to when the instance was created.
+func (c *Converter) InstanceToAPIExtendedDescription(i *gtsmodel.Instance) (*apimodel.ExtendedDescription, error) {
+	if i == nil {
+		return nil, gtserror.New("instance was nil")
+	}
+
+	updatedAt := i.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = i.CreatedAt
+	}
+
+	if updatedAt.IsZero() {
+		// Instance model has never been stored,
+		// so there's no sensible time to give.
+		updatedAt = time.Unix(0, 0)
+	}
+
+	return &apimodel.ExtendedDescription{
+		UpdatedAt: util.FormatISO8601(updatedAt),
+		Content:   i.Description,
+	}, nil
+}
+
+// InstanceLimitsToAPIConfiguration converts the given instance limits into
+// the configuration parameters advertised to clients by the instance endpoints.
+func (c *Converter) InstanceLimitsToAPIConfiguration(cfg *gtsmodel.InstanceConfiguration) apimodel.InstanceConfiguration {
+	return apimodel.InstanceConfiguration{
+		Statuses: apimodel.InstanceConfigurationStatuses{
+			MaxCharacters:            cfg.StatusesMaxChars,
+			MaxMediaAttachments:      cfg.StatusesMediaMaxFiles,
+			CharactersReservedPerURL: instanceStatusesCharactersReservedPerURL,
+			SupportedMimeTypes:       instanceStatusesSupportedMimeTypes,
+		},
+		MediaAttachments: apimodel.InstanceConfigurationMediaAttachments{
+			SupportedMimeTypes:  media.SupportedMIMETypes,
+			ImageSizeLimit:      int(cfg.MediaImageMaxSize),
+			ImageMatrixLimit:    instanceMediaAttachmentsImageMatrixLimit,
+			VideoSizeLimit:      int(cfg.MediaVideoMaxSize),
+			VideoFrameRateLimit: instanceMediaAttachmentsVideoFrameRateLimit,
+			VideoMatrixLimit:    instanceMediaAttachmentsVideoMatrixLimit,
+			DescriptionLimit:    cfg.MediaDescriptionMaxChars,
+			DescriptionMinLimit: cfg.MediaDescriptionMinChars,
+			DescriptionRequired: cfg.MediaDescriptionRequired,
+		},
+		Polls: apimodel.InstanceConfigurationPolls{
+			MaxOptions:             cfg.StatusesPollMaxOptions,
+			MaxCharactersPerOption: cfg.StatusesPollOptionMaxChars,
+			MinExpiration:          instancePollsMinExpiration,
+			MaxExpiration:          instancePollsMaxExpiration,
+		},
+		Accounts: apimodel.InstanceConfigurationAccounts{
+			AllowCustomCSS:   cfg.AccountsAllowCustomCSS,
+			MaxFeaturedTags:  instanceAccountsMaxFeaturedTags,
+			MaxProfileFields: instanceAccountsMaxProfileFields,
+		},
+		Emojis: apimodel.InstanceConfigurationEmojis{
+			EmojiSizeLimit: int(cfg.MediaEmojiLocalMaxSize),
+		},
+	}
+}
+
 // InstanceToAPIV1Instance converts a gts instance into its api equivalent for serving at /api/v1/instance
 func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV1, error) {
 	instance := &apimodel.InstanceV1{
@@ -1327,7 +1973,6 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Registrations:        config.GetAccountsRegistrationOpen(),
 		ApprovalRequired:     true,  // approval always required
 		InvitesEnabled:       false, // todo: not supported yet
-		MaxTootChars:         uint(config.GetStatusesMaxChars()),
 		Rules:                c.InstanceRulesToAPIRules(i.Rules),
 		Terms:                i.Terms,
 		TermsRaw:             i.TermsText,
@@ -1342,25 +1987,14 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	}
 
 	// configuration
-	instance.Configuration.Statuses.MaxCharacters = config.GetStatusesMaxChars()
-	instance.Configuration.Statuses.MaxMediaAttachments = config.GetStatusesMediaMaxFiles()
-	instance.Configuration.Statuses.CharactersReservedPerURL = instanceStatusesCharactersReservedPerURL
-	instance.Configuration.Statuses.SupportedMimeTypes = instanceStatusesSupportedMimeTypes
-	instance.Configuration.MediaAttachments.SupportedMimeTypes = media.SupportedMIMETypes
-	instance.Configuration.MediaAttachments.ImageSizeLimit = int(config.GetMediaImageMaxSize())
-	instance.Configuration.MediaAttachments.ImageMatrixLimit = instanceMediaAttachmentsImageMatrixLimit
-	instance.Configuration.MediaAttachments.VideoSizeLimit = int(config.GetMediaVideoMaxSize())
-	instance.Configuration.MediaAttachments.VideoFrameRateLimit = instanceMediaAttachmentsVideoFrameRateLimit
-	instance.Configuration.MediaAttachments.VideoMatrixLimit = instanceMediaAttachmentsVideoMatrixLimit
-	instance.Configuration.Polls.MaxOptions = config.GetStatusesPollMaxOptions()
-	instance.Configuration.Polls.MaxCharactersPerOption = config.GetStatusesPollOptionMaxChars()
-	instance.Configuration.Polls.MinExpiration = instancePollsMinExpiration
-	instance.Configuration.Polls.MaxExpiration = instancePollsMaxExpiration
-	instance.Configuration.Accounts.AllowCustomCSS = config.GetAccountsAllowCustomCSS()
-	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
-	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
-	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize())
+	limits := c.InstanceLimitsToAPIConfiguration(gtsmodel.NewInstanceConfiguration())
+	instance.Configuration.Statuses = limits.Statuses
+	instance.Configuration.MediaAttachments = limits.MediaAttachments
+	instance.Configuration.Polls = limits.Polls
+	instance.Configuration.Accounts = limits.Accounts
+	instance.Configuration.Emojis = limits.Emojis
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
+	instance.MaxTootChars = uint(limits.Statuses.MaxCharacters)
 
 	// URLs
 	instance.URLs.StreamingAPI = "wss://" + i.Domain
@@ -1482,24 +2116,12 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 
 	// configuration
 	instance.Configuration.URLs.Streaming = "wss://" + i.Domain
-	instance.Configuration.Statuses.MaxCharacters = config.GetStatusesMaxChars()
-	instance.Configuration.Statuses.MaxMediaAttachments = config.GetStatusesMediaMaxFiles()
-	instance.Configuration.Statuses.CharactersReservedPerURL = instanceStatusesCharactersReservedPerURL
-	instance.Configuration.Statuses.SupportedMimeTypes = instanceStatusesSupportedMimeTypes
-	instance.Configuration.MediaAttachments.SupportedMimeTypes = media.SupportedMIMETypes
-	instance.Configuration.MediaAttachments.ImageSizeLimit = int(config.GetMediaImageMaxSize())
-	instance.Configuration.MediaAttachments.ImageMatrixLimit = instanceMediaAttachmentsImageMatrixLimit
-	instance.Configuration.MediaAttachments.VideoSizeLimit = int(config.GetMediaVideoMaxSize())
-	instance.Configuration.MediaAttachments.VideoFrameRateLimit = instanceMediaAttachmentsVideoFrameRateLimit
-	instance.Configuration.MediaAttachments.VideoMatrixLimit = instanceMediaAttachmentsVideoMatrixLimit
-	instance.Configuration.Polls.MaxOptions = config.GetStatusesPollMaxOptions()
-	instance.Configuration.Polls.MaxCharactersPerOption = config.GetStatusesPollOptionMaxChars()
-	instance.Configuration.Polls.MinExpiration = instancePollsMinExpiration
-	instance.Configuration.Polls.MaxExpiration = instancePollsMaxExpiration
-	instance.Configuration.Accounts.AllowCustomCSS = config.GetAccountsAllowCustomCSS()
-	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
-	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
-	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize())
+	limits := c.InstanceLimitsToAPIConfiguration(gtsmodel.NewInstanceConfiguration())
+	instance.Configuration.Statuses = limits.Statuses
+	instance.Configuration.MediaAttachments = limits.MediaAttachments
+	instance.Configuration.Polls = limits.Polls
+	instance.Configuration.Accounts = limits.Accounts
+	instance.Configuration.Emojis = limits.Emojis
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
 
 	// registrations
@@ -1528,8 +2150,13 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	return instance, nil
 }
 
-// RelationshipToAPIRelationship converts a gts relationship into its api equivalent for serving in various places
+// RelationshipToAPIRelationship converts a gts relationship into its api equivalent for serving in various places.
+// This is the only place relationships should be converted, so that all fields are always set consistently.
 func (c *Converter) RelationshipToAPIRelationship(ctx context.Context, r *gtsmodel.Relationship) (*apimodel.Relationship, error) {
+	if r == nil {
+		return nil, gtserror.New("relationship was nil")
+	}
+
 	return &apimodel.Relationship{
 		ID:                  r.ID,
 		Following:           r.Following,
@@ -1545,6 +2172,36 @@ func (c *Converter) RelationshipToAPIRelationship(ctx context.Context, r *gtsmod
 		DomainBlocking:      r.DomainBlocking,
 		Endorsed:            r.Endorsed,
 		Note:                r.Note,
+		Languages:           r.Languages,
+	}, nil
+}
+
+// SuggestionToAPISuggestion converts a gts follow suggestion into its api equivalent,
+// using the given (already converted) api account as the suggested account. The suggestion
+// source is mapped to both the legacy 'source' string and newer 'sources' array.
+func (c *Converter) SuggestionToAPISuggestion(s *gtsmodel.FollowSuggestion, account *apimodel.Account) (*apimodel.Suggestion, error) {
+	if account == nil {
+		return nil, gtserror.New("nil account")
+	}
+
+	var source string
+	switch s.Source {
+	case gtsmodel.FollowSuggestionSourceFeatured:
+		source = "staff"
+	case gtsmodel.FollowSuggestionSourceMostFollowed,
+		gtsmodel.FollowSuggestionSourceMostInteractions:
+		source = "global"
+	case gtsmodel.FollowSuggestionSourceSimilarToRecentlyFollowed,
+		gtsmodel.FollowSuggestionSourceFriendsOfFriends:
+		source = "past_interactions"
+	default:
+		return nil, gtserror.Newf("unknown suggestion source %d", s.Source)
+	}
+
+	return &apimodel.Suggestion{
+		Source:  source,
+		Sources: []string{s.Source.String()},
+		Account: account,
 	}, nil
 }
 
@@ -1615,6 +2272,7 @@ func (c *Converter) NotificationToAPINotification(
 		CreatedAt: util.FormatISO8601(n.CreatedAt),
 		Account:   apiAccount,
 		Status:    apiStatus,
+		Dismissed: util.PtrValueOr(n.Dismissed, false),
 	}, nil
 }
 
@@ -1651,11 +2309,26 @@ func (c *Converter) DomainPermToAPIDomainPerm(
 	domainPerm.CreatedBy = d.GetCreatedByAccountID()
 	domainPerm.CreatedAt = util.FormatISO8601(d.GetCreatedAt())
 
+	if block, ok := d.(*gtsmodel.DomainBlock); ok {
+		domainPerm.Severity = block.Severity.String()
+		domainPerm.BlockListURL = block.BlockListURL
+		domainPerm.Pinned = util.Ptr(util.PtrValueOr(block.Pinned, false))
+
+		// Include progress of block side effects.
+		domainPerm.Processed = util.Ptr(block.SideEffectsProcessed)
+		domainPerm.Total = util.Ptr(block.SideEffectsTotal)
+	}
+
 	return domainPerm, nil
 }
 
-// ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
-func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
+// ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports.
+//
+// The target account and any reported statuses are embedded in the report, with
+// statuses converted from the perspective of the requester (ie., the reporter).
+func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report, requester *gtsmodel.Account) (*apimodel.Report, error) {
+	var err error
+
 	report := &apimodel.Report{
 		ID:          r.ID,
 		CreatedAt:   util.FormatISO8601(r.CreatedAt),
@@ -1667,6 +2340,8 @@ func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (
 		RuleIDs:     r.RuleIDs,
 	}
 
+	// Only set action taken at if an action was
+	// taken, leaving it null rather than zero time.
 	if !r.ActionTakenAt.IsZero() {
 		actionTakenAt := util.FormatISO8601(r.ActionTakenAt)
 		report.ActionTakenAt = &actionTakenAt
@@ -1677,18 +2352,32 @@ func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (
 	}
 
 	if r.TargetAccount == nil {
-		tAccount, err := c.state.DB.GetAccountByID(ctx, r.TargetAccountID)
+		r.TargetAccount, err = c.state.DB.GetAccountByID(ctx, r.TargetAccountID)
 		if err != nil {
-			return nil, fmt.Errorf("ReportToAPIReport: error getting target account with id %s from the db: %s", r.TargetAccountID, err)
+			return nil, gtserror.Newf("error getting target account with id %s from the db: %w", r.TargetAccountID, err)
 		}
-		r.TargetAccount = tAccount
 	}
 
-	apiAccount, err := c.AccountToAPIAccountPublic(ctx, r.TargetAccount)
+	report.TargetAccount, err = c.AccountToAPIAccountPublic(ctx, r.TargetAccount)
 	if err != nil {
-		return nil, fmt.Errorf("ReportToAPIReport: error converting target account to api: %s", err)
+		return nil, gtserror.Newf("error converting target account to api: %w", err)
+	}
+
+	if len(r.StatusIDs) != 0 && len(r.Statuses) == 0 {
+		r.Statuses, err = c.state.DB.GetStatusesByIDs(ctx, r.StatusIDs)
+		if err != nil {
+			return nil, gtserror.Newf("error getting statuses from the db: %w", err)
+		}
+	}
+
+	report.Statuses = make([]*apimodel.Status, 0, len(r.Statuses))
+	for _, s := range r.Statuses {
+		status, err := c.StatusToAPIStatus(ctx, s, requester, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			return nil, gtserror.Newf("error converting status with id %s to api status: %w", s.ID, err)
+		}
+		report.Statuses = append(report.Statuses, status)
 	}
-	report.TargetAccount = apiAccount
 
 	return report, nil
 }
@@ -1795,6 +2484,74 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 	}, nil
 }
 
+// SearchResultsToAPISearchResult converts the given accounts, statuses and tags into an
+// api model search result, for serving at /api/v2/search. Accounts and statuses that aren't
+// visible to requester are skipped, as are items that can't be converted. All three result
+// slices are always non-nil, so that they serialize as empty arrays rather than null.
+func (c *Converter) SearchResultsToAPISearchResult(
+	ctx context.Context,
+	accounts []*gtsmodel.Account,
+	statuses []*gtsmodel.Status,
+	tags []*gtsmodel.Tag,
+	requester *gtsmodel.Account,
+) (*apimodel.SearchResult, error) {
+	result := &apimodel.SearchResult{
+		Accounts: make([]*apimodel.Account, 0, len(accounts)),
+		Statuses: make([]*apimodel.Status, 0, len(statuses)),
+		Hashtags: make([]any, 0, len(tags)),
+	}
+
+	for _, account := range accounts {
+		visible, err := c.filter.AccountVisible(ctx, requester, account)
+		if err != nil {
+			return nil, gtserror.Newf("error checking visibility of account %s: %w", account.ID, err)
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Debugf(ctx, "skipping account %s because it couldn't be converted to its api representation: %v", account.ID, err)
+			continue
+		}
+
+		result.Accounts = append(result.Accounts, apiAccount)
+	}
+
+	for _, status := range statuses {
+		visible, err := c.filter.StatusVisible(ctx, requester, status)
+		if err != nil {
+			return nil, gtserror.Newf("error checking visibility of status %s: %w", status.ID, err)
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiStatus, err := c.StatusToAPIStatus(ctx, status, requester, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			log.Debugf(ctx, "skipping status %s because it couldn't be converted to its api representation: %v", status.ID, err)
+			continue
+		}
+
+		result.Statuses = append(result.Statuses, apiStatus)
+	}
+
+	for _, tag := range tags {
+		apiTag, err := c.TagToAPITag(ctx, tag, true)
+		if err != nil {
+			log.Debugf(ctx, "skipping tag %s because it couldn't be converted to its api representation: %v", tag.Name, err)
+			continue
+		}
+
+		result.Hashtags = append(result.Hashtags, &apiTag)
+	}
+
+	return result, nil
+}
+
 // ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
 func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
 	return &apimodel.List{
@@ -2185,3 +2942,16 @@ func (c *Converter) ThemesToAPIThemes(themes []*gtsmodel.Theme) []apimodel.Theme
 	}
 	return apiThemes
 }
+
+// localAcct returns the acct string for the given local
+// account. For accounts using the default account-domain
+// the domain is omitted, as usual. For accounts using one
+// of the additional account-domains it is included, so
+// that clients don't assume the default account-domain.
+func localAcct(a *gtsmodel.Account) string {
+	if a.AccountDomain == "" ||
+		a.AccountDomain == config.GetAccountDomain() {
+		return a.Username // omit domain
+	}
+	return a.Username + "@" + a.AccountDomain
+}
@@ -20,14 +20,17 @@ package typeutils_test
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -124,6 +127,8 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved()
     "note": "hey yo this is my profile!",
     "fields": [],
     "follow_requests_count": 0,
+    "media_used_bytes": 4463269,
+    "media_quota_bytes": 0,
     "also_known_as_uris": [
       "http://localhost:8080/users/1happyturtle"
     ]
@@ -306,7 +311,9 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
     "status_content_type": "text/plain",
     "note": "hey yo this is my profile!",
     "fields": [],
-    "follow_requests_count": 0
+    "follow_requests_count": 0,
+    "media_used_bytes": 4463269,
+    "media_quota_bytes": 0
   },
   "enable_rss": true,
   "role": {
@@ -427,6 +434,54 @@ func (suite *InternalToFrontendTestSuite) TestLocalInstanceAccountToFrontendBloc
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestQuoteToFrontendAccepted() {
+	quote := &gtsmodel.StatusQuote{
+		ID:             "01J0C3N9W1B8R6V4Y2QXK7H5DT",
+		StatusID:       suite.testStatuses["local_account_1_status_1"].ID,
+		QuotedStatusID: suite.testStatuses["admin_account_status_1"].ID,
+		State:          gtsmodel.QuoteStateAccepted,
+	}
+	requestingAccount := suite.testAccounts["local_account_2"]
+
+	apiQuote, err := suite.typeconverter.QuoteToAPIQuote(context.Background(), quote, requestingAccount)
+	suite.NoError(err)
+
+	suite.Equal("accepted", apiQuote.State)
+	if suite.NotNil(apiQuote.QuotedStatus) {
+		suite.Equal(quote.QuotedStatusID, apiQuote.QuotedStatus.ID)
+		suite.Equal("public", string(apiQuote.QuotedStatus.Visibility))
+	}
+}
+
+func (suite *InternalToFrontendTestSuite) TestQuoteToFrontendNowPrivate() {
+	// Quoted status has since been made followers-only,
+	// and the requester doesn't follow the quoted author.
+	quoted := new(gtsmodel.Status)
+	*quoted = *suite.testStatuses["admin_account_status_1"]
+	quoted.Visibility = gtsmodel.VisibilityFollowersOnly
+
+	quote := &gtsmodel.StatusQuote{
+		ID:             "01J0C3N9W1B8R6V4Y2QXK7H5DT",
+		StatusID:       suite.testStatuses["local_account_1_status_1"].ID,
+		QuotedStatusID: quoted.ID,
+		QuotedStatus:   quoted,
+		State:          gtsmodel.QuoteStateAccepted,
+	}
+	requestingAccount := suite.testAccounts["local_account_2"]
+
+	apiQuote, err := suite.typeconverter.QuoteToAPIQuote(context.Background(), quote, requestingAccount)
+	suite.NoError(err)
+
+	// State is kept, but quoted
+	// status should be left out.
+	suite.Equal("accepted", apiQuote.State)
+	suite.Nil(apiQuote.QuotedStatus)
+
+	b, err := json.Marshal(apiQuote)
+	suite.NoError(err)
+	suite.Equal(`{"state":"accepted","quoted_status":null}`, string(b))
+}
+
 func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
 	testStatus := suite.testStatuses["admin_account_status_1"]
 	requestingAccount := suite.testAccounts["local_account_1"]
@@ -456,6 +511,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
   "bookmarked": true,
   "pinned": false,
   "content": "hello world! #welcome ! first post on the instance :rainbow: !",
+  "word_count": 0,
   "reblog": null,
   "application": {
     "name": "superseriousbusiness",
@@ -585,6 +641,7 @@ func (suite *InternalToFrontendTestSuite) TestWarnFilteredStatusToFrontend() {
   "bookmarked": true,
   "pinned": false,
   "content": "hello world! #welcome ! first post on the instance :rainbow: ! fnord",
+  "word_count": 0,
   "reblog": null,
   "application": {
     "name": "superseriousbusiness",
@@ -772,6 +829,82 @@ func (suite *InternalToFrontendTestSuite) TestMutedReplyStatusToFrontend() {
 	suite.ErrorIs(err, statusfilter.ErrHideStatus)
 }
 
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendHideInteractionCounts() {
+	var (
+		ctx        = context.Background()
+		testStatus = new(gtsmodel.Status)
+		author     = new(gtsmodel.Account)
+		settings   = new(gtsmodel.AccountSettings)
+	)
+
+	// Copy admin's status + account, and
+	// set admin to hide interaction counts.
+	*testStatus = *suite.testStatuses["admin_account_status_1"]
+	*author = *suite.testAccounts["admin_account"]
+	*settings = *suite.testAccounts["admin_account"].Settings
+	settings.HideInteractionCounts = util.Ptr(true)
+	author.Settings = settings
+	testStatus.Account = author
+
+	// Other accounts should see zero counts.
+	for _, requester := range []*gtsmodel.Account{
+		suite.testAccounts["local_account_1"],
+		nil,
+	} {
+		apiStatus, err := suite.typeconverter.StatusToAPIStatus(ctx, testStatus, requester, statusfilter.FilterContextNone, nil, nil)
+		suite.NoError(err)
+		suite.Zero(apiStatus.FavouritesCount)
+		suite.Zero(apiStatus.ReblogsCount)
+		suite.Equal(1, apiStatus.RepliesCount)
+		suite.True(apiStatus.Account.HideInteractionCounts)
+	}
+
+	// Author should still see real counts.
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(ctx, testStatus, author, statusfilter.FilterContextNone, nil, nil)
+	suite.NoError(err)
+	suite.Equal(1, apiStatus.FavouritesCount)
+}
+
+func (suite *InternalToFrontendTestSuite) TestBoostToAPIStatus() {
+	var (
+		ctx       = context.Background()
+		boost     = suite.testStatuses["admin_account_status_4"]
+		boosted   = suite.testStatuses["local_account_1_status_1"]
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	apiStatus, err := suite.typeconverter.BoostToAPIStatus(ctx, boost, requester)
+	suite.NoError(err)
+
+	// Wrapper has the boost's own metadata, and no content.
+	suite.Equal(boost.ID, apiStatus.ID)
+	suite.Equal(boost.URI, apiStatus.URI)
+	suite.Equal(boost.AccountID, apiStatus.Account.ID)
+	suite.Empty(apiStatus.Content)
+	suite.Empty(apiStatus.SpoilerText)
+	suite.Empty(apiStatus.MediaAttachments)
+	suite.Nil(apiStatus.Poll)
+
+	// Reblog is the fully populated boosted status.
+	suite.NotNil(apiStatus.Reblog)
+	suite.Equal(boosted.ID, apiStatus.Reblog.ID)
+	suite.Equal(boosted.Content, apiStatus.Reblog.Content)
+	suite.Equal(boosted.AccountID, apiStatus.Reblog.Account.ID)
+	suite.Nil(apiStatus.Reblog.Reblog)
+
+	// Counts + interactions are taken from the boosted status.
+	suite.Equal(apiStatus.Reblog.ReblogsCount, apiStatus.ReblogsCount)
+	suite.Equal(apiStatus.Reblog.FavouritesCount, apiStatus.FavouritesCount)
+	suite.Equal(apiStatus.Reblog.RepliesCount, apiStatus.RepliesCount)
+	suite.Equal(apiStatus.Reblog.Favourited, apiStatus.Favourited)
+	suite.Equal(apiStatus.Reblog.Reblogged, apiStatus.Reblogged)
+	suite.Equal(apiStatus.Reblog.Bookmarked, apiStatus.Bookmarked)
+
+	// Non-boosts can't be converted.
+	_, err = suite.typeconverter.BoostToAPIStatus(ctx, boosted, requester)
+	suite.Error(err)
+}
+
 func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments() {
 	testStatus := suite.testStatuses["remote_account_2_status_1"]
 	requestingAccount := suite.testAccounts["admin_account"]
@@ -802,6 +935,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments
   "bookmarked": false,
   "pinned": false,
   "content": "\u003cp\u003ehi \u003cspan class=\"h-card\"\u003e\u003ca href=\"http://localhost:8080/@admin\" class=\"u-url mention\" rel=\"nofollow noreferrer noopener\" target=\"_blank\"\u003e@\u003cspan\u003eadmin\u003c/span\u003e\u003c/a\u003e\u003c/span\u003e here's some media for ya\u003c/p\u003e\u003chr\u003e\u003cp\u003e\u003ci lang=\"en\"\u003eℹ️ Note from localhost:8080: 2 attachments in this status could not be downloaded. Treat the following external links with care:\u003c/i\u003e\u003c/p\u003e\u003cul\u003e\u003cli\u003e\u003ca href=\"http://example.org/fileserver/01HE7Y659ZWZ02JM4AWYJZ176Q/attachment/original/01HE7ZGJYTSYMXF927GF9353KR.svg\" rel=\"nofollow noreferrer noopener\" target=\"_blank\"\u003e01HE7ZGJYTSYMXF927GF9353KR.svg\u003c/a\u003e [SVG line art of a sloth, public domain]\u003c/li\u003e\u003cli\u003e\u003ca href=\"http://example.org/fileserver/01HE7Y659ZWZ02JM4AWYJZ176Q/attachment/original/01HE892Y8ZS68TQCNPX7J888P3.mp3\" rel=\"nofollow noreferrer noopener\" target=\"_blank\"\u003e01HE892Y8ZS68TQCNPX7J888P3.mp3\u003c/a\u003e [Jolly salsa song, public domain.]\u003c/li\u003e\u003c/ul\u003e",
+  "word_count": 0,
   "reblog": null,
   "account": {
     "id": "01FHMQX3GAABWSM0S2VZEC2SWC",
@@ -911,6 +1045,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
   "bookmarked": false,
   "pinned": false,
   "content": "\u003cp\u003ehi \u003cspan class=\"h-card\"\u003e\u003ca href=\"http://localhost:8080/@admin\" class=\"u-url mention\" rel=\"nofollow noreferrer noopener\" target=\"_blank\"\u003e@\u003cspan\u003eadmin\u003c/span\u003e\u003c/a\u003e\u003c/span\u003e here's some media for ya\u003c/p\u003e",
+  "word_count": 0,
   "reblog": null,
   "account": {
     "id": "01FHMQX3GAABWSM0S2VZEC2SWC",
@@ -962,7 +1097,8 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
         }
       },
       "description": "Photograph of a sloth, Public Domain.",
-      "blurhash": "LNEC{|w}0K9GsEtPM|j[NFbHoeof"
+      "blurhash": "LNEC{|w}0K9GsEtPM|j[NFbHoeof",
+      "sensitive": true
     },
     {
       "id": "01HE7ZFX9GKA5ZZVD4FACABSS9",
@@ -974,7 +1110,8 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
       "preview_remote_url": null,
       "meta": null,
       "description": "SVG line art of a sloth, public domain",
-      "blurhash": "L26*j+~qE1RP?wxut7ofRlM{R*of"
+      "blurhash": "L26*j+~qE1RP?wxut7ofRlM{R*of",
+      "sensitive": true
     },
     {
       "id": "01HE88YG74PVAB81PX2XA9F3FG",
@@ -986,7 +1123,8 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
       "preview_remote_url": null,
       "meta": null,
       "description": "Jolly salsa song, public domain.",
-      "blurhash": null
+      "blurhash": null,
+      "sensitive": true
     }
   ],
   "mentions": [
@@ -1035,6 +1173,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
   "bookmarked": true,
   "pinned": false,
   "content": "hello world! #welcome ! first post on the instance :rainbow: !",
+  "word_count": 0,
   "reblog": null,
   "application": {
     "name": "superseriousbusiness",
@@ -1155,6 +1294,50 @@ func (suite *InternalToFrontendTestSuite) TestVideoAttachmentToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestInstanceToAPIExtendedDescription() {
+	ctx := context.Background()
+
+	i := &gtsmodel.Instance{}
+	if err := suite.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: config.GetHost()}}, i); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	extendedDescription, err := suite.typeconverter.InstanceToAPIExtendedDescription(i)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(i.Description, extendedDescription.Content)
+	suite.Equal("2020-01-20T11:12:00.000Z", extendedDescription.UpdatedAt)
+}
+
+func (suite *InternalToFrontendTestSuite) TestInstanceToAPIExtendedDescriptionUnset() {
+	i := testrig.NewTestInstances()["fossbros-anonymous.io"]
+	suite.Empty(i.Description)
+
+	// Should get empty content rather than nil.
+	extendedDescription, err := suite.typeconverter.InstanceToAPIExtendedDescription(i)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, err := json.MarshalIndent(extendedDescription, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "updated_at": "2021-09-20T10:40:37.000Z",
+  "content": ""
+}`, string(b))
+
+	// Instance without any times
+	// set should still get a time.
+	extendedDescription, err = suite.typeconverter.InstanceToAPIExtendedDescription(&gtsmodel.Instance{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("1970-01-01T00:00:00.000Z", extendedDescription.UpdatedAt)
+	suite.Empty(extendedDescription.Content)
+}
+
 func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
 	ctx := context.Background()
 
@@ -1211,7 +1394,10 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -1336,7 +1522,10 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500,
+      "description_min_limit": 0,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -1391,6 +1580,40 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestInstanceAdvertisedLimitsMatchEnforced() {
+	ctx := context.Background()
+
+	config.SetStatusesMaxChars(1234)
+	config.SetStatusesMediaMaxFiles(3)
+
+	i := &gtsmodel.Instance{}
+	if err := suite.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: config.GetHost()}}, i); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	v1, err := suite.typeconverter.InstanceToAPIV1Instance(ctx, i)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	v2, err := suite.typeconverter.InstanceToAPIV2Instance(ctx, i)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The limits enforced by validators.
+	enforced := gtsmodel.NewInstanceConfiguration()
+	suite.Equal(1234, enforced.StatusesMaxChars)
+	suite.Equal(3, enforced.StatusesMediaMaxFiles)
+
+	// Should match those advertised by both instance versions.
+	suite.Equal(enforced.StatusesMaxChars, v1.Configuration.Statuses.MaxCharacters)
+	suite.Equal(uint(enforced.StatusesMaxChars), v1.MaxTootChars)
+	suite.Equal(enforced.StatusesMediaMaxFiles, v1.Configuration.Statuses.MaxMediaAttachments)
+	suite.Equal(enforced.StatusesMaxChars, v2.Configuration.Statuses.MaxCharacters)
+	suite.Equal(enforced.StatusesMediaMaxFiles, v2.Configuration.Statuses.MaxMediaAttachments)
+}
+
 func (suite *InternalToFrontendTestSuite) TestEmojiToFrontend() {
 	emoji, err := suite.typeconverter.EmojiToAPIEmoji(context.Background(), suite.testEmojis["rainbow"])
 	suite.NoError(err)
@@ -1407,6 +1630,59 @@ func (suite *InternalToFrontendTestSuite) TestEmojiToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestEmojisToFrontendByCategory() {
+	// newEmoji returns a copy of the rainbow
+	// emoji with given shortcode + category.
+	newEmoji := func(shortcode string, category *gtsmodel.EmojiCategory) *gtsmodel.Emoji {
+		emoji := new(gtsmodel.Emoji)
+		*emoji = *suite.testEmojis["rainbow"]
+		emoji.Shortcode = shortcode
+		emoji.CategoryID = ""
+		emoji.Category = nil
+		if category != nil {
+			emoji.CategoryID = category.ID
+			emoji.Category = category
+		}
+		return emoji
+	}
+
+	reactions := suite.testEmojiCategories["reactions"]
+	cute := suite.testEmojiCategories["cute stuff"]
+	emojis := []*gtsmodel.Emoji{
+		newEmoji("zebra", nil),
+		newEmoji("wave", reactions),
+		newEmoji("kitten", cute),
+		newEmoji("apple", nil),
+		newEmoji("clap", reactions),
+		newEmoji("bunny", cute),
+	}
+
+	for i := 0; i < 2; i++ {
+		categories, err := suite.typeconverter.EmojisToAPIEmojisByCategory(context.Background(), emojis)
+		suite.NoError(err)
+
+		var names []string
+		shortcodes := make(map[string][]string)
+		for _, category := range categories {
+			names = append(names, category.Name)
+			for _, emoji := range category.Emojis {
+				suite.Equal(category.Name, emoji.Category)
+				shortcodes[category.Name] = append(shortcodes[category.Name], emoji.Shortcode)
+			}
+		}
+
+		// Categories sorted alphabetically, uncategorized
+		// last, and emojis sorted by shortcode in each.
+		suite.Equal([]string{"cute stuff", "reactions", ""}, names)
+		suite.Equal([]string{"bunny", "kitten"}, shortcodes["cute stuff"])
+		suite.Equal([]string{"clap", "wave"}, shortcodes["reactions"])
+		suite.Equal([]string{"apple", "zebra"}, shortcodes[""])
+
+		// Ordering should not depend on input order.
+		slices.Reverse(emojis)
+	}
+}
+
 func (suite *InternalToFrontendTestSuite) TestEmojiToFrontendAdmin1() {
 	emoji, err := suite.typeconverter.EmojiToAdminAPIEmoji(context.Background(), suite.testEmojis["rainbow"])
 	suite.NoError(err)
@@ -1452,7 +1728,7 @@ func (suite *InternalToFrontendTestSuite) TestEmojiToFrontendAdmin2() {
 }
 
 func (suite *InternalToFrontendTestSuite) TestReportToFrontend1() {
-	report, err := suite.typeconverter.ReportToAPIReport(context.Background(), suite.testReports["local_account_2_report_remote_account_1"])
+	report, err := suite.typeconverter.ReportToAPIReport(context.Background(), suite.testReports["local_account_2_report_remote_account_1"], suite.testAccounts["local_account_2"])
 	suite.NoError(err)
 
 	b, err := json.MarshalIndent(report, "", "  ")
@@ -1495,12 +1771,95 @@ func (suite *InternalToFrontendTestSuite) TestReportToFrontend1() {
     "last_status_at": "2021-09-11T09:40:37.000Z",
     "emojis": [],
     "fields": []
-  }
+  },
+  "statuses": [
+    {
+      "id": "01FVW7JHQFSFK166WWKR8CBA6M",
+      "created_at": "2021-09-20T10:40:37.000Z",
+      "in_reply_to_id": null,
+      "in_reply_to_account_id": null,
+      "sensitive": false,
+      "spoiler_text": "",
+      "visibility": "unlisted",
+      "language": "en",
+      "uri": "http://fossbros-anonymous.io/users/foss_satan/statuses/01FVW7JHQFSFK166WWKR8CBA6M",
+      "url": "http://fossbros-anonymous.io/@foss_satan/statuses/01FVW7JHQFSFK166WWKR8CBA6M",
+      "replies_count": 0,
+      "reblogs_count": 0,
+      "favourites_count": 0,
+      "favourited": false,
+      "reblogged": false,
+      "muted": false,
+      "bookmarked": false,
+      "pinned": false,
+      "content": "dark souls status bot: \"thoughts of dog\"",
+      "word_count": 0,
+      "reblog": null,
+      "account": {
+        "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
+        "username": "foss_satan",
+        "acct": "foss_satan@fossbros-anonymous.io",
+        "display_name": "big gerald",
+        "locked": false,
+        "discoverable": true,
+        "bot": false,
+        "created_at": "2021-09-26T10:52:36.000Z",
+        "note": "i post about like, i dunno, stuff, or whatever!!!!",
+        "url": "http://fossbros-anonymous.io/@foss_satan",
+        "avatar": "",
+        "avatar_static": "",
+        "header": "http://localhost:8080/assets/default_header.png",
+        "header_static": "http://localhost:8080/assets/default_header.png",
+        "followers_count": 0,
+        "following_count": 0,
+        "statuses_count": 3,
+        "last_status_at": "2021-09-11T09:40:37.000Z",
+        "emojis": [],
+        "fields": []
+      },
+      "media_attachments": [
+        {
+          "id": "01FVW7RXPQ8YJHTEXYPE7Q8ZY0",
+          "type": "image",
+          "url": "http://localhost:8080/fileserver/01F8MH5ZK5VRH73AKHQM6Y9VNX/attachment/original/01FVW7RXPQ8YJHTEXYPE7Q8ZY0.jpg",
+          "text_url": "http://localhost:8080/fileserver/01F8MH5ZK5VRH73AKHQM6Y9VNX/attachment/original/01FVW7RXPQ8YJHTEXYPE7Q8ZY0.jpg",
+          "preview_url": "http://localhost:8080/fileserver/01F8MH5ZK5VRH73AKHQM6Y9VNX/attachment/small/01FVW7RXPQ8YJHTEXYPE7Q8ZY0.jpg",
+          "remote_url": "http://fossbros-anonymous.io/attachments/original/13bbc3f8-2b5e-46ea-9531-40b4974d9912.jpg",
+          "preview_remote_url": "http://fossbros-anonymous.io/attachments/small/a499f55b-2d1e-4acd-98d2-1ac2ba6d79b9.jpg",
+          "meta": {
+            "original": {
+              "width": 472,
+              "height": 291,
+              "size": "472x291",
+              "aspect": 1.6219932
+            },
+            "small": {
+              "width": 472,
+              "height": 291,
+              "size": "472x291",
+              "aspect": 1.6219932
+            },
+            "focus": {
+              "x": 0,
+              "y": 0
+            }
+          },
+          "description": "tweet from thoughts of dog: i drank. all the water. in my bowl. earlier. but just now. i returned. to the same bowl. and it was. full again.. the bowl. is haunted",
+          "blurhash": "LARysgM_IU_3~pD%M_Rj_39FIAt6"
+        }
+      ],
+      "mentions": [],
+      "tags": [],
+      "emojis": [],
+      "card": null,
+      "poll": null
+    }
+  ]
 }`, string(b))
 }
 
 func (suite *InternalToFrontendTestSuite) TestReportToFrontend2() {
-	report, err := suite.typeconverter.ReportToAPIReport(context.Background(), suite.testReports["remote_account_1_report_local_account_2"])
+	report, err := suite.typeconverter.ReportToAPIReport(context.Background(), suite.testReports["remote_account_1_report_local_account_2"], suite.testAccounts["remote_account_1"])
 	suite.NoError(err)
 
 	b, err := json.MarshalIndent(report, "", "  ")
@@ -1553,7 +1912,8 @@ func (suite *InternalToFrontendTestSuite) TestReportToFrontend2() {
     "role": {
       "name": "user"
     }
-  }
+  },
+  "statuses": []
 }`, string(b))
 }
 
@@ -1716,7 +2076,8 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
         "name": "admin"
       }
     },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+    "media_used_bytes": 69401
   },
   "action_taken_by_account": {
     "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -1762,7 +2123,8 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
         "name": "admin"
       }
     },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+    "media_used_bytes": 69401
   },
   "statuses": [],
   "rules": [],
@@ -1908,6 +2270,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "bookmarked": false,
       "pinned": false,
       "content": "dark souls status bot: \"thoughts of dog\"",
+      "word_count": 0,
       "reblog": null,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -2153,7 +2516,8 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
         "name": "admin"
       }
     },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+    "media_used_bytes": 69401
   },
   "action_taken_by_account": {
     "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -2199,7 +2563,8 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
         "name": "admin"
       }
     },
-    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
+    "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F",
+    "media_used_bytes": 69401
   },
   "statuses": [],
   "rules": [],
@@ -2257,7 +2622,8 @@ func (suite *InternalToFrontendTestSuite) TestRelationshipFollowRequested() {
   "requested_by": false,
   "domain_blocking": false,
   "endorsed": false,
-  "note": ""
+  "note": "",
+  "languages": null
 }`, string(b))
 
 	// Check relationship from the other side too.
@@ -2291,8 +2657,295 @@ func (suite *InternalToFrontendTestSuite) TestRelationshipFollowRequested() {
   "requested_by": true,
   "domain_blocking": false,
   "endorsed": false,
-  "note": ""
+  "note": "",
+  "languages": null
+}`, string(b))
+}
+
+func (suite *InternalToFrontendTestSuite) TestRelationshipToFrontendFields() {
+	const id = "01F8MH5NBDF2MV7CTC4Q5128HF"
+
+	// Each case sets one field on the
+	// gtsmodel, and expects only the
+	// corresponding api field to be set.
+	for _, test := range []struct {
+		name     string
+		in       gtsmodel.Relationship
+		expected apimodel.Relationship
+	}{
+		{"following", gtsmodel.Relationship{Following: true}, apimodel.Relationship{Following: true}},
+		{"showing_reblogs", gtsmodel.Relationship{ShowingReblogs: true}, apimodel.Relationship{ShowingReblogs: true}},
+		{"notifying", gtsmodel.Relationship{Notifying: true}, apimodel.Relationship{Notifying: true}},
+		{"followed_by", gtsmodel.Relationship{FollowedBy: true}, apimodel.Relationship{FollowedBy: true}},
+		{"blocking", gtsmodel.Relationship{Blocking: true}, apimodel.Relationship{Blocking: true}},
+		{"blocked_by", gtsmodel.Relationship{BlockedBy: true}, apimodel.Relationship{BlockedBy: true}},
+		{"muting", gtsmodel.Relationship{Muting: true}, apimodel.Relationship{Muting: true}},
+		{"muting_notifications", gtsmodel.Relationship{MutingNotifications: true}, apimodel.Relationship{MutingNotifications: true}},
+		{"requested", gtsmodel.Relationship{Requested: true}, apimodel.Relationship{Requested: true}},
+		{"requested_by", gtsmodel.Relationship{RequestedBy: true}, apimodel.Relationship{RequestedBy: true}},
+		{"domain_blocking", gtsmodel.Relationship{DomainBlocking: true}, apimodel.Relationship{DomainBlocking: true}},
+		{"endorsed", gtsmodel.Relationship{Endorsed: true}, apimodel.Relationship{Endorsed: true}},
+		{"note", gtsmodel.Relationship{Note: "some note"}, apimodel.Relationship{Note: "some note"}},
+		{"languages", gtsmodel.Relationship{Languages: []string{"en", "de"}}, apimodel.Relationship{Languages: []string{"en", "de"}}},
+	} {
+		test.in.ID = id
+		test.expected.ID = id
+
+		relationship, err := suite.typeconverter.RelationshipToAPIRelationship(context.Background(), &test.in)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(&test.expected, relationship, test.name)
+	}
+}
+
+func (suite *InternalToFrontendTestSuite) TestSuggestionToFrontend() {
+	var (
+		ctx           = context.Background()
+		requester     = suite.testAccounts["local_account_1"]
+		targetAccount = suite.testAccounts["local_account_2"]
+	)
+
+	apiAccount, err := suite.typeconverter.AccountToAPIAccountPublic(ctx, targetAccount)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, test := range []struct {
+		source        gtsmodel.FollowSuggestionSource
+		expectSource  string
+		expectSources []string
+	}{
+		{
+			source:        gtsmodel.FollowSuggestionSourceFeatured,
+			expectSource:  "staff",
+			expectSources: []string{"featured"},
+		},
+		{
+			source:        gtsmodel.FollowSuggestionSourceMostFollowed,
+			expectSource:  "global",
+			expectSources: []string{"most_followed"},
+		},
+		{
+			source:        gtsmodel.FollowSuggestionSourceMostInteractions,
+			expectSource:  "global",
+			expectSources: []string{"most_interactions"},
+		},
+		{
+			source:        gtsmodel.FollowSuggestionSourceSimilarToRecentlyFollowed,
+			expectSource:  "past_interactions",
+			expectSources: []string{"similar_to_recently_followed"},
+		},
+		{
+			source:        gtsmodel.FollowSuggestionSourceFriendsOfFriends,
+			expectSource:  "past_interactions",
+			expectSources: []string{"friends_of_friends"},
+		},
+	} {
+		suggestion, err := suite.typeconverter.SuggestionToAPISuggestion(
+			&gtsmodel.FollowSuggestion{
+				AccountID:       requester.ID,
+				TargetAccountID: targetAccount.ID,
+				TargetAccount:   targetAccount,
+				Source:          test.source,
+			},
+			apiAccount,
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.expectSource, suggestion.Source)
+		suite.Equal(test.expectSources, suggestion.Sources)
+		suite.Equal(apiAccount, suggestion.Account)
+	}
+
+	// Unknown source should error.
+	_, err = suite.typeconverter.SuggestionToAPISuggestion(
+		&gtsmodel.FollowSuggestion{Source: gtsmodel.FollowSuggestionSourceUnknown},
+		apiAccount,
+	)
+	suite.Error(err)
+}
+
+func (suite *InternalToFrontendTestSuite) TestTokenToAPIToken() {
+	token := testrig.NewTestTokens()["local_account_1"]
+	token.Scope = "  read write\tfollow  push "
+
+	apiToken, err := suite.typeconverter.TokenToAPIToken(token)
+	suite.NoError(err)
+
+	b, err := json.Marshal(apiToken)
+	suite.NoError(err)
+
+	raw := make(map[string]any)
+	suite.NoError(json.Unmarshal(b, &raw))
+
+	// created_at must be a JSON
+	// number of unix seconds.
+	createdAt, ok := raw["created_at"].(float64)
+	suite.True(ok)
+	suite.Equal(float64(1654874528), createdAt)
+	suite.Equal("Bearer", raw["token_type"])
+	suite.Equal("read write follow push", raw["scope"])
+	suite.Equal(token.Access, raw["access_token"])
+
+	// Tokens without access should error.
+	_, err = suite.typeconverter.TokenToAPIToken(&gtsmodel.Token{})
+	suite.Error(err)
+}
+
+func (suite *InternalToFrontendTestSuite) TestFeaturedTagToAPIFeaturedTag() {
+	account := suite.testAccounts["local_account_1"]
+
+	// Name should be normalized,
+	// without the hash prefix.
+	ft, err := typeutils.APIFeaturedTagToFeaturedTag(account, "#Cafe\u0301Culture")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(ft.ID)
+	suite.Equal(account.ID, ft.AccountID)
+	suite.Equal("Caf\u00e9Culture", ft.Name)
+
+	// Tag that's been used.
+	apiFeaturedTag, err := suite.typeconverter.FeaturedTagToAPIFeaturedTag(ft, gtsmodel.TagStats{
+		StatusesCount: 3,
+		LastStatusAt:  testrig.TimeMustParse("2022-05-14T13:21:09+02:00"),
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	apiFeaturedTag.ID = "01HZ4M3G6S0PTJXEN6Q44MZ9KA" // Fix ID for comparison.
+
+	b, err := json.MarshalIndent(apiFeaturedTag, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "id": "01HZ4M3G6S0PTJXEN6Q44MZ9KA",
+  "name": "CaféCulture",
+  "url": "http://localhost:8080/tags/cafeculture",
+  "statuses_count": 3,
+  "last_status_at": "2022-05-14T11:21:09.000Z"
+}`, string(b))
+
+	// Tag that's never been used should
+	// have null rather than empty last_status_at.
+	apiFeaturedTag, err = suite.typeconverter.FeaturedTagToAPIFeaturedTag(ft, gtsmodel.TagStats{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	apiFeaturedTag.ID = "01HZ4M3G6S0PTJXEN6Q44MZ9KA"
+
+	b, err = json.MarshalIndent(apiFeaturedTag, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "id": "01HZ4M3G6S0PTJXEN6Q44MZ9KA",
+  "name": "CaféCulture",
+  "url": "http://localhost:8080/tags/cafeculture",
+  "statuses_count": 0,
+  "last_status_at": null
 }`, string(b))
+
+	// Invalid hashtags should be rejected.
+	for _, rawName := range []string{"", "#", "___", "no spaces", "#no-dashes"} {
+		_, err := typeutils.APIFeaturedTagToFeaturedTag(account, rawName)
+		suite.Error(err, rawName)
+	}
+}
+
+func (suite *InternalToFrontendTestSuite) TestTranslationToAPITranslation() {
+	// Status with a poll, to which we
+	// also attach some media to translate.
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_6"]
+	status.Poll = testrig.NewTestPolls()["local_account_1_status_6_poll"]
+	status.Attachments = []*gtsmodel.MediaAttachment{
+		suite.testAttachments["admin_account_status_1_attachment_1"],
+		suite.testAttachments["local_account_1_unattached_1"],
+	}
+	status.AttachmentIDs = []string{
+		status.Attachments[0].ID,
+		status.Attachments[1].ID,
+	}
+
+	apiTranslation, err := suite.typeconverter.TranslationToAPITranslation(status, gtsmodel.TranslationResult{
+		Content:                "<p>¿qué opinas de los perezosos?</p>",
+		SpoilerText:            "",
+		MediaDescriptions:      []string{"pantalla de bienvenida", "oh tú"},
+		PollOptions:            []string{"bueno", "malo", "meh"},
+		DetectedSourceLanguage: "en",
+		Provider:               "LibreTranslate",
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, err := json.MarshalIndent(apiTranslation, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "content": "\u003cp\u003e¿qué opinas de los perezosos?\u003c/p\u003e",
+  "spoiler_text": "",
+  "poll": {
+    "id": "01HEN2RKT1YTEZ80SA8HGP105F",
+    "options": [
+      {
+        "title": "bueno"
+      },
+      {
+        "title": "malo"
+      },
+      {
+        "title": "meh"
+      }
+    ]
+  },
+  "media_attachments": [
+    {
+      "id": "01F8MH6NEM8D7527KZAECTCR76",
+      "description": "pantalla de bienvenida"
+    },
+    {
+      "id": "01F8MH8RMYQ6MSNY3JM2XT1CQ5",
+      "description": "oh tú"
+    }
+  ],
+  "detected_source_language": "en",
+  "provider": "LibreTranslate"
+}`, string(b))
+}
+
+func (suite *InternalToFrontendTestSuite) TestTranslationToAPITranslationCountMismatch() {
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_6"]
+	status.Poll = testrig.NewTestPolls()["local_account_1_status_6_poll"]
+
+	// One poll option too few.
+	_, err := suite.typeconverter.TranslationToAPITranslation(status, gtsmodel.TranslationResult{
+		Content:     "<p>¿qué opinas de los perezosos?</p>",
+		PollOptions: []string{"bueno", "malo"},
+	})
+	suite.EqualError(err, "TranslationToAPITranslation: translation has 2 poll options, but poll of status 01HEN2RZ8BG29Y5Z9VJC73HZW7 has 3 options")
+
+	// Media description for a
+	// status with no attachments.
+	_, err = suite.typeconverter.TranslationToAPITranslation(status, gtsmodel.TranslationResult{
+		Content:           "<p>¿qué opinas de los perezosos?</p>",
+		MediaDescriptions: []string{"un perezoso"},
+		PollOptions:       []string{"bueno", "malo", "meh"},
+	})
+	suite.EqualError(err, "TranslationToAPITranslation: translation has 1 media descriptions, but status 01HEN2RZ8BG29Y5Z9VJC73HZW7 has 0 attachments")
+}
+
+func (suite *InternalToFrontendTestSuite) TestSearchResultsToAPISearchResultEmpty() {
+	requester := suite.testAccounts["local_account_1"]
+
+	result, err := suite.typeconverter.SearchResultsToAPISearchResult(context.Background(), nil, nil, nil, requester)
+	suite.NoError(err)
+
+	b, err := json.Marshal(result)
+	suite.NoError(err)
+	suite.Equal(`{"accounts":[],"statuses":[],"hashtags":[]}`, string(b))
 }
 
 func TestInternalToFrontendTestSuite(t *testing.T) {
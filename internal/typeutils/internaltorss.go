@@ -25,7 +25,6 @@ import (
 
 	"github.com/gorilla/feeds"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
-	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
@@ -63,7 +62,7 @@ func (c *Converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 		}
 		s.Account = a
 	}
-	authorName := "@" + s.Account.Username + "@" + config.GetAccountDomain()
+	authorName := "@" + s.Account.Username + "@" + s.Account.NameDomain()
 	author := &feeds.Author{
 		Name: authorName,
 	}
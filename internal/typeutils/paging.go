@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PageItemID is a single item of a page of
+// results, identified by its sortable ID.
+type PageItemID interface {
+	GetID() string
+}
+
+// BuildPageLinks returns a Link header value pointing to
+// the next and previous pages of the given (descending)
+// items, relative to base. The next link pages down from
+// the last item, and is omitted when fewer than limit
+// items were returned, as there's nothing further to page
+// to. The prev link pages up from the first item. Any
+// given params are carried over into both links, minus
+// any existing paging params. An empty string is returned
+// for an empty page.
+func BuildPageLinks(
+	base *url.URL,
+	items []PageItemID,
+	limit int,
+	params url.Values,
+) (linkHeader string) {
+	if len(items) == 0 {
+		return ""
+	}
+
+	var parts []string
+
+	if limit <= 0 || len(items) >= limit {
+		// Full page, so there may be more
+		// to page down to after the last item.
+		nextID := items[len(items)-1].GetID()
+		next := pageLink(base, params, limit, "max_id", nextID)
+		parts = append(parts, `<`+next+`>; rel="next"`)
+	}
+
+	// Always allow paging up from the first item.
+	prevID := items[0].GetID()
+	prev := pageLink(base, params, limit, "min_id", prevID)
+	parts = append(parts, `<`+prev+`>; rel="prev"`)
+
+	return strings.Join(parts, ", ")
+}
+
+// pageLink returns a copy of base with given params as its
+// query, with paging params replaced by key=id and limit.
+func pageLink(
+	base *url.URL,
+	params url.Values,
+	limit int,
+	key string,
+	id string,
+) string {
+	query := make(url.Values, len(params)+2)
+	for k, v := range params {
+		query[k] = v
+	}
+
+	// Drop any paging params
+	// from the original request.
+	query.Del("max_id")
+	query.Del("since_id")
+	query.Del("min_id")
+
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	query.Set(key, id)
+
+	u := *base
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"net/url"
+	"testing"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+func testPageItems(ids ...string) []PageItemID {
+	items := make([]PageItemID, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, &apimodel.Status{ID: id})
+	}
+	return items
+}
+
+func TestBuildPageLinksFullPage(t *testing.T) {
+	base, _ := url.Parse("https://example.org/api/v1/accounts/01F8MH1H7YV1Z7D2C8K2730QBF/statuses")
+
+	items := testPageItems(
+		"01HCWDQ1C7APSEY34B1HFVHVX7",
+		"01HCWDKKBWECZJQ93E262N36VN",
+	)
+
+	params := url.Values{
+		"max_id":          []string{"01HCWDRZQ8KWKR0JG3K6PY7DB4"},
+		"exclude_replies": []string{"true"},
+	}
+
+	const expect = `<https://example.org/api/v1/accounts/01F8MH1H7YV1Z7D2C8K2730QBF/statuses?exclude_replies=true&limit=2&max_id=01HCWDKKBWECZJQ93E262N36VN>; rel="next", ` +
+		`<https://example.org/api/v1/accounts/01F8MH1H7YV1Z7D2C8K2730QBF/statuses?exclude_replies=true&limit=2&min_id=01HCWDQ1C7APSEY34B1HFVHVX7>; rel="prev"`
+
+	if link := BuildPageLinks(base, items, 2, params); link != expect {
+		t.Fatalf("unexpected link header, wanted %q, got %q", expect, link)
+	}
+
+	// Original params must be untouched.
+	if params.Get("max_id") != "01HCWDRZQ8KWKR0JG3K6PY7DB4" {
+		t.Fatal("params were modified")
+	}
+}
+
+func TestBuildPageLinksShortPage(t *testing.T) {
+	base, _ := url.Parse("https://example.org/api/v1/admin/accounts")
+
+	items := testPageItems(
+		"01HCWDQ1C7APSEY34B1HFVHVX7",
+	)
+
+	const expect = `<https://example.org/api/v1/admin/accounts?limit=20&min_id=01HCWDQ1C7APSEY34B1HFVHVX7>; rel="prev"`
+
+	if link := BuildPageLinks(base, items, 20, nil); link != expect {
+		t.Fatalf("unexpected link header, wanted %q, got %q", expect, link)
+	}
+}
+
+func TestBuildPageLinksEmptyPage(t *testing.T) {
+	base, _ := url.Parse("https://example.org/api/v1/admin/accounts")
+
+	if link := BuildPageLinks(base, nil, 20, nil); link != "" {
+		t.Fatalf("expected no link header, got %q", link)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -264,3 +265,37 @@ func ContentToContentLanguage(
 
 	return contentStr, langTagStr
 }
+
+// contentWarning extracts the content warning of the
+// given statusable as plain text, stripping any HTML
+// exactly once. Summary is preferred, falling back to
+// Name, unless Name just repeats the status content
+// or a media description, as some implementations
+// set it that way for posts with no real title.
+//
+// The given status should already have its
+// content and attachments extracted.
+func contentWarning(statusable ap.Statusable, status *gtsmodel.Status) string {
+	if summary := ap.ExtractSummary(statusable); summary != "" {
+		return text.HTMLToPlaintext(summary)
+	}
+
+	name := text.HTMLToPlaintext(ap.ExtractName(statusable))
+	if name == "" {
+		return ""
+	}
+
+	if name == text.HTMLToPlaintext(status.Content) {
+		// Name is just the content.
+		return ""
+	}
+
+	for _, attachment := range status.Attachments {
+		if name == strings.TrimSpace(attachment.Description) {
+			// Name is a media description.
+			return ""
+		}
+	}
+
+	return name
+}
@@ -116,6 +116,20 @@ func Language(lang string) (string, error) {
 	return parsed.String(), err
 }
 
+// AccountDomain checks that the given domain, if set,
+// is one of the account domains configured on this instance.
+func AccountDomain(domain string) error {
+	if domain == "" {
+		return nil
+	}
+
+	if !config.IsAccountDomain(domain) {
+		return fmt.Errorf("domain %s is not one of this instance's account domains", domain)
+	}
+
+	return nil
+}
+
 // SignUpReason checks that a sufficient reason is given for a server signup request
 func SignUpReason(reason string, reasonRequired bool) error {
 	if !reasonRequired {
@@ -189,6 +203,23 @@ func CustomCSS(customCSS string) error {
 	return nil
 }
 
+// MediaDescription ensures that the given media description (alt text)
+// is within the configured min and max length. If checkMin is false, only
+// the max length is checked; this is used on initial upload, since clients
+// may upload media first and only add a description before posting.
+func MediaDescription(description string, checkMin bool) error {
+	limits := gtsmodel.NewInstanceConfiguration()
+	minChars := limits.MediaDescriptionMinChars
+	maxChars := limits.MediaDescriptionMaxChars
+
+	length := len([]rune(description))
+	if length > maxChars || (checkMin && length < minChars) {
+		return fmt.Errorf("image description length must be between %d and %d characters (inclusive), but provided image description was %d chars", minChars, maxChars, length)
+	}
+
+	return nil
+}
+
 // EmojiShortcode just runs the given shortcode through the regular expression
 // for emoji shortcodes, to figure out whether it's a valid shortcode, ie., 2-30 characters,
 // a-zA-Z, numbers, and underscores.
@@ -420,5 +451,9 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 	}
 	form.Locale = locale
 
+	if err := AccountDomain(form.Domain); err != nil {
+		return err
+	}
+
 	return SignUpReason(form.Reason, config.GetAccountsReasonRequired())
 }
@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+// styleCSP caches the web UI Content-Security-Policy
+// built for a particular instance custom CSS hash.
+type styleCSP struct {
+	hash   string
+	policy string
+}
+
+// pageCSP sets the web UI Content-Security-Policy for rendered
+// pages. If the admin has set instance custom CSS, it's stored
+// in the gin context for rendering inline in the page template,
+// and its hash is included in the policy's style-src.
+func (m *Module) pageCSP(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	css, errWithCode := m.processor.InstanceGetCustomCSS(ctx)
+	if errWithCode != nil {
+		// Not worth failing the page
+		// over, just render without.
+		log.Errorf(ctx, "error getting instance custom css: %v", errWithCode)
+	}
+
+	if css == "" {
+		// Nothing to inline,
+		// use the usual policy.
+		m.csp(c)
+		return
+	}
+
+	hash := middleware.CSPHashSource(css)
+
+	// Rebuild the policy only
+	// when the CSS has changed.
+	cached := m.styleCSP.Load()
+	if cached == nil || cached.hash != hash {
+		cached = &styleCSP{
+			hash: hash,
+			policy: middleware.BuildWebContentSecurityPolicy(
+				m.mediaURIs,
+				config.GetWebCSPExtraSrc(),
+				hash,
+			),
+		}
+		m.styleCSP.Store(cached)
+	}
+
+	c.Header("Content-Security-Policy", cached.policy)
+	c.Set(apiutil.InstanceCustomCSSKey, css)
+}
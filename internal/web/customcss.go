@@ -21,9 +21,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
 )
 
 const textCSSUTF8 = string(apiutil.TextCSS + "; charset=utf-8")
@@ -55,3 +57,16 @@ func (m *Module) customCSSGETHandler(c *gin.Context) {
 	c.Header(cacheControlHeader, cacheControlNoCache)
 	c.Data(http.StatusOK, textCSSUTF8, []byte(customCSS))
 }
+
+// customCSSLink returns the path of the given account's
+// custom CSS, and the Subresource Integrity hash of the
+// CSS that customCSSGETHandler will serve at that path.
+func customCSSLink(account *apimodel.Account) (string, string) {
+	var customCSS string
+	if config.GetAccountsAllowCustomCSS() {
+		customCSS = account.CustomCSS
+	}
+
+	href := "/@" + account.Username + "/custom.css"
+	return href, router.SRIHash([]byte(customCSS))
+}
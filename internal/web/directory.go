@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+const (
+	directoryPath     = aboutPath + "/directory"
+	directoryPageSize = 40
+)
+
+func (m *Module) directoryGETHandler(c *gin.Context) {
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Return instance we already got from the db,
+	// don't try to fetch it again when erroring.
+	instanceGet := func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	// We only serve text/html at this endpoint.
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextHTML); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), instanceGet)
+		return
+	}
+
+	offset, errWithCode := apiutil.ParseDirectoryOffset(c.Query(apiutil.DirectoryOffsetKey), 0, 1000000, 0)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Show local accounts only, most recently active first,
+	// using the same query as the profile directory API.
+	accounts, errWithCode := m.processor.Account().Directory(
+		c.Request.Context(),
+		nil,
+		true,
+		"active",
+		offset,
+		directoryPageSize,
+	)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Only link to the next page if
+	// this one was full, and to the
+	// previous one if we're not on
+	// the first page already.
+	var prevOffset, nextOffset *int
+	if offset > 0 {
+		prev := max(offset-directoryPageSize, 0)
+		prevOffset = &prev
+	}
+	if len(accounts) == directoryPageSize {
+		next := offset + directoryPageSize
+		nextOffset = &next
+	}
+
+	page := apiutil.WebPage{
+		Template:    "directory.tmpl",
+		Instance:    instance,
+		OGMeta:      apiutil.OGBase(instance),
+		Stylesheets: []string{cssFA},
+		Javascript:  []string{jsFrontend},
+		Extra: map[string]any{
+			"accounts":   accounts,
+			"prevOffset": prevOffset,
+			"nextOffset": nextOffset,
+		},
+	}
+
+	apiutil.TemplateWebPage(c, page)
+}
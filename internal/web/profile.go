@@ -163,10 +163,8 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 	}
 
 	// Custom CSS for this user last in cascade.
-	stylesheets = append(
-		stylesheets,
-		"/@"+targetAccount.Username+"/custom.css",
-	)
+	customCSSHref, customCSSIntegrity := customCSSLink(targetAccount)
+	stylesheets = append(stylesheets, customCSSHref)
 
 	page := apiutil.WebPage{
 		Template:    "profile.tmpl",
@@ -174,6 +172,9 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		OGMeta:      apiutil.OGBase(instance).WithAccount(targetAccount),
 		Stylesheets: stylesheets,
 		Javascript:  []string{jsFrontend},
+		Integrity: map[string]string{
+			customCSSHref: customCSSIntegrity,
+		},
 		Extra: map[string]any{
 			"account":          targetAccount,
 			"rssFeed":          rssFeed,
@@ -60,6 +60,7 @@ func (m *Module) signupGETHandler(c *gin.Context) {
 		Extra: map[string]any{
 			"reasonRequired":   config.GetAccountsReasonRequired(),
 			"registrationOpen": config.GetAccountsRegistrationOpen(),
+			"accountDomains":   config.AllAccountDomains(),
 		},
 	}
 
@@ -160,10 +160,8 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 	}
 
 	// Custom CSS for this user last in cascade.
-	stylesheets = append(
-		stylesheets,
-		"/@"+targetAccount.Username+"/custom.css",
-	)
+	customCSSHref, customCSSIntegrity := customCSSLink(targetAccount)
+	stylesheets = append(stylesheets, customCSSHref)
 
 	page := apiutil.WebPage{
 		Template:    "thread.tmpl",
@@ -171,6 +169,9 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		OGMeta:      apiutil.OGBase(instance).WithStatus(status),
 		Stylesheets: stylesheets,
 		Javascript:  []string{jsFrontend},
+		Integrity: map[string]string{
+			customCSSHref: customCSSIntegrity,
+		},
 		Extra: map[string]any{
 			"status":  status,
 			"context": context,
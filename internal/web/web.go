@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"sync/atomic"
 
 	"codeberg.org/gruf/go-cache/v3"
 	"github.com/gin-gonic/gin"
@@ -75,13 +76,25 @@ type Module struct {
 	processor    *processing.Processor
 	eTagCache    cache.Cache[string, eTagCacheEntry]
 	isURIBlocked func(context.Context, *url.URL) (bool, error)
+	csp          gin.HandlerFunc
+	mediaURIs    []string
+	styleCSP     atomic.Pointer[styleCSP]
 }
 
-func New(db db.DB, processor *processing.Processor) *Module {
+// New returns a new web module. mediaURIs should contain
+// any origins that media is served from other than the
+// instance itself, for inclusion in the web UI's
+// Content-Security-Policy.
+func New(db db.DB, processor *processing.Processor, mediaURIs []string) *Module {
 	return &Module{
 		processor:    processor,
 		eTagCache:    newETagCache(),
 		isURIBlocked: db.IsURIBlocked,
+		csp: middleware.WebContentSecurityPolicy(
+			mediaURIs,
+			config.GetWebCSPExtraSrc(),
+		),
+		mediaURIs: mediaURIs,
 	}
 }
 
@@ -94,7 +107,7 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	}
 	fs := fileSystem{http.Dir(webAssetsAbsFilePath)}
 	assetsGroup := r.AttachGroup(assetsPathPrefix)
-	assetsGroup.Use(m.assetsCacheControlMiddleware(fs))
+	assetsGroup.Use(m.csp, m.assetsCacheControlMiddleware(fs))
 	assetsGroup.Use(mi...)
 	assetsGroup.StaticFS("/", fs)
 
@@ -102,6 +115,7 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	// middleware, so that requests with content-type application/activity+json
 	// can still be served
 	profileGroup := r.AttachGroup(profileGroupPath)
+	profileGroup.Use(m.pageCSP)
 	profileGroup.Use(mi...)
 	profileGroup.Use(middleware.SignatureCheck(m.isURIBlocked), middleware.CacheControl(middleware.CacheControlConfig{
 		Directives: []string{"no-store"},
@@ -109,20 +123,24 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	profileGroup.Handle(http.MethodGet, "", m.profileGETHandler) // use empty path here since it's the base of the group
 	profileGroup.Handle(http.MethodGet, statusPath, m.threadGETHandler)
 
-	// Attach individual web handlers which require no specific middlewares
-	r.AttachHandler(http.MethodGet, "/", m.indexHandler) // front-page
-	r.AttachHandler(http.MethodGet, settingsPathPrefix, m.SettingsPanelHandler)
-	r.AttachHandler(http.MethodGet, settingsPanelGlob, m.SettingsPanelHandler)
-	r.AttachHandler(http.MethodGet, customCSSPath, m.customCSSGETHandler)
-	r.AttachHandler(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
-	r.AttachHandler(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
-	r.AttachHandler(http.MethodPost, confirmEmailPath, m.confirmEmailPOSTHandler)
-	r.AttachHandler(http.MethodGet, robotsPath, m.robotsGETHandler)
-	r.AttachHandler(http.MethodGet, aboutPath, m.aboutGETHandler)
-	r.AttachHandler(http.MethodGet, domainBlockListPath, m.domainBlockListGETHandler)
-	r.AttachHandler(http.MethodGet, tagsPath, m.tagGETHandler)
-	r.AttachHandler(http.MethodGet, signupPath, m.signupGETHandler)
-	r.AttachHandler(http.MethodPost, signupPath, m.signupPOSTHandler)
+	// Attach individual web handlers which require no specific
+	// middlewares, other than the web UI's content-security-policy.
+	webGroup := r.AttachGroup("")
+	webGroup.Use(m.pageCSP)
+	webGroup.Handle(http.MethodGet, "/", m.indexHandler) // front-page
+	webGroup.Handle(http.MethodGet, settingsPathPrefix, m.SettingsPanelHandler)
+	webGroup.Handle(http.MethodGet, settingsPanelGlob, m.SettingsPanelHandler)
+	webGroup.Handle(http.MethodGet, customCSSPath, m.customCSSGETHandler)
+	webGroup.Handle(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
+	webGroup.Handle(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
+	webGroup.Handle(http.MethodPost, confirmEmailPath, m.confirmEmailPOSTHandler)
+	webGroup.Handle(http.MethodGet, robotsPath, m.robotsGETHandler)
+	webGroup.Handle(http.MethodGet, aboutPath, m.aboutGETHandler)
+	webGroup.Handle(http.MethodGet, domainBlockListPath, m.domainBlockListGETHandler)
+	webGroup.Handle(http.MethodGet, directoryPath, m.directoryGETHandler)
+	webGroup.Handle(http.MethodGet, tagsPath, m.tagGETHandler)
+	webGroup.Handle(http.MethodGet, signupPath, m.signupGETHandler)
+	webGroup.Handle(http.MethodPost, signupPath, m.signupPOSTHandler)
 
 	// Attach redirects from old endpoints to current ones for backwards compatibility
 	r.AttachHandler(http.MethodGet, "/auth/edit", func(c *gin.Context) { c.Redirect(http.StatusMovedPermanently, userPanelPath) })
@@ -0,0 +1,256 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package wordfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+const (
+	// MaxPatternLength is the maximum
+	// length of a keyword or expression.
+	MaxPatternLength = 512
+
+	// MaxProgramSize is the maximum number of
+	// instructions a compiled expression may have.
+	MaxProgramSize = 4096
+
+	// MaxInputLength is the maximum length
+	// of an input value matched against; any
+	// more than this is ignored when matching.
+	MaxInputLength = 64 * 1024
+
+	// MatchTimeout is the maximum time spent
+	// matching filters against one input,
+	// after which any remaining are skipped.
+	MatchTimeout = 100 * time.Millisecond
+)
+
+// ErrMatchTimeout is returned by Filters.Match
+// when matching took longer than MatchTimeout.
+var ErrMatchTimeout = errors.New("word filter match timed out")
+
+// Input contains the parts of a
+// status which filters match against.
+type Input struct {
+	// Content is the plaintext content
+	// and content warning of a status.
+	Content string
+
+	// Links contains the links
+	// included in a status.
+	Links []string
+
+	// DisplayName is the display
+	// name of the status author.
+	DisplayName string
+}
+
+// Filter is a compiled gtsmodel.WordFilter.
+type Filter struct {
+	// ID of the gtsmodel.WordFilter.
+	ID string
+
+	// Target and Action of
+	// the gtsmodel.WordFilter.
+	Target gtsmodel.WordFilterTarget
+	Action gtsmodel.WordFilterAction
+
+	// keyword to match, lowercase,
+	// only set if regex is nil.
+	keyword string
+
+	// regex to match, if
+	// this is an expression.
+	regex *regexp.Regexp
+}
+
+// Filters represents a set of compiled word filters.
+//
+// Go regular expressions are guaranteed to run in time
+// linear in the size of their input, so no expression
+// can backtrack catastrophically. To bound that time
+// further, expressions are limited in size when they
+// are compiled, inputs are capped at MaxInputLength,
+// and matching gives up after MatchTimeout.
+type Filters []Filter
+
+// Validate checks whether the given keyword
+// or expression can be used in a word filter.
+func Validate(pattern string, regex bool) error {
+	if _, err := compile(pattern, regex); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Append will compile the given word filter
+// and add it to the set of filters.
+func (fs *Filters) Append(filter *gtsmodel.WordFilter) error {
+	regex := filter.Regex != nil && *filter.Regex
+
+	rgx, err := compile(filter.Pattern, regex)
+	if err != nil {
+		return err
+	}
+
+	f := Filter{
+		ID:     filter.ID,
+		Target: filter.Target,
+		Action: filter.Action,
+		regex:  rgx,
+	}
+
+	if rgx == nil {
+		// Keywords are matched
+		// case-insensitively.
+		f.keyword = strings.ToLower(filter.Pattern)
+	}
+
+	(*fs) = append((*fs), f)
+	return nil
+}
+
+// Match returns every filter matching the given input.
+//
+// If matching takes longer than MatchTimeout, the filters
+// matched so far are returned alongside ErrMatchTimeout.
+func (fs Filters) Match(in *Input) ([]*Filter, error) {
+	if len(fs) == 0 {
+		return nil, nil
+	}
+
+	var (
+		deadline = time.Now().Add(MatchTimeout)
+		values   = prepInput(in)
+		lower    [][]string
+		matched  []*Filter
+	)
+
+	for i := range fs {
+		if time.Now().After(deadline) {
+			return matched, ErrMatchTimeout
+		}
+
+		filter := &fs[i]
+		targetValues := values[filter.Target]
+
+		if filter.regex == nil && lower == nil {
+			// Lowercase values once, on first
+			// need of them to match keywords.
+			lower = make([][]string, len(values))
+			for t, vs := range values {
+				lower[t] = make([]string, len(vs))
+				for j, v := range vs {
+					lower[t][j] = strings.ToLower(v)
+				}
+			}
+		}
+
+		for j, value := range targetValues {
+			var ok bool
+
+			if filter.regex != nil {
+				ok = filter.regex.MatchString(value)
+			} else {
+				ok = strings.Contains(lower[filter.Target][j], filter.keyword)
+			}
+
+			if ok {
+				matched = append(matched, filter)
+				break
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+// prepInput returns the values of given input
+// to match against, indexed by filter target,
+// each capped at MaxInputLength.
+func prepInput(in *Input) [][]string {
+	capped := func(value string) string {
+		if len(value) > MaxInputLength {
+			value = value[:MaxInputLength]
+		}
+		return value
+	}
+
+	values := make([][]string, gtsmodel.WordFilterTargetDisplayName+1)
+	values[gtsmodel.WordFilterTargetContent] = []string{capped(in.Content)}
+	values[gtsmodel.WordFilterTargetDisplayName] = []string{capped(in.DisplayName)}
+
+	links := make([]string, len(in.Links))
+	for i, link := range in.Links {
+		links[i] = capped(link)
+	}
+	values[gtsmodel.WordFilterTargetLinks] = links
+
+	return values
+}
+
+// compile validates the given pattern, returning
+// a compiled regular expression if regex is set.
+func compile(pattern string, regex bool) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern must not be empty")
+	}
+
+	if len(pattern) > MaxPatternLength {
+		return nil, fmt.Errorf("pattern must not be longer than %d characters", MaxPatternLength)
+	}
+
+	if !regex {
+		// Keyword,
+		// nothing to do.
+		return nil, nil
+	}
+
+	// Parse and compile to program
+	// to check its size, since the
+	// pattern length alone doesn't
+	// limit it (eg., counted repeats).
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling regexp %q: %w", pattern, err)
+	}
+
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, fmt.Errorf("error compiling regexp %q: %w", pattern, err)
+	}
+
+	if len(prog.Inst) > MaxProgramSize {
+		return nil, fmt.Errorf("regexp %q is too complex", pattern)
+	}
+
+	rgx, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling regexp %q: %w", pattern, err)
+	}
+
+	return rgx, nil
+}
@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package wordfilter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/wordfilter"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		regex   bool
+		ok      bool
+	}{
+		{pattern: "casino", ok: true},
+		{pattern: "casino(", ok: true},
+		{pattern: `(?i)casino\s+bonus`, regex: true, ok: true},
+		{pattern: "", ok: false},
+		{pattern: strings.Repeat("a", wordfilter.MaxPatternLength+1), ok: false},
+		{pattern: "casino(", regex: true, ok: false},
+		{pattern: strings.Repeat("[a-z]{1000}", 5), regex: true, ok: false},
+	} {
+		err := wordfilter.Validate(test.pattern, test.regex)
+		if test.ok && err != nil {
+			t.Errorf("expected %q (regex=%v) to be valid, got %v", test.pattern, test.regex, err)
+		} else if !test.ok && err == nil {
+			t.Errorf("expected %q (regex=%v) to be invalid", test.pattern, test.regex)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	var filters wordfilter.Filters
+	for _, filter := range []*gtsmodel.WordFilter{
+		{
+			ID:      "keyword",
+			Pattern: "Casino",
+			Regex:   util.Ptr(false),
+			Target:  gtsmodel.WordFilterTargetContent,
+		},
+		{
+			ID:      "links",
+			Pattern: `^https://spam\.example/`,
+			Regex:   util.Ptr(true),
+			Target:  gtsmodel.WordFilterTargetLinks,
+		},
+		{
+			ID:      "display_name",
+			Pattern: "bot",
+			Regex:   util.Ptr(false),
+			Target:  gtsmodel.WordFilterTargetDisplayName,
+		},
+	} {
+		if err := filters.Append(filter); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		in      wordfilter.Input
+		matched []string
+	}{
+		{
+			in:      wordfilter.Input{Content: "hello world", DisplayName: "someone"},
+			matched: nil,
+		},
+		{
+			in:      wordfilter.Input{Content: "best CASINO bonus", DisplayName: "someone"},
+			matched: []string{"keyword"},
+		},
+		{
+			// Links are only matched by links filters.
+			in: wordfilter.Input{
+				Content: "https://spam.example/",
+				Links:   []string{"https://example.org/", "https://spam.example/win"},
+			},
+			matched: []string{"links"},
+		},
+		{
+			in:      wordfilter.Input{Content: "casino", DisplayName: "Casino Bot"},
+			matched: []string{"keyword", "display_name"},
+		},
+	} {
+		matched, err := filters.Match(&test.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids := make([]string, len(matched))
+		for i, filter := range matched {
+			ids[i] = filter.ID
+		}
+
+		if strings.Join(ids, ",") != strings.Join(test.matched, ",") {
+			t.Errorf("expected %+v to match %v, got %v", test.in, test.matched, ids)
+		}
+	}
+}
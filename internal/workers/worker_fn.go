@@ -19,6 +19,9 @@ package workers
 
 import (
 	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
 
 	"codeberg.org/gruf/go-runners"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -36,10 +39,16 @@ type FnWorkerPool struct {
 
 	// internal fields.
 	workers []*FnWorker
+	retired []*FnWorker
+	busy    atomic.Int64
+	mutex   sync.Mutex
 }
 
 // Start will attempt to start 'n' FnWorker{}s.
 func (p *FnWorkerPool) Start(n int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
 	// Check whether workers are
 	// set (is already running).
 	ok := (len(p.workers) > 0)
@@ -47,24 +56,15 @@ func (p *FnWorkerPool) Start(n int) {
 		return
 	}
 
-	// Allocate new workers slice.
-	p.workers = make([]*FnWorker, n)
-	for i := range p.workers {
-
-		// Allocate new FnWorker{}.
-		p.workers[i] = new(FnWorker)
-		p.workers[i].Queue = &p.Queue
-
-		// Attempt to start worker.
-		// Return bool not useful
-		// here, as true = started,
-		// false = already running.
-		_ = p.workers[i].Start()
-	}
+	// Start new workers.
+	p.grow(n)
 }
 
 // Stop will attempt to stop contained FnWorker{}s.
 func (p *FnWorkerPool) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
 	// Check whether workers are
 	// set (is currently running).
 	ok := (len(p.workers) == 0)
@@ -72,17 +72,95 @@ func (p *FnWorkerPool) Stop() {
 		return
 	}
 
-	// Stop all running workers.
-	for i := range p.workers {
+	// Stop all running workers,
+	// including any retired that
+	// are still finishing a task.
+	for _, w := range append(p.workers, p.retired...) {
 
 		// return bool not useful
 		// here, as true = stopped,
 		// false = never running.
-		_ = p.workers[i].Stop()
+		_ = w.Stop()
 	}
 
-	// Unset workers slice.
+	// Unset workers slices.
 	p.workers = p.workers[:0]
+	p.retired = p.retired[:0]
+}
+
+// Resize will grow or shrink the running pool to 'n' FnWorker{}s,
+// returning false if the pool is not running. Shrinking the pool
+// retires surplus FnWorker{}s, which exit after any current task.
+func (p *FnWorkerPool) Resize(n int) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Check whether workers are
+	// set (is currently running).
+	if len(p.workers) == 0 {
+		return false
+	}
+
+	// Drop retired workers
+	// that have since exited.
+	p.retired = slices.DeleteFunc(p.retired, func(w *FnWorker) bool {
+		return !w.service.Running()
+	})
+
+	// Clamp to 1.
+	n = max(n, 1)
+
+	switch {
+	case n > len(p.workers):
+		p.grow(n - len(p.workers))
+
+	case n < len(p.workers):
+		for _, w := range p.workers[n:] {
+			w.retire()
+			p.retired = append(p.retired, w)
+		}
+		p.workers = slices.Clip(p.workers[:n])
+	}
+
+	return true
+}
+
+// Size returns the current number of running FnWorker{}s.
+func (p *FnWorkerPool) Size() int {
+	p.mutex.Lock()
+	n := len(p.workers)
+	p.mutex.Unlock()
+	return n
+}
+
+// Busy returns the number of FnWorker{}s currently executing a task.
+func (p *FnWorkerPool) Busy() int {
+	return int(p.busy.Load())
+}
+
+// Queued returns the number of queued jobs awaiting a worker.
+func (p *FnWorkerPool) Queued() int {
+	return p.Queue.Len()
+}
+
+// grow will start 'n' new FnWorker{}s, under lock.
+func (p *FnWorkerPool) grow(n int) {
+	for i := 0; i < n; i++ {
+
+		// Allocate new FnWorker{}.
+		w := new(FnWorker)
+		w.Queue = &p.Queue
+		w.busy = &p.busy
+		w.retired = make(chan struct{})
+
+		// Attempt to start worker.
+		// Return bool not useful
+		// here, as true = started,
+		// false = already running.
+		_ = w.Start()
+
+		p.workers = append(p.workers, w)
+	}
 }
 
 // FnWorker wraps a queue.SimpleQueue{} which
@@ -97,6 +175,8 @@ type FnWorker struct {
 
 	// internal fields.
 	service runners.Service
+	retired chan struct{}
+	busy    *atomic.Int64
 }
 
 // Start will attempt to start the Worker{}.
@@ -109,6 +189,12 @@ func (w *FnWorker) Stop() bool {
 	return w.service.Stop()
 }
 
+// retire signals the Worker{} to exit
+// after finishing any current task.
+func (w *FnWorker) retire() {
+	close(w.retired)
+}
+
 // run wraps process to restart on any panic.
 func (w *FnWorker) run(ctx context.Context) {
 	if w.Queue == nil {
@@ -129,14 +215,34 @@ func (w *FnWorker) process(ctx context.Context) {
 		panic("not yet initialized")
 	}
 
+	// Wrap context to also be
+	// cancelled on retirement,
+	// used only when popping.
+	popCtx, cncl := withRetire(ctx, w.retired)
+	defer cncl()
+
 	for {
+		// Check for retirement
+		// before any next pop.
+		if popCtx.Err() != nil {
+			return
+		}
+
 		// Block until pop next func.
-		fn, ok := w.Queue.PopCtx(ctx)
+		fn, ok := w.Queue.PopCtx(popCtx)
 		if !ok {
 			return
 		}
 
 		// run!
-		fn(ctx)
+		w.exec(ctx, fn)
 	}
 }
+
+// exec executes a single function task,
+// marking the worker busy while doing so.
+func (w *FnWorker) exec(ctx context.Context, fn func(context.Context)) {
+	setBusy(w.busy, +1)
+	defer setBusy(w.busy, -1)
+	fn(ctx)
+}
@@ -19,6 +19,9 @@ package workers
 
 import (
 	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
 
 	"codeberg.org/gruf/go-runners"
 	"codeberg.org/gruf/go-structr"
@@ -40,6 +43,9 @@ type MsgWorkerPool[Msg any] struct {
 
 	// internal fields.
 	workers []*MsgWorker[Msg]
+	retired []*MsgWorker[Msg]
+	busy    atomic.Int64
+	mutex   sync.Mutex
 }
 
 // Init will initialize the worker pool queue with given struct indices.
@@ -49,6 +55,9 @@ func (p *MsgWorkerPool[T]) Init(indices []structr.IndexConfig) {
 
 // Start will attempt to start 'n' Worker{}s.
 func (p *MsgWorkerPool[T]) Start(n int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
 	// Check whether workers are
 	// set (is already running).
 	ok := (len(p.workers) > 0)
@@ -56,25 +65,15 @@ func (p *MsgWorkerPool[T]) Start(n int) {
 		return
 	}
 
-	// Allocate new msg workers slice.
-	p.workers = make([]*MsgWorker[T], n)
-	for i := range p.workers {
-
-		// Allocate new MsgWorker[T]{}.
-		p.workers[i] = new(MsgWorker[T])
-		p.workers[i].Process = p.Process
-		p.workers[i].Queue = &p.Queue
-
-		// Attempt to start worker.
-		// Return bool not useful
-		// here, as true = started,
-		// false = already running.
-		_ = p.workers[i].Start()
-	}
+	// Start new workers.
+	p.grow(n)
 }
 
 // Stop will attempt to stop contained Worker{}s.
 func (p *MsgWorkerPool[T]) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
 	// Check whether workers are
 	// set (is currently running).
 	ok := (len(p.workers) == 0)
@@ -82,17 +81,96 @@ func (p *MsgWorkerPool[T]) Stop() {
 		return
 	}
 
-	// Stop all running workers.
-	for i := range p.workers {
+	// Stop all running workers,
+	// including any retired that
+	// are still processing a msg.
+	for _, w := range append(p.workers, p.retired...) {
 
 		// return bool not useful
 		// here, as true = stopped,
 		// false = never running.
-		_ = p.workers[i].Stop()
+		_ = w.Stop()
 	}
 
-	// Unset workers slice.
+	// Unset workers slices.
 	p.workers = p.workers[:0]
+	p.retired = p.retired[:0]
+}
+
+// Resize will grow or shrink the running pool to 'n' Worker{}s,
+// returning false if the pool is not running. Shrinking the pool
+// retires surplus Worker{}s, which exit after any current message.
+func (p *MsgWorkerPool[T]) Resize(n int) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Check whether workers are
+	// set (is currently running).
+	if len(p.workers) == 0 {
+		return false
+	}
+
+	// Drop retired workers
+	// that have since exited.
+	p.retired = slices.DeleteFunc(p.retired, func(w *MsgWorker[T]) bool {
+		return !w.service.Running()
+	})
+
+	// Clamp to 1.
+	n = max(n, 1)
+
+	switch {
+	case n > len(p.workers):
+		p.grow(n - len(p.workers))
+
+	case n < len(p.workers):
+		for _, w := range p.workers[n:] {
+			w.retire()
+			p.retired = append(p.retired, w)
+		}
+		p.workers = slices.Clip(p.workers[:n])
+	}
+
+	return true
+}
+
+// Size returns the current number of running Worker{}s.
+func (p *MsgWorkerPool[T]) Size() int {
+	p.mutex.Lock()
+	n := len(p.workers)
+	p.mutex.Unlock()
+	return n
+}
+
+// Busy returns the number of Worker{}s currently processing a message.
+func (p *MsgWorkerPool[T]) Busy() int {
+	return int(p.busy.Load())
+}
+
+// Queued returns the number of queued jobs awaiting a worker.
+func (p *MsgWorkerPool[T]) Queued() int {
+	return p.Queue.Len()
+}
+
+// grow will start 'n' new Worker{}s, under lock.
+func (p *MsgWorkerPool[T]) grow(n int) {
+	for i := 0; i < n; i++ {
+
+		// Allocate new MsgWorker[T]{}.
+		w := new(MsgWorker[T])
+		w.Process = p.Process
+		w.Queue = &p.Queue
+		w.busy = &p.busy
+		w.retired = make(chan struct{})
+
+		// Attempt to start worker.
+		// Return bool not useful
+		// here, as true = started,
+		// false = already running.
+		_ = w.Start()
+
+		p.workers = append(p.workers, w)
+	}
 }
 
 // MsgWorker wraps a processing function to
@@ -110,6 +188,8 @@ type MsgWorker[Msg any] struct {
 
 	// internal fields.
 	service runners.Service
+	retired chan struct{}
+	busy    *atomic.Int64
 }
 
 // Start will attempt to start the Worker{}.
@@ -122,6 +202,12 @@ func (w *MsgWorker[T]) Stop() bool {
 	return w.service.Stop()
 }
 
+// retire signals the Worker{} to exit
+// after processing any current message.
+func (w *MsgWorker[T]) retire() {
+	close(w.retired)
+}
+
 // run wraps process to restart on any panic.
 func (w *MsgWorker[T]) run(ctx context.Context) {
 	if w.Process == nil || w.Queue == nil {
@@ -140,16 +226,36 @@ func (w *MsgWorker[T]) process(ctx context.Context) {
 		panic("not yet initialized")
 	}
 
+	// Wrap context to also be
+	// cancelled on retirement,
+	// used only when popping.
+	popCtx, cncl := withRetire(ctx, w.retired)
+	defer cncl()
+
 	for {
+		// Check for retirement
+		// before any next pop.
+		if popCtx.Err() != nil {
+			return
+		}
+
 		// Block until pop next message.
-		msg, ok := w.Queue.PopCtx(ctx)
+		msg, ok := w.Queue.PopCtx(popCtx)
 		if !ok {
 			return
 		}
 
 		// Attempt to process popped message type.
-		if err := w.Process(ctx, msg); err != nil {
+		if err := w.process1(ctx, msg); err != nil {
 			log.Errorf(ctx, "%p: error processing: %v", w, err)
 		}
 	}
 }
+
+// process1 processes a single message,
+// marking the worker busy while doing so.
+func (w *MsgWorker[T]) process1(ctx context.Context, msg T) error {
+	setBusy(w.busy, +1)
+	defer setBusy(w.busy, -1)
+	return w.Process(ctx, msg)
+}
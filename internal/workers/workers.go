@@ -18,7 +18,9 @@
 package workers
 
 import (
+	"context"
 	"runtime"
+	"sync/atomic"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -27,6 +29,43 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 )
 
+// Names of the worker pools
+// contained within Workers{}.
+const (
+	PoolDelivery    = "delivery"
+	PoolClient      = "client"
+	PoolFederator   = "federator"
+	PoolDereference = "dereference"
+	PoolMedia       = "media"
+)
+
+// PoolNames contains the names of all
+// worker pools, in order of Workers{} fields.
+var PoolNames = []string{
+	PoolDelivery,
+	PoolClient,
+	PoolFederator,
+	PoolDereference,
+	PoolMedia,
+}
+
+// Pool provides runtime inspection and
+// resizing of a running worker pool.
+type Pool interface {
+	// Resize grows or shrinks the running pool
+	// to n workers, returning false if not running.
+	Resize(n int) bool
+
+	// Size returns the current number of workers.
+	Size() int
+
+	// Busy returns the number of workers currently busy.
+	Busy() int
+
+	// Queued returns the number of jobs queued for workers.
+	Queued() int
+}
+
 type Workers struct {
 	// Main task scheduler instance.
 	Scheduler scheduler.Scheduler
@@ -67,29 +106,46 @@ func (w *Workers) StartScheduler() {
 func (w *Workers) Start() {
 	var n int
 
-	maxprocs := runtime.GOMAXPROCS(0)
-
-	n = deliveryWorkers(maxprocs)
+	n = DefaultPoolSize(PoolDelivery)
 	w.Delivery.Start(n)
 	log.Infof(nil, "started %d delivery workers", n)
 
-	n = 4 * maxprocs
+	n = DefaultPoolSize(PoolClient)
 	w.Client.Start(n)
 	log.Infof(nil, "started %d client workers", n)
 
-	n = 4 * maxprocs
+	n = DefaultPoolSize(PoolFederator)
 	w.Federator.Start(n)
 	log.Infof(nil, "started %d federator workers", n)
 
-	n = 4 * maxprocs
+	n = DefaultPoolSize(PoolDereference)
 	w.Dereference.Start(n)
 	log.Infof(nil, "started %d dereference workers", n)
 
-	n = 8 * maxprocs
+	n = DefaultPoolSize(PoolMedia)
 	w.Media.Start(n)
 	log.Infof(nil, "started %d media workers", n)
 }
 
+// Pool returns the worker pool with given name, one of
+// PoolNames, or false if no pool exists with this name.
+func (w *Workers) Pool(name string) (Pool, bool) {
+	switch name {
+	case PoolDelivery:
+		return &w.Delivery, true
+	case PoolClient:
+		return &w.Client, true
+	case PoolFederator:
+		return &w.Federator, true
+	case PoolDereference:
+		return &w.Dereference, true
+	case PoolMedia:
+		return &w.Media, true
+	default:
+		return nil, false
+	}
+}
+
 // Stop will stop all of the contained worker pools (and global scheduler).
 func (w *Workers) Stop() {
 	_ = w.Scheduler.Stop() // false = not running
@@ -118,11 +174,50 @@ func (*nocopy) Lock() {}
 
 func (*nocopy) Unlock() {}
 
-func deliveryWorkers(maxprocs int) int {
-	n := config.GetAdvancedSenderMultiplier()
-	if n < 1 {
+// DefaultPoolSize returns the configured number of workers for
+// the named worker pool, i.e. its per-cpu multiplier * GOMAXPROCS.
+func DefaultPoolSize(name string) int {
+	var multiplier int
+
+	switch name {
+	case PoolDelivery:
+		multiplier = config.GetAdvancedSenderMultiplier()
+	case PoolClient:
+		multiplier = config.GetAdvancedClientWorkerMultiplier()
+	case PoolFederator:
+		multiplier = config.GetAdvancedFederatorWorkerMultiplier()
+	case PoolDereference:
+		multiplier = config.GetAdvancedDereferenceWorkerMultiplier()
+	case PoolMedia:
+		multiplier = config.GetAdvancedMediaWorkerMultiplier()
+	}
+
+	if multiplier < 1 {
 		// clamp to 1
 		return 1
 	}
-	return n * maxprocs
+
+	return multiplier * runtime.GOMAXPROCS(0)
+}
+
+// withRetire returns a copy of ctx that is
+// additionally cancelled on close of retired.
+func withRetire(ctx context.Context, retired <-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cncl := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-retired:
+			cncl()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cncl
+}
+
+// setBusy updates busy counter by
+// delta, if set (i.e. pool worker).
+func setBusy(busy *atomic.Int64, delta int64) {
+	if busy != nil {
+		busy.Add(delta)
+	}
 }
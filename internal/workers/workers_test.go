@@ -0,0 +1,174 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-structr"
+	"github.com/superseriousbusiness/gotosocial/internal/workers"
+)
+
+func TestFnWorkerPoolShrinkWhileBusy(t *testing.T) {
+	var pool workers.FnWorkerPool
+	pool.Start(4)
+	defer pool.Stop()
+
+	release := make(chan struct{})
+	var done atomic.Int64
+
+	// Occupy every worker
+	// with a blocking job.
+	for i := 0; i < 4; i++ {
+		pool.Queue.Push(func(ctx context.Context) {
+			<-release
+			done.Add(1)
+		})
+	}
+	waitFor(t, func() bool { return pool.Busy() == 4 })
+
+	// Shrink the pool while all
+	// workers are mid-job.
+	if !pool.Resize(1) {
+		t.Fatal("expected resize of running pool to succeed")
+	}
+	if n := pool.Size(); n != 1 {
+		t.Fatalf("expected pool size 1, got %d", n)
+	}
+
+	// Retired workers should
+	// not abandon their jobs.
+	if n := pool.Busy(); n != 4 {
+		t.Fatalf("expected 4 busy workers after shrink, got %d", n)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return done.Load() == 4 })
+	waitFor(t, func() bool { return pool.Busy() == 0 })
+
+	// Queue further jobs, tracking the
+	// max number run concurrently, which
+	// should be the single remaining worker.
+	var running, maxRunning atomic.Int64
+	for i := 0; i < 8; i++ {
+		pool.Queue.Push(func(ctx context.Context) {
+			n := running.Add(1)
+			for {
+				max := maxRunning.Load()
+				if n <= max || maxRunning.CompareAndSwap(max, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			done.Add(1)
+		})
+	}
+	waitFor(t, func() bool { return done.Load() == 12 })
+
+	if n := maxRunning.Load(); n != 1 {
+		t.Fatalf("expected max 1 concurrent job after shrink, got %d", n)
+	}
+}
+
+func TestFnWorkerPoolGrow(t *testing.T) {
+	var pool workers.FnWorkerPool
+	pool.Start(1)
+	defer pool.Stop()
+
+	if !pool.Resize(4) {
+		t.Fatal("expected resize of running pool to succeed")
+	}
+	if n := pool.Size(); n != 4 {
+		t.Fatalf("expected pool size 4, got %d", n)
+	}
+
+	// All new workers should
+	// be picking up jobs.
+	release := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		pool.Queue.Push(func(ctx context.Context) {
+			<-release
+		})
+	}
+	waitFor(t, func() bool { return pool.Busy() == 4 })
+	close(release)
+}
+
+func TestMsgWorkerPoolShrinkWhileBusy(t *testing.T) {
+	var pool workers.MsgWorkerPool[*testMsg]
+	pool.Init([]structr.IndexConfig{{Fields: "ID"}})
+
+	release := make(chan struct{})
+	var processed atomic.Int64
+	pool.Process = func(ctx context.Context, msg *testMsg) error {
+		<-release
+		processed.Add(1)
+		return nil
+	}
+
+	pool.Start(2)
+	defer pool.Stop()
+
+	pool.Queue.Push(&testMsg{ID: 1}, &testMsg{ID: 2}, &testMsg{ID: 3})
+	waitFor(t, func() bool { return pool.Busy() == 2 })
+
+	if !pool.Resize(1) {
+		t.Fatal("expected resize of running pool to succeed")
+	}
+	if n := pool.Size(); n != 1 {
+		t.Fatalf("expected pool size 1, got %d", n)
+	}
+
+	// Remaining message must still be
+	// processed by the remaining worker.
+	close(release)
+	waitFor(t, func() bool { return processed.Load() == 3 })
+	waitFor(t, func() bool { return pool.Busy() == 0 && pool.Queued() == 0 })
+}
+
+func TestResizeStoppedPool(t *testing.T) {
+	var pool workers.FnWorkerPool
+	if pool.Resize(4) {
+		t.Fatal("expected resize of stopped pool to fail")
+	}
+
+	pool.Start(2)
+	pool.Stop()
+
+	if pool.Resize(4) {
+		t.Fatal("expected resize of stopped pool to fail")
+	}
+}
+
+type testMsg struct{ ID int }
+
+// waitFor polls cond until it returns true, failing the test on timeout.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -5,13 +5,22 @@ set -eu
 EXPECT=$(cat << "EOF"
 {
     "account-domain": "peepee",
+    "account-domains": [
+        "poopoo",
+        "weewee"
+    ],
     "accounts-allow-custom-css": true,
     "accounts-custom-css-length": 5000,
     "accounts-reason-required": false,
     "accounts-registration-open": true,
+    "accounts-stats-recount-weekly": false,
+    "advanced-client-worker-multiplier": 4,
     "advanced-cookies-samesite": "strict",
     "advanced-csp-extra-uris": [],
+    "advanced-dereference-worker-multiplier": 4,
+    "advanced-federator-worker-multiplier": 4,
     "advanced-header-filter-mode": "",
+    "advanced-media-worker-multiplier": 8,
     "advanced-rate-limit-exceptions": [
         "192.0.2.0/24",
         "127.0.0.1/32"
@@ -20,6 +29,10 @@ EXPECT=$(cat << "EOF"
     "advanced-sender-multiplier": -1,
     "advanced-throttling-multiplier": -1,
     "advanced-throttling-retry-after": 10000000000,
+    "api-cors-allowed-origins": [
+        "https://frontend.example.org",
+        "https://*.example.com"
+    ],
     "application-name": "gts",
     "bind-address": "127.0.0.1",
     "cache": {
@@ -41,8 +54,14 @@ EXPECT=$(cat << "EOF"
         "follow-mem-ratio": 2,
         "follow-request-ids-mem-ratio": 2,
         "follow-request-mem-ratio": 2,
+        "follow-suggestions-mem-ratio": 0.5,
         "in-reply-to-ids-mem-ratio": 3,
         "instance-mem-ratio": 1,
+        "instance-settings-mem-ratio": 0.1,
+        "invalidation-backend": "",
+        "invalidation-redis-address": "localhost:6379",
+        "invalidation-redis-channel": "gotosocial:cache-invalidation",
+        "invalidation-redis-password": "",
         "list-entry-mem-ratio": 2,
         "list-mem-ratio": 1,
         "marker-mem-ratio": 0.5,
@@ -55,6 +74,7 @@ EXPECT=$(cat << "EOF"
         "poll-vote-ids-mem-ratio": 2,
         "poll-vote-mem-ratio": 2,
         "report-mem-ratio": 1,
+        "shards": 1,
         "status-bookmark-ids-mem-ratio": 2,
         "status-bookmark-mem-ratio": 0.5,
         "status-fave-ids-mem-ratio": 3,
@@ -73,9 +93,11 @@ EXPECT=$(cat << "EOF"
     "config-path": "internal/config/testdata/test.yaml",
     "db-address": ":memory:",
     "db-database": "gotosocial_prod",
+    "db-maintenance-schedule": "",
     "db-max-open-conns-multiplier": 3,
     "db-password": "hunter2",
     "db-port": 6969,
+    "db-replica-addresses": [],
     "db-sqlite-busy-timeout": 1000000000,
     "db-sqlite-cache-size": 0,
     "db-sqlite-journal-mode": "DELETE",
@@ -84,8 +106,20 @@ EXPECT=$(cat << "EOF"
     "db-tls-mode": "disable",
     "db-type": "sqlite",
     "db-user": "sex-haver",
+    "detect-status-language": false,
+    "detect-status-languages": [
+        "en",
+        "fr"
+    ],
     "dry-run": true,
     "email": "",
+    "federation-audit-log": false,
+    "federation-audit-log-max-rows": 100000,
+    "federation-block-list-urls": [],
+    "federation-delivery-backend": "",
+    "federation-delivery-redis-address": "localhost:6379",
+    "federation-delivery-redis-key": "gotosocial:delivery",
+    "federation-delivery-redis-password": "",
     "host": "example.com",
     "http-client": {
         "allow-ips": [],
@@ -105,6 +139,8 @@ EXPECT=$(cat << "EOF"
         "nl",
         "en-GB"
     ],
+    "instance-obfuscate-ids": true,
+    "instance-obfuscate-ids-secret": "ohwowthisisverysecret",
     "landing-page-user": "admin",
     "letsencrypt-cert-dir": "/gotosocial/storage/certs",
     "letsencrypt-email-address": "",
@@ -115,10 +151,13 @@ EXPECT=$(cat << "EOF"
     "log-db-queries": true,
     "log-level": "info",
     "log-timestamp-format": "banana",
+    "max-spoiler-text-chars": 500,
+    "media-account-quota": 0,
     "media-cleanup-every": 86400000000000,
     "media-cleanup-from": "00:00",
     "media-description-max-chars": 5000,
     "media-description-min-chars": 69,
+    "media-description-required": false,
     "media-emoji-local-max-size": 420,
     "media-emoji-remote-max-size": 420,
     "media-image-max-size": 420,
@@ -128,6 +167,7 @@ EXPECT=$(cat << "EOF"
     "metrics-auth-password": "",
     "metrics-auth-username": "",
     "metrics-enabled": false,
+    "min-status-chars": 0,
     "oidc-admin-groups": [
         "steamy"
     ],
@@ -150,6 +190,7 @@ EXPECT=$(cat << "EOF"
     "port": 6969,
     "protocol": "http",
     "remote-only": false,
+    "report": "",
     "request-id-header": "X-Trace-Id",
     "smtp-disclose-recipients": true,
     "smtp-from": "queen.rip.in.piss@terfisland.org",
@@ -158,16 +199,29 @@ EXPECT=$(cat << "EOF"
     "smtp-port": 4269,
     "smtp-username": "sex-haver",
     "software-version": "",
+    "status-context-max-depth-ancestors": 20,
+    "status-context-max-depth-descendants": 40,
     "statuses-max-chars": 69,
     "statuses-media-max-files": 1,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-retention-deletes-per-hour": 50,
+    "storage-azure-account-key": "",
+    "storage-azure-account-name": "",
+    "storage-azure-block-size": 8388608,
+    "storage-azure-connection-string": "DefaultEndpointsProtocol=https;AccountName=gts;AccountKey=c2VjcmV0;EndpointSuffix=core.windows.net",
+    "storage-azure-container": "gts",
+    "storage-azure-endpoint": "",
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
     "storage-s3-access-key": "minio",
     "storage-s3-bucket": "gts",
     "storage-s3-endpoint": "localhost:9000",
     "storage-s3-proxy": true,
+    "storage-s3-rate-limit-initial": 500,
+    "storage-s3-rate-limit-max": 1000,
+    "storage-s3-rate-limit-min": 5,
+    "storage-s3-requester-pays": true,
     "storage-s3-secret-key": "miniostorage",
     "storage-s3-use-ssl": false,
     "syslog-address": "127.0.0.1:6969",
@@ -185,6 +239,10 @@ EXPECT=$(cat << "EOF"
     ],
     "username": "",
     "web-asset-base-dir": "/root",
+    "web-csp-extra-src": [
+        "https://fonts.example.org"
+    ],
+    "web-default-theme": "light",
     "web-template-base-dir": "/root"
 }
 EOF
@@ -200,6 +258,7 @@ GTS_APPLICATION_NAME=gts \
 GTS_LANDING_PAGE_USER=admin \
 GTS_HOST=example.com \
 GTS_ACCOUNT_DOMAIN='peepee' \
+GTS_ACCOUNT_DOMAINS='poopoo,weewee' \
 GTS_PROTOCOL=http \
 GTS_BIND_ADDRESS='127.0.0.1' \
 GTS_PORT=6969 \
@@ -219,6 +278,9 @@ GTS_TLS_MODE='' \
 GTS_DB_TLS_CA_CERT='' \
 GTS_WEB_TEMPLATE_BASE_DIR='/root' \
 GTS_WEB_ASSET_BASE_DIR='/root' \
+GTS_WEB_CSP_EXTRA_SRC='https://fonts.example.org' \
+GTS_WEB_DEFAULT_THEME='light' \
+GTS_API_CORS_ALLOWED_ORIGINS='https://frontend.example.org,https://*.example.com' \
 GTS_INSTANCE_EXPOSE_PEERS=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED_WEB=true \
@@ -228,6 +290,8 @@ GTS_INSTANCE_FEDERATION_SPAM_FILTER=true \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
 GTS_INSTANCE_LANGUAGES="nl,en-gb" \
+GTS_INSTANCE_OBFUSCATE_IDS=true \
+GTS_INSTANCE_OBFUSCATE_IDS_SECRET='ohwowthisisverysecret' \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
@@ -248,12 +312,20 @@ GTS_STORAGE_S3_SECRET_KEY='miniostorage' \
 GTS_STORAGE_S3_ENDPOINT='localhost:9000' \
 GTS_STORAGE_S3_USE_SSL='false' \
 GTS_STORAGE_S3_PROXY='true' \
+GTS_STORAGE_S3_REQUESTER_PAYS='true' \
+GTS_STORAGE_S3_RATE_LIMIT_INITIAL=500 \
+GTS_STORAGE_S3_RATE_LIMIT_MIN=5 \
+GTS_STORAGE_S3_RATE_LIMIT_MAX=1000 \
 GTS_STORAGE_S3_BUCKET='gts' \
+GTS_STORAGE_AZURE_CONNECTION_STRING='DefaultEndpointsProtocol=https;AccountName=gts;AccountKey=c2VjcmV0;EndpointSuffix=core.windows.net' \
+GTS_STORAGE_AZURE_CONTAINER='gts' \
+GTS_STORAGE_AZURE_BLOCK_SIZE=8388608 \
 GTS_STATUSES_MAX_CHARS=69 \
 GTS_STATUSES_CW_MAX_CHARS=420 \
 GTS_STATUSES_POLL_MAX_OPTIONS=1 \
 GTS_STATUSES_POLL_OPTIONS_MAX_CHARS=69 \
 GTS_STATUSES_MEDIA_MAX_FILES=1 \
+GTS_DETECT_STATUS_LANGUAGES='en,fr' \
 GTS_LETS_ENCRYPT_ENABLED=false \
 GTS_LETS_ENCRYPT_PORT=8080 \
 GTS_LETS_ENCRYPT_CERT_DIR='/root/certs' \
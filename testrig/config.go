@@ -65,6 +65,9 @@ func testDefaults() config.Configuration {
 
 		WebTemplateBaseDir: "./web/template/",
 		WebAssetBaseDir:    "./web/assets/",
+		WebDefaultTheme:    config.WebDefaultThemeSystem,
+
+		APICORSAllowedOrigins: []string{"*"},
 
 		InstanceFederationMode:         config.InstanceFederationModeDefault,
 		InstanceFederationSpamFilter:   true,
@@ -90,9 +93,11 @@ func testDefaults() config.Configuration {
 		MediaVideoMaxSize:        41943040, // 40MiB
 		MediaDescriptionMinChars: 0,
 		MediaDescriptionMaxChars: 500,
+		MediaDescriptionRequired: false,
 		MediaRemoteCacheDays:     7,
 		MediaEmojiLocalMaxSize:   51200,          // 50KiB
 		MediaEmojiRemoteMaxSize:  102400,         // 100KiB
+		MediaAccountQuota:        0,              // no limit.
 		MediaCleanupFrom:         "00:00",        // midnight.
 		MediaCleanupEvery:        24 * time.Hour, // 1/day.
 
@@ -103,10 +108,13 @@ func testDefaults() config.Configuration {
 		StorageLocalBasePath: "",
 
 		StatusesMaxChars:           5000,
+		MaxSpoilerTextChars:        500,
 		StatusesPollMaxOptions:     6,
 		StatusesPollOptionMaxChars: 50,
 		StatusesMediaMaxFiles:      6,
 
+		DetectStatusLanguages: config.Defaults.DetectStatusLanguages,
+
 		LetsEncryptEnabled:      false,
 		LetsEncryptPort:         0,
 		LetsEncryptCertDir:      "",
@@ -31,14 +31,17 @@ var testModels = []interface{}{
 	&gtsmodel.Account{},
 	&gtsmodel.AccountToEmoji{},
 	&gtsmodel.Application{},
+	&gtsmodel.ApplicationBlock{},
 	&gtsmodel.Block{},
 	&gtsmodel.DomainBlock{},
 	&gtsmodel.EmailDomainBlock{},
+	&gtsmodel.FeaturedSuggestion{},
 	&gtsmodel.Filter{},
 	&gtsmodel.FilterKeyword{},
 	&gtsmodel.FilterStatus{},
 	&gtsmodel.Follow{},
 	&gtsmodel.FollowRequest{},
+	&gtsmodel.FollowSuggestionDismissal{},
 	&gtsmodel.List{},
 	&gtsmodel.ListEntry{},
 	&gtsmodel.Marker{},
@@ -59,6 +62,7 @@ var testModels = []interface{}{
 	&gtsmodel.UserMute{},
 	&gtsmodel.Emoji{},
 	&gtsmodel.Instance{},
+	&gtsmodel.InstanceSettings{},
 	&gtsmodel.Notification{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
@@ -67,8 +71,14 @@ var testModels = []interface{}{
 	&gtsmodel.Tombstone{},
 	&gtsmodel.Report{},
 	&gtsmodel.Rule{},
+	&gtsmodel.RetentionPolicy{},
+	&gtsmodel.AdminActionLog{},
+	&gtsmodel.AccountWarning{},
+	&gtsmodel.DeliveryAudit{},
+	&gtsmodel.DomainDeliveryHealth{},
 	&gtsmodel.AccountNote{},
 	&gtsmodel.AccountSettings{},
+	&gtsmodel.WordFilter{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
@@ -650,44 +650,60 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 func NewTestAccountSettings() map[string]*gtsmodel.AccountSettings {
 	return map[string]*gtsmodel.AccountSettings{
 		"unconfirmed_account": {
-			AccountID:       "01F8MH0BBE4FHXPH513MBVFHB0",
-			CreatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(false),
-			HideCollections: util.Ptr(false),
+			AccountID:                     "01F8MH0BBE4FHXPH513MBVFHB0",
+			CreatedAt:                     TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:                     TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:                       gtsmodel.VisibilityPublic,
+			Sensitive:                     util.Ptr(false),
+			Language:                      "en",
+			EnableRSS:                     util.Ptr(false),
+			HideCollections:               util.Ptr(false),
+			HideInteractionCounts:         util.Ptr(false),
+			HideOutbox:                    util.Ptr(false),
+			StatusRetentionKeepPinned:     util.Ptr(true),
+			StatusRetentionKeepBookmarked: util.Ptr(true),
 		},
 		"admin_account": {
-			AccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
-			CreatedAt:       TimeMustParse("2022-05-17T13:10:59Z"),
-			UpdatedAt:       TimeMustParse("2022-05-17T13:10:59Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
+			AccountID:                     "01F8MH17FWEB39HZJ76B6VXSKF",
+			CreatedAt:                     TimeMustParse("2022-05-17T13:10:59Z"),
+			UpdatedAt:                     TimeMustParse("2022-05-17T13:10:59Z"),
+			Privacy:                       gtsmodel.VisibilityPublic,
+			Sensitive:                     util.Ptr(false),
+			Language:                      "en",
+			EnableRSS:                     util.Ptr(true),
+			HideCollections:               util.Ptr(false),
+			HideInteractionCounts:         util.Ptr(false),
+			HideOutbox:                    util.Ptr(false),
+			StatusRetentionKeepPinned:     util.Ptr(true),
+			StatusRetentionKeepBookmarked: util.Ptr(true),
 		},
 		"local_account_1": {
-			AccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
-			CreatedAt:       TimeMustParse("2022-05-20T11:09:18Z"),
-			UpdatedAt:       TimeMustParse("2022-05-20T11:09:18Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
+			AccountID:                     "01F8MH1H7YV1Z7D2C8K2730QBF",
+			CreatedAt:                     TimeMustParse("2022-05-20T11:09:18Z"),
+			UpdatedAt:                     TimeMustParse("2022-05-20T11:09:18Z"),
+			Privacy:                       gtsmodel.VisibilityPublic,
+			Sensitive:                     util.Ptr(false),
+			Language:                      "en",
+			EnableRSS:                     util.Ptr(true),
+			HideCollections:               util.Ptr(false),
+			HideInteractionCounts:         util.Ptr(false),
+			HideOutbox:                    util.Ptr(false),
+			StatusRetentionKeepPinned:     util.Ptr(true),
+			StatusRetentionKeepBookmarked: util.Ptr(true),
 		},
 		"local_account_2": {
-			AccountID:       "01F8MH5NBDF2MV7CTC4Q5128HF",
-			CreatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:         gtsmodel.VisibilityFollowersOnly,
-			Sensitive:       util.Ptr(true),
-			Language:        "fr",
-			EnableRSS:       util.Ptr(false),
-			HideCollections: util.Ptr(true),
+			AccountID:                     "01F8MH5NBDF2MV7CTC4Q5128HF",
+			CreatedAt:                     TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:                     TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:                       gtsmodel.VisibilityFollowersOnly,
+			Sensitive:                     util.Ptr(true),
+			Language:                      "fr",
+			EnableRSS:                     util.Ptr(false),
+			HideCollections:               util.Ptr(true),
+			HideInteractionCounts:         util.Ptr(false),
+			HideOutbox:                    util.Ptr(false),
+			StatusRetentionKeepPinned:     util.Ptr(true),
+			StatusRetentionKeepBookmarked: util.Ptr(true),
 		},
 	}
 }
@@ -749,9 +765,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/attachment/small/01F8MH6NEM8D7527KZAECTCR76.jpg",
 				RemoteURL:   "",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"local_account_1_status_4_attachment_1": {
 			ID:        "01F8MH7TDVANYKWVE8VVKFPJTJ",
@@ -798,9 +815,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH7TDVANYKWVE8VVKFPJTJ.jpg",
 				RemoteURL:   "",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"local_account_1_status_4_attachment_2": {
 			ID:        "01CDR64G398ADCHXK08WWTHEZ5",
@@ -850,9 +868,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01CDR64G398ADCHXK08WWTHEZ5.jpg",
 				RemoteURL:   "",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"local_account_1_unattached_1": {
 			ID:        "01F8MH8RMYQ6MSNY3JM2XT1CQ5",
@@ -899,9 +918,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH8RMYQ6MSNY3JM2XT1CQ5.jpg",
 				RemoteURL:   "",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"local_account_1_avatar": {
 			ID:        "01F8MH58A357CV5K7R7TJMSH6S",
@@ -948,9 +968,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/small/01F8MH58A357CV5K7R7TJMSH6S.jpg",
 				RemoteURL:   "",
 			},
-			Avatar: util.Ptr(true),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(true),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"local_account_1_header": {
 			ID:        "01PFPMWK2FF0D9WMHEJHR07C3Q",
@@ -997,9 +1018,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/header/small/01PFPMWK2FF0D9WMHEJHR07C3Q.jpg",
 				RemoteURL:   "",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(true),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(true),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"remote_account_1_status_1_attachment_1": {
 			ID:        "01FVW7RXPQ8YJHTEXYPE7Q8ZY0",
@@ -1046,9 +1068,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/01F8MH5ZK5VRH73AKHQM6Y9VNX/attachment/small/01FVW7RXPQ8YJHTEXYPE7Q8ZY0.jpg",
 				RemoteURL:   "http://fossbros-anonymous.io/attachments/small/a499f55b-2d1e-4acd-98d2-1ac2ba6d79b9.jpg",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"remote_account_3_header": {
 			ID:        "01PFPMWK2FF0D9WMHEJHR07C3R",
@@ -1095,9 +1118,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				URL:         "http://localhost:8080/fileserver/062G5WYKY35KKD12EMSM3F8PJ8/header/small/01PFPMWK2FF0D9WMHEJHR07C3R.jpg",
 				RemoteURL:   "http://fossbros-anonymous.io/attachments/small/a499f55b-2d1e-4acd-98d2-1ac2ba6d79b9.jpg",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(true),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(true),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"remote_account_2_status_1_attachment_1": {
 			ID:        "01HE7Y3C432WRSNS10EZM86SA5",
@@ -1142,9 +1166,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				UpdatedAt:   TimeMustParse("2023-11-02T12:44:25+02:00"),
 				URL:         "http://localhost:8080/fileserver/01FHMQX3GAABWSM0S2VZEC2SWC/attachment/small/01HE7Y3C432WRSNS10EZM86SA5.jpg",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(true),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(true),
+			Sensitive: util.Ptr(false),
 		},
 		"remote_account_2_status_1_attachment_2": {
 			ID:          "01HE7ZFX9GKA5ZZVD4FACABSS9",
@@ -1172,9 +1197,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				UpdatedAt:   TimeMustParse("2023-11-02T12:44:25+02:00"),
 				URL:         "http://localhost:8080/fileserver/01FHMQX3GAABWSM0S2VZEC2SWC/attachment/small/01HE7ZFX9GKA5ZZVD4FACABSS9.jpg",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(false),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(false),
+			Sensitive: util.Ptr(false),
 		},
 		"remote_account_2_status_1_attachment_3": {
 			ID:          "01HE88YG74PVAB81PX2XA9F3FG",
@@ -1202,9 +1228,10 @@ func NewTestAttachments() map[string]*gtsmodel.MediaAttachment {
 				UpdatedAt:   TimeMustParse("2023-11-02T12:44:25+02:00"),
 				URL:         "http://localhost:8080/fileserver/01FHMQX3GAABWSM0S2VZEC2SWC/attachment/small/01HE88YG74PVAB81PX2XA9F3FG.jpg",
 			},
-			Avatar: util.Ptr(false),
-			Header: util.Ptr(false),
-			Cached: util.Ptr(false),
+			Avatar:    util.Ptr(false),
+			Header:    util.Ptr(false),
+			Cached:    util.Ptr(false),
+			Sensitive: util.Ptr(false),
 		},
 	}
 }
@@ -1334,6 +1361,8 @@ func NewTestDomainBlocks() map[string]*gtsmodel.DomainBlock {
 			PrivateComment:     "i blocked this domain because they keep replying with pushy + unwarranted linux advice",
 			PublicComment:      "reply-guying to tech posts",
 			Obfuscate:          util.Ptr(false),
+
+			SideEffectsCompletedAt: TimeMustParse("2020-05-13T15:29:12+02:00"),
 		},
 	}
 }
@@ -2023,7 +2052,7 @@ func NewTestPolls() map[string]*gtsmodel.Poll {
 			Voters:     util.Ptr(2),    // needs to match stored poll votes
 			StatusID:   "01HEN2RZ8BG29Y5Z9VJC73HZW7",
 			Status:     nil,
-			ExpiresAt:  TimeMustParse("2022-05-21T11:41:10Z"),
+			ExpiresAt:  TimeMustParse("2050-05-21T11:41:10Z"),
 			ClosedAt:   time.Time{},
 			Closing:    false,
 		},
@@ -2403,6 +2432,7 @@ func NewTestNotifications() map[string]*gtsmodel.Notification {
 			OriginAccountID:  "01F8MH17FWEB39HZJ76B6VXSKF",
 			StatusID:         "01F8MHAMCHF6Y650WCRSCP4WMY",
 			Read:             util.Ptr(false),
+			Dismissed:        util.Ptr(false),
 		},
 		"local_account_2_like": {
 			ID:               "01GTS6PRPXJYZBPFFQ56PP0XR8",
@@ -2412,6 +2442,7 @@ func NewTestNotifications() map[string]*gtsmodel.Notification {
 			OriginAccountID:  "01F8MH5NBDF2MV7CTC4Q5128HF",
 			StatusID:         "01F8MH75CBF9JFX4ZAD54N0W0R",
 			Read:             util.Ptr(false),
+			Dismissed:        util.Ptr(false),
 		},
 		"new_signup": {
 			ID:               "01HTM9TETMB3YQCBKZ7KD4KV02",
@@ -2421,6 +2452,7 @@ func NewTestNotifications() map[string]*gtsmodel.Notification {
 			OriginAccountID:  "01F8MH0BBE4FHXPH513MBVFHB0",
 			StatusID:         "",
 			Read:             util.Ptr(false),
+			Dismissed:        util.Ptr(false),
 		},
 	}
 }
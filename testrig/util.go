@@ -47,7 +47,7 @@ func StartNoopWorkers(state *state.State) {
 
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
-	state.Workers.Delivery.Init(nil)
+	_ = state.Workers.Delivery.Init(nil)
 
 	// Specifically do NOT start the workers
 	// as caller may require queue contents.
@@ -76,7 +76,7 @@ func StartWorkers(state *state.State, processor *workers.Processor) {
 
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
-	state.Workers.Delivery.Init(nil)
+	_ = state.Workers.Delivery.Init(nil)
 
 	_ = state.Workers.Scheduler.Start()
 	state.Workers.Client.Start(1)
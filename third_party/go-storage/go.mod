@@ -0,0 +1,26 @@
+module codeberg.org/gruf/go-storage
+
+go 1.22
+
+require (
+	codeberg.org/gruf/go-fastcopy v1.1.2
+	codeberg.org/gruf/go-fastpath/v2 v2.0.0
+	codeberg.org/gruf/go-iotools v0.0.0-20230811115124-5d4223615a7f
+	github.com/minio/minio-go/v7 v7.0.72
+)
+
+require (
+	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/goccy/go-json v0.10.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.17.8 // indirect
+	github.com/klauspost/cpuid/v2 v2.2.6 // indirect
+	github.com/minio/md5-simd v1.1.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rs/xid v1.5.0 // indirect
+	golang.org/x/crypto v0.21.0 // indirect
+	golang.org/x/net v0.23.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/ini.v1 v1.67.0 // indirect
+)
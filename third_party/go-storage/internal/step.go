@@ -0,0 +1,90 @@
+package internal
+
+import "sync"
+
+// StepGroup runs WalkKeys() step functions, either
+// serially in the calling goroutine, or spread across
+// a bounded number of worker goroutines. The first
+// error returned by a step is stored, after which no
+// further steps will be started.
+type StepGroup struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+	mu  sync.Mutex
+	err error
+}
+
+// NewStepGroup returns a new StepGroup running at most
+// 'concurrency' steps at once. Values <= 1 run serially.
+func NewStepGroup(concurrency int) *StepGroup {
+	g := new(StepGroup)
+	if concurrency > 1 {
+		g.sem = make(chan struct{}, concurrency)
+	}
+	return g
+}
+
+// Go runs the given step function, waiting for a free
+// worker if necessary. If a step has already failed,
+// the function is not run and the stored error is
+// returned, in which case the caller should stop
+// dispatching and return the result of .Wait().
+func (g *StepGroup) Go(step func() error) error {
+	if g.sem == nil {
+		// Serial, just
+		// run in place.
+		err := step()
+		g.setErr(err)
+		return err
+	}
+
+	if err := g.getErr(); err != nil {
+		return err
+	}
+
+	// Acquire worker slot.
+	g.sem <- struct{}{}
+
+	// Check again, a step may
+	// have failed while waiting.
+	if err := g.getErr(); err != nil {
+		<-g.sem
+		return err
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer func() {
+			<-g.sem
+			g.wg.Done()
+		}()
+		g.setErr(step())
+	}()
+
+	return nil
+}
+
+// Wait waits for all in-flight steps
+// to finish, returning the first error.
+func (g *StepGroup) Wait() error {
+	g.wg.Wait()
+	return g.getErr()
+}
+
+func (g *StepGroup) getErr() error {
+	g.mu.Lock()
+	err := g.err
+	g.mu.Unlock()
+	return err
+}
+
+func (g *StepGroup) setErr(err error) {
+	if err == nil {
+		return
+	}
+	g.mu.Lock()
+	if g.err == nil {
+		g.err = err
+	}
+	g.mu.Unlock()
+}
@@ -0,0 +1,239 @@
+package s3
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit configures adaptive rate limiting of the requests made
+// to S3. When S3 responds with "503 SlowDown" the permitted request
+// rate is halved (down to Min), after which it gradually recovers
+// (up to Max) as requests succeed, i.e. an AIMD-style controller.
+//
+// The limit is applied at the HTTP transport level, so it is shared
+// by all concurrent operations on an S3Storage, and also applies to
+// any retries made internally by the underlying S3 client library.
+type RateLimit struct {
+	// Initial is the initially permitted request
+	// rate, in requests per second. A value <= 0
+	// disables rate limiting entirely.
+	Initial float64
+
+	// Min is the minimum request rate (in requests
+	// per second) to back off to. Defaults to 1.
+	Min float64
+
+	// Max is the maximum request rate (in requests
+	// per second) to recover to. Defaults to Initial.
+	Max float64
+}
+
+// getRateLimit returns valid RateLimit for given.
+func getRateLimit(cfg RateLimit) RateLimit {
+	if cfg.Initial <= 0 {
+		// Disabled.
+		return RateLimit{}
+	}
+
+	if cfg.Min <= 0 {
+		cfg.Min = 1
+	}
+
+	if cfg.Max <= 0 {
+		cfg.Max = cfg.Initial
+	}
+
+	// Ensure ordered bounds,
+	// with initial between.
+	cfg.Min = min(cfg.Min, cfg.Max)
+	cfg.Initial = max(cfg.Min, min(cfg.Initial, cfg.Max))
+
+	return cfg
+}
+
+// rateLimiter is a token bucket rate limiter
+// with a request rate that adapts according to
+// the responses received for issued requests.
+type rateLimiter struct {
+	mu     sync.Mutex
+	cfg    RateLimit
+	rate   float64   // current permitted requests/second
+	tokens float64   // available tokens, -ve when reserved
+	last   time.Time // time of last token refill
+	cut    time.Time // time of last rate decrease
+
+	// ring of last issued
+	// request times, used
+	// to calc issue rate.
+	issued [32]time.Time
+	n      int
+}
+
+func newRateLimiter(cfg RateLimit) *rateLimiter {
+	return &rateLimiter{
+		cfg:    cfg,
+		rate:   cfg.Initial,
+		tokens: burst(cfg.Initial),
+		last:   time.Now(),
+	}
+}
+
+// burst returns the token bucket
+// capacity for given request rate.
+func burst(rate float64) float64 {
+	return max(1, rate/10)
+}
+
+// Wait blocks until a request may be issued under
+// the current rate limit, or context is cancelled.
+// On success returns the request issue time.
+func (l *rateLimiter) Wait(ctx context.Context) (time.Time, error) {
+	l.mu.Lock()
+
+	now := time.Now()
+	l.refill(now)
+
+	// Reserve a token, calculating
+	// the wait if bucket was empty.
+	var delay time.Duration
+	if l.tokens--; l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	// Track expected issue time.
+	at := now.Add(delay)
+	l.issued[l.n%len(l.issued)] = at
+	l.n++
+
+	l.mu.Unlock()
+
+	if delay > 0 {
+		t := time.NewTimer(delay)
+		defer t.Stop()
+
+		select {
+		case <-ctx.Done():
+			// Return token.
+			l.mu.Lock()
+			l.tokens++
+			l.mu.Unlock()
+			return time.Time{}, ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	return at, nil
+}
+
+// SlowDown decreases the permitted request rate
+// to half the lesser of the current permitted and
+// the actual request issue rate. Responses to
+// requests issued prior to the last decrease
+// are ignored, as they were issued at the old rate.
+func (l *rateLimiter) SlowDown(issued time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if issued.Before(l.cut) {
+		return
+	}
+
+	rate := l.rate
+	if r := l.issueRate(); r > 0 {
+		rate = min(rate, r)
+	}
+
+	l.rate = max(l.cfg.Min, rate/2)
+	l.cut = time.Now()
+
+	// Drop any burst tokens,
+	// the next request must
+	// wait at the new rate.
+	l.refill(l.cut)
+	l.tokens = min(l.tokens, 0)
+}
+
+// Success additively increases the permitted request
+// rate, such that at full utilization it increases
+// by Min requests per second, each second.
+func (l *rateLimiter) Success() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.rate = min(l.cfg.Max, l.rate+l.cfg.Min/l.rate)
+}
+
+// Rate returns the currently permitted
+// request rate, in requests per second.
+func (l *rateLimiter) Rate() float64 {
+	l.mu.Lock()
+	rate := l.rate
+	l.mu.Unlock()
+	return rate
+}
+
+// refill adds tokens accrued
+// since last refill to bucket.
+func (l *rateLimiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		l.tokens = min(l.tokens, burst(l.rate))
+		l.last = now
+	}
+}
+
+// issueRate returns the rate at which the most recent
+// requests were issued, in requests per second, or 0
+// if not enough requests have been issued to tell.
+func (l *rateLimiter) issueRate() float64 {
+	count := min(l.n, len(l.issued))
+	if count < 2 {
+		return 0
+	}
+
+	latest := l.issued[(l.n-1)%len(l.issued)]
+	oldest := l.issued[(l.n-count)%len(l.issued)]
+
+	span := latest.Sub(oldest).Seconds()
+	if span <= 0 {
+		return 0
+	}
+
+	return float64(count-1) / span
+}
+
+// rateLimitTransport wraps an http.RoundTripper
+// to rate limit requests using a rateLimiter.
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	rt      http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	issued, err := t.limiter.Wait(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := t.rt.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	// S3 returns 503 for "SlowDown"
+	// and "ServiceUnavailable", both
+	// are indications to back off.
+	case rsp.StatusCode == http.StatusServiceUnavailable,
+		rsp.StatusCode == http.StatusTooManyRequests:
+		t.limiter.SlowDown(issued)
+
+	case rsp.StatusCode < 500:
+		t.limiter.Success()
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,1027 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"codeberg.org/gruf/go-storage"
+	"codeberg.org/gruf/go-storage/internal"
+	"github.com/minio/minio-go/v7"
+)
+
+// ensure S3Storage conforms to storage.Storage.
+var _ storage.Storage = (*S3Storage)(nil)
+
+// ensure bytes.Reader conforms to ReaderSize.
+var _ ReaderSize = (*bytes.Reader)(nil)
+
+// ReaderSize is an extension of the io.Reader interface
+// that may be implemented by callers of WriteStream() in
+// order to improve performance. When the size is known it
+// is passed onto the underlying minio S3 library.
+type ReaderSize interface {
+	io.Reader
+	Size() int64
+}
+
+// DefaultConfig returns the default S3Storage configuration.
+func DefaultConfig() Config {
+	return defaultConfig
+}
+
+// immutable default configuration.
+var defaultConfig = Config{
+	CoreOpts:     minio.Options{},
+	GetOpts:      minio.GetObjectOptions{},
+	PutOpts:      minio.PutObjectOptions{},
+	PutChunkOpts: minio.PutObjectPartOptions{},
+	PutChunkSize: 4 * 1024 * 1024, // 4MiB
+	StatOpts:     minio.StatObjectOptions{},
+	RemoveOpts:   minio.RemoveObjectOptions{},
+	ListSize:     200,
+}
+
+// Config defines options to be used when opening an S3Storage,
+// mostly options for underlying S3 client library.
+type Config struct {
+	// CoreOpts are S3 client options
+	// passed during initialization.
+	CoreOpts minio.Options
+
+	// GetOpts are S3 client options
+	// passed during .Read___() calls.
+	GetOpts minio.GetObjectOptions
+
+	// PutOpts are S3 client options
+	// passed during .Write___() calls.
+	PutOpts minio.PutObjectOptions
+
+	// PutChunkSize is the chunk size (in bytes)
+	// to use when sending a byte stream reader
+	// of unknown size as a multi-part object.
+	PutChunkSize int64
+
+	// PutChunkOpts are S3 client options
+	// passed during chunked .Write___() calls.
+	PutChunkOpts minio.PutObjectPartOptions
+
+	// StatOpts are S3 client options
+	// passed during .Stat() calls.
+	StatOpts minio.StatObjectOptions
+
+	// RemoveOpts are S3 client options
+	// passed during .Remove() calls.
+	RemoveOpts minio.RemoveObjectOptions
+
+	// ListSize determines how many items
+	// to include in each list request, made
+	// during calls to .WalkKeys().
+	ListSize int
+
+	// MaxObjectBytes is the maximum size (in
+	// bytes) of objects written during calls
+	// to .Write___(). Writes exceeding this
+	// are aborted with storage.ErrTooLarge.
+	// A value <= 0 means no limit.
+	MaxObjectBytes int64
+
+	// RequesterPays sets the "x-amz-request-payer"
+	// header on requests made during .Read___(),
+	// .Stat() and .WalkKeys() calls, required to
+	// read from requester-pays buckets. Note that
+	// writes to such buckets are not supported.
+	RequesterPays bool
+
+	// BucketRouter returns the name of the bucket
+	// in which to store the object at given key,
+	// allowing objects to be spread across buckets
+	// by key prefix. If nil, all objects are stored
+	// in the bucket passed to Open(). Note that only
+	// the bucket passed to Open() is checked for
+	// existence, and that .WalkKeys() only walks
+	// that bucket, see .WalkBucketKeys().
+	BucketRouter func(key string) string
+
+	// AutoDetectContentType enables detection of
+	// the content-type of objects written during
+	// calls to .Write___(), when none is set in
+	// PutOpts, by peeking at the first 512 bytes
+	// of data with http.DetectContentType().
+	AutoDetectContentType bool
+
+	// RateLimit configures adaptive rate limiting
+	// of requests to S3, backing off on responses
+	// of "503 SlowDown". Disabled by default.
+	RateLimit RateLimit
+}
+
+// requestPayerHeader is the header to set on
+// requests to requester-pays buckets, with the
+// value "requester" to acknowledge the charge.
+const requestPayerHeader = "X-Amz-Request-Payer"
+
+// getS3Config returns valid (and owned!) Config for given ptr.
+func getS3Config(cfg *Config) Config {
+	// See: https://docs.aws.amazon.com/AmazonS3/latest/userguide/qfacts.html
+	const minChunkSz = 5 * 1024 * 1024
+
+	if cfg == nil {
+		// use defaults.
+		return defaultConfig
+	}
+
+	// Ensure a minimum compat chunk size.
+	if cfg.PutChunkSize <= minChunkSz {
+		cfg.PutChunkSize = minChunkSz
+	}
+
+	// Ensure valid list size.
+	if cfg.ListSize <= 0 {
+		cfg.ListSize = 200
+	}
+
+	if cfg.RequesterPays {
+		// Acknowledge request charges on reads.
+		cfg.GetOpts.Set(requestPayerHeader, "requester")
+		cfg.StatOpts.Set(requestPayerHeader, "requester")
+	}
+
+	return Config{
+		CoreOpts:       cfg.CoreOpts,
+		GetOpts:        cfg.GetOpts,
+		PutOpts:        cfg.PutOpts,
+		PutChunkSize:   cfg.PutChunkSize,
+		ListSize:       cfg.ListSize,
+		StatOpts:       cfg.StatOpts,
+		RemoveOpts:     cfg.RemoveOpts,
+		MaxObjectBytes: cfg.MaxObjectBytes,
+		RequesterPays:  cfg.RequesterPays,
+		BucketRouter:   cfg.BucketRouter,
+
+		AutoDetectContentType: cfg.AutoDetectContentType,
+		RateLimit:             getRateLimit(cfg.RateLimit),
+	}
+}
+
+// S3Storage is a storage implementation that stores key-value
+// pairs in an S3 instance at given endpoint with bucket name.
+type S3Storage struct {
+	client  *minio.Core
+	bucket  string
+	config  Config
+	limiter *rateLimiter
+}
+
+// Open opens a new S3Storage instance with given S3 endpoint URL, bucket name and configuration.
+func Open(endpoint string, bucket string, cfg *Config) (*S3Storage, error) {
+	// Check + set config defaults.
+	config := getS3Config(cfg)
+
+	var limiter *rateLimiter
+
+	if config.RateLimit.Initial > 0 {
+		rt := config.CoreOpts.Transport
+		if rt == nil {
+			var err error
+
+			// Use the same default as the S3 client.
+			rt, err = minio.DefaultTransport(config.CoreOpts.Secure)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Wrap the transport to rate limit all requests,
+		// such that the limit is shared by all operations.
+		limiter = newRateLimiter(config.RateLimit)
+		config.CoreOpts.Transport = &rateLimitTransport{
+			limiter: limiter,
+			rt:      rt,
+		}
+	}
+
+	// Create new S3 client connection to given endpoint.
+	client, err := minio.NewCore(endpoint, &config.CoreOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	// Check that provided bucket actually exists.
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New("storage/s3: bucket does not exist")
+	}
+
+	return &S3Storage{
+		client:  client,
+		bucket:  bucket,
+		config:  config,
+		limiter: limiter,
+	}, nil
+}
+
+// RequestRate returns the currently permitted rate of requests
+// to S3, in requests per second, or 0 if rate limiting is disabled.
+func (st *S3Storage) RequestRate() float64 {
+	if st.limiter == nil {
+		return 0
+	}
+	return st.limiter.Rate()
+}
+
+// BucketFor returns the name of the bucket in which the object
+// at given key is stored, according to the configured router.
+func (st *S3Storage) BucketFor(key string) string {
+	if st.config.BucketRouter == nil {
+		return st.bucket
+	}
+	return st.config.BucketRouter(key)
+}
+
+// Client: returns access to the underlying S3 client.
+func (st *S3Storage) Client() *minio.Core {
+	return st.client
+}
+
+// Clean: implements Storage.Clean().
+func (st *S3Storage) Clean(ctx context.Context) error {
+	return nil // nothing to do for S3
+}
+
+// ReadBytes: implements Storage.ReadBytes().
+func (st *S3Storage) ReadBytes(ctx context.Context, key string) ([]byte, error) {
+	// Get stream reader for key
+	rc, err := st.ReadStream(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read all data to memory.
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+
+	// Close storage stream reader.
+	if err := rc.Close(); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// ReadStream: implements Storage.ReadStream().
+func (st *S3Storage) ReadStream(ctx context.Context, key string) (io.ReadCloser, error) {
+	// Fetch object reader from S3 bucket
+	rc, _, _, err := st.client.GetObject(
+		ctx,
+		st.BucketFor(key),
+		key,
+		st.config.GetOpts,
+	)
+	if err != nil {
+
+		if isNotFoundError(err) {
+			// Wrap not found errors as our not found type.
+			err = internal.WrapErr(err, storage.ErrNotFound)
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return nil, transformS3Error(err)
+	}
+	return rc, nil
+}
+
+// WriteBytes: implements Storage.WriteBytes().
+func (st *S3Storage) WriteBytes(ctx context.Context, key string, value []byte) (int, error) {
+	n, err := st.WriteStream(ctx, key, bytes.NewReader(value))
+	return int(n), err
+}
+
+// WriteStream: implements Storage.WriteStream().
+func (st *S3Storage) WriteStream(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return st.writeStream(ctx, key, r, false)
+}
+
+// WriteIfAbsent is like .WriteStream(), but atomically fails with
+// storage.ErrAlreadyExists if an object already exists at key, by
+// sending the "If-None-Match: *" conditional write header. Backends
+// that reject this header will return ErrConditionalWriteUnsupported.
+// Note that some backends may silently ignore the header, in which
+// case this behaves exactly like .WriteStream().
+func (st *S3Storage) WriteIfAbsent(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return st.writeStream(ctx, key, r, true)
+}
+
+// writeStream performs the object write for .WriteStream() and
+// .WriteIfAbsent(), setting the conditional write header on the
+// final (or only) PUT request if ifAbsent is set.
+func (st *S3Storage) writeStream(ctx context.Context, key string, r io.Reader, ifAbsent bool) (int64, error) {
+	opts := st.config.PutOpts
+
+	if st.config.AutoDetectContentType &&
+		opts.ContentType == "" {
+		var err error
+
+		// Peek start of data to detect content-type,
+		// replacing reader with one that includes it.
+		r, opts.ContentType, err = detectContentType(r)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Options for a multipart upload
+	// start, which must include any
+	// detected content-type.
+	initOpts := st.config.PutOpts
+	initOpts.ContentType = opts.ContentType
+
+	if ifAbsent {
+		// Only write if no object exists. Since minio-go
+		// v7.0.72 an etag of "*" is sent unquoted, as
+		// the "If-None-Match: *" conditional header.
+		opts.SetMatchETagExcept("*")
+	}
+
+	if rs, ok := r.(ReaderSize); ok {
+		// Check known size against max, if set.
+		if st.config.MaxObjectBytes > 0 &&
+			rs.Size() > st.config.MaxObjectBytes {
+			return 0, storage.ErrTooLarge
+		}
+
+		// This reader supports providing us the size of
+		// the encompassed data, allowing us to perform
+		// a singular .PutObject() call with length.
+		info, err := st.client.PutObject(
+			ctx,
+			st.BucketFor(key),
+			key,
+			r,
+			rs.Size(),
+			"",
+			"",
+			opts,
+		)
+		if err != nil {
+
+			if ifAbsent {
+				// Check for failed write condition.
+				if cerr := conditionalWriteError(err); cerr != nil {
+					return 0, cerr
+				}
+			}
+
+			if isConflictError(err) {
+				// Wrap conflict errors as our already exists type.
+				err = internal.WrapErr(err, storage.ErrAlreadyExists)
+			} else if !isObjectNameError(err) {
+				// Wrap object name errors as our invalid key type.
+				err = internal.WrapErr(err, storage.ErrInvalidKey)
+			}
+
+			return 0, err
+		}
+
+		return info.Size, nil
+	}
+
+	// Start a new multipart upload to get ID.
+	uploadID, err := st.client.NewMultipartUpload(
+		ctx,
+		st.BucketFor(key),
+		key,
+		initOpts,
+	)
+	if err != nil {
+
+		if isConflictError(err) {
+			// Wrap conflict errors as our already exists type.
+			err = internal.WrapErr(err, storage.ErrAlreadyExists)
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return 0, transformS3Error(err)
+	}
+
+	// Wrap the reader, if needed, to
+	// abort once max size is exceeded.
+	r = st.limitReader(r)
+
+	var (
+		index = int(1) // parts index
+		total = int64(0)
+		parts []minio.CompletePart
+		chunk = make([]byte, st.config.PutChunkSize)
+		rbuf  = bytes.NewReader(nil)
+	)
+
+	// Note that we do not perform any kind of
+	// memory pooling of the chunk buffers here.
+	// Optimal chunking sizes for S3 writes are in
+	// the orders of megabytes, so letting the GC
+	// collect these ASAP is much preferred.
+
+loop:
+	for done := false; !done; {
+		// Read next chunk into byte buffer.
+		n, err := io.ReadFull(r, chunk)
+
+		switch err {
+		// Successful read.
+		case nil:
+
+		// Reached end, buffer empty.
+		case io.EOF:
+			break loop
+
+		// Reached end, but buffer not empty.
+		case io.ErrUnexpectedEOF:
+			done = true
+
+		// All other errors.
+		default:
+			st.abortUpload(ctx, key, uploadID)
+			return 0, err
+		}
+
+		// Reset byte reader.
+		rbuf.Reset(chunk[:n])
+
+		// Put this object chunk in S3 store.
+		pt, err := st.client.PutObjectPart(
+			ctx,
+			st.BucketFor(key),
+			key,
+			uploadID,
+			index,
+			rbuf,
+			int64(n),
+			st.config.PutChunkOpts,
+		)
+		if err != nil {
+			st.abortUpload(ctx, key, uploadID)
+			return 0, err
+		}
+
+		// Append completed part to slice.
+		parts = append(parts, minio.CompletePart{
+			PartNumber:     pt.PartNumber,
+			ETag:           pt.ETag,
+			ChecksumCRC32:  pt.ChecksumCRC32,
+			ChecksumCRC32C: pt.ChecksumCRC32C,
+			ChecksumSHA1:   pt.ChecksumSHA1,
+			ChecksumSHA256: pt.ChecksumSHA256,
+		})
+
+		// Iterate.
+		index++
+
+		// Update total size.
+		total += pt.Size
+	}
+
+	// Complete this multi-part upload operation
+	_, err = st.client.CompleteMultipartUpload(
+		ctx,
+		st.BucketFor(key),
+		key,
+		uploadID,
+		parts,
+		opts,
+	)
+	if err != nil {
+		st.abortUpload(ctx, key, uploadID)
+
+		if ifAbsent {
+			// Check for failed write condition.
+			if cerr := conditionalWriteError(err); cerr != nil {
+				return 0, cerr
+			}
+		}
+
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// detectContentType reads up to the first 512 bytes of r in
+// order to detect its content-type, returning the content-type
+// and a reader yielding the entire original stream. If r is
+// a ReaderSize, the returned reader will be one too.
+func detectContentType(r io.Reader) (io.Reader, string, error) {
+	// Get size before any reads.
+	rs, sized := r.(ReaderSize)
+	var size int64
+	if sized {
+		size = rs.Size()
+	}
+
+	// Read up to 512 bytes, which is all
+	// that http.DetectContentType() uses.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	switch err {
+	case nil, io.EOF, io.ErrUnexpectedEOF:
+		head = head[:n]
+	default:
+		return nil, "", err
+	}
+
+	// Detect from peeked bytes.
+	ctype := http.DetectContentType(head)
+
+	// Prepend peeked bytes to remaining stream.
+	r = io.MultiReader(bytes.NewReader(head), r)
+
+	if sized {
+		// Retain the known size.
+		r = &sizedReader{r, size}
+	}
+
+	return r, ctype, nil
+}
+
+// sizedReader wraps an io.Reader
+// to implement ReaderSize{}.
+type sizedReader struct {
+	io.Reader
+	size int64
+}
+
+func (r *sizedReader) Size() int64 {
+	return r.size
+}
+
+// limitReader wraps the given reader to return storage.ErrTooLarge
+// once more than the configured maximum object size has been read.
+func (st *S3Storage) limitReader(r io.Reader) io.Reader {
+	if st.config.MaxObjectBytes <= 0 {
+		return r
+	}
+	return &limitedReader{r: r, n: st.config.MaxObjectBytes}
+}
+
+// abortUpload aborts the multipart upload with given ID, cleaning
+// up any already uploaded parts. This is performed regardless of
+// context cancellation as it may have caused the upload to fail.
+func (st *S3Storage) abortUpload(ctx context.Context, key string, uploadID string) {
+	_ = st.client.AbortMultipartUpload(
+		context.WithoutCancel(ctx),
+		st.BucketFor(key),
+		key,
+		uploadID,
+	)
+}
+
+// limitedReader is an io.Reader that returns
+// storage.ErrTooLarge when more than n bytes
+// are read from the underlying reader.
+type limitedReader struct {
+	r io.Reader
+	n int64 // bytes remaining
+}
+
+func (l *limitedReader) Read(b []byte) (int, error) {
+	if l.n < 0 {
+		return 0, storage.ErrTooLarge
+	}
+
+	// Read up to one byte past
+	// limit, to detect overflow.
+	if int64(len(b)) > l.n+1 {
+		b = b[:l.n+1]
+	}
+
+	n, err := l.r.Read(b)
+	l.n -= int64(n)
+
+	if l.n < 0 {
+		// Limit exceeded.
+		return 0, storage.ErrTooLarge
+	}
+
+	return n, err
+}
+
+// Stat: implements Storage.Stat().
+func (st *S3Storage) Stat(ctx context.Context, key string) (*storage.Entry, error) {
+	// Query object in S3 bucket.
+	stat, err := st.client.StatObject(
+		ctx,
+		st.BucketFor(key),
+		key,
+		st.config.StatOpts,
+	)
+	if err != nil {
+
+		if isNotFoundError(err) {
+			// Ignore err return
+			// for not-found.
+			err = nil
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return nil, err
+	}
+
+	return &storage.Entry{
+		Key:  key,
+		Size: stat.Size,
+	}, nil
+}
+
+// Remove: implements Storage.Remove().
+func (st *S3Storage) Remove(ctx context.Context, key string) error {
+	// Query object in S3 bucket.
+	_, err := st.client.StatObject(
+		ctx,
+		st.BucketFor(key),
+		key,
+		st.config.StatOpts,
+	)
+	if err != nil {
+
+		if isNotFoundError(err) {
+			// Wrap not found errors as our not found type.
+			err = internal.WrapErr(err, storage.ErrNotFound)
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return err
+	}
+
+	// Remove object from S3 bucket
+	err = st.client.RemoveObject(
+		ctx,
+		st.BucketFor(key),
+		key,
+		st.config.RemoveOpts,
+	)
+	if err != nil {
+
+		if isNotFoundError(err) {
+			// Wrap not found errors as our not found type.
+			err = internal.WrapErr(err, storage.ErrNotFound)
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// maxCopyObjectSize is the largest object that S3
+// allows to be copied in a single CopyObject request,
+// larger objects must be copied using a multipart copy.
+// This is also used as the size of each copied part.
+const maxCopyObjectSize = 5 * 1024 * 1024 * 1024 // 5GiB
+
+// UpdateMetadata replaces the user metadata, tags and storage class of
+// the object at key, by performing an in-place server-side copy, i.e.
+// without downloading or re-uploading the object data. Nil meta or tags
+// and an empty storage class preserve the object's existing values.
+// Objects larger than the single copy limit use a multipart copy.
+func (st *S3Storage) UpdateMetadata(ctx context.Context, key string, meta map[string]string, tags map[string]string, storageClass string) error {
+	bucket := st.BucketFor(key)
+
+	// Query object in S3 bucket.
+	stat, err := st.client.StatObject(
+		ctx,
+		bucket,
+		key,
+		st.config.StatOpts,
+	)
+	if err != nil {
+
+		if isNotFoundError(err) {
+			// Wrap not found errors as our not found type.
+			err = internal.WrapErr(err, storage.ErrNotFound)
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return err
+	}
+
+	if meta == nil {
+		// Preserve existing.
+		meta = stat.UserMetadata
+	}
+
+	if storageClass == "" {
+		// Preserve existing. Note that S3 otherwise
+		// resets a copied object to STANDARD class.
+		storageClass = stat.Metadata.Get("X-Amz-Storage-Class")
+	}
+
+	// Replacing metadata replaces *all* of it, so
+	// ensure we keep the object's existing content-type.
+	opts := minio.PutObjectOptions{
+		ContentType:  stat.ContentType,
+		UserMetadata: meta,
+		UserTags:     tags,
+		StorageClass: storageClass,
+	}
+
+	if stat.Size > maxCopyObjectSize {
+		return st.updateMetadataMultipart(ctx, key, stat.Size, opts, tags != nil)
+	}
+
+	// Copy object onto itself, replacing metadata
+	// (and tags if set) with those in the headers.
+	headers := opts.Header()
+	headers.Set("X-Amz-Metadata-Directive", "REPLACE")
+	if tags != nil {
+		headers.Set("X-Amz-Tagging-Directive", "REPLACE")
+	}
+
+	metadata := make(map[string]string, len(headers))
+	for k := range headers {
+		metadata[k] = headers.Get(k)
+	}
+
+	_, err = st.client.CopyObject(
+		ctx,
+		bucket,
+		key,
+		bucket,
+		key,
+		metadata,
+		minio.CopySrcOptions{},
+		minio.PutObjectOptions{},
+	)
+	if err != nil {
+
+		if isNotFoundError(err) {
+			// Wrap not found errors as our not found type.
+			err = internal.WrapErr(err, storage.ErrNotFound)
+		} else if !isObjectNameError(err) {
+			// Wrap object name errors as our invalid key type.
+			err = internal.WrapErr(err, storage.ErrInvalidKey)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// updateMetadataMultipart performs the server-side copy of
+// UpdateMetadata() for objects too large for a single copy.
+// Multipart uploads never inherit source tags, so if these
+// are not being replaced they're fetched and set explicitly.
+func (st *S3Storage) updateMetadataMultipart(ctx context.Context, key string, size int64, opts minio.PutObjectOptions, replaceTags bool) error {
+	bucket := st.BucketFor(key)
+
+	if !replaceTags {
+		// Fetch existing tags to preserve.
+		tags, err := st.client.GetObjectTagging(
+			ctx,
+			bucket,
+			key,
+			minio.GetObjectTaggingOptions{},
+		)
+		if err != nil {
+			return err
+		}
+		opts.UserTags = tags.ToMap()
+	}
+
+	// Start a new multipart upload with the replacement
+	// metadata, onto which the object itself is copied.
+	uploadID, err := st.client.NewMultipartUpload(
+		ctx,
+		bucket,
+		key,
+		opts,
+	)
+	if err != nil {
+		return err
+	}
+
+	var parts []minio.CompletePart
+
+	for off, i := int64(0), 1; off < size; off, i = off+maxCopyObjectSize, i+1 {
+		// Copy up to max size per part.
+		length := min(size-off, maxCopyObjectSize)
+
+		part, err := st.client.CopyObjectPart(
+			ctx,
+			bucket,
+			key,
+			bucket,
+			key,
+			uploadID,
+			i,
+			off,
+			length,
+			nil,
+		)
+		if err != nil {
+			st.abortUpload(ctx, key, uploadID)
+			return err
+		}
+
+		parts = append(parts, part)
+	}
+
+	// Complete the upload, replacing the
+	// existing object with its new metadata.
+	_, err = st.client.CompleteMultipartUpload(
+		ctx,
+		bucket,
+		key,
+		uploadID,
+		parts,
+		minio.PutObjectOptions{},
+	)
+	if err != nil {
+		st.abortUpload(ctx, key, uploadID)
+		return err
+	}
+
+	return nil
+}
+
+// WalkKeys: implements Storage.WalkKeys().
+//
+// Note that only the bucket passed to Open() is walked. When a
+// BucketRouter is configured, objects routed to other buckets
+// must be walked separately using .WalkBucketKeys().
+func (st *S3Storage) WalkKeys(ctx context.Context, opts storage.WalkKeysOpts) error {
+	return st.WalkBucketKeys(ctx, st.bucket, opts)
+}
+
+// WalkBucketKeys is like .WalkKeys(), but walks the named bucket.
+func (st *S3Storage) WalkBucketKeys(ctx context.Context, bucket string, opts storage.WalkKeysOpts) error {
+	if opts.Step == nil {
+		panic("nil step fn")
+	}
+
+	if st.config.RequesterPays || opts.FetchMetadata {
+		// The core ListObjectsV2() doesn't allow
+		// setting request headers, or requesting
+		// object metadata in the listing.
+		return st.walkKeysListObjects(ctx, bucket, opts)
+	}
+
+	var (
+		prev  string
+		token string
+	)
+
+	// Prepare group to run steps,
+	// ensuring none outlive walk.
+	group := internal.NewStepGroup(opts.Concurrency)
+	defer group.Wait()
+
+	for {
+		// List objects in bucket starting at marker.
+		result, err := st.client.ListObjectsV2(
+			bucket,
+			opts.Prefix,
+			prev,
+			token,
+			"",
+			st.config.ListSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Iterate through list result contents.
+		for _, obj := range result.Contents {
+
+			// Skip filtered obj keys.
+			if opts.Filter != nil &&
+				opts.Filter(obj.Key) {
+				continue
+			}
+
+			entry := toEntry(obj, false)
+
+			// Pass each obj through step func.
+			if group.Go(func() error {
+				return opts.Step(entry)
+			}) != nil {
+				return group.Wait()
+			}
+		}
+
+		// Wait for page steps to finish.
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		// No token means we reached end of bucket.
+		if result.NextContinuationToken == "" {
+			return nil
+		}
+
+		// Set continue token and prev mark
+		token = result.NextContinuationToken
+		prev = result.StartAfter
+	}
+}
+
+// walkKeysListObjects is WalkKeys() for requester-pays buckets, or when
+// object metadata is requested, using the (higher-level) client object
+// listing which accepts extra request headers and listing options.
+func (st *S3Storage) walkKeysListObjects(ctx context.Context, bucket string, opts storage.WalkKeysOpts) error {
+	// Cancel listing on early return.
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	listOpts := minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		MaxKeys:      st.config.ListSize,
+		WithMetadata: opts.FetchMetadata,
+	}
+
+	if st.config.RequesterPays {
+		listOpts.Set(requestPayerHeader, "requester")
+	}
+
+	// Prepare group to run steps,
+	// ensuring none outlive walk.
+	group := internal.NewStepGroup(opts.Concurrency)
+	defer group.Wait()
+
+	// Objects received in
+	// the current "page".
+	var n int
+
+	for obj := range st.client.Client.ListObjects(ctx, bucket, listOpts) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+
+		// Skip filtered obj keys.
+		if opts.Filter != nil &&
+			opts.Filter(obj.Key) {
+			continue
+		}
+
+		entry := toEntry(obj, opts.FetchMetadata)
+
+		// Pass each obj through step func.
+		if group.Go(func() error {
+			return opts.Step(entry)
+		}) != nil {
+			return group.Wait()
+		}
+
+		// The listing channel hides page
+		// boundaries, so emulate them to
+		// keep the same ordering guarantee.
+		if n++; n >= st.config.ListSize {
+			if err := group.Wait(); err != nil {
+				return err
+			}
+			n = 0
+		}
+	}
+
+	return group.Wait()
+}
+
+// toEntry converts listed object info to a storage
+// entry, including the extended fields if metadata.
+func toEntry(obj minio.ObjectInfo, metadata bool) storage.Entry {
+	entry := storage.Entry{
+		Key:  obj.Key,
+		Size: obj.Size,
+	}
+
+	if metadata {
+		entry.LastModified = obj.LastModified
+		entry.ETag = obj.ETag
+		entry.StorageClass = obj.StorageClass
+	}
+
+	return entry
+}
@@ -0,0 +1,1010 @@
+package structr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// DefaultIgnoreErr is the default function used to
+// ignore (i.e. not cache) incoming error results during
+// Load() calls. By default ignores context pkg errors.
+func DefaultIgnoreErr(err error) bool {
+	return errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// CacheConfig defines config vars
+// for initializing a struct cache.
+type CacheConfig[StructType any] struct {
+
+	// Name optionally identifies the
+	// Cache, e.g. to label its stats
+	// when exported as metrics. This
+	// can't be changed after Init().
+	Name string
+
+	// Indices defines indices to create
+	// in the Cache for the receiving
+	// generic struct type parameter.
+	Indices []IndexConfig
+
+	// MaxSize defines the maximum number
+	// of items allowed in the Cache at
+	// one time, before old items start
+	// getting evicted.
+	MaxSize int
+
+	// ScanResistant enables a segmented LRU
+	// eviction policy, in place of plain LRU.
+	// New items enter a probationary segment,
+	// and are only promoted to the protected
+	// segment (up to ProtectedRatio of MaxSize)
+	// when accessed again. Items are evicted
+	// from the probationary segment first, so
+	// a burst of one-off entries, e.g. from a
+	// bulk crawl, can't evict frequently used
+	// entries from the cache.
+	ScanResistant bool
+
+	// ProtectedRatio defines the fraction of
+	// MaxSize given to the protected segment
+	// when ScanResistant is set. This may be
+	// left as zero, on which 0.8 will be used.
+	ProtectedRatio float64
+
+	// IgnoreErr defines which errors to
+	// ignore (i.e. not cache) returned
+	// from load function callback calls.
+	// This may be left as nil, on which
+	// DefaultIgnoreErr will be used.
+	IgnoreErr func(error) bool
+
+	// Copy provides a means of copying
+	// cached values, to ensure returned values
+	// do not share memory with those in cache.
+	Copy func(StructType) StructType
+
+	// Invalidate is called when cache values
+	// (NOT errors) are invalidated, either
+	// as the values passed to Put() / Store(),
+	// or by the keys by calls to Invalidate().
+	Invalidate func(StructType)
+}
+
+// Cache provides a structure cache with automated
+// indexing and lookups by any initialization-defined
+// combination of fields. This also supports caching
+// of negative results (errors!) returned by LoadOne().
+type Cache[StructType any] struct {
+
+	// optional cache name,
+	// immutable after init.
+	name string
+
+	// indices used in storing passed struct
+	// types by user defined sets of fields.
+	indices []Index
+
+	// keeps track of all indexed items,
+	// in order of last recently used (LRU).
+	//
+	// in scan resistant mode this is the
+	// probationary segment, i.e. only items
+	// not accessed since they were stored.
+	lru list
+
+	// protected segment, in order of last
+	// recently used. only used in scan
+	// resistant mode, and contains items
+	// accessed again since they were stored.
+	prot list
+
+	// max cache size, imposes size
+	// limit on the lruList in order
+	// to evict old entries.
+	maxSize int
+
+	// max protected segment size,
+	// non-zero only when in scan
+	// resistant mode.
+	maxProt int
+
+	// hook functions.
+	ignore  func(error) bool
+	copy    func(StructType) StructType
+	invalid func(StructType)
+
+	// protective mutex, guards:
+	// - Cache{}.lruList
+	// - Index{}.data
+	// - Cache{} hook fns
+	mutex sync.Mutex
+}
+
+// Init initializes the cache with given configuration
+// including struct fields to index, and necessary fns.
+func (c *Cache[T]) Init(config CacheConfig[T]) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	if len(config.Indices) == 0 {
+		panic("no indices provided")
+	}
+
+	if config.IgnoreErr == nil {
+		config.IgnoreErr = DefaultIgnoreErr
+	}
+
+	if config.Copy == nil {
+		panic("copy function must be provided")
+	}
+
+	if config.MaxSize < 2 {
+		panic("minimum cache size is 2 for LRU to work")
+	}
+
+	var maxProt int
+	if config.ScanResistant {
+		ratio := config.ProtectedRatio
+		if ratio == 0 {
+			ratio = 0.8
+		}
+
+		if ratio < 0 || ratio >= 1 {
+			panic("protected ratio must be in range (0, 1)")
+		}
+
+		// Calculate protected segment size,
+		// always leaving room for at least
+		// one probationary item.
+		maxProt = int(ratio * float64(config.MaxSize))
+		maxProt = max(maxProt, 1)
+		maxProt = min(maxProt, config.MaxSize-1)
+	}
+
+	// Safely copy over
+	// provided config.
+	c.mutex.Lock()
+	c.indices = make([]Index, len(config.Indices))
+	for i, cfg := range config.Indices {
+		c.indices[i].ptr = unsafe.Pointer(c)
+		c.indices[i].init(t, cfg, config.MaxSize)
+	}
+	c.name = config.Name
+	c.ignore = config.IgnoreErr
+	c.copy = config.Copy
+	c.invalid = config.Invalidate
+	c.maxSize = config.MaxSize
+	c.maxProt = maxProt
+	c.mutex.Unlock()
+}
+
+// Validate checks the given cache configuration for any
+// errors that would otherwise cause a panic in Init(), e.g.
+// unknown or unexported index fields. Unlike Init() this
+// checks every index, returning all errors found joined.
+func (config *CacheConfig[T]) Validate() error {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	var errs []error
+
+	if len(config.Indices) == 0 {
+		errs = append(errs, errors.New("no indices provided"))
+	}
+
+	if config.Copy == nil {
+		errs = append(errs, errors.New("copy function must be provided"))
+	}
+
+	if config.MaxSize < 2 {
+		errs = append(errs, errors.New("minimum cache size is 2 for LRU to work"))
+	}
+
+	if config.ScanResistant {
+		if r := config.ProtectedRatio; r < 0 || r >= 1 {
+			errs = append(errs, errors.New("protected ratio must be in range (0, 1)"))
+		}
+	}
+
+	seen := make(map[string]struct{}, len(config.Indices))
+	for _, cfg := range config.Indices {
+		if _, ok := seen[cfg.Fields]; ok {
+			errs = append(errs, errors.New("index already exists: "+cfg.Fields))
+			continue
+		}
+		seen[cfg.Fields] = struct{}{}
+
+		if err := validate_index(t, cfg); err != nil {
+			errs = append(errs, fmt.Errorf("index %q: %w", cfg.Fields, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// AddComputedIndex adds an index with given name to the cache,
+// keyed by the string returned from keyFn for each stored value.
+// This allows lookups by keys that can't be expressed as a set of
+// struct fields, e.g. a normalized "username@domain" string. The
+// computed string is used directly as the index key, so lookups on
+// this index take the single pre-computed string as the key part.
+// Values for which keyFn returns an empty string are not indexed.
+//
+// This must be called after Init() and before the cache is used.
+// Note that any *Index previously returned by Index() is invalid
+// after calling this, and should be fetched again.
+func (c *Cache[T]) AddComputedIndex(name string, keyFn func(value T) string) {
+	if keyFn == nil {
+		panic("nil key function")
+	}
+
+	// Acquire lock.
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Check cache init.
+	if c.copy == nil {
+		panic("not initialized")
+	}
+
+	// Check cache not in use.
+	if c.len() != 0 {
+		panic("cannot add index to cache in use")
+	}
+
+	// Check for name clashes.
+	for i := range c.indices {
+		if c.indices[i].name == name {
+			panic("index already exists: " + name)
+		}
+	}
+
+	// Wrap key function to take ptr to value data.
+	compute := func(ptr unsafe.Pointer) string {
+		return keyFn(*(*T)(ptr))
+	}
+
+	// Append new computed index.
+	c.indices = append(c.indices, Index{})
+	idx := &c.indices[len(c.indices)-1]
+	idx.ptr = unsafe.Pointer(c)
+	idx.init_computed(name, compute, c.maxSize)
+}
+
+// Index selects index with given name from cache, else panics.
+func (c *Cache[T]) Index(name string) *Index {
+	for i := range c.indices {
+		if c.indices[i].name == name {
+			return &c.indices[i]
+		}
+	}
+	panic("unknown index: " + name)
+}
+
+// GetOne fetches value from cache stored under index, using precalculated index key.
+func (c *Cache[T]) GetOne(index *Index, key Key) (T, bool) {
+	values := c.Get(index, key)
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	return values[0], true
+}
+
+// Get fetches values from the cache stored under index, using precalculated index keys.
+func (c *Cache[T]) Get(index *Index, keys ...Key) []T {
+	if index == nil {
+		panic("no index given")
+	} else if index.ptr != unsafe.Pointer(c) {
+		panic("invalid index for cache")
+	}
+
+	// Preallocate expected ret slice.
+	values := make([]T, 0, len(keys))
+
+	// Acquire lock.
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Check cache init.
+	if c.copy == nil {
+		panic("not initialized")
+	}
+
+	for i := range keys {
+		// Concatenate all *values* from cached items.
+		index.get(keys[i].key, func(item *indexed_item) {
+			if value, ok := item.data.(T); ok {
+				// Append value COPY.
+				value = c.copy(value)
+				values = append(values, value)
+
+				// Mark item as recently used, USING
+				// THE ITEM'S LRU ENTRY, NOT THE
+				// INDEX KEY ENTRY. VERY IMPORTANT!!
+				c.touch(item)
+			}
+		})
+	}
+
+	return values
+}
+
+// GetAll fetches all values stored under key in the given index,
+// in the order they were stored in the cache (i.e. oldest first).
+// This is intended for non-unique indices, e.g. statuses by author.
+//
+// If touch is set the values are marked as recently used as in Get(),
+// otherwise the LRU order is left as-is, such that a single lookup of
+// many values does not distort which cache entries get evicted.
+func (c *Cache[T]) GetAll(index *Index, key Key, touch bool) []T {
+	values, _ := c.GetAllSeq(index, key, touch)
+	return values
+}
+
+// GetAllSeq is as GetAll(), but additionally returns the insertion
+// sequence number of each value. These are unique and increase
+// monotonically across ALL caches, so can be used to merge the
+// results of GetAll() on multiple caches in order of insertion.
+func (c *Cache[T]) GetAllSeq(index *Index, key Key, touch bool) ([]T, []uint64) {
+	if index == nil {
+		panic("no index given")
+	} else if index.ptr != unsafe.Pointer(c) {
+		panic("invalid index for cache")
+	}
+
+	var (
+		values []T
+		seqs   []uint64
+	)
+
+	// Acquire lock.
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Check cache init.
+	if c.copy == nil {
+		panic("not initialized")
+	}
+
+	index.get_all(key.key, func(item *indexed_item) {
+		if value, ok := item.data.(T); ok {
+			// Append value COPY.
+			value = c.copy(value)
+			values = append(values, value)
+			seqs = append(seqs, item.seq)
+
+			if touch {
+				// Mark item as recently used.
+				c.touch(item)
+			}
+		}
+	})
+
+	return values, seqs
+}
+
+// Put will insert the given values into cache,
+// calling any invalidate hook on each value.
+func (c *Cache[T]) Put(values ...T) {
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Wrap unlock to only do once.
+	unlock := once(c.mutex.Unlock)
+	defer unlock()
+
+	// Check cache init.
+	if c.copy == nil {
+		panic("not initialized")
+	}
+
+	// Store all passed values.
+	for i := range values {
+		c.store_value(
+			nil,
+			Key{},
+			values[i],
+		)
+	}
+
+	// Get func ptrs.
+	invalid := c.invalid
+
+	// Done with
+	// the lock.
+	unlock()
+
+	if invalid != nil {
+		// Pass all invalidated values
+		// to given user hook (if set).
+		for _, value := range values {
+			invalid(value)
+		}
+	}
+}
+
+// LoadOneBy fetches one result from the cache stored under index, using precalculated index key.
+// In the case that no result is found, provided load callback will be used to hydrate the cache.
+func (c *Cache[T]) LoadOne(index *Index, key Key, load func() (T, error)) (T, error) {
+	if index == nil {
+		panic("no index given")
+	} else if index.ptr != unsafe.Pointer(c) {
+		panic("invalid index for cache")
+	} else if !is_unique(index.flags) {
+		panic("cannot get one by non-unique index")
+	}
+
+	var (
+		// whether an item was found
+		// (and so val / err are set).
+		ok bool
+
+		// separate value / error ptrs
+		// as the item is liable to
+		// change outside of lock.
+		val T
+		err error
+	)
+
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Wrap unlock to only do once.
+	unlock := once(c.mutex.Unlock)
+	defer unlock()
+
+	// Check init'd.
+	if c.copy == nil ||
+		c.ignore == nil {
+		panic("not initialized")
+	}
+
+	// Get item indexed at key.
+	item := index.get_one(key)
+
+	if ok = (item != nil); ok {
+		var is bool
+
+		if val, is = item.data.(T); is {
+			// Set value COPY.
+			val = c.copy(val)
+
+			// Mark item as recently used, USING
+			// THE ITEM'S LRU ENTRY, NOT THE
+			// INDEX KEY ENTRY. VERY IMPORTANT!!
+			c.touch(item)
+
+		} else {
+
+			// Attempt to return error.
+			err, _ = item.data.(error)
+		}
+	}
+
+	// Get func ptrs.
+	ignore := c.ignore
+
+	// Done with
+	// the lock.
+	unlock()
+
+	if ok {
+		// item found!
+		return val, err
+	}
+
+	// Load new result.
+	val, err = load()
+
+	// Check for ignored error types.
+	if err != nil && ignore(err) {
+		return val, err
+	}
+
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Index this new loaded item.
+	// Note this handles copying of
+	// the provided value, so it is
+	// safe for us to return as-is.
+	if err != nil {
+		c.store_error(index, key, err)
+	} else {
+		c.store_value(index, key, val)
+	}
+
+	// Done with lock.
+	c.mutex.Unlock()
+
+	return val, err
+}
+
+// Load fetches values from the cache stored under index, using precalculated index keys. The cache will attempt to
+// results with values stored under keys, passing keys with uncached results to the provider load callback to further
+// hydrate the cache with missing results. Cached error results not included or returned by this function.
+func (c *Cache[T]) Load(index *Index, keys []Key, load func([]Key) ([]T, error)) ([]T, error) {
+	if index == nil {
+		panic("no index given")
+	} else if index.ptr != unsafe.Pointer(c) {
+		panic("invalid index for cache")
+	}
+
+	// Preallocate expected ret slice.
+	values := make([]T, 0, len(keys))
+
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Wrap unlock to only do once.
+	unlock := once(c.mutex.Unlock)
+	defer unlock()
+
+	// Check init'd.
+	if c.copy == nil {
+		panic("not initialized")
+	}
+
+	for i := 0; i < len(keys); {
+		// Value length before
+		// any below appends.
+		before := len(values)
+
+		// Concatenate all *values* from cached items.
+		index.get(keys[i].key, func(item *indexed_item) {
+			if value, ok := item.data.(T); ok {
+				// Append value COPY.
+				value = c.copy(value)
+				values = append(values, value)
+
+				// Mark item as recently used, USING
+				// THE ITEM'S LRU ENTRY, NOT THE
+				// INDEX KEY ENTRY. VERY IMPORTANT!!
+				c.touch(item)
+			}
+		})
+
+		// Only if values changed did
+		// we actually find anything.
+		if len(values) != before {
+
+			// We found values at key,
+			// drop key from the slice.
+			copy(keys[i:], keys[i+1:])
+			keys = keys[:len(keys)-1]
+			continue
+		}
+
+		// Iter
+		i++
+	}
+
+	// Done with
+	// the lock.
+	unlock()
+
+	// Load uncached values.
+	uncached, err := load(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Store all uncached values.
+	for i := range uncached {
+		c.store_value(
+			nil,
+			Key{},
+			uncached[i],
+		)
+	}
+
+	// Done with lock.
+	c.mutex.Unlock()
+
+	// Append uncached to return values.
+	values = append(values, uncached...)
+
+	return values, nil
+}
+
+// Store will call the given store callback, on non-error then
+// passing the provided value to the Put() function. On error
+// return the value is still passed to stored invalidate hook.
+func (c *Cache[T]) Store(value T, store func() error) error {
+	// Store value.
+	err := store()
+	if err != nil {
+
+		// Get func ptrs.
+		c.mutex.Lock()
+		invalid := c.invalid
+		c.mutex.Unlock()
+
+		// On error don't store
+		// value, but still pass
+		// to invalidate hook.
+		if invalid != nil {
+			invalid(value)
+		}
+
+		return err
+	}
+
+	// Store value.
+	c.Put(value)
+
+	return nil
+}
+
+// Invalidate invalidates all results stored under index keys.
+func (c *Cache[T]) Invalidate(index *Index, keys ...Key) {
+	if index == nil {
+		panic("no index given")
+	} else if index.ptr != unsafe.Pointer(c) {
+		panic("invalid index for cache")
+	}
+
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Preallocate expected ret slice.
+	values := make([]T, 0, len(keys))
+
+	for i := range keys {
+		// Delete all items under key from index, collecting
+		// value items and dropping them from all their indices.
+		index.delete(keys[i].key, func(item *indexed_item) {
+
+			if value, ok := item.data.(T); ok {
+				// No need to copy, as item
+				// being deleted from cache.
+				values = append(values, value)
+			}
+
+			// Delete cached.
+			c.delete(item)
+		})
+	}
+
+	// Get func ptrs.
+	invalid := c.invalid
+
+	// Done with lock.
+	c.mutex.Unlock()
+
+	if invalid != nil {
+		// Pass all invalidated values
+		// to given user hook (if set).
+		for _, value := range values {
+			invalid(value)
+		}
+	}
+}
+
+// Trim will truncate the cache to ensure it
+// stays within given percentage of MaxSize.
+func (c *Cache[T]) Trim(perc float64) {
+	// Acquire lock.
+	c.mutex.Lock()
+
+	// Calculate number of cache items to drop.
+	max := (perc / 100) * float64(c.maxSize)
+	diff := c.len() - int(max)
+	if diff <= 0 {
+
+		// Trim not needed.
+		c.mutex.Unlock()
+		return
+	}
+
+	// Iterate over 'diff' items
+	// from back (oldest) of cache.
+	for i := 0; i < diff; i++ {
+
+		// Get oldest elem.
+		oldest := c.oldest()
+		if oldest == nil {
+
+			// reached
+			// end.
+			break
+		}
+
+		// Drop oldest item from cache.
+		item := (*indexed_item)(oldest.data)
+		c.delete(item)
+	}
+
+	// Done with lock.
+	c.mutex.Unlock()
+}
+
+// Clear empties the cache by calling .Trim(0).
+func (c *Cache[T]) Clear() { c.Trim(0) }
+
+// Len returns the current length of cache.
+func (c *Cache[T]) Len() int {
+	c.mutex.Lock()
+	l := c.len()
+	c.mutex.Unlock()
+	return l
+}
+
+// Debug returns debug stats about cache.
+func (c *Cache[T]) Debug() map[string]any {
+	m := make(map[string]any)
+	c.mutex.Lock()
+	m["lru"] = c.lru.len
+	if c.maxProt > 0 {
+		m["protected"] = c.prot.len
+	}
+	indices := make(map[string]any)
+	m["indices"] = indices
+	for i := range c.indices {
+		var n uint64
+		c.indices[i].data.Iter(func(_ string, l *list) (stop bool) {
+			n += uint64(l.len)
+			return
+		})
+		indices[c.indices[i].name] = n
+	}
+	c.mutex.Unlock()
+	return m
+}
+
+// IndexStats returns statistics about each of the cache
+// indices, keyed by index name. This is useful to spot an
+// index degenerating, e.g. a non-unique index where a single
+// key has come to hold a large portion of the cache entries.
+func (c *Cache[T]) IndexStats() map[string]IndexStat {
+	stats := make(map[string]IndexStat, len(c.indices))
+	c.mutex.Lock()
+	for i := range c.indices {
+		stat := c.indices[i].stat()
+		stat.Cache = c.name
+		stats[c.indices[i].name] = stat
+	}
+	c.mutex.Unlock()
+	return stats
+}
+
+// CacheStat contains statistics about
+// the contents of a Cache, see Stats().
+type CacheStat struct {
+	// Name is the configured
+	// name of the cache, if any.
+	Name string
+
+	// Len is the current
+	// length of the cache.
+	Len int
+
+	// Cap is the maximum
+	// capacity of the cache.
+	Cap int
+
+	// Indices contains stats about each
+	// index, keyed by name, as IndexStats().
+	Indices map[string]IndexStat
+}
+
+// Stats returns statistics about the cache and each of its
+// indices, labelled with the configured cache name (if any).
+func (c *Cache[T]) Stats() CacheStat {
+	stats := c.IndexStats()
+	c.mutex.Lock()
+	stat := CacheStat{
+		Name:    c.name,
+		Len:     c.len(),
+		Cap:     c.maxSize,
+		Indices: stats,
+	}
+	c.mutex.Unlock()
+	return stat
+}
+
+// Name returns the configured name of the cache, if any.
+func (c *Cache[T]) Name() string {
+	c.mutex.Lock()
+	n := c.name
+	c.mutex.Unlock()
+	return n
+}
+
+// Cap returns the maximum capacity (size) of cache.
+func (c *Cache[T]) Cap() int {
+	c.mutex.Lock()
+	m := c.maxSize
+	c.mutex.Unlock()
+	return m
+}
+
+func (c *Cache[T]) store_value(index *Index, key Key, value T) {
+	// Alloc new index item.
+	item := new_indexed_item()
+	if cap(item.indexed) < len(c.indices) {
+
+		// Preallocate item indices slice to prevent Go auto
+		// allocating overlying large slices we don't need.
+		item.indexed = make([]*index_entry, 0, len(c.indices))
+	}
+
+	// Create COPY of value.
+	value = c.copy(value)
+	item.data = value
+	item.seq = next_seq()
+
+	if index != nil {
+		// Append item to index.
+		index.append(key.key, item)
+	}
+
+	// Get ptr to value data.
+	ptr := unsafe.Pointer(&value)
+
+	// Acquire key buf.
+	buf := new_buffer()
+
+	for i := range c.indices {
+		// Get current index ptr.
+		idx := &(c.indices[i])
+		if idx == index {
+
+			// Already stored under
+			// this index, ignore.
+			continue
+		}
+
+		var parts []any
+
+		if idx.compute != nil {
+			// Compute the index key part.
+			parts = []any{idx.compute(ptr)}
+		} else {
+			// Extract fields comprising index key.
+			parts = extract_fields(ptr, idx.fields)
+			if parts == nil {
+				continue
+			}
+		}
+
+		// Calculate index key.
+		key := idx.key(buf, parts)
+		if key == "" {
+			continue
+		}
+
+		// Append item to index.
+		idx.append(key, item)
+	}
+
+	// Add item to main lru list.
+	c.lru.push_front(&item.elem)
+
+	// Done with buf.
+	free_buffer(buf)
+
+	// Evict if over size.
+	c.evict()
+}
+
+func (c *Cache[T]) store_error(index *Index, key Key, err error) {
+	if index == nil {
+		// nothing we
+		// can do here.
+		return
+	}
+
+	// Alloc new index item.
+	item := new_indexed_item()
+	if cap(item.indexed) < len(c.indices) {
+
+		// Preallocate item indices slice to prevent Go auto
+		// allocating overlying large slices we don't need.
+		item.indexed = make([]*index_entry, 0, len(c.indices))
+	}
+
+	// Set error val.
+	item.data = err
+
+	// Append item to index.
+	index.append(key.key, item)
+
+	// Add item to main lru list.
+	c.lru.push_front(&item.elem)
+
+	// Evict if over size.
+	c.evict()
+}
+
+func (c *Cache[T]) delete(item *indexed_item) {
+	for len(item.indexed) != 0 {
+		// Pop last indexed entry from list.
+		entry := item.indexed[len(item.indexed)-1]
+		item.indexed = item.indexed[:len(item.indexed)-1]
+
+		// Drop index_entry from index.
+		entry.index.delete_entry(entry)
+	}
+
+	// Drop entry from its lru list.
+	if item.protected {
+		c.prot.remove(&item.elem)
+	} else {
+		c.lru.remove(&item.elem)
+	}
+
+	// Free now-unused item.
+	free_indexed_item(item)
+}
+
+// len returns the total number of items
+// in cache, across both LRU segments.
+func (c *Cache[T]) len() int {
+	return c.lru.len + c.prot.len
+}
+
+// oldest returns the next element to be evicted
+// from the cache, i.e. the oldest probationary
+// item, else the oldest protected item.
+func (c *Cache[T]) oldest() *list_elem {
+	if c.lru.tail != nil {
+		return c.lru.tail
+	}
+	return c.prot.tail
+}
+
+// evict drops the oldest element
+// if the cache has hit max size.
+func (c *Cache[T]) evict() {
+	if c.len() > c.maxSize {
+		ptr := c.oldest().data
+		item := (*indexed_item)(ptr)
+		c.delete(item)
+	}
+}
+
+// touch marks item as recently used. In plain LRU
+// mode this moves it to the front of the LRU list.
+// In scan resistant mode, probationary items are
+// promoted to the front of the protected segment,
+// demoting the oldest protected item to the front
+// of the probationary segment if it is full.
+func (c *Cache[T]) touch(item *indexed_item) {
+	if c.maxProt == 0 {
+		// Plain LRU.
+		c.lru.move_front(&item.elem)
+		return
+	}
+
+	if item.protected {
+		// Already protected, move to front.
+		c.prot.move_front(&item.elem)
+		return
+	}
+
+	// Promote from probationary
+	// to the protected segment.
+	c.lru.remove(&item.elem)
+	c.prot.push_front(&item.elem)
+	item.protected = true
+
+	if c.prot.len > c.maxProt {
+		// Protected segment is full, demote
+		// its oldest item to probationary,
+		// giving it another chance before
+		// it's eventually evicted.
+		ptr := c.prot.tail.data
+		oldest := (*indexed_item)(ptr)
+		c.prot.remove(&oldest.elem)
+		c.lru.push_front(&oldest.elem)
+		oldest.protected = false
+	}
+}
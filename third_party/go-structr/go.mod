@@ -0,0 +1,15 @@
+module codeberg.org/gruf/go-structr
+
+go 1.21
+
+toolchain go1.21.3
+
+require (
+	codeberg.org/gruf/go-byteutil v1.2.0
+	codeberg.org/gruf/go-kv v1.6.4
+	codeberg.org/gruf/go-mangler v1.3.0
+	github.com/dolthub/swiss v0.2.1
+	github.com/modern-go/reflect2 v1.0.2
+)
+
+require github.com/dolthub/maphash v0.1.0 // indirect
@@ -0,0 +1,5 @@
+#!/bin/sh
+set -e
+go test -v -tags=structr_32bit_hash .
+go test -v -tags=structr_48bit_hash .
+go test -v -tags=structr_64bit_hash .
\ No newline at end of file
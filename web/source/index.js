@@ -64,6 +64,15 @@ skulk({
 				}]
 			],
 		},
+		theme: {
+			entryFile: "theme",
+			outputFile: "theme.js",
+			preset: ["js"],
+			prodCfg: prodCfg,
+			transform: [
+				["babelify", { global: true }]
+			],
+		},
 		settings: {
 			entryFile: "settings",
 			outputFile: "settings.js",
@@ -67,6 +67,8 @@ function UserProfileForm({ data: profile }) {
 		- file header
 		- bool enable_rss
 		- bool hide_collections
+		- bool hide_interaction_counts
+		- bool hide_outbox
 		- string custom_css (if enabled)
 		- string theme
 	*/
@@ -101,6 +103,8 @@ function UserProfileForm({ data: profile }) {
 		discoverable: useBoolInput("discoverable", { source: profile}),
 		enableRSS: useBoolInput("enable_rss", { source: profile }),
 		hideCollections: useBoolInput("hide_collections", { source: profile }),
+		hideInteractionCounts: useBoolInput("hide_interaction_counts", { source: profile }),
+		hideOutbox: useBoolInput("hide_outbox", { source: profile }),
 		fields: useFieldArrayInput("fields_attributes", {
 			defaultValue: profile?.source?.fields,
 			length: instanceConfig.maxPinnedFields
@@ -215,6 +219,14 @@ function UserProfileForm({ data: profile }) {
 				field={form.hideCollections}
 				label="Hide who you follow / are followed by"
 			/>
+			<Checkbox
+				field={form.hideInteractionCounts}
+				label="Hide favourite and boost counts of your posts from others"
+			/>
+			<Checkbox
+				field={form.hideOutbox}
+				label="Hide your posts from remote outbox fetches"
+			/>
 
 			<div className="form-section-docs">
 				<h3>Advanced</h3>
@@ -0,0 +1,88 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+/*
+	Applies the visitor's chosen color theme, and
+	wires up the theme toggle button in the footer.
+
+	This script is loaded synchronously in the page
+	<head> so that a stored theme is applied before
+	the page is first painted, avoiding a flash of
+	the instance's default theme.
+*/
+
+const storageKey = "gts-theme";
+const themes = ["system", "light", "dark"];
+const root = document.documentElement;
+
+// Instance default, as rendered into the template.
+const defaultTheme = root.dataset.theme;
+
+function getStoredTheme() {
+	try {
+		return window.localStorage.getItem(storageKey);
+	} catch (e) {
+		// Storage may be disabled
+		// by browser privacy settings.
+		return null;
+	}
+}
+
+function storeTheme(theme) {
+	try {
+		if (theme == defaultTheme) {
+			window.localStorage.removeItem(storageKey);
+		} else {
+			window.localStorage.setItem(storageKey, theme);
+		}
+	} catch (e) {
+		// Nothing we can do, the theme
+		// will just be applied to this page.
+	}
+}
+
+const storedTheme = getStoredTheme();
+if (themes.includes(storedTheme)) {
+	root.dataset.theme = storedTheme;
+}
+
+document.addEventListener("DOMContentLoaded", () => {
+	const toggle = document.getElementById("theme-toggle");
+	if (toggle == null) {
+		return;
+	}
+
+	const label = toggle.querySelector(".theme-toggle-label");
+	const updateLabel = () => {
+		label.textContent = `Theme: ${root.dataset.theme}`;
+	};
+
+	toggle.addEventListener("click", () => {
+		// Cycle through system -> light -> dark.
+		const current = themes.indexOf(root.dataset.theme);
+		const next = themes[(current + 1) % themes.length];
+
+		root.dataset.theme = next;
+		storeTheme(next);
+		updateLabel();
+	});
+
+	updateLabel();
+	toggle.closest("li").hidden = false;
+});